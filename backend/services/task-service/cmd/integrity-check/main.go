@@ -0,0 +1,50 @@
+// Command integrity-check menjalankan pemeriksaan integritas data task-service satu kali,
+// mencetak laporannya sebagai JSON, dan keluar dengan status 1 jika masih ada anomali.
+//
+//	DATABASE_URL=postgres://... go run ./cmd/integrity-check [-repair]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/persistence"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	repair := flag.Bool("repair", false, "perbaiki anomali yang ditemukan")
+	flag.Parse()
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		log.Fatalf("DATABASE_URL must be set")
+	}
+
+	dbpool, err := pgxpool.New(context.Background(), databaseURL)
+	if err != nil {
+		log.Fatalf("Could not create database pool: %s\n", err.Error())
+	}
+	defer dbpool.Close()
+
+	integrityService := application.NewIntegrityService(
+		persistence.NewPostgresIntegrityChecks(dbpool),
+		persistence.NewPostgresAdminAuditRepository(dbpool),
+	)
+	report := integrityService.RunChecks(context.Background(), *repair)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(dto.NewIntegrityReportResponse(report)); err != nil {
+		log.Fatalf("Could not write report: %s\n", err.Error())
+	}
+	if !report.Clean() {
+		dbpool.Close()
+		os.Exit(1)
+	}
+}
@@ -1,33 +1,1212 @@
 package main
 
 import (
-	"fmt"
-	"log"
+	"cmp"
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/buildinfo"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/attachmentstore"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/blobstore"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/circuitbreaker"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/discord"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/errorreport"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/faultinject"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/fieldcrypt"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/googlecalendar"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/idgen"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/leader"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/logging"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/mailer"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/matrix"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/persistence"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/push"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/ratelimit"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/realtime"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/runtimeconfig"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/secrets"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/slack"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/telemetry"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/todoist"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/userdirectory"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/userevents"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/webhook"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/caldav"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/graphql"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/rest"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/rpc"
+	taskv1 "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/pkg/pb/task/v1"
+	"github.com/exaring/otelpgx"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
 )
 
 func main() {
-	fmt.Println("Starting Task Service...")
+	logger, logLevel, err := logging.New(os.Stderr, os.Getenv("LOG_FORMAT"), os.Getenv("LOG_LEVEL"))
+	if err != nil {
+		fatal("Invalid logging configuration", "error", err)
+	}
+	slog.SetDefault(logger)
+	build := buildinfo.Get()
+	slog.Info("Starting Task Service...", "version", build.Version, "commit", build.Commit, "build_time", build.BuildTime, "go_version", build.GoVersion)
 
-	// Konfigurasi akan ditambahkan di sini nanti (port, db conn string, dll)
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8081" // Port default untuk task-service
 	}
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9081" // Port default untuk API gRPC
+	}
+
+	// DATABASE_URL, SUPABASE_JWT_SECRET, LOCAL_AUTH_SECRET, GUEST_TOKEN_SECRET, SMTP_USERNAME,
+	// SMTP_PASSWORD, dan FIELD_ENCRYPTION_KEY boleh berisi referensi "vault:<path>#<key>" atau "aws-sm:<secret-id>[#<key>]"
+	// yang diambil saat startup dan di-refresh setiap SECRETS_REFRESH_INTERVAL.
+	secretNames := []string{"DATABASE_URL", "SUPABASE_JWT_SECRET", "LOCAL_AUTH_SECRET", "GUEST_TOKEN_SECRET", "SMTP_USERNAME", "SMTP_PASSWORD", "FIELD_ENCRYPTION_KEY"}
+	secretBackends := make(map[string]secrets.Backend)
+	if vaultAddr := os.Getenv("VAULT_ADDR"); vaultAddr != "" {
+		vaultBackend, err := secrets.NewVaultBackend(secrets.VaultConfig{
+			Addr:      vaultAddr,
+			Token:     os.Getenv("VAULT_TOKEN"),
+			TokenFile: os.Getenv("VAULT_TOKEN_FILE"),
+			Namespace: os.Getenv("VAULT_NAMESPACE"),
+		})
+		if err != nil {
+			fatal("Invalid Vault configuration", "error", err)
+		}
+		secretBackends[secrets.SchemeVault] = vaultBackend
+	}
+	for _, name := range secretNames {
+		if strings.HasPrefix(os.Getenv(name), secrets.SchemeAWS+":") {
+			awsBackend, err := secrets.NewAWSBackend(context.Background())
+			if err != nil {
+				fatal("Invalid AWS configuration", "error", err)
+			}
+			secretBackends[secrets.SchemeAWS] = awsBackend
+			break
+		}
+	}
+	secretManager := secrets.NewManager(secretBackends)
+	resolvedSecrets := make(map[string]*secrets.Secret, len(secretNames))
+	for _, name := range secretNames {
+		secret, err := secretManager.Resolve(context.Background(), os.Getenv(name))
+		if err != nil {
+			fatal("Could not resolve secret", "env", name, "error", err)
+		}
+		resolvedSecrets[name] = secret
+	}
+	secretsRefreshInterval := 5 * time.Minute
+	if raw := os.Getenv("SECRETS_REFRESH_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid SECRETS_REFRESH_INTERVAL", "error", err)
+		}
+		secretsRefreshInterval = parsed
+	}
+
+	databaseURL := resolvedSecrets["DATABASE_URL"]
+	if databaseURL.Value() == "" {
+		fatal("DATABASE_URL must be set")
+	}
+	jwtSecret := resolvedSecrets["SUPABASE_JWT_SECRET"]
+	oidcIssuerURL := os.Getenv("OIDC_ISSUER_URL")
+	// LOCAL_AUTH_SECRET mengaktifkan mode autentikasi lokal untuk instalasi tanpa Supabase:
+	// task-service sendiri yang menerbitkan dan memverifikasi access token.
+	localAuthSecret := resolvedSecrets["LOCAL_AUTH_SECRET"]
+	localAuthEnabled := localAuthSecret.Value() != ""
+	// SUPABASE_JWKS_URL mengaktifkan verifikasi JWT Supabase yang ditandatangani signing key
+	// asimetris; kosong berarti JWKS Supabase Auth di SUPABASE_URL, jika diisi.
+	supabaseJWKSURL := os.Getenv("SUPABASE_JWKS_URL")
+	if supabaseJWKSURL != "" {
+		parsed, err := url.Parse(supabaseJWKSURL)
+		if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			fatal("Invalid SUPABASE_JWKS_URL: must be an absolute http(s) URL")
+		}
+	}
+	if jwtSecret.Value() == "" && supabaseJWKSURL == "" && oidcIssuerURL == "" && !localAuthEnabled {
+		fatal("SUPABASE_JWT_SECRET, SUPABASE_JWKS_URL, OIDC_ISSUER_URL, or LOCAL_AUTH_SECRET must be set")
+	}
+	if localAuthEnabled && (jwtSecret.Value() != "" || supabaseJWKSURL != "" || oidcIssuerURL != "") {
+		fatal("LOCAL_AUTH_SECRET cannot be combined with SUPABASE_JWT_SECRET, SUPABASE_JWKS_URL, or OIDC_ISSUER_URL")
+	}
+	if supabaseJWKSURL == "" && oidcIssuerURL == "" && !localAuthEnabled {
+		if supabaseURL := os.Getenv("SUPABASE_URL"); supabaseURL != "" {
+			supabaseJWKSURL = strings.TrimSuffix(supabaseURL, "/") + "/auth/v1/.well-known/jwks.json"
+		}
+	}
+	localAuthAccessTTL := auth.DefaultAccessTokenTTL
+	if raw := os.Getenv("LOCAL_AUTH_ACCESS_TOKEN_TTL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid LOCAL_AUTH_ACCESS_TOKEN_TTL: must be a positive duration")
+		}
+		localAuthAccessTTL = parsed
+	}
+	localAuthRefreshTTL := application.DefaultRefreshTokenTTL
+	if raw := os.Getenv("LOCAL_AUTH_REFRESH_TOKEN_TTL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid LOCAL_AUTH_REFRESH_TOKEN_TTL: must be a positive duration")
+		}
+		localAuthRefreshTTL = parsed
+	}
+	localAuthSignup := true
+	if raw := os.Getenv("LOCAL_AUTH_SIGNUP"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			fatal("Invalid LOCAL_AUTH_SIGNUP", "error", err)
+		}
+		localAuthSignup = parsed
+	}
+	// LOCAL_AUTH_UNLOCK_URL adalah halaman frontend untuk link di email buka kunci akun.
+	localAuthUnlockURL := os.Getenv("LOCAL_AUTH_UNLOCK_URL")
+	if localAuthUnlockURL != "" {
+		parsed, err := url.Parse(localAuthUnlockURL)
+		if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			fatal("Invalid LOCAL_AUTH_UNLOCK_URL: must be an absolute http(s) URL")
+		}
+	}
+	// GUEST_TOKEN_SECRET mengaktifkan mode tamu: klien anonim bisa membuat task dengan token tamu
+	// lalu menggabungkannya ke akun setelah signup.
+	guestTokenSecret := resolvedSecrets["GUEST_TOKEN_SECRET"]
+	if value := guestTokenSecret.Value(); value != "" && len(value) < auth.MinGuestTokenSecretLength {
+		fatal("Invalid GUEST_TOKEN_SECRET: too short", "min_bytes", auth.MinGuestTokenSecretLength)
+	}
+	guestTokenTTL := application.DefaultGuestTokenTTL
+	if raw := os.Getenv("GUEST_TOKEN_TTL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid GUEST_TOKEN_TTL: must be a positive duration")
+		}
+		guestTokenTTL = parsed
+	}
+	guestMaxTasks := int64(application.DefaultGuestMaxTasks)
+	if raw := os.Getenv("GUEST_MAX_TASKS"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid GUEST_MAX_TASKS: must be a positive integer")
+		}
+		guestMaxTasks = parsed
+	}
+
+	integrityInterval := 6 * time.Hour
+	if raw := os.Getenv("INTEGRITY_CHECK_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid INTEGRITY_CHECK_INTERVAL", "error", err)
+		}
+		integrityInterval = parsed
+	}
+	integrityAutoRepair := false
+	if raw := os.Getenv("INTEGRITY_AUTO_REPAIR"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			fatal("Invalid INTEGRITY_AUTO_REPAIR", "error", err)
+		}
+		integrityAutoRepair = parsed
+	}
+
+	undoWindow := application.DefaultUndoWindow
+	if raw := os.Getenv("BULK_UNDO_WINDOW"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid BULK_UNDO_WINDOW", "error", err)
+		}
+		undoWindow = parsed
+	}
+
+	archiveRetention := application.DefaultArchiveRetention
+	if raw := os.Getenv("ARCHIVE_RETENTION"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid ARCHIVE_RETENTION", "error", err)
+		}
+		archiveRetention = parsed
+	}
+	securityAuditRetention := application.DefaultSecurityAuditRetention
+	if raw := os.Getenv("SECURITY_AUDIT_RETENTION"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid SECURITY_AUDIT_RETENTION: must be a positive duration")
+		}
+		securityAuditRetention = parsed
+	}
+	dataExportRetention := application.DefaultDataExportRetention
+	if raw := os.Getenv("DATA_EXPORT_RETENTION"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid DATA_EXPORT_RETENTION: must be a positive duration")
+		}
+		dataExportRetention = parsed
+	}
+	blobStoreDir := os.Getenv("BLOB_STORE_DIR")
+	if blobStoreDir == "" {
+		blobStoreDir = "./data/blobs"
+	}
+	// FIELD_ENCRYPTION_KEY mengaktifkan enkripsi deskripsi task dan isi komentar di database.
+	fieldCipher, err := fieldcrypt.New(resolvedSecrets["FIELD_ENCRYPTION_KEY"].Value)
+	if err != nil {
+		fatal("Invalid FIELD_ENCRYPTION_KEY", "error", err)
+	}
+
+	attachmentMaxSize := int64(application.DefaultMaxAttachmentSize)
+	if raw := os.Getenv("ATTACHMENT_MAX_SIZE"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			fatal("Invalid ATTACHMENT_MAX_SIZE", "error", err)
+		}
+		attachmentMaxSize = parsed
+	}
+	attachmentBucket := os.Getenv("ATTACHMENT_BUCKET")
+	if attachmentBucket == "" {
+		attachmentBucket = "attachments"
+	}
+	attachmentDir := os.Getenv("ATTACHMENT_DIR")
+	if attachmentDir == "" {
+		attachmentDir = "./data/attachments"
+	}
+	attachmentStorage, err := attachmentstore.New(attachmentstore.Config{
+		Provider:          os.Getenv("ATTACHMENT_STORAGE"),
+		Bucket:            attachmentBucket,
+		SupabaseURL:       os.Getenv("SUPABASE_URL"),
+		SupabaseAPIKey:    os.Getenv("SUPABASE_SERVICE_ROLE_KEY"),
+		S3Region:          os.Getenv("S3_REGION"),
+		S3Endpoint:        os.Getenv("S3_ENDPOINT"),
+		S3AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		S3SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		LocalDir:          attachmentDir,
+		LocalBaseURL:      os.Getenv("ATTACHMENT_PUBLIC_URL"),
+		LocalSigningKey:   os.Getenv("ATTACHMENT_SIGNING_KEY"),
+	})
+	if err != nil {
+		fatal("Invalid attachment storage configuration", "error", err)
+	}
+	// Storage yang isinya dilayani task-service sendiri (ATTACHMENT_STORAGE=local) juga http.Handler.
+	attachmentObjects, _ := attachmentStorage.(http.Handler)
+
+	webhookAllowPrivate := false
+	if raw := os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			fatal("Invalid WEBHOOK_ALLOW_PRIVATE_NETWORKS", "error", err)
+		}
+		webhookAllowPrivate = parsed
+	}
+
+	matrixAllowPrivate := false
+	if raw := os.Getenv("MATRIX_ALLOW_PRIVATE_NETWORKS"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			fatal("Invalid MATRIX_ALLOW_PRIVATE_NETWORKS", "error", err)
+		}
+		matrixAllowPrivate = parsed
+	}
+	matrixClient := matrix.NewClient(matrixAllowPrivate)
+
+	googleCalendarInterval := 15 * time.Minute
+	if raw := os.Getenv("GOOGLE_CALENDAR_SYNC_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid GOOGLE_CALENDAR_SYNC_INTERVAL", "error", err)
+		}
+		googleCalendarInterval = parsed
+	}
+	googleCalendarClient := googlecalendar.NewClient(os.Getenv("GOOGLE_CLIENT_ID"), os.Getenv("GOOGLE_CLIENT_SECRET"))
+
+	// Circuit breaker database dan SMTP terbuka setelah CIRCUIT_BREAKER_FAILURES kegagalan beruntun,
+	// lalu menolak pemanggilan dengan 503 selama CIRCUIT_BREAKER_OPEN_TIMEOUT sebelum mencoba lagi.
+	breakerConfig := circuitbreaker.Config{ConsecutiveFailures: 5, OpenTimeout: 10 * time.Second}
+	if raw := os.Getenv("CIRCUIT_BREAKER_FAILURES"); raw != "" {
+		parsed, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			fatal("Invalid CIRCUIT_BREAKER_FAILURES: must be a non-negative integer")
+		}
+		breakerConfig.ConsecutiveFailures = uint32(parsed)
+	}
+	if raw := os.Getenv("CIRCUIT_BREAKER_OPEN_TIMEOUT"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid CIRCUIT_BREAKER_OPEN_TIMEOUT: must be a positive duration")
+		}
+		breakerConfig.OpenTimeout = parsed
+	}
+
+	smtpPort := 0
+	if raw := os.Getenv("SMTP_PORT"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid SMTP_PORT: must be a positive integer")
+		}
+		smtpPort = parsed
+	}
+	emailSender, err := mailer.New(mailer.Config{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     smtpPort,
+		Username: resolvedSecrets["SMTP_USERNAME"].Value(),
+		From:     os.Getenv("SMTP_FROM"),
+		TLS:      os.Getenv("SMTP_TLS"),
+
+		PasswordFunc: resolvedSecrets["SMTP_PASSWORD"].Value,
+	})
+	if err != nil {
+		fatal("Invalid SMTP configuration", "error", err)
+	}
+	emailSender = mailer.NewBreakerSender(emailSender, circuitbreaker.New("smtp", breakerConfig, mailer.IsDeliveryFailure))
+	emailReminderInterval := time.Minute
+	if raw := os.Getenv("EMAIL_REMINDER_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid EMAIL_REMINDER_INTERVAL", "error", err)
+		}
+		emailReminderInterval = parsed
+	}
+
+	pushSender, err := push.New(push.Config{
+		FCMCredentialsFile: os.Getenv("FCM_CREDENTIALS_FILE"),
+		VAPIDPublicKey:     os.Getenv("VAPID_PUBLIC_KEY"),
+		VAPIDPrivateKey:    os.Getenv("VAPID_PRIVATE_KEY"),
+		VAPIDSubject:       os.Getenv("VAPID_SUBJECT"),
+	})
+	if err != nil {
+		fatal("Invalid push configuration", "error", err)
+	}
+	pushReminderInterval := time.Minute
+	if raw := os.Getenv("PUSH_REMINDER_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid PUSH_REMINDER_INTERVAL", "error", err)
+		}
+		pushReminderInterval = parsed
+	}
+	slackReminderInterval := time.Minute
+	if raw := os.Getenv("SLACK_REMINDER_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid SLACK_REMINDER_INTERVAL", "error", err)
+		}
+		slackReminderInterval = parsed
+	}
+	slackClient := slack.NewClient()
+
+	// Profil pengguna (nama tampilan dan avatar) dibaca dari user-service lewat gRPC dan di-cache di
+	// memori; tanpa USER_SERVICE_ADDR pengguna hanya ditampilkan dengan ID-nya.
+	var userDirectory domain.UserDirectory = userdirectory.Unavailable{}
+	if addr := os.Getenv("USER_SERVICE_ADDR"); addr != "" {
+		timeout := 500 * time.Millisecond
+		if raw := os.Getenv("USER_SERVICE_TIMEOUT"); raw != "" {
+			parsed, err := time.ParseDuration(raw)
+			if err != nil {
+				fatal("Invalid USER_SERVICE_TIMEOUT", "error", err)
+			}
+			timeout = parsed
+		}
+		userServiceClient, err := userdirectory.NewGRPCClient(addr, os.Getenv("USER_SERVICE_TOKEN"), timeout)
+		if err != nil {
+			fatal("Could not create user-service client", "error", err)
+		}
+		defer userServiceClient.Close()
+		userDirectory = userdirectory.NewCache(userServiceClient, userdirectory.DefaultCacheConfig())
+	}
+	userEventPollInterval := time.Minute
+	if raw := os.Getenv("USER_EVENT_POLL_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid USER_EVENT_POLL_INTERVAL", "error", err)
+		}
+		userEventPollInterval = parsed
+	}
+	leaderConfig := leader.DefaultElectorConfig()
+	if raw := os.Getenv("LEADER_ELECTION_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid LEADER_ELECTION_INTERVAL: must be a positive duration")
+		}
+		leaderConfig.CheckInterval = parsed
+	}
+	shutdownTimeout := 20 * time.Second
+	if raw := os.Getenv("SHUTDOWN_TIMEOUT"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid SHUTDOWN_TIMEOUT: must be a positive duration")
+		}
+		shutdownTimeout = parsed
+	}
+	// SHUTDOWN_DRAIN_PERIOD adalah jeda antara SIGTERM dan berhentinya penerimaan koneksi baru,
+	// agar orchestrator sempat mengeluarkan replika dari endpoint load balancer.
+	shutdownDrainPeriod := 5 * time.Second
+	if raw := os.Getenv("SHUTDOWN_DRAIN_PERIOD"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			fatal("Invalid SHUTDOWN_DRAIN_PERIOD: must be a non-negative duration")
+		}
+		shutdownDrainPeriod = parsed
+	}
+
+	requestTimeout := 30 * time.Second
+	if raw := os.Getenv("REQUEST_TIMEOUT"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid REQUEST_TIMEOUT", "error", err)
+		}
+		requestTimeout = parsed
+	}
+
+	// Access log ditulis terpisah dari log aplikasi (stderr): ACCESS_LOG berisi "stdout" (default),
+	// "stderr", path file, atau "off".
+	var accessLog rest.AccessLogConfig
+	switch dest := os.Getenv("ACCESS_LOG"); dest {
+	case "", "stdout":
+		accessLog.Writer = os.Stdout
+	case "stderr":
+		accessLog.Writer = os.Stderr
+	case "off":
+	default:
+		file, err := os.OpenFile(dest, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			fatal("Failed to open ACCESS_LOG", "error", err)
+		}
+		defer file.Close()
+		accessLog.Writer = file
+	}
+	accessLog.SampleRate = 1
+	if raw := os.Getenv("ACCESS_LOG_SAMPLE_RATE"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed < 0 || parsed > 1 {
+			fatal("Invalid ACCESS_LOG_SAMPLE_RATE: must be a number between 0 and 1")
+		}
+		accessLog.SampleRate = parsed
+	}
+	accessLog.SlowThreshold = time.Second
+	if raw := os.Getenv("ACCESS_LOG_SLOW_THRESHOLD"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			fatal("Invalid ACCESS_LOG_SLOW_THRESHOLD", "error", err)
+		}
+		accessLog.SlowThreshold = parsed
+	}
+
+	// Dengan REDIS_URL, state rate limit disimpan di Redis sehingga batas berlaku di semua
+	// replika; tanpa Redis, batas dihitung per proses.
+	var redisClient *redis.Client
+	if raw := os.Getenv("REDIS_URL"); raw != "" {
+		redisOptions, err := redis.ParseURL(raw)
+		if err != nil {
+			fatal("Invalid REDIS_URL", "error", err)
+		}
+		redisClient = redis.NewClient(redisOptions)
+		defer redisClient.Close()
+	}
+	// REALTIME_TRANSPORT=redis menyebarkan event realtime lewat Redis pub/sub alih-alih Postgres
+	// LISTEN/NOTIFY; event tetap disimpan di task_events.
+	realtimeTransport := cmp.Or(os.Getenv("REALTIME_TRANSPORT"), "postgres")
+	switch realtimeTransport {
+	case "postgres":
+	case "redis":
+		if redisClient == nil {
+			fatal("REALTIME_TRANSPORT=redis requires REDIS_URL")
+		}
+	default:
+		fatal("Invalid REALTIME_TRANSPORT: must be postgres or redis")
+	}
+	rateLimits := rest.RateLimits{
+		Read:   domain.RateLimit{Requests: 1200, Period: time.Minute},
+		Write:  domain.RateLimit{Requests: 300, Period: time.Minute},
+		Guests: domain.RateLimit{Requests: 10, Period: time.Hour},
+	}
+	if raw := os.Getenv("RATE_LIMIT_READ"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			fatal("Invalid RATE_LIMIT_READ: must be a non-negative integer")
+		}
+		rateLimits.Read.Requests = parsed
+	}
+	if raw := os.Getenv("RATE_LIMIT_WRITE"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			fatal("Invalid RATE_LIMIT_WRITE: must be a non-negative integer")
+		}
+		rateLimits.Write.Requests = parsed
+	}
+	if raw := os.Getenv("RATE_LIMIT_GUESTS"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			fatal("Invalid RATE_LIMIT_GUESTS: must be a non-negative integer")
+		}
+		rateLimits.Guests.Requests = parsed
+	}
+	var rateLimiter domain.RateLimiter
+	if redisClient != nil {
+		rateLimiter = ratelimit.NewRedisLimiter(redisClient)
+	} else {
+		rateLimiter = ratelimit.NewMemoryLimiter()
+	}
+	rateLimit := rest.NewRateLimitConfig(rateLimiter, rateLimits)
+
+	// Fault injection (FAULT_INJECTION=true) hanya untuk pengujian ketahanan: latency dan error
+	// buatan disisipkan ke TaskRepository dan notifier event.
+	var faultInjector *faultinject.Injector
+	if os.Getenv("FAULT_INJECTION") == "true" {
+		var faultConfig faultinject.Config
+		if raw := os.Getenv("FAULT_INJECTION_LATENCY"); raw != "" {
+			parsed, err := time.ParseDuration(raw)
+			if err != nil || parsed < 0 {
+				fatal("Invalid FAULT_INJECTION_LATENCY: must be a non-negative duration")
+			}
+			faultConfig.Latency = parsed
+		}
+		if raw := os.Getenv("FAULT_INJECTION_JITTER"); raw != "" {
+			parsed, err := time.ParseDuration(raw)
+			if err != nil || parsed < 0 {
+				fatal("Invalid FAULT_INJECTION_JITTER: must be a non-negative duration")
+			}
+			faultConfig.Jitter = parsed
+		}
+		if raw := os.Getenv("FAULT_INJECTION_ERROR_RATE"); raw != "" {
+			parsed, err := strconv.ParseFloat(raw, 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				fatal("Invalid FAULT_INJECTION_ERROR_RATE: must be a number between 0 and 1")
+			}
+			faultConfig.ErrorRate = parsed
+		}
+		if raw := os.Getenv("FAULT_INJECTION_OPERATIONS"); raw != "" {
+			for _, operation := range strings.Split(raw, ",") {
+				faultConfig.Operations = append(faultConfig.Operations, strings.TrimSpace(operation))
+			}
+		}
+		faultInjector = faultinject.New(faultConfig)
+		slog.Warn("Fault injection enabled, do not use in production",
+			"latency", faultConfig.Latency.String(), "jitter", faultConfig.Jitter.String(),
+			"error_rate", faultConfig.ErrorRate, "operations", faultConfig.Operations)
+	}
+
+	// Panic di handler HTTP dan gRPC selalu dicatat di log; dengan SENTRY_DSN juga dikirim ke Sentry.
+	var panicReporter errorreport.Reporter = errorreport.Nop{}
+	if dsn := os.Getenv("SENTRY_DSN"); dsn != "" {
+		sentry, err := errorreport.NewSentry(errorreport.SentryConfig{
+			DSN:         dsn,
+			Environment: os.Getenv("SENTRY_ENVIRONMENT"),
+			Release:     cmp.Or(os.Getenv("SENTRY_RELEASE"), build.Version),
+		})
+		if err != nil {
+			fatal("Invalid SENTRY_DSN", "error", err)
+		}
+		panicReporter = sentry
+	}
+
+	// Endpoint pprof dan runtime hanya tersedia di port internal DEBUG_ADDR dan membutuhkan DEBUG_TOKEN.
+	debugAddr := os.Getenv("DEBUG_ADDR")
+	debugToken := os.Getenv("DEBUG_TOKEN")
+	if debugAddr != "" && len(debugToken) < 32 {
+		fatal("DEBUG_TOKEN must be at least 32 characters when DEBUG_ADDR is set")
+	}
+
+	var wsAllowedOrigins []string
+	for _, origin := range strings.Split(os.Getenv("WS_ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			wsAllowedOrigins = append(wsAllowedOrigins, origin)
+		}
+	}
+	var corsAllowedOrigins []string
+	for _, origin := range strings.Split(os.Getenv("CORS_ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			corsAllowedOrigins = append(corsAllowedOrigins, origin)
+		}
+	}
+	corsConfig := rest.NewCORSConfig(corsAllowedOrigins)
+	trustedProxies, err := rest.ParseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		fatal("Invalid TRUSTED_PROXIES", "error", err)
+	}
+	// SESSION_COOKIE mengaktifkan autentikasi cookie untuk frontend browser; kosong berarti hanya
+	// header Authorization (klien bearer token) yang diterima dan CSRF tidak relevan.
+	var sessionCookie *rest.SessionCookieConfig
+	if name := os.Getenv("SESSION_COOKIE"); name != "" {
+		if err := (&http.Cookie{Name: name}).Valid(); err != nil {
+			fatal("Invalid SESSION_COOKIE", "error", err)
+		}
+		sessionCookie = &rest.SessionCookieConfig{Name: name, CSRF: true}
+		if raw := os.Getenv("CSRF_PROTECTION"); raw != "" {
+			parsed, err := strconv.ParseBool(raw)
+			if err != nil {
+				fatal("Invalid CSRF_PROTECTION", "error", err)
+			}
+			sessionCookie.CSRF = parsed
+		}
+		if !sessionCookie.CSRF {
+			slog.Warn("CSRF protection disabled for cookie sessions")
+		}
+		corsConfig.AllowCredentials = true
+	}
+	featureHandler := rest.NewFeatureHandler(nil)
+
+	// CONFIG_FILE berisi pengaturan yang bisa dimuat ulang tanpa restart (SIGHUP atau saat file
+	// berubah): level log, rate limit, origin CORS, dan feature flag. Field yang tidak diisi
+	// kembali ke nilai dari environment.
+	configFile := os.Getenv("CONFIG_FILE")
+	configPollInterval := 10 * time.Second
+	if raw := os.Getenv("CONFIG_FILE_POLL_INTERVAL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			fatal("Invalid CONFIG_FILE_POLL_INTERVAL: must be a non-negative duration")
+		}
+		configPollInterval = parsed
+	}
+	envLogLevel := logLevel.Base()
+	applyRuntimeConfig := func(config runtimeconfig.Config) {
+		level := envLogLevel
+		if config.LogLevel != nil {
+			level = *config.LogLevel
+		}
+		logLevel.SetBase(level)
+		limits := rateLimits
+		if config.RateLimit.Read != nil {
+			limits.Read.Requests = *config.RateLimit.Read
+		}
+		if config.RateLimit.Write != nil {
+			limits.Write.Requests = *config.RateLimit.Write
+		}
+		rateLimit.SetLimits(limits)
+		origins := corsAllowedOrigins
+		if config.CORS.AllowedOrigins != nil {
+			origins = config.CORS.AllowedOrigins
+		}
+		corsConfig.SetAllowedOrigins(origins)
+		featureHandler.SetFlags(config.Features)
+	}
+	var configWatcher *runtimeconfig.Watcher
+	if configFile != "" {
+		runtimeConfig, err := runtimeconfig.Load(configFile)
+		if err != nil {
+			fatal("Invalid CONFIG_FILE", "error", err)
+		}
+		applyRuntimeConfig(runtimeConfig)
+		configWatcher = runtimeconfig.NewWatcher(configFile, applyRuntimeConfig)
+	}
 
-	// Setup router HTTP (akan menggunakan chi/gin nanti)
-	// router := SetupRouter() // Fungsi ini akan dibuat nanti
+	var discordPublicKey ed25519.PublicKey
+	if raw := os.Getenv("DISCORD_PUBLIC_KEY"); raw != "" {
+		parsed, err := hex.DecodeString(raw)
+		if err != nil || len(parsed) != ed25519.PublicKeySize {
+			fatal("Invalid DISCORD_PUBLIC_KEY: must be a hex-encoded Ed25519 public key")
+		}
+		discordPublicKey = parsed
+	}
+	discordClient := discord.NewClient(os.Getenv("DISCORD_BOT_TOKEN"))
+
+	idGen, err := idgen.New(os.Getenv("TASK_ID_STRATEGY"))
+	if err != nil {
+		fatal("Invalid TASK_ID_STRATEGY", "error", err)
+	}
+
+	shutdownTracing, err := telemetry.Setup(context.Background(), "task-service", build.Version)
+	if err != nil {
+		fatal("Could not set up tracing", "error", err)
+	}
+	if telemetry.Enabled() {
+		slog.Info("OpenTelemetry tracing enabled")
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(databaseURL.Value())
+	if err != nil {
+		fatal("Invalid DATABASE_URL", "error", err)
+	}
+	if secrets.IsReference(os.Getenv("DATABASE_URL")) {
+		// Koneksi baru memakai user dan password dari DATABASE_URL terbaru, sehingga kredensial
+		// yang dirotasi berlaku tanpa restart. Koneksi lama diganti pool paling lambat setelah
+		// MaxConnLifetime (default 1 jam, atau pool_max_conn_lifetime di DSN).
+		poolConfig.BeforeConnect = func(ctx context.Context, connConfig *pgx.ConnConfig) error {
+			current, err := pgx.ParseConfig(databaseURL.Value())
+			if err != nil {
+				return err
+			}
+			connConfig.User = current.User
+			connConfig.Password = current.Password
+			return nil
+		}
+	}
+	// Setiap query menjadi span di bawah span request yang menjalankannya. Parameter query tidak
+	// ikut dicatat karena bisa berisi data pengguna.
+	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithTrimSQLInSpanName())
+	persistence.ProtectPool(poolConfig, circuitbreaker.New("database", breakerConfig, persistence.IsDatabaseFailure))
+	dbpool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		fatal("Could not create database pool", "error", err)
+	}
+	defer dbpool.Close()
+
+	// Listener dan job latar belakang berhenti lewat ctx saat shutdown, sebelum pool ditutup;
+	// dbpool.Close menunggu semua koneksi yang sedang dipakai dikembalikan.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	// Untuk sementara, buat handler sederhana
-	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		fmt.Fprintf(w, "Task Service is healthy!")
+	if secretsRefreshInterval > 0 {
+		go secretManager.Run(ctx, secretsRefreshInterval)
+	}
+
+	// SIGUSR1 mengganti level log antara LOG_LEVEL dan debug tanpa restart (lihat juga
+	// PUT /api/v1/admin/log-level); SIGHUP memuat ulang CONFIG_FILE.
+	runtimeSignals := make(chan os.Signal, 1)
+	signal.Notify(runtimeSignals, syscall.SIGUSR1, syscall.SIGHUP)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				signal.Stop(runtimeSignals)
+				return
+			case sig := <-runtimeSignals:
+				if sig == syscall.SIGUSR1 {
+					slog.Warn("log level changed", "level", logLevel.ToggleDebug().String(), "signal", "SIGUSR1")
+					continue
+				}
+				if configWatcher == nil {
+					slog.Warn("SIGHUP received but CONFIG_FILE is not set, nothing to reload")
+					continue
+				}
+				if err := configWatcher.Reload(); err != nil {
+					slog.Error("error reloading configuration, keeping previous values", "path", configFile, "trigger", "SIGHUP", "error", err)
+					continue
+				}
+				slog.Warn("configuration reloaded", "path", configFile, "trigger", "SIGHUP")
+			}
+		}
+	}()
+	if configWatcher != nil && configPollInterval > 0 {
+		go configWatcher.Run(ctx, configPollInterval)
+	}
+
+	// Change feed realtime: event disebarkan ke semua replika lewat Postgres LISTEN/NOTIFY atau
+	// Redis pub/sub, lalu diteruskan ke subscriber lokal (misalnya klien WebSocket) oleh hub.
+	eventHub := realtime.NewHub()
+	var realtimePublisher domain.TaskEventPublisher
+	var eventListener realtime.Listener
+	if realtimeTransport == "redis" {
+		realtimePublisher = realtime.NewRedisEventPublisher(dbpool, redisClient, realtime.DefaultChannel)
+		eventListener = realtime.NewRedisSubscriber(dbpool, redisClient, eventHub, realtime.DefaultListenerConfig())
+	} else {
+		realtimePublisher = realtime.NewPostgresEventPublisher(dbpool, realtime.DefaultChannel)
+		eventListener = realtime.NewPostgresListener(dbpool, eventHub, realtime.DefaultListenerConfig())
+	}
+	go func() {
+		if err := eventListener.Run(ctx); err != nil {
+			slog.Error("Task event listener stopped", "error", err)
+		}
+	}()
+
+	// Notifikasi (webhook, Discord, Matrix, Google Calendar, email, push, Slack) dikirim oleh replika yang menangani write, bukan oleh
+	// setiap replika penerima change feed.
+	webhookRepo := persistence.NewPostgresWebhookRepository(dbpool)
+	taskCallbackRepo := persistence.NewPostgresTaskCallbackRepository(dbpool)
+	webhookSender := webhook.NewHTTPSender(webhookAllowPrivate)
+	discordChannelRepo := persistence.NewPostgresDiscordChannelRepository(dbpool)
+	matrixChannelRepo := persistence.NewPostgresMatrixChannelRepository(dbpool)
+	googleCalendarConnRepo := persistence.NewPostgresGoogleCalendarConnectionRepository(dbpool)
+	googleCalendarLinkRepo := persistence.NewPostgresGoogleCalendarLinkRepository(dbpool)
+	emailChannelRepo := persistence.NewPostgresEmailChannelRepository(dbpool, fieldCipher)
+	pushChannelRepo := persistence.NewPostgresPushChannelRepository(dbpool, fieldCipher)
+	slackChannelRepo := persistence.NewPostgresSlackChannelRepository(dbpool, fieldCipher)
+	deviceRepo := persistence.NewPostgresDeviceRepository(dbpool)
+	listShareRepo := persistence.NewPostgresListShareRepository(dbpool)
+	taskRepo := persistence.NewPostgresTaskRepository(dbpool, idGen, fieldCipher)
+	if faultInjector != nil {
+		taskRepo = faultinject.NewTaskRepository(taskRepo, faultInjector)
+	}
+	retrospectiveService := application.NewRetrospectiveService(persistence.NewPostgresRetrospectiveRepository(dbpool), taskRepo)
+	notifiers := []application.EventNotifier{
+		application.NewWebhookNotifier(webhookRepo, webhookSender),
+		application.NewTaskCallbackNotifier(taskCallbackRepo, webhookSender),
+		application.NewDiscordNotifier(discordChannelRepo, discordClient),
+		application.NewMatrixNotifier(matrixChannelRepo, matrixClient),
+		application.NewGoogleCalendarNotifier(googleCalendarConnRepo, googleCalendarLinkRepo, googleCalendarClient),
+		application.NewEmailNotifier(emailChannelRepo, emailSender, retrospectiveService),
+		application.NewPushNotifier(pushChannelRepo, deviceRepo, listShareRepo, pushSender, retrospectiveService),
+		application.NewSlackNotifier(slackChannelRepo, slackClient),
+	}
+	if faultInjector != nil {
+		for i, notifier := range notifiers {
+			notifiers[i] = faultinject.NewNotifier(notifier, faultInjector)
+		}
+	}
+	eventPublisher := application.NewNotifyingPublisher(realtimePublisher, notifiers...)
+	go eventPublisher.Run(ctx, 4)
+
+	// Dependency injection: repository -> application service -> handler
+	quotaService := application.NewQuotaService(
+		persistence.NewPostgresQuotaPolicyRepository(dbpool),
+		persistence.NewPostgresUsageEventRecorder(dbpool),
+		map[domain.QuotaResource]application.UsageCounter{
+			domain.QuotaTasks: taskRepo.CountByUserID,
+		},
+	)
+	enumService := application.NewEnumService(persistence.NewPostgresEnumRepository(dbpool))
+	listShareService := application.NewListShareService(listShareRepo)
+	userProfileService := application.NewUserProfileService(userDirectory, listShareRepo)
+	taskService := application.NewTracingTaskService(application.NewTaskService(taskRepo, eventPublisher, idGen, quotaService, enumService, retrospectiveService, listShareService))
+	syncService := application.NewSyncService(taskRepo, deviceRepo, eventPublisher, idGen, quotaService)
+	deviceService := application.NewDeviceService(deviceRepo)
+	bulkTaskService := application.NewBulkTaskService(taskService, taskRepo, persistence.NewPostgresUndoRepository(dbpool), eventPublisher, undoWindow)
+	taskHistoryService := application.NewTaskHistoryService(taskRepo, persistence.NewPostgresTaskHistoryRepository(dbpool, fieldCipher), eventPublisher, listShareService)
+	attachmentRepo := persistence.NewPostgresAttachmentRepository(dbpool)
+	attachmentService := application.NewAttachmentService(
+		taskRepo, attachmentRepo, attachmentStorage, idGen, listShareService, attachmentMaxSize)
+	taskCommentRepo := persistence.NewPostgresTaskCommentRepository(dbpool, fieldCipher)
+	taskCommentService := application.NewTaskCommentService(
+		taskRepo, taskCommentRepo, listShareService, eventPublisher, idGen)
+	timeTrackingService := application.NewTimeTrackingService(taskRepo, persistence.NewPostgresTimeEntryRepository(dbpool), idGen)
+	boardRepo := persistence.NewPostgresBoardRepository(dbpool, fieldCipher)
+	boardService := application.NewBoardService(boardRepo, taskRepo, eventPublisher, idGen)
+	activityRepo := persistence.NewPostgresActivityRepository(dbpool)
+	activityService := application.NewActivityService(activityRepo)
+	statsService := application.NewStatsService(taskRepo)
+	webhookService := application.NewWebhookService(webhookRepo, idGen)
+	taskCallbackService := application.NewTaskCallbackService(taskCallbackRepo, taskRepo, idGen)
+	scimService := application.NewScimService(
+		persistence.NewPostgresWorkspaceDirectoryRepository(dbpool), persistence.NewPostgresScimTokenRepository(dbpool), idGen)
+	workspaceConfigService := application.NewWorkspaceConfigService(boardRepo, boardService, enumService, scimService, retrospectiveService)
+	calendarFeedService := application.NewCalendarFeedService(taskRepo, persistence.NewPostgresCalendarFeedTokenRepository(dbpool))
+	calDAVService := application.NewCalDAVService(persistence.NewPostgresCalDAVTokenRepository(dbpool))
+	personalAccessTokenRepo := persistence.NewPostgresPersonalAccessTokenRepository(dbpool)
+	personalAccessTokenService := application.NewPersonalAccessTokenService(personalAccessTokenRepo, idGen)
+	var localTokenIssuer domain.AccessTokenIssuer
+	if localAuthEnabled {
+		localTokenIssuer = auth.NewLocalTokenIssuer(localAuthSecret.Value, localAuthAccessTTL)
+	}
+	refreshTokenRepo := persistence.NewPostgresRefreshTokenRepository(dbpool)
+	localAuthService := application.NewLocalAuthService(
+		persistence.NewPostgresLocalUserRepository(dbpool), refreshTokenRepo, persistence.NewPostgresLoginThrottleRepository(dbpool),
+		auth.NewBcryptHasher(), localTokenIssuer, idGen, emailSender,
+		application.LocalAuthConfig{RefreshTokenTTL: localAuthRefreshTTL, SignupEnabled: localAuthSignup, UnlockURL: localAuthUnlockURL})
+	var guestTokenSigner domain.GuestTokenSigner
+	if guestTokenSecret.Value() != "" {
+		guestTokenSigner = auth.NewHMACGuestTokenSigner(guestTokenSecret.Value)
+	}
+	guestService := application.NewGuestService(guestTokenSigner, persistence.NewPostgresGuestMergeRepository(dbpool), taskRepo, idGen, guestTokenTTL, guestMaxTasks)
+	blobStore, err := blobstore.NewFileStore(blobStoreDir)
+	if err != nil {
+		fatal("Could not create blob store", "error", err)
+	}
+	accountService := application.NewAccountService(
+		taskRepo, listShareRepo, emailChannelRepo, pushChannelRepo, slackChannelRepo, personalAccessTokenRepo,
+		persistence.NewPostgresAccountErasureRepository(dbpool), attachmentStorage, blobStore)
+	accountDeletionService := application.NewAccountDeletionService(
+		persistence.NewPostgresAccountDeletionRepository(dbpool), accountService, idGen)
+
+	// Event akun (misalnya user.deleted) dari user-service atau trigger Supabase dikonsumsi lewat
+	// tabel user_events dan LISTEN/NOTIFY, dengan pola yang sama seperti change feed task.
+	userEventConfig := userevents.DefaultConsumerConfig()
+	userEventConfig.PollInterval = userEventPollInterval
+	userEventConsumer := userevents.NewPostgresConsumer(dbpool,
+		application.NewUserEventService(persistence.NewPostgresUserEventRepository(dbpool), accountService), userEventConfig)
+	go func() {
+		if err := userEventConsumer.Run(ctx); err != nil {
+			slog.Error("User event consumer stopped", "error", err)
+		}
+	}()
+	adminAuditRepo := persistence.NewPostgresAdminAuditRepository(dbpool)
+	securityAuditService := application.NewSecurityAuditService(
+		persistence.NewPostgresSecurityAuditRepository(dbpool), adminAuditRepo, securityAuditRetention)
+	go securityAuditService.Run(ctx)
+	userSessionService := application.NewUserSessionService(
+		persistence.NewPostgresUserSessionRepository(dbpool), personalAccessTokenRepo, refreshTokenRepo)
+	go userSessionService.Run(ctx)
+	// Status maintenance dibaca ulang dari database secara berkala agar perubahan admin di satu
+	// replika berlaku di semua replika.
+	maintenanceService := application.NewMaintenanceService(persistence.NewPostgresMaintenanceRepository(dbpool), adminAuditRepo)
+	if err := maintenanceService.Refresh(ctx); err != nil {
+		slog.Warn("Could not load maintenance mode, assuming disabled", "error", err)
+	}
+	go maintenanceService.Run(ctx, 5*time.Second)
+	adminService := application.NewAdminService(
+		persistence.NewPostgresAdminSearchRepository(dbpool, fieldCipher), persistence.NewPostgresSystemStatsRepository(dbpool), taskRepo, taskCommentRepo, eventPublisher, adminAuditRepo)
+	roleService := application.NewRoleService(persistence.NewPostgresRoleRepository(dbpool), adminAuditRepo)
+	integrityService := application.NewIntegrityService(persistence.NewPostgresIntegrityChecks(dbpool), adminAuditRepo)
+	archiveService := application.NewArchiveService(
+		persistence.NewPostgresArchiveRepository(dbpool), taskRepo, blobStore, adminAuditRepo, archiveRetention)
+	discordService := application.NewDiscordService(discordChannelRepo, listShareRepo, taskService, archiveService, discordClient)
+	matrixService := application.NewMatrixService(matrixChannelRepo, matrixClient)
+	emailService := application.NewEmailService(emailChannelRepo, emailSender, retrospectiveService)
+	pushService := application.NewPushService(pushChannelRepo, deviceRepo, pushSender, retrospectiveService)
+	slackService := application.NewSlackService(slackChannelRepo, slackClient)
+	agendaService := application.NewAgendaService(taskRepo, listShareRepo, enumService)
+	todoistImportService := application.NewTodoistImportService(todoist.NewClient(), bulkTaskService)
+	backupService := application.NewBackupService(
+		taskRepo, boardRepo, taskCommentRepo, attachmentRepo, attachmentStorage, enumService, quotaService, idGen)
+	dataExportService := application.NewDataExportService(persistence.NewPostgresDataExportRepository(dbpool),
+		backupService, activityRepo, taskCommentRepo, adminAuditRepo, blobStore, idGen, dataExportRetention)
+	googleCalendarService := application.NewGoogleCalendarService(
+		googleCalendarConnRepo, googleCalendarLinkRepo, googleCalendarClient, taskRepo, taskService)
+	fieldEncryptionService := application.NewFieldEncryptionService(
+		persistence.NewPostgresFieldEncryptionRepository(dbpool, fieldCipher), fieldCipher)
+	// Job terjadwal hanya berjalan di replika leader (advisory lock Postgres), agar pengingat
+	// tidak terkirim ganda dan purge tidak berjalan bersamaan di semua replika.
+	scheduledJobs := []func(context.Context){
+		func(ctx context.Context) { retrospectiveService.RunPeriodically(ctx, time.Hour) },
+		func(ctx context.Context) { archiveService.RunPurgePeriodically(ctx, time.Hour) },
+		func(ctx context.Context) { dataExportService.RunPeriodically(ctx, 15*time.Second) },
+		func(ctx context.Context) { accountDeletionService.RunPeriodically(ctx, 15*time.Second) },
+		func(ctx context.Context) { fieldEncryptionService.RunPeriodically(ctx, time.Minute) },
+		func(ctx context.Context) { securityAuditService.RunPurgePeriodically(ctx, time.Hour) },
+		func(ctx context.Context) { userSessionService.RunPurgePeriodically(ctx, time.Hour) },
+		func(ctx context.Context) { guestService.RunPurgePeriodically(ctx, time.Minute) },
+	}
+	if localAuthEnabled {
+		scheduledJobs = append(scheduledJobs, func(ctx context.Context) {
+			localAuthService.RunPurgePeriodically(ctx, time.Hour)
+		})
+	}
+	if integrityInterval > 0 {
+		scheduledJobs = append(scheduledJobs, func(ctx context.Context) {
+			integrityService.RunPeriodically(ctx, integrityInterval, integrityAutoRepair)
+		})
+	}
+	if googleCalendarInterval > 0 {
+		scheduledJobs = append(scheduledJobs, func(ctx context.Context) {
+			googleCalendarService.RunPeriodically(ctx, googleCalendarInterval)
+		})
+	}
+	if emailReminderInterval > 0 {
+		scheduledJobs = append(scheduledJobs, func(ctx context.Context) {
+			emailService.RunRemindersPeriodically(ctx, emailReminderInterval)
+		})
+	}
+	if pushReminderInterval > 0 {
+		scheduledJobs = append(scheduledJobs, func(ctx context.Context) {
+			pushService.RunRemindersPeriodically(ctx, pushReminderInterval)
+		})
+	}
+	if slackReminderInterval > 0 {
+		scheduledJobs = append(scheduledJobs, func(ctx context.Context) {
+			slackService.RunRemindersPeriodically(ctx, slackReminderInterval)
+		})
+	}
+	go leader.NewPostgresElector(dbpool, leaderConfig).Run(ctx, scheduledJobs...)
+
+	syncHandler, err := rest.NewSyncHandler(syncService)
+	if err != nil {
+		fatal("Could not create sync handler", "error", err)
+	}
+
+	// Dengan OIDC_ISSUER_URL, token dari issuer OIDC (Keycloak, Auth0, ...) dipakai menggantikan
+	// JWT Supabase. Access token akun lokal memakai format JWT Supabase dengan LOCAL_AUTH_SECRET.
+	var verifier auth.TokenVerifier = auth.NewSupabaseVerifier(jwtSecret.Value, supabaseJWKSURL)
+	if localAuthEnabled {
+		verifier = auth.NewSupabaseVerifier(localAuthSecret.Value, "")
+	}
+	if oidcIssuerURL != "" {
+		oidcVerifier, err := auth.NewOIDCVerifier(auth.OIDCConfig{
+			IssuerURL:  oidcIssuerURL,
+			Audience:   os.Getenv("OIDC_AUDIENCE"),
+			JWKSURL:    os.Getenv("OIDC_JWKS_URL"),
+			PlanClaim:  os.Getenv("OIDC_PLAN_CLAIM"),
+			RoleClaim:  os.Getenv("OIDC_ROLE_CLAIM"),
+			ScopeClaim: os.Getenv("OIDC_SCOPE_CLAIM"),
+		})
+		if err != nil {
+			fatal("Invalid OIDC configuration", "error", err)
+		}
+		verifier = oidcVerifier
+	}
+	// Token yang sesinya dicabut lewat DELETE /api/v1/me/sessions/{id} ditolak di REST maupun gRPC.
+	verifier = auth.NewSessionVerifier(verifier, userSessionService)
+	authenticator := auth.NewAuthenticator(verifier, personalAccessTokenService, guestService, roleService,
+		auth.AuthAuditors{securityAuditService, userSessionService})
+	// /readyz gagal selama database, migrasi, koneksi realtime (LISTEN atau SUBSCRIBE), atau Redis
+	// belum siap.
+	readinessChecks := []rest.ReadinessCheck{
+		{Name: "database", Check: dbpool.Ping},
+		{Name: "migrations", Check: persistence.NewPostgresSchemaChecker(dbpool).Check},
+		{Name: "realtime_listener", Check: func(context.Context) error {
+			if !eventListener.Connected() {
+				return errors.New("task event listener is not connected")
+			}
+			return nil
+		}},
+		{Name: "user_event_consumer", Check: func(context.Context) error {
+			if !userEventConsumer.Connected() {
+				return errors.New("user event consumer is not connected")
+			}
+			return nil
+		}},
+	}
+	if redisClient != nil {
+		readinessChecks = append(readinessChecks, rest.ReadinessCheck{Name: "redis", Check: func(ctx context.Context) error {
+			return redisClient.Ping(ctx).Err()
+		}})
+	}
+	healthHandler := rest.NewHealthHandler(readinessChecks...)
+	realtimeHandler := rest.NewRealtimeHandler(eventHub, authenticator, wsAllowedOrigins)
+	eventStreamHandler := rest.NewEventStreamHandler(eventHub, realtime.NewPostgresEventLog(dbpool), authenticator)
+	calDAVHandler := caldav.NewHandler(calDAVService, taskService, idGen)
+	router := rest.NewRouter(rest.RouterConfig{
+		TaskHandler:                rest.NewTaskHandler(taskService),
+		BulkTaskHandler:            rest.NewBulkTaskHandler(bulkTaskService),
+		TaskHistoryHandler:         rest.NewTaskHistoryHandler(taskHistoryService),
+		AttachmentHandler:          rest.NewAttachmentHandler(attachmentService),
+		TimeTrackingHandler:        rest.NewTimeTrackingHandler(timeTrackingService),
+		BoardHandler:               rest.NewBoardHandler(boardService),
+		WebhookHandler:             rest.NewWebhookHandler(webhookService),
+		DiscordHandler:             rest.NewDiscordHandler(discordService, discordPublicKey),
+		MatrixHandler:              rest.NewMatrixHandler(matrixService),
+		GoogleCalendarHandler:      rest.NewGoogleCalendarHandler(googleCalendarService),
+		EmailHandler:               rest.NewEmailHandler(emailService),
+		PushHandler:                rest.NewPushHandler(pushService, os.Getenv("VAPID_PUBLIC_KEY")),
+		SlackHandler:               rest.NewSlackHandler(slackService),
+		TodoistImportHandler:       rest.NewTodoistImportHandler(todoistImportService),
+		TaskCSVHandler:             rest.NewTaskCSVHandler(taskService, bulkTaskService),
+		TaskMarkdownHandler:        rest.NewTaskMarkdownHandler(taskService),
+		AgendaHandler:              rest.NewAgendaHandler(agendaService),
+		BackupHandler:              rest.NewBackupHandler(backupService),
+		DataExportHandler:          rest.NewDataExportHandler(dataExportService),
+		SyncHandler:                syncHandler,
+		AccountHandler:             rest.NewAccountHandler(accountDeletionService),
+		QuotaHandler:               rest.NewQuotaHandler(quotaService),
+		AdminHandler:               rest.NewAdminHandler(adminService),
+		SecurityAuditHandler:       rest.NewSecurityAuditHandler(securityAuditService),
+		RoleHandler:                rest.NewRoleHandler(roleService),
+		IntegrityHandler:           rest.NewIntegrityHandler(integrityService),
+		RetrospectiveHandler:       rest.NewRetrospectiveHandler(retrospectiveService),
+		ArchiveHandler:             rest.NewArchiveHandler(archiveService),
+		ActivityHandler:            rest.NewActivityHandler(activityService),
+		DeviceHandler:              rest.NewDeviceHandler(deviceService),
+		StatsHandler:               rest.NewStatsHandler(statsService),
+		EnumHandler:                rest.NewEnumHandler(enumService),
+		TaskCallbackHandler:        rest.NewTaskCallbackHandler(taskCallbackService),
+		ScimHandler:                rest.NewScimHandler(scimService),
+		WorkspaceConfigHandler:     rest.NewWorkspaceConfigHandler(workspaceConfigService),
+		ListShareHandler:           rest.NewListShareHandler(listShareService, taskService),
+		TaskCommentHandler:         rest.NewTaskCommentHandler(taskCommentService, userProfileService),
+		RealtimeHandler:            realtimeHandler,
+		EventStreamHandler:         eventStreamHandler,
+		CalendarFeedHandler:        rest.NewCalendarFeedHandler(calendarFeedService),
+		CalDAVTokenHandler:         rest.NewCalDAVTokenHandler(calDAVService),
+		PersonalAccessTokenHandler: rest.NewPersonalAccessTokenHandler(personalAccessTokenService),
+		UserSessionHandler:         rest.NewUserSessionHandler(userSessionService),
+		LocalAuthHandler:           rest.NewLocalAuthHandler(localAuthService),
+		GuestHandler:               rest.NewGuestHandler(guestService, rateLimit),
+		UserProfileHandler:         rest.NewUserProfileHandler(userProfileService),
+		HealthHandler:              healthHandler,
+		LogLevelHandler:            rest.NewLogLevelHandler(logLevel),
+		VersionHandler:             rest.NewVersionHandler(build),
+		MaintenanceHandler:         rest.NewMaintenanceHandler(maintenanceService),
+		FeatureHandler:             featureHandler,
+		GraphQLHandler:             graphql.NewHandler(graphql.NewResolver(taskService, taskCommentService, listShareService)),
+		CalDAVHandler:              calDAVHandler,
+		CalDAVAuth:                 calDAVHandler.Authenticate,
+		AttachmentObjectHandler:    attachmentObjects,
+		AuthMiddleware:             authenticator.Middleware,
+		RequestTimeout:             requestTimeout,
+		PanicReporter:              panicReporter,
+		AccessLog:                  accessLog,
+		RateLimit:                  rateLimit,
+		CORS:                       corsConfig,
+		TrustedProxies:             trustedProxies,
+		SessionCookie:              sessionCookie,
 	})
 
-	log.Printf("Task Service listening on port %s", port)
-	// err := http.ListenAndServe(":"+port, router) // Akan diaktifkan nanti
-	err := http.ListenAndServe(":"+port, nil) // Gunakan handler default sementara
+	// API gRPC berjalan di port terpisah dan memakai TaskApplicationService yang sama dengan REST.
+	grpcListener, err := net.Listen("tcp", ":"+grpcPort)
 	if err != nil {
-		log.Fatalf("Could not start server: %s\n", err.Error())
+		fatal("Could not listen on gRPC port", "error", err)
 	}
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(rpc.AuthInterceptor(verifier), rpc.RecoveryInterceptor(panicReporter), rpc.MaintenanceInterceptor(maintenanceService)))
+	taskv1.RegisterTaskServiceServer(grpcServer, rpc.NewTaskServer(taskService))
+	go func() {
+		slog.Info("Task Service gRPC listening", "port", grpcPort)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			fatal("Could not start gRPC server", "error", err)
+		}
+	}()
+
+	server := &http.Server{Addr: ":" + port, Handler: router}
+	server.RegisterOnShutdown(eventStreamHandler.Close)
+	go func() {
+		slog.Info("Task Service listening", "port", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("Could not start server", "error", err)
+		}
+	}()
+
+	var debugServer *http.Server
+	if debugAddr != "" {
+		debugMux := http.NewServeMux()
+		rest.NewDebugHandler(debugToken).RegisterRoutes(debugMux)
+		debugServer = &http.Server{Addr: debugAddr, Handler: debugMux}
+		go func() {
+			slog.Info("Task Service debug endpoints listening", "addr", debugAddr)
+			if err := debugServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fatal("Could not start debug server", "error", err)
+			}
+		}()
+	}
+
+	// SIGINT/SIGTERM (misalnya saat deploy) langsung membuat /readyz gagal, lalu request tetap
+	// dilayani selama shutdownDrainPeriod sampai load balancer berhenti mengirim traffic. Setelah
+	// itu penerimaan koneksi baru dihentikan dan request HTTP dan RPC yang sedang berjalan serta
+	// close frame WebSocket ditunggu, paling lama shutdownTimeout.
+	signals, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	<-signals.Done()
+	stopSignals() // Sinyal berikutnya langsung menghentikan proses
+	healthHandler.SetShuttingDown()
+	if shutdownDrainPeriod > 0 {
+		slog.Info("Draining Task Service before shutdown", "drain_period", shutdownDrainPeriod)
+		server.SetKeepAlivesEnabled(false) // Klien membuka koneksi baru lewat load balancer
+		time.Sleep(shutdownDrainPeriod)
+	}
+	slog.Info("Shutting down Task Service...")
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	websocketsClosed := make(chan error, 1)
+	go func() {
+		websocketsClosed <- realtimeHandler.Shutdown(shutdownCtx)
+	}()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("HTTP server did not shut down cleanly", "error", err)
+		server.Close()
+	}
+	if err := <-websocketsClosed; err != nil {
+		slog.Warn("WebSocket connections did not close cleanly", "error", err)
+	}
+	select {
+	case <-grpcStopped:
+	case <-shutdownCtx.Done():
+		grpcServer.Stop()
+	}
+	if debugServer != nil {
+		debugServer.Close() // Profil yang sedang diambil tidak perlu ditunggu
+	}
+	cancel()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Warn("Could not flush traces", "error", err)
+	}
+	slog.Info("Task Service stopped")
+}
+
+// fatal mencatat error startup lalu menghentikan proses dengan status 1, pengganti log.Fatalf
+// yang menulis lewat slog.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
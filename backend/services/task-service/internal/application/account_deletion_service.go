@@ -0,0 +1,120 @@
+// file: backend/services/task-service/internal/application/account_deletion_service.go
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	accountDeletionBatchSize  = 5                // Penghapusan yang diproses per putaran job
+	accountDeletionStaleAfter = 30 * time.Minute // Penghapusan running selama ini dianggap terhenti dan diklaim ulang
+)
+
+// AccountDeletionApplicationService mendefinisikan use case penghapusan akun atas permintaan
+// pengguna (hak untuk dilupakan). Data dihapus secara asinkron oleh RunPeriodically lewat
+// AccountApplicationService.DeleteAccountData, dan hasilnya disimpan sebagai receipt.
+type AccountDeletionApplicationService interface {
+	// RequestDeletion membuat permintaan penghapusan pending. Mengembalikan
+	// ErrAccountDeletionInProgress jika pengguna masih memiliki penghapusan yang belum selesai.
+	RequestDeletion(ctx context.Context, userID domain.UserID) (*domain.AccountDeletion, error)
+
+	// GetDeletion mengembalikan status dan receipt penghapusan milik pengguna, atau
+	// ErrAccountDeletionNotFound.
+	GetDeletion(ctx context.Context, userID domain.UserID, id string) (*domain.AccountDeletion, error)
+
+	// ProcessPending menghapus data untuk penghapusan pending dan mengembalikan jumlah yang selesai.
+	ProcessPending(ctx context.Context) (int, error)
+
+	// RunPeriodically menjalankan ProcessPending setiap interval sampai ctx dibatalkan.
+	RunPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// accountDeletionService adalah implementasi dari AccountDeletionApplicationService.
+type accountDeletionService struct {
+	deletionRepo domain.AccountDeletionRepository
+	accounts     AccountApplicationService
+	idGen        domain.IDGenerator
+}
+
+// NewAccountDeletionService adalah constructor untuk accountDeletionService.
+func NewAccountDeletionService(deletionRepo domain.AccountDeletionRepository, accounts AccountApplicationService, idGen domain.IDGenerator) AccountDeletionApplicationService {
+	return &accountDeletionService{
+		deletionRepo: deletionRepo,
+		accounts:     accounts,
+		idGen:        idGen,
+	}
+}
+
+// RequestDeletion hanya menyimpan permintaan; data dihapus job terjadwal di replika leader.
+func (s *accountDeletionService) RequestDeletion(ctx context.Context, userID domain.UserID) (*domain.AccountDeletion, error) {
+	deletion := &domain.AccountDeletion{
+		ID:          s.idGen.NewID(),
+		UserID:      userID,
+		Status:      domain.AccountDeletionPending,
+		RequestedAt: time.Now().UTC(),
+	}
+	if err := s.deletionRepo.Create(ctx, deletion); err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// GetDeletion membaca penghapusan dari repository.
+func (s *accountDeletionService) GetDeletion(ctx context.Context, userID domain.UserID, id string) (*domain.AccountDeletion, error) {
+	return s.deletionRepo.FindByID(ctx, userID, id)
+}
+
+// ProcessPending menandai penghapusan yang gagal sebagai failed agar pengguna bisa meminta ulang;
+// karena DeleteAccountData aman dipanggil ulang, permintaan berikutnya melanjutkan sisanya. Error
+// hanya dikembalikan jika klaim atau penyimpanan status gagal.
+func (s *accountDeletionService) ProcessPending(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	deletions, err := s.deletionRepo.ClaimPending(ctx, now, now.Add(-accountDeletionStaleAfter), accountDeletionBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	completed := 0
+	for _, deletion := range deletions {
+		receipt, err := s.accounts.DeleteAccountData(ctx, deletion.UserID)
+		finishedAt := time.Now().UTC()
+		deletion.CompletedAt = &finishedAt
+		if err != nil {
+			slog.ErrorContext(ctx, "error deleting account data", "deletion_id", deletion.ID, "user_id", deletion.UserID, "error", err)
+			deletion.Status = domain.AccountDeletionFailed
+			deletion.Error = "account data could not be fully deleted, please request deletion again"
+		} else {
+			deletion.Status = domain.AccountDeletionCompleted
+			deletion.Receipt = receipt
+			completed++
+		}
+		if err := s.deletionRepo.Update(ctx, deletion); err != nil {
+			return completed, err
+		}
+	}
+	return completed, nil
+}
+
+// RunPeriodically memproses penghapusan pending. Error hanya di-log dan dicoba lagi di putaran
+// berikutnya.
+func (s *accountDeletionService) RunPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			completed, err := s.ProcessPending(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error processing account deletions", "error", err)
+			}
+			if completed > 0 {
+				slog.InfoContext(ctx, "completed account deletions", "count", completed)
+			}
+		}
+	}
+}
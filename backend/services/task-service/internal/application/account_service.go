@@ -0,0 +1,162 @@
+// file: backend/services/task-service/internal/application/account_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// AccountApplicationService mendefinisikan use case yang berhubungan dengan akun pengguna
+// di sisi task-service. Akun itu sendiri dikelola oleh Supabase Auth.
+type AccountApplicationService interface {
+	// DeleteAccountData menghapus semua data milik pengguna yang disimpan oleh task-service, dan
+	// menganonimkan data yang tetap disimpan untuk pengguna lain. Aman dipanggil ulang untuk
+	// pengguna yang datanya sudah dihapus.
+	DeleteAccountData(ctx context.Context, userID domain.UserID) (*domain.AccountDeletionReceipt, error)
+}
+
+// accountService adalah implementasi dari AccountApplicationService.
+type accountService struct {
+	taskRepo         domain.TaskRepository
+	shareRepo        domain.ListShareRepository
+	emailChannelRepo domain.EmailChannelRepository
+	pushChannelRepo  domain.PushChannelRepository
+	slackChannelRepo domain.SlackChannelRepository
+	tokenRepo        domain.PersonalAccessTokenRepository
+	erasureRepo      domain.AccountErasureRepository
+	attachments      domain.AttachmentStorage
+	blobs            domain.BlobStore
+}
+
+// NewAccountService adalah constructor untuk accountService.
+func NewAccountService(repo domain.TaskRepository, shareRepo domain.ListShareRepository, emailChannelRepo domain.EmailChannelRepository, pushChannelRepo domain.PushChannelRepository, slackChannelRepo domain.SlackChannelRepository, tokenRepo domain.PersonalAccessTokenRepository, erasureRepo domain.AccountErasureRepository, attachments domain.AttachmentStorage, blobs domain.BlobStore) AccountApplicationService {
+	return &accountService{
+		taskRepo:         repo,
+		shareRepo:        shareRepo,
+		emailChannelRepo: emailChannelRepo,
+		pushChannelRepo:  pushChannelRepo,
+		slackChannelRepo: slackChannelRepo,
+		tokenRepo:        tokenRepo,
+		erasureRepo:      erasureRepo,
+		attachments:      attachments,
+		blobs:            blobs,
+	}
+}
+
+// DeleteAccountData menghapus semua task pengguna sekaligus lewat DeleteByUserID,
+// bukan FindByUserID + Delete per task. Event per task sengaja tidak dipublikasikan
+// karena akun yang dihapus tidak lagi memiliki klien yang perlu disinkronkan.
+//
+// Klaim pengingat tenggat ikut terhapus bersama task-nya. Personal access token dicabut lebih dulu
+// agar tidak ada request baru selama pembersihan, lalu berbagi daftar dan pengaturan pengingat
+// (email, push, Slack) dihapus. Isi attachment dan arsip dihapus dari storage sebelum barisnya,
+// karena key-nya hilang setelah baris dihapus. Sisa data dihapus atau dianonimkan oleh
+// AccountErasureRepository. Data yang sudah tidak ada dilewati sehingga pemanggilan ulang setelah
+// kegagalan sebagian melanjutkan sisanya.
+func (s *accountService) DeleteAccountData(ctx context.Context, userID domain.UserID) (*domain.AccountDeletionReceipt, error) {
+	receipt := &domain.AccountDeletionReceipt{}
+	if err := s.tokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+	deletedShares, err := s.deleteShares(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	receipt.DeletedShares = deletedShares
+	if err := s.deleteReminderChannels(ctx, userID, receipt); err != nil {
+		return nil, err
+	}
+	if err := s.deleteStoredObjects(ctx, userID, receipt); err != nil {
+		return nil, err
+	}
+	deleted, err := s.taskRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	receipt.DeletedTasks = deleted
+	if err := s.erasureRepo.EraseUserData(ctx, userID, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// deleteReminderChannels menghapus pengaturan pengingat email, push, dan Slack.
+func (s *accountService) deleteReminderChannels(ctx context.Context, userID domain.UserID, receipt *domain.AccountDeletionReceipt) error {
+	err := s.emailChannelRepo.Delete(ctx, userID)
+	if err != nil && !errors.Is(err, domain.ErrEmailChannelNotFound) {
+		return err
+	}
+	if err == nil {
+		receipt.DeletedReminderChannels++
+	}
+	err = s.pushChannelRepo.Delete(ctx, userID)
+	if err != nil && !errors.Is(err, domain.ErrPushChannelNotFound) {
+		return err
+	}
+	if err == nil {
+		receipt.DeletedReminderChannels++
+	}
+	err = s.slackChannelRepo.Delete(ctx, userID)
+	if err != nil && !errors.Is(err, domain.ErrSlackChannelNotFound) {
+		return err
+	}
+	if err == nil {
+		receipt.DeletedReminderChannels++
+	}
+	return nil
+}
+
+// deleteStoredObjects menghapus isi attachment dari AttachmentStorage dan arsip workspace serta
+// ekspor data dari BlobStore. Jika attachment tidak dikonfigurasi, tidak ada isi attachment yang
+// perlu dihapus.
+func (s *accountService) deleteStoredObjects(ctx context.Context, userID domain.UserID, receipt *domain.AccountDeletionReceipt) error {
+	keys, err := s.erasureRepo.FindObjectKeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys.AttachmentKeys {
+		err := s.attachments.Delete(ctx, key)
+		if errors.Is(err, domain.ErrAttachmentStorageDisabled) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting attachment %s: %w", key, err)
+		}
+		receipt.DeletedFiles++
+	}
+	for _, key := range keys.BlobKeys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			return fmt.Errorf("error deleting blob %s: %w", key, err)
+		}
+		receipt.DeletedFiles++
+	}
+	return nil
+}
+
+// deleteShares mencabut daftar yang dibagikan pengguna dan akses pengguna ke daftar orang lain.
+// Mencabut akses kolaborator juga melepas penugasan task kepadanya di daftar pemilik.
+func (s *accountService) deleteShares(ctx context.Context, userID domain.UserID) (int, error) {
+	owned, err := s.shareRepo.FindByOwner(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	joined, err := s.shareRepo.FindByCollaborator(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, share := range append(owned, joined...) {
+		err := s.shareRepo.Delete(ctx, share.OwnerID, share.CollaboratorID)
+		if errors.Is(err, domain.ErrListShareNotFound) {
+			continue // Sudah dicabut bersamaan oleh pemilik atau pemrosesan lain
+		}
+		if err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
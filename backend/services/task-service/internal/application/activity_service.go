@@ -0,0 +1,31 @@
+// file: backend/services/task-service/internal/application/activity_service.go
+package application
+
+import (
+	"context"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// ActivityApplicationService mendefinisikan use case feed aktivitas pengguna.
+type ActivityApplicationService interface {
+	// GetActivityPage mengembalikan satu halaman aktivitas pengguna, dari yang terbaru.
+	GetActivityPage(ctx context.Context, userID domain.UserID, query domain.ActivityPageQuery) (*domain.ActivityPage, error)
+}
+
+// activityService adalah implementasi dari ActivityApplicationService.
+type activityService struct {
+	activityRepo domain.ActivityRepository
+}
+
+// NewActivityService adalah constructor untuk activityService.
+func NewActivityService(activityRepo domain.ActivityRepository) ActivityApplicationService {
+	return &activityService{
+		activityRepo: activityRepo,
+	}
+}
+
+// GetActivityPage membaca feed aktivitas; aktivitas sudah dicatat oleh penyimpanan saat task berubah.
+func (s *activityService) GetActivityPage(ctx context.Context, userID domain.UserID, query domain.ActivityPageQuery) (*domain.ActivityPage, error) {
+	return s.activityRepo.FindPageByUserID(ctx, userID, query)
+}
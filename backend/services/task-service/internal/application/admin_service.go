@@ -0,0 +1,259 @@
+// file: backend/services/task-service/internal/application/admin_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Jenis hasil yang bisa dicari oleh admin.
+const (
+	AdminSearchTasks = "tasks"
+	AdminSearchUsers = "users"
+)
+
+// redactedText menggantikan konten pengguna yang diredaksi pada hasil pencarian admin.
+const redactedText = "[redacted]"
+
+// AdminSearchInput adalah parameter pencarian admin.
+type AdminSearchInput struct {
+	Query  string
+	Types  []string // AdminSearchTasks dan/atau AdminSearchUsers; kosong berarti keduanya
+	Redact bool     // Jika true, deskripsi task diganti redactedText
+	Reason string   // Wajib diisi, dicatat di audit log
+	Limit  int
+}
+
+// AdminSearchResult adalah hasil pencarian admin.
+type AdminSearchResult struct {
+	Tasks []*domain.Task
+	Users []domain.UserSummary
+}
+
+// AdminApplicationService mendefinisikan use case admin untuk investigasi support.
+type AdminApplicationService interface {
+	// Search mencari task dan pengguna lintas tenant. Setiap pencarian dicatat di audit log;
+	// jika pencatatan gagal, hasil tidak dikembalikan.
+	Search(ctx context.Context, adminID domain.UserID, input AdminSearchInput) (*AdminSearchResult, error)
+
+	// SystemStats mengembalikan statistik penggunaan seluruh instalasi. Hanya berisi agregat,
+	// sehingga tidak dicatat di audit log.
+	SystemStats(ctx context.Context) (*domain.SystemStats, error)
+
+	// TopUsers mengembalikan pengguna dengan task terbanyak. Dicatat di audit log.
+	TopUsers(ctx context.Context, adminID domain.UserID, reason string, limit int) ([]domain.UserSummary, error)
+
+	// UserContentCounts mengembalikan jumlah konten seorang pengguna. Dicatat di audit log.
+	UserContentCounts(ctx context.Context, adminID, userID domain.UserID, reason string) (*domain.UserContentCounts, error)
+
+	// DeleteTask menghapus task pengguna mana pun, misalnya konten yang melanggar. Audit log
+	// dicatat sebelum penghapusan; jika pencatatan gagal, task tidak dihapus.
+	DeleteTask(ctx context.Context, adminID domain.UserID, taskID, reason string) error
+
+	// DeleteComment menghapus komentar pengguna mana pun, dengan audit log seperti DeleteTask.
+	DeleteComment(ctx context.Context, adminID domain.UserID, commentID, reason string) error
+}
+
+// adminService adalah implementasi dari AdminApplicationService.
+type adminService struct {
+	searchRepo  domain.AdminSearchRepository
+	statsRepo   domain.SystemStatsRepository
+	taskRepo    domain.TaskRepository
+	commentRepo domain.TaskCommentRepository
+	publisher   domain.TaskEventPublisher
+	auditRepo   domain.AdminAuditRepository
+}
+
+// NewAdminService adalah constructor untuk adminService.
+func NewAdminService(searchRepo domain.AdminSearchRepository, statsRepo domain.SystemStatsRepository, taskRepo domain.TaskRepository, commentRepo domain.TaskCommentRepository, publisher domain.TaskEventPublisher, auditRepo domain.AdminAuditRepository) AdminApplicationService {
+	return &adminService{
+		searchRepo:  searchRepo,
+		statsRepo:   statsRepo,
+		taskRepo:    taskRepo,
+		commentRepo: commentRepo,
+		publisher:   publisher,
+		auditRepo:   auditRepo,
+	}
+}
+
+// Search menjalankan pencarian, meredaksi hasil jika diminta, lalu mencatat audit log.
+func (s *adminService) Search(ctx context.Context, adminID domain.UserID, input AdminSearchInput) (*AdminSearchResult, error) {
+	query := strings.TrimSpace(input.Query)
+	if len([]rune(query)) < 3 {
+		return nil, domain.ErrSearchQueryTooShort
+	}
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, domain.ErrAuditReasonRequired
+	}
+	types := input.Types
+	if len(types) == 0 {
+		types = []string{AdminSearchTasks, AdminSearchUsers}
+	}
+
+	result := &AdminSearchResult{}
+	for _, t := range types {
+		switch t {
+		case AdminSearchTasks:
+			tasks, err := s.searchRepo.SearchTasks(ctx, query, input.Limit)
+			if err != nil {
+				return nil, err
+			}
+			result.Tasks = tasks
+		case AdminSearchUsers:
+			users, err := s.searchRepo.SearchUsers(ctx, query, input.Limit)
+			if err != nil {
+				return nil, err
+			}
+			result.Users = users
+		default:
+			return nil, fmt.Errorf("%w: unknown search type %q", domain.ErrInvalidSearchType, t)
+		}
+	}
+
+	if input.Redact {
+		for _, task := range result.Tasks {
+			if task.Description != "" {
+				task.Description = redactedText
+			}
+		}
+	}
+
+	err := s.audit(ctx, domain.AdminAuditEntry{
+		AdminID: adminID,
+		Action:  "admin.search",
+		Reason:  reason,
+		Details: map[string]any{
+			"query":  query,
+			"types":  types,
+			"redact": input.Redact,
+		},
+		ResultCount: len(result.Tasks) + len(result.Users),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SystemStats membaca statistik dari repository.
+func (s *adminService) SystemStats(ctx context.Context) (*domain.SystemStats, error) {
+	return s.statsRepo.SystemStats(ctx)
+}
+
+// TopUsers membaca pengguna dari repository lalu mencatat audit log.
+func (s *adminService) TopUsers(ctx context.Context, adminID domain.UserID, reason string, limit int) ([]domain.UserSummary, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, domain.ErrAuditReasonRequired
+	}
+	users, err := s.searchRepo.TopUsers(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	err = s.audit(ctx, domain.AdminAuditEntry{
+		AdminID:     adminID,
+		Action:      "admin.users.list",
+		Reason:      reason,
+		Details:     map[string]any{"limit": limit},
+		ResultCount: len(users),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// UserContentCounts membaca jumlah konten dari repository lalu mencatat audit log.
+func (s *adminService) UserContentCounts(ctx context.Context, adminID, userID domain.UserID, reason string) (*domain.UserContentCounts, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, domain.ErrAuditReasonRequired
+	}
+	counts, err := s.searchRepo.UserContentCounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.audit(ctx, domain.AdminAuditEntry{
+		AdminID:     adminID,
+		Action:      "admin.user.counts",
+		Reason:      reason,
+		Details:     map[string]any{"user_id": userID},
+		ResultCount: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// DeleteTask mempublikasikan task.deleted seperti penghapusan oleh pemiliknya, sehingga klien
+// realtime dan webhook pemilik ikut diperbarui. Isi task tidak disalin ke audit log.
+func (s *adminService) DeleteTask(ctx context.Context, adminID domain.UserID, taskID, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return domain.ErrAuditReasonRequired
+	}
+	task, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	err = s.audit(ctx, domain.AdminAuditEntry{
+		AdminID: adminID,
+		Action:  "admin.task.delete",
+		Reason:  reason,
+		Details: map[string]any{
+			"task_id":  task.ID,
+			"owner_id": task.UserID,
+		},
+		ResultCount: 1,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.taskRepo.Delete(ctx, task.ID); err != nil {
+		return err
+	}
+	publishTaskEvent(ctx, s.publisher, domain.TaskDeleted, task)
+	return nil
+}
+
+// DeleteComment tidak memeriksa task komentar, karena admin menghapus berdasarkan ID komentar saja.
+func (s *adminService) DeleteComment(ctx context.Context, adminID domain.UserID, commentID, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return domain.ErrAuditReasonRequired
+	}
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	err = s.audit(ctx, domain.AdminAuditEntry{
+		AdminID: adminID,
+		Action:  "admin.comment.delete",
+		Reason:  reason,
+		Details: map[string]any{
+			"comment_id": comment.ID,
+			"task_id":    comment.TaskID,
+			"author_id":  comment.AuthorID,
+		},
+		ResultCount: 1,
+	})
+	if err != nil {
+		return err
+	}
+	return s.commentRepo.Delete(ctx, comment.ID)
+}
+
+// audit mencatat entry dengan waktu sekarang.
+func (s *adminService) audit(ctx context.Context, entry domain.AdminAuditEntry) error {
+	entry.OccurredAt = time.Now()
+	if err := s.auditRepo.Record(ctx, entry); err != nil {
+		return fmt.Errorf("error writing admin audit log: %w", err)
+	}
+	return nil
+}
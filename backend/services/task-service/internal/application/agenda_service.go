@@ -0,0 +1,144 @@
+// file: backend/services/task-service/internal/application/agenda_service.go
+package application
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// maxAgendaTasks adalah jumlah task terbanyak per daftar dalam satu agenda.
+const maxAgendaTasks = 500
+
+// AgendaApplicationService mendefinisikan use case agenda task bertenggat.
+type AgendaApplicationService interface {
+	// GetAgenda mengembalikan task bertenggat selama days hari sejak from (awal hari di zona waktu
+	// from) di daftar pengguna dan daftar yang dibagikan kepadanya. Mengembalikan
+	// ErrInvalidAgendaRange jika days bukan 1 sampai MaxAgendaDays.
+	GetAgenda(ctx context.Context, userID domain.UserID, from time.Time, days int) (*domain.Agenda, error)
+}
+
+// agendaService adalah implementasi dari AgendaApplicationService.
+type agendaService struct {
+	taskRepo  domain.TaskRepository
+	shareRepo domain.ListShareRepository
+	enums     EnumApplicationService
+}
+
+// NewAgendaService adalah constructor untuk agendaService. enums dipakai untuk urutan dan label
+// prioritas, yang bisa berbeda per workspace pemilik daftar.
+func NewAgendaService(taskRepo domain.TaskRepository, shareRepo domain.ListShareRepository, enums EnumApplicationService) AgendaApplicationService {
+	return &agendaService{
+		taskRepo:  taskRepo,
+		shareRepo: shareRepo,
+		enums:     enums,
+	}
+}
+
+// GetAgenda menyertakan task yang sudah selesai, agar agenda yang dicetak di akhir hari tetap
+// lengkap. Task yang diarsipkan tidak ikut.
+func (s *agendaService) GetAgenda(ctx context.Context, userID domain.UserID, from time.Time, days int) (*domain.Agenda, error) {
+	if days < 1 || days > domain.MaxAgendaDays {
+		return nil, domain.ErrInvalidAgendaRange
+	}
+	agenda := &domain.Agenda{
+		From:     from,
+		To:       from.AddDate(0, 0, days),
+		Location: from.Location(),
+	}
+	shares, err := s.shareRepo.FindByCollaborator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	owners := []domain.UserID{userID}
+	for _, share := range shares {
+		owners = append(owners, share.OwnerID)
+	}
+
+	for _, ownerID := range owners {
+		tasks, err := s.taskRepo.FindDueByUserID(ctx, ownerID, agenda.From, maxAgendaTasks)
+		if err != nil {
+			return nil, err
+		}
+		inRange := slices.IndexFunc(tasks, func(task *domain.Task) bool { return !task.DueAt.Before(agenda.To) })
+		if inRange < 0 {
+			agenda.Truncated = agenda.Truncated || len(tasks) == maxAgendaTasks
+			inRange = len(tasks)
+		}
+		if inRange == 0 {
+			continue
+		}
+		priorities, err := s.groupByPriority(ctx, ownerID, tasks[:inRange])
+		if err != nil {
+			return nil, err
+		}
+		agenda.Lists = append(agenda.Lists, domain.AgendaList{
+			OwnerID:    ownerID,
+			Shared:     ownerID != userID,
+			Priorities: priorities,
+		})
+	}
+	return agenda, nil
+}
+
+// groupByPriority mengelompokkan tasks (urut tenggat) per prioritas. Prioritas diurutkan dari
+// Position terbesar menurut enum pemilik daftar; nilai yang tidak dikenal lagi diurutkan menurut
+// nama, dan task tanpa prioritas terakhir.
+func (s *agendaService) groupByPriority(ctx context.Context, ownerID domain.UserID, tasks []*domain.Task) ([]domain.AgendaPriority, error) {
+	enums, err := s.enums.ListEnums(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]domain.EnumValue)
+	for _, value := range enums[domain.EnumTaskPriority] {
+		known[value.Value] = value
+	}
+
+	groups := make(map[string]*domain.AgendaPriority)
+	var order []string
+	for _, task := range tasks {
+		priority := ""
+		if task.Priority != nil {
+			priority = *task.Priority
+		}
+		group, ok := groups[priority]
+		if !ok {
+			group = &domain.AgendaPriority{Priority: priority, Label: priority}
+			if value, ok := known[priority]; ok && value.Label != "" {
+				group.Label = value.Label
+			}
+			if priority == "" {
+				group.Label = "No priority"
+			}
+			groups[priority] = group
+			order = append(order, priority)
+		}
+		group.Tasks = append(group.Tasks, task)
+	}
+
+	slices.SortFunc(order, func(a, b string) int {
+		if (a == "") != (b == "") {
+			return cmp.Compare(b, a) // "" selalu terakhir
+		}
+		valueA, knownA := known[a]
+		valueB, knownB := known[b]
+		switch {
+		case knownA && knownB:
+			return cmp.Or(cmp.Compare(valueB.Position, valueA.Position), cmp.Compare(a, b))
+		case knownA != knownB:
+			if knownA {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a, b)
+	})
+	result := make([]domain.AgendaPriority, 0, len(order))
+	for _, priority := range order {
+		result = append(result, *groups[priority])
+	}
+	return result, nil
+}
@@ -0,0 +1,288 @@
+// file: backend/services/task-service/internal/application/archive_service.go
+package application
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DefaultArchiveRetention adalah lama data live dipertahankan setelah workspace diarsipkan.
+const DefaultArchiveRetention = 30 * 24 * time.Hour
+
+// archiveFormat dan archiveFormatVersion mengidentifikasi format ekspor arsip workspace.
+// Format didokumentasikan di README; naikkan versi jika field berubah secara tidak kompatibel.
+const (
+	archiveFormat        = "task-service.workspace-archive"
+	archiveFormatVersion = 1
+)
+
+// purgeBatchSize adalah jumlah arsip maksimum yang di-purge dalam satu putaran job.
+const purgeBatchSize = 50
+
+// archiveDocument adalah isi ekspor arsip (JSON, dikompresi gzip) yang disimpan di BlobStore.
+type archiveDocument struct {
+	Format     string         `json:"format"`
+	Version    int            `json:"version"`
+	UserID     domain.UserID  `json:"user_id"`
+	ArchivedAt time.Time      `json:"archived_at"`
+	Tasks      []*domain.Task `json:"tasks"`
+}
+
+// ArchiveApplicationService mendefinisikan use case arsip workspace.
+type ArchiveApplicationService interface {
+	// ArchiveWorkspace membuat workspace pengguna read-only dan mengekspor datanya ke BlobStore.
+	ArchiveWorkspace(ctx context.Context, userID domain.UserID) (*domain.WorkspaceArchive, error)
+
+	// GetArchive mengembalikan catatan arsip pengguna, atau ErrArchiveNotFound.
+	GetArchive(ctx context.Context, userID domain.UserID) (*domain.WorkspaceArchive, error)
+
+	// IsReadOnly bernilai true jika workspace pengguna sedang diarsipkan.
+	IsReadOnly(ctx context.Context, userID domain.UserID) (bool, error)
+
+	// RestoreWorkspace memulihkan workspace dari arsip di BlobStore oleh admin. Dicatat di audit log.
+	RestoreWorkspace(ctx context.Context, adminID, userID domain.UserID, reason string) (*domain.WorkspaceArchive, error)
+
+	// PurgeExpired menghapus data live dari arsip yang sudah melewati masa retensi.
+	PurgeExpired(ctx context.Context) (int, error)
+
+	// RunPurgePeriodically menjalankan PurgeExpired setiap interval sampai ctx dibatalkan.
+	RunPurgePeriodically(ctx context.Context, interval time.Duration)
+}
+
+// archiveService adalah implementasi dari ArchiveApplicationService.
+type archiveService struct {
+	archiveRepo domain.ArchiveRepository
+	taskRepo    domain.TaskRepository
+	blobs       domain.BlobStore
+	auditRepo   domain.AdminAuditRepository
+	retention   time.Duration
+}
+
+// NewArchiveService adalah constructor untuk archiveService.
+// retention <= 0 berarti DefaultArchiveRetention.
+func NewArchiveService(archiveRepo domain.ArchiveRepository, taskRepo domain.TaskRepository, blobs domain.BlobStore, auditRepo domain.AdminAuditRepository, retention time.Duration) ArchiveApplicationService {
+	if retention <= 0 {
+		retention = DefaultArchiveRetention
+	}
+	return &archiveService{
+		archiveRepo: archiveRepo,
+		taskRepo:    taskRepo,
+		blobs:       blobs,
+		auditRepo:   auditRepo,
+		retention:   retention,
+	}
+}
+
+// ArchiveWorkspace menandai workspace sebagai archived terlebih dahulu agar write baru ditolak,
+// baru kemudian mengekspor task. Jika ekspor gagal, catatan arsip dihapus lagi sehingga
+// workspace kembali bisa ditulis.
+func (s *archiveService) ArchiveWorkspace(ctx context.Context, userID domain.UserID) (*domain.WorkspaceArchive, error) {
+	existing, err := s.archiveRepo.FindByUserID(ctx, userID)
+	switch {
+	case err == nil && existing.ReadOnly():
+		return nil, domain.ErrWorkspaceArchived
+	case err != nil && !errors.Is(err, domain.ErrArchiveNotFound):
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	archive := &domain.WorkspaceArchive{
+		UserID:     userID,
+		Status:     domain.ArchiveStatusArchived,
+		BlobKey:    fmt.Sprintf("archives/%s/%s.json.gz", userID, now.Format("20060102T150405Z")),
+		ArchivedAt: now,
+		PurgeAfter: now.Add(s.retention),
+	}
+	if err := s.archiveRepo.Save(ctx, archive); err != nil {
+		return nil, err
+	}
+
+	count, err := s.export(ctx, archive)
+	if err != nil {
+		if rollbackErr := s.archiveRepo.Delete(ctx, userID); rollbackErr != nil {
+			slog.ErrorContext(ctx, "error rolling back workspace archive", "user_id", userID, "error", rollbackErr)
+		}
+		return nil, err
+	}
+
+	archive.TaskCount = count
+	if err := s.archiveRepo.Save(ctx, archive); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// export menulis semua task pengguna ke BlobStore sebagai archiveDocument.
+func (s *archiveService) export(ctx context.Context, archive *domain.WorkspaceArchive) (int64, error) {
+	tasks, err := s.taskRepo.FindByUserID(ctx, archive.UserID, domain.TaskOrder{Sort: domain.TaskSortPosition})
+	if err != nil {
+		return 0, err
+	}
+	if tasks == nil {
+		tasks = []*domain.Task{}
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	err = json.NewEncoder(zw).Encode(archiveDocument{
+		Format:     archiveFormat,
+		Version:    archiveFormatVersion,
+		UserID:     archive.UserID,
+		ArchivedAt: archive.ArchivedAt,
+		Tasks:      tasks,
+	})
+	if err == nil {
+		err = zw.Close()
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error encoding workspace archive: %w", err)
+	}
+
+	if err := s.blobs.Put(ctx, archive.BlobKey, &buf); err != nil {
+		return 0, err
+	}
+	return int64(len(tasks)), nil
+}
+
+// GetArchive mengembalikan catatan arsip pengguna.
+func (s *archiveService) GetArchive(ctx context.Context, userID domain.UserID) (*domain.WorkspaceArchive, error) {
+	return s.archiveRepo.FindByUserID(ctx, userID)
+}
+
+// IsReadOnly memeriksa status arsip pengguna.
+func (s *archiveService) IsReadOnly(ctx context.Context, userID domain.UserID) (bool, error) {
+	archive, err := s.archiveRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrArchiveNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return archive.ReadOnly(), nil
+}
+
+// RestoreWorkspace membaca ekspor dari BlobStore dan menyisipkan kembali task yang belum ada.
+// Bisa dipakai baik sebelum maupun sesudah purge.
+func (s *archiveService) RestoreWorkspace(ctx context.Context, adminID, userID domain.UserID, reason string) (*domain.WorkspaceArchive, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, domain.ErrAuditReasonRequired
+	}
+	archive, err := s.archiveRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !archive.ReadOnly() {
+		return nil, domain.ErrArchiveNotRestorable
+	}
+
+	doc, err := s.readArchive(ctx, archive.BlobKey)
+	if err != nil {
+		return nil, err
+	}
+	if doc.UserID != userID {
+		return nil, fmt.Errorf("workspace archive %s belongs to user %s, not %s", archive.BlobKey, doc.UserID, userID)
+	}
+	restored, err := s.taskRepo.RestoreTasks(ctx, doc.Tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.auditRepo.Record(ctx, domain.AdminAuditEntry{
+		AdminID: adminID,
+		Action:  "admin.workspace.restore",
+		Reason:  reason,
+		Details: map[string]any{
+			"user_id":  userID,
+			"blob_key": archive.BlobKey,
+			"status":   archive.Status,
+		},
+		ResultCount: int(restored),
+		OccurredAt:  time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error writing admin audit log: %w", err)
+	}
+
+	now := time.Now().UTC()
+	archive.Status = domain.ArchiveStatusRestored
+	archive.RestoredAt = &now
+	if err := s.archiveRepo.Save(ctx, archive); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// readArchive membuka dan memvalidasi ekspor arsip dari BlobStore.
+func (s *archiveService) readArchive(ctx context.Context, key string) (*archiveDocument, error) {
+	blob, err := s.blobs.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+
+	zr, err := gzip.NewReader(blob)
+	if err != nil {
+		return nil, fmt.Errorf("error reading workspace archive %s: %w", key, err)
+	}
+	var doc archiveDocument
+	if err := json.NewDecoder(zr).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("error decoding workspace archive %s: %w", key, err)
+	}
+	if doc.Format != archiveFormat || doc.Version != archiveFormatVersion {
+		return nil, fmt.Errorf("unsupported workspace archive format %s v%d", doc.Format, doc.Version)
+	}
+	return &doc, nil
+}
+
+// PurgeExpired menghapus task live dari arsip yang jatuh tempo. Ekspor di BlobStore tetap disimpan
+// sehingga workspace masih bisa dipulihkan oleh admin.
+func (s *archiveService) PurgeExpired(ctx context.Context) (int, error) {
+	archives, err := s.archiveRepo.FindDueForPurge(ctx, time.Now(), purgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, archive := range archives {
+		if _, err := s.taskRepo.DeleteByUserID(ctx, archive.UserID); err != nil {
+			return purged, err
+		}
+		now := time.Now().UTC()
+		archive.Status = domain.ArchiveStatusPurged
+		archive.PurgedAt = &now
+		if err := s.archiveRepo.Save(ctx, archive); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// RunPurgePeriodically menjalankan purge berkala. Error hanya di-log dan dicoba lagi di putaran berikutnya.
+func (s *archiveService) RunPurgePeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.PurgeExpired(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error purging archived workspaces", "error", err)
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "purged archived workspaces", "count", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// file: backend/services/task-service/internal/application/attachment_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DefaultMaxAttachmentSize adalah ukuran attachment maksimum jika tidak dikonfigurasi.
+const DefaultMaxAttachmentSize = 25 << 20
+
+// Masa berlaku URL upload dan download yang diberikan ke klien.
+const (
+	attachmentUploadExpiry   = 15 * time.Minute
+	attachmentDownloadExpiry = 15 * time.Minute
+)
+
+// maxAttachmentFileNameLength adalah panjang nama file maksimum dalam byte.
+const maxAttachmentFileNameLength = 255
+
+// AttachmentUploadInput adalah metadata file yang akan diunggah klien.
+type AttachmentUploadInput struct {
+	FileName    string
+	ContentType string
+	Size        int64
+}
+
+// AttachmentUpload adalah izin upload untuk satu attachment. Setelah upload selesai, klien
+// mendaftarkan attachment dengan ID yang sama lewat RegisterAttachment.
+type AttachmentUpload struct {
+	AttachmentID string
+	Request      *domain.PresignedRequest
+}
+
+// RegisterAttachmentInput adalah data untuk mendaftarkan attachment yang sudah diunggah.
+type RegisterAttachmentInput struct {
+	ID          string // AttachmentUpload.AttachmentID
+	FileName    string
+	ContentType string
+}
+
+// AttachmentLink adalah attachment beserta URL download sementara.
+type AttachmentLink struct {
+	Attachment *domain.Attachment
+	Download   *domain.PresignedRequest
+}
+
+// AttachmentApplicationService mendefinisikan use case attachment task. Isi file tidak pernah
+// melewati service ini: klien mengunggah dan mengunduh langsung ke storage lewat presigned URL.
+type AttachmentApplicationService interface {
+	// RequestUpload membuat ID attachment dan URL upload untuk task yang bisa ditulis pengguna.
+	RequestUpload(ctx context.Context, userID domain.UserID, taskID string, input AttachmentUploadInput) (*AttachmentUpload, error)
+
+	// RegisterAttachment mendaftarkan file yang sudah diunggah. Ukuran dibaca dari storage, bukan dari klien.
+	RegisterAttachment(ctx context.Context, userID domain.UserID, taskID string, input RegisterAttachmentInput) (*domain.Attachment, error)
+
+	// ListAttachments mengembalikan attachment task beserta URL download.
+	ListAttachments(ctx context.Context, userID domain.UserID, taskID string) ([]AttachmentLink, error)
+
+	// DeleteAttachment menghapus metadata dan objek attachment.
+	DeleteAttachment(ctx context.Context, userID domain.UserID, taskID, attachmentID string) error
+}
+
+// attachmentService adalah implementasi dari AttachmentApplicationService.
+type attachmentService struct {
+	taskRepo       domain.TaskRepository
+	attachmentRepo domain.AttachmentRepository
+	storage        domain.AttachmentStorage
+	idGen          domain.IDGenerator
+	access         TaskAuthorizer
+	maxSize        int64
+}
+
+// NewAttachmentService adalah constructor untuk attachmentService.
+// maxSize <= 0 berarti DefaultMaxAttachmentSize.
+func NewAttachmentService(taskRepo domain.TaskRepository, attachmentRepo domain.AttachmentRepository, storage domain.AttachmentStorage, idGen domain.IDGenerator, access TaskAuthorizer, maxSize int64) AttachmentApplicationService {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxAttachmentSize
+	}
+	return &attachmentService{
+		taskRepo:       taskRepo,
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		idGen:          idGen,
+		access:         access,
+		maxSize:        maxSize,
+	}
+}
+
+// attachmentKey menurunkan key storage dari pengunggah, task, dan ID attachment. Karena key tidak
+// pernah diterima dari klien, klien tidak bisa mendaftarkan objek milik pengguna atau task lain.
+func attachmentKey(userID domain.UserID, taskID, attachmentID string) string {
+	return fmt.Sprintf("attachments/%s/%s/%s", userID, taskID, attachmentID)
+}
+
+// RequestUpload memvalidasi metadata file lalu meminta presigned upload ke storage.
+func (s *attachmentService) RequestUpload(ctx context.Context, userID domain.UserID, taskID string, input AttachmentUploadInput) (*AttachmentUpload, error) {
+	if err := s.checkTaskAccess(ctx, userID, taskID, domain.ListAccessWrite); err != nil {
+		return nil, err
+	}
+	if _, err := normalizeFileName(input.FileName); err != nil {
+		return nil, err
+	}
+	contentType, err := normalizeContentType(input.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	if input.Size <= 0 {
+		return nil, domain.ErrInvalidAttachment
+	}
+	if input.Size > s.maxSize {
+		return nil, domain.ErrAttachmentTooLarge
+	}
+
+	id := s.idGen.NewID()
+	req, err := s.storage.PresignUpload(ctx, attachmentKey(userID, taskID, id), contentType, input.Size, attachmentUploadExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return &AttachmentUpload{AttachmentID: id, Request: req}, nil
+}
+
+// RegisterAttachment memastikan objek sudah ada di storage dan ukurannya masih dalam batas.
+// Objek yang terlalu besar langsung dihapus dari storage.
+func (s *attachmentService) RegisterAttachment(ctx context.Context, userID domain.UserID, taskID string, input RegisterAttachmentInput) (*domain.Attachment, error) {
+	if err := s.checkTaskAccess(ctx, userID, taskID, domain.ListAccessWrite); err != nil {
+		return nil, err
+	}
+	if err := s.idGen.Validate(input.ID); err != nil {
+		return nil, domain.ErrInvalidAttachment
+	}
+	fileName, err := normalizeFileName(input.FileName)
+	if err != nil {
+		return nil, err
+	}
+	contentType, err := normalizeContentType(input.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	key := attachmentKey(userID, taskID, input.ID)
+	size, err := s.storage.Stat(ctx, key)
+	if errors.Is(err, domain.ErrBlobNotFound) {
+		return nil, domain.ErrAttachmentNotUploaded
+	}
+	if err != nil {
+		return nil, err
+	}
+	if size > s.maxSize {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			slog.ErrorContext(ctx, "error deleting oversized attachment", "storage_key", key, "error", err)
+		}
+		return nil, domain.ErrAttachmentTooLarge
+	}
+
+	attachment := &domain.Attachment{
+		ID:          input.ID,
+		TaskID:      taskID,
+		UserID:      userID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  key,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.attachmentRepo.Save(ctx, attachment); err != nil {
+		return nil, err
+	}
+	// Registrasi ulang dengan ID yang sama mengembalikan data yang sudah tersimpan.
+	return s.attachmentRepo.FindByID(ctx, attachment.ID)
+}
+
+// ListAttachments membuat URL download baru untuk setiap attachment.
+func (s *attachmentService) ListAttachments(ctx context.Context, userID domain.UserID, taskID string) ([]AttachmentLink, error) {
+	if err := s.checkTaskAccess(ctx, userID, taskID, domain.ListAccessRead); err != nil {
+		return nil, err
+	}
+	attachments, err := s.attachmentRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]AttachmentLink, 0, len(attachments))
+	for _, attachment := range attachments {
+		download, err := s.storage.PresignDownload(ctx, attachment.StorageKey, attachment.FileName, attachmentDownloadExpiry)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, AttachmentLink{Attachment: attachment, Download: download})
+	}
+	return links, nil
+}
+
+// DeleteAttachment menghapus metadata terlebih dahulu. Jika penghapusan objek gagal, error hanya
+// di-log: objek yatim tidak bisa diakses lagi karena URL download hanya dibuat dari metadata.
+func (s *attachmentService) DeleteAttachment(ctx context.Context, userID domain.UserID, taskID, attachmentID string) error {
+	if err := s.checkTaskAccess(ctx, userID, taskID, domain.ListAccessWrite); err != nil {
+		return err
+	}
+	attachment, err := s.attachmentRepo.FindByID(ctx, attachmentID)
+	if err != nil {
+		return err
+	}
+	if attachment.TaskID != taskID {
+		return domain.ErrAttachmentNotFound
+	}
+	if err := s.attachmentRepo.Delete(ctx, attachmentID); err != nil {
+		return err
+	}
+	if err := s.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		slog.ErrorContext(ctx, "error deleting attachment object", "storage_key", attachment.StorageKey, "error", err)
+	}
+	return nil
+}
+
+// checkTaskAccess memastikan task ada dan pengguna punya akses need ke task tersebut.
+func (s *attachmentService) checkTaskAccess(ctx context.Context, userID domain.UserID, taskID string, need domain.ListAccess) error {
+	task, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return s.access.AuthorizeTask(ctx, userID, task, need)
+}
+
+// normalizeFileName membuang path dan karakter kontrol dari nama file yang dikirim klien.
+func normalizeFileName(name string) (string, error) {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name))
+	if name == "" || name == "." || name == ".." || len(name) > maxAttachmentFileNameLength || !utf8.ValidString(name) {
+		return "", domain.ErrInvalidAttachment
+	}
+	return name, nil
+}
+
+// normalizeContentType memvalidasi MIME type; kosong berarti application/octet-stream.
+func normalizeContentType(contentType string) (string, error) {
+	if contentType == "" {
+		return "application/octet-stream", nil
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", domain.ErrInvalidAttachment
+	}
+	return mime.FormatMediaType(mediaType, params), nil
+}
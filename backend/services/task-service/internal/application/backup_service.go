@@ -0,0 +1,393 @@
+// file: backend/services/task-service/internal/application/backup_service.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// backupFormat dan backupFormatVersion mengidentifikasi format backup akun. Format didokumentasikan
+// di README; naikkan versi jika field berubah secara tidak kompatibel.
+const (
+	backupFormat        = "task-service.account-backup"
+	backupFormatVersion = 1
+)
+
+// MaxBackupTasks adalah jumlah task terbanyak dalam satu dokumen backup yang dipulihkan.
+const MaxBackupTasks = 10000
+
+// backupPageSize adalah jumlah task yang dibaca per halaman saat ekspor backup.
+const backupPageSize = 200
+
+// backupDocument adalah isi backup akun. Saat ekspor, dokumen ditulis bertahap dengan urutan field
+// yang sama (lihat ExportBackup) sehingga tidak pernah ditampung utuh di memori.
+type backupDocument struct {
+	Format       string         `json:"format"`
+	Version      int            `json:"version"`
+	UserID       domain.UserID  `json:"user_id"`
+	ExportedAt   time.Time      `json:"exported_at"`
+	BoardColumns []backupColumn `json:"board_columns"`
+	Tasks        []backupTask   `json:"tasks"`
+}
+
+// backupColumn adalah satu kolom board di dokumen backup.
+type backupColumn struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Position  int       `json:"position"`
+	WIPLimit  *int      `json:"wip_limit,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// backupTask adalah satu task beserta komentar dan metadata attachment-nya.
+type backupTask struct {
+	*domain.Task
+	Comments    []*domain.TaskComment `json:"comments"`
+	Attachments []*domain.Attachment  `json:"attachments"`
+}
+
+// BackupRestoreCount adalah jumlah item di dokumen backup dan yang benar-benar disisipkan.
+// Selisihnya adalah item yang dilewati karena sudah ada atau tidak lagi bisa dipulihkan.
+type BackupRestoreCount struct {
+	Total    int
+	Restored int64
+}
+
+// BackupRestoreReport adalah hasil pemulihan backup akun.
+type BackupRestoreReport struct {
+	BoardColumns BackupRestoreCount
+	Tasks        BackupRestoreCount
+	Comments     BackupRestoreCount
+	Attachments  BackupRestoreCount
+}
+
+// BackupApplicationService mendefinisikan use case backup akun sebagai satu dokumen JSON.
+type BackupApplicationService interface {
+	// ExportBackup menulis kolom board dan semua task milik pengguna (termasuk yang di-snooze dan
+	// diarsipkan) beserta komentar dan metadata attachment-nya ke w. Belum ada list terpisah atau
+	// tag: daftar task adalah seluruh task milik pengguna. Isi file attachment tidak ikut.
+	// Jika error terjadi sebelum byte pertama ditulis, tidak ada yang ditulis ke w.
+	ExportBackup(ctx context.Context, userID domain.UserID, w io.Writer) error
+
+	// RestoreBackup memulihkan dokumen dari ExportBackup milik pengguna yang sama. Data yang masih
+	// ada tidak diubah; hanya item yang ID-nya belum ada yang disisipkan.
+	RestoreBackup(ctx context.Context, userID domain.UserID, r io.Reader) (*BackupRestoreReport, error)
+}
+
+// backupService adalah implementasi dari BackupApplicationService.
+type backupService struct {
+	taskRepo       domain.TaskRepository
+	boardRepo      domain.BoardRepository
+	commentRepo    domain.TaskCommentRepository
+	attachmentRepo domain.AttachmentRepository
+	storage        domain.AttachmentStorage
+	enums          EnumValidator
+	quota          QuotaMonitor
+	idGen          domain.IDGenerator
+}
+
+// NewBackupService adalah constructor untuk backupService.
+func NewBackupService(taskRepo domain.TaskRepository, boardRepo domain.BoardRepository, commentRepo domain.TaskCommentRepository, attachmentRepo domain.AttachmentRepository, storage domain.AttachmentStorage, enums EnumValidator, quota QuotaMonitor, idGen domain.IDGenerator) BackupApplicationService {
+	return &backupService{
+		taskRepo:       taskRepo,
+		boardRepo:      boardRepo,
+		commentRepo:    commentRepo,
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		enums:          enums,
+		quota:          quota,
+		idGen:          idGen,
+	}
+}
+
+// ExportBackup membaca kolom board dan halaman task pertama sebelum menulis apa pun, lalu menulis
+// task halaman demi halaman. Komentar dan attachment dibaca dengan satu query per halaman.
+func (s *backupService) ExportBackup(ctx context.Context, userID domain.UserID, w io.Writer) error {
+	columns, err := s.boardRepo.FindColumns(ctx, userID)
+	if err != nil {
+		return err
+	}
+	query := domain.TaskPageQuery{Limit: backupPageSize}
+	page, err := s.taskRepo.FindPageByUserID(ctx, userID, query)
+	if err != nil {
+		return err
+	}
+
+	out := &backupWriter{w: w}
+	out.raw(`{"format":`)
+	out.value(backupFormat)
+	out.raw(`,"version":`)
+	out.value(backupFormatVersion)
+	out.raw(`,"user_id":`)
+	out.value(userID)
+	out.raw(`,"exported_at":`)
+	out.value(time.Now().UTC())
+	out.raw(`,"board_columns":`)
+	backupColumns := make([]backupColumn, len(columns))
+	for i, column := range columns {
+		backupColumns[i] = backupColumn{
+			ID:        column.ID,
+			Name:      column.Name,
+			Position:  column.Position,
+			WIPLimit:  column.WIPLimit,
+			CreatedAt: column.CreatedAt,
+			UpdatedAt: column.UpdatedAt,
+		}
+	}
+	out.value(backupColumns)
+	out.raw(`,"tasks":[`)
+	first := true
+	for {
+		tasks, err := s.backupTasks(ctx, page.Tasks)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			if !first {
+				out.raw(",")
+			}
+			first = false
+			out.value(task)
+		}
+		if out.err != nil {
+			return out.err
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+		if page, err = s.taskRepo.FindPageByUserID(ctx, userID, query); err != nil {
+			return err
+		}
+	}
+	out.raw("]}\n")
+	return out.err
+}
+
+// backupTasks melengkapi satu halaman task dengan komentar dan attachment-nya.
+func (s *backupService) backupTasks(ctx context.Context, tasks []*domain.Task) ([]backupTask, error) {
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	comments, err := s.commentRepo.FindByTaskIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := s.attachmentRepo.FindByTaskIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	commentsByTask := make(map[string][]*domain.TaskComment)
+	for _, comment := range comments {
+		commentsByTask[comment.TaskID] = append(commentsByTask[comment.TaskID], comment)
+	}
+	attachmentsByTask := make(map[string][]*domain.Attachment)
+	for _, attachment := range attachments {
+		attachmentsByTask[attachment.TaskID] = append(attachmentsByTask[attachment.TaskID], attachment)
+	}
+	result := make([]backupTask, len(tasks))
+	for i, task := range tasks {
+		result[i] = backupTask{
+			Task:        task,
+			Comments:    commentsByTask[task.ID],
+			Attachments: attachmentsByTask[task.ID],
+		}
+		if result[i].Comments == nil {
+			result[i].Comments = []*domain.TaskComment{}
+		}
+		if result[i].Attachments == nil {
+			result[i].Attachments = []*domain.Attachment{}
+		}
+	}
+	return result, nil
+}
+
+// backupWriter menulis potongan dokumen JSON dan menyimpan error pertama, sehingga pemanggil cukup
+// memeriksa err sesekali.
+type backupWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (b *backupWriter) raw(s string) {
+	if b.err == nil {
+		_, b.err = io.WriteString(b.w, s)
+	}
+}
+
+func (b *backupWriter) value(v any) {
+	if b.err != nil {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		b.err = fmt.Errorf("error encoding account backup: %w", err)
+		return
+	}
+	_, b.err = b.w.Write(data)
+}
+
+// RestoreBackup memvalidasi seluruh dokumen lebih dulu, lalu memulihkan kolom board, task, komentar,
+// dan attachment dengan urutan itu agar referensi di antaranya sudah ada. Seperti pemulihan arsip,
+// tidak ada event task yang dipublikasikan; klien perlu memuat ulang daftar task.
+//
+// Item yang tidak lagi bisa dipulihkan dilewati, bukan menggagalkan pemulihan: kolom di atas
+// MaxBoardColumns, prioritas yang sudah dihapus atau deprecated, komentar dan attachment dari
+// pengguna yang bukan lagi kolaborator, serta attachment yang file-nya sudah tidak ada di storage.
+func (s *backupService) RestoreBackup(ctx context.Context, userID domain.UserID, r io.Reader) (*BackupRestoreReport, error) {
+	var doc backupDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidBackup, err) // Termasuk *http.MaxBytesError dari handler
+	}
+	if doc.Format != backupFormat || doc.Version != backupFormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format %q version %d", domain.ErrInvalidBackup, doc.Format, doc.Version)
+	}
+	if doc.UserID != userID {
+		return nil, fmt.Errorf("%w: backup belongs to another account", domain.ErrInvalidBackup)
+	}
+	if len(doc.Tasks) > MaxBackupTasks {
+		return nil, fmt.Errorf("%w: backup contains more than %d tasks", domain.ErrInvalidBackup, MaxBackupTasks)
+	}
+
+	columns := make([]*domain.BoardColumn, len(doc.BoardColumns))
+	for i, column := range doc.BoardColumns {
+		name := strings.TrimSpace(column.Name)
+		if s.idGen.Validate(column.ID) != nil || name == "" || (column.WIPLimit != nil && *column.WIPLimit <= 0) {
+			return nil, fmt.Errorf("%w: board column %d is invalid", domain.ErrInvalidBackup, i)
+		}
+		columns[i] = &domain.BoardColumn{
+			ID:        column.ID,
+			UserID:    userID,
+			Name:      name,
+			WIPLimit:  column.WIPLimit,
+			CreatedAt: column.CreatedAt,
+			UpdatedAt: column.UpdatedAt,
+		}
+	}
+
+	tasks := make([]*domain.Task, 0, len(doc.Tasks))
+	var (
+		comments    []*domain.TaskComment
+		attachments []*domain.Attachment
+	)
+	priorities := make(map[string]bool) // Hasil validasi per nilai prioritas
+	for i, entry := range doc.Tasks {
+		task := entry.Task
+		if err := s.validateBackupTask(task); err != nil {
+			return nil, fmt.Errorf("%w: task %d: %v", domain.ErrInvalidBackup, i, err)
+		}
+		task.UserID = userID
+		if task.Priority != nil {
+			valid, ok := priorities[*task.Priority]
+			if !ok {
+				err := s.enums.ValidateEnumValue(ctx, userID, domain.EnumTaskPriority, *task.Priority)
+				if err != nil && !errors.Is(err, domain.ErrInvalidEnumValue) {
+					return nil, err
+				}
+				valid = err == nil
+				priorities[*task.Priority] = valid
+			}
+			if !valid {
+				task.Priority = nil
+			}
+		}
+		tasks = append(tasks, task)
+
+		for _, comment := range entry.Comments {
+			if comment == nil || s.idGen.Validate(comment.ID) != nil || comment.Body == "" ||
+				utf8.RuneCountInString(comment.Body) > domain.MaxCommentLength || len(comment.Mentions) > domain.MaxMentionsPerComment {
+				return nil, fmt.Errorf("%w: task %d has an invalid comment", domain.ErrInvalidBackup, i)
+			}
+			comment.TaskID = task.ID
+			comments = append(comments, comment)
+		}
+		for _, attachment := range entry.Attachments {
+			if attachment == nil || s.idGen.Validate(attachment.ID) != nil || attachment.FileName == "" ||
+				attachment.StorageKey != attachmentKey(attachment.UserID, task.ID, attachment.ID) {
+				return nil, fmt.Errorf("%w: task %d has an invalid attachment", domain.ErrInvalidBackup, i)
+			}
+			attachment.TaskID = task.ID
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	report := &BackupRestoreReport{
+		BoardColumns: BackupRestoreCount{Total: len(columns)},
+		Tasks:        BackupRestoreCount{Total: len(tasks)},
+		Comments:     BackupRestoreCount{Total: len(comments)},
+		Attachments:  BackupRestoreCount{Total: len(attachments)},
+	}
+	var err error
+	if report.BoardColumns.Restored, err = s.boardRepo.RestoreColumns(ctx, userID, columns); err != nil {
+		return nil, err
+	}
+	if report.Tasks.Restored, err = s.taskRepo.RestoreTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+	s.quota.ObserveUsage(ctx, userID, domain.QuotaTasks, report.Tasks.Restored)
+	if report.Comments.Restored, err = s.commentRepo.RestoreComments(ctx, userID, comments); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.storedAttachments(ctx, attachments)
+	if err != nil {
+		return nil, err
+	}
+	if report.Attachments.Restored, err = s.attachmentRepo.RestoreAttachments(ctx, userID, stored); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// validateBackupTask memeriksa field yang juga divalidasi saat task dibuat.
+func (s *backupService) validateBackupTask(task *domain.Task) error {
+	if task == nil {
+		return errors.New("task is missing")
+	}
+	if err := s.idGen.Validate(task.ID); err != nil {
+		return err
+	}
+	if task.Title == "" {
+		return domain.ErrTaskTitleRequired
+	}
+	if err := domain.ValidateEstimate(task.EstimateMinutes); err != nil {
+		return err
+	}
+	return domain.ValidateTaskAppearance(task.Color, task.Icon)
+}
+
+// storedAttachments mengembalikan attachment yang file-nya masih ada di storage, dengan ukuran yang
+// dibaca ulang dari storage seperti saat registrasi. Jika storage tidak dikonfigurasi, tidak ada
+// attachment yang dipulihkan.
+func (s *backupService) storedAttachments(ctx context.Context, attachments []*domain.Attachment) ([]*domain.Attachment, error) {
+	stored := make([]*domain.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		size, err := s.storage.Stat(ctx, attachment.StorageKey)
+		if errors.Is(err, domain.ErrBlobNotFound) {
+			continue
+		}
+		if errors.Is(err, domain.ErrAttachmentStorageDisabled) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		attachment.Size = size
+		stored = append(stored, attachment)
+	}
+	return stored, nil
+}
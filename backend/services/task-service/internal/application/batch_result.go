@@ -0,0 +1,15 @@
+// file: backend/services/task-service/internal/application/batch_result.go
+package application
+
+import "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+
+// BatchItemResult adalah hasil satu item dalam operasi batch (bulk create, bulk complete,
+// import, push sync). Semua operasi batch mengembalikan satu hasil per item dengan urutan
+// yang sama seperti request, sehingga klien bisa mencocokkan hasil lewat Index.
+type BatchItemResult struct {
+	Index   int          // Posisi item pada request
+	ID      string       // ID task; kosong jika belum diketahui (misalnya create tanpa ID yang gagal)
+	Task    *domain.Task // Keadaan task setelah operasi; nil jika Err tidak nil
+	Created bool         // true jika item membuat task baru
+	Err     error        // Error item ini; domain.ErrBatchItemNotApplied jika batal karena item lain
+}
@@ -0,0 +1,214 @@
+// file: backend/services/task-service/internal/application/board_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// maxBoardColumnNameLength adalah panjang nama kolom maksimum dalam rune.
+const maxBoardColumnNameLength = 100
+
+// BoardColumnInput adalah data kolom board. Pada update, field nil berarti tidak diubah dan
+// WIPLimit 0 menghapus batas WIP.
+type BoardColumnInput struct {
+	Name     *string
+	WIPLimit *int
+}
+
+// MoveBoardTaskInput adalah tujuan perpindahan task di board.
+type MoveBoardTaskInput struct {
+	ColumnID string  // Kosong berarti task dikeluarkan dari board
+	AfterID  *string // Jika diisi, task diletakkan tepat setelah task ini; kosong berarti paling atas
+}
+
+// BoardApplicationService mendefinisikan use case board Kanban.
+type BoardApplicationService interface {
+	// GetBoard mengembalikan kolom board beserta task yang belum diarsipkan dan tidak sedang di-snooze.
+	GetBoard(ctx context.Context, userID domain.UserID) (*domain.Board, error)
+	CreateColumn(ctx context.Context, userID domain.UserID, input BoardColumnInput) (*domain.BoardColumn, error)
+
+	// UpdateColumn mengubah nama atau batas WIP. Batas WIP yang lebih kecil dari isi kolom saat ini
+	// diterima; batas hanya diperiksa saat task dipindahkan ke kolom.
+	UpdateColumn(ctx context.Context, userID domain.UserID, columnID string, input BoardColumnInput) (*domain.BoardColumn, error)
+	DeleteColumn(ctx context.Context, userID domain.UserID, columnID string) error
+	ReorderColumns(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.BoardColumn, error)
+
+	// MoveTask memindahkan task ke kolom lain. Mengembalikan ErrWIPLimitReached jika kolom tujuan penuh.
+	MoveTask(ctx context.Context, userID domain.UserID, taskID string, input MoveBoardTaskInput) (*domain.Task, error)
+}
+
+// boardService adalah implementasi dari BoardApplicationService.
+type boardService struct {
+	boardRepo domain.BoardRepository
+	taskRepo  domain.TaskRepository
+	publisher domain.TaskEventPublisher
+	idGen     domain.IDGenerator
+}
+
+// NewBoardService adalah constructor untuk boardService.
+func NewBoardService(boardRepo domain.BoardRepository, taskRepo domain.TaskRepository, publisher domain.TaskEventPublisher, idGen domain.IDGenerator) BoardApplicationService {
+	return &boardService{
+		boardRepo: boardRepo,
+		taskRepo:  taskRepo,
+		publisher: publisher,
+		idGen:     idGen,
+	}
+}
+
+// GetBoard mengelompokkan task menurut ColumnID. Urutan task di setiap kolom mengikuti Position,
+// sama dengan urutan manual daftar task.
+func (s *boardService) GetBoard(ctx context.Context, userID domain.UserID) (*domain.Board, error) {
+	columns, err := s.boardRepo.FindColumns(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.taskRepo.FindByUserID(ctx, userID, domain.TaskOrder{
+		Sort:          domain.TaskSortPosition,
+		HideSnoozedAt: time.Now(),
+		HideArchived:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	board := &domain.Board{Columns: make([]domain.BoardColumnTasks, len(columns)), Unassigned: []*domain.Task{}}
+	index := make(map[string]int, len(columns))
+	for i, column := range columns {
+		board.Columns[i] = domain.BoardColumnTasks{Column: column, Tasks: []*domain.Task{}}
+		index[column.ID] = i
+	}
+	for _, task := range tasks {
+		if task.ColumnID != nil {
+			if i, ok := index[*task.ColumnID]; ok {
+				board.Columns[i].Tasks = append(board.Columns[i].Tasks, task)
+				continue
+			}
+		}
+		board.Unassigned = append(board.Unassigned, task)
+	}
+	return board, nil
+}
+
+// CreateColumn menambahkan kolom di posisi paling kanan.
+func (s *boardService) CreateColumn(ctx context.Context, userID domain.UserID, input BoardColumnInput) (*domain.BoardColumn, error) {
+	if input.Name == nil {
+		return nil, fmt.Errorf("%w: name is required", domain.ErrInvalidBoardColumn)
+	}
+	columns, err := s.boardRepo.FindColumns(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) >= domain.MaxBoardColumns {
+		return nil, fmt.Errorf("%w: a board can have at most %d columns", domain.ErrInvalidBoardColumn, domain.MaxBoardColumns)
+	}
+
+	now := time.Now()
+	column := &domain.BoardColumn{
+		ID:        s.idGen.NewID(),
+		UserID:    userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := applyBoardColumnInput(column, input); err != nil {
+		return nil, err
+	}
+	if err := s.boardRepo.SaveColumn(ctx, column); err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+// UpdateColumn mengubah kolom milik pengguna.
+func (s *boardService) UpdateColumn(ctx context.Context, userID domain.UserID, columnID string, input BoardColumnInput) (*domain.BoardColumn, error) {
+	column, err := s.findColumn(ctx, userID, columnID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyBoardColumnInput(column, input); err != nil {
+		return nil, err
+	}
+	column.UpdatedAt = time.Now()
+	if err := s.boardRepo.UpdateColumn(ctx, column); err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+// DeleteColumn menghapus kolom dan mempublikasikan TaskMoved untuk task yang dikeluarkan dari board.
+func (s *boardService) DeleteColumn(ctx context.Context, userID domain.UserID, columnID string) error {
+	tasks, err := s.boardRepo.DeleteColumn(ctx, userID, columnID, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		publishTaskEvent(ctx, s.publisher, domain.TaskMoved, task)
+	}
+	return nil
+}
+
+// ReorderColumns mengatur ulang urutan kolom.
+func (s *boardService) ReorderColumns(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.BoardColumn, error) {
+	return s.boardRepo.ReorderColumns(ctx, userID, ids, time.Now())
+}
+
+// MoveTask memindahkan task ke kolom terlebih dahulu (di situ batas WIP diperiksa), lalu
+// mengatur posisinya jika AfterID diisi. Task yang tidak berpindah kolom tetap diperiksa
+// kepemilikannya, tetapi tidak dihitung dua kali terhadap batas WIP kolomnya sendiri.
+func (s *boardService) MoveTask(ctx context.Context, userID domain.UserID, taskID string, input MoveBoardTaskInput) (*domain.Task, error) {
+	now := time.Now()
+	task, err := s.boardRepo.MoveTask(ctx, userID, taskID, input.ColumnID, now)
+	if err != nil {
+		return nil, err
+	}
+	if input.AfterID != nil {
+		if *input.AfterID == taskID {
+			return nil, domain.ErrInvalidReorder
+		}
+		if task, err = s.taskRepo.MoveAfter(ctx, userID, taskID, *input.AfterID, now); err != nil {
+			return nil, err
+		}
+	}
+	publishTaskEvent(ctx, s.publisher, domain.TaskMoved, task)
+	return task, nil
+}
+
+// findColumn mencari kolom milik pengguna berdasarkan ID.
+func (s *boardService) findColumn(ctx context.Context, userID domain.UserID, columnID string) (*domain.BoardColumn, error) {
+	columns, err := s.boardRepo.FindColumns(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range columns {
+		if column.ID == columnID {
+			return column, nil
+		}
+	}
+	return nil, domain.ErrBoardColumnNotFound
+}
+
+// applyBoardColumnInput memvalidasi dan menerapkan input ke column.
+func applyBoardColumnInput(column *domain.BoardColumn, input BoardColumnInput) error {
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" || utf8.RuneCountInString(name) > maxBoardColumnNameLength {
+			return fmt.Errorf("%w: name must be between 1 and %d characters", domain.ErrInvalidBoardColumn, maxBoardColumnNameLength)
+		}
+		column.Name = name
+	}
+	if input.WIPLimit != nil {
+		if *input.WIPLimit < 0 {
+			return fmt.Errorf("%w: wip_limit cannot be negative", domain.ErrInvalidBoardColumn)
+		}
+		column.WIPLimit = input.WIPLimit
+		if *input.WIPLimit == 0 {
+			column.WIPLimit = nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+// file: backend/services/task-service/internal/application/bulk_service.go
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DefaultUndoWindow adalah lama token undo berlaku jika tidak dikonfigurasi.
+const DefaultUndoWindow = 30 * time.Second
+
+// BulkCompleteResult adalah hasil menandai banyak task selesai sekaligus.
+type BulkCompleteResult struct {
+	Results       []BatchItemResult // Satu hasil per ID unik; Index merujuk kemunculan pertama di request
+	UndoToken     string            // Kosong jika tidak ada task yang berubah
+	UndoExpiresAt time.Time
+}
+
+// ImportTaskInput adalah satu task hasil impor dari file.
+type ImportTaskInput struct {
+	CreateTaskInput
+	Completed bool // Tandai selesai setelah dibuat
+}
+
+// BulkTaskApplicationService mendefinisikan use case operasi banyak task sekaligus.
+type BulkTaskApplicationService interface {
+	// CreateTasks membuat banyak task sekaligus. Setiap item diproses terpisah, sehingga item yang
+	// gagal validasi tidak membatalkan item lain.
+	CreateTasks(ctx context.Context, userID domain.UserID, inputs []CreateTaskInput) []BatchItemResult
+
+	// ImportTasks sama dengan CreateTasks, ditambah menandai selesai task dengan Completed. Jika
+	// penandaan gagal, task tetap dibuat dan hasil item berisi error tersebut.
+	ImportTasks(ctx context.Context, userID domain.UserID, inputs []ImportTaskInput) []BatchItemResult
+
+	// CompleteTasks menandai semua task dalam ids sebagai selesai secara atomik, dan mengembalikan
+	// token undo yang berlaku selama jendela undo (misalnya alur "clear my day"). Jika ada ID yang
+	// tidak ditemukan, tidak ada task yang diubah dan hasil per item menjelaskan penyebabnya.
+	CompleteTasks(ctx context.Context, userID domain.UserID, ids []string) (*BulkCompleteResult, error)
+
+	// Undo membatalkan operasi bulk yang dibuat dengan token milik pengguna. Token hanya bisa dipakai sekali.
+	Undo(ctx context.Context, userID domain.UserID, token string) ([]*domain.Task, error)
+}
+
+// bulkTaskService adalah implementasi dari BulkTaskApplicationService.
+type bulkTaskService struct {
+	tasks      TaskApplicationService
+	taskRepo   domain.TaskRepository
+	undoRepo   domain.UndoRepository
+	publisher  domain.TaskEventPublisher
+	undoWindow time.Duration
+}
+
+// NewBulkTaskService adalah constructor untuk bulkTaskService.
+// undoWindow menentukan berapa lama token undo berlaku; nilai <= 0 berarti DefaultUndoWindow.
+func NewBulkTaskService(tasks TaskApplicationService, taskRepo domain.TaskRepository, undoRepo domain.UndoRepository, publisher domain.TaskEventPublisher, undoWindow time.Duration) BulkTaskApplicationService {
+	if undoWindow <= 0 {
+		undoWindow = DefaultUndoWindow
+	}
+	return &bulkTaskService{
+		tasks:      tasks,
+		taskRepo:   taskRepo,
+		undoRepo:   undoRepo,
+		publisher:  publisher,
+		undoWindow: undoWindow,
+	}
+}
+
+// CreateTasks memakai alur CreateTask yang sama dengan endpoint create tunggal, termasuk
+// validasi ID dari klien dan pemantauan kuota.
+func (s *bulkTaskService) CreateTasks(ctx context.Context, userID domain.UserID, inputs []CreateTaskInput) []BatchItemResult {
+	results := make([]BatchItemResult, len(inputs))
+	for i, input := range inputs {
+		results[i] = BatchItemResult{Index: i, ID: input.ID}
+		task, created, err := s.tasks.CreateTask(ctx, userID, input)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		results[i].ID, results[i].Task, results[i].Created = task.ID, task, created
+	}
+	return results
+}
+
+// ImportTasks membuat task satu per satu lewat CreateTasks, lalu menandai selesai yang perlu.
+func (s *bulkTaskService) ImportTasks(ctx context.Context, userID domain.UserID, inputs []ImportTaskInput) []BatchItemResult {
+	creates := make([]CreateTaskInput, len(inputs))
+	for i, input := range inputs {
+		creates[i] = input.CreateTaskInput
+	}
+	results := s.CreateTasks(ctx, userID, creates)
+	for i, input := range inputs {
+		if !input.Completed || results[i].Err != nil {
+			continue
+		}
+		task, err := s.tasks.CompleteTask(ctx, userID, results[i].ID)
+		if err != nil {
+			results[i].Task, results[i].Err = nil, err
+			continue
+		}
+		results[i].Task = task
+	}
+	return results
+}
+
+// CompleteTasks menyimpan status sebelumnya sebagai UndoOperation setelah task diperbarui.
+// Jika penyimpanan undo gagal, perubahan tetap berlaku dan error dikembalikan agar klien tahu
+// operasi ini tidak bisa dibatalkan.
+func (s *bulkTaskService) CompleteTasks(ctx context.Context, userID domain.UserID, ids []string) (*BulkCompleteResult, error) {
+	indexOf := firstIndexes(ids)
+	ids, err := uniqueTaskIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	previous, tasks, err := s.taskRepo.CompleteMany(ctx, userID, ids, now)
+	var missing *domain.TasksNotFoundError
+	if errors.As(err, &missing) {
+		return &BulkCompleteResult{Results: notAppliedResults(ids, indexOf, missing)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(map[string]struct{}, len(previous))
+	for _, c := range previous {
+		changed[c.TaskID] = struct{}{}
+	}
+	byID := make(map[string]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+		if _, ok := changed[task.ID]; ok {
+			publishTaskEvent(ctx, s.publisher, domain.TaskUpdated, task)
+		}
+	}
+	result := &BulkCompleteResult{Results: make([]BatchItemResult, len(ids))}
+	for i, id := range ids {
+		result.Results[i] = BatchItemResult{Index: indexOf[id], ID: id, Task: byID[id]}
+	}
+	if len(previous) == 0 {
+		return result, nil
+	}
+
+	token, err := newUndoToken()
+	if err != nil {
+		return nil, err
+	}
+	op := domain.UndoOperation{
+		Token:       token,
+		UserID:      userID,
+		Kind:        domain.UndoBulkComplete,
+		Completions: previous,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.undoWindow),
+	}
+	if err := s.undoRepo.Save(ctx, op); err != nil {
+		return nil, err
+	}
+	result.UndoToken, result.UndoExpiresAt = op.Token, op.ExpiresAt
+	return result, nil
+}
+
+// notAppliedResults membuat hasil per item untuk bulk atomik yang dibatalkan: ID yang hilang
+// mendapat ErrTaskNotFound, sisanya ErrBatchItemNotApplied.
+func notAppliedResults(ids []string, indexOf map[string]int, missing *domain.TasksNotFoundError) []BatchItemResult {
+	notFound := make(map[string]struct{}, len(missing.IDs))
+	for _, id := range missing.IDs {
+		notFound[id] = struct{}{}
+	}
+	results := make([]BatchItemResult, len(ids))
+	for i, id := range ids {
+		results[i] = BatchItemResult{Index: indexOf[id], ID: id, Err: domain.ErrBatchItemNotApplied}
+		if _, ok := notFound[id]; ok {
+			results[i].Err = domain.ErrTaskNotFound
+		}
+	}
+	return results
+}
+
+// Undo mengembalikan status selesai task ke state sebelum operasi bulk.
+func (s *bulkTaskService) Undo(ctx context.Context, userID domain.UserID, token string) ([]*domain.Task, error) {
+	if token == "" {
+		return nil, domain.ErrUndoUnavailable
+	}
+	op, err := s.undoRepo.Take(ctx, userID, token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if op.Kind != domain.UndoBulkComplete {
+		return nil, fmt.Errorf("unsupported undo operation kind %q", op.Kind)
+	}
+
+	tasks, err := s.taskRepo.RestoreCompletion(ctx, userID, op.Completions, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		publishTaskEvent(ctx, s.publisher, domain.TaskUpdated, task)
+	}
+	return tasks, nil
+}
+
+// uniqueTaskIDs memastikan ids tidak kosong dan membuang ID ganda dengan urutan tetap.
+func uniqueTaskIDs(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, domain.ErrInvalidTaskID
+	}
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			return nil, domain.ErrInvalidTaskID
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique, nil
+}
+
+// firstIndexes memetakan setiap ID ke posisi kemunculan pertamanya di request, agar Index
+// pada hasil tetap merujuk ke request asli setelah ID ganda dibuang.
+func firstIndexes(ids []string) map[string]int {
+	indexOf := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if _, ok := indexOf[id]; !ok {
+			indexOf[id] = i
+		}
+	}
+	return indexOf
+}
+
+// newUndoToken membuat token undo acak 128-bit.
+func newUndoToken() (string, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("error generating undo token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
@@ -0,0 +1,64 @@
+// file: backend/services/task-service/internal/application/caldav_service.go
+package application
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// calDAVTokenPrefix membantu secret scanner mengenali password CalDAV yang bocor.
+const calDAVTokenPrefix = "dav_"
+
+// CalDAVApplicationService mendefinisikan use case password aplikasi untuk klien CalDAV.
+type CalDAVApplicationService interface {
+	// RotateToken membuat token CalDAV baru untuk pengguna dan mencabut token lama. Token hanya
+	// dikembalikan di sini.
+	RotateToken(ctx context.Context, userID domain.UserID) (string, error)
+
+	// RevokeToken mencabut token CalDAV pengguna.
+	RevokeToken(ctx context.Context, userID domain.UserID) error
+
+	// Authenticate mengembalikan pemilik token, atau ErrInvalidCalDAVToken.
+	Authenticate(ctx context.Context, token string) (domain.UserID, error)
+}
+
+// calDAVService adalah implementasi dari CalDAVApplicationService.
+type calDAVService struct {
+	tokenRepo domain.CalDAVTokenRepository
+}
+
+// NewCalDAVService adalah constructor untuk calDAVService.
+func NewCalDAVService(tokenRepo domain.CalDAVTokenRepository) CalDAVApplicationService {
+	return &calDAVService{
+		tokenRepo: tokenRepo,
+	}
+}
+
+// RotateToken memakai generator secret webhook; token disimpan sebagai hash SHA-256.
+func (s *calDAVService) RotateToken(ctx context.Context, userID domain.UserID) (string, error) {
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return "", err
+	}
+	token := calDAVTokenPrefix + secret
+	if err := s.tokenRepo.Replace(ctx, userID, hashAccessToken(token), time.Now()); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RevokeToken mencabut token CalDAV pengguna.
+func (s *calDAVService) RevokeToken(ctx context.Context, userID domain.UserID) error {
+	return s.tokenRepo.Delete(ctx, userID)
+}
+
+// Authenticate mencari pemilik token berdasarkan hash-nya.
+func (s *calDAVService) Authenticate(ctx context.Context, token string) (domain.UserID, error) {
+	if !strings.HasPrefix(token, calDAVTokenPrefix) {
+		return "", domain.ErrInvalidCalDAVToken
+	}
+	return s.tokenRepo.FindUser(ctx, hashAccessToken(token))
+}
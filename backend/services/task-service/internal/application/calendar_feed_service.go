@@ -0,0 +1,88 @@
+// file: backend/services/task-service/internal/application/calendar_feed_service.go
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	// calendarFeedTokenPrefix membantu secret scanner mengenali URL feed kalender yang bocor.
+	calendarFeedTokenPrefix = "cal_"
+
+	// calendarFeedLookback adalah umur tenggat terlama yang masih ikut di feed.
+	calendarFeedLookback = 30 * 24 * time.Hour
+
+	// maxCalendarFeedTasks membatasi jumlah task per feed agar dokumen tetap kecil.
+	maxCalendarFeedTasks = 1000
+)
+
+// CalendarFeedApplicationService mendefinisikan use case feed kalender (.ics) berisi task bertenggat.
+type CalendarFeedApplicationService interface {
+	// RotateFeedToken membuat token feed baru untuk pengguna dan mencabut token lama. Token hanya
+	// dikembalikan di sini.
+	RotateFeedToken(ctx context.Context, userID domain.UserID) (string, error)
+
+	// RevokeFeedToken mencabut token feed pengguna.
+	RevokeFeedToken(ctx context.Context, userID domain.UserID) error
+
+	// GetFeed mengembalikan task bertenggat milik pemilik token, dimulai dari tenggat 30 hari
+	// terakhir. Mengembalikan ErrInvalidCalendarFeedToken jika token tidak dikenal.
+	GetFeed(ctx context.Context, token string) ([]*domain.Task, error)
+}
+
+// calendarFeedService adalah implementasi dari CalendarFeedApplicationService.
+type calendarFeedService struct {
+	taskRepo  domain.TaskRepository
+	tokenRepo domain.CalendarFeedTokenRepository
+}
+
+// NewCalendarFeedService adalah constructor untuk calendarFeedService.
+func NewCalendarFeedService(taskRepo domain.TaskRepository, tokenRepo domain.CalendarFeedTokenRepository) CalendarFeedApplicationService {
+	return &calendarFeedService{
+		taskRepo:  taskRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// RotateFeedToken memakai generator secret webhook; token disimpan sebagai hash SHA-256.
+func (s *calendarFeedService) RotateFeedToken(ctx context.Context, userID domain.UserID) (string, error) {
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return "", err
+	}
+	token := calendarFeedTokenPrefix + secret
+	if err := s.tokenRepo.Replace(ctx, userID, hashAccessToken(token), time.Now()); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RevokeFeedToken mencabut token feed pengguna.
+func (s *calendarFeedService) RevokeFeedToken(ctx context.Context, userID domain.UserID) error {
+	return s.tokenRepo.Delete(ctx, userID)
+}
+
+// GetFeed mencari pemilik token lalu membaca task bertenggatnya.
+func (s *calendarFeedService) GetFeed(ctx context.Context, token string) ([]*domain.Task, error) {
+	if !strings.HasPrefix(token, calendarFeedTokenPrefix) {
+		return nil, domain.ErrInvalidCalendarFeedToken
+	}
+	userID, err := s.tokenRepo.FindUser(ctx, hashAccessToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return s.taskRepo.FindDueByUserID(ctx, userID, time.Now().Add(-calendarFeedLookback), maxCalendarFeedTasks)
+}
+
+// hashAccessToken mengembalikan hash SHA-256 token dalam hex, untuk token yang hanya disimpan
+// hash-nya (feed kalender, CalDAV, dan personal access token).
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,378 @@
+// file: backend/services/task-service/internal/application/data_export_service.go
+package application
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DefaultDataExportRetention adalah lama arsip ekspor data bisa diunduh jika DATA_EXPORT_RETENTION
+// tidak diatur.
+const DefaultDataExportRetention = 7 * 24 * time.Hour
+
+// dataExportFormat dan dataExportFormatVersion mengidentifikasi isi manifest.json arsip ekspor.
+const (
+	dataExportFormat        = "task-service.data-export"
+	dataExportFormatVersion = 1
+)
+
+const (
+	dataExportBatchSize  = 5                // Ekspor yang disusun per putaran job
+	dataExportStaleAfter = 30 * time.Minute // Ekspor running selama ini dianggap terhenti dan diklaim ulang
+	dataExportPageSize   = 500              // Aktivitas yang dibaca per halaman
+	dataExportMaxEntries = 10000            // Komentar yang ditulis dan entri audit log paling banyak
+)
+
+// dataExportFiles adalah isi arsip ekspor, sesuai urutan penulisan.
+var dataExportFiles = []string{"manifest.json", "account_backup.json", "comments_authored.json", "activity.json", "admin_audit_log.json"}
+
+// dataExportManifest adalah isi manifest.json.
+type dataExportManifest struct {
+	Format     string        `json:"format"`
+	Version    int           `json:"version"`
+	UserID     domain.UserID `json:"user_id"`
+	ExportID   string        `json:"export_id"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Files      []string      `json:"files"`
+}
+
+// dataExportActivity adalah satu entri activity.json.
+type dataExportActivity struct {
+	ActorID    domain.UserID       `json:"actor_id"`
+	Type       domain.ActivityType `json:"type"`
+	TaskID     string              `json:"task_id"`
+	TaskTitle  string              `json:"task_title"`
+	OccurredAt time.Time           `json:"occurred_at"`
+}
+
+// dataExportAuditEntry adalah satu entri admin_audit_log.json. ID admin lain tidak diekspor karena
+// merupakan data pribadi orang lain.
+type dataExportAuditEntry struct {
+	Action          string         `json:"action"`
+	Reason          string         `json:"reason"`
+	Details         map[string]any `json:"details"`
+	PerformedByUser bool           `json:"performed_by_user"` // true jika pengguna sendiri adalah admin pelakunya
+	OccurredAt      time.Time      `json:"occurred_at"`
+}
+
+// DataExportApplicationService mendefinisikan use case ekspor seluruh data yang disimpan tentang
+// pengguna. Arsip disusun secara asinkron oleh RunPeriodically.
+type DataExportApplicationService interface {
+	// RequestExport membuat permintaan ekspor pending. Mengembalikan ErrDataExportInProgress jika
+	// pengguna masih memiliki ekspor yang belum selesai.
+	RequestExport(ctx context.Context, userID domain.UserID) (*domain.DataExport, error)
+
+	// GetExport mengembalikan status ekspor milik pengguna, atau ErrDataExportNotFound.
+	GetExport(ctx context.Context, userID domain.UserID, id string) (*domain.DataExport, error)
+
+	// OpenExport membuka arsip ZIP ekspor yang sudah completed. Mengembalikan ErrDataExportNotReady
+	// jika ekspor belum selesai atau gagal. Pemanggil wajib menutup reader yang dikembalikan.
+	OpenExport(ctx context.Context, userID domain.UserID, id string) (*domain.DataExport, io.ReadCloser, error)
+
+	// ProcessPending menyusun arsip untuk ekspor pending dan mengembalikan jumlah yang selesai.
+	ProcessPending(ctx context.Context) (int, error)
+
+	// PurgeExpired menghapus arsip dan catatan ekspor yang sudah kedaluwarsa.
+	PurgeExpired(ctx context.Context) (int, error)
+
+	// RunPeriodically menjalankan ProcessPending dan PurgeExpired setiap interval sampai ctx
+	// dibatalkan.
+	RunPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// dataExportService adalah implementasi dari DataExportApplicationService.
+type dataExportService struct {
+	exportRepo   domain.DataExportRepository
+	backups      BackupApplicationService
+	activityRepo domain.ActivityRepository
+	commentRepo  domain.TaskCommentRepository
+	auditRepo    domain.AdminAuditRepository
+	blobs        domain.BlobStore
+	idGen        domain.IDGenerator
+	retention    time.Duration
+}
+
+// NewDataExportService adalah constructor untuk dataExportService. retention <= 0 berarti
+// DefaultDataExportRetention.
+func NewDataExportService(exportRepo domain.DataExportRepository, backups BackupApplicationService, activityRepo domain.ActivityRepository, commentRepo domain.TaskCommentRepository, auditRepo domain.AdminAuditRepository, blobs domain.BlobStore, idGen domain.IDGenerator, retention time.Duration) DataExportApplicationService {
+	if retention <= 0 {
+		retention = DefaultDataExportRetention
+	}
+	return &dataExportService{
+		exportRepo:   exportRepo,
+		backups:      backups,
+		activityRepo: activityRepo,
+		commentRepo:  commentRepo,
+		auditRepo:    auditRepo,
+		blobs:        blobs,
+		idGen:        idGen,
+		retention:    retention,
+	}
+}
+
+// RequestExport hanya menyimpan permintaan; arsip disusun job terjadwal di replika leader.
+func (s *dataExportService) RequestExport(ctx context.Context, userID domain.UserID) (*domain.DataExport, error) {
+	export := &domain.DataExport{
+		ID:          s.idGen.NewID(),
+		UserID:      userID,
+		Status:      domain.DataExportPending,
+		RequestedAt: time.Now().UTC(),
+	}
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// GetExport membaca ekspor dari repository.
+func (s *dataExportService) GetExport(ctx context.Context, userID domain.UserID, id string) (*domain.DataExport, error) {
+	return s.exportRepo.FindByID(ctx, userID, id)
+}
+
+// OpenExport membaca arsip dari BlobStore. Arsip yang sudah dihapus dari BlobStore dianggap tidak
+// ada.
+func (s *dataExportService) OpenExport(ctx context.Context, userID domain.UserID, id string) (*domain.DataExport, io.ReadCloser, error) {
+	export, err := s.exportRepo.FindByID(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.Status != domain.DataExportCompleted {
+		return nil, nil, domain.ErrDataExportNotReady
+	}
+	r, err := s.blobs.Get(ctx, export.BlobKey)
+	if errors.Is(err, domain.ErrBlobNotFound) {
+		return nil, nil, domain.ErrDataExportNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return export, r, nil
+}
+
+// ProcessPending menandai ekspor yang gagal sebagai failed agar pengguna bisa meminta ulang; error
+// hanya dikembalikan jika klaim atau penyimpanan status gagal.
+func (s *dataExportService) ProcessPending(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	exports, err := s.exportRepo.ClaimPending(ctx, now, now.Add(-dataExportStaleAfter), dataExportBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	completed := 0
+	for _, export := range exports {
+		export.BlobKey = fmt.Sprintf("exports/%s/%s.zip", export.UserID, export.ID)
+		size, err := s.build(ctx, export)
+		finishedAt := time.Now().UTC()
+		if err != nil {
+			slog.ErrorContext(ctx, "error building data export", "export_id", export.ID, "user_id", export.UserID, "error", err)
+			export.Status = domain.DataExportFailed
+			export.Error = "export could not be generated, please request a new one"
+			export.BlobKey = ""
+			export.CompletedAt = &finishedAt
+		} else {
+			expiresAt := finishedAt.Add(s.retention)
+			export.Status = domain.DataExportCompleted
+			export.SizeBytes = size
+			export.CompletedAt = &finishedAt
+			export.ExpiresAt = &expiresAt
+			completed++
+		}
+		if err := s.exportRepo.Update(ctx, export); err != nil {
+			return completed, err
+		}
+	}
+	return completed, nil
+}
+
+// build menulis arsip ZIP langsung ke BlobStore lewat pipe, sehingga arsip tidak ditampung utuh di
+// memori, dan mengembalikan ukurannya.
+func (s *dataExportService) build(ctx context.Context, export *domain.DataExport) (int64, error) {
+	pr, pw := io.Pipe()
+	counter := &countingWriter{w: pw}
+	go func() {
+		pw.CloseWithError(s.writeArchive(ctx, export, counter))
+	}()
+	err := s.blobs.Put(ctx, export.BlobKey, pr)
+	pr.CloseWithError(err) // Menghentikan writeArchive jika Put berhenti membaca lebih awal
+	if err != nil {
+		return 0, err
+	}
+	return counter.n, nil
+}
+
+// writeArchive menulis semua file di dataExportFiles.
+func (s *dataExportService) writeArchive(ctx context.Context, export *domain.DataExport, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	manifest := dataExportManifest{
+		Format:     dataExportFormat,
+		Version:    dataExportFormatVersion,
+		UserID:     export.UserID,
+		ExportID:   export.ID,
+		ExportedAt: time.Now().UTC(),
+		Files:      dataExportFiles,
+	}
+	if err := writeZipJSON(zw, "manifest.json", manifest); err != nil {
+		return err
+	}
+
+	// Task, kolom board, komentar di task pengguna, dan metadata attachment memakai format backup
+	// akun, sehingga file ini juga bisa dipulihkan lewat POST /api/v1/me/backup/restore.
+	f, err := zw.Create("account_backup.json")
+	if err != nil {
+		return err
+	}
+	if err := s.backups.ExportBackup(ctx, export.UserID, f); err != nil {
+		return err
+	}
+
+	comments, err := s.commentRepo.FindByAuthor(ctx, export.UserID, dataExportMaxEntries)
+	if err != nil {
+		return err
+	}
+	if comments == nil {
+		comments = []*domain.TaskComment{}
+	}
+	if err := writeZipJSON(zw, "comments_authored.json", comments); err != nil {
+		return err
+	}
+
+	if err := s.writeActivity(ctx, zw, export.UserID); err != nil {
+		return err
+	}
+
+	entries, err := s.auditRepo.FindByUser(ctx, export.UserID, dataExportMaxEntries)
+	if err != nil {
+		return err
+	}
+	auditEntries := make([]dataExportAuditEntry, len(entries))
+	for i, entry := range entries {
+		auditEntries[i] = dataExportAuditEntry{
+			Action:          entry.Action,
+			Reason:          entry.Reason,
+			Details:         entry.Details,
+			PerformedByUser: entry.AdminID == export.UserID,
+			OccurredAt:      entry.OccurredAt,
+		}
+	}
+	if err := writeZipJSON(zw, "admin_audit_log.json", auditEntries); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// writeActivity menulis seluruh feed aktivitas halaman demi halaman sebagai satu array JSON.
+func (s *dataExportService) writeActivity(ctx context.Context, zw *zip.Writer, userID domain.UserID) error {
+	f, err := zw.Create("activity.json")
+	if err != nil {
+		return err
+	}
+	out := &backupWriter{w: f}
+	out.raw("[")
+	query := domain.ActivityPageQuery{Limit: dataExportPageSize}
+	first := true
+	for {
+		page, err := s.activityRepo.FindPageByUserID(ctx, userID, query)
+		if err != nil {
+			return err
+		}
+		for _, activity := range page.Activities {
+			if !first {
+				out.raw(",")
+			}
+			first = false
+			out.value(dataExportActivity{
+				ActorID:    activity.ActorID,
+				Type:       activity.Type,
+				TaskID:     activity.TaskID,
+				TaskTitle:  activity.TaskTitle,
+				OccurredAt: activity.OccurredAt,
+			})
+		}
+		if out.err != nil || page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+	}
+	out.raw("]\n")
+	return out.err
+}
+
+func writeZipJSON(zw *zip.Writer, name string, v any) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("error encoding %s: %w", name, err)
+	}
+	return nil
+}
+
+// countingWriter menghitung byte yang ditulis ke w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// PurgeExpired menghapus arsip dari BlobStore sebelum catatannya, sehingga arsip yang gagal
+// dihapus dicoba lagi di putaran berikutnya.
+func (s *dataExportService) PurgeExpired(ctx context.Context) (int, error) {
+	exports, err := s.exportRepo.FindExpired(ctx, time.Now(), purgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, export := range exports {
+		if err := s.blobs.Delete(ctx, export.BlobKey); err != nil {
+			return purged, err
+		}
+		if err := s.exportRepo.Delete(ctx, export.ID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// RunPeriodically menjalankan kedua tugas berkala. Error hanya di-log dan dicoba lagi di putaran
+// berikutnya.
+func (s *dataExportService) RunPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			completed, err := s.ProcessPending(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error processing data exports", "error", err)
+			}
+			if completed > 0 {
+				slog.InfoContext(ctx, "completed data exports", "count", completed)
+			}
+			purged, err := s.PurgeExpired(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error purging expired data exports", "error", err)
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "purged expired data exports", "count", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// file: backend/services/task-service/internal/application/device_service.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Batas panjang profil perangkat dan ukuran langganan push yang disimpan.
+const (
+	maxDeviceNameLength        = 100
+	maxDevicePlatformLength    = 32
+	maxPushSubscriptionByteLen = 4096
+)
+
+// RegisterDeviceInput adalah data untuk mendaftarkan atau memperbarui perangkat.
+type RegisterDeviceInput struct {
+	ID               string // Dibuat oleh klien, sama dengan header X-Device-ID saat sync
+	Name             string
+	Platform         string
+	PushSubscription json.RawMessage // Kosong berarti perangkat tidak berlangganan push
+}
+
+// DeviceApplicationService mendefinisikan use case pengelolaan perangkat pengguna.
+type DeviceApplicationService interface {
+	// RegisterDevice membuat atau memperbarui profil perangkat.
+	// Mengembalikan ErrInvalidDevice, ErrTooManyDevices, atau ErrDeviceRevoked.
+	RegisterDevice(ctx context.Context, userID domain.UserID, input RegisterDeviceInput) (*domain.Device, error)
+
+	// ListDevices mengembalikan semua perangkat pengguna beserta diagnostik sync-nya.
+	ListDevices(ctx context.Context, userID domain.UserID) ([]*domain.Device, error)
+
+	// RevokeDevice mencabut perangkat sehingga sync dengan ID-nya ditolak.
+	RevokeDevice(ctx context.Context, userID domain.UserID, deviceID string) error
+}
+
+// deviceService adalah implementasi dari DeviceApplicationService.
+type deviceService struct {
+	deviceRepo domain.DeviceRepository
+}
+
+// NewDeviceService adalah constructor untuk deviceService.
+func NewDeviceService(deviceRepo domain.DeviceRepository) DeviceApplicationService {
+	return &deviceService{
+		deviceRepo: deviceRepo,
+	}
+}
+
+// RegisterDevice memvalidasi profil perangkat lalu menyimpannya.
+func (s *deviceService) RegisterDevice(ctx context.Context, userID domain.UserID, input RegisterDeviceInput) (*domain.Device, error) {
+	if err := domain.ValidateDeviceID(input.ID); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(input.Name)
+	platform := strings.ToLower(strings.TrimSpace(input.Platform))
+	if len(name) > maxDeviceNameLength || len(platform) > maxDevicePlatformLength {
+		return nil, fmt.Errorf("%w: name or platform too long", domain.ErrInvalidDevice)
+	}
+	if len(input.PushSubscription) > maxPushSubscriptionByteLen {
+		return nil, fmt.Errorf("%w: push subscription too large", domain.ErrInvalidDevice)
+	}
+	if string(input.PushSubscription) == "null" {
+		input.PushSubscription = nil
+	}
+	if _, err := s.deviceRepo.FindByID(ctx, userID, input.ID); errors.Is(err, domain.ErrDeviceNotFound) {
+		if err := checkDeviceLimit(ctx, s.deviceRepo, userID); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	device := &domain.Device{
+		ID:               input.ID,
+		UserID:           userID,
+		Name:             name,
+		Platform:         platform,
+		PushSubscription: input.PushSubscription,
+		UpdatedAt:        time.Now(),
+	}
+	if err := s.deviceRepo.Save(ctx, device); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+// ListDevices mengambil semua perangkat pengguna.
+func (s *deviceService) ListDevices(ctx context.Context, userID domain.UserID) ([]*domain.Device, error) {
+	return s.deviceRepo.FindByUserID(ctx, userID)
+}
+
+// RevokeDevice mencabut perangkat milik pengguna.
+func (s *deviceService) RevokeDevice(ctx context.Context, userID domain.UserID, deviceID string) error {
+	return s.deviceRepo.Revoke(ctx, userID, deviceID, time.Now())
+}
+
+// checkDeviceLimit memastikan perangkat baru tidak melebihi MaxDevicesPerUser.
+func checkDeviceLimit(ctx context.Context, repo domain.DeviceRepository, userID domain.UserID) error {
+	count, err := repo.CountActive(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if count >= domain.MaxDevicesPerUser {
+		return domain.ErrTooManyDevices
+	}
+	return nil
+}
+
+// resolveSyncDevice mengambil perangkat yang melakukan sync. Perangkat yang belum terdaftar
+// didaftarkan otomatis tanpa nama, sehingga klien lama cukup mengirim X-Device-ID.
+func resolveSyncDevice(ctx context.Context, repo domain.DeviceRepository, userID domain.UserID, deviceID string) (*domain.Device, error) {
+	if err := domain.ValidateDeviceID(deviceID); err != nil {
+		return nil, err
+	}
+	device, err := repo.FindByID(ctx, userID, deviceID)
+	if err == nil {
+		if device.RevokedAt != nil {
+			return nil, domain.ErrDeviceRevoked
+		}
+		return device, nil
+	}
+	if !errors.Is(err, domain.ErrDeviceNotFound) {
+		return nil, err
+	}
+	if err := checkDeviceLimit(ctx, repo, userID); err != nil {
+		return nil, err
+	}
+	device = &domain.Device{ID: deviceID, UserID: userID, UpdatedAt: time.Now()}
+	if err := repo.Save(ctx, device); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
@@ -0,0 +1,85 @@
+// file: backend/services/task-service/internal/application/discord_notifier.go
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// discordNotifier adalah EventNotifier yang mengirim event task sebagai embed Discord.
+type discordNotifier struct {
+	channelRepo domain.DiscordChannelRepository
+	client      domain.DiscordClient
+}
+
+// NewDiscordNotifier adalah constructor untuk discordNotifier.
+func NewDiscordNotifier(channelRepo domain.DiscordChannelRepository, client domain.DiscordClient) EventNotifier {
+	return &discordNotifier{
+		channelRepo: channelRepo,
+		client:      client,
+	}
+}
+
+// Notify mengirim event ke channel Discord pemilik task dan, kecuali untuk mention dan penugasan
+// yang ditujukan ke satu pengguna, ke channel daftar bersama yang diatur kolaboratornya. Setiap
+// channel harus menerima jenis event tersebut.
+func (n *discordNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	msg := discordEventMessage(event)
+	channel, err := n.channelRepo.FindByUserID(ctx, event.UserID)
+	switch {
+	case errors.Is(err, domain.ErrDiscordChannelNotFound):
+	case err != nil:
+		slog.ErrorContext(ctx, "error loading discord channel", "user_id", event.UserID, "error", err)
+	default:
+		n.send(ctx, channel, event, msg)
+	}
+	if event.Type == domain.TaskMentioned || event.Type == domain.TaskAssigned {
+		return
+	}
+
+	listChannels, err := n.channelRepo.FindListChannels(ctx, event.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading discord list channels", "owner_id", event.UserID, "error", err)
+		return
+	}
+	for _, listChannel := range listChannels {
+		n.send(ctx, listChannel, event, msg)
+	}
+}
+
+// send mengirim msg ke channel jika channel menerima event.
+func (n *discordNotifier) send(ctx context.Context, channel *domain.DiscordChannel, event domain.TaskEvent, msg domain.DiscordMessage) {
+	if !channel.Enabled() || !channel.Accepts(event.Type) {
+		return
+	}
+	if err := sendDiscordMessage(ctx, n.client, channel, msg); err != nil {
+		slog.ErrorContext(ctx, "error sending event to discord", "event_type", event.Type, "user_id", channel.UserID, "error", err)
+	}
+}
+
+// discordEventMessage membentuk embed untuk event task.
+func discordEventMessage(event domain.TaskEvent) domain.DiscordMessage {
+	var embed domain.DiscordEmbed
+	switch {
+	case event.Task == nil:
+		embed = domain.DiscordEmbed{Title: "Task deleted", Color: discordColorDeleted,
+			Fields: []domain.DiscordEmbedField{{Name: "ID", Value: event.TaskID}}}
+	case event.Type == domain.TaskCreated:
+		embed = discordTaskEmbed("Task created", discordColorCreated, event.Task)
+	case event.Type == domain.TaskMentioned && event.Comment != nil:
+		embed = discordTaskEmbed("Mentioned", discordColorCreated, event.Task)
+		embed.Description = truncateRunes(event.Comment.Body, discordEmbedDescriptionLength)
+	case event.Type == domain.TaskAssigned:
+		embed = discordTaskEmbed("Assigned to you", discordColorCreated, event.Task)
+	case event.Task.Completed:
+		embed = discordTaskEmbed("Task completed", discordColorCompleted, event.Task)
+	default:
+		embed = discordTaskEmbed("Task updated", discordColorUpdated, event.Task)
+	}
+	occurredAt := event.OccurredAt
+	embed.Timestamp = &occurredAt
+	return domain.DiscordMessage{Embeds: []domain.DiscordEmbed{embed}}
+}
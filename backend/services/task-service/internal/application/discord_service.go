@@ -0,0 +1,333 @@
+// file: backend/services/task-service/internal/application/discord_service.go
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// discordLinkCodeExpiry adalah masa berlaku kode untuk menghubungkan akun Discord.
+const discordLinkCodeExpiry = 10 * time.Minute
+
+// Batas panjang field embed Discord yang dipakai di sini (batas Discord: judul 256, deskripsi 4096).
+const (
+	discordEmbedTitleLength       = 256
+	discordEmbedDescriptionLength = 1024
+)
+
+// Warna embed per jenis notifikasi.
+const (
+	discordColorCreated   = 0x5865F2
+	discordColorUpdated   = 0xFEE75C
+	discordColorCompleted = 0x57F287
+	discordColorDeleted   = 0xED4245
+)
+
+// discordWebhookHosts adalah host yang diizinkan untuk incoming webhook Discord. URL lain ditolak
+// agar pengaturan Discord tidak bisa dipakai untuk mengirim request ke alamat sembarang.
+var discordWebhookHosts = []string{"discord.com", "discordapp.com", "ptb.discord.com", "canary.discord.com"}
+
+// SaveDiscordChannelInput adalah tujuan notifikasi Discord. Tepat satu dari WebhookURL dan
+// ChannelID harus diisi.
+type SaveDiscordChannelInput struct {
+	WebhookURL string
+	ChannelID  string
+	EventTypes []domain.TaskEventType
+}
+
+// DiscordLinkCode adalah kode sekali pakai untuk slash command /task link.
+type DiscordLinkCode struct {
+	Code      string
+	ExpiresAt time.Time
+}
+
+// DiscordCommand adalah slash command /task yang sudah diverifikasi berasal dari Discord.
+type DiscordCommand struct {
+	DiscordUserID string
+	Name          string            // Subcommand, misalnya "create" atau "link"
+	Options       map[string]string // Opsi subcommand berdasarkan nama
+}
+
+// DiscordApplicationService mendefinisikan use case integrasi Discord.
+type DiscordApplicationService interface {
+	GetChannel(ctx context.Context, userID domain.UserID) (*domain.DiscordChannel, error)
+
+	// SaveChannel memvalidasi tujuan dengan mengirim pesan percobaan sebelum menyimpannya.
+	SaveChannel(ctx context.Context, userID domain.UserID, input SaveDiscordChannelInput) (*domain.DiscordChannel, error)
+	DeleteChannel(ctx context.Context, userID domain.UserID) error
+
+	// GetListChannel, SaveListChannel, dan DeleteListChannel mengelola pengaturan userID untuk event
+	// task di daftar bersama ownerID. Mengembalikan ErrListShareNotFound jika daftar tersebut tidak
+	// dibagikan kepada userID.
+	GetListChannel(ctx context.Context, userID, ownerID domain.UserID) (*domain.DiscordChannel, error)
+	SaveListChannel(ctx context.Context, userID, ownerID domain.UserID, input SaveDiscordChannelInput) (*domain.DiscordChannel, error)
+	DeleteListChannel(ctx context.Context, userID, ownerID domain.UserID) error
+
+	// CreateLinkCode membuat kode untuk menghubungkan akun Discord lewat /task link.
+	CreateLinkCode(ctx context.Context, userID domain.UserID) (*DiscordLinkCode, error)
+
+	// HandleCommand menjalankan slash command dan mengembalikan balasan untuk pengguna Discord.
+	// Error ditampilkan sebagai isi balasan, bukan dikembalikan.
+	HandleCommand(ctx context.Context, cmd DiscordCommand) domain.DiscordMessage
+}
+
+// discordService adalah implementasi dari DiscordApplicationService.
+type discordService struct {
+	channelRepo domain.DiscordChannelRepository
+	shareRepo   domain.ListShareRepository
+	tasks       TaskApplicationService
+	archives    ArchiveApplicationService
+	client      domain.DiscordClient
+}
+
+// NewDiscordService adalah constructor untuk discordService. Task dari slash command dibuat lewat
+// tasks agar validasi, kuota, dan event sama dengan API REST. archives dipakai untuk menolak write
+// ke workspace yang diarsipkan, karena slash command tidak melewati middleware read-only.
+func NewDiscordService(channelRepo domain.DiscordChannelRepository, shareRepo domain.ListShareRepository, tasks TaskApplicationService, archives ArchiveApplicationService, client domain.DiscordClient) DiscordApplicationService {
+	return &discordService{
+		channelRepo: channelRepo,
+		shareRepo:   shareRepo,
+		tasks:       tasks,
+		archives:    archives,
+		client:      client,
+	}
+}
+
+// GetChannel mengembalikan pengaturan Discord milik pengguna.
+func (s *discordService) GetChannel(ctx context.Context, userID domain.UserID) (*domain.DiscordChannel, error) {
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// SaveChannel menyimpan tujuan notifikasi setelah pesan percobaan berhasil dikirim.
+func (s *discordService) SaveChannel(ctx context.Context, userID domain.UserID, input SaveDiscordChannelInput) (*domain.DiscordChannel, error) {
+	channel, err := s.verifyChannel(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.channelRepo.Save(ctx, channel); err != nil {
+		return nil, err
+	}
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// DeleteChannel menghapus pengaturan Discord milik pengguna.
+func (s *discordService) DeleteChannel(ctx context.Context, userID domain.UserID) error {
+	return s.channelRepo.Delete(ctx, userID)
+}
+
+// GetListChannel mengembalikan pengaturan Discord pengguna untuk daftar bersama ownerID.
+func (s *discordService) GetListChannel(ctx context.Context, userID, ownerID domain.UserID) (*domain.DiscordChannel, error) {
+	if _, err := s.shareRepo.FindAccess(ctx, ownerID, userID); err != nil {
+		return nil, err
+	}
+	return s.channelRepo.FindListChannel(ctx, ownerID, userID)
+}
+
+// SaveListChannel memvalidasi tujuan seperti SaveChannel. Akses baca sudah cukup, karena
+// notifikasi hanya berisi task yang memang bisa dilihat kolaborator.
+func (s *discordService) SaveListChannel(ctx context.Context, userID, ownerID domain.UserID, input SaveDiscordChannelInput) (*domain.DiscordChannel, error) {
+	if _, err := s.shareRepo.FindAccess(ctx, ownerID, userID); err != nil {
+		return nil, err
+	}
+	channel, err := s.verifyChannel(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	channel.ListOwnerID = ownerID
+	if err := s.channelRepo.SaveListChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+	return s.channelRepo.FindListChannel(ctx, ownerID, userID)
+}
+
+// DeleteListChannel menghapus pengaturan Discord pengguna untuk daftar bersama ownerID.
+func (s *discordService) DeleteListChannel(ctx context.Context, userID, ownerID domain.UserID) error {
+	if _, err := s.shareRepo.FindAccess(ctx, ownerID, userID); err != nil {
+		return err
+	}
+	return s.channelRepo.DeleteListChannel(ctx, ownerID, userID)
+}
+
+// verifyChannel memvalidasi input lalu mengirim pesan percobaan ke tujuannya.
+func (s *discordService) verifyChannel(ctx context.Context, userID domain.UserID, input SaveDiscordChannelInput) (*domain.DiscordChannel, error) {
+	channel := &domain.DiscordChannel{
+		UserID:     userID,
+		WebhookURL: strings.TrimSpace(input.WebhookURL),
+		ChannelID:  strings.TrimSpace(input.ChannelID),
+		EventTypes: slices.Compact(slices.Sorted(slices.Values(input.EventTypes))),
+		UpdatedAt:  time.Now(),
+	}
+	if (channel.WebhookURL == "") == (channel.ChannelID == "") {
+		return nil, fmt.Errorf("%w: exactly one of webhook_url and channel_id is required", domain.ErrInvalidDiscordChannel)
+	}
+	if channel.WebhookURL != "" && !isDiscordWebhookURL(channel.WebhookURL) {
+		return nil, fmt.Errorf("%w: webhook_url must be a Discord webhook URL", domain.ErrInvalidDiscordChannel)
+	}
+	if channel.ChannelID != "" && !isDiscordSnowflake(channel.ChannelID) {
+		return nil, fmt.Errorf("%w: channel_id must be a Discord channel ID", domain.ErrInvalidDiscordChannel)
+	}
+	for _, eventType := range channel.EventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", domain.ErrInvalidDiscordChannel, eventType)
+		}
+	}
+
+	msg := domain.DiscordMessage{Embeds: []domain.DiscordEmbed{{
+		Title:       "Notifications connected",
+		Description: "Task notifications will be posted here.",
+		Color:       discordColorCreated,
+	}}}
+	if err := sendDiscordMessage(ctx, s.client, channel, msg); err != nil {
+		if errors.Is(err, domain.ErrDiscordIntegrationMissing) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: test message failed: %v", domain.ErrInvalidDiscordChannel, err)
+	}
+	return channel, nil
+}
+
+// CreateLinkCode hanya menyimpan hash kode, sehingga kode yang bocor dari database tidak bisa dipakai.
+func (s *discordService) CreateLinkCode(ctx context.Context, userID domain.UserID) (*DiscordLinkCode, error) {
+	raw := make([]byte, 10)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("error generating discord link code: %w", err)
+	}
+	code := &DiscordLinkCode{
+		Code:      base32.StdEncoding.EncodeToString(raw),
+		ExpiresAt: time.Now().Add(discordLinkCodeExpiry),
+	}
+	if err := s.channelRepo.SetLinkCode(ctx, userID, hashDiscordLinkCode(code.Code), code.ExpiresAt); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
+// HandleCommand menjalankan subcommand /task.
+func (s *discordService) HandleCommand(ctx context.Context, cmd DiscordCommand) domain.DiscordMessage {
+	switch cmd.Name {
+	case "link":
+		_, err := s.channelRepo.Link(ctx, hashDiscordLinkCode(cmd.Options["code"]), cmd.DiscordUserID, time.Now())
+		if err != nil {
+			return discordErrorReply(err)
+		}
+		return domain.DiscordMessage{Content: "Your Discord account is now linked."}
+
+	case "create":
+		channel, err := s.channelRepo.FindByDiscordUserID(ctx, cmd.DiscordUserID)
+		if errors.Is(err, domain.ErrDiscordChannelNotFound) {
+			return discordErrorReply(domain.ErrDiscordAccountNotLinked)
+		}
+		if err != nil {
+			return discordErrorReply(err)
+		}
+		readOnly, err := s.archives.IsReadOnly(ctx, channel.UserID)
+		if err != nil {
+			return discordErrorReply(err)
+		}
+		if readOnly {
+			return discordErrorReply(domain.ErrWorkspaceArchived)
+		}
+		task, _, err := s.tasks.CreateTask(ctx, channel.UserID, CreateTaskInput{
+			Title:       cmd.Options["title"],
+			Description: cmd.Options["description"],
+		})
+		if err != nil {
+			return discordErrorReply(err)
+		}
+		return domain.DiscordMessage{Embeds: []domain.DiscordEmbed{discordTaskEmbed("Task created", discordColorCreated, task)}}
+	}
+	return domain.DiscordMessage{Content: fmt.Sprintf("Unknown command %q.", cmd.Name)}
+}
+
+// sendDiscordMessage mengirim msg ke tujuan channel.
+func sendDiscordMessage(ctx context.Context, client domain.DiscordClient, channel *domain.DiscordChannel, msg domain.DiscordMessage) error {
+	if channel.WebhookURL != "" {
+		return client.SendWebhook(ctx, channel.WebhookURL, msg)
+	}
+	return client.SendChannelMessage(ctx, channel.ChannelID, msg)
+}
+
+// discordTaskEmbed membentuk embed berisi judul dan deskripsi task.
+func discordTaskEmbed(heading string, color int, task *domain.Task) domain.DiscordEmbed {
+	status := "Open"
+	if task.Completed {
+		status = "Completed"
+	}
+	return domain.DiscordEmbed{
+		Title:       truncateRunes(heading+": "+task.Title, discordEmbedTitleLength),
+		Description: truncateRunes(task.Description, discordEmbedDescriptionLength),
+		Color:       color,
+		Fields: []domain.DiscordEmbedField{
+			{Name: "Status", Value: status, Inline: true},
+			{Name: "ID", Value: task.ID, Inline: true},
+		},
+	}
+}
+
+// discordErrorReply mengubah error menjadi balasan. Error internal hanya di-log.
+func discordErrorReply(err error) domain.DiscordMessage {
+	for _, known := range []error{
+		domain.ErrTaskTitleRequired,
+		domain.ErrDiscordLinkCodeInvalid,
+		domain.ErrWorkspaceArchived,
+	} {
+		if errors.Is(err, known) {
+			return domain.DiscordMessage{Content: "Error: " + err.Error() + "."}
+		}
+	}
+	if errors.Is(err, domain.ErrDiscordAccountNotLinked) {
+		return domain.DiscordMessage{Content: "Your Discord account is not linked. Create a link code in the app, then run /task link."}
+	}
+	slog.Error("error handling discord command", "error", err)
+	return domain.DiscordMessage{Content: "Something went wrong. Please try again later."}
+}
+
+// hashDiscordLinkCode menormalkan kode (huruf besar, tanpa spasi) lalu meng-hash-nya.
+func hashDiscordLinkCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToUpper(strings.Join(strings.Fields(code), ""))))
+	return hex.EncodeToString(sum[:])
+}
+
+// isDiscordWebhookURL bernilai true untuk URL https://discord.com/api/webhooks/<id>/<token>.
+func isDiscordWebhookURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.User != nil || u.Port() != "" {
+		return false
+	}
+	return slices.Contains(discordWebhookHosts, u.Hostname()) && strings.HasPrefix(u.Path, "/api/webhooks/")
+}
+
+// isDiscordSnowflake bernilai true jika s adalah ID numerik Discord.
+func isDiscordSnowflake(s string) bool {
+	if s == "" || len(s) > 20 {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// truncateRunes memotong s menjadi paling banyak n rune, diakhiri elipsis jika dipotong.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,61 @@
+// file: backend/services/task-service/internal/application/email_notifier.go
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// emailNotifier adalah EventNotifier yang mengirim event task sebagai email ke pengguna.
+type emailNotifier struct {
+	channelRepo domain.EmailChannelRepository
+	sender      domain.EmailSender
+	locations   UserLocationProvider
+}
+
+// NewEmailNotifier adalah constructor untuk emailNotifier.
+func NewEmailNotifier(channelRepo domain.EmailChannelRepository, sender domain.EmailSender, locations UserLocationProvider) EventNotifier {
+	return &emailNotifier{
+		channelRepo: channelRepo,
+		sender:      sender,
+		locations:   locations,
+	}
+}
+
+// Notify mengirim email ke pengguna event jika channel-nya berlangganan jenis event tersebut.
+// Jika SMTP tidak dikonfigurasi, event dilewati tanpa log.
+func (n *emailNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	channel, err := n.channelRepo.FindByUserID(ctx, event.UserID)
+	if errors.Is(err, domain.ErrEmailChannelNotFound) {
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading email channel", "user_id", event.UserID, "error", err)
+		return
+	}
+	if !channel.Accepts(event.Type) {
+		return
+	}
+	msg, err := renderEmail(channel.Address, emailEventContent(event, userLocationOrUTC(ctx, n.locations, event.UserID)))
+	if err == nil {
+		err = n.sender.Send(ctx, msg)
+	}
+	if err != nil && !errors.Is(err, domain.ErrEmailNotConfigured) {
+		slog.ErrorContext(ctx, "error sending event email", "event_type", event.Type, "user_id", event.UserID, "error", err)
+	}
+}
+
+// userLocationOrUTC mengembalikan zona waktu pengguna untuk menulis tenggat di email. Error hanya
+// di-log karena email tetap berguna dengan waktu UTC.
+func userLocationOrUTC(ctx context.Context, locations UserLocationProvider, userID domain.UserID) *time.Location {
+	location, err := locations.UserLocation(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading time zone", "user_id", userID, "error", err)
+		return time.UTC
+	}
+	return location
+}
@@ -0,0 +1,172 @@
+// file: backend/services/task-service/internal/application/email_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// emailReminderBatchSize adalah jumlah pengingat maksimum yang dikirim dalam satu putaran job.
+const emailReminderBatchSize = 100
+
+// SaveEmailChannelInput adalah pengaturan notifikasi email. Address diisi handler dari klaim email
+// token pengguna, bukan dari body request.
+type SaveEmailChannelInput struct {
+	Address      string
+	EventTypes   []domain.TaskEventType
+	ReminderLead time.Duration
+}
+
+// EmailApplicationService mendefinisikan use case notifikasi email.
+type EmailApplicationService interface {
+	GetChannel(ctx context.Context, userID domain.UserID) (*domain.EmailChannel, error)
+	SaveChannel(ctx context.Context, userID domain.UserID, input SaveEmailChannelInput) (*domain.EmailChannel, error)
+	DeleteChannel(ctx context.Context, userID domain.UserID) error
+
+	// SendTest mengirim email percobaan ke alamat channel pengguna.
+	SendTest(ctx context.Context, userID domain.UserID) error
+
+	// SendReminders mengirim email untuk task yang tenggatnya sudah dekat dan mengembalikan jumlah
+	// email yang terkirim. Setiap tenggat hanya diingatkan sekali.
+	SendReminders(ctx context.Context) (int, error)
+
+	// RunRemindersPeriodically menjalankan SendReminders setiap interval sampai ctx dibatalkan.
+	RunRemindersPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// emailService adalah implementasi dari EmailApplicationService.
+type emailService struct {
+	channelRepo domain.EmailChannelRepository
+	sender      domain.EmailSender
+	locations   UserLocationProvider
+}
+
+// NewEmailService adalah constructor untuk emailService.
+func NewEmailService(channelRepo domain.EmailChannelRepository, sender domain.EmailSender, locations UserLocationProvider) EmailApplicationService {
+	return &emailService{
+		channelRepo: channelRepo,
+		sender:      sender,
+		locations:   locations,
+	}
+}
+
+// GetChannel mengembalikan pengaturan email milik pengguna.
+func (s *emailService) GetChannel(ctx context.Context, userID domain.UserID) (*domain.EmailChannel, error) {
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// SaveChannel memvalidasi lalu mengganti pengaturan email pengguna.
+func (s *emailService) SaveChannel(ctx context.Context, userID domain.UserID, input SaveEmailChannelInput) (*domain.EmailChannel, error) {
+	address, err := mail.ParseAddress(input.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: the account has no valid email address", domain.ErrInvalidEmailChannel)
+	}
+	eventTypes := slices.Compact(slices.Sorted(slices.Values(input.EventTypes)))
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", domain.ErrInvalidEmailChannel, eventType)
+		}
+	}
+	if input.ReminderLead < 0 || input.ReminderLead > domain.MaxEmailReminderLead {
+		return nil, fmt.Errorf("%w: reminder lead must be between 0 and %s", domain.ErrInvalidEmailChannel, domain.MaxEmailReminderLead)
+	}
+
+	channel := &domain.EmailChannel{
+		UserID:       userID,
+		Address:      address.Address,
+		EventTypes:   eventTypes,
+		ReminderLead: input.ReminderLead.Truncate(time.Minute),
+		UpdatedAt:    time.Now(),
+	}
+	if err := s.channelRepo.Save(ctx, channel); err != nil {
+		return nil, err
+	}
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// DeleteChannel menghapus pengaturan email milik pengguna.
+func (s *emailService) DeleteChannel(ctx context.Context, userID domain.UserID) error {
+	return s.channelRepo.Delete(ctx, userID)
+}
+
+// SendTest memakai template yang sama dengan email notifikasi, sehingga tampilannya bisa diperiksa.
+func (s *emailService) SendTest(ctx context.Context, userID domain.UserID) error {
+	channel, err := s.channelRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	msg, err := renderEmail(channel.Address, emailContent{
+		Subject: "Test email from your task list",
+		Heading: "Email notifications are working",
+		Details: []string{"Task events and due date reminders you enabled will be sent to this address."},
+	})
+	if err != nil {
+		return err
+	}
+	err = s.sender.Send(ctx, msg)
+	if err != nil && !errors.Is(err, domain.ErrEmailNotConfigured) && !errors.Is(err, domain.ErrDependencyUnavailable) {
+		return fmt.Errorf("%w: %v", domain.ErrEmailDeliveryFailed, err)
+	}
+	return err
+}
+
+// SendReminders mengklaim setiap pengingat sebelum dikirim, dan melepas klaimnya lagi jika
+// pengiriman gagal agar dicoba di putaran berikutnya selama tenggatnya belum lewat.
+func (s *emailService) SendReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	reminders, err := s.channelRepo.FindDueReminders(ctx, now, emailReminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, reminder := range reminders {
+		task := reminder.Task
+		claimed, err := s.channelRepo.ClaimReminder(ctx, task.ID, *task.DueAt, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+		msg, err := renderEmail(reminder.Channel.Address, emailReminderContent(task, userLocationOrUTC(ctx, s.locations, task.UserID)))
+		if err == nil {
+			err = s.sender.Send(ctx, msg)
+		}
+		if err != nil {
+			if releaseErr := s.channelRepo.ReleaseReminder(ctx, task.ID, *task.DueAt); releaseErr != nil {
+				slog.ErrorContext(ctx, "error releasing email reminder", "task_id", task.ID, "error", releaseErr)
+			}
+			if errors.Is(err, domain.ErrEmailNotConfigured) {
+				return sent, nil
+			}
+			slog.ErrorContext(ctx, "error sending email reminder", "task_id", task.ID, "error", err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// RunRemindersPeriodically mengirim pengingat berkala. Error hanya di-log dan dicoba lagi di
+// putaran berikutnya.
+func (s *emailService) RunRemindersPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.SendReminders(ctx); err != nil {
+				slog.ErrorContext(ctx, "error sending email reminders", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// file: backend/services/task-service/internal/application/email_templates.go
+package application
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// emailSubjectLength adalah panjang judul task maksimum (dalam rune) di subjek email.
+const emailSubjectLength = 120
+
+// emailContent adalah data untuk template email. Semua teks berasal dari pengguna atau task, jadi
+// hanya template HTML (dengan escaping otomatis) yang boleh dipakai untuk membentuk HTML.
+type emailContent struct {
+	Subject string
+	Heading string   // Kalimat pembuka, misalnya "Task completed"
+	Title   string   // Judul task; kosong untuk email percobaan
+	Details []string // Baris tambahan, misalnya tenggat atau isi komentar
+	Footer  string   // Alasan email dikirim; kosong berarti notifikasi yang diaktifkan pengguna
+}
+
+var emailTextTemplate = template.Must(template.New("text").Parse(`{{.Heading}}
+{{if .Title}}
+{{.Title}}
+{{end}}{{range .Details}}
+{{.}}
+{{end}}
+--
+{{if .Footer}}{{.Footer}}{{else}}You receive this email because notifications are enabled in your task settings.{{end}}
+`))
+
+var emailHTMLTemplate = htmltemplate.Must(htmltemplate.New("html").Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:24px;background:#f4f4f5;font-family:-apple-system,Segoe UI,Roboto,sans-serif;color:#18181b">
+<table role="presentation" width="100%" cellpadding="0" cellspacing="0" style="max-width:560px;margin:0 auto;background:#ffffff;border-radius:8px">
+<tr><td style="padding:24px">
+<p style="margin:0 0 8px;font-size:14px;color:#71717a">{{.Heading}}</p>
+{{if .Title}}<h1 style="margin:0 0 16px;font-size:20px">{{.Title}}</h1>{{end}}
+{{range .Details}}<p style="margin:0 0 8px;font-size:14px;white-space:pre-wrap">{{.}}</p>{{end}}
+</td></tr>
+</table>
+<p style="max-width:560px;margin:16px auto 0;font-size:12px;color:#a1a1aa">{{if .Footer}}{{.Footer}}{{else}}You receive this email because notifications are enabled in your task settings.{{end}}</p>
+</body>
+</html>
+`))
+
+// renderEmail membentuk EmailMessage dari content untuk alamat to.
+func renderEmail(to string, content emailContent) (domain.EmailMessage, error) {
+	var text, html bytes.Buffer
+	if err := emailTextTemplate.Execute(&text, content); err != nil {
+		return domain.EmailMessage{}, err
+	}
+	if err := emailHTMLTemplate.Execute(&html, content); err != nil {
+		return domain.EmailMessage{}, err
+	}
+	return domain.EmailMessage{
+		To:      to,
+		Subject: content.Subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// emailSubjectTitle meringkas judul task menjadi satu baris untuk subjek email.
+func emailSubjectTitle(title string) string {
+	return truncateRunes(strings.Join(strings.Fields(title), " "), emailSubjectLength)
+}
+
+// emailDueLine menulis tenggat task dalam zona waktu pengguna, atau "" jika task tanpa tenggat.
+func emailDueLine(task *domain.Task, location *time.Location) string {
+	if task == nil || task.DueAt == nil {
+		return ""
+	}
+	return "Due " + task.DueAt.In(location).Format("Mon, 2 Jan 2006 15:04 MST")
+}
+
+// emailEventContent membentuk isi email untuk event task.
+func emailEventContent(event domain.TaskEvent, location *time.Location) emailContent {
+	var heading, title string
+	switch {
+	case event.Task == nil:
+		heading, title = "Task deleted", event.TaskID
+	case event.Type == domain.TaskCreated:
+		heading, title = "Task created", event.Task.Title
+	case event.Type == domain.TaskMentioned:
+		heading, title = "You were mentioned in a comment on", event.Task.Title
+	case event.Type == domain.TaskAssigned:
+		heading, title = "Task assigned to you", event.Task.Title
+	case event.Type == domain.TaskMoved:
+		heading, title = "Task moved", event.Task.Title
+	case event.Task.Completed:
+		heading, title = "Task completed", event.Task.Title
+	default:
+		heading, title = "Task updated", event.Task.Title
+	}
+	content := emailContent{
+		Subject: heading + ": " + emailSubjectTitle(title),
+		Heading: heading,
+		Title:   title,
+	}
+	if due := emailDueLine(event.Task, location); due != "" {
+		content.Details = append(content.Details, due)
+	}
+	if event.Comment != nil {
+		content.Details = append(content.Details, event.Comment.Body)
+	}
+	return content
+}
+
+// emailReminderContent membentuk isi email pengingat tenggat.
+func emailReminderContent(task *domain.Task, location *time.Location) emailContent {
+	due := emailDueLine(task, location)
+	return emailContent{
+		Subject: "Reminder: " + emailSubjectTitle(task.Title),
+		Heading: "Reminder: this task is due soon",
+		Title:   task.Title,
+		Details: []string{due},
+	}
+}
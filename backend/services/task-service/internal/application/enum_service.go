@@ -0,0 +1,128 @@
+// file: backend/services/task-service/internal/application/enum_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// maxEnumLabelLength adalah panjang label nilai enum maksimum.
+const maxEnumLabelLength = 64
+
+// EnumValidator dipakai oleh use case lain untuk memeriksa nilai enum sebelum disimpan.
+type EnumValidator interface {
+	// ValidateEnumValue mengembalikan error yang membungkus ErrInvalidEnumValue jika value tidak
+	// terdaftar untuk pengguna atau sudah deprecated.
+	ValidateEnumValue(ctx context.Context, userID domain.UserID, enum domain.EnumName, value string) error
+}
+
+// SaveEnumValueInput adalah data untuk menambah atau mengubah nilai enum milik workspace.
+type SaveEnumValueInput struct {
+	Label      string
+	Position   int
+	Deprecated bool
+}
+
+// EnumApplicationService mendefinisikan use case enum yang bisa diperluas saat runtime.
+type EnumApplicationService interface {
+	EnumValidator
+
+	// ListEnums mengembalikan nilai yang berlaku untuk pengguna pada setiap enum domain.KnownEnums.
+	ListEnums(ctx context.Context, userID domain.UserID) (map[domain.EnumName][]domain.EnumValue, error)
+
+	// SaveEnumValue menambah atau mengubah nilai enum milik workspace pengguna. Nilai builtin tidak
+	// bisa diubah (ErrBuiltinEnumValue). Nilai tidak bisa dihapus, hanya ditandai deprecated.
+	SaveEnumValue(ctx context.Context, userID domain.UserID, enum domain.EnumName, value string, input SaveEnumValueInput) (*domain.EnumValue, error)
+}
+
+// enumService adalah implementasi dari EnumApplicationService.
+type enumService struct {
+	enumRepo domain.EnumRepository
+}
+
+// NewEnumService adalah constructor untuk enumService.
+func NewEnumService(enumRepo domain.EnumRepository) EnumApplicationService {
+	return &enumService{
+		enumRepo: enumRepo,
+	}
+}
+
+// ValidateEnumValue memeriksa value terhadap tabel lookup enum.
+func (s *enumService) ValidateEnumValue(ctx context.Context, userID domain.UserID, enum domain.EnumName, value string) error {
+	found, err := s.enumRepo.FindValue(ctx, userID, enum, value)
+	if errors.Is(err, domain.ErrEnumValueNotFound) {
+		return fmt.Errorf("%w: %q is not a %s value", domain.ErrInvalidEnumValue, value, enum)
+	}
+	if err != nil {
+		return err
+	}
+	if found.Deprecated {
+		return fmt.Errorf("%w: %s value %q is deprecated", domain.ErrInvalidEnumValue, enum, value)
+	}
+	return nil
+}
+
+// ListEnums mengambil nilai setiap enum yang dikenal.
+func (s *enumService) ListEnums(ctx context.Context, userID domain.UserID) (map[domain.EnumName][]domain.EnumValue, error) {
+	enums := make(map[domain.EnumName][]domain.EnumValue, len(domain.KnownEnums))
+	for _, enum := range domain.KnownEnums {
+		values, err := s.enumRepo.FindValues(ctx, userID, enum)
+		if err != nil {
+			return nil, err
+		}
+		enums[enum] = values
+	}
+	return enums, nil
+}
+
+// SaveEnumValue memvalidasi lalu menyimpan nilai enum milik workspace.
+func (s *enumService) SaveEnumValue(ctx context.Context, userID domain.UserID, enum domain.EnumName, value string, input SaveEnumValueInput) (*domain.EnumValue, error) {
+	if err := enum.Validate(); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateEnumValueName(value); err != nil {
+		return nil, fmt.Errorf("%w: value must match [a-z][a-z0-9_]{0,31}", err)
+	}
+	label := strings.TrimSpace(input.Label)
+	if label == "" || len(label) > maxEnumLabelLength {
+		return nil, fmt.Errorf("%w: label must be 1 to %d characters", domain.ErrInvalidEnumValue, maxEnumLabelLength)
+	}
+
+	values, err := s.enumRepo.FindValues(ctx, userID, enum)
+	if err != nil {
+		return nil, err
+	}
+	custom, exists := 0, false
+	for _, existing := range values {
+		if existing.Value == value {
+			if existing.Builtin {
+				return nil, domain.ErrBuiltinEnumValue
+			}
+			exists = true
+		}
+		if !existing.Builtin {
+			custom++
+		}
+	}
+	if !exists && custom >= domain.MaxCustomEnumValues {
+		return nil, domain.ErrTooManyEnumValues
+	}
+
+	saved := &domain.EnumValue{
+		Enum:       enum,
+		Value:      value,
+		Label:      label,
+		Position:   input.Position,
+		Deprecated: input.Deprecated,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.enumRepo.SaveValue(ctx, userID, saved); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
@@ -0,0 +1,80 @@
+// file: backend/services/task-service/internal/application/event_notifier.go
+package application
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// notifierQueueSize adalah jumlah event maksimum yang menunggu diteruskan ke notifier.
+const notifierQueueSize = 1024
+
+// EventNotifier meneruskan TaskEvent ke pihak luar, misalnya webhook atau Discord.
+// Notify dipanggil di luar request write, jadi error ditangani (di-log) oleh notifier sendiri.
+type EventNotifier interface {
+	Notify(ctx context.Context, event domain.TaskEvent)
+}
+
+// NotifyingPublisher adalah decorator domain.TaskEventPublisher yang meneruskan event ke publisher
+// berikutnya (change feed realtime) lalu mengantrekannya untuk setiap EventNotifier.
+// Karena hanya replika yang menangani write yang memanggil Publish, setiap event dikirim sekali,
+// tidak sekali per replika seperti subscriber change feed.
+type NotifyingPublisher struct {
+	next      domain.TaskEventPublisher
+	notifiers []EventNotifier
+	queue     chan queuedEvent
+}
+
+// queuedEvent adalah event di antrean beserta ID request yang memicunya, agar log dan pengiriman
+// notifier tetap bisa dikorelasikan dengan request tersebut.
+type queuedEvent struct {
+	event     domain.TaskEvent
+	requestID string
+}
+
+// NewNotifyingPublisher adalah constructor untuk NotifyingPublisher. Run harus dijalankan agar
+// event yang diantrekan benar-benar dikirim.
+func NewNotifyingPublisher(next domain.TaskEventPublisher, notifiers ...EventNotifier) *NotifyingPublisher {
+	return &NotifyingPublisher{
+		next:      next,
+		notifiers: notifiers,
+		queue:     make(chan queuedEvent, notifierQueueSize),
+	}
+}
+
+// Publish tidak pernah memblokir karena notifier: jika antrean penuh, event hanya di-log,
+// seperti penyebaran realtime yang bersifat best-effort.
+func (p *NotifyingPublisher) Publish(ctx context.Context, event domain.TaskEvent) error {
+	err := p.next.Publish(ctx, event)
+	select {
+	case p.queue <- queuedEvent{event: event, requestID: domain.RequestIDFromContext(ctx)}:
+	default:
+		slog.WarnContext(ctx, "notifier queue full, dropping event", "event_type", event.Type, "task_id", event.TaskID)
+	}
+	return err
+}
+
+// Run meneruskan event dari antrean dengan sejumlah worker sampai ctx dibatalkan.
+func (p *NotifyingPublisher) Run(ctx context.Context, workers int) {
+	for range max(workers, 1) {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case queued := <-p.queue:
+					notifyCtx := ctx
+					if queued.requestID != "" {
+						notifyCtx = domain.ContextWithRequestID(ctx, queued.requestID)
+					}
+					for _, notifier := range p.notifiers {
+						notifier.Notify(notifyCtx, queued.event)
+					}
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+}
@@ -0,0 +1,81 @@
+// file: backend/services/task-service/internal/application/field_encryption_service.go
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// fieldEncryptionBatchSize adalah jumlah nilai per tabel yang dienkripsi ulang dalam satu transaksi.
+const fieldEncryptionBatchSize = 200
+
+// FieldEncryptionApplicationService mendefinisikan use case enkripsi ulang deskripsi task dan isi
+// komentar yang masih tersimpan sebagai plaintext atau dengan key lama, setelah enkripsi diaktifkan
+// atau key dirotasi.
+type FieldEncryptionApplicationService interface {
+	// EncryptPending mengenkripsi ulang semua nilai yang belum memakai key aktif dan mengembalikan
+	// jumlahnya.
+	EncryptPending(ctx context.Context) (int, error)
+
+	// RunPeriodically menjalankan EncryptPending setiap interval sampai ctx dibatalkan.
+	RunPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// fieldEncryptionService adalah implementasi dari FieldEncryptionApplicationService.
+type fieldEncryptionService struct {
+	repo   domain.FieldEncryptionRepository
+	cipher domain.FieldCipher
+	done   string // Prefix key aktif yang sudah selesai diterapkan ke semua data
+}
+
+// NewFieldEncryptionService adalah constructor untuk fieldEncryptionService.
+func NewFieldEncryptionService(repo domain.FieldEncryptionRepository, cipher domain.FieldCipher) FieldEncryptionApplicationService {
+	return &fieldEncryptionService{
+		repo:   repo,
+		cipher: cipher,
+	}
+}
+
+// EncryptPending tidak memindai tabel lagi setelah semua data memakai key aktif, sampai key
+// dirotasi. Nilai yang ditulis setelahnya sudah dienkripsi oleh repository.
+func (s *fieldEncryptionService) EncryptPending(ctx context.Context) (int, error) {
+	prefix := s.cipher.CurrentPrefix()
+	if prefix == "" || prefix == s.done {
+		return 0, nil
+	}
+	total := 0
+	for {
+		n, err := s.repo.EncryptBatch(ctx, fieldEncryptionBatchSize)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n == 0 {
+			s.done = prefix
+			return total, nil
+		}
+	}
+}
+
+// RunPeriodically hanya me-log error; nilai yang gagal dicoba lagi di putaran berikutnya.
+func (s *fieldEncryptionService) RunPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			encrypted, err := s.EncryptPending(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error encrypting stored fields", "error", err)
+			}
+			if encrypted > 0 {
+				slog.InfoContext(ctx, "encrypted stored fields", "count", encrypted)
+			}
+		}
+	}
+}
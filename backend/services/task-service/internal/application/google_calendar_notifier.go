@@ -0,0 +1,54 @@
+// file: backend/services/task-service/internal/application/google_calendar_notifier.go
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// googleCalendarNotifier adalah EventNotifier yang menulis perubahan task ke Google Calendar
+// pemiliknya. Perubahan yang gagal ditulis diulang oleh job rekonsiliasi.
+type googleCalendarNotifier struct {
+	connRepo domain.GoogleCalendarConnectionRepository
+	linkRepo domain.GoogleCalendarLinkRepository
+	client   domain.GoogleCalendarClient
+}
+
+// NewGoogleCalendarNotifier adalah constructor untuk googleCalendarNotifier.
+func NewGoogleCalendarNotifier(
+	connRepo domain.GoogleCalendarConnectionRepository,
+	linkRepo domain.GoogleCalendarLinkRepository,
+	client domain.GoogleCalendarClient,
+) EventNotifier {
+	return &googleCalendarNotifier{
+		connRepo: connRepo,
+		linkRepo: linkRepo,
+		client:   client,
+	}
+}
+
+// Notify membuat, mengganti, atau menghapus event untuk task. Mention, penugasan (yang dikirim ke
+// penerima tugas, bukan pemilik), dan perpindahan kolom tidak mengubah event.
+func (n *googleCalendarNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	if event.Type == domain.TaskMentioned || event.Type == domain.TaskAssigned || event.Type == domain.TaskMoved {
+		return
+	}
+	conn, err := n.connRepo.FindByUserID(ctx, event.UserID)
+	if errors.Is(err, domain.ErrGoogleCalendarNotConnected) {
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading google calendar connection", "user_id", event.UserID, "error", err)
+		return
+	}
+	accessToken, err := googleCalendarToken(ctx, n.connRepo, n.client, conn)
+	if err == nil {
+		err = pushGoogleCalendarTask(ctx, n.linkRepo, n.client, conn, accessToken, event.TaskID, event.Task)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error syncing task to google calendar", "task_id", event.TaskID, "user_id", event.UserID, "error", err)
+	}
+}
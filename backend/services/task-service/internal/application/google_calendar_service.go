@@ -0,0 +1,405 @@
+// file: backend/services/task-service/internal/application/google_calendar_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	// defaultGoogleCalendarID adalah kalender utama akun Google.
+	defaultGoogleCalendarID = "primary"
+
+	// googleEventDefaultDuration adalah durasi event untuk task tanpa estimasi.
+	googleEventDefaultDuration = 30 * time.Minute
+
+	// googleTokenRefreshMargin memperbarui access token sebelum benar-benar kedaluwarsa.
+	googleTokenRefreshMargin = time.Minute
+
+	// googleCalendarSettleTime melewati task yang baru berubah saat rekonsiliasi, karena event-nya
+	// sedang ditulis oleh notifier; tanpa ini keduanya bisa membuat dua event untuk task yang sama.
+	googleCalendarSettleTime = time.Minute
+)
+
+// ConnectGoogleCalendarInput adalah token OAuth pengguna dengan scope
+// https://www.googleapis.com/auth/calendar.events.
+type ConnectGoogleCalendarInput struct {
+	AccessToken  string
+	RefreshToken string     // Opsional; membutuhkan GOOGLE_CLIENT_ID dan GOOGLE_CLIENT_SECRET
+	ExpiresAt    *time.Time // Opsional: masa berlaku AccessToken
+	CalendarID   string     // Kosong berarti kalender utama
+}
+
+// GoogleCalendarApplicationService mendefinisikan use case sinkronisasi dua arah dengan Google
+// Calendar: task bertenggat ditulis sebagai event, dan perubahan judul, deskripsi, atau waktu event
+// di Google dibaca kembali ke task.
+type GoogleCalendarApplicationService interface {
+	GetConnection(ctx context.Context, userID domain.UserID) (*domain.GoogleCalendarConnection, error)
+
+	// Connect memverifikasi akses ke kalender sebelum menyimpan koneksi. Event dibuat pada
+	// sinkronisasi berikutnya.
+	Connect(ctx context.Context, userID domain.UserID, input ConnectGoogleCalendarInput) (*domain.GoogleCalendarConnection, error)
+
+	// Disconnect menghapus koneksi dan pemetaan event; event yang sudah ada di Google dibiarkan.
+	Disconnect(ctx context.Context, userID domain.UserID) error
+
+	// Sync menjalankan satu putaran rekonsiliasi untuk pengguna.
+	Sync(ctx context.Context, userID domain.UserID) (*domain.GoogleCalendarConnection, error)
+
+	// RunPeriodically menjalankan rekonsiliasi semua koneksi setiap interval sampai ctx dibatalkan.
+	RunPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// googleCalendarService adalah implementasi dari GoogleCalendarApplicationService.
+type googleCalendarService struct {
+	connRepo    domain.GoogleCalendarConnectionRepository
+	linkRepo    domain.GoogleCalendarLinkRepository
+	client      domain.GoogleCalendarClient
+	taskRepo    domain.TaskRepository
+	taskService TaskApplicationService
+}
+
+// NewGoogleCalendarService adalah constructor untuk googleCalendarService. Perubahan dari Google
+// ditulis lewat taskService agar validasi dan event task tetap berlaku.
+func NewGoogleCalendarService(
+	connRepo domain.GoogleCalendarConnectionRepository,
+	linkRepo domain.GoogleCalendarLinkRepository,
+	client domain.GoogleCalendarClient,
+	taskRepo domain.TaskRepository,
+	taskService TaskApplicationService,
+) GoogleCalendarApplicationService {
+	return &googleCalendarService{
+		connRepo:    connRepo,
+		linkRepo:    linkRepo,
+		client:      client,
+		taskRepo:    taskRepo,
+		taskService: taskService,
+	}
+}
+
+// GetConnection mengembalikan koneksi Google Calendar milik pengguna.
+func (s *googleCalendarService) GetConnection(ctx context.Context, userID domain.UserID) (*domain.GoogleCalendarConnection, error) {
+	return s.connRepo.FindByUserID(ctx, userID)
+}
+
+// Connect menyimpan ID kalender hasil verifikasi, sehingga "primary" dan alamat email akun yang
+// sama dianggap kalender yang sama.
+func (s *googleCalendarService) Connect(ctx context.Context, userID domain.UserID, input ConnectGoogleCalendarInput) (*domain.GoogleCalendarConnection, error) {
+	accessToken := strings.TrimSpace(input.AccessToken)
+	if accessToken == "" {
+		return nil, fmt.Errorf("%w: access_token is required", domain.ErrInvalidGoogleCalendarConnection)
+	}
+	calendarID := strings.TrimSpace(input.CalendarID)
+	if calendarID == "" {
+		calendarID = defaultGoogleCalendarID
+	}
+
+	verifiedID, err := s.client.VerifyCalendar(ctx, accessToken, calendarID)
+	if errors.Is(err, domain.ErrGoogleCalendarUnauthorized) {
+		return nil, fmt.Errorf("%w: access token was rejected", domain.ErrInvalidGoogleCalendarConnection)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: calendar verification failed: %v", domain.ErrInvalidGoogleCalendarConnection, err)
+	}
+
+	conn := &domain.GoogleCalendarConnection{
+		UserID:         userID,
+		CalendarID:     verifiedID,
+		AccessToken:    accessToken,
+		RefreshToken:   strings.TrimSpace(input.RefreshToken),
+		TokenExpiresAt: input.ExpiresAt,
+		UpdatedAt:      time.Now(),
+	}
+	if err := s.connRepo.Save(ctx, conn); err != nil {
+		return nil, err
+	}
+	return s.connRepo.FindByUserID(ctx, userID)
+}
+
+// Disconnect menghapus koneksi Google Calendar milik pengguna.
+func (s *googleCalendarService) Disconnect(ctx context.Context, userID domain.UserID) error {
+	return s.connRepo.Delete(ctx, userID)
+}
+
+// Sync merekonsiliasi koneksi pengguna lalu mengembalikan status sinkronisasinya. Error
+// sinkronisasi dicatat di LastError, bukan dikembalikan.
+func (s *googleCalendarService) Sync(ctx context.Context, userID domain.UserID) (*domain.GoogleCalendarConnection, error) {
+	conn, err := s.connRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reconcile(ctx, conn); err != nil {
+		slog.ErrorContext(ctx, "error syncing google calendar", "user_id", userID, "error", err)
+	}
+	return s.connRepo.FindByUserID(ctx, userID)
+}
+
+// RunPeriodically merekonsiliasi semua koneksi berkala. Error hanya di-log dan dicoba lagi di
+// putaran berikutnya.
+func (s *googleCalendarService) RunPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			conns, err := s.connRepo.FindAll(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error loading google calendar connections", "error", err)
+				continue
+			}
+			for _, conn := range conns {
+				if err := s.reconcile(ctx, conn); err != nil {
+					slog.ErrorContext(ctx, "error syncing google calendar", "user_id", conn.UserID, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// reconcile membaca perubahan dari Google lalu menulis task yang belum tersinkron, misalnya karena
+// antrean notifier penuh atau Google sempat tidak bisa dihubungi. Hasilnya disimpan di koneksi.
+func (s *googleCalendarService) reconcile(ctx context.Context, conn *domain.GoogleCalendarConnection) error {
+	accessToken, err := googleCalendarToken(ctx, s.connRepo, s.client, conn)
+	syncToken := conn.SyncToken
+	if err == nil {
+		syncToken, err = s.pull(ctx, conn, accessToken)
+	}
+	if err == nil {
+		err = s.push(ctx, conn, accessToken)
+	}
+	lastError := ""
+	if err != nil {
+		lastError = err.Error()
+	}
+	if saveErr := s.connRepo.UpdateSyncState(ctx, conn.UserID, syncToken, time.Now(), lastError); saveErr != nil {
+		return saveErr
+	}
+	return err
+}
+
+// pull menerapkan perubahan event yang dibuat service ini ke task-nya dan mengembalikan sync
+// token berikutnya. Event lain di kalender diabaikan. Event yang dihapus di Google menghapus tenggat
+// task, bukan task-nya.
+func (s *googleCalendarService) pull(ctx context.Context, conn *domain.GoogleCalendarConnection, accessToken string) (string, error) {
+	events, syncToken, err := s.client.ListChanges(ctx, accessToken, conn.CalendarID, conn.SyncToken)
+	if errors.Is(err, domain.ErrGoogleCalendarSyncTokenExpired) {
+		events, syncToken, err = s.client.ListChanges(ctx, accessToken, conn.CalendarID, "")
+	}
+	if err != nil {
+		return conn.SyncToken, err
+	}
+	links, err := s.linkRepo.FindByUserID(ctx, conn.UserID)
+	if err != nil {
+		return conn.SyncToken, err
+	}
+	byEvent := make(map[string]*domain.GoogleCalendarLink, len(links))
+	for _, link := range links {
+		byEvent[link.EventID] = link
+	}
+
+	var failed []error
+	for _, event := range events {
+		link, ok := byEvent[event.ID]
+		if !ok {
+			continue
+		}
+		if err := s.applyEvent(ctx, conn, accessToken, link, event); err != nil {
+			failed = append(failed, fmt.Errorf("task %s: %w", link.TaskID, err))
+		}
+	}
+	// Sync token tetap maju agar satu task yang gagal tidak menahan sinkronisasi task lain.
+	return syncToken, syncFailures(failed)
+}
+
+// applyEvent menerapkan satu event ke task-nya. Hanya field yang berbeda yang diubah, sehingga
+// event yang baru ditulis dari task tidak mengubah task lagi.
+func (s *googleCalendarService) applyEvent(ctx context.Context, conn *domain.GoogleCalendarConnection, accessToken string, link *domain.GoogleCalendarLink, event domain.GoogleCalendarEvent) error {
+	task, err := s.taskRepo.FindByID(ctx, link.TaskID)
+	if errors.Is(err, domain.ErrTaskNotFound) {
+		return pushGoogleCalendarTask(ctx, s.linkRepo, s.client, conn, accessToken, link.TaskID, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	var input UpdateTaskInput
+	changed := false
+	if event.Cancelled {
+		if err := s.linkRepo.Delete(ctx, conn.UserID, link.TaskID); err != nil {
+			return err
+		}
+		if task.DueAt == nil {
+			return nil
+		}
+		noDue := ""
+		input.DueText, changed = &noDue, true
+	} else {
+		if event.Summary != "" && event.Summary != task.Title {
+			input.Title, changed = &event.Summary, true
+		}
+		if event.Description != task.Description {
+			input.Description, changed = &event.Description, true
+		}
+		if due, ok := googleEventDue(task, event); ok {
+			input.DueText, input.DueLocation, changed = &due, time.UTC, true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	_, err = s.taskService.UpdateTask(ctx, conn.UserID, task.ID, input)
+	return err
+}
+
+// googleEventDue mengembalikan teks tenggat untuk waktu mulai event jika berbeda dari tenggat
+// task. Perbandingan per menit karena teks tenggat tidak memuat detik. Event sehari penuh
+// menjadi tenggat akhir hari di zona waktu pengguna.
+func googleEventDue(task *domain.Task, event domain.GoogleCalendarEvent) (string, bool) {
+	if event.AllDay {
+		return event.Start.Format("2006-01-02"), true
+	}
+	if task.DueAt != nil && task.DueAt.Truncate(time.Minute).Equal(event.Start.Truncate(time.Minute)) {
+		return "", false
+	}
+	return event.Start.UTC().Format("2006-01-02 15:04"), true
+}
+
+// push menulis event untuk task bertenggat yang berubah sejak terakhir ditulis, dan menghapus
+// event untuk task yang sudah dihapus, diarsipkan, atau tidak bertenggat lagi.
+func (s *googleCalendarService) push(ctx context.Context, conn *domain.GoogleCalendarConnection, accessToken string) error {
+	now := time.Now()
+	tasks, err := s.taskRepo.FindDueByUserID(ctx, conn.UserID, now.Add(-calendarFeedLookback), maxCalendarFeedTasks)
+	if err != nil {
+		return err
+	}
+	links, err := s.linkRepo.FindByUserID(ctx, conn.UserID)
+	if err != nil {
+		return err
+	}
+	byTask := make(map[string]*domain.GoogleCalendarLink, len(links))
+	for _, link := range links {
+		byTask[link.TaskID] = link
+	}
+
+	var failed []error
+	for _, task := range tasks {
+		link, linked := byTask[task.ID]
+		delete(byTask, task.ID)
+		if (linked && !link.SyncedAt.Before(task.UpdatedAt)) || now.Sub(task.UpdatedAt) < googleCalendarSettleTime {
+			continue
+		}
+		if err := pushGoogleCalendarTask(ctx, s.linkRepo, s.client, conn, accessToken, task.ID, task); err != nil {
+			failed = append(failed, fmt.Errorf("task %s: %w", task.ID, err))
+		}
+	}
+
+	// Pemetaan sisanya milik task di luar jendela FindDueByUserID: tenggatnya sudah lama lewat,
+	// atau task-nya dihapus, diarsipkan, atau tidak bertenggat lagi.
+	for _, link := range byTask {
+		task, err := s.taskRepo.FindByID(ctx, link.TaskID)
+		if err != nil && !errors.Is(err, domain.ErrTaskNotFound) {
+			failed = append(failed, fmt.Errorf("task %s: %w", link.TaskID, err))
+			continue
+		}
+		if task != nil && task.DueAt != nil && !task.Archived {
+			continue
+		}
+		if err := pushGoogleCalendarTask(ctx, s.linkRepo, s.client, conn, accessToken, link.TaskID, task); err != nil {
+			failed = append(failed, fmt.Errorf("task %s: %w", link.TaskID, err))
+		}
+	}
+	return syncFailures(failed)
+}
+
+// syncFailures meringkas error per task menjadi satu error; nil jika tidak ada yang gagal.
+func syncFailures(failed []error) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d tasks failed to sync, last error: %w", len(failed), failed[len(failed)-1])
+}
+
+// googleCalendarToken mengembalikan access token koneksi, diperbarui lebih dulu dengan refresh
+// token jika hampir kedaluwarsa.
+func googleCalendarToken(ctx context.Context, connRepo domain.GoogleCalendarConnectionRepository, client domain.GoogleCalendarClient, conn *domain.GoogleCalendarConnection) (string, error) {
+	if conn.RefreshToken == "" || conn.TokenExpiresAt == nil || time.Until(*conn.TokenExpiresAt) > googleTokenRefreshMargin {
+		return conn.AccessToken, nil
+	}
+	accessToken, expiresAt, err := client.RefreshAccessToken(ctx, conn.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("error refreshing google access token: %w", err)
+	}
+	if err := connRepo.UpdateToken(ctx, conn.UserID, accessToken, expiresAt); err != nil {
+		return "", err
+	}
+	conn.AccessToken, conn.TokenExpiresAt = accessToken, &expiresAt
+	return accessToken, nil
+}
+
+// pushGoogleCalendarTask membuat, mengganti, atau menghapus event Google untuk task. task nil
+// berarti task sudah dihapus. Event hanya ada untuk task bertenggat yang belum diarsipkan; event
+// yang dihapus pengguna di Google dibuat ulang jika task-nya berubah lagi.
+func pushGoogleCalendarTask(ctx context.Context, linkRepo domain.GoogleCalendarLinkRepository, client domain.GoogleCalendarClient, conn *domain.GoogleCalendarConnection, accessToken, taskID string, task *domain.Task) error {
+	link, err := linkRepo.FindByTaskID(ctx, conn.UserID, taskID)
+	if err != nil && !errors.Is(err, domain.ErrGoogleCalendarLinkNotFound) {
+		return err
+	}
+
+	if task == nil || task.DueAt == nil || task.Archived {
+		if link == nil {
+			return nil
+		}
+		err := client.DeleteEvent(ctx, accessToken, conn.CalendarID, link.EventID)
+		if err != nil && !errors.Is(err, domain.ErrGoogleCalendarEventNotFound) {
+			return err
+		}
+		return linkRepo.Delete(ctx, conn.UserID, taskID)
+	}
+
+	event := googleCalendarEvent(task)
+	if link != nil {
+		event.ID = link.EventID
+		err = client.UpdateEvent(ctx, accessToken, conn.CalendarID, event)
+		if err == nil {
+			link.SyncedAt = time.Now()
+			return linkRepo.Save(ctx, link)
+		}
+		if !errors.Is(err, domain.ErrGoogleCalendarEventNotFound) {
+			return err
+		}
+	}
+	eventID, err := client.InsertEvent(ctx, accessToken, conn.CalendarID, event)
+	if err != nil {
+		return err
+	}
+	return linkRepo.Save(ctx, &domain.GoogleCalendarLink{
+		UserID:   conn.UserID,
+		TaskID:   task.ID,
+		EventID:  eventID,
+		SyncedAt: time.Now(),
+	})
+}
+
+// googleCalendarEvent memetakan task bertenggat ke event yang dimulai pada tenggatnya, dengan
+// durasi estimasi task jika ada.
+func googleCalendarEvent(task *domain.Task) domain.GoogleCalendarEvent {
+	duration := googleEventDefaultDuration
+	if task.EstimateMinutes != nil && *task.EstimateMinutes > 0 {
+		duration = time.Duration(*task.EstimateMinutes) * time.Minute
+	}
+	return domain.GoogleCalendarEvent{
+		TaskID:      task.ID,
+		Summary:     task.Title,
+		Description: task.Description,
+		Start:       *task.DueAt,
+		End:         task.DueAt.Add(duration),
+	}
+}
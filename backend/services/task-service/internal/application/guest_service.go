@@ -0,0 +1,182 @@
+// file: backend/services/task-service/internal/application/guest_service.go
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	// DefaultGuestTokenTTL adalah masa berlaku token tamu jika tidak diatur.
+	DefaultGuestTokenTTL = 30 * 24 * time.Hour
+
+	// DefaultGuestMaxTasks adalah jumlah task maksimum per tamu jika tidak diatur.
+	DefaultGuestMaxTasks = 100
+)
+
+// GuestApplicationService mendefinisikan use case mode tamu: klien anonim membuat task di bawah ID
+// tamu, lalu setelah signup task tersebut dipindahkan ke akun pengguna. Semua method mengembalikan
+// ErrGuestModeDisabled jika mode tamu tidak aktif, kecuali PurgeExpired.
+type GuestApplicationService interface {
+	// CreateGuest menerbitkan ID tamu baru beserta token yang ditandatangani. Tamu hanya bisa
+	// membuat task sampai batas maxTasks; task berikutnya ditolak dengan ErrTaskLimitReached.
+	CreateGuest(ctx context.Context) (*domain.GuestSession, error)
+
+	// Authenticate mengembalikan ID tamu dari token, ErrInvalidGuestToken jika token tidak valid
+	// atau kedaluwarsa, atau ErrGuestAlreadyMerged jika tamu sudah digabung.
+	Authenticate(ctx context.Context, token string) (domain.UserID, error)
+
+	// Merge memindahkan semua task tamu pemilik guestToken ke userID secara atomik. Mengembalikan
+	// ErrInvalidGuestToken, ErrGuestAlreadyMerged, atau ErrGuestMergeForbidden jika userID sendiri
+	// adalah tamu.
+	Merge(ctx context.Context, userID domain.UserID, guestToken string) (*domain.GuestMerge, error)
+
+	// PurgeExpired menghapus task dan baris counter tamu yang tokennya sudah kedaluwarsa, sehingga
+	// tamu yang tidak pernah digabung tidak tersimpan selamanya. Tetap berjalan saat mode tamu
+	// dinonaktifkan, agar tamu yang dibuat sebelumnya ikut dibersihkan.
+	PurgeExpired(ctx context.Context) (int, error)
+
+	// RunPurgePeriodically menjalankan PurgeExpired setiap interval sampai ctx dibatalkan.
+	RunPurgePeriodically(ctx context.Context, interval time.Duration)
+}
+
+// guestService adalah implementasi dari GuestApplicationService.
+type guestService struct {
+	signer    domain.GuestTokenSigner
+	mergeRepo domain.GuestMergeRepository
+	taskRepo  domain.TaskRepository
+	idGen     domain.IDGenerator
+	ttl       time.Duration
+	maxTasks  int64
+}
+
+// NewGuestService adalah constructor untuk guestService. signer nil berarti mode tamu tidak aktif;
+// ttl <= 0 berarti DefaultGuestTokenTTL dan maxTasks <= 0 berarti DefaultGuestMaxTasks.
+func NewGuestService(signer domain.GuestTokenSigner, mergeRepo domain.GuestMergeRepository, taskRepo domain.TaskRepository, idGen domain.IDGenerator, ttl time.Duration, maxTasks int64) GuestApplicationService {
+	if ttl <= 0 {
+		ttl = DefaultGuestTokenTTL
+	}
+	if maxTasks <= 0 {
+		maxTasks = DefaultGuestMaxTasks
+	}
+	return &guestService{
+		signer:    signer,
+		mergeRepo: mergeRepo,
+		taskRepo:  taskRepo,
+		idGen:     idGen,
+		ttl:       ttl,
+		maxTasks:  maxTasks,
+	}
+}
+
+// CreateGuest menyimpan batas task tamu sebelum menerbitkan token, sehingga tidak ada tamu yang
+// bisa membuat task tanpa batas.
+func (s *guestService) CreateGuest(ctx context.Context) (*domain.GuestSession, error) {
+	if s.signer == nil {
+		return nil, domain.ErrGuestModeDisabled
+	}
+	guestID := domain.UserID(domain.GuestUserIDPrefix + s.idGen.NewID())
+	expiresAt := time.Now().UTC().Add(s.ttl).Truncate(time.Second)
+	if err := s.taskRepo.SetTaskLimit(ctx, guestID, s.maxTasks, expiresAt); err != nil {
+		return nil, err
+	}
+	token, err := s.signer.Sign(guestID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "guest created", "guest_id", guestID)
+	return &domain.GuestSession{
+		GuestID:   guestID,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Authenticate memeriksa tanda tangan token lebih dulu, sehingga token palsu tidak sampai ke
+// database.
+func (s *guestService) Authenticate(ctx context.Context, token string) (domain.UserID, error) {
+	if s.signer == nil {
+		return "", domain.ErrGuestModeDisabled
+	}
+	guestID, err := s.signer.Verify(token, time.Now())
+	if err != nil {
+		return "", err
+	}
+	merged, err := s.mergeRepo.IsMerged(ctx, guestID)
+	if err != nil {
+		return "", err
+	}
+	if merged {
+		return "", domain.ErrGuestAlreadyMerged
+	}
+	return guestID, nil
+}
+
+// Merge hanya menerima token tamu yang masih berlaku, sehingga token lama yang bocor tidak bisa
+// dipakai untuk mengambil task tamu.
+func (s *guestService) Merge(ctx context.Context, userID domain.UserID, guestToken string) (*domain.GuestMerge, error) {
+	if s.signer == nil {
+		return nil, domain.ErrGuestModeDisabled
+	}
+	if domain.IsGuestUserID(userID) {
+		return nil, domain.ErrGuestMergeForbidden
+	}
+	guestID, err := s.signer.Verify(guestToken, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	merge := &domain.GuestMerge{
+		GuestID:  guestID,
+		UserID:   userID,
+		MergedAt: time.Now().UTC(),
+	}
+	if err := s.mergeRepo.Merge(ctx, merge); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "guest merged", "guest_id", guestID, "merged_tasks", merge.MergedTasks)
+	return merge, nil
+}
+
+// PurgeExpired menghapus paling banyak purgeBatchSize tamu per pemanggilan. Setelah expiresAt,
+// token tamu ditolak Authenticate maupun Merge, sehingga task-nya tidak bisa diakses lagi. Tamu
+// yang sudah digabung tidak punya baris counter lagi (lihat GuestMergeRepository.Merge).
+func (s *guestService) PurgeExpired(ctx context.Context) (int, error) {
+	guestIDs, err := s.taskRepo.FindExpiredTaskLimits(ctx, time.Now(), purgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, guestID := range guestIDs {
+		if !domain.IsGuestUserID(guestID) {
+			continue // Batas berwaktu hanya dipasang untuk tamu; pengguna lain tidak pernah dihapus di sini
+		}
+		if _, err := s.taskRepo.DeleteByUserID(ctx, guestID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// RunPurgePeriodically menjalankan purge berkala. Error hanya di-log dan dicoba lagi di putaran berikutnya.
+func (s *guestService) RunPurgePeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.PurgeExpired(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error purging expired guests", "error", err)
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "purged expired guests", "count", purged)
+			}
+		}
+	}
+}
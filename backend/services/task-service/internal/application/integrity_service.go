@@ -0,0 +1,155 @@
+// file: backend/services/task-service/internal/application/integrity_service.go
+package application
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// integritySampleLimit adalah jumlah contoh ID maksimum per pemeriksaan di laporan.
+const integritySampleLimit = 10
+
+// Metrics integritas data, dipublikasikan lewat expvar:
+// integrity_findings berisi jumlah anomali tersisa per pemeriksaan pada run terakhir,
+// integrity_last_run_unix berisi waktu selesai run terakhir.
+var (
+	integrityFindings    = expvar.NewMap("integrity_findings")
+	integrityLastRunUnix = expvar.NewInt("integrity_last_run_unix")
+)
+
+// IntegrityApplicationService mendefinisikan use case pemeriksaan integritas data.
+type IntegrityApplicationService interface {
+	// RunChecks menjalankan semua pemeriksaan; jika repair bernilai true, anomali yang ditemukan
+	// langsung diperbaiki. Kegagalan satu pemeriksaan dicatat di laporan dan tidak menghentikan yang lain.
+	RunChecks(ctx context.Context, repair bool) *domain.IntegrityReport
+
+	// RunChecksAsAdmin sama dengan RunChecks, tetapi dipicu admin dan dicatat di audit log.
+	// reason wajib diisi.
+	RunChecksAsAdmin(ctx context.Context, adminID domain.UserID, reason string, repair bool) (*domain.IntegrityReport, error)
+
+	// LastReport mengembalikan laporan run terakhir. ok bernilai false jika belum pernah dijalankan.
+	LastReport() (report *domain.IntegrityReport, ok bool)
+
+	// RunPeriodically menjalankan RunChecks setiap interval sampai ctx dibatalkan.
+	RunPeriodically(ctx context.Context, interval time.Duration, repair bool)
+}
+
+// integrityService adalah implementasi dari IntegrityApplicationService.
+type integrityService struct {
+	checks    []domain.IntegrityCheck
+	auditRepo domain.AdminAuditRepository
+
+	runMu sync.Mutex // Mencegah dua run (periodik dan admin) berjalan bersamaan
+
+	mu   sync.RWMutex
+	last *domain.IntegrityReport
+}
+
+// NewIntegrityService adalah constructor untuk integrityService.
+func NewIntegrityService(checks []domain.IntegrityCheck, auditRepo domain.AdminAuditRepository) IntegrityApplicationService {
+	return &integrityService{
+		checks:    checks,
+		auditRepo: auditRepo,
+	}
+}
+
+// RunChecks menjalankan semua pemeriksaan secara berurutan lalu memperbarui metrics.
+func (s *integrityService) RunChecks(ctx context.Context, repair bool) *domain.IntegrityReport {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	report := &domain.IntegrityReport{StartedAt: time.Now(), Repair: repair}
+	for _, check := range s.checks {
+		report.Findings = append(report.Findings, runIntegrityCheck(ctx, check, repair))
+	}
+	report.FinishedAt = time.Now()
+
+	for _, finding := range report.Findings {
+		remaining := new(expvar.Int)
+		remaining.Set(finding.Remaining)
+		integrityFindings.Set(finding.Check, remaining)
+		if finding.Error != "" || finding.Count > 0 {
+			slog.InfoContext(ctx, "integrity check finding", "check", finding.Check, "found", finding.Count,
+				"repaired", finding.Repaired, "remaining", finding.Remaining, "error", finding.Error)
+		}
+	}
+	integrityLastRunUnix.Set(report.FinishedAt.Unix())
+
+	s.mu.Lock()
+	s.last = report
+	s.mu.Unlock()
+	return report
+}
+
+// runIntegrityCheck menjalankan satu pemeriksaan. Setelah perbaikan, pemeriksaan diulang
+// untuk mengisi Remaining dengan jumlah anomali yang benar-benar tersisa.
+func runIntegrityCheck(ctx context.Context, check domain.IntegrityCheck, repair bool) domain.IntegrityFinding {
+	finding := domain.IntegrityFinding{Check: check.Name()}
+	count, sample, err := check.Find(ctx, integritySampleLimit)
+	if err != nil {
+		finding.Error = err.Error()
+		return finding
+	}
+	finding.Count, finding.Sample, finding.Remaining = count, sample, count
+	if !repair || count == 0 {
+		return finding
+	}
+
+	if finding.Repaired, err = check.Repair(ctx); err != nil {
+		finding.Error = err.Error()
+		return finding
+	}
+	if finding.Remaining, _, err = check.Find(ctx, 0); err != nil {
+		finding.Error = err.Error()
+	}
+	return finding
+}
+
+// RunChecksAsAdmin mencatat audit log sebelum pemeriksaan dijalankan, sehingga perbaikan
+// data oleh admin selalu tercatat meskipun run gagal di tengah jalan.
+func (s *integrityService) RunChecksAsAdmin(ctx context.Context, adminID domain.UserID, reason string, repair bool) (*domain.IntegrityReport, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, domain.ErrAuditReasonRequired
+	}
+	err := s.auditRepo.Record(ctx, domain.AdminAuditEntry{
+		AdminID:    adminID,
+		Action:     "admin.integrity.run",
+		Reason:     reason,
+		Details:    map[string]any{"repair": repair},
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error writing admin audit log: %w", err)
+	}
+	return s.RunChecks(ctx, repair), nil
+}
+
+// LastReport mengembalikan laporan run terakhir.
+func (s *integrityService) LastReport() (*domain.IntegrityReport, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.last, s.last != nil
+}
+
+// RunPeriodically menjalankan pemeriksaan pertama setelah satu interval, agar startup
+// banyak replika sekaligus tidak langsung membebani database.
+func (s *integrityService) RunPeriodically(ctx context.Context, interval time.Duration, repair bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunChecks(ctx, repair)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// file: backend/services/task-service/internal/application/list_share_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// maxCollaboratorIDLength adalah panjang ID kolaborator maksimum.
+const maxCollaboratorIDLength = 255
+
+// TaskAuthorizer dipakai oleh use case lain untuk memeriksa akses pengguna ke task milik pengguna
+// lain, menggantikan pemeriksaan task.UserID == userID.
+type TaskAuthorizer interface {
+	// AuthorizeList mengembalikan nil jika userID adalah ownerID atau punya akses need ke daftar
+	// ownerID. Mengembalikan ErrListShareNotFound jika tidak punya akses, ErrListReadOnly jika
+	// hanya punya akses baca untuk operasi yang membutuhkan akses tulis, atau ErrTokenListForbidden
+	// jika request dibatasi ke daftar lain (lihat domain.ContextWithAllowedLists).
+	AuthorizeList(ctx context.Context, userID, ownerID domain.UserID, need domain.ListAccess) error
+
+	// AuthorizeTask sama dengan AuthorizeList untuk pemilik task, tetapi mengembalikan
+	// ErrTaskNotFound jika tidak punya akses agar keberadaan task tidak bocor.
+	AuthorizeTask(ctx context.Context, userID domain.UserID, task *domain.Task, need domain.ListAccess) error
+}
+
+// ListShareApplicationService mendefinisikan use case berbagi daftar task dengan kolaborator.
+type ListShareApplicationService interface {
+	TaskAuthorizer
+
+	// ShareList memberi atau mengubah akses collaboratorID ke daftar ownerID.
+	ShareList(ctx context.Context, ownerID, collaboratorID domain.UserID, access domain.ListAccess) (*domain.ListShare, error)
+
+	// UnshareList mencabut akses kolaborator. Dipanggil pemilik, atau kolaborator itu sendiri
+	// untuk keluar dari daftar.
+	UnshareList(ctx context.Context, ownerID, collaboratorID domain.UserID) error
+	ListCollaborators(ctx context.Context, ownerID domain.UserID) ([]*domain.ListShare, error)
+
+	// ListSharedWithMe mengembalikan daftar milik pengguna lain yang bisa diakses userID.
+	ListSharedWithMe(ctx context.Context, userID domain.UserID) ([]*domain.ListShare, error)
+}
+
+// listShareService adalah implementasi dari ListShareApplicationService.
+type listShareService struct {
+	shareRepo domain.ListShareRepository
+}
+
+// NewListShareService adalah constructor untuk listShareService.
+func NewListShareService(shareRepo domain.ListShareRepository) ListShareApplicationService {
+	return &listShareService{
+		shareRepo: shareRepo,
+	}
+}
+
+// AuthorizeList membaca akses dari tabel list_shares; pemilik selalu punya akses penuh, kecuali
+// token yang dipakai tidak mencakup daftarnya.
+func (s *listShareService) AuthorizeList(ctx context.Context, userID, ownerID domain.UserID, need domain.ListAccess) error {
+	if !domain.ListAllowedFromContext(ctx, ownerID) {
+		return domain.ErrTokenListForbidden
+	}
+	if userID == ownerID {
+		return nil
+	}
+	access, err := s.shareRepo.FindAccess(ctx, ownerID, userID)
+	if err != nil {
+		return err
+	}
+	if !access.Allows(need) {
+		return domain.ErrListReadOnly
+	}
+	return nil
+}
+
+// AuthorizeTask memeriksa akses ke daftar pemilik task.
+func (s *listShareService) AuthorizeTask(ctx context.Context, userID domain.UserID, task *domain.Task, need domain.ListAccess) error {
+	err := s.AuthorizeList(ctx, userID, task.UserID, need)
+	if errors.Is(err, domain.ErrListShareNotFound) {
+		return domain.ErrTaskNotFound
+	}
+	return err
+}
+
+// readableTasks menyaring tasks menjadi task yang boleh dibaca userID. Akses diperiksa sekali per
+// pemilik daftar, dan task tanpa akses dilewati tanpa error.
+func readableTasks(ctx context.Context, access TaskAuthorizer, userID domain.UserID, tasks []*domain.Task) ([]*domain.Task, error) {
+	allowed := make(map[domain.UserID]bool)
+	readable := make([]*domain.Task, 0, len(tasks))
+	for _, task := range tasks {
+		ok, checked := allowed[task.UserID]
+		if !checked {
+			err := access.AuthorizeTask(ctx, userID, task, domain.ListAccessRead)
+			if err != nil && !errors.Is(err, domain.ErrTaskNotFound) && !errors.Is(err, domain.ErrTokenListForbidden) {
+				return nil, err
+			}
+			ok = err == nil
+			allowed[task.UserID] = ok
+		}
+		if ok {
+			readable = append(readable, task)
+		}
+	}
+	return readable, nil
+}
+
+// ShareList memvalidasi kolaborator lalu menyimpan aksesnya.
+func (s *listShareService) ShareList(ctx context.Context, ownerID, collaboratorID domain.UserID, access domain.ListAccess) (*domain.ListShare, error) {
+	if collaboratorID == "" || len(collaboratorID) > maxCollaboratorIDLength {
+		return nil, fmt.Errorf("%w: user_id is required", domain.ErrInvalidListShare)
+	}
+	if collaboratorID == ownerID {
+		return nil, fmt.Errorf("%w: a list cannot be shared with its owner", domain.ErrInvalidListShare)
+	}
+	if err := access.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: access must be read or write", err)
+	}
+
+	shares, err := s.shareRepo.FindByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	exists := false
+	for _, share := range shares {
+		if share.CollaboratorID == collaboratorID {
+			exists = true
+		}
+	}
+	if !exists && len(shares) >= domain.MaxListCollaborators {
+		return nil, domain.ErrTooManyCollaborators
+	}
+
+	now := time.Now()
+	share := &domain.ListShare{
+		OwnerID:        ownerID,
+		CollaboratorID: collaboratorID,
+		Access:         access,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := s.shareRepo.Save(ctx, share); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+// UnshareList mencabut akses kolaborator.
+func (s *listShareService) UnshareList(ctx context.Context, ownerID, collaboratorID domain.UserID) error {
+	return s.shareRepo.Delete(ctx, ownerID, collaboratorID)
+}
+
+// ListCollaborators mengembalikan kolaborator daftar pemilik.
+func (s *listShareService) ListCollaborators(ctx context.Context, ownerID domain.UserID) ([]*domain.ListShare, error) {
+	return s.shareRepo.FindByOwner(ctx, ownerID)
+}
+
+// ListSharedWithMe mengembalikan daftar yang dibagikan kepada pengguna.
+func (s *listShareService) ListSharedWithMe(ctx context.Context, userID domain.UserID) ([]*domain.ListShare, error) {
+	return s.shareRepo.FindByCollaborator(ctx, userID)
+}
@@ -0,0 +1,304 @@
+// file: backend/services/task-service/internal/application/local_auth_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DefaultRefreshTokenTTL adalah masa berlaku refresh token jika LocalAuthConfig.RefreshTokenTTL nol.
+const DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// LocalAuthConfig adalah pengaturan mode autentikasi lokal.
+type LocalAuthConfig struct {
+	RefreshTokenTTL time.Duration // Dihitung ulang di setiap rotasi
+	SignupEnabled   bool          // false berarti akun hanya bisa dibuat langsung di database
+
+	// UnlockURL adalah halaman frontend untuk membuka kunci akun; token buka kunci ditambahkan
+	// sebagai query parameter token. Kosong berarti email buka kunci hanya berisi token-nya.
+	UnlockURL string
+}
+
+// LocalAuthApplicationService mendefinisikan use case login, refresh, dan logout untuk mode
+// autentikasi lokal. Semua method mengembalikan ErrLocalAuthNotConfigured jika mode ini tidak
+// aktif.
+type LocalAuthApplicationService interface {
+	// SignUp membuat akun lalu login. Mengembalikan ErrSignupDisabled, ErrInvalidSignup, atau
+	// ErrEmailTaken.
+	SignUp(ctx context.Context, email, password string) (*domain.AuthSession, error)
+
+	// Login mengembalikan ErrInvalidCredentials jika email atau password salah, atau
+	// LoginThrottledError jika akun atau IP klien sedang ditolak karena terlalu banyak login
+	// yang gagal.
+	Login(ctx context.Context, email, password string) (*domain.AuthSession, error)
+
+	// Unlock membuka kunci akun dengan token dari email buka kunci. Mengembalikan
+	// ErrInvalidUnlockToken jika token tidak dikenal, sudah dipakai, atau kedaluwarsa.
+	Unlock(ctx context.Context, token string) error
+
+	// Refresh merotasi refresh token. Mengembalikan ErrInvalidRefreshToken, atau
+	// ErrRefreshTokenReused jika token sudah pernah dirotasi (seluruh family-nya dicabut).
+	Refresh(ctx context.Context, refreshToken string) (*domain.AuthSession, error)
+
+	// Logout mencabut refresh token beserta family-nya. Token yang tidak dikenal diabaikan.
+	Logout(ctx context.Context, refreshToken string) error
+
+	// PurgeExpired menghapus refresh token yang sudah kedaluwarsa.
+	PurgeExpired(ctx context.Context) (int, error)
+
+	// RunPurgePeriodically menjalankan PurgeExpired, lalu menghapus penghitung login gagal yang
+	// sudah tidak berlaku, setiap interval sampai ctx dibatalkan.
+	RunPurgePeriodically(ctx context.Context, interval time.Duration)
+}
+
+// localAuthService adalah implementasi dari LocalAuthApplicationService.
+type localAuthService struct {
+	userRepo  domain.LocalUserRepository
+	tokenRepo domain.RefreshTokenRepository
+	hasher    domain.PasswordHasher
+	issuer    domain.AccessTokenIssuer
+	idGen     domain.IDGenerator
+	config    LocalAuthConfig
+	throttle  *loginThrottler
+
+	// dummyHash dibandingkan saat email tidak terdaftar, agar waktu respons login tidak
+	// membocorkan email mana yang terdaftar.
+	dummyHash string
+}
+
+// NewLocalAuthService adalah constructor untuk localAuthService. issuer nil berarti mode
+// autentikasi lokal tidak aktif. sender dipakai untuk email buka kunci akun.
+func NewLocalAuthService(userRepo domain.LocalUserRepository, tokenRepo domain.RefreshTokenRepository, throttleRepo domain.LoginThrottleRepository, hasher domain.PasswordHasher, issuer domain.AccessTokenIssuer, idGen domain.IDGenerator, sender domain.EmailSender, config LocalAuthConfig) LocalAuthApplicationService {
+	if config.RefreshTokenTTL <= 0 {
+		config.RefreshTokenTTL = DefaultRefreshTokenTTL
+	}
+	s := &localAuthService{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		hasher:    hasher,
+		issuer:    issuer,
+		idGen:     idGen,
+		config:    config,
+		throttle:  &loginThrottler{repo: throttleRepo, sender: sender, unlockURL: config.UnlockURL},
+	}
+	if issuer != nil {
+		if hash, err := hasher.Hash("dummy-password"); err == nil {
+			s.dummyHash = hash
+		}
+	}
+	return s
+}
+
+// SignUp menormalkan email menjadi huruf kecil sebelum disimpan.
+func (s *localAuthService) SignUp(ctx context.Context, email, password string) (*domain.AuthSession, error) {
+	if s.issuer == nil {
+		return nil, domain.ErrLocalAuthNotConfigured
+	}
+	if !s.config.SignupEnabled {
+		return nil, domain.ErrSignupDisabled
+	}
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if len(password) < domain.MinPasswordLength || len(password) > domain.MaxPasswordLength {
+		return nil, fmt.Errorf("%w: password must be between %d and %d bytes", domain.ErrInvalidSignup, domain.MinPasswordLength, domain.MaxPasswordLength)
+	}
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+	user := &domain.LocalUser{
+		ID:           domain.UserID(s.idGen.NewID()),
+		Email:        email,
+		PasswordHash: hash,
+		CreatedAt:    time.Now(),
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return s.issueSession(ctx, user, s.idGen.NewID())
+}
+
+// Login membandingkan password dengan hash tersimpan, atau dengan dummyHash jika email tidak
+// terdaftar. Percobaan dicatat sebagai kegagalan sebelum password diperiksa, sehingga login yang
+// sedang di-throttle ditolak tanpa membebani bcrypt dan login bersamaan tidak bisa melewati batas.
+func (s *localAuthService) Login(ctx context.Context, email, password string) (*domain.AuthSession, error) {
+	if s.issuer == nil {
+		return nil, domain.ErrLocalAuthNotConfigured
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	reserved, err := s.throttle.reserve(ctx, email, domain.ClientIPFromContext(ctx), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if errors.Is(err, domain.ErrLocalUserNotFound) {
+		s.hasher.Compare(s.dummyHash, password)
+		s.throttle.recordFailure(ctx, reserved, nil)
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		s.throttle.release(ctx, reserved)
+		return nil, err
+	}
+	if !s.hasher.Compare(user.PasswordHash, password) {
+		s.throttle.recordFailure(ctx, reserved, user)
+		return nil, domain.ErrInvalidCredentials
+	}
+	s.throttle.recordSuccess(ctx, email, reserved)
+	return s.issueSession(ctx, user, s.idGen.NewID())
+}
+
+// Unlock hanya menghapus penghitung akun; penghitung IP tetap berlaku.
+func (s *localAuthService) Unlock(ctx context.Context, token string) error {
+	if s.issuer == nil {
+		return domain.ErrLocalAuthNotConfigured
+	}
+	return s.throttle.unlock(ctx, token)
+}
+
+// Refresh menandai token lama terpakai sebelum menerbitkan token baru, sehingga dari dua refresh
+// bersamaan dengan token yang sama hanya satu yang berhasil dan yang lain dianggap pemakaian ulang.
+func (s *localAuthService) Refresh(ctx context.Context, refreshToken string) (*domain.AuthSession, error) {
+	if s.issuer == nil {
+		return nil, domain.ErrLocalAuthNotConfigured
+	}
+	token, err := s.tokenRepo.FindByHash(ctx, hashAccessToken(refreshToken))
+	if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if token.RevokedAt != nil || token.Expired(now) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if token.UsedAt != nil {
+		return nil, s.revokeReused(ctx, token, now)
+	}
+	marked, err := s.tokenRepo.MarkUsed(ctx, token.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !marked {
+		return nil, s.revokeReused(ctx, token, now)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, token.UserID)
+	if errors.Is(err, domain.ErrLocalUserNotFound) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.issueSession(ctx, user, token.FamilyID)
+}
+
+// revokeReused mencabut family token yang dipakai ulang dan mengembalikan ErrRefreshTokenReused.
+func (s *localAuthService) revokeReused(ctx context.Context, token *domain.RefreshToken, now time.Time) error {
+	slog.WarnContext(ctx, "refresh token reuse detected, revoking token family",
+		"user_id", token.UserID, "family_id", token.FamilyID)
+	if err := s.tokenRepo.RevokeFamily(ctx, token.FamilyID, now); err != nil {
+		return err
+	}
+	return domain.ErrRefreshTokenReused
+}
+
+// Logout tidak mencabut access token yang sudah diterbitkan; token tersebut tetap berlaku sampai
+// kedaluwarsa.
+func (s *localAuthService) Logout(ctx context.Context, refreshToken string) error {
+	if s.issuer == nil {
+		return domain.ErrLocalAuthNotConfigured
+	}
+	token, err := s.tokenRepo.FindByHash(ctx, hashAccessToken(refreshToken))
+	if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.tokenRepo.RevokeFamily(ctx, token.FamilyID, time.Now())
+}
+
+// PurgeExpired menghapus token yang kedaluwarsa, termasuk token terpakai yang masih dibutuhkan
+// untuk mendeteksi pemakaian ulang sampai saat itu.
+func (s *localAuthService) PurgeExpired(ctx context.Context) (int, error) {
+	return s.tokenRepo.DeleteExpired(ctx, time.Now())
+}
+
+// RunPurgePeriodically menjalankan purge berkala. Error hanya di-log dan dicoba lagi di putaran berikutnya.
+func (s *localAuthService) RunPurgePeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.PurgeExpired(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error purging expired refresh tokens", "error", err)
+				continue
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "purged expired refresh tokens", "count", purged)
+			}
+			stale, err := s.throttle.purgeStale(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error purging login throttles", "error", err)
+				continue
+			}
+			if stale > 0 {
+				slog.InfoContext(ctx, "purged login throttles", "count", stale)
+			}
+		}
+	}
+}
+
+// issueSession menerbitkan access token dan refresh token baru dalam familyID.
+func (s *localAuthService) issueSession(ctx context.Context, user *domain.LocalUser, familyID string) (*domain.AuthSession, error) {
+	now := time.Now()
+	accessToken, accessExpiresAt, err := s.issuer.IssueAccessToken(user, familyID, now)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	refresh := &domain.RefreshToken{
+		ID:        s.idGen.NewID(),
+		FamilyID:  familyID,
+		UserID:    user.ID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.config.RefreshTokenTTL),
+	}
+	if err := s.tokenRepo.Create(ctx, refresh, hashAccessToken(secret)); err != nil {
+		return nil, err
+	}
+	return &domain.AuthSession{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          secret,
+		RefreshTokenExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
+
+// normalizeEmail memvalidasi alamat email tanpa nama tampilan dan mengembalikannya dalam huruf kecil.
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || len(email) > domain.MaxEmailLength {
+		return "", fmt.Errorf("%w: email must be a valid address", domain.ErrInvalidSignup)
+	}
+	return email, nil
+}
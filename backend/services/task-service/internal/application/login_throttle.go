@@ -0,0 +1,219 @@
+// file: backend/services/task-service/internal/application/login_throttle.go
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	// loginThrottleWindow adalah lama kegagalan login diingat; penghitung dimulai lagi dari nol jika
+	// tidak ada kegagalan selama ini.
+	loginThrottleWindow = 24 * time.Hour
+
+	// unlockTokenTTL adalah masa berlaku token buka kunci yang dikirim lewat email.
+	unlockTokenTTL = 24 * time.Hour
+)
+
+// loginThrottleEvents menghitung kejadian throttling login sejak proses berjalan: failures (login
+// gagal), throttled (login ditolak tanpa memeriksa password), lockouts (akun terkunci),
+// unlock_emails, dan unlocks.
+var loginThrottleEvents = expvar.NewMap("login_throttle_events")
+
+// loginThrottlePolicy menentukan jeda setelah sejumlah login gagal berturut-turut.
+type loginThrottlePolicy struct {
+	freeFailures int           // Kegagalan yang belum memicu jeda
+	maxDelay     time.Duration // Jeda terpanjang sebelum terkunci; jeda berlipat dua mulai dari 1 detik
+	lockoutAfter int           // Kegagalan yang membuat key terkunci selama lockout
+	lockout      time.Duration
+}
+
+var (
+	accountLoginThrottle = loginThrottlePolicy{freeFailures: 3, maxDelay: time.Minute, lockoutAfter: 10, lockout: 15 * time.Minute}
+
+	// ipLoginThrottle lebih longgar, karena banyak pengguna bisa berbagi satu IP (NAT kantor atau
+	// kampus), tetapi tetap membatasi satu IP yang mencoba banyak akun.
+	ipLoginThrottle = loginThrottlePolicy{freeFailures: 10, maxDelay: time.Minute, lockoutAfter: 50, lockout: time.Hour}
+)
+
+// delay mengembalikan lama login ditolak setelah kegagalan ke-failures.
+func (p loginThrottlePolicy) delay(failures int) time.Duration {
+	switch {
+	case failures >= p.lockoutAfter:
+		return p.lockout
+	case failures <= p.freeFailures:
+		return 0
+	}
+	return min(time.Second<<(failures-p.freeFailures-1), p.maxDelay)
+}
+
+// loginThrottler membatasi login akun lokal yang gagal per akun dan per IP, lalu mengirim email
+// buka kunci saat akun terkunci.
+type loginThrottler struct {
+	repo      domain.LoginThrottleRepository
+	sender    domain.EmailSender
+	unlockURL string
+}
+
+const ipThrottleKeyPrefix = "ip:"
+
+func accountThrottleKey(email string) string {
+	return "account:" + email
+}
+
+// throttleKeys mengembalikan key akun dan key IP; IP kosong (request tanpa withClientIP) dilewati.
+func throttleKeys(email, ip string) []string {
+	keys := []string{accountThrottleKey(email)}
+	if ip != "" {
+		keys = append(keys, ipThrottleKeyPrefix+ip)
+	}
+	return keys
+}
+
+// throttleDelay memilih kebijakan dari jenis key.
+func throttleDelay(key string, failures int) time.Duration {
+	if strings.HasPrefix(key, ipThrottleKeyPrefix) {
+		return ipLoginThrottle.delay(failures)
+	}
+	return accountLoginThrottle.delay(failures)
+}
+
+// reserve mencatat percobaan login sebagai kegagalan sebelum password diperiksa, sehingga login
+// bersamaan tidak bisa melewati batas. Mengembalikan domain.LoginThrottledError jika akun atau IP
+// sedang ditolak. Hasilnya diteruskan ke recordFailure, recordSuccess, atau release.
+func (t *loginThrottler) reserve(ctx context.Context, email, ip string, now time.Time) ([]domain.LoginThrottle, error) {
+	reserved, err := t.repo.Reserve(ctx, throttleKeys(email, ip), now, now.Add(-loginThrottleWindow), throttleDelay)
+	if errors.Is(err, domain.ErrLoginThrottled) {
+		loginThrottleEvents.Add("throttled", 1)
+	}
+	return reserved, err
+}
+
+// recordFailure menangani login yang gagal setelah reserve: kegagalannya sudah dihitung, sehingga
+// yang tersisa hanya mencatat lockout. user nil berarti email tidak terdaftar; penghitungnya tetap
+// dicatat agar respons tidak membedakan email yang terdaftar, tetapi email buka kunci tidak dikirim.
+func (t *loginThrottler) recordFailure(ctx context.Context, reserved []domain.LoginThrottle, user *domain.LocalUser) {
+	loginThrottleEvents.Add("failures", 1)
+	for _, throttle := range reserved {
+		isIP := strings.HasPrefix(throttle.Key, ipThrottleKeyPrefix)
+		policy := accountLoginThrottle
+		if isIP {
+			policy = ipLoginThrottle
+		}
+		if throttle.Failures < policy.lockoutAfter {
+			continue
+		}
+		loginThrottleEvents.Add("lockouts", 1)
+		if isIP {
+			slog.WarnContext(ctx, "login locked for ip", "ip", strings.TrimPrefix(throttle.Key, ipThrottleKeyPrefix), "failures", throttle.Failures)
+		} else if user != nil && throttle.BlockedUntil != nil {
+			slog.WarnContext(ctx, "login locked for local user", "user_id", user.ID, "failures", throttle.Failures)
+			// Dikirim di latar belakang agar waktu respons tidak membedakan email yang terdaftar.
+			go t.sendUnlockEmail(context.WithoutCancel(ctx), user, throttle.Key, throttle.Failures, *throttle.BlockedUntil)
+		}
+	}
+}
+
+// sendUnlockEmail membuat token buka kunci dan mengirimkannya ke pemilik akun. Tidak ada email
+// jika token sebelumnya masih berlaku.
+func (t *loginThrottler) sendUnlockEmail(ctx context.Context, user *domain.LocalUser, key string, failures int, lockedUntil time.Time) {
+	token := rand.Text()
+	now := time.Now()
+	saved, err := t.repo.SetUnlockToken(ctx, key, hashAccessToken(token), now.Add(unlockTokenTTL), now)
+	if err != nil {
+		slog.ErrorContext(ctx, "error saving unlock token", "user_id", user.ID, "error", err)
+		return
+	}
+	if !saved {
+		return
+	}
+	msg, err := renderEmail(user.Email, unlockEmailContent(t.unlockURL, token, failures, lockedUntil))
+	if err == nil {
+		err = t.sender.Send(ctx, msg)
+	}
+	if err != nil {
+		if !errors.Is(err, domain.ErrEmailNotConfigured) {
+			slog.ErrorContext(ctx, "error sending unlock email", "user_id", user.ID, "error", err)
+		}
+		return
+	}
+	loginThrottleEvents.Add("unlock_emails", 1)
+}
+
+// unlockEmailContent menyertakan link buka kunci jika unlockURL diisi, atau token-nya saja.
+func unlockEmailContent(unlockURL, token string, failures int, lockedUntil time.Time) emailContent {
+	action := "If this was you, unlock it now with this code: " + token
+	if unlockURL != "" {
+		separator := "?"
+		if strings.Contains(unlockURL, "?") {
+			separator = "&"
+		}
+		action = "If this was you, unlock it now: " + unlockURL + separator + "token=" + url.QueryEscape(token)
+	}
+	return emailContent{
+		Subject: "Your account has been locked",
+		Heading: "Too many failed sign-in attempts",
+		Details: []string{
+			fmt.Sprintf("Sign-in to your account was locked after %d failed attempts. It unlocks automatically at %s.",
+				failures, lockedUntil.UTC().Format("2006-01-02 15:04 UTC")),
+			action + " (valid for 24 hours)",
+			"If this wasn't you, someone may be trying to guess your password. The lock protects your account; no action is needed.",
+		},
+		Footer: "You receive this email because of sign-in attempts to your account.",
+	}
+}
+
+// recordSuccess menghapus penghitung akun dan membatalkan percobaan yang dicatat reserve pada
+// penghitung IP. Penghitung IP tidak dihapus, agar login berhasil ke akun sendiri tidak bisa dipakai
+// untuk mereset batas IP.
+func (t *loginThrottler) recordSuccess(ctx context.Context, email string, reserved []domain.LoginThrottle) {
+	account := accountThrottleKey(email)
+	if err := t.repo.Delete(ctx, account); err != nil {
+		slog.ErrorContext(ctx, "error resetting login throttle", "error", err)
+	}
+	t.release(ctx, slices.DeleteFunc(reserved, func(throttle domain.LoginThrottle) bool {
+		return throttle.Key == account
+	}))
+}
+
+// release membatalkan percobaan yang dicatat reserve, untuk login yang berhenti sebelum password
+// selesai diperiksa. Error hanya di-log.
+func (t *loginThrottler) release(ctx context.Context, reserved []domain.LoginThrottle) {
+	for _, throttle := range reserved {
+		if err := t.repo.Release(ctx, throttle); err != nil {
+			slog.ErrorContext(ctx, "error releasing login attempt", "error", err)
+		}
+	}
+}
+
+// unlock membuka kunci akun dengan token dari email buka kunci.
+func (t *loginThrottler) unlock(ctx context.Context, token string) error {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return domain.ErrInvalidUnlockToken
+	}
+	_, err := t.repo.Unlock(ctx, hashAccessToken(token), time.Now())
+	if errors.Is(err, domain.ErrLoginThrottleNotFound) {
+		return domain.ErrInvalidUnlockToken
+	}
+	if err != nil {
+		return err
+	}
+	loginThrottleEvents.Add("unlocks", 1)
+	return nil
+}
+
+// purgeStale menghapus penghitung yang sudah lewat dari loginThrottleWindow.
+func (t *loginThrottler) purgeStale(ctx context.Context) (int64, error) {
+	return t.repo.DeleteStale(ctx, time.Now().Add(-loginThrottleWindow))
+}
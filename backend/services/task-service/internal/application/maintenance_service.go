@@ -0,0 +1,127 @@
+// file: backend/services/task-service/internal/application/maintenance_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// defaultMaintenanceRetryAfter dipakai jika admin tidak memberikan perkiraan lama maintenance.
+const defaultMaintenanceRetryAfter = 5 * time.Minute
+
+// MaintenanceInput adalah perubahan mode maintenance oleh admin.
+type MaintenanceInput struct {
+	Enabled    bool
+	Message    string
+	RetryAfter time.Duration // 0 berarti defaultMaintenanceRetryAfter
+	Reason     string        // Wajib diisi, dicatat di audit log
+}
+
+// MaintenanceApplicationService mendefinisikan use case mode maintenance global.
+type MaintenanceApplicationService interface {
+	// Current mengembalikan status yang terakhir dibaca dari database tanpa query, sehingga aman
+	// dipanggil di setiap request.
+	Current() domain.Maintenance
+
+	// Set mengganti mode maintenance untuk semua replika dan mencatatnya di audit log; jika
+	// pencatatan gagal, status tidak diubah. Replika lain mengikuti setelah Refresh berikutnya.
+	Set(ctx context.Context, adminID domain.UserID, input MaintenanceInput) (domain.Maintenance, error)
+
+	// Refresh membaca ulang status dari database.
+	Refresh(ctx context.Context) error
+
+	// Run memanggil Refresh setiap interval sampai ctx selesai. Jika database tidak bisa dibaca,
+	// status terakhir tetap dipakai.
+	Run(ctx context.Context, interval time.Duration)
+}
+
+// maintenanceService adalah implementasi dari MaintenanceApplicationService.
+type maintenanceService struct {
+	maintenanceRepo domain.MaintenanceRepository
+	auditRepo       domain.AdminAuditRepository
+	current         atomic.Pointer[domain.Maintenance]
+}
+
+// NewMaintenanceService adalah constructor untuk maintenanceService.
+func NewMaintenanceService(maintenanceRepo domain.MaintenanceRepository, auditRepo domain.AdminAuditRepository) MaintenanceApplicationService {
+	s := &maintenanceService{
+		maintenanceRepo: maintenanceRepo,
+		auditRepo:       auditRepo,
+	}
+	s.current.Store(&domain.Maintenance{})
+	return s
+}
+
+func (s *maintenanceService) Current() domain.Maintenance {
+	return *s.current.Load()
+}
+
+func (s *maintenanceService) Set(ctx context.Context, adminID domain.UserID, input MaintenanceInput) (domain.Maintenance, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return domain.Maintenance{}, domain.ErrAuditReasonRequired
+	}
+	maintenance := domain.Maintenance{
+		Enabled:    input.Enabled,
+		Message:    strings.TrimSpace(input.Message),
+		RetryAfter: input.RetryAfter,
+		UpdatedBy:  adminID,
+		UpdatedAt:  time.Now(),
+	}
+	if maintenance.RetryAfter <= 0 {
+		maintenance.RetryAfter = defaultMaintenanceRetryAfter
+	}
+
+	err := s.auditRepo.Record(ctx, domain.AdminAuditEntry{
+		AdminID: adminID,
+		Action:  "admin.maintenance",
+		Reason:  reason,
+		Details: map[string]any{
+			"enabled":             maintenance.Enabled,
+			"message":             maintenance.Message,
+			"retry_after_seconds": int(maintenance.RetryAfter / time.Second),
+		},
+		OccurredAt: maintenance.UpdatedAt,
+	})
+	if err != nil {
+		return domain.Maintenance{}, fmt.Errorf("error writing admin audit log: %w", err)
+	}
+	if err := s.maintenanceRepo.Save(ctx, maintenance); err != nil {
+		return domain.Maintenance{}, err
+	}
+	s.current.Store(&maintenance)
+	return maintenance, nil
+}
+
+func (s *maintenanceService) Refresh(ctx context.Context) error {
+	maintenance, err := s.maintenanceRepo.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if previous := s.Current(); previous.Enabled != maintenance.Enabled {
+		slog.WarnContext(ctx, "maintenance mode changed", "enabled", maintenance.Enabled, "updated_by", maintenance.UpdatedBy)
+	}
+	s.current.Store(&maintenance)
+	return nil
+}
+
+func (s *maintenanceService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "error refreshing maintenance mode, keeping previous state", "error", err)
+			}
+		}
+	}
+}
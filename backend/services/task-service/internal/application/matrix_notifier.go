@@ -0,0 +1,78 @@
+// file: backend/services/task-service/internal/application/matrix_notifier.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// matrixSubjectLength adalah panjang judul task maksimum (dalam rune) di pesan Matrix.
+const matrixSubjectLength = 256
+
+// matrixNotifier adalah EventNotifier yang mengirim event task ke room Matrix pengguna.
+type matrixNotifier struct {
+	channelRepo domain.MatrixChannelRepository
+	client      domain.MatrixClient
+}
+
+// NewMatrixNotifier adalah constructor untuk matrixNotifier.
+func NewMatrixNotifier(channelRepo domain.MatrixChannelRepository, client domain.MatrixClient) EventNotifier {
+	return &matrixNotifier{
+		channelRepo: channelRepo,
+		client:      client,
+	}
+}
+
+// Notify mengirim event ke room Matrix pemilik task jika channel menerima jenis event tersebut.
+func (n *matrixNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	channel, err := n.channelRepo.FindByUserID(ctx, event.UserID)
+	if errors.Is(err, domain.ErrMatrixChannelNotFound) {
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading matrix channel", "user_id", event.UserID, "error", err)
+		return
+	}
+	if !channel.Accepts(event.Type) {
+		return
+	}
+	txnID, err := newMatrixTxnID()
+	if err != nil {
+		slog.ErrorContext(ctx, "error sending event to matrix", "event_type", event.Type, "user_id", event.UserID, "error", err)
+		return
+	}
+	msg := matrixEventMessage(event)
+	if err := n.client.SendMessage(ctx, channel.HomeserverURL, channel.AccessToken, channel.RoomID, txnID, msg); err != nil {
+		slog.ErrorContext(ctx, "error sending event to matrix", "event_type", event.Type, "user_id", event.UserID, "error", err)
+	}
+}
+
+// matrixEventMessage membentuk pesan teks dan HTML untuk event task. Judul task di-escape karena
+// berasal dari pengguna.
+func matrixEventMessage(event domain.TaskEvent) domain.MatrixMessage {
+	var heading, subject string
+	switch {
+	case event.Task == nil:
+		heading, subject = "Task deleted", event.TaskID
+	case event.Type == domain.TaskCreated:
+		heading, subject = "Task created", event.Task.Title
+	case event.Type == domain.TaskMentioned:
+		heading, subject = "Mentioned in a comment on", event.Task.Title
+	case event.Type == domain.TaskAssigned:
+		heading, subject = "Assigned to you", event.Task.Title
+	case event.Task.Completed:
+		heading, subject = "Task completed", event.Task.Title
+	default:
+		heading, subject = "Task updated", event.Task.Title
+	}
+	subject = truncateRunes(subject, matrixSubjectLength)
+	return domain.MatrixMessage{
+		Body:          fmt.Sprintf("%s: %s", heading, subject),
+		FormattedBody: fmt.Sprintf("<strong>%s</strong>: %s", heading, html.EscapeString(subject)),
+	}
+}
@@ -0,0 +1,118 @@
+// file: backend/services/task-service/internal/application/matrix_service.go
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// SaveMatrixChannelInput adalah pengaturan notifikasi Matrix. Room boleh berupa ID room
+// (!abc:example.org) atau alias (#tasks:example.org).
+type SaveMatrixChannelInput struct {
+	HomeserverURL string
+	AccessToken   string
+	Room          string
+	EventTypes    []domain.TaskEventType
+}
+
+// MatrixApplicationService mendefinisikan use case integrasi Matrix.
+type MatrixApplicationService interface {
+	GetChannel(ctx context.Context, userID domain.UserID) (*domain.MatrixChannel, error)
+
+	// SaveChannel memverifikasi room dan mengirim pesan percobaan sebelum menyimpan pengaturan.
+	SaveChannel(ctx context.Context, userID domain.UserID, input SaveMatrixChannelInput) (*domain.MatrixChannel, error)
+	DeleteChannel(ctx context.Context, userID domain.UserID) error
+}
+
+// matrixService adalah implementasi dari MatrixApplicationService.
+type matrixService struct {
+	channelRepo domain.MatrixChannelRepository
+	client      domain.MatrixClient
+}
+
+// NewMatrixService adalah constructor untuk matrixService.
+func NewMatrixService(channelRepo domain.MatrixChannelRepository, client domain.MatrixClient) MatrixApplicationService {
+	return &matrixService{
+		channelRepo: channelRepo,
+		client:      client,
+	}
+}
+
+// GetChannel mengembalikan pengaturan Matrix milik pengguna.
+func (s *matrixService) GetChannel(ctx context.Context, userID domain.UserID) (*domain.MatrixChannel, error) {
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// SaveChannel menyimpan pengaturan hanya jika token valid, akunnya sudah bergabung di room, dan
+// pesan percobaan berhasil dikirim.
+func (s *matrixService) SaveChannel(ctx context.Context, userID domain.UserID, input SaveMatrixChannelInput) (*domain.MatrixChannel, error) {
+	homeserver, err := url.Parse(strings.TrimSpace(input.HomeserverURL))
+	if err != nil || (homeserver.Scheme != "https" && homeserver.Scheme != "http") || homeserver.Host == "" {
+		return nil, fmt.Errorf("%w: homeserver_url must be an absolute http or https URL", domain.ErrInvalidMatrixChannel)
+	}
+	accessToken := strings.TrimSpace(input.AccessToken)
+	if accessToken == "" {
+		return nil, fmt.Errorf("%w: access_token is required", domain.ErrInvalidMatrixChannel)
+	}
+	room := strings.TrimSpace(input.Room)
+	if (!strings.HasPrefix(room, "!") && !strings.HasPrefix(room, "#")) || !strings.Contains(room, ":") {
+		return nil, fmt.Errorf("%w: room must be a room ID (!id:server) or alias (#alias:server)", domain.ErrInvalidMatrixChannel)
+	}
+	eventTypes := slices.Compact(slices.Sorted(slices.Values(input.EventTypes)))
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", domain.ErrInvalidMatrixChannel, eventType)
+		}
+	}
+
+	homeserverURL := strings.TrimSuffix(homeserver.String(), "/")
+	roomID, err := s.client.VerifyRoom(ctx, homeserverURL, accessToken, room)
+	if err != nil {
+		return nil, fmt.Errorf("%w: room verification failed: %v", domain.ErrInvalidMatrixChannel, err)
+	}
+	txnID, err := newMatrixTxnID()
+	if err != nil {
+		return nil, err
+	}
+	msg := domain.MatrixMessage{Body: "Task notifications will be posted here."}
+	if err := s.client.SendMessage(ctx, homeserverURL, accessToken, roomID, txnID, msg); err != nil {
+		return nil, fmt.Errorf("%w: test message failed: %v", domain.ErrInvalidMatrixChannel, err)
+	}
+
+	now := time.Now()
+	channel := &domain.MatrixChannel{
+		UserID:        userID,
+		HomeserverURL: homeserverURL,
+		AccessToken:   accessToken,
+		RoomID:        roomID,
+		EventTypes:    eventTypes,
+		VerifiedAt:    now,
+		UpdatedAt:     now,
+	}
+	if err := s.channelRepo.Save(ctx, channel); err != nil {
+		return nil, err
+	}
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// DeleteChannel menghapus pengaturan Matrix milik pengguna.
+func (s *matrixService) DeleteChannel(ctx context.Context, userID domain.UserID) error {
+	return s.channelRepo.Delete(ctx, userID)
+}
+
+// newMatrixTxnID membuat ID transaksi acak untuk satu pesan.
+func newMatrixTxnID() (string, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("error generating matrix transaction id: %w", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
@@ -0,0 +1,210 @@
+// file: backend/services/task-service/internal/application/personal_access_token_service.go
+package application
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	// maxPersonalAccessTokenLifetime adalah masa berlaku token terlama yang bisa dipilih.
+	maxPersonalAccessTokenLifetime = 365 * 24 * time.Hour
+
+	// personalAccessTokenTouchInterval membatasi penulisan last_used_at, sehingga script yang
+	// memanggil API berkali-kali per detik tidak menulis ke database di setiap request.
+	personalAccessTokenTouchInterval = time.Minute
+
+	// personalAccessTokenHintLength adalah jumlah karakter terakhir token yang disimpan sebagai hint.
+	personalAccessTokenHintLength = 4
+)
+
+// CreatePersonalAccessTokenInput adalah data untuk membuat personal access token.
+type CreatePersonalAccessTokenInput struct {
+	Name   string
+	Scopes []domain.TokenScope
+	// Lists membatasi token ke daftar milik pengguna-pengguna ini (ID sendiri untuk daftar
+	// sendiri); kosong berarti semua daftar yang bisa diakses.
+	Lists []domain.UserID
+	// AllowedIPs membatasi token ke jaringan klien ini, dalam notasi CIDR ("192.168.1.0/24") atau
+	// satu alamat IP; kosong berarti semua IP.
+	AllowedIPs []string
+	// ExpiresIn adalah masa berlaku token; 0 berarti tidak kedaluwarsa.
+	ExpiresIn time.Duration
+}
+
+// PersonalAccessTokenApplicationService mendefinisikan use case personal access token untuk script
+// dan integrasi pihak ketiga.
+type PersonalAccessTokenApplicationService interface {
+	ListTokens(ctx context.Context, userID domain.UserID) ([]*domain.PersonalAccessToken, error)
+
+	// CreateToken membuat token baru dan mengembalikannya beserta nilai token, yang hanya
+	// dikembalikan di sini. Mengembalikan ErrInvalidPersonalAccessToken atau
+	// ErrTooManyPersonalAccessTokens.
+	CreateToken(ctx context.Context, userID domain.UserID, input CreatePersonalAccessTokenInput) (*domain.PersonalAccessToken, string, error)
+
+	// RevokeToken mencabut token milik pengguna.
+	RevokeToken(ctx context.Context, userID domain.UserID, id string) error
+
+	// Authenticate mengembalikan token yang cocok dan mencatat pemakaiannya. Mengembalikan
+	// ErrPersonalAccessTokenNotFound, ErrPersonalAccessTokenExpired, atau
+	// ErrPersonalAccessTokenIPDenied jika IP klien (domain.ClientIPFromContext) tidak ada di
+	// allowlist token. Untuk ErrPersonalAccessTokenIPDenied token tetap dikembalikan, agar
+	// penolakannya bisa dicatat atas nama pemilik token.
+	Authenticate(ctx context.Context, token string) (*domain.PersonalAccessToken, error)
+}
+
+// personalAccessTokenService adalah implementasi dari PersonalAccessTokenApplicationService.
+type personalAccessTokenService struct {
+	tokenRepo domain.PersonalAccessTokenRepository
+	idGen     domain.IDGenerator
+}
+
+// NewPersonalAccessTokenService adalah constructor untuk personalAccessTokenService.
+func NewPersonalAccessTokenService(tokenRepo domain.PersonalAccessTokenRepository, idGen domain.IDGenerator) PersonalAccessTokenApplicationService {
+	return &personalAccessTokenService{
+		tokenRepo: tokenRepo,
+		idGen:     idGen,
+	}
+}
+
+// ListTokens mengembalikan semua token pengguna, termasuk yang sudah kedaluwarsa.
+func (s *personalAccessTokenService) ListTokens(ctx context.Context, userID domain.UserID) ([]*domain.PersonalAccessToken, error) {
+	return s.tokenRepo.FindByUserID(ctx, userID)
+}
+
+// CreateToken memakai generator secret webhook seperti token CalDAV; token disimpan sebagai hash
+// SHA-256.
+func (s *personalAccessTokenService) CreateToken(ctx context.Context, userID domain.UserID, input CreatePersonalAccessTokenInput) (*domain.PersonalAccessToken, string, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || utf8.RuneCountInString(name) > domain.MaxPersonalAccessTokenNameLength {
+		return nil, "", fmt.Errorf("%w: name must be between 1 and %d characters", domain.ErrInvalidPersonalAccessToken, domain.MaxPersonalAccessTokenNameLength)
+	}
+	scopes := slices.Compact(slices.Sorted(slices.Values(input.Scopes)))
+	if len(scopes) == 0 {
+		return nil, "", fmt.Errorf("%w: at least one scope is required", domain.ErrInvalidPersonalAccessToken)
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(domain.TokenScopes, scope) {
+			return nil, "", fmt.Errorf("%w: unknown scope %q", domain.ErrInvalidPersonalAccessToken, scope)
+		}
+	}
+	lists := slices.Compact(slices.Sorted(slices.Values(input.Lists)))
+	if len(lists) > domain.MaxPersonalAccessTokenLists {
+		return nil, "", fmt.Errorf("%w: at most %d lists are allowed", domain.ErrInvalidPersonalAccessToken, domain.MaxPersonalAccessTokenLists)
+	}
+	if slices.Contains(lists, "") {
+		return nil, "", fmt.Errorf("%w: list ids must not be empty", domain.ErrInvalidPersonalAccessToken)
+	}
+	allowedIPs, err := parseAllowedIPs(input.AllowedIPs)
+	if err != nil {
+		return nil, "", err
+	}
+	if input.ExpiresIn < 0 || input.ExpiresIn > maxPersonalAccessTokenLifetime {
+		return nil, "", fmt.Errorf("%w: lifetime must be at most %s", domain.ErrInvalidPersonalAccessToken, maxPersonalAccessTokenLifetime)
+	}
+	count, err := s.tokenRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if count >= domain.MaxPersonalAccessTokensPerUser {
+		return nil, "", domain.ErrTooManyPersonalAccessTokens
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	value := domain.PersonalAccessTokenPrefix + secret
+	now := time.Now()
+	token := &domain.PersonalAccessToken{
+		ID:         s.idGen.NewID(),
+		UserID:     userID,
+		Name:       name,
+		Scopes:     scopes,
+		Lists:      lists,
+		AllowedIPs: allowedIPs,
+		Hint:       value[len(value)-personalAccessTokenHintLength:],
+		CreatedAt:  now,
+	}
+	if input.ExpiresIn > 0 {
+		expiresAt := now.Add(input.ExpiresIn)
+		token.ExpiresAt = &expiresAt
+	}
+	if err := s.tokenRepo.Create(ctx, token, hashAccessToken(value)); err != nil {
+		return nil, "", err
+	}
+	return token, value, nil
+}
+
+// RevokeToken menghapus token; request berikutnya dengan token tersebut langsung ditolak.
+func (s *personalAccessTokenService) RevokeToken(ctx context.Context, userID domain.UserID, id string) error {
+	return s.tokenRepo.Delete(ctx, userID, id)
+}
+
+// Authenticate mencari token berdasarkan hash-nya. last_used_at paling sering diperbarui sekali
+// per personalAccessTokenTouchInterval; kegagalan memperbaruinya hanya di-log.
+func (s *personalAccessTokenService) Authenticate(ctx context.Context, value string) (*domain.PersonalAccessToken, error) {
+	if !strings.HasPrefix(value, domain.PersonalAccessTokenPrefix) {
+		return nil, domain.ErrPersonalAccessTokenNotFound
+	}
+	token, err := s.tokenRepo.FindByHash(ctx, hashAccessToken(value))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if token.Expired(now) {
+		return nil, domain.ErrPersonalAccessTokenExpired
+	}
+	if !token.AllowsIP(domain.ClientIPFromContext(ctx)) {
+		return token, domain.ErrPersonalAccessTokenIPDenied
+	}
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= personalAccessTokenTouchInterval {
+		if err := s.tokenRepo.TouchLastUsed(ctx, token.ID, now); err != nil {
+			slog.ErrorContext(ctx, "error recording personal access token use", "token_id", token.ID, "error", err)
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+	return token, nil
+}
+
+// parseAllowedIPs memvalidasi allowlist IP token. Alamat tunggal menjadi prefix /32 atau /128, dan
+// bit host prefix dibuang, sehingga "10.0.0.1/8" disimpan sebagai "10.0.0.0/8". Alamat IPv4 harus
+// ditulis dalam notasi IPv4, karena IP klien IPv4-mapped dibandingkan sebagai IPv4.
+func parseAllowedIPs(values []string) ([]netip.Prefix, error) {
+	if len(values) > domain.MaxPersonalAccessTokenAllowedIPs {
+		return nil, fmt.Errorf("%w: at most %d allowed ip ranges are allowed", domain.ErrInvalidPersonalAccessToken, domain.MaxPersonalAccessTokenAllowedIPs)
+	}
+	var networks []netip.Prefix
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		network, err := netip.ParsePrefix(value)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(value)
+			if addrErr != nil || addr.Zone() != "" {
+				return nil, fmt.Errorf("%w: invalid allowed ip %q", domain.ErrInvalidPersonalAccessToken, value)
+			}
+			network = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		if network.Addr().Is4In6() {
+			return nil, fmt.Errorf("%w: allowed ip %q must use IPv4 notation", domain.ErrInvalidPersonalAccessToken, value)
+		}
+		networks = append(networks, network.Masked())
+	}
+	slices.SortFunc(networks, func(a, b netip.Prefix) int {
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Bits(), b.Bits())
+	})
+	return slices.Compact(networks), nil
+}
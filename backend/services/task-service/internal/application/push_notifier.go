@@ -0,0 +1,163 @@
+// file: backend/services/task-service/internal/application/push_notifier.go
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Panjang judul dan isi notifikasi push (dalam rune). Payload Web Push harus muat dalam 4 KB
+// setelah di-encode sebagai JSON.
+const (
+	pushTitleLength = 100
+	pushBodyLength  = 300
+)
+
+// pushNotifier adalah EventNotifier yang mengirim event task sebagai notifikasi push ke perangkat
+// pemilik daftar, penerima mention dan penugasan, dan kolaborator daftar bersama.
+type pushNotifier struct {
+	channelRepo domain.PushChannelRepository
+	deviceRepo  domain.DeviceRepository
+	shareRepo   domain.ListShareRepository
+	sender      domain.PushSender
+	locations   UserLocationProvider
+}
+
+// NewPushNotifier adalah constructor untuk pushNotifier.
+func NewPushNotifier(channelRepo domain.PushChannelRepository, deviceRepo domain.DeviceRepository, shareRepo domain.ListShareRepository, sender domain.PushSender, locations UserLocationProvider) EventNotifier {
+	return &pushNotifier{
+		channelRepo: channelRepo,
+		deviceRepo:  deviceRepo,
+		shareRepo:   shareRepo,
+		sender:      sender,
+		locations:   locations,
+	}
+}
+
+// Notify mengirim event ke pengguna event (pemilik task, atau penerima mention dan penugasan) dan,
+// kecuali untuk mention dan penugasan, ke kolaborator daftar pemilik yang mengaktifkan SharedLists.
+// Setiap penerima harus berlangganan jenis event tersebut.
+func (n *pushNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	recipients := n.recipients(ctx, event)
+	if len(recipients) == 0 {
+		return
+	}
+	targets, err := n.deviceRepo.FindPushTargets(ctx, recipients)
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading push targets", "event_type", event.Type, "task_id", event.TaskID, "error", err)
+		return
+	}
+	messages := make(map[domain.UserID]domain.PushMessage)
+	for _, target := range targets {
+		msg, ok := messages[target.UserID]
+		if !ok {
+			msg = pushEventMessage(event, userLocationOrUTC(ctx, n.locations, target.UserID))
+			messages[target.UserID] = msg
+		}
+		err := sendPush(ctx, n.sender, n.deviceRepo, target, msg)
+		if err != nil && !errors.Is(err, domain.ErrPushNotConfigured) && !errors.Is(err, domain.ErrPushSubscriptionGone) {
+			slog.ErrorContext(ctx, "error sending event push", "event_type", event.Type, "device_id", target.DeviceID, "user_id", target.UserID, "error", err)
+		}
+	}
+}
+
+// recipients mengembalikan pengguna yang channel push-nya menerima event.
+func (n *pushNotifier) recipients(ctx context.Context, event domain.TaskEvent) []domain.UserID {
+	var recipients []domain.UserID
+	if n.accepts(ctx, event.UserID, event.Type, false) {
+		recipients = append(recipients, event.UserID)
+	}
+	if event.Type == domain.TaskMentioned || event.Type == domain.TaskAssigned {
+		return recipients
+	}
+	shares, err := n.shareRepo.FindByOwner(ctx, event.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading collaborators for push", "owner_id", event.UserID, "error", err)
+		return recipients
+	}
+	for _, share := range shares {
+		if n.accepts(ctx, share.CollaboratorID, event.Type, true) {
+			recipients = append(recipients, share.CollaboratorID)
+		}
+	}
+	return recipients
+}
+
+// accepts memeriksa channel push userID; sharedList berarti event berasal dari daftar pengguna lain.
+func (n *pushNotifier) accepts(ctx context.Context, userID domain.UserID, eventType domain.TaskEventType, sharedList bool) bool {
+	channel, err := n.channelRepo.FindByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrPushChannelNotFound) {
+		return false
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading push channel", "user_id", userID, "error", err)
+		return false
+	}
+	return channel.Accepts(eventType) && (!sharedList || channel.SharedLists)
+}
+
+// sendPush mengirim msg ke satu perangkat. Langganan yang dilaporkan tidak berlaku lagi oleh
+// provider dihapus dari perangkat, sehingga tidak dicoba lagi.
+func sendPush(ctx context.Context, sender domain.PushSender, deviceRepo domain.DeviceRepository, target domain.PushTarget, msg domain.PushMessage) error {
+	err := sender.Send(ctx, target.Subscription, msg)
+	if errors.Is(err, domain.ErrPushSubscriptionGone) {
+		if removeErr := deviceRepo.RemovePushSubscription(ctx, target.UserID, target.DeviceID, target.Stored); removeErr != nil {
+			slog.ErrorContext(ctx, "error removing expired push subscription", "device_id", target.DeviceID, "error", removeErr)
+		}
+	}
+	return err
+}
+
+// pushEventMessage membentuk notifikasi untuk event task dengan teks yang sama seperti email.
+// Notifikasi satu task saling menggantikan lewat tag, kecuali mention.
+func pushEventMessage(event domain.TaskEvent, location *time.Location) domain.PushMessage {
+	content := emailEventContent(event, location)
+	msg := newPushMessage(content)
+	msg.Tag = "task-" + event.TaskID
+	if event.Type == domain.TaskMentioned && event.Comment != nil {
+		msg.Tag = "comment-" + event.Comment.ID
+	}
+	msg.Data = map[string]string{
+		"type":     string(event.Type),
+		"task_id":  event.TaskID,
+		"owner_id": string(event.UserID),
+	}
+	if event.Task != nil {
+		msg.Data["owner_id"] = string(event.Task.UserID)
+	}
+	return msg
+}
+
+// pushReminderMessage membentuk notifikasi pengingat tenggat.
+func pushReminderMessage(task *domain.Task, location *time.Location) domain.PushMessage {
+	msg := newPushMessage(emailReminderContent(task, location))
+	msg.Tag = "reminder-" + task.ID
+	msg.Data = map[string]string{
+		"type":     "task.reminder",
+		"task_id":  task.ID,
+		"owner_id": string(task.UserID),
+	}
+	return msg
+}
+
+// newPushMessage meringkas emailContent: heading menjadi judul, judul task dan detailnya menjadi isi.
+func newPushMessage(content emailContent) domain.PushMessage {
+	lines := make([]string, 0, len(content.Details)+1)
+	if content.Title != "" {
+		lines = append(lines, strings.Join(strings.Fields(content.Title), " "))
+	}
+	for _, detail := range content.Details {
+		if detail != "" {
+			lines = append(lines, detail)
+		}
+	}
+	return domain.PushMessage{
+		Title: truncateRunes(content.Heading, pushTitleLength),
+		Body:  truncateRunes(strings.Join(lines, "\n"), pushBodyLength),
+	}
+}
@@ -0,0 +1,254 @@
+// file: backend/services/task-service/internal/application/push_service.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// pushReminderBatchSize adalah jumlah pengingat push maksimum yang dikirim dalam satu putaran job.
+const pushReminderBatchSize = 100
+
+// SavePushChannelInput adalah pengaturan notifikasi push.
+type SavePushChannelInput struct {
+	EventTypes   []domain.TaskEventType
+	SharedLists  bool
+	ReminderLead time.Duration
+}
+
+// PushApplicationService mendefinisikan use case notifikasi push dan pendaftaran langganan push
+// perangkat.
+type PushApplicationService interface {
+	GetChannel(ctx context.Context, userID domain.UserID) (*domain.PushChannel, error)
+	SaveChannel(ctx context.Context, userID domain.UserID, input SavePushChannelInput) (*domain.PushChannel, error)
+	DeleteChannel(ctx context.Context, userID domain.UserID) error
+
+	// RegisterSubscription menyimpan token FCM atau langganan Web Push perangkat deviceID. Perangkat
+	// yang belum terdaftar didaftarkan otomatis. Mengembalikan ErrInvalidPushSubscription,
+	// ErrInvalidDevice, ErrTooManyDevices, atau ErrDeviceRevoked.
+	RegisterSubscription(ctx context.Context, userID domain.UserID, deviceID string, subscription json.RawMessage) (*domain.Device, error)
+
+	// RemoveSubscription menghapus langganan push perangkat; perangkatnya tetap terdaftar.
+	RemoveSubscription(ctx context.Context, userID domain.UserID, deviceID string) error
+
+	// SendTest mengirim notifikasi percobaan ke semua perangkat pengguna yang berlangganan push dan
+	// mengembalikan jumlah perangkat yang menerimanya.
+	SendTest(ctx context.Context, userID domain.UserID) (int, error)
+
+	// SendReminders mengirim pengingat untuk task yang tenggatnya sudah dekat dan mengembalikan
+	// jumlah task yang diingatkan. Setiap tenggat hanya diingatkan sekali.
+	SendReminders(ctx context.Context) (int, error)
+
+	// RunRemindersPeriodically menjalankan SendReminders setiap interval sampai ctx dibatalkan.
+	RunRemindersPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// pushService adalah implementasi dari PushApplicationService.
+type pushService struct {
+	channelRepo domain.PushChannelRepository
+	deviceRepo  domain.DeviceRepository
+	sender      domain.PushSender
+	locations   UserLocationProvider
+}
+
+// NewPushService adalah constructor untuk pushService.
+func NewPushService(channelRepo domain.PushChannelRepository, deviceRepo domain.DeviceRepository, sender domain.PushSender, locations UserLocationProvider) PushApplicationService {
+	return &pushService{
+		channelRepo: channelRepo,
+		deviceRepo:  deviceRepo,
+		sender:      sender,
+		locations:   locations,
+	}
+}
+
+// GetChannel mengembalikan pengaturan push milik pengguna.
+func (s *pushService) GetChannel(ctx context.Context, userID domain.UserID) (*domain.PushChannel, error) {
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// SaveChannel memvalidasi lalu mengganti pengaturan push pengguna.
+func (s *pushService) SaveChannel(ctx context.Context, userID domain.UserID, input SavePushChannelInput) (*domain.PushChannel, error) {
+	eventTypes := slices.Compact(slices.Sorted(slices.Values(input.EventTypes)))
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", domain.ErrInvalidPushChannel, eventType)
+		}
+	}
+	if input.ReminderLead < 0 || input.ReminderLead > domain.MaxPushReminderLead {
+		return nil, fmt.Errorf("%w: reminder lead must be between 0 and %s", domain.ErrInvalidPushChannel, domain.MaxPushReminderLead)
+	}
+
+	channel := &domain.PushChannel{
+		UserID:       userID,
+		EventTypes:   eventTypes,
+		SharedLists:  input.SharedLists,
+		ReminderLead: input.ReminderLead.Truncate(time.Minute),
+		UpdatedAt:    time.Now(),
+	}
+	if err := s.channelRepo.Save(ctx, channel); err != nil {
+		return nil, err
+	}
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// DeleteChannel menghapus pengaturan push milik pengguna.
+func (s *pushService) DeleteChannel(ctx context.Context, userID domain.UserID) error {
+	return s.channelRepo.Delete(ctx, userID)
+}
+
+// RegisterSubscription menyimpan langganan dalam bentuk yang sudah dinormalisasi
+// ParsePushSubscription, tanpa mengubah nama dan platform perangkat.
+func (s *pushService) RegisterSubscription(ctx context.Context, userID domain.UserID, deviceID string, subscription json.RawMessage) (*domain.Device, error) {
+	if err := domain.ValidateDeviceID(deviceID); err != nil {
+		return nil, err
+	}
+	sub, err := domain.ParsePushSubscription(subscription)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := json.Marshal(sub)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding push subscription: %w", err)
+	}
+
+	now := time.Now()
+	device, err := s.deviceRepo.FindByID(ctx, userID, deviceID)
+	switch {
+	case errors.Is(err, domain.ErrDeviceNotFound):
+		if err := checkDeviceLimit(ctx, s.deviceRepo, userID); err != nil {
+			return nil, err
+		}
+		device = &domain.Device{ID: deviceID, UserID: userID, PushSubscription: stored, UpdatedAt: now}
+		if err := s.deviceRepo.Save(ctx, device); err != nil {
+			return nil, err
+		}
+		return device, nil
+	case err != nil:
+		return nil, err
+	case device.RevokedAt != nil:
+		return nil, domain.ErrDeviceRevoked
+	}
+	if err := s.deviceRepo.SavePushSubscription(ctx, userID, deviceID, stored, now); err != nil {
+		return nil, err
+	}
+	return s.deviceRepo.FindByID(ctx, userID, deviceID)
+}
+
+// RemoveSubscription menghapus langganan push perangkat yang belum dicabut.
+func (s *pushService) RemoveSubscription(ctx context.Context, userID domain.UserID, deviceID string) error {
+	return s.deviceRepo.SavePushSubscription(ctx, userID, deviceID, nil, time.Now())
+}
+
+// SendTest tidak membutuhkan pengaturan push, sehingga pendaftaran perangkat bisa diperiksa sebelum
+// memilih event. Jika tidak ada perangkat yang menerima, error pertama yang dikembalikan.
+func (s *pushService) SendTest(ctx context.Context, userID domain.UserID) (int, error) {
+	targets, err := s.deviceRepo.FindPushTargets(ctx, []domain.UserID{userID})
+	if err != nil {
+		return 0, err
+	}
+	msg := domain.PushMessage{
+		Title: "Push notifications are working",
+		Body:  "Task events and due date reminders you enabled will appear on this device.",
+		Tag:   "test",
+		Data:  map[string]string{"type": "test"},
+	}
+	sent := 0
+	var firstErr error
+	for _, target := range targets {
+		err := sendPush(ctx, s.sender, s.deviceRepo, target, msg)
+		if err == nil {
+			sent++
+			continue
+		}
+		slog.ErrorContext(ctx, "error sending test push", "device_id", target.DeviceID, "error", err)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if sent > 0 || firstErr == nil {
+		return sent, nil
+	}
+	if errors.Is(firstErr, domain.ErrPushNotConfigured) {
+		return 0, firstErr
+	}
+	return 0, fmt.Errorf("%w: %v", domain.ErrPushDeliveryFailed, firstErr)
+}
+
+// SendReminders mengklaim setiap pengingat sebelum dikirim ke semua perangkat pemilik task. Klaim
+// dilepas lagi jika tidak ada perangkat yang berhasil menerima karena error yang mungkin sementara,
+// agar dicoba di putaran berikutnya selama tenggatnya belum lewat.
+func (s *pushService) SendReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	reminders, err := s.channelRepo.FindDueReminders(ctx, now, pushReminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, reminder := range reminders {
+		task := reminder.Task
+		claimed, err := s.channelRepo.ClaimReminder(ctx, task.ID, *task.DueAt, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+		delivered, retry := s.sendReminder(ctx, task)
+		if delivered {
+			sent++
+		} else if retry {
+			if err := s.channelRepo.ReleaseReminder(ctx, task.ID, *task.DueAt); err != nil {
+				slog.ErrorContext(ctx, "error releasing push reminder", "task_id", task.ID, "error", err)
+			}
+		}
+	}
+	return sent, nil
+}
+
+// sendReminder mengirim pengingat task ke perangkat pemiliknya. retry bernilai true jika tidak ada
+// perangkat yang menerima dan setidaknya satu pengiriman gagal karena error selain langganan yang
+// sudah tidak berlaku atau provider yang tidak dikonfigurasi.
+func (s *pushService) sendReminder(ctx context.Context, task *domain.Task) (delivered, retry bool) {
+	targets, err := s.deviceRepo.FindPushTargets(ctx, []domain.UserID{task.UserID})
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading push targets for reminder", "task_id", task.ID, "error", err)
+		return false, true
+	}
+	msg := pushReminderMessage(task, userLocationOrUTC(ctx, s.locations, task.UserID))
+	for _, target := range targets {
+		err := sendPush(ctx, s.sender, s.deviceRepo, target, msg)
+		switch {
+		case err == nil:
+			delivered = true
+		case errors.Is(err, domain.ErrPushNotConfigured), errors.Is(err, domain.ErrPushSubscriptionGone):
+		default:
+			slog.ErrorContext(ctx, "error sending push reminder", "task_id", task.ID, "device_id", target.DeviceID, "error", err)
+			retry = true
+		}
+	}
+	return delivered, retry && !delivered
+}
+
+// RunRemindersPeriodically mengirim pengingat push berkala. Error hanya di-log dan dicoba lagi di
+// putaran berikutnya.
+func (s *pushService) RunRemindersPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.SendReminders(ctx); err != nil {
+				slog.ErrorContext(ctx, "error sending push reminders", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// file: backend/services/task-service/internal/application/quota_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// QuotaMonitor dipanggil oleh use case lain setelah pemakaian sumber daya bertambah,
+// untuk memeriksa apakah ambang peringatan kuota terlewati.
+type QuotaMonitor interface {
+	// ObserveUsage bersifat best-effort: error hanya di-log dan tidak menggagalkan operasi pemanggil.
+	ObserveUsage(ctx context.Context, userID domain.UserID, resource domain.QuotaResource, added int64)
+}
+
+// QuotaApplicationService mendefinisikan use case kuota: melihat batas pemakaian,
+// memantau ambang peringatan, dan mengubah kebijakan kuota per plan (admin).
+type QuotaApplicationService interface {
+	QuotaMonitor
+
+	// GetLimits mengembalikan pemakaian pengguna terhadap semua kuota plan-nya.
+	// Plan dibaca dari context (lihat domain.PlanFromContext).
+	GetLimits(ctx context.Context, userID domain.UserID) ([]domain.QuotaUsage, error)
+
+	// GetPlanPolicies mengembalikan kebijakan kuota untuk sebuah plan.
+	GetPlanPolicies(ctx context.Context, plan domain.Plan) ([]domain.QuotaPolicy, error)
+
+	// UpdatePolicy mengganti batas dan ambang peringatan kuota untuk (Plan, Resource).
+	UpdatePolicy(ctx context.Context, policy domain.QuotaPolicy) error
+}
+
+// UsageCounter menghitung pemakaian satu sumber daya milik pengguna.
+type UsageCounter func(ctx context.Context, userID domain.UserID) (int64, error)
+
+// quotaService adalah implementasi dari QuotaApplicationService.
+type quotaService struct {
+	policyRepo domain.QuotaPolicyRepository
+	recorder   domain.UsageEventRecorder
+	counters   map[domain.QuotaResource]UsageCounter
+}
+
+// NewQuotaService adalah constructor untuk quotaService.
+// counters memetakan setiap sumber daya ke fungsi penghitung pemakaiannya; kebijakan untuk
+// sumber daya tanpa counter diabaikan.
+func NewQuotaService(policyRepo domain.QuotaPolicyRepository, recorder domain.UsageEventRecorder, counters map[domain.QuotaResource]UsageCounter) QuotaApplicationService {
+	return &quotaService{
+		policyRepo: policyRepo,
+		recorder:   recorder,
+		counters:   counters,
+	}
+}
+
+// policiesFor mengambil kebijakan kuota plan, dengan fallback ke DefaultPlan jika plan belum dikonfigurasi.
+func (s *quotaService) policiesFor(ctx context.Context, plan domain.Plan) ([]domain.QuotaPolicy, error) {
+	policies, err := s.policyRepo.FindByPlan(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 && plan != domain.DefaultPlan {
+		return s.policyRepo.FindByPlan(ctx, domain.DefaultPlan)
+	}
+	return policies, nil
+}
+
+// GetLimits menghitung pemakaian pengguna untuk setiap kuota plan-nya.
+func (s *quotaService) GetLimits(ctx context.Context, userID domain.UserID) ([]domain.QuotaUsage, error) {
+	policies, err := s.policiesFor(ctx, domain.PlanFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]domain.QuotaUsage, 0, len(policies))
+	for _, policy := range policies {
+		count, ok := s.counters[policy.Resource]
+		if !ok {
+			continue
+		}
+		used, err := count(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("error counting %s usage: %w", policy.Resource, err)
+		}
+		usages = append(usages, domain.QuotaUsage{Policy: policy, Used: used})
+	}
+	return usages, nil
+}
+
+// ObserveUsage mencatat UsageEvent jika pemakaian baru saja melewati ambang peringatan.
+// Pemakaian sebelumnya diperkirakan sebagai pemakaian saat ini dikurangi added.
+func (s *quotaService) ObserveUsage(ctx context.Context, userID domain.UserID, resource domain.QuotaResource, added int64) {
+	if added <= 0 {
+		return
+	}
+	if err := s.observeUsage(ctx, userID, resource, added); err != nil {
+		slog.ErrorContext(ctx, "error observing quota usage", "resource", resource, "user_id", userID, "error", err)
+	}
+}
+
+func (s *quotaService) observeUsage(ctx context.Context, userID domain.UserID, resource domain.QuotaResource, added int64) error {
+	count, ok := s.counters[resource]
+	if !ok {
+		return nil
+	}
+	plan := domain.PlanFromContext(ctx)
+	policies, err := s.policiesFor(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if policy.Resource != resource {
+			continue
+		}
+		used, err := count(ctx, userID)
+		if err != nil {
+			return err
+		}
+		threshold, crossed := policy.CrossedThreshold(used-added, used)
+		if !crossed {
+			return nil
+		}
+		return s.recorder.Record(ctx, domain.UsageEvent{
+			Type:       domain.UsageEventQuotaWarning,
+			UserID:     userID,
+			Plan:       policy.Plan,
+			Resource:   resource,
+			Threshold:  threshold,
+			Used:       used,
+			Limit:      policy.Limit,
+			OccurredAt: time.Now(),
+		})
+	}
+	return nil
+}
+
+// GetPlanPolicies mengembalikan kebijakan kuota untuk plan, tanpa fallback ke DefaultPlan.
+func (s *quotaService) GetPlanPolicies(ctx context.Context, plan domain.Plan) ([]domain.QuotaPolicy, error) {
+	return s.policyRepo.FindByPlan(ctx, plan)
+}
+
+// UpdatePolicy memvalidasi lalu menyimpan kebijakan kuota.
+func (s *quotaService) UpdatePolicy(ctx context.Context, policy domain.QuotaPolicy) error {
+	if policy.Plan == "" {
+		return domain.ErrInvalidQuotaPolicy
+	}
+	if _, ok := s.counters[policy.Resource]; !ok {
+		return domain.ErrInvalidQuotaPolicy
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	return s.policyRepo.Upsert(ctx, policy)
+}
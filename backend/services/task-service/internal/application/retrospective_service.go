@@ -0,0 +1,306 @@
+// file: backend/services/task-service/internal/application/retrospective_service.go
+package application
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// retrospectiveListSize adalah jumlah task maksimum di Highlights dan LongestOpen.
+const retrospectiveListSize = 5
+
+// retrospectiveTemplate adalah body email HTML retrospektif. Style ditulis inline karena banyak
+// klien email mengabaikan tag <style>.
+var retrospectiveTemplate = template.Must(template.New("retrospective").Funcs(template.FuncMap{
+	"duration": formatRetrospectiveDuration,
+}).Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #222; max-width: 600px; margin: 0 auto;">
+<h1 style="font-size: 22px;">Your {{.Month.Format "January 2006"}} retrospective</h1>
+<p>You completed <strong>{{.Completed}}</strong> task{{if ne .Completed 1}}s{{end}} on <strong>{{.ActiveDays}}</strong> day{{if ne .ActiveDays 1}}s{{end}}.
+Your longest streak was <strong>{{.LongestStreak}}</strong> day{{if ne .LongestStreak 1}}s{{end}} in a row.</p>
+{{- if .Highlights}}
+<h2 style="font-size: 18px;">Highlights</h2>
+<ul>{{range .Highlights}}<li>{{.Title}}{{if .EstimateMinutes}} ({{.EstimateMinutes}} min){{end}}</li>{{end}}</ul>
+{{- end}}
+{{- if .LongestOpen}}
+<h2 style="font-size: 18px;">Finally done</h2>
+<ul>{{range .LongestOpen}}<li>{{.Task.Title}} &mdash; open for {{duration .OpenFor}}</li>{{end}}</ul>
+{{- end}}
+{{- if .BusiestWeekdays}}
+<h2 style="font-size: 18px;">Busiest days</h2>
+<ul>{{range .BusiestWeekdays}}<li>{{.Weekday}}: {{.Tasks}}</li>{{end}}</ul>
+{{- end}}
+</body>
+</html>
+`))
+
+// UserLocationProvider mengembalikan zona waktu tersimpan pengguna (time_zone pada pengaturan
+// retrospektif), untuk fitur lain yang perlu hari kalender pengguna.
+type UserLocationProvider interface {
+	// UserLocation mengembalikan UTC jika pengguna belum menyimpan zona waktu yang valid.
+	UserLocation(ctx context.Context, userID domain.UserID) (*time.Location, error)
+}
+
+// RetrospectiveApplicationService mendefinisikan use case retrospektif bulanan.
+type RetrospectiveApplicationService interface {
+	UserLocationProvider
+
+	GetSettings(ctx context.Context, userID domain.UserID) (*domain.RetrospectiveSettings, error)
+
+	// SaveSettings mengaktifkan atau menonaktifkan retrospektif otomatis. timeZone adalah nama
+	// zona waktu IANA; kosong berarti UTC.
+	SaveSettings(ctx context.Context, userID domain.UserID, enabled bool, timeZone string) (*domain.RetrospectiveSettings, error)
+
+	// GetRetrospective mengembalikan retrospektif bulan month (YYYY-MM). Retrospektif yang sudah
+	// tersimpan dikembalikan apa adanya; selain itu disusun saat itu juga di zona waktu loc, atau
+	// zona waktu pengaturan pengguna jika loc nil.
+	GetRetrospective(ctx context.Context, userID domain.UserID, month string, loc *time.Location) (*domain.Retrospective, error)
+
+	// ListMonths mengembalikan bulan yang retrospektifnya sudah tersimpan.
+	ListMonths(ctx context.Context, userID domain.UserID) ([]string, error)
+
+	// GenerateDue membuat retrospektif bulan lalu untuk setiap pengguna yang mengaktifkannya dan
+	// belum memilikinya, lalu mengembalikan jumlah yang dibuat.
+	GenerateDue(ctx context.Context, now time.Time) (int, error)
+
+	// RunPeriodically menjalankan GenerateDue setiap interval sampai ctx dibatalkan.
+	RunPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// retrospectiveService adalah implementasi dari RetrospectiveApplicationService.
+type retrospectiveService struct {
+	retroRepo domain.RetrospectiveRepository
+	taskRepo  domain.TaskRepository
+}
+
+// NewRetrospectiveService adalah constructor untuk retrospectiveService.
+func NewRetrospectiveService(retroRepo domain.RetrospectiveRepository, taskRepo domain.TaskRepository) RetrospectiveApplicationService {
+	return &retrospectiveService{
+		retroRepo: retroRepo,
+		taskRepo:  taskRepo,
+	}
+}
+
+// GetSettings mengembalikan pengaturan retrospektif pengguna.
+func (s *retrospectiveService) GetSettings(ctx context.Context, userID domain.UserID) (*domain.RetrospectiveSettings, error) {
+	return s.retroRepo.FindSettings(ctx, userID)
+}
+
+// SaveSettings memvalidasi zona waktu sebelum menyimpan pengaturan.
+func (s *retrospectiveService) SaveSettings(ctx context.Context, userID domain.UserID, enabled bool, timeZone string) (*domain.RetrospectiveSettings, error) {
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return nil, domain.ErrInvalidTimeZone
+	}
+	settings := &domain.RetrospectiveSettings{
+		UserID:    userID,
+		Enabled:   enabled,
+		TimeZone:  timeZone,
+		UpdatedAt: time.Now(),
+	}
+	if err := s.retroRepo.SaveSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// GetRetrospective menyusun retrospektif bulan berjalan atau bulan tanpa hasil tersimpan secara
+// langsung tanpa menyimpannya; hanya job bulanan yang menyimpan retrospektif.
+func (s *retrospectiveService) GetRetrospective(ctx context.Context, userID domain.UserID, month string, loc *time.Location) (*domain.Retrospective, error) {
+	stored, err := s.retroRepo.Find(ctx, userID, month)
+	if err == nil {
+		return stored, nil
+	}
+	if !errors.Is(err, domain.ErrRetrospectiveNotFound) {
+		return nil, err
+	}
+
+	if loc == nil {
+		if loc, err = s.UserLocation(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	start, err := time.ParseInLocation("2006-01", month, loc)
+	if err != nil || start.After(time.Now()) {
+		return nil, domain.ErrInvalidRetrospectiveMonth
+	}
+	return s.build(ctx, userID, start)
+}
+
+// UserLocation membaca zona waktu dari pengaturan retrospektif pengguna.
+func (s *retrospectiveService) UserLocation(ctx context.Context, userID domain.UserID) (*time.Location, error) {
+	settings, err := s.retroRepo.FindSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(settings.TimeZone)
+	if err != nil {
+		return time.UTC, nil
+	}
+	return loc, nil
+}
+
+// ListMonths mengembalikan bulan yang retrospektifnya sudah tersimpan.
+func (s *retrospectiveService) ListMonths(ctx context.Context, userID domain.UserID) ([]string, error) {
+	return s.retroRepo.ListMonths(ctx, userID)
+}
+
+// GenerateDue memakai zona waktu setiap pengguna untuk menentukan bulan lalu, sehingga
+// retrospektif dibuat setelah bulan benar-benar berakhir di waktu lokal pengguna.
+func (s *retrospectiveService) GenerateDue(ctx context.Context, now time.Time) (int, error) {
+	enabled, err := s.retroRepo.FindEnabled(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for _, settings := range enabled {
+		loc, err := time.LoadLocation(settings.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+		local := now.In(loc)
+		previous := time.Date(local.Year(), local.Month()-1, 1, 0, 0, 0, 0, loc)
+
+		_, err = s.retroRepo.Find(ctx, settings.UserID, previous.Format("2006-01"))
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, domain.ErrRetrospectiveNotFound) {
+			return generated, err
+		}
+		retro, err := s.build(ctx, settings.UserID, previous)
+		if err != nil {
+			return generated, err
+		}
+		if err := s.retroRepo.Save(ctx, retro); err != nil {
+			return generated, err
+		}
+		generated++
+	}
+	return generated, nil
+}
+
+// RunPeriodically menjalankan GenerateDue berkala. Error hanya di-log dan dicoba lagi di putaran berikutnya.
+func (s *retrospectiveService) RunPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			generated, err := s.GenerateDue(ctx, time.Now())
+			if err != nil {
+				slog.ErrorContext(ctx, "error generating monthly retrospectives", "error", err)
+			}
+			if generated > 0 {
+				slog.InfoContext(ctx, "generated monthly retrospectives", "count", generated)
+			}
+		}
+	}
+}
+
+// build menyusun retrospektif untuk bulan yang dimulai pada start (tengah malam tanggal 1).
+func (s *retrospectiveService) build(ctx context.Context, userID domain.UserID, start time.Time) (*domain.Retrospective, error) {
+	loc := start.Location()
+	end := start.AddDate(0, 1, 0)
+	tasks, err := s.taskRepo.FindCompletedBetween(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	retro := &domain.Retrospective{
+		UserID:      userID,
+		Month:       start,
+		TimeZone:    loc.String(),
+		Completed:   len(tasks),
+		GeneratedAt: time.Now(),
+	}
+
+	// FindCompletedBetween sudah mengurutkan dari yang terakhir diselesaikan; SortStableFunc
+	// mempertahankan urutan itu untuk task dengan estimasi yang sama.
+	highlights := slices.Clone(tasks)
+	slices.SortStableFunc(highlights, func(a, b *domain.Task) int {
+		return cmp.Compare(estimateOf(b), estimateOf(a))
+	})
+	retro.Highlights = highlights[:min(len(highlights), retrospectiveListSize)]
+
+	longest := make([]domain.RetrospectiveTask, 0, len(tasks))
+	for _, task := range tasks {
+		longest = append(longest, domain.RetrospectiveTask{Task: task, OpenFor: task.CompletedAt.Sub(task.CreatedAt)})
+	}
+	slices.SortStableFunc(longest, func(a, b domain.RetrospectiveTask) int {
+		return cmp.Compare(b.OpenFor, a.OpenFor)
+	})
+	retro.LongestOpen = longest[:min(len(longest), retrospectiveListSize)]
+
+	var weekdays [7]int
+	activeDays := make(map[int]bool)
+	for _, task := range tasks {
+		completedAt := task.CompletedAt.In(loc)
+		weekdays[completedAt.Weekday()]++
+		activeDays[completedAt.Day()] = true
+	}
+	for weekday, count := range weekdays {
+		if count > 0 {
+			retro.BusiestWeekdays = append(retro.BusiestWeekdays, domain.WeekdayCount{Weekday: time.Weekday(weekday), Tasks: count})
+		}
+	}
+	slices.SortStableFunc(retro.BusiestWeekdays, func(a, b domain.WeekdayCount) int {
+		return cmp.Compare(b.Tasks, a.Tasks)
+	})
+
+	streak := 0
+	for day := 1; day <= end.AddDate(0, 0, -1).Day(); day++ {
+		if !activeDays[day] {
+			streak = 0
+			continue
+		}
+		streak++
+		retro.ActiveDays++
+		retro.LongestStreak = max(retro.LongestStreak, streak)
+	}
+	return retro, nil
+}
+
+// RenderRetrospectiveHTML merender retrospektif sebagai body email HTML. Judul task di-escape
+// oleh html/template.
+func RenderRetrospectiveHTML(retro *domain.Retrospective) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := retrospectiveTemplate.Execute(&buf, retro); err != nil {
+		return nil, fmt.Errorf("error rendering retrospective: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// estimateOf mengembalikan EstimateMinutes task, atau 0 jika belum diestimasi.
+func estimateOf(task *domain.Task) int {
+	if task.EstimateMinutes == nil {
+		return 0
+	}
+	return *task.EstimateMinutes
+}
+
+// formatRetrospectiveDuration menampilkan durasi dalam hari, atau jam jika kurang dari sehari.
+func formatRetrospectiveDuration(d time.Duration) string {
+	if days := int(d.Hours() / 24); days > 0 {
+		if days == 1 {
+			return "1 day"
+		}
+		return fmt.Sprintf("%d days", days)
+	}
+	if hours := int(d.Hours()); hours != 1 {
+		return fmt.Sprintf("%d hours", hours)
+	}
+	return "1 hour"
+}
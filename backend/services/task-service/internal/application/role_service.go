@@ -0,0 +1,97 @@
+// file: backend/services/task-service/internal/application/role_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// RoleApplicationService mendefinisikan use case pengelolaan role aplikasi oleh admin.
+type RoleApplicationService interface {
+	// ResolveRole mengembalikan role tersimpan pengguna, atau domain.RoleUser jika tidak ada.
+	// Dipakai middleware autentikasi untuk pengguna yang JWT-nya tidak membawa role admin.
+	ResolveRole(ctx context.Context, userID domain.UserID) (domain.Role, error)
+
+	// ListRoles mengembalikan semua role yang diberikan lewat SetRole.
+	ListRoles(ctx context.Context) ([]domain.UserRole, error)
+
+	// SetRole mengganti role pengguna dan mencatatnya di audit log. domain.RoleUser menghapus role
+	// tersimpan. Mengembalikan ErrInvalidRole, ErrAuditReasonRequired, atau ErrCannotChangeOwnRole
+	// agar admin tidak mencabut akses dirinya sendiri secara tidak sengaja.
+	SetRole(ctx context.Context, adminID, userID domain.UserID, role domain.Role, reason string) (*domain.UserRole, error)
+}
+
+// roleService adalah implementasi dari RoleApplicationService.
+type roleService struct {
+	roleRepo  domain.RoleRepository
+	auditRepo domain.AdminAuditRepository
+}
+
+// NewRoleService adalah constructor untuk roleService.
+func NewRoleService(roleRepo domain.RoleRepository, auditRepo domain.AdminAuditRepository) RoleApplicationService {
+	return &roleService{
+		roleRepo:  roleRepo,
+		auditRepo: auditRepo,
+	}
+}
+
+// ResolveRole membaca role dari repository.
+func (s *roleService) ResolveRole(ctx context.Context, userID domain.UserID) (domain.Role, error) {
+	return s.roleRepo.FindRole(ctx, userID)
+}
+
+// ListRoles membaca semua role tersimpan.
+func (s *roleService) ListRoles(ctx context.Context) ([]domain.UserRole, error) {
+	return s.roleRepo.ListRoles(ctx)
+}
+
+// SetRole mencatat audit log setelah role disimpan; jika pencatatan gagal, error dikembalikan
+// meskipun role sudah berubah, sama seperti aksi admin lain.
+func (s *roleService) SetRole(ctx context.Context, adminID, userID domain.UserID, role domain.Role, reason string) (*domain.UserRole, error) {
+	if err := role.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: role must be one of %v", err, domain.Roles)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, domain.ErrAuditReasonRequired
+	}
+	if userID == adminID {
+		return nil, domain.ErrCannotChangeOwnRole
+	}
+
+	userRole := &domain.UserRole{
+		UserID:    userID,
+		Role:      role,
+		GrantedBy: adminID,
+		GrantedAt: time.Now(),
+	}
+	var err error
+	if role == domain.RoleUser {
+		err = s.roleRepo.DeleteRole(ctx, userID)
+	} else {
+		err = s.roleRepo.SetRole(ctx, *userRole)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.auditRepo.Record(ctx, domain.AdminAuditEntry{
+		AdminID: adminID,
+		Action:  "admin.role.set",
+		Reason:  reason,
+		Details: map[string]any{
+			"user_id": userID,
+			"role":    role,
+		},
+		ResultCount: 1,
+		OccurredAt:  userRole.GrantedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error writing admin audit log: %w", err)
+	}
+	return userRole, nil
+}
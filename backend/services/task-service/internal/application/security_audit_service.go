@@ -0,0 +1,289 @@
+// file: backend/services/task-service/internal/application/security_audit_service.go
+package application
+
+import (
+	"context"
+	"log/slog"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	// DefaultSecurityAuditRetention adalah lama entri audit log keamanan disimpan.
+	DefaultSecurityAuditRetention = 90 * 24 * time.Hour
+
+	securityAuditQueueSize = 1024
+
+	// securityAuditIPRecheck adalah lama IP terakhir pengguna di-cache per replika sebelum dibaca
+	// ulang dari database, agar perpindahan IP yang dilayani replika lain tetap terdeteksi.
+	securityAuditIPRecheck = 10 * time.Minute
+
+	// securityAuditKeyUseInterval membatasi entri api_key_used menjadi satu per token dan IP per
+	// interval, agar script yang memanggil API terus-menerus tidak membanjiri audit log.
+	securityAuditKeyUseInterval = time.Hour
+
+	// securityAuditCacheSize adalah jumlah entri cache maksimum sebelum cache dikosongkan.
+	securityAuditCacheSize = 10000
+)
+
+// SecurityEventSearchInput adalah parameter pencarian audit log keamanan oleh admin.
+type SecurityEventSearchInput struct {
+	Filter domain.SecurityEventFilter
+	Reason string // Wajib diisi, dicatat di audit log admin
+}
+
+// SecurityAuditApplicationService mendefinisikan use case audit log keamanan: pencatatan hasil
+// autentikasi dan pencarian oleh admin.
+type SecurityAuditApplicationService interface {
+	// RecordAttempt mengantrekan hasil autentikasi tanpa memblokir request. Attempt yang gagal
+	// dicatat sebagai token_rejected, atau token_ip_rejected jika token-nya diketahui (ditolak
+	// allowlist IP); yang berhasil diperiksa untuk ip_changed dan api_key_used.
+	RecordAttempt(ctx context.Context, attempt domain.AuthAttempt)
+
+	// Search mencari entri audit log keamanan. Setiap pencarian dicatat di audit log admin; jika
+	// pencatatan gagal, hasil tidak dikembalikan.
+	Search(ctx context.Context, adminID domain.UserID, input SecurityEventSearchInput) ([]domain.SecurityEvent, error)
+
+	// Run menyimpan attempt dari antrean sampai ctx dibatalkan. Berjalan di setiap replika.
+	Run(ctx context.Context)
+
+	// RunPurgePeriodically menghapus entri yang lebih lama dari retensi setiap interval sampai ctx
+	// dibatalkan.
+	RunPurgePeriodically(ctx context.Context, interval time.Duration)
+}
+
+// cachedAt adalah nilai cache beserta waktu pengisiannya.
+type cachedAt struct {
+	value string
+	at    time.Time
+}
+
+// securityAuditService adalah implementasi dari SecurityAuditApplicationService.
+type securityAuditService struct {
+	repo      domain.SecurityAuditRepository
+	auditRepo domain.AdminAuditRepository
+	retention time.Duration
+	queue     chan domain.AuthAttempt
+
+	// Hanya diakses goroutine Run.
+	lastIPs map[domain.UserID]cachedAt
+	keyUses map[string]time.Time
+}
+
+// NewSecurityAuditService adalah constructor untuk securityAuditService. Run harus dijalankan agar
+// attempt yang diantrekan benar-benar disimpan.
+func NewSecurityAuditService(repo domain.SecurityAuditRepository, auditRepo domain.AdminAuditRepository, retention time.Duration) SecurityAuditApplicationService {
+	return &securityAuditService{
+		repo:      repo,
+		auditRepo: auditRepo,
+		retention: retention,
+		queue:     make(chan domain.AuthAttempt, securityAuditQueueSize),
+		lastIPs:   make(map[domain.UserID]cachedAt),
+		keyUses:   make(map[string]time.Time),
+	}
+}
+
+// RecordAttempt membuang attempt jika antrean penuh, seperti NotifyingPublisher.
+func (s *securityAuditService) RecordAttempt(ctx context.Context, attempt domain.AuthAttempt) {
+	if attempt.OccurredAt.IsZero() {
+		attempt.OccurredAt = time.Now().UTC()
+	}
+	select {
+	case s.queue <- attempt:
+	default:
+		slog.WarnContext(ctx, "security audit queue full, dropping auth attempt", "credential", attempt.Credential)
+	}
+}
+
+// Search memvalidasi filter, membaca entri dari repository, lalu mencatat audit log admin.
+func (s *securityAuditService) Search(ctx context.Context, adminID domain.UserID, input SecurityEventSearchInput) ([]domain.SecurityEvent, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, domain.ErrAuditReasonRequired
+	}
+	filter := input.Filter
+	if filter.Type != "" {
+		if err := filter.Type.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	events, err := s.repo.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	details := map[string]any{"limit": filter.Limit}
+	if filter.UserID != "" {
+		details["user_id"] = filter.UserID
+	}
+	if filter.Type != "" {
+		details["type"] = filter.Type
+	}
+	if filter.IP != "" {
+		details["ip"] = filter.IP
+	}
+	err = s.auditRepo.Record(ctx, domain.AdminAuditEntry{
+		AdminID:     adminID,
+		Action:      "admin.security_events.search",
+		Reason:      reason,
+		Details:     details,
+		ResultCount: len(events),
+		OccurredAt:  time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Run memproses attempt satu per satu. Error penyimpanan hanya di-log.
+func (s *securityAuditService) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case attempt := <-s.queue:
+			for _, event := range s.events(ctx, attempt) {
+				if err := s.repo.Record(ctx, event); err != nil {
+					slog.ErrorContext(ctx, "error recording security event", "type", event.Type, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// events menerjemahkan satu attempt menjadi entri audit log yang perlu disimpan.
+func (s *securityAuditService) events(ctx context.Context, attempt domain.AuthAttempt) []domain.SecurityEvent {
+	base := domain.SecurityEvent{
+		UserID:     attempt.UserID,
+		IP:         attempt.IP,
+		UserAgent:  attempt.UserAgent,
+		OccurredAt: attempt.OccurredAt,
+	}
+	if attempt.Failure != "" && attempt.TokenID != "" {
+		event := base
+		event.Type = domain.SecurityEventTokenIPRejected
+		event.Details = map[string]any{
+			"token_id":   attempt.TokenID,
+			"token_name": attempt.TokenName,
+			"reason":     attempt.Failure,
+			"method":     attempt.Method,
+			"path":       attempt.Path,
+		}
+		return []domain.SecurityEvent{event}
+	}
+	if attempt.Failure != "" {
+		event := base
+		event.Type = domain.SecurityEventTokenRejected
+		event.Details = map[string]any{
+			"credential": attempt.Credential,
+			"reason":     attempt.Failure,
+			"method":     attempt.Method,
+			"path":       attempt.Path,
+		}
+		return []domain.SecurityEvent{event}
+	}
+
+	var events []domain.SecurityEvent
+	if attempt.TokenID != "" && s.markKeyUse(attempt) {
+		event := base
+		event.Type = domain.SecurityEventAPIKeyUsed
+		event.Details = map[string]any{
+			"token_id":   attempt.TokenID,
+			"token_name": attempt.TokenName,
+			"method":     attempt.Method,
+			"path":       attempt.Path,
+		}
+		events = append(events, event)
+	}
+	if previous, changed := s.checkIP(ctx, attempt); changed {
+		event := base
+		event.Type = domain.SecurityEventIPChanged
+		event.Details = map[string]any{
+			"previous_ip": previous,
+			"credential":  attempt.Credential,
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// markKeyUse melaporkan apakah pemakaian token dari IP ini belum dicatat dalam
+// securityAuditKeyUseInterval terakhir.
+func (s *securityAuditService) markKeyUse(attempt domain.AuthAttempt) bool {
+	key := attempt.TokenID + "|" + attempt.IP
+	if last, ok := s.keyUses[key]; ok && attempt.OccurredAt.Sub(last) < securityAuditKeyUseInterval {
+		return false
+	}
+	if len(s.keyUses) >= securityAuditCacheSize {
+		clear(s.keyUses)
+	}
+	s.keyUses[key] = attempt.OccurredAt
+	return true
+}
+
+// checkIP menyimpan IP terakhir pengguna dan melaporkan perpindahan ke jaringan lain (lihat
+// suspiciousIPChange) beserta IP sebelumnya. Database hanya ditulis jika IP berbeda dari cache
+// atau cache sudah lebih lama dari securityAuditIPRecheck.
+func (s *securityAuditService) checkIP(ctx context.Context, attempt domain.AuthAttempt) (string, bool) {
+	if attempt.UserID == "" || attempt.IP == "" {
+		return "", false
+	}
+	if cached, ok := s.lastIPs[attempt.UserID]; ok && cached.value == attempt.IP &&
+		attempt.OccurredAt.Sub(cached.at) < securityAuditIPRecheck {
+		return "", false
+	}
+	previous, err := s.repo.SwapLastIP(ctx, attempt.UserID, attempt.IP, attempt.OccurredAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "error updating last ip", "user_id", attempt.UserID, "error", err)
+		return "", false
+	}
+	if len(s.lastIPs) >= securityAuditCacheSize {
+		clear(s.lastIPs)
+	}
+	s.lastIPs[attempt.UserID] = cachedAt{value: attempt.IP, at: attempt.OccurredAt}
+	return previous, suspiciousIPChange(previous, attempt.IP)
+}
+
+// suspiciousIPChange melaporkan apakah current berada di jaringan lain dari previous: di luar /24
+// yang sama untuk IPv4 atau /48 yang sama untuk IPv6. Perpindahan antara IPv4 dan IPv6 tidak
+// dianggap mencurigakan karena umum pada jaringan dual-stack.
+func suspiciousIPChange(previous, current string) bool {
+	prev, err := netip.ParseAddr(previous)
+	if err != nil {
+		return false
+	}
+	curr, err := netip.ParseAddr(current)
+	if err != nil || prev.Is4() != curr.Is4() {
+		return false
+	}
+	bits := 48
+	if prev.Is4() {
+		bits = 24
+	}
+	network, err := prev.Prefix(bits)
+	return err == nil && !network.Contains(curr)
+}
+
+// RunPurgePeriodically hanya me-log error; purge dicoba lagi di putaran berikutnya.
+func (s *securityAuditService) RunPurgePeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.repo.DeleteBefore(ctx, time.Now().Add(-s.retention))
+			if err != nil {
+				slog.ErrorContext(ctx, "error purging security audit log", "error", err)
+				continue
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "purged security audit log", "count", purged)
+			}
+		}
+	}
+}
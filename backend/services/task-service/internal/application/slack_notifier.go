@@ -0,0 +1,71 @@
+// file: backend/services/task-service/internal/application/slack_notifier.go
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// slackNotifier adalah EventNotifier yang mengirim penugasan dan penyelesaian task ke Slack.
+// Pengingat tenggat dikirim oleh SlackApplicationService.SendReminders.
+type slackNotifier struct {
+	channelRepo domain.SlackChannelRepository
+	client      domain.SlackClient
+}
+
+// NewSlackNotifier adalah constructor untuk slackNotifier.
+func NewSlackNotifier(channelRepo domain.SlackChannelRepository, client domain.SlackClient) EventNotifier {
+	return &slackNotifier{
+		channelRepo: channelRepo,
+		client:      client,
+	}
+}
+
+// Notify mengirim TaskAssigned ke channel Slack penerima tugas, dan task yang selesai ke channel
+// pemiliknya. Penyelesaian diklaim per CompletedAt, karena update berikutnya pada task yang sudah
+// selesai juga membawa Completed.
+func (n *slackNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	if event.Task == nil {
+		return
+	}
+	var (
+		eventType domain.SlackEventType
+		heading   string
+	)
+	switch {
+	case event.Type == domain.TaskAssigned:
+		eventType, heading = domain.SlackEventAssigned, "Assigned to you"
+	case (event.Type == domain.TaskCreated || event.Type == domain.TaskUpdated) && event.Task.Completed && event.Task.CompletedAt != nil:
+		eventType, heading = domain.SlackEventCompleted, "Task completed"
+	default:
+		return
+	}
+
+	channel, err := n.channelRepo.FindByUserID(ctx, event.UserID)
+	if errors.Is(err, domain.ErrSlackChannelNotFound) {
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading slack channel", "user_id", event.UserID, "error", err)
+		return
+	}
+	if !channel.Accepts(eventType) {
+		return
+	}
+	if eventType == domain.SlackEventCompleted {
+		claimed, err := n.channelRepo.ClaimCompletion(ctx, event.TaskID, *event.Task.CompletedAt)
+		if err != nil {
+			slog.ErrorContext(ctx, "error claiming slack completion", "task_id", event.TaskID, "error", err)
+			return
+		}
+		if !claimed {
+			return
+		}
+	}
+	if err := sendSlackMessage(ctx, n.client, channel, slackTaskMessage(heading, event.Task)); err != nil {
+		slog.ErrorContext(ctx, "error sending event to slack", "event_type", event.Type, "user_id", event.UserID, "error", err)
+	}
+}
@@ -0,0 +1,259 @@
+// file: backend/services/task-service/internal/application/slack_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// slackReminderBatchSize adalah jumlah pengingat Slack maksimum yang dikirim dalam satu putaran job.
+const slackReminderBatchSize = 100
+
+// Batas panjang teks pesan Slack yang dipakai di sini (batas Slack untuk teks section: 3000).
+const (
+	slackTitleLength       = 200
+	slackDescriptionLength = 1000
+)
+
+// slackWebhookHost adalah satu-satunya host incoming webhook Slack. URL lain ditolak agar pengaturan
+// Slack tidak bisa dipakai untuk mengirim request ke alamat sembarang.
+const slackWebhookHost = "hooks.slack.com"
+
+// SaveSlackChannelInput adalah tujuan dan pilihan notifikasi Slack. Tepat satu dari WebhookURL dan
+// ChannelID harus diisi; BotToken wajib bersama ChannelID kecuali bot token yang tersimpan dipakai lagi.
+type SaveSlackChannelInput struct {
+	WebhookURL   string
+	BotToken     string
+	ChannelID    string
+	EventTypes   []domain.SlackEventType
+	ReminderLead time.Duration
+}
+
+// SlackApplicationService mendefinisikan use case integrasi Slack.
+type SlackApplicationService interface {
+	GetChannel(ctx context.Context, userID domain.UserID) (*domain.SlackChannel, error)
+
+	// SaveChannel memvalidasi tujuan dengan mengirim pesan percobaan sebelum menyimpannya.
+	SaveChannel(ctx context.Context, userID domain.UserID, input SaveSlackChannelInput) (*domain.SlackChannel, error)
+	DeleteChannel(ctx context.Context, userID domain.UserID) error
+
+	// SendReminders mengirim pengingat untuk task yang tenggatnya sudah dekat dan mengembalikan
+	// jumlah task yang diingatkan. Setiap tenggat hanya diingatkan sekali.
+	SendReminders(ctx context.Context) (int, error)
+
+	// RunRemindersPeriodically menjalankan SendReminders setiap interval sampai ctx dibatalkan.
+	RunRemindersPeriodically(ctx context.Context, interval time.Duration)
+}
+
+// slackService adalah implementasi dari SlackApplicationService.
+type slackService struct {
+	channelRepo domain.SlackChannelRepository
+	client      domain.SlackClient
+}
+
+// NewSlackService adalah constructor untuk slackService.
+func NewSlackService(channelRepo domain.SlackChannelRepository, client domain.SlackClient) SlackApplicationService {
+	return &slackService{
+		channelRepo: channelRepo,
+		client:      client,
+	}
+}
+
+// GetChannel mengembalikan pengaturan Slack milik pengguna.
+func (s *slackService) GetChannel(ctx context.Context, userID domain.UserID) (*domain.SlackChannel, error) {
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// SaveChannel menyimpan tujuan notifikasi setelah pesan percobaan berhasil dikirim. Bot token tidak
+// pernah dikembalikan API, jadi BotToken kosong dengan ChannelID berarti memakai token yang tersimpan.
+func (s *slackService) SaveChannel(ctx context.Context, userID domain.UserID, input SaveSlackChannelInput) (*domain.SlackChannel, error) {
+	channel := &domain.SlackChannel{
+		UserID:       userID,
+		WebhookURL:   strings.TrimSpace(input.WebhookURL),
+		BotToken:     strings.TrimSpace(input.BotToken),
+		ChannelID:    strings.TrimSpace(input.ChannelID),
+		EventTypes:   slices.Compact(slices.Sorted(slices.Values(input.EventTypes))),
+		ReminderLead: input.ReminderLead.Truncate(time.Minute),
+		UpdatedAt:    time.Now(),
+	}
+	if (channel.WebhookURL == "") == (channel.ChannelID == "") {
+		return nil, fmt.Errorf("%w: exactly one of webhook_url and channel_id is required", domain.ErrInvalidSlackChannel)
+	}
+	if channel.WebhookURL != "" {
+		if channel.BotToken != "" {
+			return nil, fmt.Errorf("%w: bot_token is only used with channel_id", domain.ErrInvalidSlackChannel)
+		}
+		if !isSlackWebhookURL(channel.WebhookURL) {
+			return nil, fmt.Errorf("%w: webhook_url must be a Slack incoming webhook URL", domain.ErrInvalidSlackChannel)
+		}
+	}
+	if channel.ChannelID != "" {
+		if !isSlackChannelID(channel.ChannelID) {
+			return nil, fmt.Errorf("%w: channel_id must be a Slack channel ID", domain.ErrInvalidSlackChannel)
+		}
+		if channel.BotToken == "" {
+			existing, err := s.channelRepo.FindByUserID(ctx, userID)
+			if err != nil && !errors.Is(err, domain.ErrSlackChannelNotFound) {
+				return nil, err
+			}
+			if existing != nil {
+				channel.BotToken = existing.BotToken
+			}
+		}
+		if !strings.HasPrefix(channel.BotToken, "xoxb-") {
+			return nil, fmt.Errorf("%w: bot_token must be a Slack bot token (xoxb-...)", domain.ErrInvalidSlackChannel)
+		}
+	}
+	for _, eventType := range channel.EventTypes {
+		if !slices.Contains(domain.SlackEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", domain.ErrInvalidSlackChannel, eventType)
+		}
+	}
+	if channel.ReminderLead < 0 || channel.ReminderLead > domain.MaxSlackReminderLead {
+		return nil, fmt.Errorf("%w: reminder lead must be between 0 and %s", domain.ErrInvalidSlackChannel, domain.MaxSlackReminderLead)
+	}
+
+	msg := domain.SlackMessage{
+		Text: "Notifications connected",
+		Blocks: []domain.SlackBlock{{
+			Type: "section",
+			Text: &domain.SlackText{Type: "mrkdwn", Text: "*Notifications connected*\nTask notifications will be posted here."},
+		}},
+	}
+	if err := sendSlackMessage(ctx, s.client, channel, msg); err != nil {
+		if errors.Is(err, domain.ErrSlackRateLimited) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: test message failed: %v", domain.ErrInvalidSlackChannel, err)
+	}
+	if err := s.channelRepo.Save(ctx, channel); err != nil {
+		return nil, err
+	}
+	return s.channelRepo.FindByUserID(ctx, userID)
+}
+
+// DeleteChannel menghapus pengaturan Slack milik pengguna.
+func (s *slackService) DeleteChannel(ctx context.Context, userID domain.UserID) error {
+	return s.channelRepo.Delete(ctx, userID)
+}
+
+// SendReminders mengklaim setiap pengingat sebelum dikirim. Klaim dilepas lagi jika pengiriman
+// gagal, agar dicoba di putaran berikutnya selama tenggatnya belum lewat.
+func (s *slackService) SendReminders(ctx context.Context) (int, error) {
+	now := time.Now()
+	reminders, err := s.channelRepo.FindDueReminders(ctx, now, slackReminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, reminder := range reminders {
+		task := reminder.Task
+		claimed, err := s.channelRepo.ClaimReminder(ctx, task.ID, *task.DueAt, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := sendSlackMessage(ctx, s.client, reminder.Channel, slackReminderMessage(task)); err != nil {
+			slog.ErrorContext(ctx, "error sending slack reminder", "task_id", task.ID, "error", err)
+			if err := s.channelRepo.ReleaseReminder(ctx, task.ID, *task.DueAt); err != nil {
+				slog.ErrorContext(ctx, "error releasing slack reminder", "task_id", task.ID, "error", err)
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// RunRemindersPeriodically mengirim pengingat Slack berkala. Error hanya di-log dan dicoba lagi di
+// putaran berikutnya.
+func (s *slackService) RunRemindersPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.SendReminders(ctx); err != nil {
+				slog.ErrorContext(ctx, "error sending slack reminders", "error", err)
+			}
+		}
+	}
+}
+
+// sendSlackMessage mengirim msg ke tujuan channel.
+func sendSlackMessage(ctx context.Context, client domain.SlackClient, channel *domain.SlackChannel, msg domain.SlackMessage) error {
+	if channel.WebhookURL != "" {
+		return client.SendWebhook(ctx, channel.WebhookURL, msg)
+	}
+	return client.PostMessage(ctx, channel.BotToken, channel.ChannelID, msg)
+}
+
+// slackReminderMessage membentuk pesan pengingat tenggat.
+func slackReminderMessage(task *domain.Task) domain.SlackMessage {
+	return slackTaskMessage("Due soon", task)
+}
+
+// slackTaskMessage membentuk pesan berisi heading, judul, deskripsi, dan tenggat task. Teks task
+// di-escape sehingga judul seperti "<!channel>" tidak menjadi mention atau link.
+func slackTaskMessage(heading string, task *domain.Task) domain.SlackMessage {
+	title := truncateRunes(strings.Join(strings.Fields(task.Title), " "), slackTitleLength)
+	text := "*" + heading + ":* " + escapeSlackText(title)
+	if description := strings.TrimSpace(task.Description); description != "" {
+		text += "\n" + escapeSlackText(truncateRunes(description, slackDescriptionLength))
+	}
+	blocks := []domain.SlackBlock{{Type: "section", Text: &domain.SlackText{Type: "mrkdwn", Text: text}}}
+	elements := []domain.SlackText{{Type: "mrkdwn", Text: "ID " + escapeSlackText(task.ID)}}
+	if task.DueAt != nil {
+		elements = append(elements, domain.SlackText{Type: "mrkdwn", Text: "Due " + slackDate(*task.DueAt)})
+	}
+	blocks = append(blocks, domain.SlackBlock{Type: "context", Elements: elements})
+	return domain.SlackMessage{
+		Text:   heading + ": " + escapeSlackText(title),
+		Blocks: blocks,
+	}
+}
+
+// slackDate menulis t dengan format tanggal Slack, yang ditampilkan dalam zona waktu pembaca.
+// Teks setelah "|" adalah fallback untuk klien yang tidak mendukungnya.
+func slackDate(t time.Time) string {
+	return fmt.Sprintf("<!date^%d^{date_short_pretty} {time}|%s>", t.Unix(), t.UTC().Format("2 Jan 2006 15:04 UTC"))
+}
+
+// escapeSlackText meng-escape karakter kontrol format mrkdwn Slack (&, <, >).
+func escapeSlackText(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// isSlackWebhookURL bernilai true untuk URL https://hooks.slack.com/services/....
+func isSlackWebhookURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.User != nil || u.Port() != "" {
+		return false
+	}
+	return u.Hostname() == slackWebhookHost && strings.HasPrefix(u.Path, "/services/")
+}
+
+// isSlackChannelID bernilai true jika s berbentuk ID channel Slack (misalnya C0123ABCD).
+func isSlackChannelID(s string) bool {
+	if len(s) < 9 || len(s) > 20 || !strings.ContainsRune("CGD", rune(s[0])) {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+// file: backend/services/task-service/internal/application/stats_service.go
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// maxStatsDays adalah jumlah hari terbanyak pada GetStats.
+const maxStatsDays = 90
+
+// StatsApplicationService mendefinisikan use case statistik produktivitas pengguna.
+type StatsApplicationService interface {
+	// GetStats mengembalikan statistik untuk days hari kalender terakhir (termasuk hari ini) di
+	// zona waktu loc. days dibatasi antara 1 dan maxStatsDays.
+	GetStats(ctx context.Context, userID domain.UserID, days int, loc *time.Location) (*domain.ProductivityStats, error)
+}
+
+// statsService adalah implementasi dari StatsApplicationService.
+type statsService struct {
+	taskRepo domain.TaskRepository
+}
+
+// NewStatsService adalah constructor untuk statsService.
+func NewStatsService(taskRepo domain.TaskRepository) StatsApplicationService {
+	return &statsService{
+		taskRepo: taskRepo,
+	}
+}
+
+// GetStats menghitung statistik lalu melengkapi ByDay dengan hari tanpa aktivitas, seperti
+// GetEstimateSummary, agar klien bisa langsung menggambar grafik. Streak tidak bergantung pada
+// rentang maupun loc.
+func (s *statsService) GetStats(ctx context.Context, userID domain.UserID, days int, loc *time.Location) (*domain.ProductivityStats, error) {
+	days = min(max(days, 1), maxStatsDays)
+	now := time.Now().In(loc)
+	to := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+	from := to.AddDate(0, 0, -days)
+
+	stats, err := s.taskRepo.SummarizeProductivity(ctx, userID, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+	byDate := make(map[string]domain.DailyActivity, len(stats.ByDay))
+	for _, day := range stats.ByDay {
+		byDate[day.Date.Format(time.DateOnly)] = day
+	}
+	stats.ByDay = make([]domain.DailyActivity, 0, days)
+	for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+		day, ok := byDate[date.Format(time.DateOnly)]
+		if !ok {
+			day = domain.DailyActivity{Date: date}
+		}
+		stats.ByDay = append(stats.ByDay, day)
+	}
+
+	stats.Streak, err = s.taskRepo.FindCompletionStreak(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
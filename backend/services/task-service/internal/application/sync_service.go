@@ -0,0 +1,196 @@
+// file: backend/services/task-service/internal/application/sync_service.go
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// SyncChanges adalah hasil delta sync: task yang berubah sejak cursor sebelumnya,
+// beserta cursor baru yang harus dikirim klien pada sinkronisasi berikutnya.
+type SyncChanges struct {
+	Tasks  []*domain.Task
+	Cursor int64
+}
+
+// PushTaskInput adalah satu task yang dikirim klien saat push sync.
+// ID wajib diisi oleh klien (format sesuai IDGenerator) agar push aman diulang.
+type PushTaskInput struct {
+	ID          string
+	Title       string
+	Description string
+	Completed   bool
+}
+
+// SyncApplicationService mendefinisikan use case sinkronisasi untuk klien offline-first (misalnya mobile).
+// deviceID (header X-Device-ID) bersifat opsional; jika diisi, diagnostik sync perangkat dicatat
+// dan perangkat yang sudah dicabut ditolak dengan ErrDeviceRevoked.
+type SyncApplicationService interface {
+	// PullChanges mengambil semua task milik pengguna yang berubah sejak cursor since (lihat
+	// domain.TaskChanges). since bernilai 0 berarti full sync. Task yang sudah dikirim bisa
+	// terkirim lagi, sehingga klien harus menerapkannya sebagai upsert. Task yang dihapus belum
+	// ikut terkirim karena penghapusan saat ini masih berupa hard delete.
+	PullChanges(ctx context.Context, userID domain.UserID, deviceID string, since int64) (*SyncChanges, error)
+
+	// PushChanges menyimpan task yang dibuat/diubah klien saat offline dengan semantik upsert,
+	// sehingga push aman dikirim ulang secara utuh. Hasil berisi satu BatchItemResult per input.
+	// Error hanya dikembalikan jika perangkat tidak valid; kegagalan per task ada di hasilnya.
+	PushChanges(ctx context.Context, userID domain.UserID, deviceID string, inputs []PushTaskInput) ([]BatchItemResult, error)
+}
+
+// syncService adalah implementasi dari SyncApplicationService.
+type syncService struct {
+	taskRepo   domain.TaskRepository
+	deviceRepo domain.DeviceRepository
+	publisher  domain.TaskEventPublisher
+	idGen      domain.IDGenerator
+	quota      QuotaMonitor
+}
+
+// NewSyncService adalah constructor untuk syncService.
+func NewSyncService(repo domain.TaskRepository, deviceRepo domain.DeviceRepository, publisher domain.TaskEventPublisher, idGen domain.IDGenerator, quota QuotaMonitor) SyncApplicationService {
+	return &syncService{
+		taskRepo:   repo,
+		deviceRepo: deviceRepo,
+		publisher:  publisher,
+		idGen:      idGen,
+		quota:      quota,
+	}
+}
+
+// syncDevice mengambil perangkat dari deviceID, atau nil jika klien tidak mengirim ID perangkat.
+func (s *syncService) syncDevice(ctx context.Context, userID domain.UserID, deviceID string) (*domain.Device, error) {
+	if deviceID == "" {
+		return nil, nil
+	}
+	return resolveSyncDevice(ctx, s.deviceRepo, userID, deviceID)
+}
+
+// saveSyncState menyimpan diagnostik sync perangkat. Diagnostik bersifat best-effort, jadi
+// kegagalan hanya di-log dan tidak menggagalkan sync.
+func (s *syncService) saveSyncState(ctx context.Context, device *domain.Device) {
+	if err := s.deviceRepo.SaveSyncState(ctx, device); err != nil {
+		slog.ErrorContext(ctx, "error saving sync state", "device_id", device.ID, "error", err)
+	}
+}
+
+// PullChanges mengambil perubahan task sejak cursor since.
+func (s *syncService) PullChanges(ctx context.Context, userID domain.UserID, deviceID string, since int64) (*SyncChanges, error) {
+	device, err := s.syncDevice(ctx, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := s.taskRepo.FindChangedSince(ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	if device != nil {
+		now := time.Now()
+		device.Sync.LastPullAt = &now
+		device.Sync.LastCursor = &changes.Cursor
+		s.saveSyncState(ctx, device)
+	}
+
+	return &SyncChanges{
+		Tasks:  changes.Tasks,
+		Cursor: changes.Cursor,
+	}, nil
+}
+
+// PushChanges menyimpan perubahan dari klien satu per satu. Item yang gagal dicatat di hasilnya
+// tanpa menghentikan item lain; klien cukup mengirim ulang item yang gagal.
+func (s *syncService) PushChanges(ctx context.Context, userID domain.UserID, deviceID string, inputs []PushTaskInput) ([]BatchItemResult, error) {
+	device, err := s.syncDevice(ctx, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	changedSincePull := s.changedSinceLastPull(ctx, device)
+
+	results := make([]BatchItemResult, len(inputs))
+	var createdCount int64
+	defer func() { s.quota.ObserveUsage(ctx, userID, domain.QuotaTasks, createdCount) }()
+
+	for i, input := range inputs {
+		results[i] = BatchItemResult{Index: i, ID: input.ID}
+		if input.Title == "" {
+			results[i].Err = domain.ErrTaskTitleRequired
+			continue
+		}
+
+		now := time.Now()
+		task := &domain.Task{
+			ID:          input.ID,
+			UserID:      userID,
+			Title:       input.Title,
+			Description: input.Description,
+			CreatedAt:   now, // Diabaikan oleh repository jika task sudah ada
+			UpdatedAt:   now, // Selalu waktu server, bukan waktu perangkat
+		}
+		task.SetCompleted(input.Completed, now)
+		created, err := saveClientTask(ctx, s.taskRepo, s.publisher, s.idGen, task)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		if created {
+			createdCount++
+		}
+		results[i].Task, results[i].Created = task, created
+	}
+
+	if device != nil {
+		recordPushDiagnostics(device, results, changedSincePull)
+		s.saveSyncState(ctx, device)
+	}
+	return results, nil
+}
+
+// changedSinceLastPull mengembalikan ID task yang berubah setelah cursor terakhir perangkat, yaitu
+// perubahan yang belum dilihat perangkat saat push. Nil jika perangkat belum pernah pull.
+func (s *syncService) changedSinceLastPull(ctx context.Context, device *domain.Device) map[string]bool {
+	if device == nil || device.Sync.LastCursor == nil {
+		return nil
+	}
+	changes, err := s.taskRepo.FindChangedSince(ctx, device.UserID, *device.Sync.LastCursor)
+	if err != nil {
+		slog.ErrorContext(ctx, "error finding tasks changed since last pull", "device_id", device.ID, "error", err)
+		return nil
+	}
+	changed := make(map[string]bool, len(changes.Tasks))
+	for _, task := range changes.Tasks {
+		changed[task.ID] = true
+	}
+	return changed
+}
+
+// recordPushDiagnostics merangkum hasil push ke device.Sync. Task yang ditimpa padahal berubah
+// setelah pull terakhir dicatat sebagai stale write; ini juga mencakup push sebelumnya dari
+// perangkat yang sama jika perangkat tidak pull di antaranya.
+func recordPushDiagnostics(device *domain.Device, results []BatchItemResult, changedSincePull map[string]bool) {
+	now := time.Now()
+	state := &device.Sync
+	state.LastPushAt = &now
+	state.LastPushItems = len(results)
+	state.LastPushFailed, state.LastPushStale = 0, 0
+	state.LastPushIssues = nil
+
+	addIssue := func(taskID, problem string) {
+		if len(state.LastPushIssues) < domain.MaxSyncIssues {
+			state.LastPushIssues = append(state.LastPushIssues, domain.SyncIssue{TaskID: taskID, Problem: problem})
+		}
+	}
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			state.LastPushFailed++
+			addIssue(result.ID, result.Err.Error())
+		case !result.Created && changedSincePull[result.ID]:
+			state.LastPushStale++
+			addIssue(result.ID, domain.SyncIssueStaleWrite)
+		}
+	}
+}
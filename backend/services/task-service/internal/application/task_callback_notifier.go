@@ -0,0 +1,68 @@
+// file: backend/services/task-service/internal/application/task_callback_notifier.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// TaskCallbackPayload adalah body JSON request callback task.
+type TaskCallbackPayload struct {
+	Type       string       `json:"type"` // Selalu domain.TaskCallbackEvent
+	CallbackID string       `json:"callback_id"`
+	Task       *domain.Task `json:"task"`
+	OccurredAt time.Time    `json:"occurred_at"`
+}
+
+// taskCallbackNotifier adalah EventNotifier yang memanggil callback task saat task selesai.
+type taskCallbackNotifier struct {
+	callbackRepo domain.TaskCallbackRepository
+	sender       domain.WebhookSender
+}
+
+// NewTaskCallbackNotifier adalah constructor untuk taskCallbackNotifier.
+func NewTaskCallbackNotifier(callbackRepo domain.TaskCallbackRepository, sender domain.WebhookSender) EventNotifier {
+	return &taskCallbackNotifier{
+		callbackRepo: callbackRepo,
+		sender:       sender,
+	}
+}
+
+// Notify mengklaim callback setiap kali event membawa task yang sudah selesai, dari jalur mana pun
+// (PATCH, bulk, sync, atau board), kecuali TaskMentioned dan TaskAssigned yang tidak mengubah task. Callback
+// dihapus sebelum dikirim, sehingga callback yang gagal terkirim setelah semua percobaan ulang
+// tidak dicoba lagi.
+func (n *taskCallbackNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	if event.Task == nil || !event.Task.Completed || event.Type == domain.TaskMentioned || event.Type == domain.TaskAssigned {
+		return
+	}
+	callbacks, err := n.callbackRepo.ClaimByTaskID(ctx, event.TaskID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error claiming task callbacks", "task_id", event.TaskID, "error", err)
+		return
+	}
+	for _, callback := range callbacks {
+		body, err := json.Marshal(TaskCallbackPayload{
+			Type:       domain.TaskCallbackEvent,
+			CallbackID: callback.ID,
+			Task:       event.Task,
+			OccurredAt: event.OccurredAt,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "error encoding task callback payload", "callback_id", callback.ID, "error", err)
+			continue
+		}
+		err = sendSignedWebhook(ctx, n.sender, callback.URL, callback.Secret, map[string]string{
+			"Content-Type":     "application/json",
+			webhookHeaderEvent: domain.TaskCallbackEvent,
+			webhookHeaderID:    callback.ID,
+		}, body)
+		if err != nil {
+			slog.ErrorContext(ctx, "giving up delivering task callback", "callback_id", callback.ID, "task_id", callback.TaskID, "error", err)
+		}
+	}
+}
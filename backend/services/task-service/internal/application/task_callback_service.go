@@ -0,0 +1,100 @@
+// file: backend/services/task-service/internal/application/task_callback_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// TaskCallbackApplicationService mendefinisikan use case callback sekali pakai per task.
+type TaskCallbackApplicationService interface {
+	// CreateCallback mendaftarkan URL yang dipanggil saat task selesai. Secret hanya dikembalikan
+	// di sini. Mengembalikan ErrTaskAlreadyCompleted jika task sudah selesai.
+	CreateCallback(ctx context.Context, userID domain.UserID, taskID, url string) (*domain.TaskCallback, error)
+	ListCallbacks(ctx context.Context, userID domain.UserID, taskID string) ([]*domain.TaskCallback, error)
+	DeleteCallback(ctx context.Context, userID domain.UserID, taskID, id string) error
+}
+
+// taskCallbackService adalah implementasi dari TaskCallbackApplicationService.
+type taskCallbackService struct {
+	callbackRepo domain.TaskCallbackRepository
+	taskRepo     domain.TaskRepository
+	idGen        domain.IDGenerator
+}
+
+// NewTaskCallbackService adalah constructor untuk taskCallbackService.
+func NewTaskCallbackService(callbackRepo domain.TaskCallbackRepository, taskRepo domain.TaskRepository, idGen domain.IDGenerator) TaskCallbackApplicationService {
+	return &taskCallbackService{
+		callbackRepo: callbackRepo,
+		taskRepo:     taskRepo,
+		idGen:        idGen,
+	}
+}
+
+// CreateCallback menolak task yang sudah selesai, karena event penyelesaiannya sudah lewat dan
+// callback hanya akan terpanggil oleh perubahan berikutnya yang tidak berhubungan.
+func (s *taskCallbackService) CreateCallback(ctx context.Context, userID domain.UserID, taskID, url string) (*domain.TaskCallback, error) {
+	target, ok := parseWebhookURL(url)
+	if !ok {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrInvalidTaskCallback)
+	}
+	task, err := s.ownedTask(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Completed {
+		return nil, domain.ErrTaskAlreadyCompleted
+	}
+	existing, err := s.callbackRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxCallbacksPerTask {
+		return nil, domain.ErrTaskCallbackLimitReached
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	callback := &domain.TaskCallback{
+		ID:        s.idGen.NewID(),
+		UserID:    userID,
+		TaskID:    taskID,
+		URL:       target.String(),
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+	if err := s.callbackRepo.Save(ctx, callback); err != nil {
+		return nil, err
+	}
+	return callback, nil
+}
+
+// ListCallbacks mengembalikan callback yang masih menunggu pada task milik pengguna.
+func (s *taskCallbackService) ListCallbacks(ctx context.Context, userID domain.UserID, taskID string) ([]*domain.TaskCallback, error) {
+	if _, err := s.ownedTask(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+	return s.callbackRepo.FindByTaskID(ctx, taskID)
+}
+
+// DeleteCallback membatalkan callback sebelum task selesai.
+func (s *taskCallbackService) DeleteCallback(ctx context.Context, userID domain.UserID, taskID, id string) error {
+	return s.callbackRepo.Delete(ctx, userID, taskID, id)
+}
+
+// ownedTask mengembalikan ErrTaskNotFound jika task tidak ada atau milik pengguna lain.
+func (s *taskCallbackService) ownedTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	task, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.UserID != userID {
+		return nil, domain.ErrTaskNotFound
+	}
+	return task, nil
+}
@@ -0,0 +1,178 @@
+// file: backend/services/task-service/internal/application/task_comment_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// TaskCommentApplicationService mendefinisikan use case komentar task dan mention di dalamnya.
+type TaskCommentApplicationService interface {
+	// AddComment menambahkan komentar ke task yang bisa dibaca pengguna. Pengguna yang di-mention
+	// dan punya akses ke daftar task dicatat dan diberi tahu lewat event TaskMentioned; mention
+	// lainnya dibiarkan sebagai teks biasa.
+	AddComment(ctx context.Context, userID domain.UserID, taskID, body string) (*domain.TaskComment, error)
+	ListComments(ctx context.Context, userID domain.UserID, taskID string) ([]*domain.TaskComment, error)
+
+	// ListCommentsForTasks mengembalikan komentar beberapa task sekaligus, dikelompokkan per ID
+	// task. Task yang tidak ada atau tidak boleh dibaca userID tidak punya entri.
+	ListCommentsForTasks(ctx context.Context, userID domain.UserID, taskIDs []string) (map[string][]*domain.TaskComment, error)
+
+	// DeleteComment menghapus komentar. Mengembalikan ErrCommentForbidden jika pengguna bukan
+	// penulis komentar maupun pemilik task.
+	DeleteComment(ctx context.Context, userID domain.UserID, taskID, commentID string) error
+
+	// ListMentions mengembalikan komentar terbaru yang me-mention pengguna.
+	ListMentions(ctx context.Context, userID domain.UserID, limit int) ([]*domain.TaskComment, error)
+}
+
+// taskCommentService adalah implementasi dari TaskCommentApplicationService.
+type taskCommentService struct {
+	taskRepo    domain.TaskRepository
+	commentRepo domain.TaskCommentRepository
+	access      TaskAuthorizer
+	publisher   domain.TaskEventPublisher
+	idGen       domain.IDGenerator
+}
+
+// NewTaskCommentService adalah constructor untuk taskCommentService.
+func NewTaskCommentService(taskRepo domain.TaskRepository, commentRepo domain.TaskCommentRepository, access TaskAuthorizer, publisher domain.TaskEventPublisher, idGen domain.IDGenerator) TaskCommentApplicationService {
+	return &taskCommentService{
+		taskRepo:    taskRepo,
+		commentRepo: commentRepo,
+		access:      access,
+		publisher:   publisher,
+		idGen:       idGen,
+	}
+}
+
+// findReadableTask mengambil task dan memastikan pengguna boleh membacanya. Komentar tidak
+// mengubah task, sehingga akses baca sudah cukup untuk berkomentar.
+func (s *taskCommentService) findReadableTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	task, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.access.AuthorizeTask(ctx, userID, task, domain.ListAccessRead); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// AddComment memvalidasi isi komentar, menyaring mention, lalu menyimpan dan memberi tahu.
+func (s *taskCommentService) AddComment(ctx context.Context, userID domain.UserID, taskID, body string) (*domain.TaskComment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > domain.MaxCommentLength {
+		return nil, fmt.Errorf("%w: body must be 1 to %d characters", domain.ErrInvalidComment, domain.MaxCommentLength)
+	}
+	task, err := s.findReadableTask(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentions []domain.UserID
+	for _, mentioned := range domain.ParseMentions(body) {
+		if mentioned == userID {
+			continue
+		}
+		err := s.access.AuthorizeList(ctx, mentioned, task.UserID, domain.ListAccessRead)
+		if errors.Is(err, domain.ErrListShareNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, mentioned)
+	}
+
+	comment := &domain.TaskComment{
+		ID:        s.idGen.NewID(),
+		TaskID:    task.ID,
+		AuthorID:  userID,
+		Body:      body,
+		Mentions:  mentions,
+		CreatedAt: time.Now(),
+	}
+	if err := s.commentRepo.Save(ctx, comment); err != nil {
+		return nil, err
+	}
+	for _, mentioned := range mentions {
+		if err := s.publisher.Publish(ctx, domain.NewTaskMentionEvent(task, comment, mentioned)); err != nil {
+			slog.ErrorContext(ctx, "error publishing event", "event_type", domain.TaskMentioned, "task_id", task.ID, "recipient_id", mentioned, "error", err)
+		}
+	}
+	return comment, nil
+}
+
+// ListComments mengembalikan komentar task yang bisa dibaca pengguna.
+func (s *taskCommentService) ListComments(ctx context.Context, userID domain.UserID, taskID string) ([]*domain.TaskComment, error) {
+	if _, err := s.findReadableTask(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+	return s.commentRepo.FindByTaskID(ctx, taskID)
+}
+
+// ListCommentsForTasks memeriksa akses semua task dengan satu query lalu mengambil komentarnya
+// dengan satu query lagi.
+func (s *taskCommentService) ListCommentsForTasks(ctx context.Context, userID domain.UserID, taskIDs []string) (map[string][]*domain.TaskComment, error) {
+	byTask := make(map[string][]*domain.TaskComment, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return byTask, nil
+	}
+	tasks, err := s.taskRepo.FindByIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err = readableTasks(ctx, s.access, userID, tasks)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+		byTask[task.ID] = []*domain.TaskComment{}
+	}
+	if len(ids) == 0 {
+		return byTask, nil
+	}
+
+	comments, err := s.commentRepo.FindByTaskIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, comment := range comments {
+		byTask[comment.TaskID] = append(byTask[comment.TaskID], comment)
+	}
+	return byTask, nil
+}
+
+// DeleteComment memastikan komentar milik task tersebut sebelum memeriksa penulisnya.
+func (s *taskCommentService) DeleteComment(ctx context.Context, userID domain.UserID, taskID, commentID string) error {
+	task, err := s.findReadableTask(ctx, userID, taskID)
+	if err != nil {
+		return err
+	}
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.TaskID != task.ID {
+		return domain.ErrCommentNotFound
+	}
+	if comment.AuthorID != userID && task.UserID != userID {
+		return domain.ErrCommentForbidden
+	}
+	return s.commentRepo.Delete(ctx, commentID)
+}
+
+// ListMentions mengembalikan komentar yang me-mention pengguna.
+func (s *taskCommentService) ListMentions(ctx context.Context, userID domain.UserID, limit int) ([]*domain.TaskComment, error) {
+	return s.commentRepo.FindMentioning(ctx, userID, limit)
+}
@@ -0,0 +1,81 @@
+// file: backend/services/task-service/internal/application/task_history_service.go
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// TaskHistoryApplicationService mendefinisikan use case riwayat perubahan task.
+type TaskHistoryApplicationService interface {
+	// GetHistory mengembalikan revisi task yang bisa dibaca pengguna, dari yang terbaru.
+	GetHistory(ctx context.Context, userID domain.UserID, taskID string) ([]domain.TaskRevision, error)
+
+	// RevertToRevision mengembalikan field yang dilacak ke nilai pada revisi tertentu.
+	// Revert sendiri dicatat sebagai revisi baru, sehingga bisa dibatalkan dengan revert berikutnya.
+	RevertToRevision(ctx context.Context, userID domain.UserID, taskID string, revision int) (*domain.Task, error)
+}
+
+// taskHistoryService adalah implementasi dari TaskHistoryApplicationService.
+type taskHistoryService struct {
+	taskRepo    domain.TaskRepository
+	historyRepo domain.TaskHistoryRepository
+	publisher   domain.TaskEventPublisher
+	access      TaskAuthorizer
+}
+
+// NewTaskHistoryService adalah constructor untuk taskHistoryService.
+func NewTaskHistoryService(taskRepo domain.TaskRepository, historyRepo domain.TaskHistoryRepository, publisher domain.TaskEventPublisher, access TaskAuthorizer) TaskHistoryApplicationService {
+	return &taskHistoryService{
+		taskRepo:    taskRepo,
+		historyRepo: historyRepo,
+		publisher:   publisher,
+		access:      access,
+	}
+}
+
+// findAccessibleTask mengambil task dan memastikan pengguna punya akses need ke task tersebut.
+func (s *taskHistoryService) findAccessibleTask(ctx context.Context, userID domain.UserID, taskID string, need domain.ListAccess) (*domain.Task, error) {
+	task, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.access.AuthorizeTask(ctx, userID, task, need); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// GetHistory mengambil riwayat revisi task setelah memeriksa akses baca.
+func (s *taskHistoryService) GetHistory(ctx context.Context, userID domain.UserID, taskID string) ([]domain.TaskRevision, error) {
+	if _, err := s.findAccessibleTask(ctx, userID, taskID, domain.ListAccessRead); err != nil {
+		return nil, err
+	}
+	return s.historyRepo.FindByTaskID(ctx, taskID)
+}
+
+// RevertToRevision menerapkan snapshot revisi ke task dan menyimpannya seperti update biasa.
+func (s *taskHistoryService) RevertToRevision(ctx context.Context, userID domain.UserID, taskID string, revision int) (*domain.Task, error) {
+	task, err := s.findAccessibleTask(ctx, userID, taskID, domain.ListAccessWrite)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := s.historyRepo.FindRevision(ctx, taskID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Title = rev.Snapshot.Title
+	task.Description = rev.Snapshot.Description
+	task.Completed = rev.Snapshot.Completed
+	task.CompletedAt = rev.Snapshot.CompletedAt
+	task.UpdatedAt = time.Now()
+
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return nil, err
+	}
+	publishTaskEvent(ctx, s.publisher, domain.TaskUpdated, task)
+	return task, nil
+}
@@ -4,6 +4,8 @@ package application
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain" // Sesuaikan dengan path module Anda
@@ -13,63 +15,243 @@ import (
 // Kita bisa menggunakan DTO (Data Transfer Object) yang lebih spesifik nanti jika diperlukan,
 // terutama jika input dari API berbeda signifikan dengan struktur domain.
 type CreateTaskInput struct {
-	Title       string
-	Description string
+	ID              string // Opsional: UUID yang di-generate klien (offline-first). Kosong berarti di-generate server.
+	Title           string
+	Description     string
+	EstimateMinutes *int    // Opsional: perkiraan usaha dalam menit
+	Priority        *string // Opsional: nilai enum task_priority
+	DueText         *string // Opsional: tenggat dalam bahasa sehari-hari, misalnya "tomorrow 5pm"
+	Color           *string // Opsional: salah satu domain.TaskColors
+	Icon            *string // Opsional: salah satu domain.TaskIcons
+
+	// DueLocation adalah zona waktu untuk DueText; nil berarti zona waktu tersimpan pengguna.
+	DueLocation *time.Location
+
+	// OwnerID adalah pemilik daftar bersama tujuan task; kosong berarti daftar pengguna sendiri.
+	// Membutuhkan akses tulis ke daftar tersebut.
+	OwnerID domain.UserID
 }
 
 type UpdateTaskInput struct {
-	Title       *string // Pointer untuk menandakan field mana yang ingin diupdate
-	Description *string
-	Completed   *bool
+	Title           *string // Pointer untuk menandakan field mana yang ingin diupdate
+	Description     *string
+	Completed       *bool
+	EstimateMinutes *int       // 0 menghapus estimasi
+	SnoozedUntil    *time.Time // Waktu nol menghapus snooze
+	Archived        *bool      // Hanya task selesai yang boleh diarsipkan
+	Priority        *string    // String kosong menghapus prioritas
+	DueText         *string    // String kosong menghapus tenggat
+	Color           *string    // String kosong menghapus warna
+	Icon            *string    // String kosong menghapus ikon
+
+	// DueLocation adalah zona waktu untuk DueText; nil berarti zona waktu tersimpan pengguna.
+	DueLocation *time.Location
+
+	// AssigneeID harus pemilik daftar atau kolaboratornya; string kosong melepas penugasan.
+	AssigneeID *domain.UserID
 }
 
+// maxEstimateSummaryDays adalah jumlah hari terbanyak pada GetEstimateSummary.
+const maxEstimateSummaryDays = 31
+
 // TaskApplicationService mendefinisikan interface untuk service aplikasi Task.
 // Ini adalah kontrak untuk use cases yang berhubungan dengan Task.
 type TaskApplicationService interface {
-	CreateTask(ctx context.Context, userID domain.UserID, input CreateTaskInput) (*domain.Task, error)
+	// CreateTask membuat task baru. Dengan input.ID yang sudah ada, task tersebut ditimpa dan
+	// created bernilai false.
+	CreateTask(ctx context.Context, userID domain.UserID, input CreateTaskInput) (task *domain.Task, created bool, err error)
 	GetTaskByID(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error)
-	GetTasksByUserID(ctx context.Context, userID domain.UserID) ([]*domain.Task, error)
+
+	// GetTasksByIDs mengambil beberapa task sekaligus. Task yang tidak ada atau tidak boleh dibaca
+	// userID dilewati, sehingga hasilnya bisa lebih sedikit dari ids.
+	GetTasksByIDs(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.Task, error)
+	GetTasksByUserID(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error)
+
+	// GetSharedTasks mengambil task di daftar ownerID yang dibagikan kepada userID.
+	GetSharedTasks(ctx context.Context, userID, ownerID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error)
+	GetTaskCounters(ctx context.Context, userID domain.UserID) (domain.TaskCounters, error)
+
+	// CountTasks menghitung task yang dikembalikan GetTasksByUserID dengan HideArchived dan
+	// hideSnoozedAt yang sama, tanpa memuat task-nya.
+	CountTasks(ctx context.Context, userID domain.UserID, hideSnoozedAt time.Time) (int64, error)
+	GetTasksPage(ctx context.Context, userID domain.UserID, query domain.TaskPageQuery) (*domain.TaskPage, error)
+	SearchTasks(ctx context.Context, userID domain.UserID, query string, limit int) ([]*domain.Task, error)
+
+	// GetTaskGroups mengembalikan task yang dikelompokkan di server beserta jumlah task per grup.
+	// Mengembalikan ErrInvalidTaskGroupBy jika cara pengelompokan tidak dikenal.
+	GetTaskGroups(ctx context.Context, userID domain.UserID, query domain.TaskGroupQuery) ([]domain.TaskGroup, error)
 	UpdateTask(ctx context.Context, userID domain.UserID, taskID string, input UpdateTaskInput) (*domain.Task, error)
+	CompleteTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error)
+	UncompleteTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error)
+
+	// SnoozeTask menyembunyikan task dari daftar default sampai until.
+	// Mengembalikan ErrInvalidSnooze jika until tidak di masa depan atau lebih dari MaxSnoozeDuration.
+	SnoozeTask(ctx context.Context, userID domain.UserID, taskID string, until time.Time) (*domain.Task, error)
+	UnsnoozeTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error)
+
+	// ArchiveTask menyembunyikan task selesai dari daftar default tanpa menghapusnya.
+	// Mengembalikan ErrTaskNotCompleted jika task belum selesai.
+	ArchiveTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error)
+	UnarchiveTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error)
+	GetArchivedTasks(ctx context.Context, userID domain.UserID) ([]*domain.Task, error)
+
+	// AssignTask menugaskan task kepada assigneeID. Mengembalikan ErrInvalidAssignee jika
+	// assigneeID bukan pemilik daftar task maupun kolaboratornya.
+	AssignTask(ctx context.Context, userID domain.UserID, taskID string, assigneeID domain.UserID) (*domain.Task, error)
+	UnassignTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error)
+
+	// GetAssignedTasks mengambil task yang ditugaskan kepada userID di semua daftar yang bisa diaksesnya.
+	GetAssignedTasks(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error)
+	GetCompletedTasks(ctx context.Context, userID domain.UserID, from, to time.Time) ([]*domain.Task, error)
+
+	// GetEstimateSummary mengembalikan sisa usaha task yang belum selesai dan usaha yang
+	// diselesaikan per hari untuk days hari terakhir (termasuk hari ini) di zona waktu loc.
+	GetEstimateSummary(ctx context.Context, userID domain.UserID, days int, loc *time.Location) (*domain.EstimateSummary, error)
+	ReorderTasks(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.Task, error)
+	MoveTask(ctx context.Context, userID domain.UserID, taskID, afterID string) (*domain.Task, error)
 	DeleteTask(ctx context.Context, userID domain.UserID, taskID string) error
 }
 
 // taskService adalah implementasi dari TaskApplicationService.
 type taskService struct {
-	taskRepo domain.TaskRepository // Dependensi ke TaskRepository dari domain layer
+	taskRepo  domain.TaskRepository     // Dependensi ke TaskRepository dari domain layer
+	publisher domain.TaskEventPublisher // Menyebarkan perubahan task ke subscriber realtime
+	idGen     domain.IDGenerator        // Memvalidasi ID task yang di-generate klien
+	quota     QuotaMonitor              // Memantau ambang peringatan kuota jumlah task
+	enums     EnumValidator             // Memvalidasi field enum seperti Priority
+	locations UserLocationProvider      // Zona waktu pengguna untuk parsing DueText
+	access    TaskAuthorizer            // Memeriksa akses ke task di daftar bersama
 }
 
 // NewTaskService adalah constructor untuk taskService.
-// Ini menerapkan dependency injection untuk TaskRepository.
-func NewTaskService(repo domain.TaskRepository) TaskApplicationService {
+// Ini menerapkan dependency injection untuk TaskRepository, TaskEventPublisher, IDGenerator, QuotaMonitor,
+// EnumValidator, UserLocationProvider, dan TaskAuthorizer.
+func NewTaskService(repo domain.TaskRepository, publisher domain.TaskEventPublisher, idGen domain.IDGenerator, quota QuotaMonitor, enums EnumValidator, locations UserLocationProvider, access TaskAuthorizer) TaskApplicationService {
 	return &taskService{
-		taskRepo: repo,
+		taskRepo:  repo,
+		publisher: publisher,
+		idGen:     idGen,
+		quota:     quota,
+		enums:     enums,
+		locations: locations,
+		access:    access,
+	}
+}
+
+// nilIfEmpty mengembalikan nil untuk string kosong, yang berarti menghapus nilai field opsional.
+func nilIfEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
 	}
+	return s
 }
 
-// CreateTask menghandle logika bisnis untuk membuat task baru.
-func (s *taskService) CreateTask(ctx context.Context, userID domain.UserID, input CreateTaskInput) (*domain.Task, error) {
+// setTaskDue mem-parse DueText di zona waktu loc, atau zona waktu tersimpan pengguna jika loc nil.
+func (s *taskService) setTaskDue(ctx context.Context, task *domain.Task, text string, loc *time.Location, now time.Time) error {
+	if loc == nil && text != "" {
+		var err error
+		if loc, err = s.locations.UserLocation(ctx, task.UserID); err != nil {
+			return err
+		}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return task.SetDue(text, now.In(loc))
+}
+
+// publishTaskEvent menyebarkan event perubahan task. Kegagalan publish hanya di-log,
+// karena penyebaran realtime bersifat best-effort dan tidak boleh menggagalkan operasi utama.
+func publishTaskEvent(ctx context.Context, publisher domain.TaskEventPublisher, eventType domain.TaskEventType, task *domain.Task) {
+	if err := publisher.Publish(ctx, domain.NewTaskEvent(eventType, task)); err != nil {
+		slog.ErrorContext(ctx, "error publishing event", "event_type", eventType, "task_id", task.ID, "error", err)
+	}
+}
+
+// saveClientTask menyimpan task dengan ID dari klien menggunakan upsert, sehingga request yang
+// diulang (retry) dengan ID yang sama tidak gagal karena duplikasi. Event yang dipublikasikan
+// menyesuaikan apakah task benar-benar baru (created bernilai true) atau hanya diperbarui.
+func saveClientTask(ctx context.Context, repo domain.TaskRepository, publisher domain.TaskEventPublisher, idGen domain.IDGenerator, task *domain.Task) (created bool, err error) {
+	if err := idGen.Validate(task.ID); err != nil {
+		return false, err
+	}
+	created, err = repo.SaveOrUpdate(ctx, task)
+	if err != nil {
+		return false, err
+	}
+	if created {
+		publishTaskEvent(ctx, publisher, domain.TaskCreated, task)
+	} else {
+		publishTaskEvent(ctx, publisher, domain.TaskUpdated, task)
+	}
+	return created, nil
+}
+
+// CreateTask menghandle logika bisnis untuk membuat task baru. Task di daftar bersama dimiliki
+// pemilik daftar, sehingga enum dan kuota yang berlaku adalah milik pemilik.
+func (s *taskService) CreateTask(ctx context.Context, userID domain.UserID, input CreateTaskInput) (*domain.Task, bool, error) {
 	// Di sini bisa ada validasi input tambahan jika diperlukan
 	if input.Title == "" {
-		// Sebaiknya gunakan error yang lebih spesifik atau error package
-		return nil, errors.New("title cannot be empty")
+		return nil, false, domain.ErrTaskTitleRequired
+	}
+	if err := domain.ValidateEstimate(input.EstimateMinutes); err != nil {
+		return nil, false, err
+	}
+	if err := domain.ValidateTaskAppearance(input.Color, input.Icon); err != nil {
+		return nil, false, err
+	}
+	ownerID := userID
+	if input.OwnerID != "" {
+		ownerID = input.OwnerID
+	}
+	if err := s.access.AuthorizeList(ctx, userID, ownerID, domain.ListAccessWrite); err != nil {
+		return nil, false, err
+	}
+	if input.Priority != nil {
+		if err := s.enums.ValidateEnumValue(ctx, ownerID, domain.EnumTaskPriority, *input.Priority); err != nil {
+			return nil, false, err
+		}
 	}
 
 	newTask := &domain.Task{
-		// ID akan di-generate oleh persistence layer atau database (misalnya, UUID)
-		UserID:      userID,
-		Title:       input.Title,
-		Description: input.Description,
-		Completed:   false, // Default saat pembuatan
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		// Jika kosong, ID akan di-generate oleh persistence layer atau database (misalnya, UUID)
+		ID:              input.ID,
+		UserID:          ownerID,
+		Title:           input.Title,
+		Description:     input.Description,
+		EstimateMinutes: input.EstimateMinutes,
+		Priority:        input.Priority,
+		Color:           input.Color,
+		Icon:            input.Icon,
+		Completed:       false, // Default saat pembuatan
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	if input.DueText != nil {
+		if err := s.setTaskDue(ctx, newTask, *input.DueText, input.DueLocation, newTask.CreatedAt); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if input.ID != "" {
+		created, err := saveClientTask(ctx, s.taskRepo, s.publisher, s.idGen, newTask)
+		if err != nil {
+			return nil, false, err
+		}
+		if created {
+			s.quota.ObserveUsage(ctx, ownerID, domain.QuotaTasks, 1)
+		}
+		return newTask, created, nil
 	}
 
 	err := s.taskRepo.Save(ctx, newTask)
 	if err != nil {
 		// Log error di sini jika perlu
-		return nil, err
+		return nil, false, err
 	}
-	return newTask, nil
+	publishTaskEvent(ctx, s.publisher, domain.TaskCreated, newTask)
+	s.quota.ObserveUsage(ctx, ownerID, domain.QuotaTasks, 1)
+	return newTask, true, nil
 }
 
 // GetTaskByID mengambil task berdasarkan ID, memastikan pengguna memiliki akses.
@@ -79,17 +261,80 @@ func (s *taskService) GetTaskByID(ctx context.Context, userID domain.UserID, tas
 		return nil, err // Bisa jadi domain.ErrTaskNotFound
 	}
 
-	// Otorisasi: Pastikan task milik pengguna yang meminta
-	if task.UserID != userID {
-		return nil, domain.ErrTaskNotFound // Atau error Forbidden yang lebih spesifik
+	// Otorisasi: pemilik atau kolaborator daftar bersama
+	if err := s.access.AuthorizeTask(ctx, userID, task, domain.ListAccessRead); err != nil {
+		return nil, err
 	}
 
 	return task, nil
 }
 
-// GetTasksByUserID mengambil semua task milik pengguna tertentu.
-func (s *taskService) GetTasksByUserID(ctx context.Context, userID domain.UserID) ([]*domain.Task, error) {
-	return s.taskRepo.FindByUserID(ctx, userID)
+// GetTasksByUserID mengambil semua task milik pengguna tertentu dengan urutan order.
+// GetTasksByIDs memakai satu query lalu memeriksa akses per pemilik daftar.
+func (s *taskService) GetTasksByIDs(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.Task, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	tasks, err := s.taskRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return readableTasks(ctx, s.access, userID, tasks)
+}
+
+func (s *taskService) GetTasksByUserID(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	if err := order.Sort.Validate(); err != nil {
+		return nil, err
+	}
+	return s.taskRepo.FindByUserID(ctx, userID, order)
+}
+
+// GetSharedTasks memeriksa akses baca ke daftar ownerID lalu mengambil task-nya.
+func (s *taskService) GetSharedTasks(ctx context.Context, userID, ownerID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	if err := order.Sort.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.access.AuthorizeList(ctx, userID, ownerID, domain.ListAccessRead); err != nil {
+		return nil, err
+	}
+	return s.taskRepo.FindByUserID(ctx, ownerID, order)
+}
+
+// GetTaskCounters mengambil jumlah task milik pengguna dari counter cache, misalnya untuk sidebar.
+func (s *taskService) GetTaskCounters(ctx context.Context, userID domain.UserID) (domain.TaskCounters, error) {
+	return s.taskRepo.CountersByUserID(ctx, userID)
+}
+
+// CountTasks tidak memakai counter cache, karena counter cache ikut menghitung task yang diarsipkan
+// dan di-snooze.
+func (s *taskService) CountTasks(ctx context.Context, userID domain.UserID, hideSnoozedAt time.Time) (int64, error) {
+	return s.taskRepo.CountVisibleByUserID(ctx, userID, hideSnoozedAt, true)
+}
+
+// SearchTasks mencari task milik pengguna. Satu karakter sudah cukup agar emoji bisa dicari.
+func (s *taskService) SearchTasks(ctx context.Context, userID domain.UserID, query string, limit int) ([]*domain.Task, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, domain.ErrSearchQueryEmpty
+	}
+	return s.taskRepo.Search(ctx, userID, query, limit)
+}
+
+// GetTasksPage mengambil satu halaman task milik pengguna dengan keyset pagination.
+func (s *taskService) GetTasksPage(ctx context.Context, userID domain.UserID, query domain.TaskPageQuery) (*domain.TaskPage, error) {
+	return s.taskRepo.FindPageByUserID(ctx, userID, query)
+}
+
+// GetTaskGroups memvalidasi cara pengelompokan lalu mengambil grup task milik pengguna.
+// Cursor selalu milik satu grup, sehingga hanya berlaku bersama Group.
+func (s *taskService) GetTaskGroups(ctx context.Context, userID domain.UserID, query domain.TaskGroupQuery) ([]domain.TaskGroup, error) {
+	if err := query.GroupBy.Validate(); err != nil {
+		return nil, err
+	}
+	if query.Cursor != "" && query.Group == "" {
+		return nil, domain.ErrInvalidCursor
+	}
+	return s.taskRepo.FindGroupsByUserID(ctx, userID, query)
 }
 
 // UpdateTask menghandle logika bisnis untuk memperbarui task.
@@ -99,9 +344,9 @@ func (s *taskService) UpdateTask(ctx context.Context, userID domain.UserID, task
 		return nil, err
 	}
 
-	// Otorisasi: Pastikan task milik pengguna yang meminta
-	if task.UserID != userID {
-		return nil, domain.ErrTaskNotFound // Atau error Forbidden
+	// Otorisasi: pemilik atau kolaborator dengan akses tulis
+	if err := s.access.AuthorizeTask(ctx, userID, task, domain.ListAccessWrite); err != nil {
+		return nil, err
 	}
 
 	// Terapkan perubahan jika ada inputnya
@@ -111,15 +356,225 @@ func (s *taskService) UpdateTask(ctx context.Context, userID domain.UserID, task
 	if input.Description != nil {
 		task.Description = *input.Description
 	}
+	if input.EstimateMinutes != nil {
+		if err := domain.ValidateEstimate(input.EstimateMinutes); err != nil {
+			return nil, err
+		}
+		task.EstimateMinutes = input.EstimateMinutes
+		if *input.EstimateMinutes == 0 {
+			task.EstimateMinutes = nil
+		}
+	}
+	// Prioritas hanya divalidasi saat berubah, agar task dengan nilai yang sudah deprecated tetap
+	// bisa diubah field lainnya.
+	if input.Priority != nil && (task.Priority == nil || *task.Priority != *input.Priority) {
+		if *input.Priority == "" {
+			task.Priority = nil
+		} else {
+			if err := s.enums.ValidateEnumValue(ctx, task.UserID, domain.EnumTaskPriority, *input.Priority); err != nil {
+				return nil, err
+			}
+			task.Priority = input.Priority
+		}
+	}
+	// Seperti prioritas, warna dan ikon hanya divalidasi jika diisi di request ini.
+	if err := domain.ValidateTaskAppearance(nilIfEmpty(input.Color), nilIfEmpty(input.Icon)); err != nil {
+		return nil, err
+	}
+	if input.Color != nil {
+		task.Color = nilIfEmpty(input.Color)
+	}
+	if input.Icon != nil {
+		task.Icon = nilIfEmpty(input.Icon)
+	}
+	assigned := false
+	if input.AssigneeID != nil && (task.AssigneeID == nil || *task.AssigneeID != *input.AssigneeID) {
+		if *input.AssigneeID == "" {
+			task.AssigneeID = nil
+		} else {
+			// Penerima tugas cukup punya akses baca, misalnya untuk melihat task yang harus dikerjakannya.
+			err := s.access.AuthorizeList(ctx, *input.AssigneeID, task.UserID, domain.ListAccessRead)
+			if errors.Is(err, domain.ErrListShareNotFound) {
+				return nil, domain.ErrInvalidAssignee
+			}
+			if err != nil {
+				return nil, err
+			}
+			task.AssigneeID = input.AssigneeID
+			assigned = *input.AssigneeID != userID
+		}
+	}
+	now := time.Now()
+	if input.DueText != nil {
+		if err := s.setTaskDue(ctx, task, *input.DueText, input.DueLocation, now); err != nil {
+			return nil, err
+		}
+	}
 	if input.Completed != nil {
-		task.Completed = *input.Completed
+		task.SetCompleted(*input.Completed, now)
+	}
+	if input.Archived != nil {
+		if *input.Archived && !task.Completed {
+			return nil, domain.ErrTaskNotCompleted
+		}
+		task.Archived = *input.Archived
+	}
+	// Task yang dibuka kembali keluar dari arsip agar tidak hilang dari semua daftar.
+	if !task.Completed {
+		task.Archived = false
+	}
+	if input.SnoozedUntil != nil {
+		task.SnoozedUntil = input.SnoozedUntil
+		if input.SnoozedUntil.IsZero() {
+			task.SnoozedUntil = nil
+		}
 	}
-	task.UpdatedAt = time.Now()
+	task.UpdatedAt = now
 
 	err = s.taskRepo.Update(ctx, task)
 	if err != nil {
 		return nil, err
 	}
+	publishTaskEvent(ctx, s.publisher, domain.TaskUpdated, task)
+	if assigned {
+		if err := s.publisher.Publish(ctx, domain.NewTaskAssignEvent(task, *task.AssigneeID)); err != nil {
+			slog.ErrorContext(ctx, "error publishing event", "event_type", domain.TaskAssigned, "task_id", task.ID, "recipient_id", *task.AssigneeID, "error", err)
+		}
+	}
+	return task, nil
+}
+
+// CompleteTask menandai task sebagai selesai dan mencatat CompletedAt.
+func (s *taskService) CompleteTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	completed := true
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{Completed: &completed})
+}
+
+// UncompleteTask membuka kembali task yang sudah selesai dan mengosongkan CompletedAt.
+func (s *taskService) UncompleteTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	completed := false
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{Completed: &completed})
+}
+
+// SnoozeTask memvalidasi until terhadap waktu sekarang lalu menyimpannya lewat UpdateTask.
+func (s *taskService) SnoozeTask(ctx context.Context, userID domain.UserID, taskID string, until time.Time) (*domain.Task, error) {
+	now := time.Now()
+	if !until.After(now) || until.Sub(now) > domain.MaxSnoozeDuration {
+		return nil, domain.ErrInvalidSnooze
+	}
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{SnoozedUntil: &until})
+}
+
+// UnsnoozeTask mengembalikan task ke daftar default sebelum waktu snooze berakhir.
+func (s *taskService) UnsnoozeTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{SnoozedUntil: &time.Time{}})
+}
+
+// ArchiveTask mengarsipkan task yang sudah selesai.
+func (s *taskService) ArchiveTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	archived := true
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{Archived: &archived})
+}
+
+// UnarchiveTask mengembalikan task yang diarsipkan ke daftar default.
+func (s *taskService) UnarchiveTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	archived := false
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{Archived: &archived})
+}
+
+// GetArchivedTasks mengambil task milik pengguna yang diarsipkan.
+func (s *taskService) GetArchivedTasks(ctx context.Context, userID domain.UserID) ([]*domain.Task, error) {
+	return s.taskRepo.FindArchivedByUserID(ctx, userID)
+}
+
+// AssignTask menyimpan penerima tugas lewat UpdateTask, sehingga membutuhkan akses tulis.
+func (s *taskService) AssignTask(ctx context.Context, userID domain.UserID, taskID string, assigneeID domain.UserID) (*domain.Task, error) {
+	if assigneeID == "" {
+		return nil, domain.ErrInvalidAssignee
+	}
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{AssigneeID: &assigneeID})
+}
+
+// UnassignTask melepas penerima tugas task.
+func (s *taskService) UnassignTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	var none domain.UserID
+	return s.UpdateTask(ctx, userID, taskID, UpdateTaskInput{AssigneeID: &none})
+}
+
+// GetAssignedTasks mengambil task yang ditugaskan kepada pengguna dengan urutan order.
+func (s *taskService) GetAssignedTasks(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	if err := order.Sort.Validate(); err != nil {
+		return nil, err
+	}
+	return s.taskRepo.FindByAssignee(ctx, userID, order)
+}
+
+// GetCompletedTasks mengambil task milik pengguna yang diselesaikan dalam rentang [from, to).
+func (s *taskService) GetCompletedTasks(ctx context.Context, userID domain.UserID, from, to time.Time) ([]*domain.Task, error) {
+	return s.taskRepo.FindCompletedBetween(ctx, userID, from, to)
+}
+
+// GetEstimateSummary melengkapi hari tanpa task selesai dengan nol agar klien bisa langsung
+// menggambar grafik per hari.
+func (s *taskService) GetEstimateSummary(ctx context.Context, userID domain.UserID, days int, loc *time.Location) (*domain.EstimateSummary, error) {
+	days = min(max(days, 1), maxEstimateSummaryDays)
+	now := time.Now().In(loc)
+	to := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+	from := to.AddDate(0, 0, -days)
+
+	summary, err := s.taskRepo.SummarizeEstimates(ctx, userID, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+	byDate := make(map[string]domain.DailyEffort, len(summary.CompletedByDay))
+	for _, day := range summary.CompletedByDay {
+		byDate[day.Date.Format(time.DateOnly)] = day
+	}
+	summary.CompletedByDay = make([]domain.DailyEffort, 0, days)
+	for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+		day, ok := byDate[date.Format(time.DateOnly)]
+		if !ok {
+			day = domain.DailyEffort{Date: date}
+		}
+		summary.CompletedByDay = append(summary.CompletedByDay, day)
+	}
+	return summary, nil
+}
+
+// ReorderTasks mengatur urutan manual task sesuai urutan ids (misalnya hasil drag-and-drop
+// seluruh daftar). ids tidak boleh kosong atau berisi ID ganda.
+func (s *taskService) ReorderTasks(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.Task, error) {
+	if len(ids) == 0 {
+		return nil, domain.ErrInvalidReorder
+	}
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, dup := seen[id]; dup || id == "" {
+			return nil, domain.ErrInvalidReorder
+		}
+		seen[id] = struct{}{}
+	}
+
+	tasks, err := s.taskRepo.Reorder(ctx, userID, ids, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		publishTaskEvent(ctx, s.publisher, domain.TaskUpdated, task)
+	}
+	return tasks, nil
+}
+
+// MoveTask memindahkan satu task tepat setelah task afterID, atau ke paling atas jika afterID kosong.
+func (s *taskService) MoveTask(ctx context.Context, userID domain.UserID, taskID, afterID string) (*domain.Task, error) {
+	if taskID == "" || taskID == afterID {
+		return nil, domain.ErrInvalidReorder
+	}
+	task, err := s.taskRepo.MoveAfter(ctx, userID, taskID, afterID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	publishTaskEvent(ctx, s.publisher, domain.TaskUpdated, task)
 	return task, nil
 }
 
@@ -130,10 +585,14 @@ func (s *taskService) DeleteTask(ctx context.Context, userID domain.UserID, task
 		return err
 	}
 
-	// Otorisasi: Pastikan task milik pengguna yang meminta
-	if task.UserID != userID {
-		return domain.ErrTaskNotFound // Atau error Forbidden
+	// Otorisasi: pemilik atau kolaborator dengan akses tulis
+	if err := s.access.AuthorizeTask(ctx, userID, task, domain.ListAccessWrite); err != nil {
+		return err
 	}
 
-	return s.taskRepo.Delete(ctx, taskID)
+	if err := s.taskRepo.Delete(ctx, taskID); err != nil {
+		return err
+	}
+	publishTaskEvent(ctx, s.publisher, domain.TaskDeleted, task)
+	return nil
 }
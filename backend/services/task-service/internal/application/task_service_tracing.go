@@ -0,0 +1,205 @@
+// file: backend/services/task-service/internal/application/task_service_tracing.go
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName adalah nama instrumentation scope untuk span di layer aplikasi.
+const tracerName = "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+
+// tracingTaskService membungkus TaskApplicationService dan membuat satu span per use case,
+// sehingga di satu trace span SQL dari repository berada di bawah use case yang menjalankannya.
+type tracingTaskService struct {
+	next   TaskApplicationService
+	tracer trace.Tracer
+}
+
+// NewTracingTaskService adalah constructor untuk tracingTaskService. Span memakai tracer provider
+// global; tanpa provider yang dikonfigurasi, span tidak direkam.
+func NewTracingTaskService(next TaskApplicationService) TaskApplicationService {
+	return &tracingTaskService{next: next, tracer: otel.Tracer(tracerName)}
+}
+
+// traced menjalankan fn di dalam span "TaskService.<name>" dan mencatat error yang dikembalikan.
+func traced[T any](ctx context.Context, tracer trace.Tracer, name string, fn func(ctx context.Context) (T, error), attrs ...attribute.KeyValue) (T, error) {
+	ctx, span := tracer.Start(ctx, "TaskService."+name, trace.WithAttributes(attrs...))
+	defer span.End()
+	result, err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+func taskIDAttr(taskID string) attribute.KeyValue {
+	return attribute.String("task.id", taskID)
+}
+
+func (s *tracingTaskService) CreateTask(ctx context.Context, userID domain.UserID, input CreateTaskInput) (*domain.Task, bool, error) {
+	var created bool
+	task, err := traced(ctx, s.tracer, "CreateTask", func(ctx context.Context) (task *domain.Task, err error) {
+		task, created, err = s.next.CreateTask(ctx, userID, input)
+		return task, err
+	})
+	return task, created, err
+}
+
+func (s *tracingTaskService) GetTaskByID(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "GetTaskByID", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.GetTaskByID(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) GetTasksByIDs(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "GetTasksByIDs", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.GetTasksByIDs(ctx, userID, ids)
+	}, attribute.Int("task.count", len(ids)))
+}
+
+func (s *tracingTaskService) GetTasksByUserID(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "GetTasksByUserID", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.GetTasksByUserID(ctx, userID, order)
+	})
+}
+
+func (s *tracingTaskService) GetSharedTasks(ctx context.Context, userID, ownerID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "GetSharedTasks", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.GetSharedTasks(ctx, userID, ownerID, order)
+	})
+}
+
+func (s *tracingTaskService) GetTaskCounters(ctx context.Context, userID domain.UserID) (domain.TaskCounters, error) {
+	return traced(ctx, s.tracer, "GetTaskCounters", func(ctx context.Context) (domain.TaskCounters, error) {
+		return s.next.GetTaskCounters(ctx, userID)
+	})
+}
+
+func (s *tracingTaskService) CountTasks(ctx context.Context, userID domain.UserID, hideSnoozedAt time.Time) (int64, error) {
+	return traced(ctx, s.tracer, "CountTasks", func(ctx context.Context) (int64, error) {
+		return s.next.CountTasks(ctx, userID, hideSnoozedAt)
+	})
+}
+
+func (s *tracingTaskService) GetTasksPage(ctx context.Context, userID domain.UserID, query domain.TaskPageQuery) (*domain.TaskPage, error) {
+	return traced(ctx, s.tracer, "GetTasksPage", func(ctx context.Context) (*domain.TaskPage, error) {
+		return s.next.GetTasksPage(ctx, userID, query)
+	})
+}
+
+func (s *tracingTaskService) SearchTasks(ctx context.Context, userID domain.UserID, query string, limit int) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "SearchTasks", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.SearchTasks(ctx, userID, query, limit)
+	})
+}
+
+func (s *tracingTaskService) GetTaskGroups(ctx context.Context, userID domain.UserID, query domain.TaskGroupQuery) ([]domain.TaskGroup, error) {
+	return traced(ctx, s.tracer, "GetTaskGroups", func(ctx context.Context) ([]domain.TaskGroup, error) {
+		return s.next.GetTaskGroups(ctx, userID, query)
+	})
+}
+
+func (s *tracingTaskService) UpdateTask(ctx context.Context, userID domain.UserID, taskID string, input UpdateTaskInput) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "UpdateTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.UpdateTask(ctx, userID, taskID, input)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) CompleteTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "CompleteTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.CompleteTask(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) UncompleteTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "UncompleteTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.UncompleteTask(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) SnoozeTask(ctx context.Context, userID domain.UserID, taskID string, until time.Time) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "SnoozeTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.SnoozeTask(ctx, userID, taskID, until)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) UnsnoozeTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "UnsnoozeTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.UnsnoozeTask(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) ArchiveTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "ArchiveTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.ArchiveTask(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) UnarchiveTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "UnarchiveTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.UnarchiveTask(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) GetArchivedTasks(ctx context.Context, userID domain.UserID) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "GetArchivedTasks", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.GetArchivedTasks(ctx, userID)
+	})
+}
+
+func (s *tracingTaskService) AssignTask(ctx context.Context, userID domain.UserID, taskID string, assigneeID domain.UserID) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "AssignTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.AssignTask(ctx, userID, taskID, assigneeID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) UnassignTask(ctx context.Context, userID domain.UserID, taskID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "UnassignTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.UnassignTask(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) GetAssignedTasks(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "GetAssignedTasks", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.GetAssignedTasks(ctx, userID, order)
+	})
+}
+
+func (s *tracingTaskService) GetCompletedTasks(ctx context.Context, userID domain.UserID, from, to time.Time) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "GetCompletedTasks", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.GetCompletedTasks(ctx, userID, from, to)
+	})
+}
+
+func (s *tracingTaskService) GetEstimateSummary(ctx context.Context, userID domain.UserID, days int, loc *time.Location) (*domain.EstimateSummary, error) {
+	return traced(ctx, s.tracer, "GetEstimateSummary", func(ctx context.Context) (*domain.EstimateSummary, error) {
+		return s.next.GetEstimateSummary(ctx, userID, days, loc)
+	})
+}
+
+func (s *tracingTaskService) ReorderTasks(ctx context.Context, userID domain.UserID, ids []string) ([]*domain.Task, error) {
+	return traced(ctx, s.tracer, "ReorderTasks", func(ctx context.Context) ([]*domain.Task, error) {
+		return s.next.ReorderTasks(ctx, userID, ids)
+	}, attribute.Int("task.count", len(ids)))
+}
+
+func (s *tracingTaskService) MoveTask(ctx context.Context, userID domain.UserID, taskID, afterID string) (*domain.Task, error) {
+	return traced(ctx, s.tracer, "MoveTask", func(ctx context.Context) (*domain.Task, error) {
+		return s.next.MoveTask(ctx, userID, taskID, afterID)
+	}, taskIDAttr(taskID))
+}
+
+func (s *tracingTaskService) DeleteTask(ctx context.Context, userID domain.UserID, taskID string) error {
+	_, err := traced(ctx, s.tracer, "DeleteTask", func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, s.next.DeleteTask(ctx, userID, taskID)
+	}, taskIDAttr(taskID))
+	return err
+}
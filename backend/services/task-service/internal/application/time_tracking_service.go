@@ -0,0 +1,118 @@
+// file: backend/services/task-service/internal/application/time_tracking_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// TaskTimeReport adalah semua sesi timer task beserta total waktunya.
+type TaskTimeReport struct {
+	Entries     []*domain.TimeEntry
+	Total       time.Duration // Termasuk timer yang masih berjalan, dihitung sampai GeneratedAt
+	GeneratedAt time.Time
+}
+
+// TimeTrackingApplicationService mendefinisikan use case timer per task.
+type TimeTrackingApplicationService interface {
+	// StartTimer memulai timer pada task. Mengembalikan ErrTimerAlreadyRunning jika pengguna
+	// sudah punya timer yang berjalan, termasuk pada task lain.
+	StartTimer(ctx context.Context, userID domain.UserID, taskID string) (*domain.TimeEntry, error)
+
+	// StopTimer menghentikan timer yang berjalan pada task.
+	StopTimer(ctx context.Context, userID domain.UserID, taskID string) (*domain.TimeEntry, error)
+
+	// GetRunningTimer mengembalikan timer pengguna yang berjalan, atau ErrTimerNotRunning.
+	GetRunningTimer(ctx context.Context, userID domain.UserID) (*domain.TimeEntry, error)
+
+	// GetTaskTime mengembalikan sesi timer dan total waktu yang dihabiskan pada task.
+	GetTaskTime(ctx context.Context, userID domain.UserID, taskID string) (*TaskTimeReport, error)
+}
+
+// timeTrackingService adalah implementasi dari TimeTrackingApplicationService.
+type timeTrackingService struct {
+	taskRepo  domain.TaskRepository
+	entryRepo domain.TimeEntryRepository
+	idGen     domain.IDGenerator
+}
+
+// NewTimeTrackingService adalah constructor untuk timeTrackingService.
+func NewTimeTrackingService(taskRepo domain.TaskRepository, entryRepo domain.TimeEntryRepository, idGen domain.IDGenerator) TimeTrackingApplicationService {
+	return &timeTrackingService{
+		taskRepo:  taskRepo,
+		entryRepo: entryRepo,
+		idGen:     idGen,
+	}
+}
+
+// StartTimer memeriksa timer yang berjalan lebih dulu agar pesan error menyebut task-nya;
+// batas satu timer tetap dijaga oleh repository untuk request yang berjalan bersamaan.
+func (s *timeTrackingService) StartTimer(ctx context.Context, userID domain.UserID, taskID string) (*domain.TimeEntry, error) {
+	if err := s.checkTaskOwner(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+	running, err := s.entryRepo.FindRunning(ctx, userID)
+	switch {
+	case err == nil:
+		return nil, fmt.Errorf("%w on task %s", domain.ErrTimerAlreadyRunning, running.TaskID)
+	case !errors.Is(err, domain.ErrTimerNotRunning):
+		return nil, err
+	}
+
+	entry := &domain.TimeEntry{
+		ID:        s.idGen.NewID(),
+		UserID:    userID,
+		TaskID:    taskID,
+		StartedAt: time.Now(),
+	}
+	if err := s.entryRepo.Start(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// StopTimer menghentikan timer yang berjalan pada task.
+func (s *timeTrackingService) StopTimer(ctx context.Context, userID domain.UserID, taskID string) (*domain.TimeEntry, error) {
+	if err := s.checkTaskOwner(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+	return s.entryRepo.Stop(ctx, userID, taskID, time.Now())
+}
+
+// GetRunningTimer mengembalikan timer pengguna yang berjalan.
+func (s *timeTrackingService) GetRunningTimer(ctx context.Context, userID domain.UserID) (*domain.TimeEntry, error) {
+	return s.entryRepo.FindRunning(ctx, userID)
+}
+
+// GetTaskTime menjumlahkan durasi semua sesi timer task.
+func (s *timeTrackingService) GetTaskTime(ctx context.Context, userID domain.UserID, taskID string) (*TaskTimeReport, error) {
+	if err := s.checkTaskOwner(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+	entries, err := s.entryRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &TaskTimeReport{Entries: entries, GeneratedAt: time.Now()}
+	for _, entry := range entries {
+		report.Total += entry.Duration(report.GeneratedAt)
+	}
+	return report, nil
+}
+
+// checkTaskOwner memastikan task ada dan milik pengguna.
+func (s *timeTrackingService) checkTaskOwner(ctx context.Context, userID domain.UserID, taskID string) error {
+	task, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task.UserID != userID {
+		return domain.ErrTaskNotFound
+	}
+	return nil
+}
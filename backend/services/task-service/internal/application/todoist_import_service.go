@@ -0,0 +1,234 @@
+// file: backend/services/task-service/internal/application/todoist_import_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// MaxTodoistImportTasks adalah jumlah task terbanyak dalam satu impor Todoist.
+const MaxTodoistImportTasks = 2000
+
+// todoistPriorities memetakan prioritas Todoist ke nilai enum task_priority. Prioritas 1 (normal)
+// berarti tanpa prioritas.
+var todoistPriorities = map[int]string{2: "medium", 3: "high", 4: "urgent"}
+
+// TodoistImportInput adalah sumber impor: dokumen ekspor, atau API token untuk membacanya langsung
+// dari Todoist.
+type TodoistImportInput struct {
+	Export   *domain.TodoistExport
+	APIToken string
+	DryRun   bool // Hanya menyusun laporan tanpa membuat task
+}
+
+// TodoistImportCount adalah jumlah task yang diimpor dari satu project atau dengan satu label.
+type TodoistImportCount struct {
+	Name  string
+	Tasks int
+}
+
+// TodoistImportTask adalah satu task yang akan dibuat beserta asalnya di Todoist.
+type TodoistImportTask struct {
+	TodoistID string
+	Project   string
+	Labels    []string
+	Input     CreateTaskInput
+}
+
+// TodoistImportReport adalah hasil impor Todoist. Results nil untuk dry run.
+type TodoistImportReport struct {
+	Projects []TodoistImportCount // Urutan project di Todoist
+	Labels   []TodoistImportCount // Urut nama
+	Tasks    []TodoistImportTask  // Urutan pembuatan: per project, sub-task setelah induknya
+	Skipped  int                  // Task yang sudah selesai, dihapus, atau di project yang diarsipkan
+	Results  []BatchItemResult    // Satu hasil per Tasks, dengan Index yang sama
+}
+
+// TodoistImportApplicationService mendefinisikan use case impor dari Todoist.
+type TodoistImportApplicationService interface {
+	// Import membuat task dari project Todoist ke daftar task pengguna. Daftar task saat ini adalah
+	// seluruh task milik pengguna dan belum ada tag, sehingga nama project dan label ditulis di
+	// akhir deskripsi task. Task yang gagal validasi tidak membatalkan task lain.
+	Import(ctx context.Context, userID domain.UserID, input TodoistImportInput) (*TodoistImportReport, error)
+}
+
+// todoistImportService adalah implementasi dari TodoistImportApplicationService.
+type todoistImportService struct {
+	client domain.TodoistClient
+	bulk   BulkTaskApplicationService
+}
+
+// NewTodoistImportService adalah constructor untuk todoistImportService.
+func NewTodoistImportService(client domain.TodoistClient, bulk BulkTaskApplicationService) TodoistImportApplicationService {
+	return &todoistImportService{
+		client: client,
+		bulk:   bulk,
+	}
+}
+
+// Import menyusun laporan lebih dulu, sehingga dry run dan impor sebenarnya membuat task yang sama.
+func (s *todoistImportService) Import(ctx context.Context, userID domain.UserID, input TodoistImportInput) (*TodoistImportReport, error) {
+	export := input.Export
+	token := strings.TrimSpace(input.APIToken)
+	switch {
+	case (export == nil) == (token == ""):
+		return nil, fmt.Errorf("%w: exactly one of export and api_token is required", domain.ErrInvalidTodoistImport)
+	case export == nil:
+		var err error
+		export, err = s.client.FetchExport(ctx, token)
+		if errors.Is(err, domain.ErrTodoistUnauthorized) {
+			return nil, fmt.Errorf("%w: api token was rejected", domain.ErrInvalidTodoistImport)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := planTodoistImport(export)
+	if err != nil {
+		return nil, err
+	}
+	if input.DryRun {
+		return report, nil
+	}
+
+	inputs := make([]CreateTaskInput, len(report.Tasks))
+	for i, task := range report.Tasks {
+		inputs[i] = task.Input
+	}
+	report.Results = s.bulk.CreateTasks(ctx, userID, inputs)
+	return report, nil
+}
+
+// planTodoistImport memetakan ekspor Todoist ke task yang akan dibuat.
+func planTodoistImport(export *domain.TodoistExport) (*TodoistImportReport, error) {
+	projects := make([]domain.TodoistProject, 0, len(export.Projects))
+	skippedProjects := make(map[string]bool)
+	for _, project := range export.Projects {
+		if project.IsArchived || project.IsDeleted {
+			skippedProjects[project.ID] = true
+			continue
+		}
+		projects = append(projects, project)
+	}
+	sort.SliceStable(projects, func(i, j int) bool { return projects[i].ChildOrder < projects[j].ChildOrder })
+
+	// Sub-task dari induk yang selesai atau dihapus ikut dilewati agar tidak muncul tanpa konteks.
+	children := make(map[string][]domain.TodoistItem)
+	report := &TodoistImportReport{}
+	for _, item := range export.Items {
+		if item.Checked || item.IsDeleted || skippedProjects[item.ProjectID] {
+			report.Skipped++
+			continue
+		}
+		parent := item.ProjectID
+		if item.ParentID != nil && *item.ParentID != "" {
+			parent = "item:" + *item.ParentID
+		}
+		children[parent] = append(children[parent], item)
+	}
+	for _, items := range children {
+		sort.SliceStable(items, func(i, j int) bool { return items[i].ChildOrder < items[j].ChildOrder })
+	}
+
+	labelCounts := make(map[string]int)
+	var visit func(project, parent string) error
+	visit = func(project, parent string) error {
+		items := children[parent]
+		delete(children, parent)
+		for _, item := range items {
+			task, err := todoistImportTask(project, item)
+			if err != nil {
+				return err
+			}
+			if len(report.Tasks) == MaxTodoistImportTasks {
+				return fmt.Errorf("%w: export contains more than %d open tasks", domain.ErrInvalidTodoistImport, MaxTodoistImportTasks)
+			}
+			report.Tasks = append(report.Tasks, task)
+			for _, label := range task.Labels {
+				labelCounts[label]++
+			}
+			if err := visit(project, "item:"+item.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, project := range projects {
+		before := len(report.Tasks)
+		if err := visit(project.Name, project.ID); err != nil {
+			return nil, err
+		}
+		report.Projects = append(report.Projects, TodoistImportCount{Name: project.Name, Tasks: len(report.Tasks) - before})
+	}
+	// Sisa item milik project yang tidak ada di ekspor, atau sub-task dari induk yang dilewati.
+	for _, items := range children {
+		report.Skipped += len(items)
+	}
+
+	for name, count := range labelCounts {
+		report.Labels = append(report.Labels, TodoistImportCount{Name: name, Tasks: count})
+	}
+	sort.Slice(report.Labels, func(i, j int) bool { return report.Labels[i].Name < report.Labels[j].Name })
+	return report, nil
+}
+
+// todoistImportTask memetakan satu item Todoist ke CreateTaskInput.
+func todoistImportTask(project string, item domain.TodoistItem) (TodoistImportTask, error) {
+	input := CreateTaskInput{
+		Title:       strings.TrimSpace(item.Content),
+		Description: todoistDescription(item.Description, project, item.Labels),
+	}
+	if priority, ok := todoistPriorities[item.Priority]; ok {
+		input.Priority = &priority
+	}
+	if item.Due != nil && item.Due.Date != "" {
+		dueText, location, err := todoistDue(item.Due)
+		if err != nil {
+			return TodoistImportTask{}, fmt.Errorf("%w: task %s: %v", domain.ErrInvalidTodoistImport, item.ID, err)
+		}
+		input.DueText, input.DueLocation = &dueText, location
+	}
+	return TodoistImportTask{TodoistID: item.ID, Project: project, Labels: item.Labels, Input: input}, nil
+}
+
+// todoistDescription menambahkan baris asal Todoist, misalnya "Todoist: #Work @errand", di akhir
+// deskripsi.
+func todoistDescription(description, project string, labels []string) string {
+	origin := "Todoist: #" + project
+	for _, label := range labels {
+		origin += " @" + label
+	}
+	if description = strings.TrimSpace(description); description == "" {
+		return origin
+	}
+	return description + "\n\n" + origin
+}
+
+// todoistDue mengubah tenggat Todoist ke due_text yang dikenali ParseDueText beserta zona waktunya;
+// nil berarti zona waktu tersimpan pengguna, sama dengan tenggat mengambang di Todoist.
+func todoistDue(due *domain.TodoistDue) (string, *time.Location, error) {
+	if date, err := time.Parse(time.DateOnly, due.Date); err == nil {
+		return date.Format(time.DateOnly), nil, nil
+	}
+	if at, err := time.Parse(time.RFC3339, due.Date); err == nil {
+		return at.UTC().Format("2006-01-02 15:04"), time.UTC, nil
+	}
+	at, err := time.Parse("2006-01-02T15:04:05", due.Date)
+	if err != nil {
+		return "", nil, fmt.Errorf("unrecognized due date %q", due.Date)
+	}
+	var location *time.Location
+	if due.Timezone != nil && *due.Timezone != "" {
+		if location, err = time.LoadLocation(*due.Timezone); err != nil {
+			return "", nil, fmt.Errorf("unknown time zone %q", *due.Timezone)
+		}
+	}
+	return at.Format("2006-01-02 15:04"), location, nil
+}
@@ -0,0 +1,84 @@
+// file: backend/services/task-service/internal/application/user_event_service.go
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// userEventBatchSize adalah jumlah event akun maksimum yang dibaca dalam satu putaran.
+const userEventBatchSize = 100
+
+// UserEventApplicationService mendefinisikan use case konsumsi event akun dari luar task-service.
+type UserEventApplicationService interface {
+	// ProcessPending memproses event akun yang belum diproses dan mengembalikan jumlah event yang
+	// berhasil diproses.
+	ProcessPending(ctx context.Context) (int, error)
+}
+
+// userEventService adalah implementasi dari UserEventApplicationService.
+type userEventService struct {
+	eventRepo domain.UserEventRepository
+	accounts  AccountApplicationService
+}
+
+// NewUserEventService adalah constructor untuk userEventService.
+func NewUserEventService(eventRepo domain.UserEventRepository, accounts AccountApplicationService) UserEventApplicationService {
+	return &userEventService{
+		eventRepo: eventRepo,
+		accounts:  accounts,
+	}
+}
+
+// ProcessPending memproses event satu per satu dari yang terlama. Pembersihan data bersifat
+// idempotent, sehingga event yang diproses dua kali (misalnya oleh dua replika sekaligus, atau
+// gagal ditandai setelah berhasil) tidak merusak apa pun. Event yang gagal dicatat dan dicoba lagi
+// di putaran berikutnya sampai domain.MaxUserEventAttempts.
+func (s *userEventService) ProcessPending(ctx context.Context) (int, error) {
+	processed := 0
+	for {
+		events, err := s.eventRepo.FindPending(ctx, userEventBatchSize)
+		if err != nil {
+			return processed, err
+		}
+		failed := 0
+		for _, event := range events {
+			if err := s.process(ctx, event); err != nil {
+				slog.ErrorContext(ctx, "error processing user event", "event_type", event.Type, "event_id", event.EventID, "user_id", event.UserID, "attempt", event.Attempts+1, "error", err)
+				if err := s.eventRepo.MarkFailed(ctx, event.ID, err.Error()); err != nil {
+					return processed, err
+				}
+				failed++
+				continue
+			}
+			if err := s.eventRepo.MarkProcessed(ctx, event.ID, time.Now()); err != nil {
+				return processed, err
+			}
+			processed++
+		}
+		// Event yang gagal masih pending, jadi berhenti agar tidak langsung dicoba ulang.
+		if len(events) < userEventBatchSize || failed > 0 {
+			return processed, nil
+		}
+	}
+}
+
+// process menjalankan satu event. Jenis event yang belum dikenal diabaikan (dan ditandai sudah
+// diproses), sehingga producer bisa menambah jenis event baru lebih dulu.
+func (s *userEventService) process(ctx context.Context, event domain.UserEvent) error {
+	switch event.Type {
+	case domain.UserDeleted:
+		result, err := s.accounts.DeleteAccountData(ctx, event.UserID)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "deleted user data", "user_id", event.UserID, "tasks", result.DeletedTasks, "list_shares", result.DeletedShares)
+		return nil
+	default:
+		slog.WarnContext(ctx, "ignoring user event with unknown type", "event_id", event.EventID, "event_type", event.Type)
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+// file: backend/services/task-service/internal/application/user_profile_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// UserProfileApplicationService mendefinisikan use case menampilkan profil pengguna lain, misalnya
+// assignee task dan penulis komentar di daftar bersama.
+type UserProfileApplicationService interface {
+	// GetProfiles mengembalikan satu profil untuk setiap ID unik di ids, sesuai urutannya. Hanya
+	// pengguna itu sendiri dan pengguna yang berbagi daftar dengannya yang diisi DisplayName dan
+	// AvatarURL-nya; ID lain dan ID yang tidak dikenal direktori hanya berisi UserID, sehingga
+	// keberadaan akun tidak bocor. Mengembalikan ErrInvalidUserLookup.
+	GetProfiles(ctx context.Context, userID domain.UserID, ids []domain.UserID) ([]domain.UserProfile, error)
+}
+
+// userProfileService adalah implementasi dari UserProfileApplicationService.
+type userProfileService struct {
+	directory domain.UserDirectory
+	shareRepo domain.ListShareRepository
+}
+
+// NewUserProfileService adalah constructor untuk userProfileService.
+func NewUserProfileService(directory domain.UserDirectory, shareRepo domain.ListShareRepository) UserProfileApplicationService {
+	return &userProfileService{
+		directory: directory,
+		shareRepo: shareRepo,
+	}
+}
+
+// GetProfiles hanya mencari ID yang terlihat ke direktori. Error direktori dikembalikan apa adanya;
+// implementasi dengan cache sudah menangani layanan yang tidak tersedia.
+func (s *userProfileService) GetProfiles(ctx context.Context, userID domain.UserID, ids []domain.UserID) ([]domain.UserProfile, error) {
+	unique := make([]domain.UserID, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 || len(unique) > domain.MaxUserProfileLookup {
+		return nil, fmt.Errorf("%w: between 1 and %d user IDs are required", domain.ErrInvalidUserLookup, domain.MaxUserProfileLookup)
+	}
+
+	visible, err := s.visibleUsers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var lookup []domain.UserID
+	for _, id := range unique {
+		if visible[id] {
+			lookup = append(lookup, id)
+		}
+	}
+	found := map[domain.UserID]domain.UserProfile{}
+	if len(lookup) > 0 {
+		if found, err = s.directory.LookupUsers(ctx, lookup); err != nil {
+			return nil, err
+		}
+	}
+
+	profiles := make([]domain.UserProfile, 0, len(unique))
+	for _, id := range unique {
+		profile, ok := found[id]
+		if !ok {
+			profile = domain.UserProfile{UserID: id}
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// visibleUsers mengembalikan userID, kolaborator daftarnya, pemilik daftar yang dibagikan
+// kepadanya, dan kolaborator lain di daftar tersebut (yang bisa menjadi assignee atau menulis
+// komentar di task yang sama).
+func (s *userProfileService) visibleUsers(ctx context.Context, userID domain.UserID) (map[domain.UserID]bool, error) {
+	visible := map[domain.UserID]bool{userID: true}
+	owned, err := s.shareRepo.FindByOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, share := range owned {
+		visible[share.CollaboratorID] = true
+	}
+	joined, err := s.shareRepo.FindByCollaborator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, share := range joined {
+		visible[share.OwnerID] = true
+		others, err := s.shareRepo.FindByOwner(ctx, share.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range others {
+			visible[other.CollaboratorID] = true
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,240 @@
+// file: backend/services/task-service/internal/application/user_session_service.go
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const (
+	// UserSessionRetention adalah lama sesi yang tidak lagi terlihat disimpan sebelum dihapus. Sesi
+	// yang sudah dicabut tidak pernah dihapus.
+	UserSessionRetention = 90 * 24 * time.Hour
+
+	userSessionQueueSize = 1024
+
+	// userSessionTouchInterval membatasi penulisan last_seen_at menjadi satu per sesi per interval
+	// di setiap replika.
+	userSessionTouchInterval = time.Minute
+
+	// userSessionRevokedRecheck adalah lama status pencabutan sesi di-cache per replika, sehingga
+	// sesi yang dicabut lewat replika lain ditolak paling lambat setelah interval ini.
+	userSessionRevokedRecheck = 30 * time.Second
+
+	// userSessionCacheSize adalah jumlah entri cache maksimum sebelum cache dikosongkan.
+	userSessionCacheSize = 10000
+)
+
+// UserSessionApplicationService mendefinisikan use case sesi pengguna: pencatatan perangkat dan IP
+// setiap sesi, daftar sesi aktif, dan pencabutan sesi dari jarak jauh.
+type UserSessionApplicationService interface {
+	// RecordAttempt mengantrekan autentikasi yang berhasil tanpa memblokir request. Attempt dengan
+	// personal access token dicatat sebagai sesi token tersebut; JWT hanya dicatat jika membawa
+	// claim sesi.
+	RecordAttempt(ctx context.Context, attempt domain.AuthAttempt)
+
+	// SessionRevoked melaporkan apakah sesi sudah dicabut. Dipakai auth.SessionVerifier di setiap
+	// request.
+	SessionRevoked(ctx context.Context, userID domain.UserID, sessionID string) (bool, error)
+
+	// ListSessions mengembalikan sesi aktif pengguna, dari yang terakhir terlihat.
+	ListSessions(ctx context.Context, userID domain.UserID) ([]domain.UserSession, error)
+
+	// RevokeSession mencabut sesi beserta kredensialnya: refresh token untuk sesi login lokal, atau
+	// personal access token-nya. Access token sesi tersebut ditolak sejak saat itu.
+	RevokeSession(ctx context.Context, userID domain.UserID, id string) error
+
+	// Run menyimpan sesi dari antrean sampai ctx dibatalkan. Berjalan di setiap replika.
+	Run(ctx context.Context)
+
+	// RunPurgePeriodically menghapus sesi yang belum dicabut dan tidak terlihat lebih lama dari
+	// UserSessionRetention setiap interval sampai ctx dibatalkan.
+	RunPurgePeriodically(ctx context.Context, interval time.Duration)
+}
+
+// revokedAt adalah status pencabutan sesi yang di-cache beserta waktu pengisiannya.
+type revokedAt struct {
+	revoked bool
+	at      time.Time
+}
+
+// userSessionService adalah implementasi dari UserSessionApplicationService.
+type userSessionService struct {
+	repo             domain.UserSessionRepository
+	tokenRepo        domain.PersonalAccessTokenRepository
+	refreshTokenRepo domain.RefreshTokenRepository
+	queue            chan domain.AuthAttempt
+
+	// Hanya diakses goroutine Run.
+	touched map[string]time.Time
+
+	mu      sync.Mutex
+	revoked map[string]revokedAt
+}
+
+// NewUserSessionService adalah constructor untuk userSessionService. Run harus dijalankan agar
+// sesi yang diantrekan benar-benar disimpan.
+func NewUserSessionService(repo domain.UserSessionRepository, tokenRepo domain.PersonalAccessTokenRepository, refreshTokenRepo domain.RefreshTokenRepository) UserSessionApplicationService {
+	return &userSessionService{
+		repo:             repo,
+		tokenRepo:        tokenRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		queue:            make(chan domain.AuthAttempt, userSessionQueueSize),
+		touched:          make(map[string]time.Time),
+		revoked:          make(map[string]revokedAt),
+	}
+}
+
+// RecordAttempt membuang attempt jika antrean penuh, seperti securityAuditService.
+func (s *userSessionService) RecordAttempt(ctx context.Context, attempt domain.AuthAttempt) {
+	if attempt.Failure != "" || attempt.UserID == "" || (attempt.TokenID == "" && attempt.SessionID == "") {
+		return
+	}
+	if attempt.OccurredAt.IsZero() {
+		attempt.OccurredAt = time.Now().UTC()
+	}
+	select {
+	case s.queue <- attempt:
+	default:
+		slog.WarnContext(ctx, "user session queue full, dropping auth attempt", "credential", attempt.Credential)
+	}
+}
+
+// SessionRevoked membaca status dari cache, atau dari repository jika cache sudah lebih lama dari
+// userSessionRevokedRecheck.
+func (s *userSessionService) SessionRevoked(ctx context.Context, userID domain.UserID, sessionID string) (bool, error) {
+	key := userSessionKey(userID, sessionID)
+	now := time.Now()
+	s.mu.Lock()
+	cached, ok := s.revoked[key]
+	s.mu.Unlock()
+	if ok && (cached.revoked || now.Sub(cached.at) < userSessionRevokedRecheck) {
+		return cached.revoked, nil
+	}
+
+	revoked, err := s.repo.IsRevoked(ctx, userID, sessionID)
+	if err != nil {
+		return false, err
+	}
+	s.cacheRevoked(key, revoked, now)
+	return revoked, nil
+}
+
+func (s *userSessionService) cacheRevoked(key string, revoked bool, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.revoked) >= userSessionCacheSize {
+		clear(s.revoked)
+	}
+	s.revoked[key] = revokedAt{revoked: revoked, at: at}
+}
+
+// ListSessions hanya meneruskan ke repository.
+func (s *userSessionService) ListSessions(ctx context.Context, userID domain.UserID) ([]domain.UserSession, error) {
+	return s.repo.FindActiveByUserID(ctx, userID)
+}
+
+// RevokeSession mencabut kredensial lebih dulu, baru menandai sesi, agar sesi yang gagal dicabut
+// bisa dicoba lagi. Sesi yang sudah dicabut dianggap tidak ada.
+func (s *userSessionService) RevokeSession(ctx context.Context, userID domain.UserID, id string) error {
+	session, err := s.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if session.RevokedAt != nil {
+		return domain.ErrUserSessionNotFound
+	}
+
+	now := time.Now()
+	switch session.Kind {
+	case domain.SessionKindPersonalAccessToken:
+		err := s.tokenRepo.Delete(ctx, userID, id)
+		if err != nil && !errors.Is(err, domain.ErrPersonalAccessTokenNotFound) {
+			return err
+		}
+	case domain.SessionKindLogin:
+		// Sesi Supabase Auth dan OIDC tidak memiliki refresh token di service ini; token baru dari
+		// sesi tersebut tetap membawa ID sesi yang sama, sehingga tetap ditolak.
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, id, now); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.Revoke(ctx, userID, id, now); err != nil {
+		return err
+	}
+	s.cacheRevoked(userSessionKey(userID, id), true, now)
+	slog.InfoContext(ctx, "user session revoked", "user_id", userID, "session_id", id, "kind", session.Kind)
+	return nil
+}
+
+// Run memproses attempt satu per satu. Error penyimpanan hanya di-log.
+func (s *userSessionService) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case attempt := <-s.queue:
+			session := userSessionFromAttempt(attempt)
+			key := userSessionKey(session.UserID, session.ID)
+			if last, ok := s.touched[key]; ok && session.LastSeenAt.Sub(last) < userSessionTouchInterval {
+				continue
+			}
+			if err := s.repo.Touch(ctx, session); err != nil {
+				slog.ErrorContext(ctx, "error recording user session", "user_id", session.UserID, "error", err)
+				continue
+			}
+			if len(s.touched) >= userSessionCacheSize {
+				clear(s.touched)
+			}
+			s.touched[key] = session.LastSeenAt
+		}
+	}
+}
+
+// userSessionFromAttempt memakai ID personal access token sebagai ID sesi, sehingga mencabut sesi
+// tersebut sama dengan mencabut token-nya.
+func userSessionFromAttempt(attempt domain.AuthAttempt) *domain.UserSession {
+	session := &domain.UserSession{
+		ID:         attempt.SessionID,
+		UserID:     attempt.UserID,
+		Kind:       domain.SessionKindLogin,
+		UserAgent:  attempt.UserAgent,
+		IP:         attempt.IP,
+		LastSeenAt: attempt.OccurredAt,
+	}
+	if attempt.TokenID != "" {
+		session.ID = attempt.TokenID
+		session.Kind = domain.SessionKindPersonalAccessToken
+	}
+	return session
+}
+
+func userSessionKey(userID domain.UserID, sessionID string) string {
+	return string(userID) + "|" + sessionID
+}
+
+// RunPurgePeriodically hanya me-log error; purge dicoba lagi di putaran berikutnya.
+func (s *userSessionService) RunPurgePeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.repo.DeleteInactive(ctx, time.Now().Add(-UserSessionRetention))
+			if err != nil {
+				slog.ErrorContext(ctx, "error purging inactive user sessions", "error", err)
+				continue
+			}
+			if purged > 0 {
+				slog.InfoContext(ctx, "purged inactive user sessions", "count", purged)
+			}
+		}
+	}
+}
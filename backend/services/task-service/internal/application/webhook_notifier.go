@@ -0,0 +1,98 @@
+// file: backend/services/task-service/internal/application/webhook_notifier.go
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Header yang dikirim pada setiap request webhook. Penerima memverifikasi signature dengan
+// HMAC-SHA256(secret, timestamp + "." + body) dan menolak timestamp yang terlalu lama.
+const (
+	webhookHeaderEvent     = "X-Webhook-Event"
+	webhookHeaderID        = "X-Webhook-ID"
+	webhookHeaderTimestamp = "X-Webhook-Timestamp"
+	webhookHeaderSignature = "X-Webhook-Signature"
+)
+
+// webhookRetryDelays adalah jeda sebelum setiap percobaan ulang pengiriman webhook.
+var webhookRetryDelays = []time.Duration{time.Second, 5 * time.Second, 30 * time.Second}
+
+// webhookNotifier adalah EventNotifier yang mengirim event ke webhook pengguna.
+type webhookNotifier struct {
+	webhookRepo domain.WebhookRepository
+	sender      domain.WebhookSender
+}
+
+// NewWebhookNotifier adalah constructor untuk webhookNotifier.
+func NewWebhookNotifier(webhookRepo domain.WebhookRepository, sender domain.WebhookSender) EventNotifier {
+	return &webhookNotifier{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+	}
+}
+
+// Notify mengirim event ke setiap webhook pengguna yang menerima jenis event tersebut.
+func (n *webhookNotifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	webhooks, err := n.webhookRepo.FindByUserID(ctx, event.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error loading webhooks", "user_id", event.UserID, "error", err)
+		return
+	}
+	for _, webhook := range webhooks {
+		if !webhook.Accepts(event.Type) {
+			continue
+		}
+		body, err := renderWebhookPayload(webhook, event)
+		if err != nil {
+			slog.ErrorContext(ctx, "error rendering webhook payload", "webhook_id", webhook.ID, "error", err)
+			continue
+		}
+		n.send(ctx, webhook, event, body)
+	}
+}
+
+// send mengirim satu delivery ke webhook dan mencatat jika semua percobaan gagal.
+func (n *webhookNotifier) send(ctx context.Context, webhook *domain.Webhook, event domain.TaskEvent, body []byte) {
+	err := sendSignedWebhook(ctx, n.sender, webhook.URL, webhook.Secret, map[string]string{
+		"Content-Type":     webhook.ContentType,
+		webhookHeaderEvent: string(event.Type),
+		webhookHeaderID:    webhook.ID,
+	}, body)
+	if err != nil {
+		slog.ErrorContext(ctx, "giving up delivering event to webhook", "event_type", event.Type, "webhook_id", webhook.ID, "error", err)
+	}
+}
+
+// sendSignedWebhook mengirim body ke url dengan percobaan ulang. Setiap percobaan ditandatangani
+// ulang dengan timestamp baru; headers dilengkapi X-Webhook-Timestamp dan X-Webhook-Signature.
+// Mengembalikan error percobaan terakhir jika semuanya gagal.
+func sendSignedWebhook(ctx context.Context, sender domain.WebhookSender, url, secret string, headers map[string]string, body []byte) error {
+	for attempt := 0; ; attempt++ {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(body)
+
+		signed := maps.Clone(headers)
+		signed[webhookHeaderTimestamp] = timestamp
+		signed[webhookHeaderSignature] = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		err := sender.Send(ctx, domain.WebhookDelivery{URL: url, Headers: signed, Body: body})
+		if err == nil || attempt == len(webhookRetryDelays) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(webhookRetryDelays[attempt]):
+		}
+	}
+}
@@ -0,0 +1,376 @@
+// file: backend/services/task-service/internal/application/webhook_service.go
+package application
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// maxWebhooksPerUser membatasi jumlah webhook per pengguna, karena setiap event task
+// dikirim ke semua webhook yang cocok.
+const maxWebhooksPerUser = 10
+
+// maxWebhookTemplateSize adalah panjang PayloadTemplate maksimum dalam byte.
+const maxWebhookTemplateSize = 16 << 10
+
+// Batas eksekusi PayloadTemplate. Template ditulis pengguna dan bisa melakukan range atas bilangan
+// bulat atau memanggil template lain secara rekursif, sehingga ukuran payload, jumlah langkah
+// (iterasi range dan pemanggilan template), dan durasinya dibatasi.
+const (
+	maxWebhookPayloadSize      = 64 << 10
+	maxWebhookTemplateSteps    = 10000
+	maxWebhookTemplateDuration = 100 * time.Millisecond
+)
+
+// webhookStepFunc adalah fungsi yang disisipkan di awal setiap badan range dan sebelum setiap
+// pemanggilan template (lihat guardWebhookTemplate) untuk menghitung langkah eksekusi.
+const webhookStepFunc = "webhookStep"
+
+var (
+	errWebhookPayloadTooLarge = fmt.Errorf("payload exceeds %d bytes", maxWebhookPayloadSize)
+	errWebhookTemplateTooLong = fmt.Errorf("template exceeds %d steps or %s", maxWebhookTemplateSteps, maxWebhookTemplateDuration)
+	errWebhookFormatStarWidth = errors.New("printf width and precision must not be '*'")
+)
+
+// webhookEventTypes adalah jenis event yang bisa dipilih saat registrasi webhook.
+var webhookEventTypes = []domain.TaskEventType{domain.TaskCreated, domain.TaskUpdated, domain.TaskDeleted, domain.TaskMoved, domain.TaskMentioned, domain.TaskAssigned}
+
+// webhookTemplateFuncs adalah fungsi tambahan untuk PayloadTemplate.
+// json meng-encode nilai sebagai JSON, misalnya {"content": {{json .Task.Title}}}.
+// Hasil json dan builtin yang bisa jauh lebih besar dari masukannya dibatasi
+// maxWebhookPayloadSize (lihat cappedSprint); tanpa itu {{printf "%1000000d" 0}} membentuk 1 MB
+// per pemanggilan dan hasilnya bisa diumpankan ke printf berikutnya.
+var webhookTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		if len(raw) > maxWebhookPayloadSize {
+			return "", errWebhookPayloadTooLarge
+		}
+		return string(raw), nil
+	},
+	"print": func(args ...any) (string, error) {
+		return cappedSprint("", args, func() string { return fmt.Sprint(args...) })
+	},
+	"printf": func(format string, args ...any) (string, error) {
+		return cappedSprint(format, args, func() string { return fmt.Sprintf(format, args...) })
+	},
+	"println": func(args ...any) (string, error) {
+		return cappedSprint("", args, func() string { return fmt.Sprintln(args...) })
+	},
+	"html": func(args ...any) (string, error) {
+		return cappedSprint("", args, func() string { return template.HTMLEscaper(args...) })
+	},
+	"js": func(args ...any) (string, error) {
+		return cappedSprint("", args, func() string { return template.JSEscaper(args...) })
+	},
+	"urlquery": func(args ...any) (string, error) {
+		return cappedSprint("", args, func() string { return template.URLQueryEscaper(args...) })
+	},
+}
+
+// CreateWebhookInput adalah data registrasi webhook baru.
+type CreateWebhookInput struct {
+	URL             string
+	EventTypes      []domain.TaskEventType
+	PayloadTemplate string
+	ContentType     string
+}
+
+// WebhookApplicationService mendefinisikan use case pengelolaan webhook pengguna.
+type WebhookApplicationService interface {
+	// CreateWebhook memvalidasi dan menyimpan webhook. Secret hanya dikembalikan di sini.
+	CreateWebhook(ctx context.Context, userID domain.UserID, input CreateWebhookInput) (*domain.Webhook, error)
+	ListWebhooks(ctx context.Context, userID domain.UserID) ([]*domain.Webhook, error)
+	DeleteWebhook(ctx context.Context, userID domain.UserID, id string) error
+}
+
+// webhookService adalah implementasi dari WebhookApplicationService.
+type webhookService struct {
+	webhookRepo domain.WebhookRepository
+	idGen       domain.IDGenerator
+}
+
+// NewWebhookService adalah constructor untuk webhookService.
+func NewWebhookService(webhookRepo domain.WebhookRepository, idGen domain.IDGenerator) WebhookApplicationService {
+	return &webhookService{
+		webhookRepo: webhookRepo,
+		idGen:       idGen,
+	}
+}
+
+// CreateWebhook menjalankan PayloadTemplate terhadap contoh event untuk setiap jenis event yang
+// dipilih, sehingga template yang salah (misalnya .Task.Title untuk task.deleted) ditolak saat
+// registrasi, bukan gagal diam-diam saat pengiriman.
+func (s *webhookService) CreateWebhook(ctx context.Context, userID domain.UserID, input CreateWebhookInput) (*domain.Webhook, error) {
+	target, ok := parseWebhookURL(input.URL)
+	if !ok {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrInvalidWebhook)
+	}
+	for _, eventType := range input.EventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", domain.ErrInvalidWebhook, eventType)
+		}
+	}
+	contentType := "application/json"
+	if input.ContentType != "" {
+		if _, _, err := mime.ParseMediaType(input.ContentType); err != nil {
+			return nil, fmt.Errorf("%w: invalid content type", domain.ErrInvalidWebhook)
+		}
+		contentType = input.ContentType
+	}
+	if len(input.PayloadTemplate) > maxWebhookTemplateSize {
+		return nil, fmt.Errorf("%w: payload template is too large", domain.ErrInvalidWebhook)
+	}
+
+	existing, err := s.webhookRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxWebhooksPerUser {
+		return nil, domain.ErrWebhookLimitReached
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	webhook := &domain.Webhook{
+		ID:              s.idGen.NewID(),
+		UserID:          userID,
+		URL:             target.String(),
+		Secret:          secret,
+		EventTypes:      slices.Compact(slices.Sorted(slices.Values(input.EventTypes))),
+		PayloadTemplate: input.PayloadTemplate,
+		ContentType:     contentType,
+		CreatedAt:       time.Now(),
+	}
+	if err := validateWebhookTemplate(webhook); err != nil {
+		return nil, err
+	}
+	if err := s.webhookRepo.Save(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// ListWebhooks mengembalikan webhook milik pengguna.
+func (s *webhookService) ListWebhooks(ctx context.Context, userID domain.UserID) ([]*domain.Webhook, error) {
+	return s.webhookRepo.FindByUserID(ctx, userID)
+}
+
+// DeleteWebhook menghapus webhook milik pengguna.
+func (s *webhookService) DeleteWebhook(ctx context.Context, userID domain.UserID, id string) error {
+	return s.webhookRepo.Delete(ctx, userID, id)
+}
+
+// validateWebhookTemplate merender payload untuk contoh event setiap jenis yang diterima webhook.
+func validateWebhookTemplate(webhook *domain.Webhook) error {
+	sample := &domain.Task{
+		ID:        "00000000-0000-0000-0000-000000000000",
+		UserID:    webhook.UserID,
+		Title:     "Example task",
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.CreatedAt,
+	}
+	for _, eventType := range webhookEventTypes {
+		if !webhook.Accepts(eventType) {
+			continue
+		}
+		if _, err := renderWebhookPayload(webhook, domain.NewTaskEvent(eventType, sample)); err != nil {
+			return fmt.Errorf("%w: %v", domain.ErrInvalidWebhook, err)
+		}
+	}
+	return nil
+}
+
+// renderWebhookPayload membentuk body request untuk event. Tanpa PayloadTemplate, body berisi
+// TaskEvent sebagai JSON.
+func renderWebhookPayload(webhook *domain.Webhook, event domain.TaskEvent) ([]byte, error) {
+	if webhook.PayloadTemplate == "" {
+		return json.Marshal(event)
+	}
+	tmpl, err := template.New("payload").
+		Funcs(webhookTemplateFuncs).
+		Funcs(template.FuncMap{webhookStepFunc: func() string { return "" }}).
+		Option("missingkey=error").
+		Parse(webhook.PayloadTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing payload template: %w", err)
+	}
+	for _, t := range tmpl.Templates() {
+		guardWebhookTemplate(t.Tree, t.Tree.Root)
+	}
+
+	steps := 0
+	deadline := time.Now().Add(maxWebhookTemplateDuration)
+	tmpl.Funcs(template.FuncMap{webhookStepFunc: func() (string, error) {
+		steps++
+		if steps > maxWebhookTemplateSteps || time.Now().After(deadline) {
+			return "", errWebhookTemplateTooLong
+		}
+		return "", nil
+	}})
+	buf := &cappedBuffer{limit: maxWebhookPayloadSize}
+	if err := tmpl.Execute(buf, event); err != nil {
+		return nil, fmt.Errorf("error executing payload template for %s: %w", event.Type, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// guardWebhookTemplate menyisipkan pemanggilan webhookStepFunc di awal badan setiap range dan
+// sebelum setiap {{template}} di list, secara rekursif. Badan range yang kosong pun dihitung,
+// sehingga {{range 1000000000}}{{end}} dihentikan meskipun tidak menulis apa pun.
+func guardWebhookTemplate(tree *parse.Tree, list *parse.ListNode) {
+	if list == nil {
+		return
+	}
+	nodes := make([]parse.Node, 0, len(list.Nodes))
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.TemplateNode:
+			nodes = append(nodes, webhookStepAction(tree, n.Pos))
+		case *parse.RangeNode:
+			guardWebhookTemplate(tree, n.List)
+			n.List.Nodes = append([]parse.Node{webhookStepAction(tree, n.Pos)}, n.List.Nodes...)
+			guardWebhookTemplate(tree, n.ElseList)
+		case *parse.IfNode:
+			guardWebhookTemplate(tree, n.List)
+			guardWebhookTemplate(tree, n.ElseList)
+		case *parse.WithNode:
+			guardWebhookTemplate(tree, n.List)
+			guardWebhookTemplate(tree, n.ElseList)
+		}
+		nodes = append(nodes, node)
+	}
+	list.Nodes = nodes
+}
+
+// webhookStepNode adalah action {{webhookStep}} yang disalin oleh webhookStepAction. Node dibuat
+// lewat parser karena tree di dalam node tidak diekspor, dan String atau Copy pada node tanpa tree
+// panic.
+var webhookStepNode = func() *parse.ActionNode {
+	trees, err := parse.Parse(webhookStepFunc, "{{"+webhookStepFunc+"}}", "", "", map[string]any{webhookStepFunc: func() string { return "" }})
+	if err != nil {
+		panic(err)
+	}
+	return trees[webhookStepFunc].Root.Nodes[0].(*parse.ActionNode)
+}()
+
+// webhookStepAction membuat action {{webhookStep}}, yang tidak menulis apa pun. Identifier-nya
+// memakai tree dan posisi template pengguna agar error langkah menunjuk ke range atau {{template}}
+// yang bersangkutan.
+func webhookStepAction(tree *parse.Tree, pos parse.Pos) *parse.ActionNode {
+	action := webhookStepNode.Copy().(*parse.ActionNode)
+	action.Pipe.Cmds[0].Args[0] = parse.NewIdentifier(webhookStepFunc).SetTree(tree).SetPos(pos)
+	return action
+}
+
+// cappedBuffer adalah bytes.Buffer yang menolak tulisan melebihi limit, sehingga eksekusi
+// template berhenti begitu payload terlalu besar.
+type cappedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, errWebhookPayloadTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
+// cappedSprint memanggil sprint jika perkiraan hasilnya, yaitu panjang format ditambah width dan
+// precision setiap verb serta panjang setiap argumen, tidak melebihi maxWebhookPayloadSize. fmt
+// membentuk seluruh hasil di memori sebelum mengembalikannya, sehingga batas harus diperiksa
+// sebelum memformat. Escaping dan verb seperti %x hanya memperbesar hasil dengan faktor kecil,
+// dan hasil akhirnya diperiksa lagi agar tidak bisa diumpankan ke pemanggilan berikutnya.
+func cappedSprint(format string, args []any, sprint func() string) (string, error) {
+	padding, ok := formatPadding(format)
+	if !ok {
+		return "", errWebhookFormatStarWidth
+	}
+	size := len(format) + padding
+	for _, arg := range args {
+		if size > maxWebhookPayloadSize {
+			break
+		}
+		switch v := arg.(type) {
+		case string:
+			size += len(v)
+		case []byte:
+			size += len(v)
+		default:
+			// Selain string, argumen berasal dari TaskEvent, yang ukurannya terbatas.
+			size += len(fmt.Sprint(v))
+		}
+	}
+	if size > maxWebhookPayloadSize {
+		return "", errWebhookPayloadTooLarge
+	}
+	out := sprint()
+	if len(out) > maxWebhookPayloadSize {
+		return "", errWebhookPayloadTooLarge
+	}
+	return out, nil
+}
+
+// formatPadding menjumlahkan width dan precision setiap verb di format. ok bernilai false untuk
+// width atau precision '*', yang nilainya diambil dari argumen.
+func formatPadding(format string) (total int, ok bool) {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		n := 0
+	verb:
+		for i++; i < len(format); i++ {
+			switch c := format[i]; {
+			case c == '*':
+				return 0, false
+			case '0' <= c && c <= '9':
+				n = min(n*10+int(c-'0'), maxWebhookPayloadSize+1)
+			case strings.IndexByte("+-# .[]", c) >= 0:
+				total, n = total+n, 0
+			default:
+				break verb
+			}
+		}
+		total += n
+	}
+	return total, true
+}
+
+// parseWebhookURL bernilai false jika raw bukan URL http atau https absolut.
+func parseWebhookURL(raw string) (*url.URL, bool) {
+	target, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (target.Scheme != "https" && target.Scheme != "http") || target.Host == "" {
+		return nil, false
+	}
+	return target, true
+}
+
+// newWebhookSecret membuat secret HMAC acak 256-bit.
+func newWebhookSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("error generating webhook secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
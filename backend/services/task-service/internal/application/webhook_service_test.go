@@ -0,0 +1,85 @@
+package application
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+func TestGuardWebhookTemplate(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"plain action", `{{.Type}}`, `{{.Type}}`},
+		{"range", `{{range .X}}a{{end}}`, `{{range .X}}{{webhookStep}}a{{end}}`},
+		{"empty range", `{{range 3}}{{end}}`, `{{range 3}}{{webhookStep}}{{end}}`},
+		{"range else", `{{range .X}}a{{else}}{{template "t"}}{{end}}`, `{{range .X}}{{webhookStep}}a{{else}}{{webhookStep}}{{template "t"}}{{end}}`},
+		{"template", `a{{template "t" .}}b`, `a{{webhookStep}}{{template "t" .}}b`},
+		{"nested range", `{{range .X}}{{range .Y}}{{end}}{{end}}`, `{{range .X}}{{webhookStep}}{{range .Y}}{{webhookStep}}{{end}}{{end}}`},
+		{"inside if", `{{if .X}}{{template "t"}}{{else}}{{range .Y}}{{end}}{{end}}`, `{{if .X}}{{webhookStep}}{{template "t"}}{{else}}{{range .Y}}{{webhookStep}}{{end}}{{end}}`},
+		{"inside with", `{{with .X}}{{template "t"}}{{end}}`, `{{with .X}}{{webhookStep}}{{template "t"}}{{end}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New("payload").
+				Funcs(template.FuncMap{webhookStepFunc: func() string { return "" }}).
+				Parse(tt.text + `{{define "t"}}{{end}}`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			guardWebhookTemplate(tmpl.Tree, tmpl.Tree.Root)
+			if got := tmpl.Tree.Root.String(); got != tt.want {
+				t.Errorf("guardWebhookTemplate(%s) = %s, want %s", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderWebhookPayloadLimits(t *testing.T) {
+	task := &domain.Task{ID: "t1", Title: "Beli susu"}
+	// $lt berisi 10.000 karakter "<", yang diperbesar oleh setiap escaping.
+	const lt = `{{$lt := "<<<<<<<<<<"}}` +
+		`{{$lt = printf "%s%s%s%s%s%s%s%s%s%s" $lt $lt $lt $lt $lt $lt $lt $lt $lt $lt}}` +
+		`{{$lt = printf "%s%s%s%s%s%s%s%s%s%s" $lt $lt $lt $lt $lt $lt $lt $lt $lt $lt}}` +
+		`{{$lt = printf "%s%s%s%s%s%s%s%s%s%s" $lt $lt $lt $lt $lt $lt $lt $lt $lt $lt}}`
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		err      error
+	}{
+		{"json", `{"content": {{json .Task.Title}}}`, `{"content": "Beli susu"}`, nil},
+		{"printf", `{{printf "%s-%05d" .Task.Title 7}}`, `Beli susu-00007`, nil},
+		{"printf arg index", `{{printf "%[2]s %[1]s" "a" "b"}}`, `b a`, nil},
+		{"print", `{{print .Task.Title 1}}`, `Beli susu1`, nil},
+		{"range steps", `{{range 100000000}}{{end}}`, "", errWebhookTemplateTooLong},
+		{"recursive template", `{{define "r"}}{{template "r"}}{{end}}{{template "r"}}`, "", errWebhookTemplateTooLong},
+		{"output", `{{range 5000}}{{json $.Task}}{{end}}`, "", errWebhookPayloadTooLarge},
+		{"printf width", `{{printf "%1000000d" 1}}`, "", errWebhookPayloadTooLarge},
+		{"printf precision", `{{printf "%.1000000f" 1.0}}`, "", errWebhookPayloadTooLarge},
+		{"printf summed widths", `{{printf "%40000d%40000d" 1 2}}`, "", errWebhookPayloadTooLarge},
+		{"printf nested", `{{$s := printf "%40000d" 1}}{{printf "%s%s" $s $s}}`, "", errWebhookPayloadTooLarge},
+		{"printf star width", `{{printf "%*d" 1000000 1}}`, "", errWebhookFormatStarWidth},
+		{"print args", `{{$s := printf "%40000d" 1}}{{print $s $s}}`, "", errWebhookPayloadTooLarge},
+		{"html nested", lt + `{{html (html $lt)}}`, "", errWebhookPayloadTooLarge},
+		{"urlquery nested", lt + `{{urlquery (urlquery (urlquery $lt))}}`, "", errWebhookPayloadTooLarge},
+		{"json nested", lt + `{{json (json $lt)}}`, "", errWebhookPayloadTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook := &domain.Webhook{PayloadTemplate: tt.template}
+			got, err := renderWebhookPayload(webhook, domain.NewTaskEvent(domain.TaskCreated, task))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("renderWebhookPayload(%s) error = %v, want %v", tt.template, err, tt.err)
+			}
+			if err == nil && strings.TrimSpace(string(got)) != tt.want {
+				t.Errorf("renderWebhookPayload(%s) = %s, want %s", tt.template, got, tt.want)
+			}
+		})
+	}
+}
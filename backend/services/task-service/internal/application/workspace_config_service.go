@@ -0,0 +1,469 @@
+// file: backend/services/task-service/internal/application/workspace_config_service.go
+package application
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// WorkspaceConfigApplicationService mendefinisikan use case ekspor dan penerapan konfigurasi
+// workspace sebagai dokumen (configuration as code).
+type WorkspaceConfigApplicationService interface {
+	// ExportConfig mengembalikan konfigurasi workspace dengan semua bagian diisi, sehingga
+	// menerapkan hasilnya dengan prune menghasilkan workspace yang sama.
+	ExportConfig(ctx context.Context, userID domain.UserID) (*domain.WorkspaceConfig, error)
+
+	// DiffConfig mengembalikan perubahan yang dibutuhkan agar workspace sesuai config tanpa
+	// mengubah apa pun. Jika prune, item di bagian yang dikelola tetapi tidak ada di config ikut
+	// dihapus (kolom board dan pemetaan role) atau ditandai deprecated (nilai enum).
+	DiffConfig(ctx context.Context, userID domain.UserID, config *domain.WorkspaceConfig, prune bool) ([]domain.WorkspaceConfigChange, error)
+
+	// ApplyConfig menerapkan perubahan yang sama dengan DiffConfig lalu mengembalikannya.
+	// Penerapan bersifat idempoten: menerapkan config yang sama lagi tidak menghasilkan perubahan.
+	ApplyConfig(ctx context.Context, userID domain.UserID, config *domain.WorkspaceConfig, prune bool) ([]domain.WorkspaceConfigChange, error)
+}
+
+// workspaceConfigService adalah implementasi dari WorkspaceConfigApplicationService. Perubahan
+// diterapkan lewat use case masing-masing bagian agar validasi dan event-nya tetap sama.
+type workspaceConfigService struct {
+	boardRepo    domain.BoardRepository
+	boardService BoardApplicationService
+	enumService  EnumApplicationService
+	scimService  ScimApplicationService
+	retroService RetrospectiveApplicationService
+}
+
+// NewWorkspaceConfigService adalah constructor untuk workspaceConfigService.
+func NewWorkspaceConfigService(boardRepo domain.BoardRepository, boardService BoardApplicationService, enumService EnumApplicationService, scimService ScimApplicationService, retroService RetrospectiveApplicationService) WorkspaceConfigApplicationService {
+	return &workspaceConfigService{
+		boardRepo:    boardRepo,
+		boardService: boardService,
+		enumService:  enumService,
+		scimService:  scimService,
+		retroService: retroService,
+	}
+}
+
+// configPlan adalah hasil perbandingan: perubahan untuk ditampilkan dan langkah untuk menerapkannya.
+type configPlan struct {
+	changes []domain.WorkspaceConfigChange
+	steps   []func(ctx context.Context) error
+}
+
+func (p *configPlan) add(change domain.WorkspaceConfigChange) {
+	p.changes = append(p.changes, change)
+}
+
+func (p *configPlan) step(fn func(ctx context.Context) error) {
+	p.steps = append(p.steps, fn)
+}
+
+// ExportConfig membaca setiap bagian konfigurasi workspace.
+func (s *workspaceConfigService) ExportConfig(ctx context.Context, userID domain.UserID) (*domain.WorkspaceConfig, error) {
+	config := &domain.WorkspaceConfig{
+		Version: domain.WorkspaceConfigVersion,
+		Board:   []domain.WorkspaceConfigColumn{},
+		Enums:   make(map[domain.EnumName][]domain.WorkspaceConfigEnumValue, len(domain.KnownEnums)),
+	}
+
+	columns, err := s.boardRepo.FindColumns(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range columns {
+		config.Board = append(config.Board, domain.WorkspaceConfigColumn{Name: column.Name, WIPLimit: column.WIPLimit})
+	}
+
+	enums, err := s.enumService.ListEnums(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, enum := range domain.KnownEnums {
+		values := []domain.WorkspaceConfigEnumValue{}
+		for _, value := range enums[enum] {
+			if !value.Builtin {
+				values = append(values, domain.WorkspaceConfigEnumValue{
+					Value:      value.Value,
+					Label:      value.Label,
+					Position:   value.Position,
+					Deprecated: value.Deprecated,
+				})
+			}
+		}
+		config.Enums[enum] = values
+	}
+
+	if config.RoleMappings, err = s.scimService.GetRoleMappings(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.retroService.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	config.Retrospective = &domain.WorkspaceConfigRetrospective{Enabled: settings.Enabled, TimeZone: settings.TimeZone}
+	return config, nil
+}
+
+// DiffConfig menyusun rencana tanpa menjalankan langkahnya.
+func (s *workspaceConfigService) DiffConfig(ctx context.Context, userID domain.UserID, config *domain.WorkspaceConfig, prune bool) ([]domain.WorkspaceConfigChange, error) {
+	plan, err := s.plan(ctx, userID, config, prune)
+	if err != nil {
+		return nil, err
+	}
+	return plan.changes, nil
+}
+
+// ApplyConfig menjalankan langkah rencana secara berurutan. Seluruh config divalidasi sebelum
+// langkah pertama dijalankan; jika sebuah langkah gagal, langkah sebelumnya tetap tersimpan dan
+// menerapkan ulang config yang sama akan melanjutkan sisanya.
+func (s *workspaceConfigService) ApplyConfig(ctx context.Context, userID domain.UserID, config *domain.WorkspaceConfig, prune bool) ([]domain.WorkspaceConfigChange, error) {
+	plan, err := s.plan(ctx, userID, config, prune)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range plan.steps {
+		if err := step(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return plan.changes, nil
+}
+
+// plan membandingkan setiap bagian yang dikelola config dengan keadaan workspace saat ini.
+func (s *workspaceConfigService) plan(ctx context.Context, userID domain.UserID, config *domain.WorkspaceConfig, prune bool) (*configPlan, error) {
+	if config.Version != domain.WorkspaceConfigVersion {
+		return nil, fmt.Errorf("%w: version must be %d", domain.ErrInvalidWorkspaceConfig, domain.WorkspaceConfigVersion)
+	}
+	plan := &configPlan{changes: []domain.WorkspaceConfigChange{}}
+	if config.Board != nil {
+		if err := s.planBoard(ctx, userID, config.Board, prune, plan); err != nil {
+			return nil, err
+		}
+	}
+	if config.Enums != nil {
+		if err := s.planEnums(ctx, userID, config.Enums, prune, plan); err != nil {
+			return nil, err
+		}
+	}
+	if config.RoleMappings != nil {
+		if err := s.planRoleMappings(ctx, userID, config.RoleMappings, prune, plan); err != nil {
+			return nil, err
+		}
+	}
+	if config.Retrospective != nil {
+		if err := s.planRetrospective(ctx, userID, *config.Retrospective, plan); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// planBoard mencocokkan kolom dengan nama. Kolom dihapus lebih dulu agar slot kolom tersedia,
+// lalu diubah, dibuat, dan terakhir diurutkan sesuai config; kolom lama yang dipertahankan
+// (tanpa prune) diletakkan setelah kolom dari config.
+func (s *workspaceConfigService) planBoard(ctx context.Context, userID domain.UserID, desired []domain.WorkspaceConfigColumn, prune bool, plan *configPlan) error {
+	const section = "board"
+	current, err := s.boardRepo.FindColumns(ctx, userID)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]*domain.BoardColumn, len(current))
+	names := make(map[string]string, len(current)+len(desired)) // Key ke nama kolom untuk ringkasan
+	for _, column := range current {
+		existing[strings.ToLower(column.Name)] = column
+		names[strings.ToLower(column.Name)] = column.Name
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	var order, created []string
+	var upserts []func(ctx context.Context) error
+	for _, item := range desired {
+		target := &domain.BoardColumn{}
+		if err := applyBoardColumnInput(target, BoardColumnInput{Name: &item.Name, WIPLimit: wipLimitInput(item.WIPLimit)}); err != nil {
+			return fmt.Errorf("%w: %s: %w", domain.ErrInvalidWorkspaceConfig, section, err)
+		}
+		key := strings.ToLower(target.Name)
+		if wanted[key] {
+			return fmt.Errorf("%w: %s: duplicate column %q", domain.ErrInvalidWorkspaceConfig, section, target.Name)
+		}
+		wanted[key] = true
+		order = append(order, key)
+		names[key] = target.Name
+
+		input := BoardColumnInput{Name: &target.Name, WIPLimit: wipLimitInput(target.WIPLimit)}
+		column, ok := existing[key]
+		switch {
+		case !ok:
+			created = append(created, key)
+			plan.add(domain.WorkspaceConfigChange{Section: section, Key: target.Name, Action: domain.WorkspaceConfigCreate, After: columnSummary(target)})
+			upserts = append(upserts, func(ctx context.Context) error {
+				_, err := s.boardService.CreateColumn(ctx, userID, input)
+				return err
+			})
+		case column.Name != target.Name || !equalWIPLimit(column.WIPLimit, target.WIPLimit):
+			plan.add(domain.WorkspaceConfigChange{Section: section, Key: target.Name, Action: domain.WorkspaceConfigUpdate, Before: columnSummary(column), After: columnSummary(target)})
+			upserts = append(upserts, func(ctx context.Context) error {
+				_, err := s.boardService.UpdateColumn(ctx, userID, column.ID, input)
+				return err
+			})
+		}
+	}
+
+	// kept adalah urutan kolom lama yang tersisa; tanpa pengurutan, kolom baru menyusul di belakangnya.
+	var kept, extras []string
+	for _, column := range current {
+		key := strings.ToLower(column.Name)
+		switch {
+		case wanted[key]:
+			kept = append(kept, key)
+		case !prune:
+			kept = append(kept, key)
+			extras = append(extras, key)
+		default:
+			plan.add(domain.WorkspaceConfigChange{Section: section, Key: column.Name, Action: domain.WorkspaceConfigDelete, Before: columnSummary(column)})
+			plan.step(func(ctx context.Context) error {
+				return s.boardService.DeleteColumn(ctx, userID, column.ID)
+			})
+		}
+	}
+	if len(order)+len(extras) > domain.MaxBoardColumns {
+		return fmt.Errorf("%w: %s: a board can have at most %d columns", domain.ErrInvalidWorkspaceConfig, section, domain.MaxBoardColumns)
+	}
+	plan.steps = append(plan.steps, upserts...)
+
+	final := append(order, extras...)
+	if slices.Equal(append(kept, created...), final) {
+		return nil
+	}
+	before := make([]string, 0, len(current))
+	for _, column := range current {
+		before = append(before, column.Name)
+	}
+	after := make([]string, 0, len(final))
+	for _, key := range final {
+		after = append(after, names[key])
+	}
+	plan.add(domain.WorkspaceConfigChange{Section: section, Action: domain.WorkspaceConfigReorder, Before: strings.Join(before, ", "), After: strings.Join(after, ", ")})
+	plan.step(func(ctx context.Context) error {
+		columns, err := s.boardRepo.FindColumns(ctx, userID)
+		if err != nil {
+			return err
+		}
+		position := make(map[string]int, len(final))
+		for i, key := range final {
+			position[key] = i
+		}
+		slices.SortStableFunc(columns, func(a, b *domain.BoardColumn) int {
+			return position[strings.ToLower(a.Name)] - position[strings.ToLower(b.Name)]
+		})
+		ids := make([]string, 0, len(columns))
+		for _, column := range columns {
+			ids = append(ids, column.ID)
+		}
+		_, err = s.boardService.ReorderColumns(ctx, userID, ids)
+		return err
+	})
+	return nil
+}
+
+// planEnums membuat atau mengubah nilai enum tambahan. Nilai enum tidak bisa dihapus, jadi prune
+// menandai nilai yang tidak ada di config sebagai deprecated.
+func (s *workspaceConfigService) planEnums(ctx context.Context, userID domain.UserID, desired map[domain.EnumName][]domain.WorkspaceConfigEnumValue, prune bool, plan *configPlan) error {
+	current, err := s.enumService.ListEnums(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, enum := range slices.Sorted(maps.Keys(desired)) {
+		section := "enums." + string(enum)
+		if err := enum.Validate(); err != nil {
+			return fmt.Errorf("%w: unknown enum %q", domain.ErrInvalidWorkspaceConfig, enum)
+		}
+		existing := make(map[string]domain.EnumValue, len(current[enum]))
+		custom := 0
+		for _, value := range current[enum] {
+			existing[value.Value] = value
+			if !value.Builtin {
+				custom++
+			}
+		}
+
+		wanted := make(map[string]bool, len(desired[enum]))
+		for _, item := range desired[enum] {
+			if err := domain.ValidateEnumValueName(item.Value); err != nil {
+				return fmt.Errorf("%w: %s: value %q must match [a-z][a-z0-9_]{0,31}", domain.ErrInvalidWorkspaceConfig, section, item.Value)
+			}
+			label := strings.TrimSpace(item.Label)
+			if label == "" || len(label) > maxEnumLabelLength {
+				return fmt.Errorf("%w: %s: label of %q must be 1 to %d characters", domain.ErrInvalidWorkspaceConfig, section, item.Value, maxEnumLabelLength)
+			}
+			if wanted[item.Value] {
+				return fmt.Errorf("%w: %s: duplicate value %q", domain.ErrInvalidWorkspaceConfig, section, item.Value)
+			}
+			wanted[item.Value] = true
+
+			target := domain.EnumValue{Value: item.Value, Label: label, Position: item.Position, Deprecated: item.Deprecated}
+			value, ok := existing[item.Value]
+			switch {
+			case ok && value.Builtin:
+				return fmt.Errorf("%w: %s: %q is a builtin value", domain.ErrInvalidWorkspaceConfig, section, item.Value)
+			case !ok:
+				custom++
+				plan.add(domain.WorkspaceConfigChange{Section: section, Key: item.Value, Action: domain.WorkspaceConfigCreate, After: enumValueSummary(target)})
+			case value.Label != target.Label || value.Position != target.Position || value.Deprecated != target.Deprecated:
+				plan.add(domain.WorkspaceConfigChange{Section: section, Key: item.Value, Action: domain.WorkspaceConfigUpdate, Before: enumValueSummary(value), After: enumValueSummary(target)})
+			default:
+				continue
+			}
+			s.planEnumValue(userID, enum, target, plan)
+		}
+		if custom > domain.MaxCustomEnumValues {
+			return fmt.Errorf("%w: %s: at most %d custom values are allowed", domain.ErrInvalidWorkspaceConfig, section, domain.MaxCustomEnumValues)
+		}
+
+		if !prune {
+			continue
+		}
+		for _, value := range current[enum] {
+			if value.Builtin || value.Deprecated || wanted[value.Value] {
+				continue
+			}
+			target := value
+			target.Deprecated = true
+			plan.add(domain.WorkspaceConfigChange{Section: section, Key: value.Value, Action: domain.WorkspaceConfigUpdate, Before: enumValueSummary(value), After: enumValueSummary(target)})
+			s.planEnumValue(userID, enum, target, plan)
+		}
+	}
+	return nil
+}
+
+func (s *workspaceConfigService) planEnumValue(userID domain.UserID, enum domain.EnumName, value domain.EnumValue, plan *configPlan) {
+	plan.step(func(ctx context.Context) error {
+		_, err := s.enumService.SaveEnumValue(ctx, userID, enum, value.Value, SaveEnumValueInput{
+			Label:      value.Label,
+			Position:   value.Position,
+			Deprecated: value.Deprecated,
+		})
+		return err
+	})
+}
+
+// planRoleMappings membandingkan pemetaan grup ke role, lalu menyimpan seluruh pemetaan hasilnya
+// dalam satu langkah.
+func (s *workspaceConfigService) planRoleMappings(ctx context.Context, userID domain.UserID, desired map[string]domain.WorkspaceRole, prune bool, plan *configPlan) error {
+	const section = "role_mappings"
+	current, err := s.scimService.GetRoleMappings(ctx, userID)
+	if err != nil {
+		return err
+	}
+	final := make(map[string]domain.WorkspaceRole, len(current)+len(desired))
+	if !prune {
+		maps.Copy(final, current)
+	}
+	seen := make(map[string]bool, len(desired))
+	for name, role := range desired {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if err := role.Validate(); err != nil {
+			return fmt.Errorf("%w: %s: %q: %w", domain.ErrInvalidWorkspaceConfig, section, name, err)
+		}
+		if key == "" || seen[key] {
+			return fmt.Errorf("%w: %s: group names must be non-empty and unique ignoring case", domain.ErrInvalidWorkspaceConfig, section)
+		}
+		seen[key] = true
+		final[key] = role
+	}
+
+	changed := false
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := final[key]; !ok {
+			changed = true
+			plan.add(domain.WorkspaceConfigChange{Section: section, Key: key, Action: domain.WorkspaceConfigDelete, Before: string(current[key])})
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(final)) {
+		before, ok := current[key]
+		switch {
+		case !ok:
+			changed = true
+			plan.add(domain.WorkspaceConfigChange{Section: section, Key: key, Action: domain.WorkspaceConfigCreate, After: string(final[key])})
+		case before != final[key]:
+			changed = true
+			plan.add(domain.WorkspaceConfigChange{Section: section, Key: key, Action: domain.WorkspaceConfigUpdate, Before: string(before), After: string(final[key])})
+		}
+	}
+	if !changed {
+		return nil
+	}
+	plan.step(func(ctx context.Context) error {
+		_, err := s.scimService.SaveRoleMappings(ctx, userID, final)
+		return err
+	})
+	return nil
+}
+
+// planRetrospective membandingkan pengaturan retrospektif.
+func (s *workspaceConfigService) planRetrospective(ctx context.Context, userID domain.UserID, desired domain.WorkspaceConfigRetrospective, plan *configPlan) error {
+	if _, err := time.LoadLocation(desired.TimeZone); err != nil {
+		return fmt.Errorf("%w: retrospective: %w", domain.ErrInvalidWorkspaceConfig, domain.ErrInvalidTimeZone)
+	}
+	settings, err := s.retroService.GetSettings(ctx, userID)
+	if err != nil {
+		return err
+	}
+	current := domain.WorkspaceConfigRetrospective{Enabled: settings.Enabled, TimeZone: settings.TimeZone}
+	if current == desired {
+		return nil
+	}
+	plan.add(domain.WorkspaceConfigChange{Section: "retrospective", Action: domain.WorkspaceConfigUpdate, Before: retrospectiveSummary(current), After: retrospectiveSummary(desired)})
+	plan.step(func(ctx context.Context) error {
+		_, err := s.retroService.SaveSettings(ctx, userID, desired.Enabled, desired.TimeZone)
+		return err
+	})
+	return nil
+}
+
+// wipLimitInput mengubah batas WIP config (nil berarti tanpa batas) ke BoardColumnInput (0 berarti tanpa batas).
+func wipLimitInput(limit *int) *int {
+	if limit == nil {
+		return new(int)
+	}
+	return limit
+}
+
+func equalWIPLimit(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func columnSummary(column *domain.BoardColumn) string {
+	limit := "none"
+	if column.WIPLimit != nil {
+		limit = strconv.Itoa(*column.WIPLimit)
+	}
+	return fmt.Sprintf("name %q, wip_limit %s", column.Name, limit)
+}
+
+func enumValueSummary(value domain.EnumValue) string {
+	summary := fmt.Sprintf("label %q, position %d", value.Label, value.Position)
+	if value.Deprecated {
+		summary += ", deprecated"
+	}
+	return summary
+}
+
+func retrospectiveSummary(settings domain.WorkspaceConfigRetrospective) string {
+	timeZone := settings.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	return fmt.Sprintf("enabled %t, time_zone %s", settings.Enabled, timeZone)
+}
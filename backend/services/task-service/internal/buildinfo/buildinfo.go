@@ -0,0 +1,66 @@
+// file: backend/services/task-service/internal/buildinfo/buildinfo.go
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+// Diisi saat build dengan -ldflags, misalnya:
+//
+//	go build -ldflags "-X github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/buildinfo.version=v1.4.0 \
+//	  -X github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/buildinfo.commit=$(git rev-parse HEAD) \
+//	  -X github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/buildinfo.buildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd
+var (
+	version   string
+	commit    string
+	buildTime string
+)
+
+// Info adalah identitas build service.
+type Info struct {
+	Version   string // Versi rilis; "dev" jika tidak diisi saat build
+	Commit    string // Hash commit git; kosong jika tidak diketahui
+	BuildTime string // Waktu build RFC 3339; untuk build tanpa ldflags, waktu commit
+	Modified  bool   // Build dari working tree yang punya perubahan belum di-commit
+	GoVersion string
+}
+
+var info = sync.OnceValue(func() Info {
+	result := Info{
+		Version:   version,
+		Commit:    commit,
+		BuildTime: buildTime,
+		GoVersion: runtime.Version(),
+	}
+	// Tanpa ldflags, pakai informasi VCS yang disematkan go build.
+	if build, ok := debug.ReadBuildInfo(); ok {
+		if result.Version == "" && build.Main.Version != "" && build.Main.Version != "(devel)" {
+			result.Version = build.Main.Version
+		}
+		for _, setting := range build.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if result.Commit == "" {
+					result.Commit = setting.Value
+				}
+			case "vcs.time":
+				if result.BuildTime == "" {
+					result.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				result.Modified = setting.Value == "true"
+			}
+		}
+	}
+	if result.Version == "" {
+		result.Version = "dev"
+	}
+	return result
+})
+
+// Get mengembalikan identitas build service.
+func Get() Info {
+	return info()
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DeletedUserID menggantikan ID pengguna yang sudah dihapus pada data yang tetap disimpan untuk
+// pengguna lain, misalnya komentar di task orang lain, pelaku di feed aktivitas, dan audit log admin.
+const DeletedUserID UserID = "deleted-user"
+
+// AccountDeletionStatus adalah status penghapusan data akun.
+type AccountDeletionStatus string
+
+const (
+	AccountDeletionPending   AccountDeletionStatus = "pending"   // Menunggu diproses job terjadwal
+	AccountDeletionRunning   AccountDeletionStatus = "running"   // Sedang menghapus data
+	AccountDeletionCompleted AccountDeletionStatus = "completed" // Receipt terisi
+	AccountDeletionFailed    AccountDeletionStatus = "failed"
+)
+
+// AccountDeletionReceipt merangkum data yang dihapus atau dianonimkan saat penghapusan akun.
+type AccountDeletionReceipt struct {
+	DeletedTasks            int64
+	DeletedShares           int   // Berbagi daftar, baik sebagai pemilik maupun kolaborator
+	DeletedReminderChannels int   // Pengaturan pengingat email, push, dan Slack
+	DeletedWebhooks         int64 // Webhook dan callback task
+	DeletedFiles            int   // Isi attachment dan arsip di BlobStore
+	AnonymizedComments      int64 // Komentar di task orang lain; isinya dihapus dan penulisnya DeletedUserID
+	AnonymizedActivities    int64 // Pelaku di feed aktivitas dan riwayat revisi task orang lain
+	AnonymizedAuditEntries  int64
+	DeletedRecords          int64 // Baris lainnya, misalnya perangkat, token, integrasi, dan ekspor data
+}
+
+// AccountDeletion adalah permintaan penghapusan seluruh data pengguna (hak untuk dilupakan). Data
+// dihapus secara asinkron; catatan ini beserta receipt-nya tetap disimpan sebagai bukti penghapusan.
+type AccountDeletion struct {
+	ID          string
+	UserID      UserID
+	Status      AccountDeletionStatus
+	Receipt     *AccountDeletionReceipt // Diisi saat completed
+	Error       string                  // Pesan singkat saat failed, tanpa detail internal
+	RequestedAt time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// Active melaporkan apakah penghapusan belum selesai diproses.
+func (d *AccountDeletion) Active() bool {
+	return d.Status == AccountDeletionPending || d.Status == AccountDeletionRunning
+}
+
+var (
+	ErrAccountDeletionNotFound   = errors.New("account deletion not found")
+	ErrAccountDeletionInProgress = errors.New("an account deletion is already in progress")
+)
+
+// AccountDeletionRepository mendefinisikan kontrak penyimpanan permintaan penghapusan akun.
+type AccountDeletionRepository interface {
+	// Create menyimpan permintaan baru. Mengembalikan ErrAccountDeletionInProgress jika pengguna
+	// masih memiliki penghapusan pending atau running.
+	Create(ctx context.Context, deletion *AccountDeletion) error
+
+	// FindByID mengembalikan ErrAccountDeletionNotFound jika penghapusan tidak ada atau milik
+	// pengguna lain.
+	FindByID(ctx context.Context, userID UserID, id string) (*AccountDeletion, error)
+
+	// ClaimPending mengubah paling banyak limit penghapusan pending (yang paling lama) menjadi
+	// running dan mengembalikannya. Penghapusan running yang StartedAt-nya sebelum staleBefore
+	// diklaim ulang.
+	ClaimPending(ctx context.Context, now, staleBefore time.Time, limit int) ([]*AccountDeletion, error)
+
+	// Update menyimpan status, receipt, dan waktu penghapusan.
+	Update(ctx context.Context, deletion *AccountDeletion) error
+}
+
+// AccountObjectKeys adalah objek di storage yang menyimpan data pengguna.
+type AccountObjectKeys struct {
+	AttachmentKeys []string // Key AttachmentStorage: attachment di task pengguna dan yang diunggahnya
+	BlobKeys       []string // Key BlobStore: arsip workspace dan ekspor data
+}
+
+// AccountErasureRepository mendefinisikan kontrak penghapusan data pengguna yang tidak dimiliki
+// repository lain. Task, berbagi daftar, pengaturan pengingat, dan personal access token dihapus
+// lewat repository masing-masing.
+type AccountErasureRepository interface {
+	// FindObjectKeys mengembalikan key objek storage milik pengguna, agar isinya bisa dihapus
+	// sebelum barisnya.
+	FindObjectKeys(ctx context.Context, userID UserID) (*AccountObjectKeys, error)
+
+	// EraseUserData menghapus sisa baris milik pengguna dan menganonimkan data yang tetap disimpan
+	// untuk pengguna lain dalam satu transaksi. Catatan AccountDeletion tidak ikut dihapus. Jumlah
+	// baris yang diubah ditambahkan ke receipt. Aman dipanggil ulang.
+	EraseUserData(ctx context.Context, userID UserID, receipt *AccountDeletionReceipt) error
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ActivityType adalah jenis aktivitas yang ditampilkan di feed "aktivitas terbaru".
+type ActivityType string
+
+const (
+	ActivityTaskCreated   ActivityType = "task.created"
+	ActivityTaskCompleted ActivityType = "task.completed"
+	ActivityTaskReopened  ActivityType = "task.reopened"
+	ActivityTaskArchived  ActivityType = "task.archived"
+	ActivityTaskDeleted   ActivityType = "task.deleted"
+)
+
+// Activity adalah satu entri feed aktivitas pengguna. TaskTitle disalin saat aktivitas terjadi,
+// sehingga entri tetap terbaca setelah task diganti judulnya atau dihapus.
+type Activity struct {
+	ID         int64
+	UserID     UserID
+	ActorID    UserID // Pelaku aktivitas; sama dengan UserID kecuali diubah admin
+	Type       ActivityType
+	TaskID     string
+	TaskTitle  string
+	OccurredAt time.Time
+}
+
+// ActivityPageQuery adalah parameter cursor pagination untuk feed aktivitas.
+type ActivityPageQuery struct {
+	Limit  int    // Jumlah aktivitas maksimum per halaman
+	Cursor string // Cursor opaque dari ActivityPage.NextCursor sebelumnya; kosong berarti halaman pertama
+}
+
+// ActivityPage adalah satu halaman feed aktivitas, dari yang terbaru.
+type ActivityPage struct {
+	Activities []Activity
+	NextCursor string // Kosong jika tidak ada halaman berikutnya
+}
+
+// ActivityRepository mendefinisikan kontrak pembacaan feed aktivitas.
+// Aktivitas dicatat oleh penyimpanan setiap kali task dibuat, diselesaikan, dibuka kembali,
+// diarsipkan, atau dihapus.
+type ActivityRepository interface {
+	// FindPageByUserID mengambil satu halaman aktivitas pengguna.
+	// Mengembalikan ErrInvalidCursor jika cursor tidak bisa dibaca.
+	FindPageByUserID(ctx context.Context, userID UserID, query ActivityPageQuery) (*ActivityPage, error)
+}
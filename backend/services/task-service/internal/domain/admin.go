@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Definisikan error domain untuk fitur admin
+var (
+	ErrSearchQueryTooShort = errors.New("search query must be at least 3 characters")
+	ErrAuditReasonRequired = errors.New("reason is required for audited admin actions")
+	ErrInvalidSearchType   = errors.New("invalid search type")
+)
+
+// UserSummary adalah ringkasan pengguna yang terlihat dari sisi task-service.
+// Data profil (email, nama) dikelola oleh Supabase Auth dan tidak disimpan di sini.
+type UserSummary struct {
+	UserID         UserID
+	TaskCount      int64
+	LastActivityAt time.Time // updated_at task terakhir milik pengguna
+}
+
+// AdminSearchRepository mendefinisikan kontrak pencarian lintas pengguna untuk admin.
+type AdminSearchRepository interface {
+	// SearchTasks mencari task milik pengguna mana pun dengan ID atau user ID yang sama persis
+	// dengan query, atau judul yang memuat query (case-insensitive).
+	SearchTasks(ctx context.Context, query string, limit int) ([]*Task, error)
+
+	// SearchUsers mencari pengguna yang ID-nya diawali query.
+	SearchUsers(ctx context.Context, query string, limit int) ([]UserSummary, error)
+
+	// TopUsers mengembalikan pengguna dengan task terbanyak, terbanyak lebih dulu.
+	TopUsers(ctx context.Context, limit int) ([]UserSummary, error)
+
+	// UserContentCounts menghitung konten milik pengguna. Pengguna tanpa konten menghasilkan
+	// hitungan nol, bukan error.
+	UserContentCounts(ctx context.Context, userID UserID) (*UserContentCounts, error)
+}
+
+// UserContentCounts adalah jumlah konten milik satu pengguna, untuk investigasi penyalahgunaan.
+type UserContentCounts struct {
+	UserID          UserID
+	Tasks           int64
+	OpenTasks       int64
+	ArchivedTasks   int64
+	Comments        int64 // Komentar yang ditulis pengguna, di task siapa pun
+	Attachments     int64
+	AttachmentBytes int64
+	Collaborators   int64      // Pengguna yang diberi akses ke daftar pengguna ini
+	LastActivityAt  *time.Time // updated_at task terakhir; nil jika tidak punya task
+}
+
+// AdminAuditEntry adalah catatan satu aksi admin.
+type AdminAuditEntry struct {
+	ID          int64
+	AdminID     UserID
+	Action      string         // Misalnya "admin.search"
+	Reason      string         // Alasan aksi, misalnya nomor tiket support
+	Details     map[string]any // Parameter aksi (query, filter, opsi redaksi)
+	ResultCount int
+	OccurredAt  time.Time
+}
+
+// AdminAuditRepository mendefinisikan kontrak penyimpanan audit log aksi admin.
+type AdminAuditRepository interface {
+	Record(ctx context.Context, entry AdminAuditEntry) error
+
+	// FindByUser mengembalikan paling banyak limit entri terbaru yang dilakukan oleh userID atau
+	// yang menyangkut userID (details user_id, owner_id, atau author_id), untuk ekspor data pribadi.
+	FindByUser(ctx context.Context, userID UserID, limit int) ([]AdminAuditEntry, error)
+}
+
+// SystemStats adalah ringkasan penggunaan seluruh instalasi untuk admin.
+type SystemStats struct {
+	Users           int64 // Pengguna yang memiliki setidaknya satu task
+	ActiveUsers     int64 // Pengguna yang mengubah task dalam 7 hari terakhir
+	Tasks           int64
+	OpenTasks       int64
+	TasksCreated7d  int64
+	Attachments     int64
+	AttachmentBytes int64
+	Admins          int64 // Pengguna dengan RoleAdmin di tabel user_roles
+	GeneratedAt     time.Time
+}
+
+// SystemStatsRepository mendefinisikan kontrak agregasi statistik lintas pengguna.
+type SystemStatsRepository interface {
+	SystemStats(ctx context.Context) (*SystemStats, error)
+}
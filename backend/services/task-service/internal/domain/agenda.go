@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxAgendaDays adalah rentang agenda terpanjang (satu minggu).
+const MaxAgendaDays = 7
+
+// Agenda adalah task yang bertenggat dalam rentang [From, To), dikelompokkan per daftar lalu per
+// prioritas, misalnya untuk agenda harian atau mingguan yang dicetak.
+type Agenda struct {
+	From     time.Time
+	To       time.Time
+	Location *time.Location // Zona waktu tampilan; From dan To adalah awal hari di zona ini
+	Lists    []AgendaList   // Daftar pengguna sendiri lebih dulu, lalu daftar bersama; daftar tanpa task tidak ikut
+
+	// Truncated bernilai true jika sebagian task tidak ikut karena batas jumlah task per daftar.
+	Truncated bool
+}
+
+// AgendaList adalah task agenda di satu daftar.
+type AgendaList struct {
+	OwnerID    UserID
+	Shared     bool             // Daftar milik pengguna lain yang dibagikan kepada pengguna
+	Priorities []AgendaPriority // Prioritas terpenting lebih dulu; task tanpa prioritas terakhir
+}
+
+// AgendaPriority adalah task agenda dengan prioritas yang sama, urut tenggat.
+type AgendaPriority struct {
+	Priority string // Nilai enum EnumTaskPriority; kosong untuk task tanpa prioritas
+	Label    string
+	Tasks    []*Task
+}
+
+// ErrInvalidAgendaRange dikembalikan jika rentang agenda kosong atau lebih dari MaxAgendaDays.
+var ErrInvalidAgendaRange = errors.New("invalid agenda range")
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Workspace saat ini adalah seluruh task milik satu pengguna (belum ada workspace bersama),
+// sehingga arsip workspace diidentifikasi dengan UserID.
+
+// ArchiveStatus adalah status arsip workspace.
+type ArchiveStatus string
+
+const (
+	ArchiveStatusArchived ArchiveStatus = "archived" // Read-only, data live masih ada sampai PurgeAfter
+	ArchiveStatusPurged   ArchiveStatus = "purged"   // Data live sudah dihapus, hanya tersisa di BlobStore
+	ArchiveStatusRestored ArchiveStatus = "restored" // Sudah dipulihkan dari arsip dan bisa ditulis lagi
+)
+
+var (
+	ErrWorkspaceArchived    = errors.New("workspace is archived and read-only")
+	ErrArchiveNotFound      = errors.New("workspace archive not found")
+	ErrArchiveNotRestorable = errors.New("workspace archive cannot be restored in its current status")
+)
+
+// WorkspaceArchive adalah catatan arsip workspace milik satu pengguna.
+type WorkspaceArchive struct {
+	UserID     UserID
+	Status     ArchiveStatus
+	BlobKey    string // Lokasi ekspor di BlobStore
+	TaskCount  int64
+	ArchivedAt time.Time
+	PurgeAfter time.Time // Setelah waktu ini data live dihapus
+	PurgedAt   *time.Time
+	RestoredAt *time.Time
+}
+
+// ReadOnly bernilai true jika workspace sedang diarsipkan (archived atau purged).
+func (a *WorkspaceArchive) ReadOnly() bool {
+	return a.Status == ArchiveStatusArchived || a.Status == ArchiveStatusPurged
+}
+
+// ArchiveRepository mendefinisikan kontrak penyimpanan catatan arsip workspace.
+type ArchiveRepository interface {
+	// Save menyimpan atau mengganti catatan arsip milik archive.UserID.
+	Save(ctx context.Context, archive *WorkspaceArchive) error
+
+	// FindByUserID mengembalikan catatan arsip pengguna, atau ErrArchiveNotFound.
+	FindByUserID(ctx context.Context, userID UserID) (*WorkspaceArchive, error)
+
+	// FindDueForPurge mengembalikan paling banyak limit arsip berstatus archived dengan PurgeAfter <= now.
+	FindDueForPurge(ctx context.Context, now time.Time, limit int) ([]*WorkspaceArchive, error)
+
+	// Delete menghapus catatan arsip pengguna. Dipakai untuk membatalkan arsip yang gagal diekspor.
+	Delete(ctx context.Context, userID UserID) error
+}
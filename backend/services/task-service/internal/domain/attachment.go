@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Attachment adalah file yang dilampirkan ke task. Isi file disimpan di AttachmentStorage
+// (misalnya Supabase Storage atau S3); database hanya menyimpan metadata.
+type Attachment struct {
+	ID          string    `json:"id"`
+	TaskID      string    `json:"task_id"`
+	UserID      UserID    `json:"user_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`        // Ukuran dalam byte, dibaca dari storage saat registrasi
+	StorageKey  string    `json:"storage_key"` // Key objek di AttachmentStorage
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// PresignedRequest adalah request HTTP yang sudah ditandatangani dan bisa dikirim klien langsung
+// ke storage tanpa melewati service ini.
+type PresignedRequest struct {
+	Method    string
+	URL       string
+	Headers   map[string]string // Header yang wajib dikirim persis seperti ini
+	ExpiresAt time.Time
+}
+
+var (
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrInvalidAttachment         = errors.New("invalid attachment")
+	ErrAttachmentTooLarge        = errors.New("attachment is too large")
+	ErrAttachmentNotUploaded     = errors.New("attachment has not been uploaded")
+	ErrAttachmentStorageDisabled = errors.New("attachment storage is not configured")
+)
+
+// AttachmentStorage mendefinisikan kontrak penyimpanan objek untuk isi attachment.
+// Implementasinya ada di layer infrastructure (attachmentstore).
+type AttachmentStorage interface {
+	// PresignUpload membuat request upload untuk key. Klien wajib mengirim contentType dan size
+	// yang sama; storage yang mendukungnya menolak upload dengan ukuran berbeda.
+	PresignUpload(ctx context.Context, key, contentType string, size int64, expires time.Duration) (*PresignedRequest, error)
+
+	// PresignDownload membuat URL download sementara. fileName dipakai sebagai nama file saat diunduh.
+	PresignDownload(ctx context.Context, key, fileName string, expires time.Duration) (*PresignedRequest, error)
+
+	// Stat mengembalikan ukuran objek, atau ErrBlobNotFound jika objek belum ada.
+	Stat(ctx context.Context, key string) (size int64, err error)
+
+	// Delete menghapus objek. Menghapus objek yang tidak ada bukan error.
+	Delete(ctx context.Context, key string) error
+}
+
+// AttachmentRepository mendefinisikan kontrak penyimpanan metadata attachment.
+type AttachmentRepository interface {
+	// Save menyimpan attachment baru. Menyimpan ulang ID yang sama tidak mengubah data yang ada.
+	Save(ctx context.Context, attachment *Attachment) error
+
+	// FindByID mencari attachment berdasarkan ID. Mengembalikan ErrAttachmentNotFound jika tidak ada.
+	FindByID(ctx context.Context, id string) (*Attachment, error)
+
+	// FindByTaskID mengembalikan semua attachment milik task, diurutkan dari yang paling lama.
+	FindByTaskID(ctx context.Context, taskID string) ([]*Attachment, error)
+
+	// FindByTaskIDs sama dengan FindByTaskID untuk beberapa task sekaligus.
+	FindByTaskIDs(ctx context.Context, taskIDs []string) ([]*Attachment, error)
+
+	// RestoreAttachments menyisipkan kembali metadata attachment apa adanya dan mengembalikan jumlah
+	// yang disisipkan. ID atau StorageKey yang sudah ada dilewati, begitu juga attachment yang
+	// task-nya bukan milik ownerID atau pengunggahnya bukan pemilik maupun kolaborator daftar.
+	RestoreAttachments(ctx context.Context, ownerID UserID, attachments []*Attachment) (int64, error)
+
+	// Delete menghapus metadata attachment. Mengembalikan ErrAttachmentNotFound jika tidak ada.
+	Delete(ctx context.Context, id string) error
+}
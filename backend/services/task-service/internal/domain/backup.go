@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+// ErrInvalidBackup dikembalikan jika dokumen backup akun tidak bisa dipulihkan: format atau versinya
+// tidak dikenal, milik akun lain, atau isinya tidak valid.
+var ErrInvalidBackup = errors.New("invalid account backup")
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrBatchItemNotApplied menandai item batch yang valid tetapi tidak diterapkan karena
+// operasi atomik dibatalkan oleh kegagalan item lain.
+var ErrBatchItemNotApplied = errors.New("not applied because another item in the batch failed")
+
+// ErrInvalidImportRow menandai baris file impor yang nilainya tidak bisa dibaca, misalnya angka
+// estimasi yang bukan angka.
+var ErrInvalidImportRow = errors.New("invalid import row")
+
+// TasksNotFoundError dikembalikan operasi atomik atas banyak task jika sebagian ID tidak ditemukan
+// atau milik pengguna lain. errors.Is(err, ErrTaskNotFound) tetap bernilai true.
+type TasksNotFoundError struct {
+	IDs []string
+}
+
+func (e *TasksNotFoundError) Error() string {
+	return fmt.Sprintf("%d tasks not found", len(e.IDs))
+}
+
+func (e *TasksNotFoundError) Unwrap() error {
+	return ErrTaskNotFound
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound dikembalikan BlobStore jika key tidak ada.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore mendefinisikan kontrak penyimpanan objek biner (cold storage), misalnya
+// filesystem lokal, Supabase Storage, atau S3.
+type BlobStore interface {
+	// Put menyimpan isi r pada key, menimpa objek lama jika ada.
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Get membuka objek pada key. Pemanggil wajib menutup reader yang dikembalikan.
+	// Mengembalikan ErrBlobNotFound jika key tidak ada.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete menghapus objek pada key. Tidak error jika key tidak ada.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// MaxBoardColumns adalah jumlah kolom board maksimum per pengguna.
+const MaxBoardColumns = 20
+
+// BoardColumn adalah satu kolom status pada board Kanban pengguna.
+type BoardColumn struct {
+	ID        string
+	UserID    UserID
+	Name      string
+	Position  int  // Urutan kolom dari kiri, dimulai dari 0
+	WIPLimit  *int // Jumlah task maksimum di kolom; nil berarti tanpa batas
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Counters adalah jumlah task yang belum diarsipkan di kolom, dari counter cache yang
+	// diperbarui dalam transaksi yang sama dengan write task. Hanya diisi saat kolom dibaca.
+	Counters TaskCounters
+}
+
+// Board adalah kolom board pengguna beserta task di setiap kolom.
+type Board struct {
+	Columns    []BoardColumnTasks
+	Unassigned []*Task // Task yang belum ditempatkan di kolom mana pun
+}
+
+// BoardColumnTasks adalah satu kolom beserta task di dalamnya, sesuai urutan Position task.
+type BoardColumnTasks struct {
+	Column *BoardColumn
+	Tasks  []*Task
+}
+
+var (
+	ErrBoardColumnNotFound = errors.New("board column not found")
+	ErrInvalidBoardColumn  = errors.New("invalid board column")
+	ErrWIPLimitReached     = errors.New("board column WIP limit reached")
+)
+
+// BoardRepository mendefinisikan kontrak penyimpanan kolom board dan penempatan task di kolom.
+type BoardRepository interface {
+	// FindColumns mengembalikan kolom milik pengguna sesuai urutan Position.
+	FindColumns(ctx context.Context, userID UserID) ([]*BoardColumn, error)
+
+	// SaveColumn menyimpan kolom baru di posisi paling kanan dan mengisi Position.
+	SaveColumn(ctx context.Context, column *BoardColumn) error
+
+	// RestoreColumns menyisipkan kembali kolom milik userID dengan ID dan timestamp aslinya, sesuai
+	// urutan columns di sebelah kanan kolom yang ada, lalu mengembalikan jumlah yang disisipkan. ID
+	// yang sudah ada dilewati, begitu juga kolom yang melebihi MaxBoardColumns.
+	RestoreColumns(ctx context.Context, userID UserID, columns []*BoardColumn) (int64, error)
+
+	// UpdateColumn memperbarui Name, WIPLimit, dan UpdatedAt.
+	// Mengembalikan ErrBoardColumnNotFound jika kolom tidak ada atau milik pengguna lain.
+	UpdateColumn(ctx context.Context, column *BoardColumn) error
+
+	// DeleteColumn menghapus kolom dan mengeluarkan task di dalamnya dari kolom, lalu
+	// mengembalikan task yang dikeluarkan. Mengembalikan ErrBoardColumnNotFound jika tidak ada.
+	DeleteColumn(ctx context.Context, userID UserID, columnID string, now time.Time) ([]*Task, error)
+
+	// ReorderColumns mengatur Position kolom mengikuti urutan ids, yang harus berisi tepat semua
+	// kolom milik pengguna. Mengembalikan ErrInvalidBoardColumn jika tidak.
+	ReorderColumns(ctx context.Context, userID UserID, ids []string, now time.Time) ([]*BoardColumn, error)
+
+	// MoveTask menempatkan task di kolom columnID (kosong berarti keluar dari kolom). Batas WIP
+	// kolom tujuan diperiksa dalam transaksi yang sama dengan perpindahan, dengan menghitung task
+	// yang belum diarsipkan. Mengembalikan ErrWIPLimitReached jika kolom sudah penuh,
+	// ErrBoardColumnNotFound jika kolom tidak ada, atau ErrTaskNotFound jika task tidak ada.
+	MoveTask(ctx context.Context, userID UserID, taskID, columnID string, now time.Time) (*Task, error)
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInvalidCalDAVToken dikembalikan jika password CalDAV tidak dikenal atau sudah dicabut.
+var ErrInvalidCalDAVToken = errors.New("invalid CalDAV token")
+
+// CalDAVTokenRepository mendefinisikan kontrak penyimpanan token CalDAV, yaitu password aplikasi
+// yang dipakai klien native (Apple Reminders, Thunderbird) lewat HTTP Basic. Setiap pengguna punya
+// paling banyak satu token dan hanya hash-nya yang disimpan.
+type CalDAVTokenRepository interface {
+	// FindUser mengembalikan ErrInvalidCalDAVToken jika tidak ada token dengan hash tersebut.
+	FindUser(ctx context.Context, tokenHash string) (UserID, error)
+
+	// Replace menyimpan token baru untuk pengguna, menggantikan token sebelumnya.
+	Replace(ctx context.Context, userID UserID, tokenHash string, createdAt time.Time) error
+
+	// Delete mencabut token pengguna. Tidak error jika pengguna tidak punya token.
+	Delete(ctx context.Context, userID UserID) error
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInvalidCalendarFeedToken dikembalikan jika token feed kalender tidak dikenal atau sudah dicabut.
+var ErrInvalidCalendarFeedToken = errors.New("invalid calendar feed token")
+
+// CalendarFeedTokenRepository mendefinisikan kontrak penyimpanan token feed kalender (.ics). Setiap
+// pengguna punya paling banyak satu token dan hanya hash-nya yang disimpan.
+type CalendarFeedTokenRepository interface {
+	// FindUser mengembalikan ErrInvalidCalendarFeedToken jika tidak ada token dengan hash tersebut.
+	FindUser(ctx context.Context, tokenHash string) (UserID, error)
+
+	// Replace menyimpan token baru untuk pengguna, menggantikan token sebelumnya.
+	Replace(ctx context.Context, userID UserID, tokenHash string, createdAt time.Time) error
+
+	// Delete mencabut token pengguna. Tidak error jika pengguna tidak punya token.
+	Delete(ctx context.Context, userID UserID) error
+}
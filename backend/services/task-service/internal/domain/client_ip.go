@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+type clientIPContextKey struct{}
+
+// ContextWithClientIP menyimpan alamat IP klien request (setelah melewati proxy tepercaya) ke
+// context.
+func ContextWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPContextKey{}, ip)
+}
+
+// ClientIPFromContext mengambil alamat IP klien dari context, atau string kosong jika tidak ada.
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPContextKey{}).(string)
+	return ip
+}
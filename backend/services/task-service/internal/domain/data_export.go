@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DataExportStatus adalah status ekspor data pribadi pengguna.
+type DataExportStatus string
+
+const (
+	DataExportPending   DataExportStatus = "pending"   // Menunggu diproses job terjadwal
+	DataExportRunning   DataExportStatus = "running"   // Sedang disusun
+	DataExportCompleted DataExportStatus = "completed" // Arsip siap diunduh sampai ExpiresAt
+	DataExportFailed    DataExportStatus = "failed"
+)
+
+// DataExport adalah permintaan ekspor seluruh data yang disimpan tentang pengguna (hak akses data
+// GDPR). Arsip ZIP disusun secara asinkron dan disimpan di BlobStore.
+type DataExport struct {
+	ID          string
+	UserID      UserID
+	Status      DataExportStatus
+	BlobKey     string // Diisi saat completed
+	SizeBytes   int64
+	Error       string // Pesan singkat saat failed, tanpa detail internal
+	RequestedAt time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time // Setelah waktu ini arsip dihapus
+}
+
+// Active melaporkan apakah ekspor belum selesai diproses.
+func (e *DataExport) Active() bool {
+	return e.Status == DataExportPending || e.Status == DataExportRunning
+}
+
+var (
+	ErrDataExportNotFound   = errors.New("data export not found")
+	ErrDataExportInProgress = errors.New("a data export is already in progress")
+	ErrDataExportNotReady   = errors.New("data export is not ready for download")
+)
+
+// DataExportRepository mendefinisikan kontrak penyimpanan permintaan ekspor data.
+type DataExportRepository interface {
+	// Create menyimpan permintaan baru. Mengembalikan ErrDataExportInProgress jika pengguna masih
+	// memiliki ekspor pending atau running.
+	Create(ctx context.Context, export *DataExport) error
+
+	// FindByID mengembalikan ErrDataExportNotFound jika ekspor tidak ada atau milik pengguna lain.
+	FindByID(ctx context.Context, userID UserID, id string) (*DataExport, error)
+
+	// ClaimPending mengubah paling banyak limit ekspor pending (yang paling lama) menjadi running
+	// dan mengembalikannya. Ekspor running yang StartedAt-nya sebelum staleBefore, misalnya karena
+	// replika mati di tengah proses, diklaim ulang.
+	ClaimPending(ctx context.Context, now, staleBefore time.Time, limit int) ([]*DataExport, error)
+
+	// Update menyimpan status, hasil, dan waktu ekspor.
+	Update(ctx context.Context, export *DataExport) error
+
+	// FindExpired mengembalikan paling banyak limit ekspor completed dengan ExpiresAt <= now.
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]*DataExport, error)
+
+	// Delete menghapus catatan ekspor.
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+// ErrDependencyUnavailable dikembalikan tanpa memanggil dependensi (database atau server SMTP)
+// yang sedang gagal beruntun, agar request ditolak cepat alih-alih menunggu timeout.
+var ErrDependencyUnavailable = errors.New("dependency unavailable")
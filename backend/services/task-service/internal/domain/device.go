@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"time"
+)
+
+// MaxDevicesPerUser adalah jumlah perangkat aktif (belum dicabut) maksimum per pengguna.
+const MaxDevicesPerUser = 50
+
+// MaxSyncIssues adalah jumlah SyncIssue maksimum yang disimpan dari satu push.
+const MaxSyncIssues = 20
+
+// deviceIDPattern membatasi ID perangkat yang dibuat klien agar aman dipakai di URL dan log.
+var deviceIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// ValidateDeviceID memastikan ID perangkat dari klien (header X-Device-ID) berformat benar.
+func ValidateDeviceID(id string) error {
+	if !deviceIDPattern.MatchString(id) {
+		return ErrInvalidDevice
+	}
+	return nil
+}
+
+// SyncIssue adalah satu item push yang gagal atau menimpa perubahan yang belum di-pull perangkat.
+type SyncIssue struct {
+	TaskID  string `json:"task_id"`
+	Problem string `json:"problem"`
+}
+
+// Problem untuk SyncIssue yang tidak berasal dari error.
+const SyncIssueStaleWrite = "task changed since the device last pulled; overwritten by push"
+
+// DeviceSyncState adalah diagnostik sinkronisasi terakhir satu perangkat, untuk menelusuri
+// masalah multi-perangkat (misalnya perangkat yang jarang pull lalu menimpa perubahan lain).
+type DeviceSyncState struct {
+	LastPullAt     *time.Time
+	LastCursor     *int64 // Cursor terakhir yang dikembalikan ke perangkat (lihat TaskChanges)
+	LastPushAt     *time.Time
+	LastPushItems  int
+	LastPushFailed int
+	LastPushStale  int         // Item yang menimpa task yang berubah setelah LastCursor
+	LastPushIssues []SyncIssue // Paling banyak MaxSyncIssues
+}
+
+// Device adalah perangkat klien yang terdaftar milik seorang pengguna. ID dibuat oleh klien.
+type Device struct {
+	ID       string
+	UserID   UserID
+	Name     string
+	Platform string // Misalnya ios, android, web
+	// PushSubscription adalah langganan push dari klien. Yang didaftarkan lewat endpoint push sudah
+	// divalidasi dengan ParsePushSubscription; dari profil perangkat masih opaque.
+	PushSubscription json.RawMessage
+	Sync             DeviceSyncState
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	RevokedAt        *time.Time // Perangkat yang dicabut ditolak saat sync
+}
+
+var (
+	ErrDeviceNotFound = errors.New("device not found")
+	ErrDeviceRevoked  = errors.New("device has been revoked")
+	ErrInvalidDevice  = errors.New("invalid device")
+	ErrTooManyDevices = errors.New("too many devices")
+)
+
+// DeviceRepository mendefinisikan kontrak penyimpanan perangkat pengguna.
+type DeviceRepository interface {
+	// FindByID mengembalikan ErrDeviceNotFound jika perangkat tidak ada, termasuk milik pengguna lain.
+	FindByID(ctx context.Context, userID UserID, id string) (*Device, error)
+
+	// FindByUserID mengembalikan semua perangkat pengguna, termasuk yang dicabut, dari yang terbaru.
+	FindByUserID(ctx context.Context, userID UserID) ([]*Device, error)
+
+	// CountActive menghitung perangkat pengguna yang belum dicabut.
+	CountActive(ctx context.Context, userID UserID) (int, error)
+
+	// Save membuat perangkat atau memperbarui nama, platform, dan langganan push-nya.
+	// Mengembalikan ErrDeviceRevoked jika perangkat sudah dicabut.
+	Save(ctx context.Context, device *Device) error
+
+	// SaveSyncState menyimpan device.Sync. Perangkat yang sudah dicabut tidak diubah.
+	SaveSyncState(ctx context.Context, device *Device) error
+
+	// SavePushSubscription mengganti langganan push perangkat tanpa mengubah profilnya; nil
+	// menghapusnya. Mengembalikan ErrDeviceNotFound jika perangkat tidak ada atau sudah dicabut.
+	SavePushSubscription(ctx context.Context, userID UserID, id string, subscription json.RawMessage, at time.Time) error
+
+	// RemovePushSubscription menghapus langganan push perangkat hanya jika isinya masih subscription,
+	// sehingga langganan yang baru didaftarkan tidak ikut terhapus.
+	RemovePushSubscription(ctx context.Context, userID UserID, id string, subscription json.RawMessage) error
+
+	// FindPushTargets mengembalikan perangkat yang belum dicabut milik userIDs beserta langganan
+	// push-nya. Langganan yang tidak bisa dibaca ParsePushSubscription dilewati.
+	FindPushTargets(ctx context.Context, userIDs []UserID) ([]PushTarget, error)
+
+	// Revoke mencabut perangkat dan menghapus langganan push-nya. Mengembalikan ErrDeviceNotFound
+	// jika perangkat tidak ada atau sudah dicabut.
+	Revoke(ctx context.Context, userID UserID, id string, at time.Time) error
+}
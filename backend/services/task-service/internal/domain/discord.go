@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// DiscordChannel adalah pengaturan notifikasi Discord milik satu pengguna. Notifikasi dikirim
+// lewat incoming webhook Discord (WebhookURL) atau oleh bot service ke channel (ChannelID).
+type DiscordChannel struct {
+	UserID UserID
+
+	// ListOwnerID diisi untuk pengaturan daftar bersama: event task milik ListOwnerID dikirim ke
+	// tujuan yang diatur kolaborator UserID. Kosong untuk pengaturan task pengguna sendiri.
+	ListOwnerID UserID
+
+	WebhookURL string          // https://discord.com/api/webhooks/...; kosong jika memakai bot
+	ChannelID  string          // Snowflake channel Discord untuk mode bot; kosong jika memakai webhook
+	EventTypes []TaskEventType // Jenis event yang dikirim; kosong berarti semua jenis
+
+	// DiscordUserID adalah akun Discord yang terhubung lewat kode link, dipakai untuk slash command.
+	DiscordUserID string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Enabled bernilai true jika channel punya tujuan notifikasi.
+func (c *DiscordChannel) Enabled() bool {
+	return c.WebhookURL != "" || c.ChannelID != ""
+}
+
+// Accepts bernilai true jika channel berlangganan jenis event eventType.
+func (c *DiscordChannel) Accepts(eventType TaskEventType) bool {
+	return len(c.EventTypes) == 0 || slices.Contains(c.EventTypes, eventType)
+}
+
+var (
+	ErrDiscordChannelNotFound    = errors.New("discord channel not found")
+	ErrInvalidDiscordChannel     = errors.New("invalid discord channel")
+	ErrDiscordLinkCodeInvalid    = errors.New("discord link code is invalid or expired")
+	ErrDiscordAccountNotLinked   = errors.New("discord account is not linked")
+	ErrDiscordIntegrationMissing = errors.New("discord integration is not configured")
+)
+
+// DiscordMessage adalah pesan Discord dengan embed, dalam format JSON Discord API.
+type DiscordMessage struct {
+	Content string         `json:"content,omitempty"`
+	Embeds  []DiscordEmbed `json:"embeds,omitempty"`
+}
+
+// DiscordEmbed adalah rich embed Discord.
+type DiscordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color,omitempty"` // RGB, misalnya 0x57F287
+	Fields      []DiscordEmbedField `json:"fields,omitempty"`
+	Timestamp   *time.Time          `json:"timestamp,omitempty"`
+}
+
+// DiscordEmbedField adalah satu field pada DiscordEmbed.
+type DiscordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// DiscordClient mengirim DiscordMessage ke Discord. Layer infrastructure mengimplementasikan
+// interface ini dan wajib mematikan mention (@everyone, role, user) karena isi pesan berasal dari task.
+type DiscordClient interface {
+	SendWebhook(ctx context.Context, webhookURL string, msg DiscordMessage) error
+
+	// SendChannelMessage mengirim pesan sebagai bot. Mengembalikan ErrDiscordIntegrationMissing
+	// jika bot token tidak dikonfigurasi.
+	SendChannelMessage(ctx context.Context, channelID string, msg DiscordMessage) error
+}
+
+// DiscordChannelRepository mendefinisikan kontrak penyimpanan pengaturan Discord pengguna.
+type DiscordChannelRepository interface {
+	// FindByUserID mengembalikan ErrDiscordChannelNotFound jika pengguna belum punya pengaturan.
+	FindByUserID(ctx context.Context, userID UserID) (*DiscordChannel, error)
+
+	// FindByDiscordUserID mencari pengaturan berdasarkan akun Discord yang terhubung.
+	FindByDiscordUserID(ctx context.Context, discordUserID string) (*DiscordChannel, error)
+
+	// Save membuat atau mengganti tujuan notifikasi dan EventTypes. Akun Discord yang terhubung
+	// tidak berubah.
+	Save(ctx context.Context, channel *DiscordChannel) error
+
+	// Delete menghapus pengaturan dan akun Discord yang terhubung.
+	Delete(ctx context.Context, userID UserID) error
+
+	// SetLinkCode menyimpan hash kode link baru untuk pengguna, menggantikan kode sebelumnya.
+	SetLinkCode(ctx context.Context, userID UserID, codeHash string, expiresAt time.Time) error
+
+	// Link menghubungkan discordUserID ke pemilik kode yang belum kedaluwarsa pada now, lalu
+	// menghapus kode tersebut. Akun Discord yang sama dilepas dari pengguna lain.
+	// Mengembalikan ErrDiscordLinkCodeInvalid jika kode tidak ditemukan atau kedaluwarsa.
+	Link(ctx context.Context, codeHash, discordUserID string, now time.Time) (UserID, error)
+
+	// FindListChannel mengembalikan pengaturan userID untuk daftar bersama ownerID, atau
+	// ErrDiscordChannelNotFound.
+	FindListChannel(ctx context.Context, ownerID, userID UserID) (*DiscordChannel, error)
+
+	// FindListChannels mengembalikan pengaturan semua kolaborator daftar ownerID yang aksesnya masih
+	// berlaku.
+	FindListChannels(ctx context.Context, ownerID UserID) ([]*DiscordChannel, error)
+
+	// SaveListChannel membuat atau mengganti pengaturan daftar bersama channel.ListOwnerID milik
+	// channel.UserID.
+	SaveListChannel(ctx context.Context, channel *DiscordChannel) error
+
+	// DeleteListChannel mengembalikan ErrDiscordChannelNotFound jika pengaturan tidak ada.
+	DeleteListChannel(ctx context.Context, ownerID, userID UserID) error
+}
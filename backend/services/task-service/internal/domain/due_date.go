@@ -0,0 +1,333 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxDueTextLength adalah panjang maksimum due_text dalam karakter.
+const MaxDueTextLength = 100
+
+// Jam untuk due_text tanpa jam: akhir hari, sehingga "tomorrow" berarti sebelum besok berakhir.
+const (
+	defaultDueHour   = 23
+	defaultDueMinute = 59
+)
+
+var ErrInvalidDueText = errors.New(`due_text is not a recognized date, e.g. "tomorrow 5pm", "next friday", or "in 2 hours"`)
+
+var (
+	dueClockPattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+	dueDayPattern   = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)?$`)
+	dueYearPattern  = regexp.MustCompile(`^\d{4}$`)
+)
+
+// dueFillerWords diabaikan saat parsing ("at 5pm", "on friday", "by the 20th").
+var dueFillerWords = map[string]bool{"at": true, "on": true, "by": true, "the": true, "of": true}
+
+var dueWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var dueMonths = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// dueNamedClocks adalah kata yang berarti jam tertentu.
+var dueNamedClocks = map[string][2]int{
+	"noon": {12, 0}, "midday": {12, 0},
+	"morning": {9, 0}, "afternoon": {15, 0}, "evening": {18, 0}, "night": {20, 0},
+}
+
+// dueUnits adalah satuan untuk "in N <unit>". Satuan dengan durasi nol dihitung dengan kalender.
+var dueUnits = map[string]time.Duration{
+	"minute": time.Minute, "minutes": time.Minute, "min": time.Minute, "mins": time.Minute,
+	"hour": time.Hour, "hours": time.Hour, "hr": time.Hour, "hrs": time.Hour,
+	"day": 0, "days": 0, "week": 0, "weeks": 0, "month": 0, "months": 0,
+}
+
+// ParseDueText mengubah teks tenggat dalam bahasa Inggris sehari-hari menjadi waktu di zona waktu
+// now. Bentuk yang dikenali:
+//
+//   - hari: "today", "tonight", "tomorrow", "friday"/"this friday" (hari tersebut yang terdekat,
+//     termasuk hari ini), "next friday" (hari tersebut di minggu depan), "next week" (Senin depan),
+//     "next month" (tanggal 1 bulan depan);
+//   - tanggal: "2026-10-20", "oct 20", "20 october 2026"; tanggal tanpa tahun yang sudah lewat
+//     berarti tahun depan;
+//   - jam: "5pm", "5:30 pm", "17:00", "noon", "evening"; jam saja berarti hari ini, atau besok
+//     jika sudah lewat;
+//   - relatif: "in 30 minutes", "in an hour", "in 3 days", "in 2 weeks".
+//
+// Hari tanpa jam berarti akhir hari tersebut. Mengembalikan ErrInvalidDueText untuk teks lain.
+func ParseDueText(text string, now time.Time) (time.Time, error) {
+	if utf8.RuneCountInString(text) > MaxDueTextLength {
+		return time.Time{}, ErrInvalidDueText
+	}
+	tokens := dueTextTokens(text)
+	if len(tokens) == 0 {
+		return time.Time{}, ErrInvalidDueText
+	}
+
+	p := &dueTextParser{now: now, today: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())}
+	for i := 0; i < len(tokens); {
+		consumed, ok := p.parse(tokens[i:])
+		if !ok {
+			return time.Time{}, ErrInvalidDueText
+		}
+		i += consumed
+	}
+	return p.result()
+}
+
+// dueTextTokens memecah teks menjadi kata huruf kecil tanpa kata pengisi, dan menggabungkan
+// "5 pm" menjadi "5pm".
+func dueTextTokens(text string) []string {
+	fields := strings.Fields(strings.ToLower(strings.ReplaceAll(text, ",", " ")))
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field == "am" || field == "pm" {
+			if n := len(tokens); n > 0 && dueClockPattern.MatchString(tokens[n-1]+field) {
+				tokens[n-1] += field
+				continue
+			}
+		}
+		if !dueFillerWords[field] {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// dueTextParser mengumpulkan bagian tanggal dan jam dari token due_text.
+type dueTextParser struct {
+	now   time.Time
+	today time.Time // Tengah malam hari ini di zona waktu now
+
+	date         *time.Time // Tengah malam hari yang dipilih
+	yearExplicit bool
+	clock        *[2]int    // Jam dan menit yang disebut eksplisit
+	impliedClock *[2]int    // Jam bawaan kata seperti "tonight"; kalah dari clock
+	exact        *time.Time // Hasil "in N minutes/hours", tidak boleh digabung dengan bagian lain
+}
+
+// parse membaca satu bagian dari awal tokens dan mengembalikan jumlah token yang dipakai.
+func (p *dueTextParser) parse(tokens []string) (int, bool) {
+	token := tokens[0]
+	switch token {
+	case "today":
+		return 1, p.setDate(p.today)
+	case "tonight":
+		p.impliedClock = &[2]int{20, 0}
+		return 1, p.setDate(p.today)
+	case "tomorrow", "tmr", "tmrw":
+		return 1, p.setDate(p.today.AddDate(0, 0, 1))
+	case "midnight":
+		// Tengah malam di akhir hari yang disebut, bukan di awalnya.
+		return 1, p.setClock(23, 59)
+	case "this":
+		if len(tokens) > 1 {
+			if weekday, ok := dueWeekdays[tokens[1]]; ok {
+				return 2, p.setDate(p.upcoming(weekday))
+			}
+		}
+		return 0, false
+	case "next":
+		if len(tokens) < 2 {
+			return 0, false
+		}
+		if weekday, ok := dueWeekdays[tokens[1]]; ok {
+			return 2, p.setDate(p.nextWeekMonday().AddDate(0, 0, (int(weekday)+6)%7))
+		}
+		switch tokens[1] {
+		case "week":
+			return 2, p.setDate(p.nextWeekMonday())
+		case "month":
+			return 2, p.setDate(time.Date(p.today.Year(), p.today.Month()+1, 1, 0, 0, 0, 0, p.today.Location()))
+		}
+		return 0, false
+	case "in":
+		return p.parseRelative(tokens)
+	}
+
+	if weekday, ok := dueWeekdays[token]; ok {
+		return 1, p.setDate(p.upcoming(weekday))
+	}
+	if clock, ok := dueNamedClocks[token]; ok {
+		return 1, p.setClock(clock[0], clock[1])
+	}
+	if date, err := time.ParseInLocation(time.DateOnly, token, p.today.Location()); err == nil {
+		p.yearExplicit = true
+		return 1, p.setDate(date)
+	}
+	if consumed, ok := p.parseMonthDay(tokens); consumed > 0 {
+		return consumed, ok
+	}
+	if match := dueClockPattern.FindStringSubmatch(token); match != nil && (match[2] != "" || match[3] != "") {
+		hour, _ := strconv.Atoi(match[1])
+		minute, _ := strconv.Atoi(match[2])
+		switch match[3] {
+		case "am", "pm":
+			if hour < 1 || hour > 12 {
+				return 0, false
+			}
+			hour %= 12
+			if match[3] == "pm" {
+				hour += 12
+			}
+		default:
+			if hour > 23 {
+				return 0, false
+			}
+		}
+		if minute > 59 {
+			return 0, false
+		}
+		return 1, p.setClock(hour, minute)
+	}
+	return 0, false
+}
+
+// parseRelative membaca "in N <unit>" atau "in a/an <unit>".
+func (p *dueTextParser) parseRelative(tokens []string) (int, bool) {
+	if len(tokens) < 3 {
+		return 0, false
+	}
+	count := 1
+	if tokens[1] != "a" && tokens[1] != "an" {
+		n, err := strconv.Atoi(tokens[1])
+		if err != nil || n < 1 || n > 1000 {
+			return 0, false
+		}
+		count = n
+	}
+	unit, ok := dueUnits[tokens[2]]
+	if !ok {
+		return 0, false
+	}
+	if unit > 0 {
+		if p.exact != nil {
+			return 0, false
+		}
+		exact := p.now.Add(time.Duration(count) * unit)
+		p.exact = &exact
+		return 3, true
+	}
+	switch strings.TrimSuffix(tokens[2], "s") {
+	case "day":
+		return 3, p.setDate(p.today.AddDate(0, 0, count))
+	case "week":
+		return 3, p.setDate(p.today.AddDate(0, 0, 7*count))
+	default:
+		return 3, p.setDate(p.today.AddDate(0, count, 0))
+	}
+}
+
+// parseMonthDay membaca "oct 20", "october 20th 2026", atau "20 oct 2026". Mengembalikan 0 jika
+// tokens tidak diawali bentuk tersebut.
+func (p *dueTextParser) parseMonthDay(tokens []string) (int, bool) {
+	if len(tokens) < 2 {
+		return 0, false
+	}
+	month, dayToken := time.Month(0), ""
+	if m, ok := dueMonths[tokens[0]]; ok && dueDayPattern.MatchString(tokens[1]) {
+		month, dayToken = m, tokens[1]
+	} else if m, ok := dueMonths[tokens[1]]; ok && dueDayPattern.MatchString(tokens[0]) {
+		month, dayToken = m, tokens[0]
+	} else {
+		return 0, false
+	}
+	day, _ := strconv.Atoi(dueDayPattern.FindStringSubmatch(dayToken)[1])
+
+	consumed, year := 2, p.today.Year()
+	if len(tokens) > 2 && dueYearPattern.MatchString(tokens[2]) {
+		year, _ = strconv.Atoi(tokens[2])
+		consumed = 3
+		p.yearExplicit = true
+	}
+	date := time.Date(year, month, day, 0, 0, 0, 0, p.today.Location())
+	if date.Day() != day { // Misalnya "feb 30"
+		return consumed, false
+	}
+	if !p.yearExplicit && date.Before(p.today) {
+		date = date.AddDate(1, 0, 0)
+	}
+	return consumed, p.setDate(date)
+}
+
+// upcoming mengembalikan hari weekday terdekat mulai hari ini.
+func (p *dueTextParser) upcoming(weekday time.Weekday) time.Time {
+	return p.today.AddDate(0, 0, (int(weekday)-int(p.today.Weekday())+7)%7)
+}
+
+// nextWeekMonday mengembalikan Senin minggu depan; minggu dimulai hari Senin.
+func (p *dueTextParser) nextWeekMonday() time.Time {
+	return p.today.AddDate(0, 0, 7-(int(p.today.Weekday())+6)%7)
+}
+
+// setDate gagal jika tanggal sudah disebut sebelumnya, misalnya "tomorrow friday".
+func (p *dueTextParser) setDate(date time.Time) bool {
+	if p.date != nil {
+		return false
+	}
+	p.date = &date
+	return true
+}
+
+// setClock gagal jika jam sudah disebut sebelumnya.
+func (p *dueTextParser) setClock(hour, minute int) bool {
+	if p.clock != nil {
+		return false
+	}
+	p.clock = &[2]int{hour, minute}
+	return true
+}
+
+// result menggabungkan bagian yang dikumpulkan menjadi satu waktu.
+func (p *dueTextParser) result() (time.Time, error) {
+	if p.exact != nil {
+		if p.date != nil || p.clock != nil {
+			return time.Time{}, ErrInvalidDueText
+		}
+		return *p.exact, nil
+	}
+	if p.date == nil && p.clock == nil {
+		return time.Time{}, ErrInvalidDueText
+	}
+
+	clock := [2]int{defaultDueHour, defaultDueMinute}
+	if p.clock != nil {
+		clock = *p.clock
+	} else if p.impliedClock != nil {
+		clock = *p.impliedClock
+	}
+	date, rollForward := p.today, true
+	if p.date != nil {
+		date, rollForward = *p.date, false
+	}
+	due := time.Date(date.Year(), date.Month(), date.Day(), clock[0], clock[1], 0, 0, date.Location())
+	if rollForward && !due.After(p.now) {
+		due = due.AddDate(0, 0, 1)
+	}
+	return due, nil
+}
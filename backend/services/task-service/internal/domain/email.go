@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// MaxEmailReminderLead adalah jarak terjauh pengingat email sebelum tenggat task.
+const MaxEmailReminderLead = 7 * 24 * time.Hour
+
+// EmailChannel adalah pengaturan notifikasi email milik satu pengguna. Address selalu alamat email
+// akun dari token Supabase Auth, sehingga email tidak bisa dikirim ke alamat orang lain.
+type EmailChannel struct {
+	UserID       UserID
+	Address      string
+	EventTypes   []TaskEventType // Event task yang dikirim sebagai email; kosong berarti tidak ada
+	ReminderLead time.Duration   // Pengingat dikirim selama ini sebelum tenggat; 0 berarti tanpa pengingat
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Accepts bernilai true jika channel berlangganan jenis event eventType. Berbeda dengan channel
+// chat, daftar kosong berarti tidak ada event, karena email untuk setiap perubahan task terlalu ramai.
+func (c *EmailChannel) Accepts(eventType TaskEventType) bool {
+	return slices.Contains(c.EventTypes, eventType)
+}
+
+// EmailReminder adalah task yang tenggatnya sudah dekat beserta channel email pemiliknya.
+type EmailReminder struct {
+	Channel *EmailChannel
+	Task    *Task
+}
+
+var (
+	ErrEmailChannelNotFound = errors.New("email channel not found")
+	ErrInvalidEmailChannel  = errors.New("invalid email channel")
+	ErrEmailNotConfigured   = errors.New("email delivery is not configured")
+	ErrEmailDeliveryFailed  = errors.New("email delivery failed")
+)
+
+// EmailMessage adalah satu email dengan isi teks dan HTML (multipart/alternative).
+type EmailMessage struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// EmailSender mengirim email. Implementasinya ada di layer infrastructure (smtp); jika pengiriman
+// tidak dikonfigurasi, Send mengembalikan ErrEmailNotConfigured.
+type EmailSender interface {
+	Send(ctx context.Context, msg EmailMessage) error
+}
+
+// EmailChannelRepository mendefinisikan kontrak penyimpanan pengaturan email dan pengingat yang
+// sudah dikirim.
+type EmailChannelRepository interface {
+	// FindByUserID mengembalikan ErrEmailChannelNotFound jika pengguna belum punya pengaturan.
+	FindByUserID(ctx context.Context, userID UserID) (*EmailChannel, error)
+
+	// Save membuat atau mengganti pengaturan email pengguna.
+	Save(ctx context.Context, channel *EmailChannel) error
+
+	// Delete menghapus pengaturan. Mengembalikan ErrEmailChannelNotFound jika tidak ada.
+	Delete(ctx context.Context, userID UserID) error
+
+	// FindDueReminders mengembalikan paling banyak limit task yang belum selesai, belum diarsipkan,
+	// dan tidak di-snooze pada now, dengan tenggat setelah now dan paling lambat ReminderLead
+	// channel pemiliknya, yang pengingat untuk tenggat tersebut belum diklaim. Urut tenggat.
+	FindDueReminders(ctx context.Context, now time.Time, limit int) ([]EmailReminder, error)
+
+	// ClaimReminder mencatat pengingat untuk (taskID, dueAt) dan bernilai false jika sudah dicatat,
+	// sehingga setiap pengingat hanya dikirim oleh satu replika.
+	ClaimReminder(ctx context.Context, taskID string, dueAt, now time.Time) (bool, error)
+
+	// ReleaseReminder menghapus klaim agar pengingat dicoba lagi, misalnya setelah pengiriman gagal.
+	ReleaseReminder(ctx context.Context, taskID string, dueAt time.Time) error
+}
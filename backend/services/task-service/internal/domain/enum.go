@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+)
+
+// EnumName adalah nama enum yang nilainya disimpan di tabel lookup, bukan CHECK constraint,
+// sehingga nilai baru bisa ditambahkan saat runtime tanpa migrasi skema.
+type EnumName string
+
+const (
+	EnumTaskPriority EnumName = "task_priority"
+)
+
+// KnownEnums adalah enum yang dikenal aplikasi, sesuai urutan pada endpoint discovery.
+var KnownEnums = []EnumName{EnumTaskPriority}
+
+// Validate memastikan EnumName dikenal.
+func (n EnumName) Validate() error {
+	for _, known := range KnownEnums {
+		if n == known {
+			return nil
+		}
+	}
+	return ErrEnumNotFound
+}
+
+// enumValuePattern membatasi nilai enum ke identifier yang stabil dipakai klien dan di URL.
+var enumValuePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// ValidateEnumValueName memastikan format nilai enum benar; tidak memeriksa apakah nilainya terdaftar.
+func ValidateEnumValueName(value string) error {
+	if !enumValuePattern.MatchString(value) {
+		return ErrInvalidEnumValue
+	}
+	return nil
+}
+
+// EnumValue adalah satu nilai enum. Nilai Builtin berlaku untuk semua workspace; nilai lain hanya
+// untuk workspace yang menambahkannya. Nilai tidak pernah dihapus karena mungkin masih dipakai
+// data lama: nilai Deprecated tetap terbaca tetapi tidak bisa dipilih untuk data baru.
+type EnumValue struct {
+	Enum       EnumName
+	Value      string
+	Label      string
+	Position   int // Urutan tampilan; untuk prioritas, lebih besar berarti lebih penting
+	Builtin    bool
+	Deprecated bool
+	CreatedAt  time.Time
+}
+
+var (
+	ErrEnumNotFound      = errors.New("enum not found")
+	ErrEnumValueNotFound = errors.New("enum value not found")
+	ErrInvalidEnumValue  = errors.New("invalid enum value")
+	ErrBuiltinEnumValue  = errors.New("builtin enum values cannot be changed")
+	ErrTooManyEnumValues = errors.New("too many enum values")
+)
+
+// MaxCustomEnumValues adalah jumlah nilai tambahan maksimum per enum per workspace.
+const MaxCustomEnumValues = 50
+
+// EnumRepository mendefinisikan kontrak penyimpanan nilai enum. Workspace saat ini adalah
+// seluruh data satu pengguna, sehingga nilai tambahan diidentifikasi dengan UserID.
+type EnumRepository interface {
+	// FindValues mengembalikan nilai builtin dan nilai milik pengguna untuk enum, urut Position.
+	FindValues(ctx context.Context, userID UserID, enum EnumName) ([]EnumValue, error)
+
+	// FindValue mengembalikan satu nilai yang berlaku untuk pengguna, atau ErrEnumValueNotFound.
+	FindValue(ctx context.Context, userID UserID, enum EnumName, value string) (*EnumValue, error)
+
+	// SaveValue membuat atau memperbarui nilai milik pengguna.
+	SaveValue(ctx context.Context, userID UserID, value *EnumValue) error
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxEstimateMinutes adalah EstimateMinutes terbesar yang diterima (30 hari).
+const MaxEstimateMinutes = 30 * 24 * 60
+
+var ErrInvalidEstimate = errors.New("estimate_minutes must be between 0 and 43200")
+
+// ValidateEstimate memastikan EstimateMinutes dalam rentang yang diizinkan. nil berarti belum diestimasi.
+func ValidateEstimate(minutes *int) error {
+	if minutes != nil && (*minutes < 0 || *minutes > MaxEstimateMinutes) {
+		return ErrInvalidEstimate
+	}
+	return nil
+}
+
+// EstimateSummary adalah rangkuman usaha yang diperkirakan untuk task milik satu pengguna.
+type EstimateSummary struct {
+	OpenTasks        int64 // Task yang belum selesai
+	UnestimatedTasks int64 // Task belum selesai tanpa EstimateMinutes
+	RemainingMinutes int64 // Jumlah EstimateMinutes task yang belum selesai
+	CompletedByDay   []DailyEffort
+}
+
+// DailyEffort adalah task yang diselesaikan pada satu hari kalender beserta jumlah EstimateMinutes-nya.
+type DailyEffort struct {
+	Date    time.Time // Tengah malam di zona waktu yang diminta
+	Tasks   int64
+	Minutes int64
+}
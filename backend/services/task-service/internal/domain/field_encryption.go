@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrFieldKeyNotFound dikembalikan FieldCipher jika nilai dienkripsi dengan key yang tidak lagi
+// dikonfigurasi.
+var ErrFieldKeyNotFound = errors.New("field encryption key not found")
+
+// FieldCipher mengenkripsi field teks sensitif (deskripsi task dan isi komentar) sebelum disimpan.
+// aad mengikat ciphertext ke field dan baris asalnya, sehingga ciphertext yang dipindah ke baris
+// lain gagal didekripsi.
+type FieldCipher interface {
+	// Encrypt mengembalikan nilai yang disimpan. Jika enkripsi tidak aktif, plaintext dikembalikan
+	// apa adanya.
+	Encrypt(plaintext, aad string) (string, error)
+
+	// Decrypt mengembalikan plaintext. Nilai yang belum dienkripsi dikembalikan apa adanya, sehingga
+	// data lama tetap terbaca sebelum dienkripsi ulang.
+	Decrypt(stored, aad string) (string, error)
+
+	// CurrentPrefix mengembalikan awalan nilai yang dienkripsi dengan key aktif, atau "" jika
+	// enkripsi tidak aktif. Nilai lain perlu dienkripsi ulang.
+	CurrentPrefix() string
+}
+
+// FieldEncryptionRepository mendefinisikan kontrak enkripsi ulang data yang tersimpan sebagai
+// plaintext atau dengan key lama.
+type FieldEncryptionRepository interface {
+	// EncryptBatch mengenkripsi ulang paling banyak limit nilai per tabel dengan key aktif dan
+	// mengembalikan jumlah nilai yang diubah. Mengembalikan 0 jika semua sudah terenkripsi.
+	EncryptBatch(ctx context.Context, limit int) (int, error)
+}
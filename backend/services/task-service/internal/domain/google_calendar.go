@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// GoogleCalendarConnection adalah koneksi sinkronisasi dua arah antara task bertenggat milik satu
+// pengguna dan satu kalender Google.
+type GoogleCalendarConnection struct {
+	UserID     UserID
+	CalendarID string // ID kalender tujuan, "primary" untuk kalender utama akun
+
+	// AccessToken adalah OAuth access token dengan scope calendar.events; tidak pernah dikembalikan
+	// lewat API. RefreshToken opsional dan hanya dipakai jika client OAuth dikonfigurasi.
+	AccessToken    string
+	RefreshToken   string
+	TokenExpiresAt *time.Time // nil jika masa berlaku token tidak diketahui
+
+	SyncToken    string     // nextSyncToken dari sinkronisasi terakhir; kosong berarti daftar event lengkap
+	LastSyncedAt *time.Time // nil jika belum pernah disinkronkan
+	LastError    string     // Error sinkronisasi terakhir; kosong jika berhasil
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// GoogleCalendarEvent adalah properti event Google yang dipetakan ke task.
+type GoogleCalendarEvent struct {
+	ID          string
+	TaskID      string // extendedProperties.private.taskId; kosong untuk event yang tidak dibuat service ini
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool // Start hanya berisi tanggal (UTC tengah malam)
+	Cancelled   bool // Event dihapus; Google hanya mengirim ID-nya
+}
+
+// GoogleCalendarLink memetakan satu task ke event Google yang dibuat untuknya.
+type GoogleCalendarLink struct {
+	UserID   UserID
+	TaskID   string
+	EventID  string
+	SyncedAt time.Time // Waktu event terakhir ditulis dari task
+}
+
+var (
+	ErrGoogleCalendarNotConnected      = errors.New("google calendar is not connected")
+	ErrInvalidGoogleCalendarConnection = errors.New("invalid google calendar connection")
+	ErrGoogleCalendarLinkNotFound      = errors.New("google calendar event link not found")
+	ErrGoogleCalendarUnauthorized      = errors.New("google calendar rejected the access token")
+	ErrGoogleCalendarEventNotFound     = errors.New("google calendar event not found")
+	ErrGoogleCalendarSyncTokenExpired  = errors.New("google calendar sync token expired")
+)
+
+// GoogleCalendarClient memanggil Google Calendar API v3. Token yang ditolak dikembalikan sebagai
+// ErrGoogleCalendarUnauthorized.
+type GoogleCalendarClient interface {
+	// VerifyCalendar memastikan token bisa mengakses calendarID dan mengembalikan ID kalendernya
+	// (misalnya alamat email untuk "primary").
+	VerifyCalendar(ctx context.Context, accessToken, calendarID string) (string, error)
+
+	// InsertEvent membuat event dan mengembalikan ID-nya.
+	InsertEvent(ctx context.Context, accessToken, calendarID string, event GoogleCalendarEvent) (string, error)
+
+	// UpdateEvent mengganti event event.ID. Mengembalikan ErrGoogleCalendarEventNotFound jika
+	// event sudah dihapus.
+	UpdateEvent(ctx context.Context, accessToken, calendarID string, event GoogleCalendarEvent) error
+
+	// DeleteEvent menghapus event. Mengembalikan ErrGoogleCalendarEventNotFound jika sudah dihapus.
+	DeleteEvent(ctx context.Context, accessToken, calendarID, eventID string) error
+
+	// ListChanges mengembalikan event yang berubah sejak syncToken (semua event jika kosong) beserta
+	// sync token berikutnya. Mengembalikan ErrGoogleCalendarSyncTokenExpired jika syncToken tidak
+	// berlaku lagi dan daftar lengkap harus dibaca ulang.
+	ListChanges(ctx context.Context, accessToken, calendarID, syncToken string) ([]GoogleCalendarEvent, string, error)
+
+	// RefreshAccessToken menukar refresh token dengan access token baru. Mengembalikan
+	// ErrGoogleCalendarUnauthorized jika client OAuth tidak dikonfigurasi atau refresh token ditolak.
+	RefreshAccessToken(ctx context.Context, refreshToken string) (accessToken string, expiresAt time.Time, err error)
+}
+
+// GoogleCalendarConnectionRepository mendefinisikan kontrak penyimpanan koneksi Google Calendar.
+type GoogleCalendarConnectionRepository interface {
+	// FindByUserID mengembalikan ErrGoogleCalendarNotConnected jika pengguna belum terhubung.
+	FindByUserID(ctx context.Context, userID UserID) (*GoogleCalendarConnection, error)
+
+	// FindAll mengembalikan semua koneksi, dipakai job rekonsiliasi.
+	FindAll(ctx context.Context) ([]*GoogleCalendarConnection, error)
+
+	// Save membuat atau mengganti koneksi pengguna. Jika kalender berganti, pemetaan event dan sync
+	// token lama dihapus.
+	Save(ctx context.Context, conn *GoogleCalendarConnection) error
+
+	// UpdateToken menyimpan access token hasil refresh.
+	UpdateToken(ctx context.Context, userID UserID, accessToken string, expiresAt time.Time) error
+
+	// UpdateSyncState menyimpan hasil satu putaran sinkronisasi.
+	UpdateSyncState(ctx context.Context, userID UserID, syncToken string, syncedAt time.Time, lastError string) error
+
+	// Delete menghapus koneksi beserta pemetaan event-nya. Mengembalikan
+	// ErrGoogleCalendarNotConnected jika tidak ada.
+	Delete(ctx context.Context, userID UserID) error
+}
+
+// GoogleCalendarLinkRepository mendefinisikan kontrak penyimpanan pemetaan task ke event Google.
+type GoogleCalendarLinkRepository interface {
+	// FindByTaskID mengembalikan ErrGoogleCalendarLinkNotFound jika task belum punya event.
+	FindByTaskID(ctx context.Context, userID UserID, taskID string) (*GoogleCalendarLink, error)
+	FindByUserID(ctx context.Context, userID UserID) ([]*GoogleCalendarLink, error)
+
+	// Save membuat atau mengganti pemetaan task.
+	Save(ctx context.Context, link *GoogleCalendarLink) error
+	Delete(ctx context.Context, userID UserID, taskID string) error
+}
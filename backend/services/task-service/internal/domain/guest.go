@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// GuestTokenPrefix adalah awalan setiap token tamu. Middleware autentikasi memakainya untuk
+// membedakan token tamu dari JWT dan personal access token.
+const GuestTokenPrefix = "gst_"
+
+// GuestUserIDPrefix adalah awalan ID pengguna tamu, sehingga tidak pernah sama dengan ID pengguna
+// Supabase, issuer OIDC, atau akun lokal.
+const GuestUserIDPrefix = "guest_"
+
+// IsGuestUserID melaporkan apakah id adalah ID pengguna tamu.
+func IsGuestUserID(id UserID) bool {
+	return strings.HasPrefix(string(id), GuestUserIDPrefix)
+}
+
+// GuestSession adalah identitas tamu yang diterbitkan untuk klien anonim. Token ditandatangani
+// server dan disimpan oleh klien; server hanya menyimpan batas task tamu dan ExpiresAt-nya.
+type GuestSession struct {
+	GuestID   UserID
+	Token     string
+	ExpiresAt time.Time
+}
+
+// GuestMerge adalah hasil penggabungan data tamu ke akun pengguna setelah signup. Setiap tamu
+// hanya bisa digabung sekali; token tamu tidak berlaku lagi setelahnya.
+type GuestMerge struct {
+	GuestID     UserID
+	UserID      UserID
+	MergedTasks int64
+	MergedAt    time.Time
+}
+
+var (
+	ErrGuestModeDisabled   = errors.New("guest mode is disabled")
+	ErrInvalidGuestToken   = errors.New("invalid guest token")
+	ErrGuestAlreadyMerged  = errors.New("guest has already been merged into an account")
+	ErrGuestMergeForbidden = errors.New("guests cannot merge into another guest")
+)
+
+// GuestTokenSigner menandatangani dan memeriksa token tamu.
+type GuestTokenSigner interface {
+	Sign(guestID UserID, expiresAt time.Time) (string, error)
+
+	// Verify mengembalikan ID tamu, atau ErrInvalidGuestToken jika token rusak, tanda tangannya
+	// salah, atau sudah kedaluwarsa pada waktu now.
+	Verify(token string, now time.Time) (UserID, error)
+}
+
+// GuestMergeRepository mendefinisikan kontrak penyimpanan penggabungan tamu.
+type GuestMergeRepository interface {
+	// Merge memindahkan task tamu (beserta komentar, attachment, dan riwayatnya) ke merge.UserID,
+	// menghapus baris counter tamu, dan mencatat penggabungannya dalam satu transaksi, lalu mengisi
+	// merge.MergedTasks.
+	// Mengembalikan ErrGuestAlreadyMerged jika tamu sudah pernah digabung.
+	Merge(ctx context.Context, merge *GuestMerge) error
+
+	// IsMerged melaporkan apakah tamu sudah digabung ke akun pengguna.
+	IsMerged(ctx context.Context, guestID UserID) (bool, error)
+}
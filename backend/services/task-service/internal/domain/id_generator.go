@@ -0,0 +1,16 @@
+package domain
+
+// IDGenerator mendefinisikan kontrak untuk pembuatan ID task.
+// Strategi yang dipakai (UUIDv4, UUIDv7, ULID) dipilih per deployment oleh layer infrastructure.
+type IDGenerator interface {
+	// NewID membuat ID baru.
+	NewID() string
+
+	// Validate memeriksa apakah id (misalnya ID yang di-generate klien) berformat valid
+	// untuk deployment ini. Mengembalikan ErrInvalidTaskID jika tidak valid.
+	Validate(id string) error
+
+	// Sortable bernilai true jika urutan leksikografis ID sama dengan urutan waktu pembuatan,
+	// sehingga repository bisa memakai ID saja sebagai cursor keyset pagination.
+	Sortable() bool
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// IntegrityCheck adalah satu pemeriksaan anomali data, misalnya kolom turunan yang tidak
+// konsisten atau referensi ke baris yang sudah tidak ada. Layer persistence mengimplementasikan
+// setiap pemeriksaan karena query-nya bergantung pada skema.
+type IntegrityCheck interface {
+	// Name adalah nama unik pemeriksaan, dipakai di laporan dan metrics.
+	Name() string
+
+	// Find menghitung baris yang bermasalah dan mengembalikan paling banyak sampleLimit ID contoh.
+	Find(ctx context.Context, sampleLimit int) (count int64, sample []string, err error)
+
+	// Repair memperbaiki baris yang bermasalah dan mengembalikan jumlah baris yang diperbaiki.
+	Repair(ctx context.Context) (int64, error)
+}
+
+// IntegrityFinding adalah hasil satu IntegrityCheck.
+type IntegrityFinding struct {
+	Check     string
+	Count     int64    // Jumlah baris bermasalah saat diperiksa
+	Sample    []string // Contoh ID baris bermasalah untuk investigasi
+	Repaired  int64    // Jumlah baris yang diperbaiki; selalu 0 jika repair tidak aktif
+	Remaining int64    // Jumlah baris bermasalah setelah perbaikan; sama dengan Count jika repair tidak aktif
+	Error     string   // Diisi jika pemeriksaan atau perbaikan gagal
+}
+
+// IntegrityReport adalah hasil satu kali menjalankan semua IntegrityCheck.
+type IntegrityReport struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Repair     bool
+	Findings   []IntegrityFinding
+}
+
+// Clean bernilai true jika tidak ada anomali yang tersisa dan tidak ada pemeriksaan yang gagal.
+func (r IntegrityReport) Clean() bool {
+	for _, f := range r.Findings {
+		if f.Error != "" || f.Remaining > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ListAccess adalah hak akses kolaborator pada daftar task pemilik.
+type ListAccess string
+
+const (
+	ListAccessRead  ListAccess = "read"  // Melihat task, riwayat, dan attachment
+	ListAccessWrite ListAccess = "write" // Juga membuat, mengubah, dan menghapus task
+)
+
+// Validate mengembalikan ErrInvalidListShare jika akses tidak dikenal.
+func (a ListAccess) Validate() error {
+	switch a {
+	case ListAccessRead, ListAccessWrite:
+		return nil
+	default:
+		return ErrInvalidListShare
+	}
+}
+
+// Allows melaporkan apakah akses a mencakup akses need.
+func (a ListAccess) Allows(need ListAccess) bool {
+	return a == ListAccessWrite || (a == ListAccessRead && need == ListAccessRead)
+}
+
+// MaxListCollaborators adalah jumlah kolaborator maksimum per daftar task.
+const MaxListCollaborators = 50
+
+// ListShare adalah akses yang diberikan pemilik daftar task kepada pengguna lain. Daftar task saat
+// ini adalah seluruh task milik satu pengguna, sehingga daftar diidentifikasi dengan OwnerID.
+type ListShare struct {
+	OwnerID        UserID
+	CollaboratorID UserID
+	Access         ListAccess
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+var (
+	ErrListShareNotFound    = errors.New("list share not found")
+	ErrInvalidListShare     = errors.New("invalid list share")
+	ErrListReadOnly         = errors.New("list is shared read-only")
+	ErrTooManyCollaborators = errors.New("too many collaborators")
+)
+
+// ListShareRepository mendefinisikan kontrak penyimpanan akses kolaborator.
+type ListShareRepository interface {
+	// FindAccess mengembalikan akses collaboratorID pada daftar ownerID, atau ErrListShareNotFound.
+	FindAccess(ctx context.Context, ownerID, collaboratorID UserID) (ListAccess, error)
+
+	// FindByOwner mengembalikan kolaborator daftar ownerID, urut waktu dibagikan.
+	FindByOwner(ctx context.Context, ownerID UserID) ([]*ListShare, error)
+
+	// FindByCollaborator mengembalikan daftar yang dibagikan kepada collaboratorID.
+	FindByCollaborator(ctx context.Context, collaboratorID UserID) ([]*ListShare, error)
+
+	// Save membuat atau mengubah akses kolaborator. CreatedAt tidak berubah pada update.
+	Save(ctx context.Context, share *ListShare) error
+
+	// Delete mencabut akses kolaborator dan melepas penugasan task kepadanya di daftar tersebut.
+	// Mengembalikan ErrListShareNotFound jika tidak ada.
+	Delete(ctx context.Context, ownerID, collaboratorID UserID) error
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Batas panjang password akun lokal (dalam byte); bcrypt hanya memakai 72 byte pertama.
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+// MaxEmailLength adalah panjang alamat email maksimum (RFC 5321).
+const MaxEmailLength = 254
+
+// LocalUser adalah akun pada mode autentikasi lokal, untuk instalasi self-hosted tanpa Supabase.
+type LocalUser struct {
+	ID           UserID
+	Email        string // Selalu huruf kecil
+	PasswordHash string
+	Role         string // Role aplikasi, sama dengan app_metadata.role di JWT Supabase
+	CreatedAt    time.Time
+}
+
+// RefreshToken adalah refresh token yang disimpan di server. Setiap refresh menandai token lama
+// sebagai terpakai dan menerbitkan token baru dalam family yang sama (rotasi). Token yang dipakai
+// lagi setelah dirotasi berarti token bocor, sehingga seluruh family dicabut.
+type RefreshToken struct {
+	ID        string
+	FamilyID  string // Sama untuk semua token hasil rotasi dari satu login
+	UserID    UserID
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time // Diisi saat token dirotasi
+	RevokedAt *time.Time // Diisi saat logout atau saat pemakaian ulang terdeteksi
+}
+
+// Expired melaporkan apakah token sudah kedaluwarsa pada waktu now.
+func (t *RefreshToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// AuthSession adalah pasangan token yang diterbitkan saat login atau refresh.
+type AuthSession struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+var (
+	ErrInvalidCredentials     = errors.New("invalid email or password")
+	ErrInvalidSignup          = errors.New("invalid signup")
+	ErrEmailTaken             = errors.New("email is already registered")
+	ErrSignupDisabled         = errors.New("signup is disabled")
+	ErrLocalUserNotFound      = errors.New("local user not found")
+	ErrRefreshTokenNotFound   = errors.New("refresh token not found")
+	ErrInvalidRefreshToken    = errors.New("invalid refresh token")
+	ErrRefreshTokenReused     = errors.New("refresh token reused")
+	ErrLocalAuthNotConfigured = errors.New("local authentication is not configured")
+)
+
+// LocalUserRepository mendefinisikan kontrak penyimpanan akun lokal.
+type LocalUserRepository interface {
+	// FindByEmail mengembalikan ErrLocalUserNotFound jika tidak ada akun dengan email tersebut.
+	FindByEmail(ctx context.Context, email string) (*LocalUser, error)
+
+	// FindByID mengembalikan ErrLocalUserNotFound jika akun tidak ada.
+	FindByID(ctx context.Context, id UserID) (*LocalUser, error)
+
+	// Create menyimpan akun baru. Mengembalikan ErrEmailTaken jika email sudah terdaftar.
+	Create(ctx context.Context, user *LocalUser) error
+}
+
+// RefreshTokenRepository mendefinisikan kontrak penyimpanan refresh token. Seperti personal access
+// token, hanya hash token yang disimpan.
+type RefreshTokenRepository interface {
+	// FindByHash mengembalikan ErrRefreshTokenNotFound jika tidak ada token dengan hash tersebut.
+	FindByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// Create menyimpan token baru beserta hash-nya.
+	Create(ctx context.Context, token *RefreshToken, tokenHash string) error
+
+	// MarkUsed menandai token sebagai terpakai. Mengembalikan false jika token sudah terpakai atau
+	// dicabut, misalnya oleh refresh lain yang berjalan bersamaan.
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) (bool, error)
+
+	// RevokeFamily mencabut semua token dalam family yang belum dicabut.
+	RevokeFamily(ctx context.Context, familyID string, revokedAt time.Time) error
+
+	// DeleteExpired menghapus token yang kedaluwarsa sebelum waktu before.
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+// PasswordHasher membuat dan memeriksa hash password akun lokal.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+
+	// Compare melaporkan apakah password cocok dengan hash.
+	Compare(hash, password string) bool
+}
+
+// AccessTokenIssuer menerbitkan access token berumur pendek untuk akun lokal, dalam format yang
+// sama dengan JWT Supabase sehingga diverifikasi middleware autentikasi yang sama. sessionID
+// adalah FamilyID refresh token, sehingga sesi bisa dicabut (lihat UserSession).
+type AccessTokenIssuer interface {
+	IssueAccessToken(user *LocalUser, sessionID string, now time.Time) (token string, expiresAt time.Time, err error)
+}
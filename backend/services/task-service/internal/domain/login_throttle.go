@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// LoginThrottle adalah penghitung login akun lokal yang gagal untuk satu akun atau satu IP.
+type LoginThrottle struct {
+	Key           string // "account:<email>" atau "ip:<alamat>"
+	Failures      int
+	LastFailureAt time.Time
+	BlockedUntil  *time.Time // Login berikutnya ditolak sampai waktu ini
+}
+
+// Blocked melaporkan apakah login ditolak pada now, beserta sisa waktunya.
+func (t *LoginThrottle) Blocked(now time.Time) (time.Duration, bool) {
+	if t.BlockedUntil == nil || !now.Before(*t.BlockedUntil) {
+		return 0, false
+	}
+	return t.BlockedUntil.Sub(now), true
+}
+
+var (
+	ErrLoginThrottled        = errors.New("too many failed login attempts")
+	ErrInvalidUnlockToken    = errors.New("invalid or expired unlock token")
+	ErrLoginThrottleNotFound = errors.New("login throttle not found")
+)
+
+// LoginThrottledError dikembalikan login yang ditolak karena terlalu banyak kegagalan.
+// errors.Is(err, ErrLoginThrottled) tetap bernilai true.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrLoginThrottled, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrLoginThrottled
+}
+
+// LoginThrottleRepository mendefinisikan kontrak penyimpanan penghitung login yang gagal. Penghitung
+// disimpan bersama agar batas berlaku di semua replika.
+type LoginThrottleRepository interface {
+	// Reserve mencatat satu percobaan login sebagai kegagalan untuk semua keys dalam satu transaksi,
+	// sebelum password diperiksa, sehingga percobaan bersamaan tidak bisa melewati batas. Penghitung
+	// yang kegagalan terakhirnya sebelum resetBefore dimulai lagi dari 1, dan delay menentukan lama
+	// login berikutnya ditolak dari jumlah kegagalan key. Jika salah satu key sedang menolak login
+	// pada at, tidak ada yang dicatat dan LoginThrottledError dikembalikan. Mengembalikan penghitung
+	// terbaru setiap key.
+	Reserve(ctx context.Context, keys []string, at, resetBefore time.Time, delay func(key string, failures int) time.Duration) ([]LoginThrottle, error)
+
+	// Release membatalkan percobaan yang dicatat Reserve untuk reserved.Key, termasuk penolakan
+	// sampai reserved.BlockedUntil yang dipasang percobaan tersebut.
+	Release(ctx context.Context, reserved LoginThrottle) error
+
+	// SetUnlockToken menyimpan hash token buka kunci untuk key, kecuali key masih punya token yang
+	// belum kedaluwarsa pada now. Mengembalikan false jika token tidak disimpan.
+	SetUnlockToken(ctx context.Context, key, tokenHash string, expiresAt, now time.Time) (bool, error)
+
+	// Unlock menghapus penghitung yang memiliki token buka kunci tersebut dan mengembalikan key-nya.
+	// Mengembalikan ErrLoginThrottleNotFound jika token tidak ada atau sudah kedaluwarsa.
+	Unlock(ctx context.Context, tokenHash string, now time.Time) (string, error)
+
+	// Delete menghapus penghitung key, misalnya setelah login berhasil.
+	Delete(ctx context.Context, key string) error
+
+	// DeleteStale menghapus penghitung yang kegagalan terakhirnya sebelum before dan tidak sedang
+	// menolak login.
+	DeleteStale(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrMaintenance dikembalikan untuk request write selama mode maintenance aktif.
+var ErrMaintenance = errors.New("service is under maintenance, writes are temporarily disabled")
+
+// Maintenance adalah status mode maintenance global, misalnya selama migrasi berisiko. Selama
+// Enabled, request write ditolak sementara request baca tetap dilayani.
+type Maintenance struct {
+	Enabled    bool
+	Message    string        // Pesan untuk pengguna, misalnya perkiraan waktu selesai
+	RetryAfter time.Duration // Perkiraan jeda sebelum klien mencoba lagi (header Retry-After)
+	UpdatedBy  UserID
+	UpdatedAt  time.Time
+}
+
+// MaintenanceRepository mendefinisikan kontrak penyimpanan status maintenance yang dibagi semua
+// replika.
+type MaintenanceRepository interface {
+	Get(ctx context.Context) (Maintenance, error)
+	Save(ctx context.Context, maintenance Maintenance) error
+}
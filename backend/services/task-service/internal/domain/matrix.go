@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// MatrixChannel adalah pengaturan notifikasi Matrix milik satu pengguna: akun di homeserver
+// pengguna sendiri yang memposting event task ke satu room.
+type MatrixChannel struct {
+	UserID        UserID
+	HomeserverURL string // Base URL Client-Server API, misalnya https://matrix.example.org
+	AccessToken   string // Token akun pengirim; tidak pernah dikembalikan lewat API
+	RoomID        string // ID room hasil verifikasi, misalnya !abc:example.org
+	EventTypes    []TaskEventType
+	VerifiedAt    time.Time // Waktu room terakhir diverifikasi bisa dikirimi pesan
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Accepts bernilai true jika channel berlangganan jenis event eventType.
+func (c *MatrixChannel) Accepts(eventType TaskEventType) bool {
+	return len(c.EventTypes) == 0 || slices.Contains(c.EventTypes, eventType)
+}
+
+var (
+	ErrMatrixChannelNotFound = errors.New("matrix channel not found")
+	ErrInvalidMatrixChannel  = errors.New("invalid matrix channel")
+)
+
+// MatrixMessage adalah pesan m.notice. FormattedBody berisi HTML (org.matrix.custom.html).
+type MatrixMessage struct {
+	Body          string
+	FormattedBody string
+}
+
+// MatrixClient mengirim pesan ke homeserver Matrix. Implementasinya wajib membatasi laju request
+// per homeserver dan menolak homeserver di jaringan internal.
+type MatrixClient interface {
+	// VerifyRoom memastikan access token valid dan akunnya sudah bergabung di room (ID atau alias),
+	// lalu mengembalikan ID room.
+	VerifyRoom(ctx context.Context, homeserverURL, accessToken, room string) (roomID string, err error)
+
+	// SendMessage mengirim msg ke room. txnID membuat pengiriman ulang dengan ID yang sama idempoten.
+	SendMessage(ctx context.Context, homeserverURL, accessToken, roomID, txnID string, msg MatrixMessage) error
+}
+
+// MatrixChannelRepository mendefinisikan kontrak penyimpanan pengaturan Matrix pengguna.
+type MatrixChannelRepository interface {
+	// FindByUserID mengembalikan ErrMatrixChannelNotFound jika pengguna belum punya pengaturan.
+	FindByUserID(ctx context.Context, userID UserID) (*MatrixChannel, error)
+
+	// Save membuat atau mengganti pengaturan Matrix pengguna.
+	Save(ctx context.Context, channel *MatrixChannel) error
+
+	// Delete menghapus pengaturan. Mengembalikan ErrMatrixChannelNotFound jika tidak ada.
+	Delete(ctx context.Context, userID UserID) error
+}
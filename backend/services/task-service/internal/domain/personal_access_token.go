@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"slices"
+	"time"
+)
+
+// PersonalAccessTokenPrefix adalah awalan setiap personal access token. Middleware autentikasi
+// memakainya untuk membedakan token dari JWT Supabase, dan secret scanner untuk mengenali token
+// yang bocor.
+const PersonalAccessTokenPrefix = "pat_"
+
+// MaxPersonalAccessTokensPerUser adalah jumlah personal access token maksimum per pengguna.
+const MaxPersonalAccessTokensPerUser = 20
+
+// MaxPersonalAccessTokenNameLength adalah panjang nama token maksimum (dalam rune).
+const MaxPersonalAccessTokenNameLength = 100
+
+// MaxPersonalAccessTokenLists adalah jumlah daftar maksimum yang bisa dipilih untuk satu token.
+const MaxPersonalAccessTokenLists = 20
+
+// MaxPersonalAccessTokenAllowedIPs adalah jumlah jaringan maksimum di allowlist IP satu token.
+const MaxPersonalAccessTokenAllowedIPs = 20
+
+// TokenScope adalah izin yang diberikan kepada personal access token, atau kepada JWT lewat claim
+// scope.
+type TokenScope string
+
+const (
+	ScopeTasksRead  TokenScope = "tasks:read"  // Request GET dan HEAD
+	ScopeTasksWrite TokenScope = "tasks:write" // Semua request lain; juga mengizinkan tasks:read
+	ScopeExport     TokenScope = "export"      // Ekspor massal (backup, CSV, Markdown, PDF), ditambah tasks:read
+)
+
+// TokenScopes adalah semua TokenScope yang dikenal.
+var TokenScopes = []TokenScope{ScopeTasksRead, ScopeTasksWrite, ScopeExport}
+
+// PersonalAccessToken adalah token API milik pengguna untuk script dan integrasi pihak ketiga.
+// Hanya hash token yang disimpan; nilai token hanya dikembalikan saat dibuat.
+type PersonalAccessToken struct {
+	ID         string
+	UserID     UserID
+	Name       string
+	Scopes     []TokenScope
+	Lists      []UserID       // Pemilik daftar yang boleh diakses (lihat ListShare); kosong berarti semua
+	AllowedIPs []netip.Prefix // Jaringan klien yang boleh memakai token; kosong berarti semua
+	Hint       string         // Beberapa karakter terakhir token, untuk dikenali di daftar token
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time // nil berarti tidak kedaluwarsa
+	LastUsedAt *time.Time
+}
+
+// HasScope melaporkan apakah token memiliki scope tersebut (lihat HasTokenScope).
+func (t *PersonalAccessToken) HasScope(scope TokenScope) bool {
+	return HasTokenScope(t.Scopes, scope)
+}
+
+// HasTokenScope melaporkan apakah scopes berisi scope. ScopeTasksWrite mencakup ScopeTasksRead.
+func HasTokenScope(scopes []TokenScope, scope TokenScope) bool {
+	if scope == ScopeTasksRead && slices.Contains(scopes, ScopeTasksWrite) {
+		return true
+	}
+	return slices.Contains(scopes, scope)
+}
+
+// AllowsIP melaporkan apakah token boleh dipakai dari alamat ip (lihat ClientIPFromContext). Token
+// dengan allowlist menolak alamat yang kosong atau tidak valid.
+func (t *PersonalAccessToken) AllowsIP(ip string) bool {
+	if len(t.AllowedIPs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	return slices.ContainsFunc(t.AllowedIPs, func(network netip.Prefix) bool {
+		return network.Contains(addr)
+	})
+}
+
+// Expired melaporkan apakah token sudah kedaluwarsa pada now.
+func (t *PersonalAccessToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+var (
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+	ErrInvalidPersonalAccessToken  = errors.New("invalid personal access token")
+	ErrTooManyPersonalAccessTokens = errors.New("too many personal access tokens")
+	ErrPersonalAccessTokenExpired  = errors.New("personal access token expired")
+	ErrPersonalAccessTokenIPDenied = errors.New("personal access token is not allowed from this ip address")
+	ErrTokenListForbidden          = errors.New("token is not allowed to access this list")
+)
+
+type allowedListsContextKey struct{}
+
+// ContextWithAllowedLists membatasi request ke daftar milik lists, misalnya dari
+// PersonalAccessToken.Lists. lists kosong berarti tanpa batas.
+func ContextWithAllowedLists(ctx context.Context, lists []UserID) context.Context {
+	if len(lists) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, allowedListsContextKey{}, lists)
+}
+
+// ListAllowedFromContext melaporkan apakah request boleh mengakses daftar ownerID menurut
+// ContextWithAllowedLists.
+func ListAllowedFromContext(ctx context.Context, ownerID UserID) bool {
+	lists, ok := ctx.Value(allowedListsContextKey{}).([]UserID)
+	return !ok || slices.Contains(lists, ownerID)
+}
+
+// PersonalAccessTokenRepository mendefinisikan kontrak penyimpanan personal access token.
+type PersonalAccessTokenRepository interface {
+	// FindByUserID mengembalikan semua token pengguna, dari yang terbaru.
+	FindByUserID(ctx context.Context, userID UserID) ([]*PersonalAccessToken, error)
+
+	// FindByHash mengembalikan ErrPersonalAccessTokenNotFound jika tidak ada token dengan hash
+	// tersebut.
+	FindByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+
+	// CountByUserID menghitung token pengguna, termasuk yang sudah kedaluwarsa.
+	CountByUserID(ctx context.Context, userID UserID) (int, error)
+
+	// Create menyimpan token baru beserta hash-nya.
+	Create(ctx context.Context, token *PersonalAccessToken, tokenHash string) error
+
+	// Delete mencabut token. Mengembalikan ErrPersonalAccessTokenNotFound jika token tidak ada,
+	// termasuk milik pengguna lain.
+	Delete(ctx context.Context, userID UserID, id string) error
+
+	// DeleteByUserID mencabut semua token pengguna.
+	DeleteByUserID(ctx context.Context, userID UserID) error
+
+	// TouchLastUsed mencatat waktu terakhir token dipakai.
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
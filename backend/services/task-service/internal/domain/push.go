@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// PushProvider adalah layanan yang mengantarkan notifikasi push ke perangkat.
+type PushProvider string
+
+const (
+	PushProviderFCM     PushProvider = "fcm"     // Firebase Cloud Messaging (Android, iOS lewat APNs)
+	PushProviderWebPush PushProvider = "webpush" // Web Push (RFC 8030) dengan VAPID, untuk browser
+)
+
+// MaxPushReminderLead adalah jarak pengingat tenggat terbesar sebelum tenggat task.
+const MaxPushReminderLead = MaxEmailReminderLead
+
+// Batas ukuran data langganan push dari klien.
+const (
+	maxPushTokenLength    = 4096
+	maxPushEndpointLength = 2048
+)
+
+// PushSubscription adalah token FCM atau langganan Web Push sebuah perangkat.
+type PushSubscription struct {
+	Provider PushProvider `json:"provider"`
+	Token    string       `json:"token,omitempty"`    // Registration token FCM
+	Endpoint string       `json:"endpoint,omitempty"` // URL push service browser (Web Push)
+	Keys     *PushKeys    `json:"keys,omitempty"`     // Kunci enkripsi payload (Web Push)
+}
+
+// PushKeys adalah kunci dari PushSubscription browser, base64url seperti di PushSubscription.toJSON().
+type PushKeys struct {
+	P256dh string `json:"p256dh"`
+	Auth   string `json:"auth"`
+}
+
+// ParsePushSubscription membaca langganan push dari klien. Objek PushSubscription.toJSON() browser
+// diterima apa adanya (provider boleh kosong jika endpoint diisi); FCM memakai
+// {"provider": "fcm", "token": "…"}. Mengembalikan ErrInvalidPushSubscription jika tidak valid.
+func ParsePushSubscription(raw json.RawMessage) (*PushSubscription, error) {
+	var sub PushSubscription
+	if err := json.Unmarshal(raw, &sub); err != nil {
+		return nil, ErrInvalidPushSubscription
+	}
+	if sub.Provider == "" && sub.Endpoint != "" {
+		sub.Provider = PushProviderWebPush
+	}
+	switch sub.Provider {
+	case PushProviderFCM:
+		if sub.Token == "" || len(sub.Token) > maxPushTokenLength {
+			return nil, ErrInvalidPushSubscription
+		}
+		return &PushSubscription{Provider: PushProviderFCM, Token: sub.Token}, nil
+	case PushProviderWebPush:
+		endpoint, err := url.Parse(sub.Endpoint)
+		if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" || len(sub.Endpoint) > maxPushEndpointLength {
+			return nil, ErrInvalidPushSubscription
+		}
+		if sub.Keys == nil || !validPushKey(sub.Keys.P256dh, 65) || !validPushKey(sub.Keys.Auth, 16) {
+			return nil, ErrInvalidPushSubscription
+		}
+		return &PushSubscription{Provider: PushProviderWebPush, Endpoint: sub.Endpoint, Keys: sub.Keys}, nil
+	default:
+		return nil, ErrInvalidPushSubscription
+	}
+}
+
+// validPushKey memastikan kunci base64url (dengan atau tanpa padding) berukuran size byte.
+func validPushKey(key string, size int) bool {
+	decoded, err := base64.RawURLEncoding.DecodeString(key)
+	if err != nil {
+		decoded, err = base64.URLEncoding.DecodeString(key)
+	}
+	return err == nil && len(decoded) == size
+}
+
+// PushChannel adalah pengaturan notifikasi push seorang pengguna, berlaku untuk semua perangkatnya
+// yang punya langganan push.
+type PushChannel struct {
+	UserID     UserID
+	EventTypes []TaskEventType // Event task milik pengguna yang dikirim; kosong berarti tidak ada
+	// SharedLists juga mengirim EventTypes dari daftar task yang dibagikan kepada pengguna.
+	SharedLists  bool
+	ReminderLead time.Duration // Jarak pengingat sebelum tenggat; 0 berarti tanpa pengingat
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Accepts melaporkan apakah channel berlangganan jenis event tersebut. Seperti email, push
+// bersifat opt-in per jenis event.
+func (c *PushChannel) Accepts(eventType TaskEventType) bool {
+	return slices.Contains(c.EventTypes, eventType)
+}
+
+// PushTarget adalah satu perangkat tujuan notifikasi push.
+type PushTarget struct {
+	UserID       UserID
+	DeviceID     string
+	Subscription PushSubscription
+	Stored       json.RawMessage // Isi Device.PushSubscription, untuk RemovePushSubscription
+}
+
+// PushMessage adalah isi notifikasi push. Data dikirim ke aplikasi klien bersama notifikasi.
+type PushMessage struct {
+	Title string
+	Body  string
+	Tag   string // Notifikasi dengan tag sama menggantikan yang sebelumnya, misalnya per task
+	Data  map[string]string
+}
+
+// PushReminder adalah pengingat tenggat task yang harus dikirim ke perangkat pemiliknya.
+type PushReminder struct {
+	Channel *PushChannel
+	Task    *Task
+}
+
+var (
+	ErrPushChannelNotFound     = errors.New("push channel not found")
+	ErrInvalidPushChannel      = errors.New("invalid push channel")
+	ErrInvalidPushSubscription = errors.New("invalid push subscription")
+	ErrPushNotConfigured       = errors.New("push provider is not configured")
+	ErrPushDeliveryFailed      = errors.New("push delivery failed")
+
+	// ErrPushSubscriptionGone dikembalikan PushSender saat provider melaporkan token atau langganan
+	// sudah tidak berlaku, sehingga langganan perangkat harus dihapus.
+	ErrPushSubscriptionGone = errors.New("push subscription is no longer valid")
+)
+
+// PushSender mendefinisikan kontrak pengiriman notifikasi push lewat FCM atau Web Push.
+type PushSender interface {
+	// Send mengirim msg ke satu langganan. Mengembalikan ErrPushNotConfigured jika provider langganan
+	// tidak dikonfigurasi, dan ErrPushSubscriptionGone jika langganan sudah tidak berlaku.
+	Send(ctx context.Context, sub PushSubscription, msg PushMessage) error
+}
+
+// PushChannelRepository mendefinisikan kontrak penyimpanan pengaturan push dan klaim pengingatnya.
+// Langganan push sendiri disimpan di Device (lihat DeviceRepository.FindPushTargets).
+type PushChannelRepository interface {
+	// FindByUserID mengembalikan ErrPushChannelNotFound jika pengguna belum mengatur push.
+	FindByUserID(ctx context.Context, userID UserID) (*PushChannel, error)
+
+	// Save membuat atau mengganti pengaturan push pengguna.
+	Save(ctx context.Context, channel *PushChannel) error
+
+	// Delete mengembalikan ErrPushChannelNotFound jika pengguna belum mengatur push.
+	Delete(ctx context.Context, userID UserID) error
+
+	// FindDueReminders mengembalikan paling banyak limit task yang tenggatnya dalam ReminderLead
+	// pemiliknya dari now dan belum diingatkan untuk tenggat tersebut lewat push.
+	FindDueReminders(ctx context.Context, now time.Time, limit int) ([]PushReminder, error)
+
+	// ClaimReminder menandai pengingat push untuk tenggat dueAt task sudah dikirim. Mengembalikan
+	// false jika pengingat tersebut sudah diklaim, misalnya oleh replika lain.
+	ClaimReminder(ctx context.Context, taskID string, dueAt, now time.Time) (bool, error)
+
+	// ReleaseReminder membatalkan klaim agar pengingat dicoba lagi.
+	ReleaseReminder(ctx context.Context, taskID string, dueAt time.Time) error
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Plan adalah paket langganan pengguna, dibaca dari claim app_metadata.plan pada JWT.
+type Plan string
+
+// DefaultPlan dipakai jika token tidak membawa plan, atau plan belum punya kebijakan kuota.
+const DefaultPlan Plan = "free"
+
+// QuotaResource adalah sumber daya yang dibatasi kuotanya.
+type QuotaResource string
+
+const (
+	QuotaTasks QuotaResource = "tasks" // Jumlah task milik pengguna
+)
+
+// UsageEventQuotaWarning adalah tipe UsageEvent saat pemakaian melewati ambang peringatan.
+const UsageEventQuotaWarning = "quota.warning"
+
+var ErrInvalidQuotaPolicy = errors.New("invalid quota policy")
+
+// QuotaPolicy adalah batas kuota satu sumber daya untuk satu plan.
+type QuotaPolicy struct {
+	Plan              Plan
+	Resource          QuotaResource
+	Limit             int64 // Batas pemakaian; kuota bersifat soft, melewati batas hanya memicu peringatan
+	WarningThresholds []int // Persentase ambang peringatan, terurut naik (misalnya 80, 95)
+}
+
+// Validate memeriksa bahwa Limit positif dan ambang peringatan berada di 1..100 dan terurut naik.
+func (p QuotaPolicy) Validate() error {
+	if p.Limit <= 0 {
+		return ErrInvalidQuotaPolicy
+	}
+	prev := 0
+	for _, threshold := range p.WarningThresholds {
+		if threshold <= prev || threshold > 100 {
+			return ErrInvalidQuotaPolicy
+		}
+		prev = threshold
+	}
+	return nil
+}
+
+// CrossedThreshold mengembalikan ambang tertinggi yang terlewati saat pemakaian naik dari before ke after.
+// ok bernilai false jika tidak ada ambang yang terlewati.
+func (p QuotaPolicy) CrossedThreshold(before, after int64) (threshold int, ok bool) {
+	for _, t := range p.WarningThresholds {
+		limitAt := p.Limit * int64(t) / 100
+		if before < limitAt && after >= limitAt {
+			threshold, ok = t, true
+		}
+	}
+	return threshold, ok
+}
+
+// QuotaUsage adalah pemakaian saat ini terhadap sebuah QuotaPolicy.
+type QuotaUsage struct {
+	Policy QuotaPolicy
+	Used   int64
+}
+
+// Percent mengembalikan pemakaian dalam persen dari Limit.
+func (u QuotaUsage) Percent() float64 {
+	return float64(u.Used) * 100 / float64(u.Policy.Limit)
+}
+
+// WarningLevel mengembalikan ambang peringatan tertinggi yang sudah dicapai, atau 0.
+func (u QuotaUsage) WarningLevel() int {
+	level := 0
+	for _, t := range u.Policy.WarningThresholds {
+		if u.Used >= u.Policy.Limit*int64(t)/100 {
+			level = t
+		}
+	}
+	return level
+}
+
+// UsageEvent adalah event yang berkaitan dengan pemakaian kuota, misalnya peringatan 80%/95%.
+type UsageEvent struct {
+	Type       string
+	UserID     UserID
+	Plan       Plan
+	Resource   QuotaResource
+	Threshold  int
+	Used       int64
+	Limit      int64
+	OccurredAt time.Time
+}
+
+// QuotaPolicyRepository mendefinisikan kontrak penyimpanan kebijakan kuota per plan.
+type QuotaPolicyRepository interface {
+	// FindByPlan mengembalikan semua kebijakan kuota untuk plan. Slice kosong jika plan tidak dikenal.
+	FindByPlan(ctx context.Context, plan Plan) ([]QuotaPolicy, error)
+
+	// Upsert menyimpan atau mengganti kebijakan kuota untuk (Plan, Resource).
+	Upsert(ctx context.Context, policy QuotaPolicy) error
+}
+
+// UsageEventRecorder mendefinisikan kontrak untuk mencatat UsageEvent
+// agar bisa diteruskan ke pengguna sebagai notifikasi.
+type UsageEventRecorder interface {
+	Record(ctx context.Context, event UsageEvent) error
+}
+
+type planContextKey struct{}
+
+// ContextWithPlan menyimpan plan pengguna yang sedang login ke context.
+func ContextWithPlan(ctx context.Context, plan Plan) context.Context {
+	return context.WithValue(ctx, planContextKey{}, plan)
+}
+
+// PlanFromContext mengambil plan pengguna dari context, atau DefaultPlan jika tidak ada.
+func PlanFromContext(ctx context.Context) Plan {
+	if plan, ok := ctx.Value(planContextKey{}).(Plan); ok && plan != "" {
+		return plan
+	}
+	return DefaultPlan
+}
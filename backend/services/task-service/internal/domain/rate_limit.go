@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimit adalah batas Requests request per Period. Request boleh datang bersamaan sampai
+// Requests sekaligus, lalu kuota terisi kembali secara merata sepanjang Period.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// Enabled melaporkan apakah batas ini berlaku; Requests atau Period nol berarti tanpa batas.
+func (l RateLimit) Enabled() bool {
+	return l.Requests > 0 && l.Period > 0
+}
+
+// RateLimitResult adalah hasil pemeriksaan RateLimiter untuk satu request.
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int           // Request yang masih boleh dikirim segera
+	RetryAfter time.Duration // Jeda sebelum request berikutnya diizinkan; nol jika Allowed
+	ResetAfter time.Duration // Jeda sampai kuota terisi penuh kembali
+}
+
+// RateLimiter mendefinisikan kontrak pembatas laju request per key, di memori proses atau di
+// penyimpanan bersama agar batas berlaku di semua replika.
+type RateLimiter interface {
+	// Allow mencatat satu request untuk key dan melaporkan apakah request tersebut masih dalam
+	// batas limit. Request yang ditolak tidak mengurangi kuota.
+	Allow(ctx context.Context, key string, limit RateLimit) (RateLimitResult, error)
+}
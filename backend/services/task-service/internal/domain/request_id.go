@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+)
+
+// HeaderRequestID adalah header HTTP (dan metadata gRPC, dalam huruf kecil) yang membawa ID
+// korelasi request ke dan dari service lain.
+const HeaderRequestID = "X-Request-ID"
+
+// MaxRequestIDLength adalah panjang ID request dari klien terpanjang yang diterima.
+const MaxRequestIDLength = 128
+
+type requestIDContextKey struct{}
+
+// NewRequestID membuat ID request acak (26 karakter base32).
+func NewRequestID() string {
+	return rand.Text()
+}
+
+// ValidRequestID melaporkan apakah ID request dari klien boleh dipakai ulang: tidak kosong,
+// paling panjang MaxRequestIDLength, dan hanya berisi huruf, angka, serta "-", "_", ".", ":".
+// ID lain diganti dengan NewRequestID agar tidak bisa menyisipkan teks ke log atau header.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > MaxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ContextWithRequestID menyimpan ID request ke context, sehingga ikut dicatat di log dan diteruskan
+// ke pemanggilan service lain.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// RequestIDFromContext mengambil ID request dari context, atau string kosong jika tidak ada.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
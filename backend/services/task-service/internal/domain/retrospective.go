@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RetrospectiveSettings adalah pilihan pengguna untuk retrospektif bulanan otomatis.
+type RetrospectiveSettings struct {
+	UserID    UserID
+	Enabled   bool   // Retrospektif dibuat otomatis setiap awal bulan
+	TimeZone  string // Zona waktu IANA untuk batas bulan dan hari; kosong berarti UTC
+	UpdatedAt time.Time
+}
+
+// Retrospective adalah rangkuman satu bulan kalender task milik pengguna.
+type Retrospective struct {
+	UserID    UserID
+	Month     time.Time // Tengah malam tanggal 1 di zona waktu TimeZone
+	TimeZone  string
+	Completed int // Task yang diselesaikan dalam bulan tersebut
+
+	// Highlights adalah task selesai dengan EstimateMinutes terbesar, lalu yang paling akhir selesai.
+	Highlights []*Task
+
+	// LongestOpen adalah task yang diselesaikan bulan ini setelah paling lama terbuka.
+	LongestOpen []RetrospectiveTask
+
+	// BusiestWeekdays adalah jumlah task selesai per hari dalam seminggu, dari yang paling sibuk.
+	BusiestWeekdays []WeekdayCount
+
+	ActiveDays    int // Hari dengan minimal satu task selesai
+	LongestStreak int // Hari aktif berturut-turut terpanjang dalam bulan tersebut
+	GeneratedAt   time.Time
+}
+
+// RetrospectiveTask adalah task selesai beserta lama waktunya terbuka.
+type RetrospectiveTask struct {
+	Task    *Task
+	OpenFor time.Duration
+}
+
+// WeekdayCount adalah jumlah task yang diselesaikan pada satu hari dalam seminggu.
+type WeekdayCount struct {
+	Weekday time.Weekday
+	Tasks   int
+}
+
+var (
+	ErrRetrospectiveNotFound     = errors.New("retrospective not found")
+	ErrInvalidRetrospectiveMonth = errors.New("month must be a past or current month formatted as YYYY-MM")
+	ErrInvalidTimeZone           = errors.New("time zone must be an IANA time zone name")
+)
+
+// RetrospectiveRepository mendefinisikan kontrak penyimpanan pengaturan dan hasil retrospektif.
+type RetrospectiveRepository interface {
+	// FindSettings mengembalikan pengaturan pengguna, atau pengaturan nonaktif jika belum pernah disimpan.
+	FindSettings(ctx context.Context, userID UserID) (*RetrospectiveSettings, error)
+	SaveSettings(ctx context.Context, settings *RetrospectiveSettings) error
+
+	// FindEnabled mengembalikan pengaturan semua pengguna yang mengaktifkan retrospektif otomatis.
+	FindEnabled(ctx context.Context) ([]*RetrospectiveSettings, error)
+
+	// Save menyimpan retrospektif; retrospektif bulan yang sama untuk pengguna yang sama diganti.
+	Save(ctx context.Context, retro *Retrospective) error
+
+	// Find mencari retrospektif tersimpan untuk bulan month (YYYY-MM).
+	// Mengembalikan ErrRetrospectiveNotFound jika belum dibuat.
+	Find(ctx context.Context, userID UserID, month string) (*Retrospective, error)
+
+	// ListMonths mengembalikan bulan (YYYY-MM) yang retrospektifnya tersimpan, yang terbaru lebih dulu.
+	ListMonths(ctx context.Context, userID UserID) ([]string, error)
+}
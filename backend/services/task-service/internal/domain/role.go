@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Role adalah role aplikasi pengguna. Role dibaca dari claim app_metadata.role JWT atau dari tabel
+// user_roles (lihat RoleRepository); role admin dari salah satu sumber sudah cukup.
+type Role string
+
+const (
+	RoleUser  Role = "user" // Default untuk pengguna tanpa role
+	RoleAdmin Role = "admin"
+)
+
+// Roles adalah role yang dikenal, dari yang paling rendah.
+var Roles = []Role{RoleUser, RoleAdmin}
+
+// Validate mengembalikan ErrInvalidRole jika role tidak dikenal.
+func (r Role) Validate() error {
+	switch r {
+	case RoleUser, RoleAdmin:
+		return nil
+	default:
+		return ErrInvalidRole
+	}
+}
+
+// Permission adalah hak akses endpoint admin. Route admin memeriksa permission, bukan role, agar
+// kebijakan role cukup diubah di rolePermissions.
+type Permission string
+
+const (
+	PermissionManageUsers     Permission = "users:manage"  // Role, arsip, dan pencarian lintas pengguna
+	PermissionViewSystemStats Permission = "system:stats"  // Statistik sistem, laporan integritas, expvar
+	PermissionManageSystem    Permission = "system:manage" // Maintenance, level log, kuota, run integritas
+)
+
+// rolePermissions adalah kebijakan otorisasi: permission yang dimiliki setiap role.
+var rolePermissions = map[Role][]Permission{
+	RoleUser:  nil,
+	RoleAdmin: {PermissionManageUsers, PermissionViewSystemStats, PermissionManageSystem},
+}
+
+// Allows melaporkan apakah role memiliki permission p.
+func (r Role) Allows(p Permission) bool {
+	return slices.Contains(rolePermissions[r], p)
+}
+
+// Permissions mengembalikan salinan permission yang dimiliki role.
+func (r Role) Permissions() []Permission {
+	return slices.Clone(rolePermissions[r])
+}
+
+// UserRole adalah role yang diberikan admin kepada pengguna.
+type UserRole struct {
+	UserID    UserID
+	Role      Role
+	GrantedBy UserID // Admin yang terakhir mengubah role
+	GrantedAt time.Time
+}
+
+var (
+	ErrInvalidRole         = errors.New("invalid role")
+	ErrCannotChangeOwnRole = errors.New("admins cannot change their own role")
+)
+
+// RoleRepository mendefinisikan kontrak penyimpanan role pengguna. Pengguna tanpa baris memiliki
+// RoleUser.
+type RoleRepository interface {
+	// FindRole mengembalikan RoleUser jika pengguna tidak memiliki role tersimpan.
+	FindRole(ctx context.Context, userID UserID) (Role, error)
+
+	// ListRoles mengembalikan semua role tersimpan, urut berdasarkan user ID.
+	ListRoles(ctx context.Context) ([]UserRole, error)
+
+	// SetRole menyimpan atau mengganti role pengguna.
+	SetRole(ctx context.Context, role UserRole) error
+
+	// DeleteRole menghapus role tersimpan sehingga pengguna kembali memiliki RoleUser.
+	DeleteRole(ctx context.Context, userID UserID) error
+}
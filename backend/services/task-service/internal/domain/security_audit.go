@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SecurityEventType adalah jenis kejadian di audit log keamanan.
+type SecurityEventType string
+
+const (
+	SecurityEventTokenRejected SecurityEventType = "token_rejected" // Token tidak valid atau kedaluwarsa
+	SecurityEventIPChanged     SecurityEventType = "ip_changed"     // Pengguna login dari jaringan lain
+	SecurityEventAPIKeyUsed    SecurityEventType = "api_key_used"   // Personal access token dipakai
+
+	// SecurityEventTokenIPRejected: personal access token yang valid dipakai dari IP di luar
+	// allowlist-nya, tanda token mungkin bocor.
+	SecurityEventTokenIPRejected SecurityEventType = "token_ip_rejected"
+)
+
+// Validate mengembalikan ErrInvalidSecurityEventType untuk jenis yang tidak dikenal.
+func (t SecurityEventType) Validate() error {
+	switch t {
+	case SecurityEventTokenRejected, SecurityEventIPChanged, SecurityEventAPIKeyUsed, SecurityEventTokenIPRejected:
+		return nil
+	default:
+		return ErrInvalidSecurityEventType
+	}
+}
+
+// Jenis kredensial pada AuthAttempt.
+const (
+	CredentialJWT                 = "jwt"
+	CredentialPersonalAccessToken = "personal_access_token"
+	CredentialGuest               = "guest"
+)
+
+// AuthAttempt adalah hasil autentikasi satu request, yang diteruskan middleware autentikasi ke
+// audit log keamanan.
+type AuthAttempt struct {
+	Credential string // CredentialJWT, CredentialPersonalAccessToken, atau CredentialGuest
+	UserID     UserID // Kosong jika token ditolak, kecuali karena allowlist IP token
+	TokenID    string // ID personal access token
+	TokenName  string
+	SessionID  string // Claim sesi JWT, jika ada
+	Failure    string // Alasan penolakan; kosong jika berhasil
+	IP         string
+	UserAgent  string
+	Method     string
+	Path       string
+	OccurredAt time.Time
+}
+
+// SecurityEvent adalah satu entri audit log keamanan. Terpisah dari AdminAuditEntry, yang mencatat
+// aksi admin.
+type SecurityEvent struct {
+	ID         int64
+	Type       SecurityEventType
+	UserID     UserID // Kosong jika pengguna tidak diketahui, misalnya token yang ditolak
+	IP         string
+	UserAgent  string
+	Details    map[string]any // Misalnya alasan penolakan, IP sebelumnya, atau ID token
+	OccurredAt time.Time
+}
+
+// SecurityEventFilter adalah kriteria pencarian audit log keamanan. Field kosong tidak memfilter.
+type SecurityEventFilter struct {
+	UserID   UserID
+	Type     SecurityEventType
+	IP       string
+	BeforeID int64 // Cursor: hanya entri dengan ID lebih kecil
+	Limit    int
+}
+
+var ErrInvalidSecurityEventType = errors.New("invalid security event type")
+
+// SecurityAuditRepository mendefinisikan kontrak penyimpanan audit log keamanan.
+type SecurityAuditRepository interface {
+	Record(ctx context.Context, event SecurityEvent) error
+
+	// Find mengembalikan entri yang cocok dengan filter, dari yang terbaru.
+	Find(ctx context.Context, filter SecurityEventFilter) ([]SecurityEvent, error)
+
+	// SwapLastIP menyimpan IP terakhir pengguna dan mengembalikan IP sebelumnya, atau string kosong
+	// jika belum pernah tercatat.
+	SwapLastIP(ctx context.Context, userID UserID, ip string, seenAt time.Time) (string, error)
+
+	// DeleteBefore menghapus entri dan IP terakhir yang lebih lama dari before, lalu mengembalikan
+	// jumlah entri yang dihapus.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
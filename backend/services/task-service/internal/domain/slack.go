@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// SlackEventType adalah jenis notifikasi yang bisa dikirim ke Slack. Berbeda dengan event task,
+// jenis ini menggambarkan kejadian yang berguna di channel tim, bukan setiap perubahan.
+type SlackEventType string
+
+const (
+	SlackEventDue       SlackEventType = "task.due"       // Pengingat sebelum tenggat task
+	SlackEventAssigned  SlackEventType = "task.assigned"  // Task ditugaskan kepada pengguna
+	SlackEventCompleted SlackEventType = "task.completed" // Task diselesaikan
+)
+
+// SlackEventTypes adalah semua SlackEventType yang dikenal.
+var SlackEventTypes = []SlackEventType{SlackEventDue, SlackEventAssigned, SlackEventCompleted}
+
+// MaxSlackReminderLead adalah jarak pengingat tenggat terbesar sebelum tenggat task.
+const MaxSlackReminderLead = MaxEmailReminderLead
+
+// SlackChannel adalah pengaturan notifikasi Slack milik satu pengguna. Pesan dikirim lewat incoming
+// webhook (WebhookURL) atau dengan bot token milik workspace pengguna ke channel (ChannelID).
+type SlackChannel struct {
+	UserID     UserID
+	WebhookURL string // https://hooks.slack.com/services/...; kosong jika memakai bot token
+	BotToken   string // xoxb-...; tidak pernah dikembalikan oleh API
+	ChannelID  string // ID channel Slack untuk mode bot token
+	EventTypes []SlackEventType
+	// ReminderLead adalah jarak pengingat SlackEventDue sebelum tenggat.
+	ReminderLead time.Duration
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Accepts melaporkan apakah channel berlangganan jenis notifikasi tersebut; daftar kosong berarti
+// tidak ada notifikasi.
+func (c *SlackChannel) Accepts(eventType SlackEventType) bool {
+	return slices.Contains(c.EventTypes, eventType)
+}
+
+// SlackReminder adalah pengingat tenggat task yang harus dikirim ke channel Slack pemiliknya.
+type SlackReminder struct {
+	Channel *SlackChannel
+	Task    *Task
+}
+
+var (
+	ErrSlackChannelNotFound = errors.New("slack channel not found")
+	ErrInvalidSlackChannel  = errors.New("invalid slack channel")
+
+	// ErrSlackRateLimited dikembalikan saat pesan ditunda terlalu lama oleh batas kirim per channel
+	// atau oleh Slack (HTTP 429).
+	ErrSlackRateLimited = errors.New("slack rate limit exceeded")
+)
+
+// SlackMessage adalah pesan Slack dengan Block Kit. Text adalah fallback untuk notifikasi dan
+// klien yang tidak menampilkan blocks.
+type SlackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []SlackBlock `json:"blocks,omitempty"`
+}
+
+// SlackBlock adalah satu block Block Kit (section atau context).
+type SlackBlock struct {
+	Type     string      `json:"type"`
+	Text     *SlackText  `json:"text,omitempty"`
+	Fields   []SlackText `json:"fields,omitempty"`
+	Elements []SlackText `json:"elements,omitempty"`
+}
+
+// SlackText adalah objek teks Block Kit, "mrkdwn" atau "plain_text".
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SlackClient mengirim SlackMessage ke Slack. Implementasi wajib membatasi kecepatan kirim per
+// tujuan sesuai batas Slack (sekitar satu pesan per detik per channel).
+type SlackClient interface {
+	SendWebhook(ctx context.Context, webhookURL string, msg SlackMessage) error
+
+	// PostMessage mengirim pesan dengan chat.postMessage memakai bot token.
+	PostMessage(ctx context.Context, botToken, channelID string, msg SlackMessage) error
+}
+
+// SlackChannelRepository mendefinisikan kontrak penyimpanan pengaturan Slack dan klaim notifikasinya.
+type SlackChannelRepository interface {
+	// FindByUserID mengembalikan ErrSlackChannelNotFound jika pengguna belum menghubungkan Slack.
+	FindByUserID(ctx context.Context, userID UserID) (*SlackChannel, error)
+
+	// Save membuat atau mengganti pengaturan Slack pengguna.
+	Save(ctx context.Context, channel *SlackChannel) error
+
+	// Delete mengembalikan ErrSlackChannelNotFound jika pengguna belum menghubungkan Slack.
+	Delete(ctx context.Context, userID UserID) error
+
+	// FindDueReminders mengembalikan paling banyak limit task yang tenggatnya dalam ReminderLead
+	// pemiliknya dari now dan belum diingatkan untuk tenggat tersebut lewat Slack.
+	FindDueReminders(ctx context.Context, now time.Time, limit int) ([]SlackReminder, error)
+
+	// ClaimReminder menandai pengingat Slack untuk tenggat dueAt task sudah dikirim. Mengembalikan
+	// false jika pengingat tersebut sudah diklaim.
+	ClaimReminder(ctx context.Context, taskID string, dueAt, now time.Time) (bool, error)
+
+	// ReleaseReminder membatalkan klaim agar pengingat dicoba lagi.
+	ReleaseReminder(ctx context.Context, taskID string, dueAt time.Time) error
+
+	// ClaimCompletion menandai penyelesaian task pada completedAt sudah dikirim, sehingga update
+	// berikutnya pada task yang sudah selesai tidak mengirim ulang. Mengembalikan false jika
+	// penyelesaian tersebut sudah diklaim.
+	ClaimCompletion(ctx context.Context, taskID string, completedAt time.Time) (bool, error)
+}
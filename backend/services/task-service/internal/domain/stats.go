@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// ProductivityStats adalah statistik produktivitas satu pengguna dalam rentang [From, To).
+type ProductivityStats struct {
+	From time.Time
+	To   time.Time
+
+	Created               int64 // Task yang dibuat dalam rentang
+	CreatedStillCompleted int64 // Task yang dibuat dalam rentang dan sekarang sudah selesai
+	Completed             int64 // Task yang diselesaikan dalam rentang, kapan pun dibuatnya
+	OpenTasks             int64 // Task yang belum selesai saat ini
+
+	// AverageTimeToComplete adalah rata-rata selisih created_at dan completed_at task yang
+	// diselesaikan dalam rentang. Nol jika tidak ada task yang selesai.
+	AverageTimeToComplete time.Duration
+
+	ByDay []DailyActivity // Satu entri per hari kalender, termasuk hari tanpa aktivitas
+
+	Streak CompletionStreak
+}
+
+// CompletionRate adalah bagian task yang dibuat dalam rentang dan sudah selesai (0 sampai 1).
+func (s *ProductivityStats) CompletionRate() float64 {
+	if s.Created == 0 {
+		return 0
+	}
+	return float64(s.CreatedStillCompleted) / float64(s.Created)
+}
+
+// CompletionStreak adalah streak penyelesaian harian pengguna: jumlah hari kalender berturut-turut
+// dengan minimal satu task yang diselesaikan, dihitung di zona waktu pengaturan retrospektif.
+type CompletionStreak struct {
+	Current         int        // Nol jika hari ini maupun kemarin belum ada task yang selesai
+	Longest         int        // Streak terpanjang yang pernah dicapai
+	LastCompletedOn *time.Time // Hari terakhir dengan task selesai; nil jika belum pernah ada
+}
+
+// DailyActivity adalah jumlah task yang dibuat dan diselesaikan pada satu hari kalender.
+type DailyActivity struct {
+	Date      time.Time // Tengah malam di zona waktu yang diminta
+	Created   int64
+	Completed int64
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 )
 
@@ -13,41 +14,321 @@ type UserID string
 
 // Task merepresentasikan entitas tugas dalam sistem.
 type Task struct {
-	ID          string    `json:"id"`          // ID unik untuk task (misalnya, UUID)
-	UserID      UserID    `json:"user_id"`     // ID pengguna yang memiliki task ini
-	Title       string    `json:"title"`       // Judul task
-	Description string    `json:"description"` // Deskripsi task (opsional)
-	Completed   bool      `json:"completed"`   // Status selesai task
-	CreatedAt   time.Time `json:"created_at"`  // Waktu pembuatan task
-	UpdatedAt   time.Time `json:"updated_at"`  // Waktu pembaruan terakhir task
+	ID              string     `json:"id"`                         // ID unik untuk task (misalnya, UUID)
+	UserID          UserID     `json:"user_id"`                    // ID pengguna yang memiliki task ini
+	Title           string     `json:"title"`                      // Judul task
+	Description     string     `json:"description"`                // Deskripsi task (opsional)
+	Completed       bool       `json:"completed"`                  // Status selesai task
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`     // Waktu task diselesaikan, nil jika belum selesai
+	Position        float64    `json:"position"`                   // Urutan manual (drag-and-drop); lebih kecil tampil lebih atas
+	EstimateMinutes *int       `json:"estimate_minutes,omitempty"` // Perkiraan usaha dalam menit, nil jika belum diestimasi
+	SnoozedUntil    *time.Time `json:"snoozed_until,omitempty"`    // Task disembunyikan dari daftar default sampai waktu ini
+	Archived        bool       `json:"archived,omitempty"`         // Task selesai yang disimpan di luar daftar default; berbeda dari hapus
+	ColumnID        *string    `json:"column_id,omitempty"`        // Kolom board Kanban, nil jika belum ditempatkan
+	Priority        *string    `json:"priority,omitempty"`         // Nilai enum EnumTaskPriority, nil jika tanpa prioritas
+	DueAt           *time.Time `json:"due_at,omitempty"`           // Tenggat hasil parse DueText, nil jika tanpa tenggat
+	DueText         *string    `json:"due_text,omitempty"`         // Teks tenggat asli dari pengguna, misalnya "tomorrow 5pm"
+	AssigneeID      *UserID    `json:"assignee_id,omitempty"`      // Pengguna yang ditugaskan mengerjakan task, bisa berbeda dari pemilik
+	Color           *string    `json:"color,omitempty"`            // Salah satu TaskColors, nil jika tanpa warna
+	Icon            *string    `json:"icon,omitempty"`             // Salah satu TaskIcons, nil jika tanpa ikon
+	CreatedAt       time.Time  `json:"created_at"`                 // Waktu pembuatan task
+	UpdatedAt       time.Time  `json:"updated_at"`                 // Waktu pembaruan terakhir task
+}
+
+// SetDue mengisi DueText dan DueAt dari teks tenggat yang di-parse relatif terhadap now (termasuk
+// zona waktunya). String kosong menghapus tenggat.
+func (t *Task) SetDue(text string, now time.Time) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		t.DueAt, t.DueText = nil, nil
+		return nil
+	}
+	due, err := ParseDueText(text, now)
+	if err != nil {
+		return err
+	}
+	t.DueAt, t.DueText = &due, &text
+	return nil
+}
+
+// SetCompleted mengubah status selesai task sekaligus CompletedAt.
+// CompletedAt hanya diisi saat task berpindah dari belum selesai ke selesai, sehingga
+// menandai ulang task yang sudah selesai tidak menggeser waktu penyelesaiannya.
+func (t *Task) SetCompleted(completed bool, now time.Time) {
+	switch {
+	case completed && (!t.Completed || t.CompletedAt == nil):
+		t.CompletedAt = &now
+	case !completed:
+		t.CompletedAt = nil
+	}
+	t.Completed = completed
+}
+
+// MaxSnoozeDuration adalah jarak terjauh SnoozedUntil dari waktu snooze.
+const MaxSnoozeDuration = 365 * 24 * time.Hour
+
+// Snoozed bernilai true jika task masih di-snooze pada waktu now.
+func (t *Task) Snoozed(now time.Time) bool {
+	return t.SnoozedUntil != nil && t.SnoozedUntil.After(now)
+}
+
+// TaskCounters adalah jumlah task milik satu pengguna, dibaca dari counter cache
+// yang diperbarui dalam transaksi yang sama dengan write task.
+type TaskCounters struct {
+	Total int64
+	Open  int64 // Task yang belum selesai
+}
+
+// Completed mengembalikan jumlah task yang sudah selesai.
+func (c TaskCounters) Completed() int64 {
+	return c.Total - c.Open
+}
+
+// TaskSort menentukan urutan daftar task.
+type TaskSort string
+
+const (
+	TaskSortCreated  TaskSort = "created"  // Terbaru di atas (default)
+	TaskSortPosition TaskSort = "position" // Urutan manual hasil reorder
+	TaskSortTitle    TaskSort = "title"    // Judul A-Z sesuai collation bahasa pengguna
+)
+
+// Validate memastikan TaskSort dikenal. Nilai kosong dianggap TaskSortCreated.
+func (s TaskSort) Validate() error {
+	switch s {
+	case "", TaskSortCreated, TaskSortPosition, TaskSortTitle:
+		return nil
+	}
+	return ErrInvalidTaskSort
+}
+
+// TaskOrder menentukan urutan daftar task beserta bahasa yang dipakai untuk membandingkan judul.
+type TaskOrder struct {
+	Sort   TaskSort
+	Locale string // Tag bahasa BCP 47 (misalnya "id" atau "de-DE"), hanya dipakai TaskSortTitle
+
+	// HideSnoozedAt, jika tidak nol, menyembunyikan task yang masih di-snooze pada waktu tersebut.
+	HideSnoozedAt time.Time
+	HideArchived  bool // Sembunyikan task yang diarsipkan
+}
+
+// TaskPageQuery adalah parameter keyset pagination untuk daftar task.
+type TaskPageQuery struct {
+	Limit  int    // Jumlah task maksimum per halaman
+	Cursor string // Cursor opaque dari TaskPage.NextCursor sebelumnya; kosong berarti halaman pertama
+
+	// HideSnoozedAt, jika tidak nol, menyembunyikan task yang masih di-snooze pada waktu tersebut.
+	HideSnoozedAt time.Time
+	HideArchived  bool // Sembunyikan task yang diarsipkan
+}
+
+// TaskPage adalah satu halaman daftar task, diurutkan dari yang terbaru.
+type TaskPage struct {
+	Tasks      []*Task
+	NextCursor string // Kosong jika tidak ada halaman berikutnya
+}
+
+// TaskChanges adalah task yang berubah sejak sebuah cursor sync, beserta cursor berikutnya.
+// Cursor adalah posisi di urutan transaksi database (lihat FindChangedSince), bukan waktu.
+type TaskChanges struct {
+	Tasks  []*Task
+	Cursor int64
+}
+
+// TaskGroupBy menentukan cara daftar task dikelompokkan di server.
+type TaskGroupBy string
+
+const (
+	TaskGroupByStatus   TaskGroupBy = "status"   // Key: open atau completed
+	TaskGroupByColumn   TaskGroupBy = "column"   // Key: ID kolom board, atau TaskGroupNone
+	TaskGroupByPriority TaskGroupBy = "priority" // Key: nilai task_priority, atau TaskGroupNone
+)
+
+// Key grup untuk TaskGroupByStatus, dan key untuk task tanpa kolom board atau tanpa prioritas.
+const (
+	TaskGroupOpen      = "open"
+	TaskGroupCompleted = "completed"
+	TaskGroupNone      = "none"
+)
+
+// Validate memastikan TaskGroupBy dikenal.
+func (g TaskGroupBy) Validate() error {
+	switch g {
+	case TaskGroupByStatus, TaskGroupByColumn, TaskGroupByPriority:
+		return nil
+	}
+	return ErrInvalidTaskGroupBy
+}
+
+// TaskGroupQuery adalah parameter daftar task yang dikelompokkan. Setiap grup dipaginasi sendiri:
+// halaman berikutnya sebuah grup diminta dengan Group dan Cursor dari TaskGroup.NextCursor.
+type TaskGroupQuery struct {
+	GroupBy TaskGroupBy
+	Limit   int    // Jumlah task maksimum per grup
+	Group   string // Jika diisi, hanya grup dengan key ini yang dikembalikan
+	Cursor  string // Cursor opaque dari TaskGroup.NextCursor; hanya bersama Group
+
+	// HideSnoozedAt, jika tidak nol, menyembunyikan task yang masih di-snooze pada waktu tersebut.
+	HideSnoozedAt time.Time
+	HideArchived  bool // Sembunyikan task yang diarsipkan
+}
+
+// TaskGroup adalah satu grup task, diurutkan dari yang terbaru. Count adalah jumlah seluruh task
+// di grup, bukan hanya yang ada di halaman ini.
+type TaskGroup struct {
+	Key        string
+	Count      int64
+	Tasks      []*Task
+	NextCursor string // Kosong jika tidak ada halaman berikutnya
 }
 
 // Definisikan error domain yang umum
 var (
 	ErrTaskNotFound       = errors.New("task not found")
 	ErrTaskUpdateConflict = errors.New("task update conflict") // Contoh jika ada pemeriksaan versi
+	ErrTaskTitleRequired  = errors.New("title cannot be empty")
+	ErrInvalidTaskID      = errors.New("invalid task id")
+	ErrInvalidCursor      = errors.New("invalid pagination cursor")
+	ErrInvalidTaskSort    = errors.New("invalid task sort")
+	ErrInvalidTaskGroupBy = errors.New("invalid task grouping")
+	ErrInvalidReorder     = errors.New("invalid reorder request")
+	ErrSearchQueryEmpty   = errors.New("search query cannot be empty")
+	ErrInvalidSnooze      = errors.New("snooze must end in the future and within 365 days")
+	ErrTaskNotCompleted   = errors.New("only completed tasks can be archived")
+	ErrInvalidAssignee    = errors.New("assignee must be the list owner or a collaborator")
+	ErrTaskLimitReached   = errors.New("task limit reached")
 	// Tambahkan error domain lain jika diperlukan
 )
 
 // TaskRepository mendefinisikan kontrak untuk operasi data Task.
 // Layer infrastructure (persistence) akan mengimplementasikan interface ini.
 type TaskRepository interface {
-	// Save menyimpan task baru ke dalam penyimpanan.
+	// Save menyimpan task baru ke dalam penyimpanan. Mengembalikan ErrTaskLimitReached jika
+	// pemilik sudah mencapai batas dari SetTaskLimit; begitu juga SaveOrUpdate untuk task baru.
 	Save(ctx context.Context, task *Task) error
 
+	// SaveOrUpdate menyimpan task dengan ID yang sudah ditentukan (misalnya di-generate klien).
+	// Jika ID sudah ada dan milik pengguna yang sama, Title, Description, Completed, dan UpdatedAt
+	// diperbarui; CreatedAt diisi ulang dari nilai yang tersimpan. created bernilai true jika task baru.
+	// Mengembalikan ErrTaskUpdateConflict jika ID sudah dipakai oleh pengguna lain.
+	SaveOrUpdate(ctx context.Context, task *Task) (created bool, err error)
+
 	// FindByID mencari task berdasarkan ID uniknya.
 	// Mengembalikan ErrTaskNotFound jika tidak ditemukan.
 	FindByID(ctx context.Context, id string) (*Task, error)
 
-	// FindByUserID mencari semua task yang dimiliki oleh pengguna tertentu.
-	FindByUserID(ctx context.Context, userID UserID) ([]*Task, error)
+	// FindByIDs mencari beberapa task sekaligus tanpa urutan tertentu. ID yang tidak ada dilewati.
+	FindByIDs(ctx context.Context, ids []string) ([]*Task, error)
+
+	// FindByUserID mencari semua task yang dimiliki oleh pengguna tertentu dengan urutan order.
+	// Locale yang tidak didukung memakai urutan bahasa netral, bukan error.
+	FindByUserID(ctx context.Context, userID UserID, order TaskOrder) ([]*Task, error)
+
+	// CountByUserID menghitung jumlah task milik pengguna. Dipakai untuk pemantauan kuota.
+	CountByUserID(ctx context.Context, userID UserID) (int64, error)
+
+	// SetTaskLimit membatasi jumlah task milik pengguna menjadi paling banyak maxTasks. Batas
+	// diperiksa dalam transaksi yang sama dengan setiap task baru, sehingga write bersamaan tidak
+	// bisa melewatinya. Task yang dipindahkan ke pengguna tidak diperiksa. expiresAt yang tidak nol
+	// menandai pengguna sementara (tamu), yang dikembalikan FindExpiredTaskLimits setelah waktu itu.
+	SetTaskLimit(ctx context.Context, userID UserID, maxTasks int64, expiresAt time.Time) error
+
+	// FindExpiredTaskLimits mengembalikan paling banyak limit pengguna yang expiresAt dari
+	// SetTaskLimit-nya sudah lewat pada now, dari yang paling lama.
+	FindExpiredTaskLimits(ctx context.Context, now time.Time, limit int) ([]UserID, error)
+
+	// CountVisibleByUserID menghitung task milik pengguna dengan filter yang sama seperti
+	// FindByUserID: task yang masih di-snooze pada hideSnoozedAt (jika tidak nol) dan, jika
+	// hideArchived, task yang diarsipkan tidak ikut dihitung.
+	CountVisibleByUserID(ctx context.Context, userID UserID, hideSnoozedAt time.Time, hideArchived bool) (int64, error)
+
+	// CountersByUserID mengembalikan counter cache task milik pengguna (total dan belum selesai).
+	CountersByUserID(ctx context.Context, userID UserID) (TaskCounters, error)
+
+	// FindCompletionStreak mengembalikan streak penyelesaian harian pengguna yang dijaga di transaksi
+	// yang sama dengan write yang menyelesaikan task. Pengguna tanpa task selesai mendapat streak nol.
+	FindCompletionStreak(ctx context.Context, userID UserID) (CompletionStreak, error)
+
+	// FindPageByUserID mengambil satu halaman task milik pengguna dengan keyset pagination.
+	// Mengembalikan ErrInvalidCursor jika cursor tidak bisa dibaca.
+	FindPageByUserID(ctx context.Context, userID UserID, query TaskPageQuery) (*TaskPage, error)
+
+	// FindGroupsByUserID mengambil task milik pengguna yang dikelompokkan menurut query.GroupBy,
+	// paling banyak query.Limit task per grup. Grup tanpa task tidak dikembalikan.
+	// Mengembalikan ErrInvalidCursor jika cursor tidak bisa dibaca.
+	FindGroupsByUserID(ctx context.Context, userID UserID, query TaskGroupQuery) ([]TaskGroup, error)
+
+	// FindCompletedBetween mencari task milik pengguna yang diselesaikan dalam rentang [from, to),
+	// diurutkan dari yang terakhir diselesaikan. Dipakai untuk tampilan "selesai hari ini" dan statistik.
+	FindCompletedBetween(ctx context.Context, userID UserID, from, to time.Time) ([]*Task, error)
+
+	// FindArchivedByUserID mencari task milik pengguna yang diarsipkan, dari yang terakhir diselesaikan.
+	FindArchivedByUserID(ctx context.Context, userID UserID) ([]*Task, error)
+
+	// FindDueByUserID mencari paling banyak limit task milik pengguna yang belum diarsipkan dan
+	// bertenggat sejak from, diurutkan dari tenggat terdekat.
+	FindDueByUserID(ctx context.Context, userID UserID, from time.Time, limit int) ([]*Task, error)
+
+	// FindByAssignee mencari task yang ditugaskan kepada assigneeID di daftar miliknya sendiri atau
+	// daftar yang masih dibagikan kepadanya, dengan urutan order.
+	FindByAssignee(ctx context.Context, assigneeID UserID, order TaskOrder) ([]*Task, error)
+
+	// Search mencari task milik pengguna dengan full-text search pada judul dan deskripsi,
+	// diurutkan dari yang paling relevan. Query dan teks task dinormalisasi dengan cara yang sama
+	// (huruf kecil, tanpa diakritik, emoji sebagai token), sehingga "cafe" cocok dengan "Café".
+	Search(ctx context.Context, userID UserID, query string, limit int) ([]*Task, error)
+
+	// FindChangedSince mencari task milik pengguna yang dibuat atau diubah sejak cursor since (0
+	// berarti semua task), diurutkan dari perubahan paling lama, beserta cursor berikutnya. Cursor
+	// ditentukan database, bukan jam replika, sehingga transaksi yang commit setelah pemanggilan
+	// ini tidak pernah terlewat; sebagai gantinya task yang sama bisa terkirim lagi pada
+	// pemanggilan berikutnya. Dipakai oleh endpoint sinkronisasi (delta sync).
+	FindChangedSince(ctx context.Context, userID UserID, since int64) (*TaskChanges, error)
+
+	// SummarizeEstimates menjumlahkan EstimateMinutes task yang belum selesai, serta task yang
+	// diselesaikan dalam rentang [from, to) per hari kalender di zona waktu loc.
+	SummarizeEstimates(ctx context.Context, userID UserID, from, to time.Time, loc *time.Location) (*EstimateSummary, error)
+
+	// SummarizeProductivity menghitung statistik produktivitas dalam rentang [from, to) dengan
+	// agregat di penyimpanan. ByDay hanya berisi hari yang punya aktivitas, dikelompokkan per hari
+	// kalender di zona waktu loc.
+	SummarizeProductivity(ctx context.Context, userID UserID, from, to time.Time, loc *time.Location) (*ProductivityStats, error)
 
 	// Update memperbarui data task yang sudah ada di penyimpanan.
-	// Sebaiknya hanya field yang relevan (Title, Description, Completed, UpdatedAt) yang diupdate.
+	// Sebaiknya hanya field yang relevan (Title, Description, Completed, CompletedAt, EstimateMinutes,
+	// SnoozedUntil, Archived, Priority, tenggat, AssigneeID, Color, Icon, UpdatedAt) yang diupdate.
 	// Mengembalikan ErrTaskNotFound jika task tidak ada.
 	Update(ctx context.Context, task *Task) error
 
+	// Reorder mengatur ulang Position task milik pengguna mengikuti urutan ids, lalu mengembalikan
+	// task tersebut sesuai urutan baru. Task yang tidak disebut di ids diletakkan setelahnya dengan
+	// urutan relatif tetap. UpdatedAt task yang posisinya berubah diisi now.
+	// Mengembalikan ErrTaskNotFound jika ada ID yang tidak ditemukan atau milik pengguna lain.
+	Reorder(ctx context.Context, userID UserID, ids []string, now time.Time) ([]*Task, error)
+
+	// MoveAfter memindahkan task tepat setelah task afterID; afterID kosong berarti ke posisi paling atas.
+	// Mengembalikan ErrTaskNotFound jika salah satu task tidak ditemukan atau milik pengguna lain.
+	MoveAfter(ctx context.Context, userID UserID, taskID, afterID string, now time.Time) (*Task, error)
+
+	// CompleteMany menandai semua task dalam ids sebagai selesai dalam satu transaksi (semua atau tidak
+	// sama sekali). previous berisi status selesai sebelumnya dari task yang berubah, sedangkan tasks
+	// berisi keadaan akhir semua task dalam ids. CompletedAt task yang sudah selesai tidak diubah.
+	// Mengembalikan *TasksNotFoundError jika ada ID yang tidak ditemukan atau milik pengguna lain.
+	CompleteMany(ctx context.Context, userID UserID, ids []string, now time.Time) (previous []TaskCompletion, tasks []*Task, err error)
+
+	// RestoreCompletion mengembalikan status selesai task ke nilai pada completions dalam satu transaksi.
+	// Task yang sudah dihapus dilewati.
+	RestoreCompletion(ctx context.Context, userID UserID, completions []TaskCompletion, now time.Time) ([]*Task, error)
+
+	// RestoreTasks menyisipkan kembali task apa adanya (ID, Position, dan timestamp dipertahankan),
+	// misalnya dari arsip. Task yang ID-nya sudah ada dilewati. Mengembalikan jumlah task yang disisipkan.
+	RestoreTasks(ctx context.Context, tasks []*Task) (int64, error)
+
 	// Delete menghapus task berdasarkan ID uniknya dari penyimpanan.
 	// Mengembalikan ErrTaskNotFound jika task tidak ada.
 	Delete(ctx context.Context, id string) error
+
+	// DeleteByUserID menghapus semua task milik pengguna dalam satu statement
+	// dan mengembalikan jumlah task yang terhapus. Dipakai saat penghapusan akun.
+	DeleteByUserID(ctx context.Context, userID UserID) (int64, error)
 }
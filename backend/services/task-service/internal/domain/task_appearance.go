@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+)
+
+// TaskColors adalah warna task yang diizinkan, sesuai nama warna palet UI. Nilai lain (misalnya
+// kode hex) ditolak agar tampilan tetap konsisten dengan tema terang dan gelap.
+var TaskColors = []string{
+	"red", "orange", "amber", "yellow", "lime", "green", "teal",
+	"cyan", "blue", "indigo", "violet", "purple", "pink", "gray",
+}
+
+// TaskIcons adalah nama ikon task yang diizinkan, sesuai set ikon UI.
+var TaskIcons = []string{
+	"star", "flag", "bookmark", "heart", "bell", "calendar", "clock", "check",
+	"home", "briefcase", "cart", "book", "code", "bug", "lightbulb", "rocket",
+	"phone", "mail", "music", "money",
+}
+
+var (
+	ErrInvalidTaskColor = errors.New("color is not one of the allowed task colors")
+	ErrInvalidTaskIcon  = errors.New("icon is not one of the allowed task icons")
+)
+
+// ValidateTaskAppearance memastikan color dan icon ada di TaskColors dan TaskIcons. nil berarti
+// tidak diisi.
+func ValidateTaskAppearance(color, icon *string) error {
+	if color != nil && !slices.Contains(TaskColors, *color) {
+		return ErrInvalidTaskColor
+	}
+	if icon != nil && !slices.Contains(TaskIcons, *icon) {
+		return ErrInvalidTaskIcon
+	}
+	return nil
+}
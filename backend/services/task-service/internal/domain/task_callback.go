@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// MaxCallbacksPerTask adalah jumlah callback maksimum yang menunggu pada satu task.
+const MaxCallbacksPerTask = 5
+
+// TaskCallbackEvent adalah nilai header X-Webhook-Event pada request callback.
+const TaskCallbackEvent = "task.completed"
+
+// TaskCallback adalah URL yang dipanggil sekali saat task selesai, misalnya oleh skrip yang
+// menunggu persetujuan manusia. Callback dihapus setelah dipanggil.
+type TaskCallback struct {
+	ID        string
+	UserID    UserID
+	TaskID    string
+	URL       string
+	Secret    string // Kunci HMAC untuk header signature; hanya ditampilkan saat dibuat
+	CreatedAt time.Time
+}
+
+var (
+	ErrTaskCallbackNotFound     = errors.New("task callback not found")
+	ErrInvalidTaskCallback      = errors.New("invalid task callback")
+	ErrTaskCallbackLimitReached = errors.New("task callback limit reached")
+	ErrTaskAlreadyCompleted     = errors.New("task is already completed")
+)
+
+// TaskCallbackRepository mendefinisikan kontrak penyimpanan callback task.
+type TaskCallbackRepository interface {
+	Save(ctx context.Context, callback *TaskCallback) error
+
+	// FindByTaskID mengembalikan callback yang menunggu pada task, yang paling lama lebih dulu.
+	FindByTaskID(ctx context.Context, taskID string) ([]*TaskCallback, error)
+
+	// ClaimByTaskID menghapus lalu mengembalikan semua callback pada task secara atomik, sehingga
+	// setiap callback hanya dipanggil sekali walaupun event penyelesaian diterima berulang kali.
+	ClaimByTaskID(ctx context.Context, taskID string) ([]*TaskCallback, error)
+
+	// Delete menghapus callback milik pengguna pada task. Mengembalikan ErrTaskCallbackNotFound
+	// jika tidak ada.
+	Delete(ctx context.Context, userID UserID, taskID, id string) error
+}
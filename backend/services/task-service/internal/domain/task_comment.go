@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCommentLength adalah panjang isi komentar maksimum dalam karakter.
+const MaxCommentLength = 4000
+
+// MaxMentionsPerComment membatasi jumlah pengguna yang di-mention dalam satu komentar, karena
+// setiap mention mengirim notifikasi.
+const MaxMentionsPerComment = 20
+
+// maxMentionLength sama dengan panjang ID pengguna maksimum.
+const maxMentionLength = 255
+
+// TaskComment adalah komentar pada task. Body berisi Markdown dan boleh me-mention pengguna lain
+// dengan @ diikuti ID pengguna, misalnya "@3f1c..."; Mentions berisi pengguna yang diberi tahu.
+type TaskComment struct {
+	ID        string    `json:"id"`
+	TaskID    string    `json:"task_id"`
+	AuthorID  UserID    `json:"author_id"`
+	Body      string    `json:"body"`
+	Mentions  []UserID  `json:"mentions,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+var (
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrInvalidComment   = errors.New("invalid comment")
+	ErrCommentForbidden = errors.New("only the author or the task owner can delete a comment")
+)
+
+// ParseMentions mengembalikan ID pengguna unik yang di-mention di body sesuai urutan kemunculan,
+// paling banyak MaxMentionsPerComment. Mention adalah @ yang diikuti huruf ASCII, angka, '_', atau
+// '-', dan tidak didahului huruf, angka, atau titik, sehingga alamat email tidak ikut.
+func ParseMentions(body string) []UserID {
+	var mentions []UserID
+	for i := 0; i < len(body); i++ {
+		if body[i] != '@' {
+			continue
+		}
+		if i > 0 && (isMentionByte(body[i-1]) || body[i-1] == '.' || body[i-1] == '@' || body[i-1] >= utf8.RuneSelf) {
+			continue
+		}
+		end := i + 1
+		for end < len(body) && isMentionByte(body[end]) {
+			end++
+		}
+		id := UserID(body[i+1 : end])
+		i = end - 1
+		if id == "" || len(id) > maxMentionLength || slices.Contains(mentions, id) {
+			continue
+		}
+		mentions = append(mentions, id)
+		if len(mentions) == MaxMentionsPerComment {
+			break
+		}
+	}
+	return mentions
+}
+
+func isMentionByte(b byte) bool {
+	return b == '_' || b == '-' || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+// TaskCommentRepository mendefinisikan kontrak penyimpanan komentar dan mention-nya.
+type TaskCommentRepository interface {
+	// Save menyimpan komentar beserta catatan mention untuk comment.Mentions dalam satu transaksi.
+	Save(ctx context.Context, comment *TaskComment) error
+
+	// FindByID mencari komentar. Mengembalikan ErrCommentNotFound jika tidak ada.
+	FindByID(ctx context.Context, id string) (*TaskComment, error)
+
+	// FindByTaskID mengembalikan komentar task, dari yang paling lama.
+	FindByTaskID(ctx context.Context, taskID string) ([]*TaskComment, error)
+
+	// FindByTaskIDs sama dengan FindByTaskID untuk beberapa task sekaligus.
+	FindByTaskIDs(ctx context.Context, taskIDs []string) ([]*TaskComment, error)
+
+	// RestoreComments menyisipkan kembali komentar beserta mention-nya apa adanya dan mengembalikan
+	// jumlah komentar yang disisipkan. ID yang sudah ada dilewati, begitu juga komentar yang task-nya
+	// bukan milik ownerID atau penulisnya bukan pemilik maupun kolaborator daftar.
+	RestoreComments(ctx context.Context, ownerID UserID, comments []*TaskComment) (int64, error)
+
+	// FindMentioning mengembalikan komentar terbaru yang me-mention userID, hanya dari daftar task
+	// milik userID atau yang masih dibagikan kepadanya.
+	FindMentioning(ctx context.Context, userID UserID, limit int) ([]*TaskComment, error)
+
+	// FindByAuthor mengembalikan paling banyak limit komentar terbaru yang ditulis authorID, di task
+	// milik siapa pun.
+	FindByAuthor(ctx context.Context, authorID UserID, limit int) ([]*TaskComment, error)
+
+	// Delete menghapus komentar beserta mention-nya. Mengembalikan ErrCommentNotFound jika tidak ada.
+	Delete(ctx context.Context, id string) error
+}
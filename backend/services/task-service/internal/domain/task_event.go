@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// TaskEventType adalah jenis perubahan yang terjadi pada task.
+type TaskEventType string
+
+const (
+	TaskCreated TaskEventType = "task.created"
+	TaskUpdated TaskEventType = "task.updated"
+	TaskDeleted TaskEventType = "task.deleted"
+	TaskMoved   TaskEventType = "task.moved" // Task berpindah kolom board; Task.ColumnID berisi kolom baru
+
+	// TaskMentioned dikirim ke pengguna yang di-mention di komentar; UserID adalah pengguna yang
+	// di-mention (bukan pemilik task) dan Comment berisi komentarnya.
+	TaskMentioned TaskEventType = "task.mentioned"
+
+	// TaskAssigned dikirim ke pengguna yang baru ditugaskan pada task oleh pengguna lain; seperti
+	// TaskMentioned, UserID adalah penerima tugas, bukan pemilik task.
+	TaskAssigned TaskEventType = "task.assigned"
+)
+
+// TaskEvent merepresentasikan satu perubahan task yang disebarkan ke subscriber (misalnya klien WebSocket).
+type TaskEvent struct {
+	ID         int64         `json:"id"` // Nomor urut event, diisi oleh publisher
+	Type       TaskEventType `json:"type"`
+	TaskID     string        `json:"task_id"`
+	UserID     UserID        `json:"user_id"`
+	Task       *Task         `json:"task,omitempty"`    // Snapshot task setelah perubahan, nil untuk TaskDeleted
+	Comment    *TaskComment  `json:"comment,omitempty"` // Hanya untuk TaskMentioned
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+// NewTaskEvent membuat TaskEvent untuk task yang baru saja berubah.
+func NewTaskEvent(eventType TaskEventType, task *Task) TaskEvent {
+	event := TaskEvent{
+		Type:       eventType,
+		TaskID:     task.ID,
+		UserID:     task.UserID,
+		OccurredAt: time.Now(),
+	}
+	if eventType != TaskDeleted {
+		event.Task = task
+	}
+	return event
+}
+
+// NewTaskMentionEvent membuat TaskEvent TaskMentioned untuk pengguna mentioned.
+func NewTaskMentionEvent(task *Task, comment *TaskComment, mentioned UserID) TaskEvent {
+	event := NewTaskEvent(TaskMentioned, task)
+	event.UserID = mentioned
+	event.Comment = comment
+	return event
+}
+
+// NewTaskAssignEvent membuat TaskEvent TaskAssigned untuk pengguna assignee.
+func NewTaskAssignEvent(task *Task, assignee UserID) TaskEvent {
+	event := NewTaskEvent(TaskAssigned, task)
+	event.UserID = assignee
+	return event
+}
+
+// TaskEventPublisher mendefinisikan kontrak untuk menyebarkan TaskEvent.
+// Layer infrastructure (misalnya Postgres LISTEN/NOTIFY atau broker) akan mengimplementasikan interface ini.
+type TaskEventPublisher interface {
+	// Publish menyebarkan event ke semua replika task-service.
+	Publish(ctx context.Context, event TaskEvent) error
+}
+
+// TaskEventSubscriber mendefinisikan kontrak untuk menerima TaskEvent milik satu pengguna yang
+// sampai di replika ini, misalnya untuk koneksi WebSocket.
+type TaskEventSubscriber interface {
+	// Subscribe mengembalikan channel event milik userID dan fungsi untuk berhenti berlangganan.
+	// Channel ditutup saat berhenti berlangganan atau saat subscriber terlalu lambat.
+	Subscribe(userID UserID) (<-chan TaskEvent, func())
+}
+
+// ErrTaskEventsExpired dikembalikan saat event setelah ID tertentu mungkin sudah dihapus karena
+// melewati masa retensi, sehingga klien harus resync penuh.
+var ErrTaskEventsExpired = errors.New("task events expired")
+
+// TaskEventLog mendefinisikan kontrak untuk membaca ulang TaskEvent yang sudah disimpan, misalnya
+// untuk melanjutkan stream Server-Sent Events dari Last-Event-ID.
+type TaskEventLog interface {
+	// FindAfter mengembalikan paling banyak limit event milik userID dengan ID lebih besar dari
+	// afterID, urut ID. Mengembalikan ErrTaskEventsExpired jika event setelah afterID mungkin
+	// sudah dihapus.
+	FindAfter(ctx context.Context, userID UserID, afterID int64, limit int) ([]TaskEvent, error)
+}
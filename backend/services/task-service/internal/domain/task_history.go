@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrRevisionNotFound = errors.New("task revision not found")
+
+// FieldChange adalah perubahan satu field task. Old bernilai nil pada revisi pertama.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// TaskSnapshot adalah nilai field task yang dilacak riwayatnya, setelah sebuah revisi.
+type TaskSnapshot struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// TaskRevision adalah satu revisi task beserta field yang berubah.
+type TaskRevision struct {
+	TaskID     string
+	Revision   int
+	ActorID    UserID                 // Pelaku perubahan
+	Changes    map[string]FieldChange // Key: title, description, completed, completed_at
+	Snapshot   TaskSnapshot
+	OccurredAt time.Time
+}
+
+// TaskHistoryRepository mendefinisikan kontrak pembacaan riwayat revisi task.
+// Revisi dicatat oleh penyimpanan setiap kali task dibuat atau field yang dilacak berubah.
+type TaskHistoryRepository interface {
+	// FindByTaskID mengembalikan semua revisi task, dari yang terbaru.
+	FindByTaskID(ctx context.Context, taskID string) ([]TaskRevision, error)
+
+	// FindRevision mengembalikan satu revisi task, atau ErrRevisionNotFound.
+	FindRevision(ctx context.Context, taskID string, revision int) (*TaskRevision, error)
+}
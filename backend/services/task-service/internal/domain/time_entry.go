@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// TimeEntry adalah satu sesi timer pada task. StoppedAt nil berarti timer masih berjalan;
+// setiap pengguna punya paling banyak satu timer yang berjalan.
+type TimeEntry struct {
+	ID        string     `json:"id"`
+	UserID    UserID     `json:"user_id"`
+	TaskID    string     `json:"task_id"`
+	StartedAt time.Time  `json:"started_at"`
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+}
+
+// Running bernilai true jika timer belum dihentikan.
+func (e *TimeEntry) Running() bool {
+	return e.StoppedAt == nil
+}
+
+// Duration mengembalikan lama sesi; timer yang masih berjalan dihitung sampai now.
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	end := now
+	if e.StoppedAt != nil {
+		end = *e.StoppedAt
+	}
+	return max(end.Sub(e.StartedAt), 0)
+}
+
+var (
+	ErrTimerAlreadyRunning = errors.New("a timer is already running")
+	ErrTimerNotRunning     = errors.New("no timer is running")
+)
+
+// TimeEntryRepository mendefinisikan kontrak penyimpanan sesi timer.
+type TimeEntryRepository interface {
+	// Start menyimpan entry yang sedang berjalan. Mengembalikan ErrTimerAlreadyRunning jika
+	// pengguna sudah punya timer yang berjalan; batas ini dijaga oleh database.
+	Start(ctx context.Context, entry *TimeEntry) error
+
+	// Stop menghentikan timer pengguna yang berjalan pada task. Mengembalikan ErrTimerNotRunning
+	// jika tidak ada.
+	Stop(ctx context.Context, userID UserID, taskID string, stoppedAt time.Time) (*TimeEntry, error)
+
+	// FindRunning mengembalikan timer pengguna yang berjalan, atau ErrTimerNotRunning.
+	FindRunning(ctx context.Context, userID UserID) (*TimeEntry, error)
+
+	// FindByTaskID mengembalikan semua sesi task, yang paling lama lebih dulu.
+	FindByTaskID(ctx context.Context, taskID string) ([]*TimeEntry, error)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// TodoistExport adalah data akun Todoist dalam format response Sync API (resource projects, items,
+// dan labels). Field lain pada dokumen diabaikan.
+type TodoistExport struct {
+	Projects []TodoistProject `json:"projects"`
+	Items    []TodoistItem    `json:"items"`
+	Labels   []TodoistLabel   `json:"labels"`
+}
+
+// TodoistProject adalah satu project Todoist.
+type TodoistProject struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ChildOrder int    `json:"child_order"`
+	IsArchived bool   `json:"is_archived"`
+	IsDeleted  bool   `json:"is_deleted"`
+}
+
+// TodoistItem adalah satu task Todoist.
+type TodoistItem struct {
+	ID          string      `json:"id"`
+	ProjectID   string      `json:"project_id"`
+	ParentID    *string     `json:"parent_id"` // Task induk untuk sub-task; nil untuk task level atas
+	Content     string      `json:"content"`
+	Description string      `json:"description"`
+	Priority    int         `json:"priority"` // 1 (normal) sampai 4 (urgent)
+	Due         *TodoistDue `json:"due"`
+	Labels      []string    `json:"labels"` // Nama label
+	ChildOrder  int         `json:"child_order"`
+	Checked     bool        `json:"checked"`
+	IsDeleted   bool        `json:"is_deleted"`
+}
+
+// TodoistDue adalah tenggat task Todoist. Date berisi "2006-01-02" untuk tenggat tanpa jam,
+// "2006-01-02T15:04:05" untuk jam lokal Timezone (atau zona waktu pengguna jika Timezone kosong),
+// atau "2006-01-02T15:04:05Z" untuk waktu UTC. Untuk tenggat berulang, Date adalah kemunculan
+// berikutnya.
+type TodoistDue struct {
+	Date        string  `json:"date"`
+	Timezone    *string `json:"timezone"`
+	String      string  `json:"string"`
+	IsRecurring bool    `json:"is_recurring"`
+}
+
+// TodoistLabel adalah satu label personal Todoist.
+type TodoistLabel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsDeleted bool   `json:"is_deleted"`
+}
+
+var (
+	ErrInvalidTodoistImport = errors.New("invalid todoist import")
+	ErrTodoistUnauthorized  = errors.New("todoist rejected the api token")
+)
+
+// TodoistClient membaca data akun Todoist dengan API token pengguna. Token yang ditolak
+// dikembalikan sebagai ErrTodoistUnauthorized.
+type TodoistClient interface {
+	FetchExport(ctx context.Context, apiToken string) (*TodoistExport, error)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// UndoBulkComplete adalah jenis UndoOperation untuk menandai banyak task selesai sekaligus.
+const UndoBulkComplete = "bulk.complete"
+
+// ErrUndoUnavailable dikembalikan jika token undo tidak dikenal, sudah kedaluwarsa, atau sudah dipakai.
+var ErrUndoUnavailable = errors.New("undo token expired or already used")
+
+// TaskCompletion adalah status selesai satu task, dipakai untuk menyimpan state sebelum operasi bulk.
+type TaskCompletion struct {
+	TaskID      string     `json:"task_id"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// UndoOperation adalah operasi bulk yang masih bisa dibatalkan sampai ExpiresAt.
+type UndoOperation struct {
+	Token       string
+	UserID      UserID
+	Kind        string
+	Completions []TaskCompletion // State task sebelum operasi
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// UndoRepository mendefinisikan kontrak penyimpanan UndoOperation.
+type UndoRepository interface {
+	// Save menyimpan operasi baru.
+	Save(ctx context.Context, op UndoOperation) error
+
+	// Take mengambil sekaligus menghapus operasi milik pengguna, sehingga token hanya bisa dipakai sekali.
+	// Mengembalikan ErrUndoUnavailable jika token tidak ada atau sudah lewat ExpiresAt pada waktu now.
+	Take(ctx context.Context, userID UserID, token string, now time.Time) (*UndoOperation, error)
+}
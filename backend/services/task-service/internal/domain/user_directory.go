@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// MaxUserProfileLookup adalah jumlah ID maksimum dalam satu pencarian profil.
+const MaxUserProfileLookup = 100
+
+// ErrInvalidUserLookup dikembalikan jika pencarian profil tidak berisi ID atau berisi lebih dari
+// MaxUserProfileLookup ID.
+var ErrInvalidUserLookup = errors.New("invalid user lookup")
+
+// UserProfile adalah profil publik pengguna untuk ditampilkan di samping ID-nya, misalnya assignee
+// task dan penulis komentar. Profil dikelola oleh user-service, bukan task-service.
+type UserProfile struct {
+	UserID      UserID
+	DisplayName string
+	AvatarURL   string
+}
+
+// UserDirectory mendefinisikan kontrak pencarian profil pengguna dari layanan direktori pengguna.
+type UserDirectory interface {
+	// LookupUsers mengembalikan profil untuk ID yang dikenal; ID yang tidak dikenal tidak ada di map.
+	// Implementasi boleh mengembalikan profil dari cache yang sudah kedaluwarsa saat layanan
+	// direktori tidak tersedia, atau map kosong jika tidak ada sama sekali.
+	LookupUsers(ctx context.Context, ids []UserID) (map[UserID]UserProfile, error)
+}
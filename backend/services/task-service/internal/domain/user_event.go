@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// UserEventType adalah jenis event akun yang dikirim oleh sistem di luar task-service, misalnya
+// user-service atau trigger pada tabel auth.users Supabase.
+type UserEventType string
+
+// UserDeleted berarti akun pengguna sudah dihapus, sehingga data miliknya di task-service harus
+// dibersihkan.
+const UserDeleted UserEventType = "user.deleted"
+
+// MaxUserEventAttempts adalah jumlah percobaan pemrosesan event akun sebelum event dibiarkan untuk
+// diperiksa manual.
+const MaxUserEventAttempts = 10
+
+// UserEvent adalah satu event akun di antrean user_events.
+type UserEvent struct {
+	ID         int64
+	EventID    string // ID dari producer, unik sehingga publish ulang tidak menggandakan event
+	Type       UserEventType
+	UserID     UserID
+	OccurredAt time.Time
+	Attempts   int
+}
+
+// UserEventRepository mendefinisikan kontrak antrean event akun yang dikonsumsi task-service.
+type UserEventRepository interface {
+	// FindPending mengembalikan paling banyak limit event yang belum diproses dan belum mencapai
+	// MaxUserEventAttempts, urut ID.
+	FindPending(ctx context.Context, limit int) ([]UserEvent, error)
+
+	// MarkProcessed menandai event sudah diproses sehingga tidak diproses lagi.
+	MarkProcessed(ctx context.Context, id int64, processedAt time.Time) error
+
+	// MarkFailed mencatat percobaan yang gagal beserta pesan error-nya.
+	MarkFailed(ctx context.Context, id int64, message string) error
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SessionKind adalah jenis kredensial sebuah UserSession.
+type SessionKind string
+
+const (
+	SessionKindLogin               SessionKind = "login"                 // JWT dengan claim session_id atau sid
+	SessionKindPersonalAccessToken SessionKind = "personal_access_token" // ID sesi sama dengan ID token
+)
+
+// UserSession adalah sesi login atau personal access token yang pernah dipakai pengguna, beserta
+// perangkat (user agent) dan IP terakhirnya.
+type UserSession struct {
+	ID         string
+	UserID     UserID
+	Kind       SessionKind
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time // Pertama kali terlihat
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+var ErrUserSessionNotFound = errors.New("session not found")
+
+// UserSessionRepository mendefinisikan kontrak penyimpanan sesi pengguna.
+type UserSessionRepository interface {
+	// Touch menyimpan sesi baru, atau memperbarui user agent, IP, dan last_seen_at sesi yang sudah
+	// ada, termasuk yang sudah dicabut.
+	Touch(ctx context.Context, session *UserSession) error
+
+	// FindActiveByUserID mengembalikan sesi yang belum dicabut, dari yang terakhir terlihat.
+	// Sesi personal access token yang token-nya sudah dihapus tidak dikembalikan.
+	FindActiveByUserID(ctx context.Context, userID UserID) ([]UserSession, error)
+
+	// FindByID mengembalikan ErrUserSessionNotFound jika sesi tidak ada.
+	FindByID(ctx context.Context, userID UserID, id string) (*UserSession, error)
+
+	// IsRevoked melaporkan apakah sesi sudah dicabut. Sesi yang belum tercatat dianggap aktif.
+	IsRevoked(ctx context.Context, userID UserID, id string) (bool, error)
+
+	// Revoke menandai sesi sebagai dicabut.
+	Revoke(ctx context.Context, userID UserID, id string, revokedAt time.Time) error
+
+	// DeleteInactive menghapus sesi yang belum dicabut dan terakhir terlihat sebelum before. Sesi
+	// yang sudah dicabut disimpan agar token-nya tetap ditolak.
+	DeleteInactive(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Webhook adalah URL milik pengguna yang menerima TaskEvent lewat HTTP POST.
+type Webhook struct {
+	ID         string
+	UserID     UserID
+	URL        string
+	Secret     string          // Kunci HMAC untuk header signature; hanya ditampilkan saat dibuat
+	EventTypes []TaskEventType // Jenis event yang dikirim; kosong berarti semua jenis
+
+	// PayloadTemplate adalah Go text/template yang dieksekusi dengan TaskEvent sebagai data,
+	// misalnya untuk membentuk pesan Discord atau MS Teams. Kosong berarti TaskEvent sebagai JSON.
+	PayloadTemplate string
+	ContentType     string // Content-Type body yang dikirim; default application/json
+	CreatedAt       time.Time
+}
+
+// Accepts bernilai true jika webhook berlangganan jenis event eventType.
+func (w *Webhook) Accepts(eventType TaskEventType) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+var (
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrInvalidWebhook      = errors.New("invalid webhook")
+	ErrWebhookLimitReached = errors.New("webhook limit reached")
+)
+
+// WebhookDelivery adalah satu request HTTP yang akan dikirim ke URL webhook.
+type WebhookDelivery struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+// WebhookSender mengirim WebhookDelivery. Layer infrastructure mengimplementasikan interface ini
+// (misalnya HTTP client yang menolak alamat jaringan privat).
+type WebhookSender interface {
+	// Send mengembalikan error jika request gagal atau penerima tidak menjawab dengan status 2xx.
+	Send(ctx context.Context, delivery WebhookDelivery) error
+}
+
+// WebhookRepository mendefinisikan kontrak penyimpanan registrasi webhook.
+type WebhookRepository interface {
+	Save(ctx context.Context, webhook *Webhook) error
+
+	// FindByUserID mengembalikan semua webhook milik pengguna, yang paling lama lebih dulu.
+	FindByUserID(ctx context.Context, userID UserID) ([]*Webhook, error)
+
+	// Delete menghapus webhook milik pengguna. Mengembalikan ErrWebhookNotFound jika tidak ada.
+	Delete(ctx context.Context, userID UserID, id string) error
+}
@@ -0,0 +1,64 @@
+package domain
+
+import "errors"
+
+// WorkspaceConfigVersion adalah versi format dokumen konfigurasi workspace yang didukung.
+const WorkspaceConfigVersion = 1
+
+// WorkspaceConfig adalah konfigurasi workspace dalam bentuk dokumen yang bisa diekspor lalu
+// diterapkan ke workspace lain. Dokumen tidak berisi data task maupun kredensial integrasi
+// (webhook, Discord, Matrix, token SCIM).
+//
+// Setiap bagian bersifat deklaratif: bagian yang nil tidak dikelola dokumen dan dibiarkan apa
+// adanya, sedangkan bagian yang diisi (meskipun kosong) menggambarkan keadaan yang diinginkan.
+type WorkspaceConfig struct {
+	Version       int
+	Board         []WorkspaceConfigColumn // Sesuai urutan kolom dari kiri
+	Enums         map[EnumName][]WorkspaceConfigEnumValue
+	RoleMappings  map[string]WorkspaceRole // Nama grup SCIM ke role
+	Retrospective *WorkspaceConfigRetrospective
+}
+
+// WorkspaceConfigColumn adalah satu kolom board. Kolom dicocokkan dengan nama tanpa membedakan
+// huruf besar, karena ID kolom berbeda di setiap workspace.
+type WorkspaceConfigColumn struct {
+	Name     string
+	WIPLimit *int
+}
+
+// WorkspaceConfigEnumValue adalah satu nilai enum tambahan milik workspace; nilai builtin tidak
+// termasuk karena sama di semua workspace.
+type WorkspaceConfigEnumValue struct {
+	Value      string
+	Label      string
+	Position   int
+	Deprecated bool
+}
+
+// WorkspaceConfigRetrospective adalah pengaturan retrospektif bulanan.
+type WorkspaceConfigRetrospective struct {
+	Enabled  bool
+	TimeZone string
+}
+
+// WorkspaceConfigAction adalah jenis perubahan dari hasil perbandingan konfigurasi.
+type WorkspaceConfigAction string
+
+const (
+	WorkspaceConfigCreate  WorkspaceConfigAction = "create"
+	WorkspaceConfigUpdate  WorkspaceConfigAction = "update"
+	WorkspaceConfigDelete  WorkspaceConfigAction = "delete"
+	WorkspaceConfigReorder WorkspaceConfigAction = "reorder"
+)
+
+// WorkspaceConfigChange adalah satu perubahan yang dibutuhkan agar workspace sesuai dokumen.
+// Before dan After adalah ringkasan yang bisa dibaca manusia; kosong jika tidak relevan.
+type WorkspaceConfigChange struct {
+	Section string // "board", "enums.<nama enum>", "role_mappings", atau "retrospective"
+	Key     string // Nama kolom, nilai enum, atau nama grup
+	Action  WorkspaceConfigAction
+	Before  string
+	After   string
+}
+
+var ErrInvalidWorkspaceConfig = errors.New("invalid workspace configuration")
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// WorkspaceRole adalah role anggota di workspace. Workspace diidentifikasi dengan ID pemiliknya.
+type WorkspaceRole string
+
+const (
+	WorkspaceRoleViewer WorkspaceRole = "viewer" // Hanya membaca
+	WorkspaceRoleMember WorkspaceRole = "member" // Default anggota yang tidak berada di grup yang dipetakan
+	WorkspaceRoleAdmin  WorkspaceRole = "admin"
+)
+
+// WorkspaceRoles adalah role yang dikenal, dari yang paling rendah.
+var WorkspaceRoles = []WorkspaceRole{WorkspaceRoleViewer, WorkspaceRoleMember, WorkspaceRoleAdmin}
+
+// Validate mengembalikan ErrInvalidWorkspaceRole jika role tidak dikenal.
+func (r WorkspaceRole) Validate() error {
+	switch r {
+	case WorkspaceRoleViewer, WorkspaceRoleMember, WorkspaceRoleAdmin:
+		return nil
+	default:
+		return ErrInvalidWorkspaceRole
+	}
+}
+
+// MaxWorkspaceNameLength adalah panjang maksimum userName, displayName, dan externalId anggota atau grup.
+const MaxWorkspaceNameLength = 256
+
+// WorkspaceMember adalah anggota workspace yang diprovisikan identity provider lewat SCIM.
+type WorkspaceMember struct {
+	ID          string
+	WorkspaceID UserID
+	UserName    string // userName SCIM, biasanya email; unik per workspace tanpa membedakan huruf besar
+	ExternalID  string // ID anggota di identity provider
+	DisplayName string
+	Active      bool // false berarti anggota sudah dideprovisikan tetapi belum dihapus
+
+	// Role dihitung dari grup anggota lewat pemetaan grup ke role: role tertinggi dari grup yang
+	// dipetakan, atau WorkspaceRoleMember jika tidak ada. Diabaikan saat menyimpan.
+	Role      WorkspaceRole
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WorkspaceGroup adalah grup SCIM di workspace. Role anggotanya ditentukan oleh pemetaan nama grup.
+type WorkspaceGroup struct {
+	ID          string
+	WorkspaceID UserID
+	DisplayName string // Unik per workspace tanpa membedakan huruf besar
+	ExternalID  string
+	MemberIDs   []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// WorkspaceDirectoryQuery adalah filter dan halaman daftar anggota atau grup.
+type WorkspaceDirectoryQuery struct {
+	// FilterAttribute adalah atribut SCIM yang dibandingkan dengan FilterValue (tanpa membedakan
+	// huruf besar), misalnya "userName". Kosong berarti tanpa filter.
+	FilterAttribute string
+	FilterValue     string
+	Offset          int
+	Limit           int
+}
+
+// WorkspaceMemberPage adalah satu halaman anggota beserta jumlah seluruh anggota yang cocok.
+type WorkspaceMemberPage struct {
+	Members []*WorkspaceMember
+	Total   int
+}
+
+// WorkspaceGroupPage adalah satu halaman grup beserta jumlah seluruh grup yang cocok.
+type WorkspaceGroupPage struct {
+	Groups []*WorkspaceGroup
+	Total  int
+}
+
+var (
+	ErrWorkspaceMemberNotFound = errors.New("workspace member not found")
+	ErrWorkspaceGroupNotFound  = errors.New("workspace group not found")
+	ErrWorkspaceMemberExists   = errors.New("a workspace member with this userName already exists")
+	ErrWorkspaceGroupExists    = errors.New("a workspace group with this displayName already exists")
+	ErrInvalidWorkspaceMember  = errors.New("invalid workspace member")
+	ErrInvalidWorkspaceGroup   = errors.New("invalid workspace group")
+	ErrInvalidWorkspaceRole    = errors.New("role must be viewer, member, or admin")
+	ErrInvalidScimFilter       = errors.New(`filter must have the form <attribute> eq "<value>"`)
+	ErrInvalidScimPatch        = errors.New("invalid SCIM patch operation")
+	ErrInvalidScimToken        = errors.New("invalid SCIM token")
+)
+
+// WorkspaceDirectoryRepository mendefinisikan kontrak penyimpanan anggota, grup, dan pemetaan
+// grup ke role workspace.
+type WorkspaceDirectoryRepository interface {
+	// FindMember mengembalikan ErrWorkspaceMemberNotFound jika anggota tidak ada di workspace.
+	FindMember(ctx context.Context, workspaceID UserID, id string) (*WorkspaceMember, error)
+
+	// FindMembers mengembalikan anggota workspace yang cocok dengan query, urut waktu dibuat.
+	FindMembers(ctx context.Context, workspaceID UserID, query WorkspaceDirectoryQuery) (*WorkspaceMemberPage, error)
+
+	// SaveMember membuat atau mengganti anggota. Mengembalikan ErrWorkspaceMemberExists jika
+	// userName sudah dipakai anggota lain.
+	SaveMember(ctx context.Context, member *WorkspaceMember) error
+
+	// DeleteMember menghapus anggota beserta keanggotaan grupnya.
+	DeleteMember(ctx context.Context, workspaceID UserID, id string) error
+
+	// FindGroup mengembalikan ErrWorkspaceGroupNotFound jika grup tidak ada di workspace.
+	FindGroup(ctx context.Context, workspaceID UserID, id string) (*WorkspaceGroup, error)
+	FindGroups(ctx context.Context, workspaceID UserID, query WorkspaceDirectoryQuery) (*WorkspaceGroupPage, error)
+
+	// SaveGroup membuat atau mengganti grup beserta seluruh anggotanya dalam satu transaksi.
+	// Mengembalikan ErrWorkspaceGroupExists jika displayName sudah dipakai, atau
+	// ErrInvalidWorkspaceGroup jika ada MemberIDs yang bukan anggota workspace.
+	SaveGroup(ctx context.Context, group *WorkspaceGroup) error
+	DeleteGroup(ctx context.Context, workspaceID UserID, id string) error
+
+	// FindRoleMappings mengembalikan pemetaan nama grup (huruf kecil) ke role.
+	FindRoleMappings(ctx context.Context, workspaceID UserID) (map[string]WorkspaceRole, error)
+
+	// ReplaceRoleMappings mengganti seluruh pemetaan nama grup ke role.
+	ReplaceRoleMappings(ctx context.Context, workspaceID UserID, mappings map[string]WorkspaceRole) error
+}
+
+// ScimTokenRepository mendefinisikan kontrak penyimpanan token SCIM. Setiap workspace punya
+// paling banyak satu token dan hanya hash-nya yang disimpan.
+type ScimTokenRepository interface {
+	// FindWorkspace mengembalikan ErrInvalidScimToken jika tidak ada token dengan hash tersebut.
+	FindWorkspace(ctx context.Context, tokenHash string) (UserID, error)
+
+	// Replace menyimpan token baru untuk workspace, menggantikan token sebelumnya.
+	Replace(ctx context.Context, workspaceID UserID, tokenHash string, createdAt time.Time) error
+
+	// Delete mencabut token workspace. Tidak error jika workspace tidak punya token.
+	Delete(ctx context.Context, workspaceID UserID) error
+}
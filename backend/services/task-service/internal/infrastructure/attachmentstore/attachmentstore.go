@@ -0,0 +1,75 @@
+// file: backend/services/task-service/internal/infrastructure/attachmentstore/attachmentstore.go
+package attachmentstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Provider storage attachment yang didukung, dipilih lewat env ATTACHMENT_STORAGE.
+const (
+	ProviderSupabase = "supabase"
+	ProviderS3       = "s3"
+	ProviderLocal    = "local"
+)
+
+// Config adalah konfigurasi storage attachment. Field yang dipakai tergantung Provider.
+type Config struct {
+	Provider string
+	Bucket   string
+
+	// Supabase Storage
+	SupabaseURL    string // Misalnya https://<project>.supabase.co
+	SupabaseAPIKey string // Service role key; jangan pernah dikirim ke klien
+
+	// S3 atau layanan yang kompatibel (MinIO, Cloudflare R2)
+	S3Region          string
+	S3Endpoint        string // Kosong berarti AWS dengan virtual-hosted-style URL
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+
+	// Filesystem lokal, dilayani task-service sendiri lewat URL bertanda tangan
+	LocalDir        string
+	LocalBaseURL    string // URL publik task-service, misalnya https://api.example.com
+	LocalSigningKey string // Kunci HMAC URL; harus sama di semua replika
+}
+
+// New membuat AttachmentStorage sesuai cfg.Provider. Provider kosong berarti attachment dimatikan:
+// semua operasi mengembalikan domain.ErrAttachmentStorageDisabled.
+func New(cfg Config) (domain.AttachmentStorage, error) {
+	switch strings.ToLower(cfg.Provider) {
+	case "":
+		return disabledStorage{}, nil
+	case ProviderSupabase:
+		return NewSupabaseStorage(cfg)
+	case ProviderS3:
+		return NewS3Storage(cfg)
+	case ProviderLocal:
+		return NewLocalStorage(cfg)
+	default:
+		return nil, fmt.Errorf("unknown attachment storage %q (supported: %s, %s, %s)", cfg.Provider, ProviderSupabase, ProviderS3, ProviderLocal)
+	}
+}
+
+// disabledStorage dipakai jika storage attachment tidak dikonfigurasi.
+type disabledStorage struct{}
+
+func (disabledStorage) PresignUpload(context.Context, string, string, int64, time.Duration) (*domain.PresignedRequest, error) {
+	return nil, domain.ErrAttachmentStorageDisabled
+}
+
+func (disabledStorage) PresignDownload(context.Context, string, string, time.Duration) (*domain.PresignedRequest, error) {
+	return nil, domain.ErrAttachmentStorageDisabled
+}
+
+func (disabledStorage) Stat(context.Context, string) (int64, error) {
+	return 0, domain.ErrAttachmentStorageDisabled
+}
+
+func (disabledStorage) Delete(context.Context, string) error {
+	return domain.ErrAttachmentStorageDisabled
+}
@@ -0,0 +1,288 @@
+// file: backend/services/task-service/internal/infrastructure/attachmentstore/local_storage.go
+package attachmentstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// LocalObjectPath adalah prefix path tempat LocalStorage melayani URL bertanda tangan.
+const LocalObjectPath = "/api/v1/attachment-objects/"
+
+// minLocalSigningKeyLength adalah panjang minimum kunci HMAC LocalStorage dalam byte.
+const minLocalSigningKeyLength = 32
+
+// LocalStorage adalah implementasi domain.AttachmentStorage di filesystem lokal untuk instalasi
+// tanpa S3 maupun Supabase. Isi file dilayani task-service sendiri (lihat ServeHTTP) lewat URL
+// yang ditandatangani HMAC-SHA256 dan kedaluwarsa, sehingga klien tetap memakai alur presigned
+// URL yang sama dan tidak ada link publik permanen.
+type LocalStorage struct {
+	root       string
+	baseURL    *url.URL
+	signingKey []byte
+	now        func() time.Time
+}
+
+// NewLocalStorage adalah constructor untuk LocalStorage. Direktori cfg.LocalDir dibuat jika belum ada.
+func NewLocalStorage(cfg Config) (domain.AttachmentStorage, error) {
+	if cfg.LocalDir == "" || cfg.LocalBaseURL == "" {
+		return nil, fmt.Errorf("local attachment storage requires directory and public base url")
+	}
+	if len(cfg.LocalSigningKey) < minLocalSigningKeyLength {
+		return nil, fmt.Errorf("local attachment storage signing key must be at least %d bytes", minLocalSigningKeyLength)
+	}
+	baseURL, err := url.Parse(cfg.LocalBaseURL)
+	if err != nil || (baseURL.Scheme != "https" && baseURL.Scheme != "http") || baseURL.Host == "" {
+		return nil, fmt.Errorf("invalid local attachment storage base url %q", cfg.LocalBaseURL)
+	}
+	if err := os.MkdirAll(cfg.LocalDir, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating attachment directory %s: %w", cfg.LocalDir, err)
+	}
+	return &LocalStorage{
+		root:       cfg.LocalDir,
+		baseURL:    baseURL,
+		signingKey: []byte(cfg.LocalSigningKey),
+		now:        time.Now,
+	}, nil
+}
+
+// localObjectRequest adalah parameter URL yang ikut ditandatangani.
+type localObjectRequest struct {
+	method      string
+	key         string
+	expires     int64 // Unix detik
+	size        string
+	contentType string
+	fileName    string
+}
+
+// signature menandatangani semua parameter, dipisah baris baru yang tidak mungkin muncul di
+// dalam nilai-nilainya.
+func (s *LocalStorage) signature(req localObjectRequest) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(strings.Join([]string{
+		req.method, req.key, strconv.FormatInt(req.expires, 10), req.size, req.contentType, req.fileName,
+	}, "\n")))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// presign membuat URL bertanda tangan untuk req.
+func (s *LocalStorage) presign(req localObjectRequest, headers map[string]string, expires time.Duration) *domain.PresignedRequest {
+	expiresAt := s.now().Add(expires).Truncate(time.Second)
+	req.expires = expiresAt.Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(req.expires, 10))
+	if req.size != "" {
+		query.Set("size", req.size)
+	}
+	if req.contentType != "" {
+		query.Set("content_type", req.contentType)
+	}
+	if req.fileName != "" {
+		query.Set("filename", req.fileName)
+	}
+	query.Set("signature", s.signature(req))
+
+	u := s.baseURL.JoinPath(LocalObjectPath, req.key)
+	u.RawQuery = query.Encode()
+	return &domain.PresignedRequest{
+		Method:    req.method,
+		URL:       u.String(),
+		Headers:   headers,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// PresignUpload menandatangani ukuran dan content type, sehingga upload dengan ukuran atau tipe
+// berbeda ditolak.
+func (s *LocalStorage) PresignUpload(_ context.Context, key, contentType string, size int64, expires time.Duration) (*domain.PresignedRequest, error) {
+	headers := map[string]string{
+		"Content-Length": strconv.FormatInt(size, 10),
+		"Content-Type":   contentType,
+	}
+	req := localObjectRequest{method: http.MethodPut, key: key, size: headers["Content-Length"], contentType: contentType}
+	return s.presign(req, headers, expires), nil
+}
+
+// PresignDownload menandatangani nama file, yang dikirim di Content-Disposition saat diunduh.
+func (s *LocalStorage) PresignDownload(_ context.Context, key, fileName string, expires time.Duration) (*domain.PresignedRequest, error) {
+	req := localObjectRequest{method: http.MethodGet, key: key, fileName: fileName}
+	return s.presign(req, nil, expires), nil
+}
+
+// path memetakan key ke path file dan menolak key yang keluar dari root (misalnya "../").
+func (s *LocalStorage) path(key string) (string, error) {
+	if key == "" || !fs.ValidPath(key) || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid attachment key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Stat membaca ukuran file.
+func (s *LocalStorage) Stat(_ context.Context, key string) (int64, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, domain.ErrBlobNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error reading attachment %s: %w", key, err)
+	}
+	return info.Size(), nil
+}
+
+// Delete menghapus file. File yang tidak ada bukan error.
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting attachment %s: %w", key, err)
+	}
+	return nil
+}
+
+// ServeHTTP melayani URL dari PresignUpload (PUT) dan PresignDownload (GET dan HEAD) di bawah
+// LocalObjectPath. Request tanpa tanda tangan yang valid dan belum kedaluwarsa dijawab 403.
+func (s *LocalStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	req := localObjectRequest{
+		method:      method,
+		key:         strings.TrimPrefix(r.URL.Path, LocalObjectPath),
+		size:        query.Get("size"),
+		contentType: query.Get("content_type"),
+		fileName:    query.Get("filename"),
+	}
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	req.expires = expires
+	if err != nil || !hmac.Equal([]byte(query.Get("signature")), []byte(s.signature(req))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if s.now().Unix() >= expires {
+		http.Error(w, "url has expired", http.StatusForbidden)
+		return
+	}
+	p, err := s.path(req.key)
+	if err != nil {
+		http.Error(w, "invalid key", http.StatusBadRequest)
+		return
+	}
+
+	if method == http.MethodPut {
+		s.upload(w, r, req, p)
+		return
+	}
+	s.download(w, r, req, p)
+}
+
+// upload menulis ke file sementara lalu me-link-nya ke path tujuan, sehingga Stat tidak pernah
+// melihat file setengah jadi. Link gagal jika file sudah ada, sehingga URL upload hanya bisa dipakai
+// sekali: upload kedua, termasuk yang berjalan bersamaan, dijawab 409 dan tidak bisa mengganti isi
+// attachment yang sudah didaftarkan.
+func (s *LocalStorage) upload(w http.ResponseWriter, r *http.Request, req localObjectRequest, p string) {
+	if _, err := os.Lstat(p); err == nil {
+		http.Error(w, "attachment has already been uploaded", http.StatusConflict)
+		return
+	}
+	size, err := strconv.ParseInt(req.size, 10, 64)
+	if err != nil || r.ContentLength != size {
+		http.Error(w, "content length does not match signed size", http.StatusBadRequest)
+		return
+	}
+	if r.Header.Get("Content-Type") != req.contentType {
+		http.Error(w, "content type does not match signed content type", http.StatusBadRequest)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		http.Error(w, "could not store attachment", http.StatusInternalServerError)
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		http.Error(w, "could not store attachment", http.StatusInternalServerError)
+		return
+	}
+	defer os.Remove(tmp.Name()) // File tujuan tetap ada lewat link
+
+	written, err := io.Copy(tmp, http.MaxBytesReader(w, r.Body, size))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil || written != size {
+		http.Error(w, "could not read attachment body", http.StatusBadRequest)
+		return
+	}
+	if err := os.Link(tmp.Name(), p); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			http.Error(w, "attachment has already been uploaded", http.StatusConflict)
+			return
+		}
+		http.Error(w, "could not store attachment", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// download selalu mengirim Content-Disposition attachment dan CSP sandbox, karena file dilayani
+// dari origin API dan tidak boleh dijalankan browser sebagai halaman HTML.
+func (s *LocalStorage) download(w http.ResponseWriter, r *http.Request, req localObjectRequest, p string) {
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		http.Error(w, "attachment not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "could not read attachment", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		http.Error(w, "attachment not found", http.StatusNotFound)
+		return
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(req.fileName))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": req.fileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+	w.Header().Set("Cache-Control", "private")
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
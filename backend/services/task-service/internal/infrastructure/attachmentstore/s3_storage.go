@@ -0,0 +1,230 @@
+// file: backend/services/task-service/internal/infrastructure/attachmentstore/s3_storage.go
+package attachmentstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// s3InternalExpiry adalah masa berlaku URL yang hanya dipakai service ini sendiri (Stat, Delete).
+const s3InternalExpiry = time.Minute
+
+// S3Storage adalah implementasi domain.AttachmentStorage untuk S3 dan layanan yang kompatibel.
+// Semua request, termasuk HEAD dan DELETE dari service ini, memakai presigned URL (AWS Signature
+// Version 4, query string) sehingga tidak membutuhkan AWS SDK.
+type S3Storage struct {
+	bucket    string
+	region    string
+	endpoint  *url.URL // Nil berarti https://<bucket>.s3.<region>.amazonaws.com
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewS3Storage adalah constructor untuk S3Storage.
+func NewS3Storage(cfg Config) (domain.AttachmentStorage, error) {
+	if cfg.Bucket == "" || cfg.S3Region == "" || cfg.S3AccessKeyID == "" || cfg.S3SecretAccessKey == "" {
+		return nil, fmt.Errorf("s3 attachment storage requires bucket, region, access key id, and secret access key")
+	}
+	s := &S3Storage{
+		bucket:    cfg.Bucket,
+		region:    cfg.S3Region,
+		accessKey: cfg.S3AccessKeyID,
+		secretKey: cfg.S3SecretAccessKey,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		now:       time.Now,
+	}
+	if cfg.S3Endpoint != "" {
+		endpoint, err := url.Parse(cfg.S3Endpoint)
+		if err != nil || endpoint.Host == "" {
+			return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.S3Endpoint)
+		}
+		s.endpoint = endpoint
+	}
+	return s, nil
+}
+
+// PresignUpload menandatangani content-length dan content-type, sehingga S3 menolak upload
+// dengan ukuran atau tipe yang berbeda dari yang didaftarkan.
+func (s *S3Storage) PresignUpload(_ context.Context, key, contentType string, size int64, expires time.Duration) (*domain.PresignedRequest, error) {
+	headers := map[string]string{
+		"Content-Length": strconv.FormatInt(size, 10),
+		"Content-Type":   contentType,
+	}
+	return s.presign(http.MethodPut, key, headers, nil, expires), nil
+}
+
+// PresignDownload membuat URL GET dengan Content-Disposition attachment berisi nama file.
+func (s *S3Storage) PresignDownload(_ context.Context, key, fileName string, expires time.Duration) (*domain.PresignedRequest, error) {
+	query := url.Values{}
+	query.Set("response-content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	return s.presign(http.MethodGet, key, nil, query, expires), nil
+}
+
+// Stat mengirim HEAD ke objek dan membaca Content-Length.
+func (s *S3Storage) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := s.do(ctx, http.MethodHead, key)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return 0, domain.ErrBlobNotFound
+	case resp.StatusCode != http.StatusOK:
+		return 0, fmt.Errorf("error reading s3 object %s: unexpected status %s", key, resp.Status)
+	}
+	return resp.ContentLength, nil
+}
+
+// Delete menghapus objek. S3 menjawab 204 juga untuk objek yang tidak ada.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("error deleting s3 object %s: unexpected status %s", key, resp.Status)
+	}
+	return nil
+}
+
+func (s *S3Storage) do(ctx context.Context, method, key string) (*http.Response, error) {
+	presigned := s.presign(method, key, nil, nil, s3InternalExpiry)
+	req, err := http.NewRequestWithContext(ctx, method, presigned.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating s3 request: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling s3 %s %s: %w", method, key, err)
+	}
+	return resp, nil
+}
+
+// objectURL mengembalikan URL objek tanpa query string.
+func (s *S3Storage) objectURL(key string) *url.URL {
+	if s.endpoint == nil {
+		return &url.URL{
+			Scheme: "https",
+			Host:   s.bucket + ".s3." + s.region + ".amazonaws.com",
+			Path:   "/" + key,
+		}
+	}
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + key
+	return &u
+}
+
+// presign membuat presigned URL sesuai AWS Signature Version 4 (query string authentication)
+// dengan payload UNSIGNED-PAYLOAD. headers ikut ditandatangani dan wajib dikirim klien.
+func (s *S3Storage) presign(method, key string, headers map[string]string, query url.Values, expires time.Duration) *domain.PresignedRequest {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	scope := now.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+	u := s.objectURL(key)
+
+	canonicalHeaders := map[string]string{"host": u.Host}
+	for name, value := range headers {
+		canonicalHeaders[strings.ToLower(name)] = strings.TrimSpace(value)
+	}
+	names := make([]string, 0, len(canonicalHeaders))
+	for name := range canonicalHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	signedHeaders := strings.Join(names, ";")
+
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", s.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires/time.Second)))
+	query.Set("X-Amz-SignedHeaders", signedHeaders)
+	canonicalQuery := awsCanonicalQuery(query)
+
+	u.RawPath = awsEscape(u.Path, false)
+
+	var canonical strings.Builder
+	canonical.WriteString(method + "\n")
+	canonical.WriteString(u.RawPath + "\n")
+	canonical.WriteString(canonicalQuery + "\n")
+	for _, name := range names {
+		canonical.WriteString(name + ":" + canonicalHeaders[name] + "\n")
+	}
+	canonical.WriteString("\n" + signedHeaders + "\nUNSIGNED-PAYLOAD")
+
+	hashed := sha256.Sum256([]byte(canonical.String()))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hashed[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format("20060102"))
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	u.RawQuery = canonicalQuery + "&X-Amz-Signature=" + signature
+	return &domain.PresignedRequest{
+		Method:    method,
+		URL:       u.String(),
+		Headers:   headers,
+		ExpiresAt: now.Add(expires),
+	}
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// awsCanonicalQuery mengurutkan parameter berdasarkan nama dan meng-encode-nya sesuai aturan SigV4.
+func awsCanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, awsEscape(key, true)+"="+awsEscape(value, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsEscape meng-encode s sesuai URI encoding SigV4: hanya karakter unreserved (A-Z, a-z, 0-9,
+// '-', '.', '_', '~') yang tidak di-encode. '/' hanya di-encode jika encodeSlash bernilai true.
+func awsEscape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,160 @@
+// file: backend/services/task-service/internal/infrastructure/attachmentstore/supabase_storage.go
+package attachmentstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// supabaseUploadURLExpiry adalah masa berlaku signed upload URL Supabase Storage.
+// Nilainya ditentukan oleh Supabase dan tidak bisa diatur per request.
+const supabaseUploadURLExpiry = 2 * time.Hour
+
+// SupabaseStorage adalah implementasi domain.AttachmentStorage untuk Supabase Storage, memakai
+// Storage REST API dengan service role key. Batas ukuran upload diatur lewat konfigurasi bucket;
+// ukuran sebenarnya tetap diperiksa saat registrasi attachment.
+type SupabaseStorage struct {
+	baseURL string // <SUPABASE_URL>/storage/v1
+	apiKey  string
+	bucket  string
+	client  *http.Client
+}
+
+// NewSupabaseStorage adalah constructor untuk SupabaseStorage.
+func NewSupabaseStorage(cfg Config) (domain.AttachmentStorage, error) {
+	if cfg.Bucket == "" || cfg.SupabaseURL == "" || cfg.SupabaseAPIKey == "" {
+		return nil, fmt.Errorf("supabase attachment storage requires bucket, url, and service role key")
+	}
+	if _, err := url.ParseRequestURI(cfg.SupabaseURL); err != nil {
+		return nil, fmt.Errorf("invalid supabase url %q: %w", cfg.SupabaseURL, err)
+	}
+	return &SupabaseStorage{
+		baseURL: strings.TrimSuffix(cfg.SupabaseURL, "/") + "/storage/v1",
+		apiKey:  cfg.SupabaseAPIKey,
+		bucket:  cfg.Bucket,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// PresignUpload meminta signed upload URL. Klien mengirim file dengan PUT ke URL tersebut.
+// expires diabaikan karena masa berlaku diatur oleh Supabase (supabaseUploadURLExpiry).
+func (s *SupabaseStorage) PresignUpload(ctx context.Context, key, contentType string, size int64, _ time.Duration) (*domain.PresignedRequest, error) {
+	var body struct {
+		URL string `json:"url"`
+	}
+	if err := s.call(ctx, http.MethodPost, "/object/upload/sign/"+s.objectPath(key), nil, &body); err != nil {
+		return nil, err
+	}
+	return &domain.PresignedRequest{
+		Method:    http.MethodPut,
+		URL:       s.baseURL + body.URL,
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: time.Now().Add(supabaseUploadURLExpiry),
+	}, nil
+}
+
+// PresignDownload meminta signed URL dengan parameter download agar file diunduh dengan nama aslinya.
+func (s *SupabaseStorage) PresignDownload(ctx context.Context, key, fileName string, expires time.Duration) (*domain.PresignedRequest, error) {
+	var body struct {
+		SignedURL string `json:"signedURL"`
+	}
+	req := map[string]int{"expiresIn": int(expires / time.Second)}
+	if err := s.call(ctx, http.MethodPost, "/object/sign/"+s.objectPath(key), req, &body); err != nil {
+		return nil, err
+	}
+	return &domain.PresignedRequest{
+		Method:    http.MethodGet,
+		URL:       s.baseURL + body.SignedURL + "&download=" + url.QueryEscape(fileName),
+		ExpiresAt: time.Now().Add(expires),
+	}, nil
+}
+
+// Stat membaca ukuran objek dengan HEAD ke endpoint authenticated.
+func (s *SupabaseStorage) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := s.send(ctx, http.MethodHead, "/object/authenticated/"+s.objectPath(key), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch {
+	// Supabase menjawab 400 (bukan 404) untuk objek yang tidak ada di beberapa versi.
+	case resp.StatusCode == http.StatusNotFound, resp.StatusCode == http.StatusBadRequest:
+		return 0, domain.ErrBlobNotFound
+	case resp.StatusCode != http.StatusOK:
+		return 0, fmt.Errorf("error reading supabase object %s: unexpected status %s", key, resp.Status)
+	}
+	return resp.ContentLength, nil
+}
+
+// Delete menghapus objek. Objek yang tidak ada tidak dianggap error.
+func (s *SupabaseStorage) Delete(ctx context.Context, key string) error {
+	resp, err := s.send(ctx, http.MethodDelete, "/object/"+s.objectPath(key), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusBadRequest {
+		return fmt.Errorf("error deleting supabase object %s: unexpected status %s", key, resp.Status)
+	}
+	return nil
+}
+
+// objectPath mengembalikan "<bucket>/<key>" dengan setiap segmen di-escape.
+func (s *SupabaseStorage) objectPath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return url.PathEscape(s.bucket) + "/" + strings.Join(segments, "/")
+}
+
+// call mengirim request JSON dan men-decode response 200 ke out.
+func (s *SupabaseStorage) call(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("error encoding supabase storage request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+	resp, err := s.send(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("error calling supabase storage %s %s: status %s: %s", method, path, resp.Status, detail)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding supabase storage response: %w", err)
+	}
+	return nil
+}
+
+func (s *SupabaseStorage) send(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating supabase storage request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("apikey", s.apiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling supabase storage %s %s: %w", method, path, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+// file: backend/services/task-service/internal/infrastructure/auth/guest.go
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// MinGuestTokenSecretLength adalah panjang minimum secret token tamu dalam byte.
+const MinGuestTokenSecretLength = 32
+
+// GuestVerifier memeriksa token tamu. Diimplementasikan oleh application.GuestApplicationService.
+type GuestVerifier interface {
+	// Authenticate mengembalikan ID tamu, domain.ErrInvalidGuestToken jika token tidak valid, atau
+	// domain.ErrGuestAlreadyMerged jika tamu sudah digabung ke akun pengguna.
+	Authenticate(ctx context.Context, token string) (domain.UserID, error)
+}
+
+// IsGuest melaporkan apakah request diautentikasi dengan token tamu.
+func IsGuest(ctx context.Context) bool {
+	guest, _ := ctx.Value(guestKey).(bool)
+	return guest
+}
+
+// guestTokenPayload adalah isi token tamu yang ditandatangani.
+type guestTokenPayload struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// HMACGuestTokenSigner adalah implementasi domain.GuestTokenSigner dengan HMAC-SHA256. Token
+// berbentuk "gst_<payload>.<signature>" (base64url tanpa padding), dan tanda tangannya mencakup
+// awalan token.
+type HMACGuestTokenSigner struct {
+	secret func() string
+}
+
+// NewHMACGuestTokenSigner adalah constructor untuk HMACGuestTokenSigner. secret dipanggil untuk
+// setiap token, seperti pada NewSupabaseVerifier, sehingga secret yang dirotasi langsung berlaku
+// dan token tamu lama tidak berlaku lagi.
+func NewHMACGuestTokenSigner(secret func() string) *HMACGuestTokenSigner {
+	return &HMACGuestTokenSigner{
+		secret: secret,
+	}
+}
+
+// Sign menandatangani ID tamu dan waktu kedaluwarsanya.
+func (s *HMACGuestTokenSigner) Sign(guestID domain.UserID, expiresAt time.Time) (string, error) {
+	payload, err := json.Marshal(guestTokenPayload{Subject: string(guestID), ExpiresAt: expiresAt.Unix()})
+	if err != nil {
+		return "", fmt.Errorf("error encoding guest token: %w", err)
+	}
+	signingInput := domain.GuestTokenPrefix + base64.RawURLEncoding.EncodeToString(payload)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(s.sign(signingInput)), nil
+}
+
+// Verify memeriksa tanda tangan sebelum membaca payload.
+func (s *HMACGuestTokenSigner) Verify(token string, now time.Time) (domain.UserID, error) {
+	signingInput, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok || !strings.HasPrefix(signingInput, domain.GuestTokenPrefix) {
+		return "", domain.ErrInvalidGuestToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.sign(signingInput)) {
+		return "", domain.ErrInvalidGuestToken
+	}
+	var payload guestTokenPayload
+	if err := decodeSegment(strings.TrimPrefix(signingInput, domain.GuestTokenPrefix), &payload); err != nil {
+		return "", domain.ErrInvalidGuestToken
+	}
+	guestID := domain.UserID(payload.Subject)
+	if !domain.IsGuestUserID(guestID) || now.Unix() >= payload.ExpiresAt {
+		return "", domain.ErrInvalidGuestToken
+	}
+	return guestID, nil
+}
+
+func (s *HMACGuestTokenSigner) sign(signingInput string) []byte {
+	mac := hmac.New(sha256.New, []byte(s.secret()))
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
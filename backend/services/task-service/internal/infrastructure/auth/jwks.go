@@ -0,0 +1,332 @@
+// file: backend/services/task-service/internal/infrastructure/auth/jwks.go
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksRefreshInterval adalah jarak minimum antar pengambilan ulang JWKS, baik karena kid yang
+	// tidak dikenal maupun setelah pengambilan gagal, agar token palsu dengan kid acak atau issuer
+	// yang sedang down tidak membuat setiap request mengambil JWKS.
+	jwksRefreshInterval = time.Minute
+
+	// jwksCacheTTL adalah umur cache JWKS sebelum diambil ulang di latar belakang. Kunci yang
+	// dihapus issuer berhenti diterima paling lambat setelah interval ini.
+	jwksCacheTTL = 10 * time.Minute
+
+	// jwksMaxStale adalah umur cache terlama yang masih dipakai saat JWKS tidak bisa diambil ulang,
+	// sehingga gangguan sementara di issuer tidak menolak token yang valid.
+	jwksMaxStale = 24 * time.Hour
+)
+
+// maxJWKSSize adalah ukuran response JWKS terbesar yang dibaca.
+const maxJWKSSize = 1 << 20
+
+// jwk adalah satu kunci publik JSON Web Key (RFC 7517). Hanya kunci RSA dan EC yang dipakai.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwksFetchTimeout membatasi satu pengambilan JWKS (termasuk discovery URL-nya). Pengambilan
+// tidak memakai context request, sehingga request yang dibatalkan tidak menggagalkannya.
+const jwksFetchTimeout = 10 * time.Second
+
+// jwksKeySet menyimpan kunci publik dari endpoint JWKS. Kunci diambil saat pertama dibutuhkan,
+// diambil ulang di latar belakang setelah jwksCacheTTL, dan diambil ulang langsung saat token
+// memakai kid yang belum dikenal, misalnya setelah issuer merotasi kunci.
+type jwksKeySet struct {
+	// resolveURL mengembalikan URL JWKS; dipanggil sekali sebelum pengambilan pertama.
+	resolveURL func(ctx context.Context) (string, error)
+	client     *http.Client
+	now        func() time.Time
+
+	mu          sync.Mutex
+	url         string
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time  // Pengambilan terakhir yang berhasil
+	attemptedAt time.Time  // Pengambilan terakhir, berhasil atau tidak
+	lastErr     error      // Error pengambilan terakhir; nil jika berhasil
+	inflight    *jwksFetch // Pengambilan yang sedang berjalan; nil jika tidak ada
+}
+
+// jwksFetch adalah satu pengambilan JWKS yang ditunggu bersama oleh semua request yang
+// membutuhkannya (single flight). err diisi sebelum done ditutup.
+type jwksFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// verify memeriksa signature JWS untuk signingInput dengan kunci kid. Mengembalikan
+// ErrInvalidToken jika algoritma tidak didukung atau signature salah, atau ErrAuthUnavailable jika
+// JWKS tidak bisa diambil.
+func (s *jwksKeySet) verify(ctx context.Context, alg, kid, signingInput string, signature []byte) error {
+	hash, ok := signatureHash(alg)
+	if !ok {
+		return ErrInvalidToken
+	}
+	key, err := s.key(ctx, kid)
+	if errors.Is(err, ErrInvalidToken) {
+		return err
+	}
+	if err != nil {
+		return ErrAuthUnavailable
+	}
+	if !verifySignature(alg, hash, key, signingInput, signature) {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// key mengembalikan kunci publik untuk kid. Kid kosong diterima jika JWKS hanya berisi satu kunci.
+// Mengembalikan ErrInvalidToken jika kunci tidak ada, atau error lain jika JWKS tidak bisa diambil.
+// Dalam jwksRefreshInterval setelah pengambilan gagal, error tersebut langsung dikembalikan tanpa
+// mencoba lagi. mu tidak ditahan selama pengambilan, sehingga token dengan kid yang sudah di-cache
+// tidak ikut menunggu; request yang membutuhkan pengambilan menunggu sampai selesai atau ctx-nya
+// dibatalkan.
+func (s *jwksKeySet) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	now := s.now()
+	if key, ok := s.lookup(kid); ok {
+		age := now.Sub(s.fetchedAt)
+		if age >= jwksCacheTTL && age < jwksMaxStale && now.Sub(s.attemptedAt) >= jwksRefreshInterval {
+			s.startFetch()
+		}
+		if age < jwksMaxStale {
+			s.mu.Unlock()
+			return key, nil
+		}
+	}
+	call := s.inflight
+	if call == nil {
+		if !s.attemptedAt.IsZero() && now.Sub(s.attemptedAt) < jwksRefreshInterval {
+			err := s.lastErr
+			s.mu.Unlock()
+			if err != nil {
+				return nil, err
+			}
+			return nil, ErrInvalidToken
+		}
+		call = s.startFetch()
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, ErrInvalidToken
+}
+
+// startFetch memulai pengambilan JWKS di goroutine terpisah, atau mengembalikan pengambilan yang
+// sedang berjalan. Harus dipanggil dengan mu terkunci.
+func (s *jwksKeySet) startFetch() *jwksFetch {
+	if s.inflight != nil {
+		return s.inflight
+	}
+	call := &jwksFetch{done: make(chan struct{})}
+	s.inflight = call
+	go func() {
+		call.err = s.fetch()
+		s.mu.Lock()
+		s.inflight = nil
+		s.mu.Unlock()
+		close(call.done)
+	}()
+	return call
+}
+
+func (s *jwksKeySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// fetch mengganti kunci dengan isi JWKS terbaru, dengan context sendiri yang dibatasi
+// jwksFetchTimeout. Jika gagal, kunci lama tetap dipakai sampai jwksMaxStale. Pembatalan context
+// tidak dicatat sebagai kegagalan, sehingga tidak menahan pengambilan berikutnya selama
+// jwksRefreshInterval.
+func (s *jwksKeySet) fetch() error {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksFetchTimeout)
+	defer cancel()
+
+	s.mu.Lock()
+	url := s.url
+	s.mu.Unlock()
+	if url == "" {
+		resolved, err := s.resolveURL(ctx)
+		if err != nil {
+			s.finish(nil, err)
+			slog.Error("error resolving jwks url", "error", err)
+			return err
+		}
+		url = resolved
+		s.mu.Lock()
+		s.url = url
+		s.mu.Unlock()
+	}
+	keys, err := s.load(ctx, url)
+	s.finish(keys, err)
+	if err != nil {
+		slog.Error("error fetching jwks, using cached keys if any", "url", url, "error", err)
+	}
+	return err
+}
+
+// finish mencatat hasil pengambilan dengan mu terkunci, kecuali pembatalan context.
+func (s *jwksKeySet) finish(keys map[string]crypto.PublicKey, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.record(keys, err)
+}
+
+// record mencatat hasil pengambilan JWKS. Harus dipanggil dengan mu terkunci.
+func (s *jwksKeySet) record(keys map[string]crypto.PublicKey, err error) {
+	s.attemptedAt = s.now()
+	s.lastErr = err
+	if err == nil {
+		s.keys = keys
+		s.fetchedAt = s.attemptedAt
+	}
+}
+
+// load mengambil JWKS dari url. Kunci yang tidak dikenal atau bukan untuk signature dilewati; JWKS
+// tanpa kunci yang bisa dipakai dianggap gagal, agar cache tidak dikosongkan oleh response yang rusak.
+func (s *jwksKeySet) load(ctx context.Context, url string) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := getJSON(ctx, s.client, url, &set); err != nil {
+		return nil, fmt.Errorf("error fetching jwks: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		if key, err := k.publicKey(); err == nil {
+			keys[k.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks has no usable signing keys")
+	}
+	return keys, nil
+}
+
+// publicKey mengubah JWK menjadi *rsa.PublicKey atau *ecdsa.PublicKey.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeJWKInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 || n.BitLen() < 2048 {
+			return nil, errors.New("unsupported rsa key")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		var ecdhCurve ecdh.Curve
+		switch k.Crv {
+		case "P-256":
+			curve, ecdhCurve = elliptic.P256(), ecdh.P256()
+		case "P-384":
+			curve, ecdhCurve = elliptic.P384(), ecdh.P384()
+		case "P-521":
+			curve, ecdhCurve = elliptic.P521(), ecdh.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, errors.New("invalid ec key coordinates")
+		}
+		point := append(append([]byte{0x04}, x...), y...)
+		if _, err := ecdhCurve.NewPublicKey(point); err != nil {
+			return nil, err // Titik tidak berada di kurva
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeJWKInt(value string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(raw) == 0 {
+		return nil, errors.New("invalid jwk integer")
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
+
+// getJSON mengambil dokumen JSON dengan GET.
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s returned status %d: %s", url, resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxJWKSSize)).Decode(v)
+}
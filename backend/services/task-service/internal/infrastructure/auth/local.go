@@ -0,0 +1,86 @@
+// file: backend/services/task-service/internal/infrastructure/auth/local.go
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DefaultAccessTokenTTL adalah masa berlaku access token akun lokal jika tidak diatur.
+const DefaultAccessTokenTTL = 15 * time.Minute
+
+// LocalTokenIssuer menerbitkan access token HS256 untuk akun lokal dengan claim yang sama seperti
+// JWT Supabase, sehingga token tersebut diverifikasi SupabaseVerifier dengan secret yang sama.
+// Mengimplementasikan domain.AccessTokenIssuer.
+type LocalTokenIssuer struct {
+	secret func() string
+	ttl    time.Duration
+}
+
+// NewLocalTokenIssuer adalah constructor untuk LocalTokenIssuer. secret dipanggil untuk setiap
+// token, seperti pada NewSupabaseVerifier. ttl <= 0 berarti DefaultAccessTokenTTL.
+func NewLocalTokenIssuer(secret func() string, ttl time.Duration) *LocalTokenIssuer {
+	if ttl <= 0 {
+		ttl = DefaultAccessTokenTTL
+	}
+	return &LocalTokenIssuer{
+		secret: secret,
+		ttl:    ttl,
+	}
+}
+
+// IssueAccessToken menandatangani claim sub, email, exp, session_id, dan app_metadata.role
+// pengguna.
+func (i *LocalTokenIssuer) IssueAccessToken(user *domain.LocalUser, sessionID string, now time.Time) (string, time.Time, error) {
+	expiresAt := now.Add(i.ttl).Truncate(time.Second)
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	payload, err := json.Marshal(Claims{
+		Subject:     string(user.ID),
+		Role:        "authenticated",
+		Email:       user.Email,
+		ExpiresAt:   expiresAt.Unix(),
+		SessionID:   sessionID,
+		AppMetadata: AppMetadata{Role: user.Role},
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error encoding access token claims: %w", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(i.secret()))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), expiresAt, nil
+}
+
+// BcryptHasher adalah implementasi domain.PasswordHasher dengan bcrypt.
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher adalah constructor untuk BcryptHasher dengan cost default bcrypt.
+func NewBcryptHasher() *BcryptHasher {
+	return &BcryptHasher{cost: bcrypt.DefaultCost}
+}
+
+// Hash membuat hash bcrypt dengan salt acak.
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("error hashing password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Compare melaporkan apakah password cocok dengan hash bcrypt.
+func (h *BcryptHasher) Compare(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -0,0 +1,271 @@
+// file: backend/services/task-service/internal/infrastructure/auth/oidc.go
+package auth
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Path claim default untuk plan, role, dan scope, sama dengan letaknya di JWT Supabase.
+const (
+	defaultPlanClaim  = "app_metadata.plan"
+	defaultRoleClaim  = "app_metadata.role"
+	defaultScopeClaim = "app_metadata.scopes"
+)
+
+// OIDCConfig adalah pengaturan issuer OIDC, misalnya Keycloak atau Auth0.
+type OIDCConfig struct {
+	IssuerURL string // Harus sama persis dengan claim iss
+	Audience  string // Harus ada di claim aud, biasanya client ID atau identifier API
+	JWKSURL   string // Kosong berarti jwks_uri dari discovery document issuer
+
+	// PlanClaim dan RoleClaim adalah nama claim, atau path dipisah titik untuk claim bertingkat
+	// (misalnya "realm_access.roles" di Keycloak). Claim role boleh berupa string atau array;
+	// pengguna dianggap admin jika nilainya (atau salah satu elemennya) RoleAdmin.
+	PlanClaim string
+	RoleClaim string
+
+	// ScopeClaim adalah claim scope (lihat ScopeClaim), misalnya "scope" atau "scp" untuk
+	// memakai scope OAuth dari issuer. Token tanpa claim ini tidak dibatasi scope.
+	ScopeClaim string
+}
+
+// OIDCVerifier memverifikasi access token JWT dari issuer OIDC dengan kunci publik dari JWKS
+// issuer (RS256/384/512 atau ES256/384/512).
+type OIDCVerifier struct {
+	issuer     string
+	audience   string
+	planClaim  string
+	roleClaim  string
+	scopeClaim string
+	keys       *jwksKeySet
+	now        func() time.Time
+}
+
+// NewOIDCVerifier adalah constructor untuk OIDCVerifier. JWKS belum diambil di sini, sehingga
+// service tetap bisa start saat issuer sedang tidak tersedia.
+func NewOIDCVerifier(cfg OIDCConfig) (*OIDCVerifier, error) {
+	issuer, err := url.Parse(cfg.IssuerURL)
+	if err != nil || (issuer.Scheme != "https" && issuer.Scheme != "http") || issuer.Host == "" {
+		return nil, errors.New("oidc issuer url must be an absolute http(s) url")
+	}
+	if cfg.Audience == "" {
+		return nil, errors.New("oidc audience must be set")
+	}
+	if cfg.JWKSURL != "" {
+		if parsed, err := url.Parse(cfg.JWKSURL); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			return nil, errors.New("oidc jwks url must be an absolute http(s) url")
+		}
+	}
+	v := &OIDCVerifier{
+		issuer:     cfg.IssuerURL,
+		audience:   cfg.Audience,
+		planClaim:  cmp.Or(cfg.PlanClaim, defaultPlanClaim),
+		roleClaim:  cmp.Or(cfg.RoleClaim, defaultRoleClaim),
+		scopeClaim: cmp.Or(cfg.ScopeClaim, defaultScopeClaim),
+		now:        time.Now,
+	}
+	v.keys = &jwksKeySet{
+		url:        cfg.JWKSURL,
+		resolveURL: v.discoverJWKSURL,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		now:        time.Now,
+	}
+	return v, nil
+}
+
+// discoverJWKSURL membaca jwks_uri dari {issuer}/.well-known/openid-configuration.
+func (v *OIDCVerifier) discoverJWKSURL(ctx context.Context) (string, error) {
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	discoveryURL := strings.TrimSuffix(v.issuer, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, v.keys.client, discoveryURL, &doc); err != nil {
+		return "", fmt.Errorf("error fetching oidc discovery document: %w", err)
+	}
+	if doc.Issuer != v.issuer {
+		return "", fmt.Errorf("oidc discovery document is for issuer %q, not %q", doc.Issuer, v.issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", errors.New("oidc discovery document has no jwks_uri")
+	}
+	return doc.JWKSURI, nil
+}
+
+// oidcClaims adalah claim standar yang diperiksa sebelum token diterima.
+type oidcClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	Email     string   `json:"email"`
+	SessionID string   `json:"sid"`
+}
+
+// audience adalah claim aud, yang boleh berupa string atau array string.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// Verify memeriksa signature, issuer, audience, dan masa berlaku token, lalu memetakan claim-nya
+// ke Claims. Claim exp wajib ada. Mengembalikan ErrAuthUnavailable jika JWKS tidak bisa diambil.
+func (v *OIDCVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if err := v.keys.verify(ctx, header.Alg, header.Kid, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims oidcClaims
+	if err := decodeSegment(parts[1], &claims); err != nil || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	if claims.Issuer != v.issuer || !slices.Contains(claims.Audience, v.audience) {
+		return nil, ErrInvalidToken
+	}
+	now := v.now().Unix()
+	if claims.NotBefore != 0 && now < claims.NotBefore {
+		return nil, ErrInvalidToken
+	}
+	if claims.ExpiresAt == 0 {
+		return nil, ErrInvalidToken
+	}
+	if now >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	var raw map[string]any
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, ErrInvalidToken
+	}
+	result := &Claims{
+		Subject:   claims.Subject,
+		Email:     claims.Email,
+		ExpiresAt: claims.ExpiresAt,
+		SessionID: claims.SessionID,
+	}
+	if plan, ok := lookupClaim(raw, v.planClaim).(string); ok {
+		result.AppMetadata.Plan = plan
+	}
+	switch role := lookupClaim(raw, v.roleClaim).(type) {
+	case string:
+		result.AppMetadata.Role = role
+	case []any:
+		if slices.Contains(role, any(RoleAdmin)) {
+			result.AppMetadata.Role = RoleAdmin
+		}
+	}
+	result.AppMetadata.Scopes = scopeClaimValue(lookupClaim(raw, v.scopeClaim))
+	return result, nil
+}
+
+// Authenticate memverifikasi nilai header Authorization seperti SupabaseVerifier.Authenticate.
+func (v *OIDCVerifier) Authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	token, ok := bearerToken(authorization)
+	if !ok {
+		return nil, ErrMissingToken
+	}
+	claims, err := v.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return contextWithClaims(ctx, claims), nil
+}
+
+// lookupClaim mencari claim dengan nama persis terlebih dahulu (nama claim Auth0 berupa URL yang
+// mengandung titik), lalu sebagai path dipisah titik.
+func lookupClaim(claims map[string]any, name string) any {
+	if value, ok := claims[name]; ok {
+		return value
+	}
+	var current any = claims
+	for _, part := range strings.Split(name, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = object[part]
+	}
+	return current
+}
+
+// signatureHash mengembalikan fungsi hash untuk algoritma JWS yang didukung.
+func signatureHash(alg string) (crypto.Hash, bool) {
+	switch alg {
+	case "RS256", "ES256":
+		return crypto.SHA256, true
+	case "RS384", "ES384":
+		return crypto.SHA384, true
+	case "RS512", "ES512":
+		return crypto.SHA512, true
+	default:
+		return 0, false
+	}
+}
+
+// verifySignature memeriksa signature JWS; jenis kunci harus cocok dengan algoritma header.
+func verifySignature(alg string, hash crypto.Hash, key crypto.PublicKey, signingInput string, signature []byte) bool {
+	h := hash.New()
+	h.Write([]byte(signingInput))
+	digest := h.Sum(nil)
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return strings.HasPrefix(alg, "RS") && rsa.VerifyPKCS1v15(key, hash, digest, signature) == nil
+	case *ecdsa.PublicKey:
+		// Kurva harus sesuai dengan algoritma: ES256 dengan P-256, ES384 dengan P-384, dan ES512
+		// dengan P-521.
+		bits := key.Curve.Params().BitSize
+		if alg != fmt.Sprintf("ES%d", min(bits, 512)) {
+			return false
+		}
+		size := (bits + 7) / 8
+		if len(signature) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:size]) // r || s, masing-masing size byte big-endian
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(key, digest, r, s)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,293 @@
+// file: backend/services/task-service/internal/infrastructure/auth/personal_access_token.go
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// PersonalAccessTokenVerifier memeriksa personal access token. Diimplementasikan oleh
+// application.PersonalAccessTokenApplicationService.
+type PersonalAccessTokenVerifier interface {
+	// Authenticate mengembalikan domain.ErrPersonalAccessTokenNotFound atau
+	// domain.ErrPersonalAccessTokenExpired jika token tidak bisa dipakai, dan token beserta
+	// domain.ErrPersonalAccessTokenIPDenied jika token dipakai dari luar allowlist IP-nya.
+	Authenticate(ctx context.Context, token string) (*domain.PersonalAccessToken, error)
+}
+
+// PersonalAccessTokenFromContext mengambil personal access token yang dipakai request, jika request
+// diautentikasi dengan personal access token dan bukan JWT Supabase.
+func PersonalAccessTokenFromContext(ctx context.Context) (*domain.PersonalAccessToken, bool) {
+	token, ok := ctx.Value(personalAccessTokenKey).(*domain.PersonalAccessToken)
+	return token, ok
+}
+
+// CredentialExpiresAt mengembalikan waktu kedaluwarsa kredensial request: claim exp JWT, atau
+// ExpiresAt personal access token. false berarti kredensial tidak kedaluwarsa.
+func CredentialExpiresAt(ctx context.Context) (time.Time, bool) {
+	if pat, ok := PersonalAccessTokenFromContext(ctx); ok {
+		if pat.ExpiresAt == nil {
+			return time.Time{}, false
+		}
+		return *pat.ExpiresAt, true
+	}
+	if claims, ok := ClaimsFromContext(ctx); ok && claims.ExpiresAt != 0 {
+		return time.Unix(claims.ExpiresAt, 0), true
+	}
+	return time.Time{}, false
+}
+
+// RequireSession menolak request (403) yang diautentikasi dengan personal access token, token
+// tamu, atau JWT dengan claim scope, untuk route yang hanya boleh dipakai pengguna yang login
+// langsung, sehingga token terbatas tidak bisa menerbitkan token lain yang lebih luas. Harus
+// dipasang setelah middleware autentikasi.
+func RequireSession(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if IsGuest(r.Context()) {
+			writeAuthProblem(w, http.StatusForbidden, "guest tokens are not accepted here")
+			return
+		}
+		if _, ok := PersonalAccessTokenFromContext(r.Context()); ok {
+			writeAuthProblem(w, http.StatusForbidden, "personal access tokens are not accepted here")
+			return
+		}
+		if _, ok := ScopesFromContext(r.Context()); ok {
+			writeAuthProblem(w, http.StatusForbidden, "scoped tokens are not accepted here")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// listScopedHandler menandai route yang memeriksa batas daftar personal access token (lihat
+// domain.ContextWithAllowedLists).
+type listScopedHandler struct {
+	http.Handler
+}
+
+// ListScoped menandai route yang use case-nya memeriksa akses daftar lewat
+// application.TaskAuthorizer, sehingga boleh dipanggil token yang dibatasi ke daftar tertentu.
+// Route lain ditolak untuk token seperti itu (lihat IsListScoped).
+func ListScoped(next http.Handler) http.Handler {
+	return listScopedHandler{next}
+}
+
+// RequireList seperti ListScoped untuk route yang membaca daftar langsung dari repository:
+// daftar ditentukan path value ownerParam ("me" untuk daftar sendiri), atau daftar pengguna
+// sendiri jika ownerParam kosong. Daftar di luar batas token ditolak dengan 403.
+func RequireList(ownerParam string, next http.Handler) http.Handler {
+	return ListScoped(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ownerID, _ := UserIDFromContext(r.Context())
+		if value := r.PathValue(ownerParam); ownerParam != "" && value != "me" {
+			ownerID = domain.UserID(value)
+		}
+		if !domain.ListAllowedFromContext(r.Context(), ownerID) {
+			writeAuthProblem(w, http.StatusForbidden, domain.ErrTokenListForbidden.Error())
+			return
+		}
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// IsListScoped melaporkan apakah h dibungkus ListScoped atau RequireList.
+func IsListScoped(h http.Handler) bool {
+	_, ok := h.(listScopedHandler)
+	return ok
+}
+
+// AuthAuditor menerima hasil autentikasi setiap request untuk audit log keamanan. Diimplementasikan
+// oleh application.SecurityAuditApplicationService dan application.UserSessionApplicationService.
+type AuthAuditor interface {
+	// RecordAttempt tidak boleh memblokir request.
+	RecordAttempt(ctx context.Context, attempt domain.AuthAttempt)
+}
+
+// maxAuditUserAgentLength adalah panjang user agent maksimum yang diteruskan ke AuthAuditor.
+const maxAuditUserAgentLength = 512
+
+// AuthAuditors meneruskan setiap attempt ke semua auditor secara berurutan.
+type AuthAuditors []AuthAuditor
+
+func (a AuthAuditors) RecordAttempt(ctx context.Context, attempt domain.AuthAttempt) {
+	for _, auditor := range a {
+		auditor.RecordAttempt(ctx, attempt)
+	}
+}
+
+// Authenticator menerima JWT (Supabase atau issuer OIDC, lihat TokenVerifier), personal access
+// token (awalan domain.PersonalAccessTokenPrefix), atau token tamu (awalan
+// domain.GuestTokenPrefix) di header Authorization: Bearer.
+type Authenticator struct {
+	jwt     TokenVerifier
+	tokens  PersonalAccessTokenVerifier
+	guests  GuestVerifier
+	roles   RoleResolver
+	auditor AuthAuditor
+}
+
+// NewAuthenticator adalah constructor untuk Authenticator. guests nil berarti token tamu ditolak;
+// roles nil berarti role hanya dibaca dari claim JWT; auditor nil berarti hasil autentikasi tidak
+// dicatat.
+func NewAuthenticator(jwt TokenVerifier, tokens PersonalAccessTokenVerifier, guests GuestVerifier, roles RoleResolver, auditor AuthAuditor) *Authenticator {
+	return &Authenticator{
+		jwt:     jwt,
+		tokens:  tokens,
+		guests:  guests,
+		roles:   roles,
+		auditor: auditor,
+	}
+}
+
+// Middleware seperti SupabaseVerifier.Middleware, tetapi juga menerima personal access token.
+// Request dengan personal access token atau JWT yang membawa claim scope harus memiliki scope untuk
+// method-nya (lihat RequiredScope dan HasScope); jika tidak, request ditolak dengan 403.
+// ErrAuthUnavailable dijawab dengan 503, dan ErrIPNotAllowed dengan 403.
+// Token yang ditolak dan yang diterima diteruskan ke AuthAuditor; request tanpa token tidak.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := a.Authenticate(r.Context(), r.Header.Get("Authorization"))
+		if errors.Is(err, ErrAuthUnavailable) {
+			writeAuthProblem(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+		if errors.Is(err, ErrIPNotAllowed) {
+			a.audit(ctx, r, err)
+			writeAuthProblem(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if err != nil {
+			if !errors.Is(err, ErrMissingToken) {
+				a.audit(r.Context(), r, err)
+			}
+			unauthorized(w, err)
+			return
+		}
+		a.audit(ctx, r, nil)
+		if scope := RequiredScope(r); !HasScope(ctx, scope) {
+			writeAuthProblem(w, http.StatusForbidden, "token lacks scope "+string(scope))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// audit meneruskan hasil autentikasi r ke auditor. ctx adalah context hasil Authenticate jika
+// berhasil atau ditolak karena ErrIPNotAllowed, sehingga berisi ID pengguna dan personal access
+// token-nya.
+func (a *Authenticator) audit(ctx context.Context, r *http.Request, err error) {
+	if a.auditor == nil {
+		return
+	}
+	attempt := domain.AuthAttempt{
+		Credential: domain.CredentialJWT,
+		IP:         domain.ClientIPFromContext(ctx),
+		UserAgent:  r.UserAgent(),
+		Method:     r.Method,
+		Path:       r.URL.Path,
+	}
+	if len(attempt.UserAgent) > maxAuditUserAgentLength {
+		attempt.UserAgent = strings.ToValidUTF8(attempt.UserAgent[:maxAuditUserAgentLength], "")
+	}
+	if token, ok := bearerToken(r.Header.Get("Authorization")); ok {
+		switch {
+		case strings.HasPrefix(token, domain.PersonalAccessTokenPrefix):
+			attempt.Credential = domain.CredentialPersonalAccessToken
+		case strings.HasPrefix(token, domain.GuestTokenPrefix):
+			attempt.Credential = domain.CredentialGuest
+		}
+	}
+	if err != nil {
+		attempt.Failure = err.Error()
+	}
+	attempt.UserID, _ = UserIDFromContext(ctx)
+	if claims, ok := ClaimsFromContext(ctx); ok {
+		attempt.SessionID = claims.SessionID
+	}
+	if pat, ok := PersonalAccessTokenFromContext(ctx); ok {
+		attempt.TokenID = pat.ID
+		attempt.TokenName = pat.Name
+	}
+	a.auditor.RecordAttempt(ctx, attempt)
+}
+
+// Authenticate memverifikasi nilai header Authorization. Request dengan personal access token tidak
+// membawa claim JWT, sehingga memakai domain.DefaultPlan dan tidak pernah dianggap admin. Batas
+// daftar token disimpan ke context dengan domain.ContextWithAllowedLists. Token yang dipakai dari
+// IP di luar allowlist-nya ditolak dengan ErrIPNotAllowed, beserta context berisi token tersebut
+// untuk AuthAuditor. Untuk JWT, role dari RoleResolver baru dibaca saat dibutuhkan
+// RoleFromContext. Token tamu dibatasi ke daftarnya sendiri dengan cara yang sama seperti batas
+// daftar personal access token (lihat IsGuest).
+func (a *Authenticator) Authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	token, ok := bearerToken(authorization)
+	if ok && strings.HasPrefix(token, domain.GuestTokenPrefix) {
+		return a.authenticateGuest(ctx, token)
+	}
+	if !ok || !strings.HasPrefix(token, domain.PersonalAccessTokenPrefix) {
+		ctx, err := a.jwt.Authenticate(ctx, authorization)
+		if err != nil || a.roles == nil {
+			return ctx, err
+		}
+		userID, _ := UserIDFromContext(ctx)
+		return context.WithValue(ctx, roleStateKey, &roleState{resolver: a.roles, userID: userID}), nil
+	}
+	pat, err := a.tokens.Authenticate(ctx, token)
+	switch {
+	case errors.Is(err, domain.ErrPersonalAccessTokenIPDenied):
+		ctx = WithUserID(ctx, pat.UserID)
+		return context.WithValue(ctx, personalAccessTokenKey, pat), ErrIPNotAllowed
+	case errors.Is(err, domain.ErrPersonalAccessTokenNotFound):
+		return nil, ErrInvalidToken
+	case errors.Is(err, domain.ErrPersonalAccessTokenExpired):
+		return nil, ErrTokenExpired
+	case err != nil:
+		slog.ErrorContext(ctx, "error authenticating personal access token", "error", err)
+		return nil, ErrAuthUnavailable
+	}
+	ctx = WithUserID(ctx, pat.UserID)
+	ctx = context.WithValue(ctx, personalAccessTokenKey, pat)
+	ctx = domain.ContextWithAllowedLists(ctx, pat.Lists)
+	return ctx, nil
+}
+
+// authenticateGuest memverifikasi token tamu lewat GuestVerifier.
+func (a *Authenticator) authenticateGuest(ctx context.Context, token string) (context.Context, error) {
+	if a.guests == nil {
+		return nil, ErrInvalidToken
+	}
+	guestID, err := a.guests.Authenticate(ctx, token)
+	switch {
+	case errors.Is(err, domain.ErrInvalidGuestToken), errors.Is(err, domain.ErrGuestAlreadyMerged), errors.Is(err, domain.ErrGuestModeDisabled):
+		return nil, ErrInvalidToken
+	case err != nil:
+		slog.ErrorContext(ctx, "error authenticating guest token", "error", err)
+		return nil, ErrAuthUnavailable
+	}
+	ctx = WithUserID(ctx, guestID)
+	ctx = context.WithValue(ctx, guestKey, true)
+	ctx = domain.ContextWithAllowedLists(ctx, []domain.UserID{guestID})
+	return ctx, nil
+}
+
+// graphQLPath adalah endpoint GraphQL. Skemanya hanya berisi query, sehingga request ke sana hanya
+// membaca data meskipun memakai POST.
+const graphQLPath = "/graphql"
+
+// RequiredScope memetakan request ke scope yang dibutuhkan personal access token: method yang
+// hanya membaca dan endpoint GraphQL membutuhkan tasks:read, selain itu tasks:write.
+func RequiredScope(r *http.Request) domain.TokenScope {
+	if r.URL.Path == graphQLPath {
+		return domain.ScopeTasksRead
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return domain.ScopeTasksRead
+	default:
+		return domain.ScopeTasksWrite
+	}
+}
@@ -0,0 +1,82 @@
+// file: backend/services/task-service/internal/infrastructure/auth/role.go
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// RoleResolver membaca role tersimpan pengguna. Diimplementasikan oleh
+// application.RoleApplicationService.
+type RoleResolver interface {
+	ResolveRole(ctx context.Context, userID domain.UserID) (domain.Role, error)
+}
+
+// roleState menunda pembacaan role dari RoleResolver sampai route membutuhkannya, sehingga
+// request biasa tidak menambah query database. Hasilnya di-cache untuk sisa request.
+type roleState struct {
+	resolver RoleResolver
+	userID   domain.UserID
+
+	once sync.Once
+	role domain.Role
+	err  error
+}
+
+func (s *roleState) resolve(ctx context.Context) (domain.Role, error) {
+	s.once.Do(func() {
+		s.role, s.err = s.resolver.ResolveRole(ctx, s.userID)
+	})
+	return s.role, s.err
+}
+
+// RoleFromContext mengembalikan role pengguna yang sedang login: domain.RoleAdmin jika claim
+// app_metadata.role JWT bernilai admin, jika tidak role dari RoleResolver Authenticator, atau
+// domain.RoleUser jika tidak ada resolver. Request dengan personal access token selalu
+// domain.RoleUser. Error hanya dikembalikan jika resolver gagal.
+func RoleFromContext(ctx context.Context) (domain.Role, error) {
+	if _, ok := PersonalAccessTokenFromContext(ctx); ok {
+		return domain.RoleUser, nil
+	}
+	if claims, ok := ClaimsFromContext(ctx); ok && claims.AppMetadata.Role == RoleAdmin {
+		return domain.RoleAdmin, nil
+	}
+	if state, ok := ctx.Value(roleStateKey).(*roleState); ok {
+		return state.resolve(ctx)
+	}
+	return domain.RoleUser, nil
+}
+
+// permissionCheckedHandler menandai route yang dibungkus RequirePermission.
+type permissionCheckedHandler struct {
+	http.Handler
+}
+
+// RequirePermission menolak request (403) dari pengguna yang role-nya tidak memiliki permission p
+// (lihat domain.Role.Allows), atau 503 jika role tidak bisa dibaca. Harus dipasang setelah
+// middleware autentikasi.
+func RequirePermission(p domain.Permission, next http.Handler) http.Handler {
+	return permissionCheckedHandler{http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		role, err := RoleFromContext(r.Context())
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error resolving user role", "error", err)
+			writeAuthProblem(w, http.StatusServiceUnavailable, ErrAuthUnavailable.Error())
+			return
+		}
+		if !role.Allows(p) {
+			writeAuthProblem(w, http.StatusForbidden, "permission "+string(p)+" required")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})}
+}
+
+// IsPermissionChecked melaporkan apakah h dibungkus RequirePermission.
+func IsPermissionChecked(h http.Handler) bool {
+	_, ok := h.(permissionCheckedHandler)
+	return ok
+}
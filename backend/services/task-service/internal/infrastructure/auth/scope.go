@@ -0,0 +1,71 @@
+// file: backend/services/task-service/internal/infrastructure/auth/scope.go
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// ScopeClaim adalah claim scope JWT, yang boleh berupa array string atau string dipisah spasi
+// (format claim scope OAuth 2.0). nil berarti claim tidak ada; claim kosong menghasilkan slice
+// kosong, yang berarti token tidak memiliki scope apa pun.
+type ScopeClaim []string
+
+func (s *ScopeClaim) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = scopeClaimValue(value)
+	return nil
+}
+
+// scopeClaimValue mengubah nilai claim hasil decode JSON menjadi ScopeClaim. Elemen array yang
+// bukan string diabaikan; tipe lain dianggap claim tidak ada.
+func scopeClaimValue(value any) ScopeClaim {
+	switch value := value.(type) {
+	case string:
+		return append(ScopeClaim{}, strings.Fields(value)...)
+	case []any:
+		scopes := ScopeClaim{}
+		for _, element := range value {
+			if scope, ok := element.(string); ok {
+				scopes = append(scopes, scope)
+			}
+		}
+		return scopes
+	default:
+		return nil
+	}
+}
+
+// ScopesFromContext mengembalikan scope kredensial request: scope personal access token, atau
+// claim scope JWT (lihat AppMetadata.Scopes). Scope yang tidak dikenal domain.TokenScopes, misalnya
+// "openid", diabaikan. ok false berarti kredensial tidak dibatasi scope, yaitu JWT tanpa claim
+// scope.
+func ScopesFromContext(ctx context.Context) (scopes []domain.TokenScope, ok bool) {
+	if token, ok := PersonalAccessTokenFromContext(ctx); ok {
+		return token.Scopes, true
+	}
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok || claims.AppMetadata.Scopes == nil {
+		return nil, false
+	}
+	for _, scope := range claims.AppMetadata.Scopes {
+		if scope := domain.TokenScope(scope); slices.Contains(domain.TokenScopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, true
+}
+
+// HasScope melaporkan apakah kredensial request memiliki scope. Kredensial yang tidak dibatasi
+// scope (lihat ScopesFromContext) selalu diizinkan.
+func HasScope(ctx context.Context, scope domain.TokenScope) bool {
+	scopes, ok := ScopesFromContext(ctx)
+	return !ok || domain.HasTokenScope(scopes, scope)
+}
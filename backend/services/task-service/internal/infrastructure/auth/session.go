@@ -0,0 +1,51 @@
+// file: backend/services/task-service/internal/infrastructure/auth/session.go
+package auth
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// SessionChecker memeriksa apakah sesi login sudah dicabut. Diimplementasikan oleh
+// application.UserSessionApplicationService.
+type SessionChecker interface {
+	SessionRevoked(ctx context.Context, userID domain.UserID, sessionID string) (bool, error)
+}
+
+// SessionVerifier membungkus TokenVerifier dan menolak JWT yang claim sesinya (Claims.SessionID)
+// sudah dicabut dengan ErrSessionRevoked. JWT tanpa claim sesi tidak diperiksa.
+type SessionVerifier struct {
+	verifier TokenVerifier
+	sessions SessionChecker
+}
+
+// NewSessionVerifier adalah constructor untuk SessionVerifier.
+func NewSessionVerifier(verifier TokenVerifier, sessions SessionChecker) *SessionVerifier {
+	return &SessionVerifier{
+		verifier: verifier,
+		sessions: sessions,
+	}
+}
+
+// Authenticate mengembalikan ErrAuthUnavailable jika status sesi tidak bisa dibaca.
+func (v *SessionVerifier) Authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	authCtx, err := v.verifier.Authenticate(ctx, authorization)
+	if err != nil {
+		return authCtx, err
+	}
+	claims, ok := ClaimsFromContext(authCtx)
+	if !ok || claims.SessionID == "" {
+		return authCtx, nil
+	}
+	revoked, err := v.sessions.SessionRevoked(ctx, domain.UserID(claims.Subject), claims.SessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking session", "session_id", claims.SessionID, "error", err)
+		return nil, ErrAuthUnavailable
+	}
+	if revoked {
+		return nil, ErrSessionRevoked
+	}
+	return authCtx, nil
+}
@@ -0,0 +1,250 @@
+// file: backend/services/task-service/internal/infrastructure/auth/supabase_jwt.go
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/logging"
+)
+
+// Definisikan error autentikasi yang umum
+var (
+	ErrMissingToken   = errors.New("missing bearer token")
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrTokenExpired   = errors.New("token expired")
+	ErrSessionRevoked = errors.New("session revoked")
+	ErrIPNotAllowed   = errors.New("ip address not allowed for this token")
+
+	// ErrAuthUnavailable dikembalikan saat token tidak bisa diperiksa karena error selain token
+	// yang tidak valid, misalnya database atau JWKS issuer tidak tersedia.
+	ErrAuthUnavailable = errors.New("authentication unavailable")
+)
+
+// TokenVerifier memverifikasi nilai header Authorization ("Bearer <token>") lalu mengembalikan
+// context berisi ID pengguna, claim, dan plan-nya. Diimplementasikan oleh SupabaseVerifier dan
+// OIDCVerifier.
+type TokenVerifier interface {
+	Authenticate(ctx context.Context, authorization string) (context.Context, error)
+}
+
+// Claims adalah subset claim JWT Supabase Auth yang dipakai oleh task-service. Token dari issuer
+// OIDC lain dipetakan ke bentuk yang sama oleh OIDCVerifier.
+type Claims struct {
+	Subject     string      `json:"sub"`   // ID pengguna Supabase
+	Role        string      `json:"role"`  // Biasanya "authenticated"
+	Email       string      `json:"email"` // Alamat email akun di Supabase Auth
+	ExpiresAt   int64       `json:"exp"`
+	SessionID   string      `json:"session_id,omitempty"` // Sesi login Supabase Auth atau akun lokal (lihat domain.UserSession)
+	AppMetadata AppMetadata `json:"app_metadata"`
+}
+
+// AppMetadata adalah claim app_metadata Supabase, yang hanya bisa diubah dari sisi server
+// (service role), sehingga aman dipakai untuk plan dan role aplikasi.
+type AppMetadata struct {
+	Plan   string     `json:"plan"`             // Paket langganan, misalnya "free" atau "pro"
+	Role   string     `json:"role"`             // Role aplikasi, "admin" untuk admin
+	Scopes ScopeClaim `json:"scopes,omitempty"` // Scope token; nil berarti token tidak dibatasi scope
+}
+
+// RoleAdmin adalah nilai app_metadata.role untuk admin.
+const RoleAdmin = string(domain.RoleAdmin)
+
+type contextKey int
+
+const (
+	userIDKey contextKey = iota
+	claimsKey
+	personalAccessTokenKey
+	roleStateKey
+	guestKey
+)
+
+// WithUserID menyimpan ID pengguna yang sudah terautentikasi ke dalam context, termasuk sebagai
+// field log user_id.
+func WithUserID(ctx context.Context, userID domain.UserID) context.Context {
+	ctx = logging.With(ctx, "user_id", userID)
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext mengambil ID pengguna yang disimpan oleh middleware autentikasi.
+func UserIDFromContext(ctx context.Context) (domain.UserID, bool) {
+	userID, ok := ctx.Value(userIDKey).(domain.UserID)
+	return userID, ok && userID != ""
+}
+
+// ClaimsFromContext mengambil claim token yang disimpan oleh middleware autentikasi.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*Claims)
+	return claims, ok
+}
+
+// IsAdmin bernilai true jika pengguna yang sedang login memiliki role admin (lihat
+// RoleFromContext). Error saat membaca role dianggap bukan admin.
+func IsAdmin(ctx context.Context) bool {
+	role, err := RoleFromContext(ctx)
+	return err == nil && role == domain.RoleAdmin
+}
+
+// SupabaseVerifier memverifikasi access token Supabase yang ditandatangani dengan HS256
+// menggunakan JWT secret project, atau dengan signing key asimetris project (RS256 atau ES256)
+// dari JWKS Supabase Auth.
+type SupabaseVerifier struct {
+	secret func() string
+	keys   *jwksKeySet // Nil berarti hanya HS256
+	now    func() time.Time
+}
+
+// NewSupabaseVerifier adalah constructor untuk SupabaseVerifier. jwtSecret dipanggil untuk setiap
+// token, sehingga secret yang dirotasi (lihat package secrets) langsung berlaku; secret kosong
+// berarti token HS256 ditolak. jwksURL kosong berarti token asimetris ditolak. JWKS belum diambil
+// di sini, sehingga service tetap bisa start saat Supabase sedang tidak tersedia.
+func NewSupabaseVerifier(jwtSecret func() string, jwksURL string) *SupabaseVerifier {
+	v := &SupabaseVerifier{
+		secret: jwtSecret,
+		now:    time.Now,
+	}
+	if jwksURL != "" {
+		v.keys = &jwksKeySet{
+			url:    jwksURL,
+			client: &http.Client{Timeout: 10 * time.Second},
+			now:    time.Now,
+		}
+	}
+	return v
+}
+
+// Verify memeriksa signature dan masa berlaku token, lalu mengembalikan claim-nya. Token tanpa claim
+// exp ditolak dengan ErrInvalidToken, seperti di OIDCVerifier, karena akan berlaku selamanya.
+// Mengembalikan ErrAuthUnavailable jika JWKS dibutuhkan tetapi tidak bisa diambil.
+func (v *SupabaseVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	switch {
+	case header.Alg == "HS256":
+		secret := v.secret()
+		if secret == "" {
+			return nil, ErrInvalidToken
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return nil, ErrInvalidToken
+		}
+	case v.keys != nil:
+		if err := v.keys.verify(ctx, header.Alg, header.Kid, parts[0]+"."+parts[1], signature); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidToken
+	}
+
+	claims := &Claims{}
+	if err := decodeSegment(parts[1], claims); err != nil || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	if claims.ExpiresAt == 0 {
+		return nil, ErrInvalidToken
+	}
+	if v.now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return claims, nil
+}
+
+// Middleware mewajibkan header Authorization: Bearer <token> yang valid,
+// dan menyimpan ID pengguna (claim sub) ke context request.
+func (v *SupabaseVerifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := v.Authenticate(r.Context(), r.Header.Get("Authorization"))
+		if err != nil {
+			unauthorized(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Authenticate memverifikasi nilai header Authorization ("Bearer <token>") lalu mengembalikan
+// context berisi ID pengguna, claim, dan plan-nya. Dipakai Middleware dan transport lain seperti
+// gRPC yang membawa header yang sama lewat metadata.
+func (v *SupabaseVerifier) Authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	token, ok := bearerToken(authorization)
+	if !ok {
+		return nil, ErrMissingToken
+	}
+	claims, err := v.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return contextWithClaims(ctx, claims), nil
+}
+
+// contextWithClaims menyimpan ID pengguna, claim, dan plan dari token yang sudah diverifikasi.
+func contextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	ctx = WithUserID(ctx, domain.UserID(claims.Subject))
+	ctx = context.WithValue(ctx, claimsKey, claims)
+	return domain.ContextWithPlan(ctx, domain.Plan(claims.AppMetadata.Plan))
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func decodeSegment(segment string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// unauthorized menulis response 401 dengan header WWW-Authenticate.
+func unauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="task-service"`)
+	writeAuthProblem(w, http.StatusUnauthorized, err.Error())
+}
+
+// writeAuthProblem menulis response error dalam format problem+json yang sama dengan layer REST,
+// termasuk request_id dari header X-Request-ID response.
+func writeAuthProblem(w http.ResponseWriter, status int, detail string) {
+	body := map[string]any{
+		"type":   "about:blank",
+		"title":  http.StatusText(status),
+		"status": status,
+		"detail": detail,
+	}
+	if id := w.Header().Get(domain.HeaderRequestID); id != "" {
+		body["request_id"] = id
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
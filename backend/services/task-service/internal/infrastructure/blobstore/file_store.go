@@ -0,0 +1,94 @@
+// file: backend/services/task-service/internal/infrastructure/blobstore/file_store.go
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// FileStore adalah implementasi domain.BlobStore di filesystem lokal (atau volume yang di-mount).
+// Key berupa path relatif dengan pemisah "/", misalnya "archives/<user_id>/<timestamp>.json.gz".
+type FileStore struct {
+	root string
+}
+
+// NewFileStore adalah constructor untuk FileStore. Direktori root dibuat jika belum ada.
+func NewFileStore(root string) (domain.BlobStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating blob store directory %s: %w", root, err)
+	}
+	return &FileStore{root: root}, nil
+}
+
+// path memetakan key ke path file dan menolak key yang keluar dari root (misalnya "../").
+func (s *FileStore) path(key string) (string, error) {
+	if key == "" || !fs.ValidPath(key) || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Put menulis ke file sementara lalu me-rename-nya, sehingga pembaca tidak pernah melihat objek setengah jadi.
+func (s *FileStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("error creating blob directory for %s: %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("error creating blob %s: %w", key, err)
+	}
+	defer os.Remove(tmp.Name()) // Tidak berpengaruh setelah rename berhasil
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing blob %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing blob %s: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error storing blob %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get membuka file milik key.
+func (s *FileStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domain.ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("error opening blob %s: %w", key, err)
+	}
+	return f, nil
+}
+
+// Delete menghapus file milik key.
+func (s *FileStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting blob %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// file: backend/services/task-service/internal/infrastructure/circuitbreaker/breaker.go
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/sony/gobreaker/v2"
+)
+
+// Config adalah pengaturan Breaker.
+type Config struct {
+	// ConsecutiveFailures adalah jumlah kegagalan beruntun yang membuka breaker; 0 berarti
+	// breaker tidak pernah terbuka.
+	ConsecutiveFailures uint32
+
+	// OpenTimeout adalah lama breaker terbuka sebelum mencoba lagi (half-open).
+	OpenTimeout time.Duration
+
+	// HalfOpenRequests adalah jumlah percobaan saat half-open; jika semuanya berhasil breaker
+	// tertutup, jika satu gagal breaker terbuka lagi.
+	HalfOpenRequests uint32
+}
+
+// Breaker adalah circuit breaker untuk satu dependensi. Saat terbuka, pemanggilan langsung gagal
+// dengan domain.ErrDependencyUnavailable.
+type Breaker struct {
+	name string
+	cb   *gobreaker.TwoStepCircuitBreaker[struct{}]
+}
+
+// New adalah constructor untuk Breaker. isFailure menentukan error yang dihitung sebagai kegagalan
+// dependensi; error lain (misalnya validasi atau data tidak ditemukan) dihitung sebagai berhasil,
+// dan context.Canceled tidak dihitung sama sekali.
+func New(name string, cfg Config, isFailure func(error) bool) *Breaker {
+	return &Breaker{
+		name: name,
+		cb: gobreaker.NewTwoStepCircuitBreaker[struct{}](gobreaker.Settings{
+			Name:        name,
+			MaxRequests: cfg.HalfOpenRequests,
+			Timeout:     cfg.OpenTimeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return cfg.ConsecutiveFailures > 0 && counts.ConsecutiveFailures >= cfg.ConsecutiveFailures
+			},
+			OnStateChange: func(name string, from, to gobreaker.State) {
+				if to == gobreaker.StateOpen {
+					slog.Warn("circuit breaker opened", "breaker", name, "from", from.String())
+					return
+				}
+				slog.Info("circuit breaker state changed", "breaker", name, "from", from.String(), "to", to.String())
+			},
+			IsSuccessful: func(err error) bool {
+				return err == nil || !isFailure(err)
+			},
+			IsExcluded: func(err error) bool {
+				return errors.Is(err, context.Canceled)
+			},
+		}),
+	}
+}
+
+// Open melaporkan apakah breaker sedang terbuka.
+func (b *Breaker) Open() bool {
+	return b.cb.State() == gobreaker.StateOpen
+}
+
+// Err mengembalikan error yang dipakai saat breaker terbuka.
+func (b *Breaker) Err() error {
+	return fmt.Errorf("circuit breaker %s is open: %w", b.name, domain.ErrDependencyUnavailable)
+}
+
+// Do menjalankan fn dan mencatat hasilnya. Saat breaker terbuka, fn tidak dipanggil dan Do
+// mengembalikan Err. Saat half-open, pemanggilan di luar jatah percobaan tetap dijalankan tanpa
+// dicatat.
+func (b *Breaker) Do(fn func() error) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+	err = fn()
+	done(err)
+	return err
+}
+
+// Allow adalah versi dua langkah Do untuk pemanggilan yang hasilnya diketahui di tempat lain
+// (misalnya tracer pgx): done mencatat hasil pemanggilan. Allow hanya gagal saat breaker terbuka.
+func (b *Breaker) Allow() (done func(error), err error) {
+	done, err = b.cb.Allow()
+	switch {
+	case errors.Is(err, gobreaker.ErrOpenState):
+		return nil, b.Err()
+	case err != nil: // gobreaker.ErrTooManyRequests saat half-open
+		return func(error) {}, nil
+	}
+	return done, nil
+}
@@ -0,0 +1,112 @@
+// file: backend/services/task-service/internal/infrastructure/discord/client.go
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// apiBaseURL adalah base URL Discord REST API.
+const apiBaseURL = "https://discord.com/api/v10"
+
+// maxRetryAfter adalah batas jeda rate limit yang masih ditunggu sebelum mencoba ulang sekali.
+// Jeda yang lebih lama dikembalikan sebagai error agar worker notifier tidak tertahan.
+const maxRetryAfter = 5 * time.Second
+
+// Client adalah implementasi domain.DiscordClient dengan Discord REST API.
+type Client struct {
+	botToken string // Kosong berarti mode bot tidak tersedia
+	client   *http.Client
+}
+
+// NewClient adalah constructor untuk Client. botToken boleh kosong jika hanya incoming webhook yang dipakai.
+func NewClient(botToken string) *Client {
+	return &Client{
+		botToken: botToken,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// message adalah body request pesan Discord. allowed_mentions selalu kosong agar judul task
+// seperti "@everyone" tidak menjadi mention.
+type message struct {
+	domain.DiscordMessage
+	AllowedMentions allowedMentions `json:"allowed_mentions"`
+}
+
+type allowedMentions struct {
+	Parse []string `json:"parse"`
+}
+
+// SendWebhook mengirim pesan ke incoming webhook Discord.
+func (c *Client) SendWebhook(ctx context.Context, webhookURL string, msg domain.DiscordMessage) error {
+	return c.post(ctx, webhookURL, "", msg)
+}
+
+// SendChannelMessage mengirim pesan ke channel sebagai bot.
+func (c *Client) SendChannelMessage(ctx context.Context, channelID string, msg domain.DiscordMessage) error {
+	if c.botToken == "" {
+		return domain.ErrDiscordIntegrationMissing
+	}
+	return c.post(ctx, apiBaseURL+"/channels/"+channelID+"/messages", "Bot "+c.botToken, msg)
+}
+
+// post mengirim pesan dan mencoba ulang sekali jika Discord menjawab 429 dengan jeda yang pendek.
+func (c *Client) post(ctx context.Context, target, authorization string, msg domain.DiscordMessage) error {
+	body, err := json.Marshal(message{DiscordMessage: msg, AllowedMentions: allowedMentions{Parse: []string{}}})
+	if err != nil {
+		return fmt.Errorf("error encoding discord message: %w", err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("error creating discord request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("error sending discord request: %w", err)
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+			return nil
+		case resp.StatusCode == http.StatusTooManyRequests && attempt == 0:
+			wait := retryAfter(resp.Header)
+			if wait > maxRetryAfter {
+				return fmt.Errorf("discord rate limited for %s", wait)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		default:
+			return fmt.Errorf("discord responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+		}
+	}
+}
+
+// retryAfter membaca header Retry-After (detik, boleh pecahan) dari response 429.
+func retryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.ParseFloat(header.Get("Retry-After"), 64)
+	if err != nil || seconds < 0 {
+		return time.Second
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
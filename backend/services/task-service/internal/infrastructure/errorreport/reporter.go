@@ -0,0 +1,63 @@
+// file: backend/services/task-service/internal/infrastructure/errorreport/reporter.go
+package errorreport
+
+import (
+	"context"
+	"runtime"
+	"strings"
+)
+
+// maxFrames adalah jumlah frame stack terbanyak yang disimpan di Report.
+const maxFrames = 64
+
+// Report adalah panic yang ditangkap middleware recovery HTTP atau interceptor gRPC.
+type Report struct {
+	Value  any             // Nilai yang diberikan ke panic
+	Stack  []byte          // Stack trace goroutine dalam format debug.Stack, untuk log
+	Frames []runtime.Frame // Frame stack mulai dari fungsi yang panic ke arah pemanggil
+
+	Method    string // Method HTTP atau nama lengkap method gRPC
+	Route     string // Pattern route HTTP; kosong untuk gRPC
+	URL       string // Path request HTTP; kosong untuk gRPC
+	RequestID string
+}
+
+// Reporter mengirim Report ke layanan pelaporan error seperti Sentry. Report dipanggil dari
+// goroutine request, jadi implementasi tidak boleh memblokir lama.
+type Reporter interface {
+	Report(ctx context.Context, report Report)
+}
+
+// Nop adalah Reporter yang tidak mengirim apa pun, dipakai jika pelaporan error tidak dikonfigurasi.
+type Nop struct{}
+
+// Report tidak melakukan apa pun.
+func (Nop) Report(context.Context, Report) {}
+
+// Frames mengembalikan frame stack panic yang sedang ditangkap. Dipanggil dari fungsi deferred
+// yang memanggil recover; frame milik runtime (runtime.gopanic dan sejenisnya) serta frame di
+// atasnya dilewati, sehingga frame pertama adalah fungsi yang panic.
+func Frames() []runtime.Frame {
+	pcs := make([]uintptr, maxFrames)
+	n := runtime.Callers(2, pcs)
+	iter := runtime.CallersFrames(pcs[:n])
+	var frames []runtime.Frame
+	for {
+		frame, more := iter.Next()
+		frames = append(frames, frame)
+		if !more {
+			break
+		}
+	}
+	for i, frame := range frames {
+		if frame.Function != "runtime.gopanic" {
+			continue
+		}
+		start := i + 1
+		for start < len(frames) && strings.HasPrefix(frames[start].Function, "runtime.") {
+			start++
+		}
+		return frames[start:]
+	}
+	return frames
+}
@@ -0,0 +1,198 @@
+// file: backend/services/task-service/internal/infrastructure/errorreport/sentry.go
+package errorreport
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// sentryMaxInFlight membatasi laporan yang dikirim bersamaan; laporan berikutnya dibuang agar
+// rentetan panic tidak menumpuk goroutine.
+const sentryMaxInFlight = 8
+
+// inAppPrefix menandai frame milik kode service ini (in_app di Sentry).
+const inAppPrefix = "github.com/TubagusAldiMY/go-vue-todolist/"
+
+// SentryConfig adalah pengaturan pelaporan ke Sentry.
+type SentryConfig struct {
+	DSN         string // https://<public key>@<host>/<project id>
+	Environment string // Opsional, misalnya "production"
+	Release     string // Opsional, versi build
+}
+
+// Sentry adalah implementasi Reporter yang mengirim panic sebagai event Sentry lewat endpoint
+// envelope, tanpa SDK.
+type Sentry struct {
+	endpoint    string
+	auth        string
+	dsn         string
+	environment string
+	release     string
+	serverName  string
+	client      *http.Client
+	inFlight    chan struct{}
+}
+
+// NewSentry adalah constructor untuk Sentry.
+func NewSentry(cfg SentryConfig) (*Sentry, error) {
+	dsn, err := url.Parse(cfg.DSN)
+	if err != nil || (dsn.Scheme != "https" && dsn.Scheme != "http") || dsn.Host == "" || dsn.User == nil {
+		return nil, errors.New("sentry dsn must look like https://<key>@<host>/<project>")
+	}
+	key := dsn.User.Username()
+	prefix, projectID := "", strings.Trim(dsn.Path, "/")
+	if i := strings.LastIndex(projectID, "/"); i >= 0 {
+		prefix, projectID = "/"+projectID[:i], projectID[i+1:]
+	}
+	if key == "" || projectID == "" {
+		return nil, errors.New("sentry dsn must include a public key and project id")
+	}
+	serverName, _ := os.Hostname()
+	return &Sentry{
+		endpoint:    fmt.Sprintf("%s://%s%s/api/%s/envelope/", dsn.Scheme, dsn.Host, prefix, projectID),
+		auth:        "Sentry sentry_version=7, sentry_client=task-service/1.0, sentry_key=" + key,
+		dsn:         cfg.DSN,
+		environment: cfg.Environment,
+		release:     cfg.Release,
+		serverName:  serverName,
+		client:      &http.Client{Timeout: 5 * time.Second},
+		inFlight:    make(chan struct{}, sentryMaxInFlight),
+	}, nil
+}
+
+// sentryEvent adalah subset payload event Sentry yang dipakai.
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Transaction string            `json:"transaction,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Request     *sentryRequest    `json:"request,omitempty"`
+	Exception   struct {
+		Values []sentryException `json:"values"`
+	} `json:"exception"`
+}
+
+type sentryRequest struct {
+	Method string `json:"method,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+type sentryException struct {
+	Type       string `json:"type"`
+	Value      string `json:"value"`
+	Stacktrace struct {
+		Frames []sentryFrame `json:"frames"`
+	} `json:"stacktrace"`
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Filename string `json:"filename"`
+	AbsPath  string `json:"abs_path"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+// Report mengirim report di goroutine terpisah. Laporan dibuang jika sudah ada
+// sentryMaxInFlight laporan yang sedang dikirim; kegagalan kirim hanya dicatat di log.
+func (s *Sentry) Report(ctx context.Context, report Report) {
+	select {
+	case s.inFlight <- struct{}{}:
+	default:
+		slog.WarnContext(ctx, "sentry: dropping panic report, too many in flight")
+		return
+	}
+	event := s.event(report)
+	go func() {
+		defer func() { <-s.inFlight }()
+		ctx := context.WithoutCancel(ctx) // Request yang panic sudah selesai
+		if err := s.send(ctx, event); err != nil {
+			slog.ErrorContext(ctx, "sentry: error sending panic report", "error", err)
+		}
+	}()
+}
+
+func (s *Sentry) event(report Report) sentryEvent {
+	id := make([]byte, 16)
+	rand.Read(id)
+	event := sentryEvent{
+		EventID:     hex.EncodeToString(id),
+		Timestamp:   time.Now().UTC(),
+		Platform:    "go",
+		Level:       "fatal",
+		ServerName:  s.serverName,
+		Environment: s.environment,
+		Release:     s.release,
+		Transaction: report.Route,
+	}
+	if event.Transaction == "" {
+		event.Transaction = report.Method
+	}
+	if report.RequestID != "" {
+		event.Tags = map[string]string{"request_id": report.RequestID}
+	}
+	if report.URL != "" {
+		event.Request = &sentryRequest{Method: report.Method, URL: report.URL}
+	}
+	exception := sentryException{Type: "panic", Value: fmt.Sprint(report.Value)}
+	if err, ok := report.Value.(error); ok {
+		exception.Type = fmt.Sprintf("%T", err)
+	}
+	// Sentry mengharapkan frame dari pemanggil terluar ke frame yang panic.
+	for _, frame := range slices.Backward(report.Frames) {
+		exception.Stacktrace.Frames = append(exception.Stacktrace.Frames, sentryFrame{
+			Function: frame.Function,
+			Filename: frame.File,
+			AbsPath:  frame.File,
+			Lineno:   frame.Line,
+			InApp:    strings.HasPrefix(frame.Function, inAppPrefix),
+		})
+	}
+	event.Exception.Values = []sentryException{exception}
+	return event
+}
+
+// send menulis event sebagai envelope: header envelope, header item, lalu payload event.
+func (s *Sentry) send(ctx context.Context, event sentryEvent) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	encoder.Encode(map[string]any{"event_id": event.EventID, "dsn": s.dsn, "sent_at": time.Now().UTC()})
+	encoder.Encode(map[string]string{"type": "event"})
+	if err := encoder.Encode(event); err != nil {
+		return fmt.Errorf("error encoding sentry event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", s.auth)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("sentry returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// file: backend/services/task-service/internal/infrastructure/faultinject/injector.go
+package faultinject
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Config adalah pengaturan fault injection. Hanya untuk pengujian ketahanan (frontend, retry
+// klien, circuit breaker); jangan diaktifkan di production.
+type Config struct {
+	Latency   time.Duration // Jeda tambahan setiap operasi
+	Jitter    time.Duration // Jeda acak tambahan antara 0 dan Jitter
+	ErrorRate float64       // Peluang (0 sampai 1) operasi gagal dengan error buatan
+
+	// Operations membatasi operasi yang terkena, misalnya "TaskRepository.FindByID" atau
+	// "Notifier.emailNotifier"; kosong berarti semua operasi.
+	Operations []string
+}
+
+// Injector menyisipkan latency dan error buatan ke operasi yang dibungkus decorator di paket ini.
+type Injector struct {
+	cfg Config
+}
+
+// New adalah constructor untuk Injector.
+func New(cfg Config) *Injector {
+	return &Injector{cfg: cfg}
+}
+
+// Inject menunggu latency yang dikonfigurasi lalu, sesuai ErrorRate, mengembalikan error yang
+// membungkus domain.ErrDependencyUnavailable (dijawab 503 seperti saat database gagal). Jika ctx
+// selesai selama menunggu, ctx.Err() yang dikembalikan.
+func (i *Injector) Inject(ctx context.Context, operation string) error {
+	if len(i.cfg.Operations) > 0 && !slices.Contains(i.cfg.Operations, operation) {
+		return nil
+	}
+	delay := i.cfg.Latency
+	if i.cfg.Jitter > 0 {
+		delay += rand.N(i.cfg.Jitter)
+	}
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if i.cfg.ErrorRate > 0 && rand.Float64() < i.cfg.ErrorRate {
+		return fmt.Errorf("fault injected in %s: %w", operation, domain.ErrDependencyUnavailable)
+	}
+	return nil
+}
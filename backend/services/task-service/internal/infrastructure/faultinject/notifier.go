@@ -0,0 +1,42 @@
+// file: backend/services/task-service/internal/infrastructure/faultinject/notifier.go
+package faultinject
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// eventNotifier sama dengan application.EventNotifier.
+type eventNotifier interface {
+	Notify(ctx context.Context, event domain.TaskEvent)
+}
+
+// Notifier adalah decorator notifier event. Karena Notify tidak mengembalikan error, event yang
+// terkena error buatan tidak diteruskan dan hanya dicatat di log.
+type Notifier struct {
+	next      eventNotifier
+	operation string
+	injector  *Injector
+}
+
+// NewNotifier membungkus next. Nama operasinya "Notifier.<tipe>", misalnya "Notifier.emailNotifier".
+func NewNotifier(next eventNotifier, injector *Injector) *Notifier {
+	name := fmt.Sprintf("%T", next)
+	return &Notifier{
+		next:      next,
+		operation: "Notifier." + name[strings.LastIndex(name, ".")+1:],
+		injector:  injector,
+	}
+}
+
+func (n *Notifier) Notify(ctx context.Context, event domain.TaskEvent) {
+	if err := n.injector.Inject(ctx, n.operation); err != nil {
+		slog.WarnContext(ctx, "event not delivered", "event_type", event.Type, "task_id", event.TaskID, "error", err)
+		return
+	}
+	n.next.Notify(ctx, event)
+}
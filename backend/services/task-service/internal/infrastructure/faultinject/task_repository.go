@@ -0,0 +1,209 @@
+// file: backend/services/task-service/internal/infrastructure/faultinject/task_repository.go
+package faultinject
+
+import (
+	"context"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// taskRepository adalah decorator domain.TaskRepository yang menjalankan Injector sebelum setiap
+// method. Operasi yang terkena error buatan tidak diteruskan ke repository asli.
+type taskRepository struct {
+	next     domain.TaskRepository
+	injector *Injector
+}
+
+// NewTaskRepository membungkus next. Nama operasinya "TaskRepository.<method>".
+func NewTaskRepository(next domain.TaskRepository, injector *Injector) domain.TaskRepository {
+	return &taskRepository{next: next, injector: injector}
+}
+
+// injected menjalankan Injector untuk operasi name, lalu fn jika tidak ada error buatan.
+func injected[T any](ctx context.Context, injector *Injector, name string, fn func() (T, error)) (T, error) {
+	if err := injector.Inject(ctx, "TaskRepository."+name); err != nil {
+		var zero T
+		return zero, err
+	}
+	return fn()
+}
+
+func (r *taskRepository) Save(ctx context.Context, task *domain.Task) error {
+	if err := r.injector.Inject(ctx, "TaskRepository.Save"); err != nil {
+		return err
+	}
+	return r.next.Save(ctx, task)
+}
+
+func (r *taskRepository) SaveOrUpdate(ctx context.Context, task *domain.Task) (created bool, err error) {
+	return injected(ctx, r.injector, "SaveOrUpdate", func() (bool, error) {
+		return r.next.SaveOrUpdate(ctx, task)
+	})
+}
+
+func (r *taskRepository) FindByID(ctx context.Context, id string) (*domain.Task, error) {
+	return injected(ctx, r.injector, "FindByID", func() (*domain.Task, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *taskRepository) FindByIDs(ctx context.Context, ids []string) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "FindByIDs", func() ([]*domain.Task, error) {
+		return r.next.FindByIDs(ctx, ids)
+	})
+}
+
+func (r *taskRepository) FindByUserID(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "FindByUserID", func() ([]*domain.Task, error) {
+		return r.next.FindByUserID(ctx, userID, order)
+	})
+}
+
+func (r *taskRepository) CountByUserID(ctx context.Context, userID domain.UserID) (int64, error) {
+	return injected(ctx, r.injector, "CountByUserID", func() (int64, error) {
+		return r.next.CountByUserID(ctx, userID)
+	})
+}
+
+func (r *taskRepository) SetTaskLimit(ctx context.Context, userID domain.UserID, maxTasks int64, expiresAt time.Time) error {
+	if err := r.injector.Inject(ctx, "TaskRepository.SetTaskLimit"); err != nil {
+		return err
+	}
+	return r.next.SetTaskLimit(ctx, userID, maxTasks, expiresAt)
+}
+
+func (r *taskRepository) FindExpiredTaskLimits(ctx context.Context, now time.Time, limit int) ([]domain.UserID, error) {
+	return injected(ctx, r.injector, "FindExpiredTaskLimits", func() ([]domain.UserID, error) {
+		return r.next.FindExpiredTaskLimits(ctx, now, limit)
+	})
+}
+
+func (r *taskRepository) CountVisibleByUserID(ctx context.Context, userID domain.UserID, hideSnoozedAt time.Time, hideArchived bool) (int64, error) {
+	return injected(ctx, r.injector, "CountVisibleByUserID", func() (int64, error) {
+		return r.next.CountVisibleByUserID(ctx, userID, hideSnoozedAt, hideArchived)
+	})
+}
+
+func (r *taskRepository) CountersByUserID(ctx context.Context, userID domain.UserID) (domain.TaskCounters, error) {
+	return injected(ctx, r.injector, "CountersByUserID", func() (domain.TaskCounters, error) {
+		return r.next.CountersByUserID(ctx, userID)
+	})
+}
+
+func (r *taskRepository) FindCompletionStreak(ctx context.Context, userID domain.UserID) (domain.CompletionStreak, error) {
+	return injected(ctx, r.injector, "FindCompletionStreak", func() (domain.CompletionStreak, error) {
+		return r.next.FindCompletionStreak(ctx, userID)
+	})
+}
+
+func (r *taskRepository) FindPageByUserID(ctx context.Context, userID domain.UserID, query domain.TaskPageQuery) (*domain.TaskPage, error) {
+	return injected(ctx, r.injector, "FindPageByUserID", func() (*domain.TaskPage, error) {
+		return r.next.FindPageByUserID(ctx, userID, query)
+	})
+}
+
+func (r *taskRepository) FindGroupsByUserID(ctx context.Context, userID domain.UserID, query domain.TaskGroupQuery) ([]domain.TaskGroup, error) {
+	return injected(ctx, r.injector, "FindGroupsByUserID", func() ([]domain.TaskGroup, error) {
+		return r.next.FindGroupsByUserID(ctx, userID, query)
+	})
+}
+
+func (r *taskRepository) FindCompletedBetween(ctx context.Context, userID domain.UserID, from, to time.Time) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "FindCompletedBetween", func() ([]*domain.Task, error) {
+		return r.next.FindCompletedBetween(ctx, userID, from, to)
+	})
+}
+
+func (r *taskRepository) FindArchivedByUserID(ctx context.Context, userID domain.UserID) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "FindArchivedByUserID", func() ([]*domain.Task, error) {
+		return r.next.FindArchivedByUserID(ctx, userID)
+	})
+}
+
+func (r *taskRepository) FindDueByUserID(ctx context.Context, userID domain.UserID, from time.Time, limit int) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "FindDueByUserID", func() ([]*domain.Task, error) {
+		return r.next.FindDueByUserID(ctx, userID, from, limit)
+	})
+}
+
+func (r *taskRepository) FindByAssignee(ctx context.Context, assigneeID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "FindByAssignee", func() ([]*domain.Task, error) {
+		return r.next.FindByAssignee(ctx, assigneeID, order)
+	})
+}
+
+func (r *taskRepository) Search(ctx context.Context, userID domain.UserID, query string, limit int) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "Search", func() ([]*domain.Task, error) {
+		return r.next.Search(ctx, userID, query, limit)
+	})
+}
+
+func (r *taskRepository) FindChangedSince(ctx context.Context, userID domain.UserID, since int64) (*domain.TaskChanges, error) {
+	return injected(ctx, r.injector, "FindChangedSince", func() (*domain.TaskChanges, error) {
+		return r.next.FindChangedSince(ctx, userID, since)
+	})
+}
+
+func (r *taskRepository) SummarizeEstimates(ctx context.Context, userID domain.UserID, from, to time.Time, loc *time.Location) (*domain.EstimateSummary, error) {
+	return injected(ctx, r.injector, "SummarizeEstimates", func() (*domain.EstimateSummary, error) {
+		return r.next.SummarizeEstimates(ctx, userID, from, to, loc)
+	})
+}
+
+func (r *taskRepository) SummarizeProductivity(ctx context.Context, userID domain.UserID, from, to time.Time, loc *time.Location) (*domain.ProductivityStats, error) {
+	return injected(ctx, r.injector, "SummarizeProductivity", func() (*domain.ProductivityStats, error) {
+		return r.next.SummarizeProductivity(ctx, userID, from, to, loc)
+	})
+}
+
+func (r *taskRepository) Update(ctx context.Context, task *domain.Task) error {
+	if err := r.injector.Inject(ctx, "TaskRepository.Update"); err != nil {
+		return err
+	}
+	return r.next.Update(ctx, task)
+}
+
+func (r *taskRepository) Reorder(ctx context.Context, userID domain.UserID, ids []string, now time.Time) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "Reorder", func() ([]*domain.Task, error) {
+		return r.next.Reorder(ctx, userID, ids, now)
+	})
+}
+
+func (r *taskRepository) MoveAfter(ctx context.Context, userID domain.UserID, taskID, afterID string, now time.Time) (*domain.Task, error) {
+	return injected(ctx, r.injector, "MoveAfter", func() (*domain.Task, error) {
+		return r.next.MoveAfter(ctx, userID, taskID, afterID, now)
+	})
+}
+
+func (r *taskRepository) CompleteMany(ctx context.Context, userID domain.UserID, ids []string, now time.Time) (previous []domain.TaskCompletion, tasks []*domain.Task, err error) {
+	if err := r.injector.Inject(ctx, "TaskRepository.CompleteMany"); err != nil {
+		return nil, nil, err
+	}
+	return r.next.CompleteMany(ctx, userID, ids, now)
+}
+
+func (r *taskRepository) RestoreCompletion(ctx context.Context, userID domain.UserID, completions []domain.TaskCompletion, now time.Time) ([]*domain.Task, error) {
+	return injected(ctx, r.injector, "RestoreCompletion", func() ([]*domain.Task, error) {
+		return r.next.RestoreCompletion(ctx, userID, completions, now)
+	})
+}
+
+func (r *taskRepository) RestoreTasks(ctx context.Context, tasks []*domain.Task) (int64, error) {
+	return injected(ctx, r.injector, "RestoreTasks", func() (int64, error) {
+		return r.next.RestoreTasks(ctx, tasks)
+	})
+}
+
+func (r *taskRepository) Delete(ctx context.Context, id string) error {
+	if err := r.injector.Inject(ctx, "TaskRepository.Delete"); err != nil {
+		return err
+	}
+	return r.next.Delete(ctx, id)
+}
+
+func (r *taskRepository) DeleteByUserID(ctx context.Context, userID domain.UserID) (int64, error) {
+	return injected(ctx, r.injector, "DeleteByUserID", func() (int64, error) {
+		return r.next.DeleteByUserID(ctx, userID)
+	})
+}
@@ -0,0 +1,198 @@
+// file: backend/services/task-service/internal/infrastructure/fieldcrypt/fieldcrypt.go
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// prefix menandai nilai terenkripsi. Format lengkapnya "enc:v1:<key id>:<base64(nonce||ciphertext)>".
+const prefix = "enc:v1:"
+
+// plainPrefix menandai plaintext yang kebetulan diawali prefix atau plainPrefix sendiri, yang
+// disimpan saat enkripsi tidak aktif. Tanpa itu pengguna bisa menyimpan teks yang terbaca sebagai
+// ciphertext rusak, sehingga setiap pembacaan barisnya gagal.
+const plainPrefix = "enc:raw:"
+
+const (
+	keySize     = 32 // Panjang key AES-256 dalam byte
+	keyIDLength = 8  // Panjang key id dalam karakter hex
+	nonceSize   = 12 // Panjang nonce standar GCM dalam byte
+	tagSize     = 16 // Panjang tag autentikasi GCM dalam byte
+)
+
+// key adalah satu key dari FIELD_ENCRYPTION_KEY beserta subkey turunannya.
+type key struct {
+	id       string
+	aead     cipher.AEAD
+	nonceKey []byte // Key HMAC untuk menurunkan nonce dari plaintext
+}
+
+// keyring adalah hasil parse satu nilai FIELD_ENCRYPTION_KEY. Key pertama dipakai untuk enkripsi.
+type keyring struct {
+	raw  string
+	keys []key
+}
+
+// Cipher adalah implementasi domain.FieldCipher dengan AES-256-GCM.
+//
+// Nonce diturunkan dari HMAC-SHA256 atas aad dan plaintext, bukan acak, sehingga nilai yang sama
+// di baris yang sama selalu menghasilkan ciphertext yang sama. Tanpa itu setiap UPDATE task akan
+// tercatat sebagai perubahan deskripsi oleh trigger revisi. Yang bocor hanya apakah nilai satu
+// field berubah, yang memang sudah terlihat di riwayat revisi.
+type Cipher struct {
+	keys    func() string
+	current atomic.Pointer[keyring]
+}
+
+// New adalah constructor untuk Cipher. keys dipanggil setiap operasi agar key yang dirotasi lewat
+// secrets backend langsung berlaku; isinya satu atau beberapa key base64 32 byte dipisah koma, key
+// pertama untuk enkripsi dan sisanya hanya untuk dekripsi. Nilai kosong menonaktifkan enkripsi.
+// Mengembalikan error jika nilai awal tidak valid.
+func New(keys func() string) (*Cipher, error) {
+	c := &Cipher{keys: keys}
+	if _, err := c.keyring(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// keyring mem-parse ulang key hanya jika nilainya berubah sejak pemanggilan sebelumnya.
+func (c *Cipher) keyring() (*keyring, error) {
+	raw := c.keys()
+	if ring := c.current.Load(); ring != nil && ring.raw == raw {
+		return ring, nil
+	}
+	ring, err := parseKeyring(raw)
+	if err != nil {
+		return nil, err
+	}
+	c.current.Store(ring)
+	return ring, nil
+}
+
+func parseKeyring(raw string) (*keyring, error) {
+	ring := &keyring{raw: raw}
+	for i, encoded := range strings.Split(raw, ",") {
+		encoded = strings.TrimSpace(encoded)
+		if encoded == "" {
+			continue
+		}
+		secret, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(secret) != keySize {
+			return nil, fmt.Errorf("field encryption key %d must be %d bytes encoded as base64", i+1, keySize)
+		}
+		block, err := aes.NewCipher(derive(secret, "task-service field encryption"))
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(secret)
+		ring.keys = append(ring.keys, key{
+			id:       hex.EncodeToString(sum[:4]),
+			aead:     aead,
+			nonceKey: derive(secret, "task-service field encryption nonce"),
+		})
+	}
+	return ring, nil
+}
+
+// derive menurunkan subkey dari secret agar key enkripsi dan key nonce tidak sama.
+func derive(secret []byte, label string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(label))
+	return mac.Sum(nil)
+}
+
+// Encrypt tidak mengenkripsi string kosong, agar field opsional yang kosong tetap kosong. Jika
+// enkripsi tidak aktif, plaintext yang diawali prefix atau plainPrefix disimpan dengan plainPrefix.
+func (c *Cipher) Encrypt(plaintext, aad string) (string, error) {
+	ring, err := c.keyring()
+	if err != nil {
+		return "", err
+	}
+	if len(ring.keys) == 0 || plaintext == "" {
+		if strings.HasPrefix(plaintext, prefix) || strings.HasPrefix(plaintext, plainPrefix) {
+			return plainPrefix + plaintext, nil
+		}
+		return plaintext, nil
+	}
+	k := ring.keys[0]
+	mac := hmac.New(sha256.New, k.nonceKey)
+	mac.Write([]byte(aad))
+	mac.Write([]byte{0})
+	mac.Write([]byte(plaintext))
+	nonce := mac.Sum(nil)[:k.aead.NonceSize()]
+	sealed := k.aead.Seal(nonce, nonce, []byte(plaintext), []byte(aad))
+	return prefix + k.id + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt memilih key berdasarkan key id di nilai tersimpan. Nilai yang diawali prefix tetapi tidak
+// berformat ciphertext lengkap dianggap plaintext lama, yang tersimpan sebelum plainPrefix ada.
+func (c *Cipher) Decrypt(stored, aad string) (string, error) {
+	if plaintext, ok := strings.CutPrefix(stored, plainPrefix); ok {
+		return plaintext, nil
+	}
+	id, sealed, ok := parseCiphertext(stored)
+	if !ok {
+		return stored, nil
+	}
+	ring, err := c.keyring()
+	if err != nil {
+		return "", err
+	}
+	for _, k := range ring.keys {
+		if k.id != id {
+			continue
+		}
+		nonce, ciphertext := sealed[:nonceSize], sealed[nonceSize:]
+		plaintext, err := k.aead.Open(nil, nonce, ciphertext, []byte(aad))
+		if err != nil {
+			return "", fmt.Errorf("error decrypting field with key %s: %w", id, err)
+		}
+		return string(plaintext), nil
+	}
+	return "", fmt.Errorf("%w: %s", domain.ErrFieldKeyNotFound, id)
+}
+
+// parseCiphertext memecah nilai "enc:v1:<key id>:<base64>" dan melaporkan apakah formatnya lengkap:
+// key id 8 karakter hex dan base64 yang cukup panjang untuk nonce dan tag.
+func parseCiphertext(stored string) (string, []byte, bool) {
+	rest, ok := strings.CutPrefix(stored, prefix)
+	if !ok {
+		return "", nil, false
+	}
+	id, encoded, ok := strings.Cut(rest, ":")
+	if !ok || len(id) != keyIDLength {
+		return "", nil, false
+	}
+	if _, err := hex.DecodeString(id); err != nil {
+		return "", nil, false
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < nonceSize+tagSize {
+		return "", nil, false
+	}
+	return id, sealed, true
+}
+
+// CurrentPrefix mengembalikan "" jika key tidak dikonfigurasi atau tidak valid.
+func (c *Cipher) CurrentPrefix() string {
+	ring, err := c.keyring()
+	if err != nil || len(ring.keys) == 0 {
+		return ""
+	}
+	return prefix + ring.keys[0].id + ":"
+}
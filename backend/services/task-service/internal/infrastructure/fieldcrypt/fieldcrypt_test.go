@@ -0,0 +1,62 @@
+package fieldcrypt
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", keySize)))
+	tests := []struct {
+		name      string
+		keys      string
+		plaintext string
+		encrypted bool // Nilai tersimpan diawali CurrentPrefix
+	}{
+		{"disabled plain", "", "buy milk", false},
+		{"disabled empty", "", "", false},
+		{"disabled ciphertext prefix", "", "enc:v1:x:y", false},
+		{"disabled well-formed ciphertext", "", "enc:v1:0123abcd:" + base64.StdEncoding.EncodeToString(make([]byte, 40)), false},
+		{"disabled plain prefix", "", "enc:raw:note", false},
+		{"enabled plain", key, "buy milk", true},
+		{"enabled empty", key, "", false},
+		{"enabled ciphertext prefix", key, "enc:v1:x:y", true},
+		{"enabled plain prefix", key, "enc:raw:note", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(func() string { return tt.keys })
+			if err != nil {
+				t.Fatal(err)
+			}
+			stored, err := c.Encrypt(tt.plaintext, "tasks.description:1")
+			if err != nil {
+				t.Fatalf("Encrypt: %v", err)
+			}
+			if got := c.CurrentPrefix() != "" && strings.HasPrefix(stored, c.CurrentPrefix()); got != tt.encrypted {
+				t.Errorf("stored %q encrypted = %v, want %v", stored, got, tt.encrypted)
+			}
+			got, err := c.Decrypt(stored, "tasks.description:1")
+			if err != nil {
+				t.Fatalf("Decrypt(%q): %v", stored, err)
+			}
+			if got != tt.plaintext {
+				t.Errorf("Decrypt(Encrypt(%q)) = %q", tt.plaintext, got)
+			}
+		})
+	}
+}
+
+func TestDecryptLegacyPlaintext(t *testing.T) {
+	c, err := New(func() string { return "" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stored := range []string{"plain", "enc:v1:", "enc:v1:x:y", "enc:v1:0123abcd:not base64", "enc:v1:0123abcd:" + base64.StdEncoding.EncodeToString(make([]byte, 8))} {
+		got, err := c.Decrypt(stored, "tasks.description:1")
+		if err != nil || got != stored {
+			t.Errorf("Decrypt(%q) = %q, %v; want value unchanged", stored, got, err)
+		}
+	}
+}
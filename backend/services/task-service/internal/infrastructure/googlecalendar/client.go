@@ -0,0 +1,299 @@
+// file: backend/services/task-service/internal/infrastructure/googlecalendar/client.go
+package googlecalendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Endpoint Google Calendar API v3 dan OAuth 2.0.
+const (
+	apiBaseURL = "https://www.googleapis.com/calendar/v3"
+	tokenURL   = "https://oauth2.googleapis.com/token"
+)
+
+// pageSize adalah jumlah event per halaman saat membaca perubahan (maksimum API).
+const pageSize = 2500
+
+// maxRetryAfter adalah batas jeda rate limit yang masih ditunggu sebelum mencoba ulang sekali.
+const maxRetryAfter = 5 * time.Second
+
+// timeFormat adalah format dateTime event Google (RFC 3339).
+const timeFormat = time.RFC3339
+
+// Client adalah implementasi domain.GoogleCalendarClient dengan Google Calendar API v3.
+type Client struct {
+	clientID     string // Kosong berarti refresh token tidak bisa dipakai
+	clientSecret string
+	client       *http.Client
+}
+
+// NewClient adalah constructor untuk Client. clientID dan clientSecret adalah client OAuth yang
+// menerbitkan refresh token pengguna; keduanya boleh kosong jika hanya access token yang dipakai.
+func NewClient(clientID, clientSecret string) *Client {
+	return &Client{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// eventTime adalah start/end event: dateTime untuk event berjam, date untuk event sehari penuh.
+type eventTime struct {
+	DateTime string `json:"dateTime,omitempty"`
+	Date     string `json:"date,omitempty"`
+}
+
+type extendedProperties struct {
+	Private map[string]string `json:"private,omitempty"`
+}
+
+// event adalah resource Event yang dibaca dan ditulis service ini.
+type event struct {
+	ID                 string              `json:"id,omitempty"`
+	Status             string              `json:"status,omitempty"`
+	Summary            string              `json:"summary"`
+	Description        string              `json:"description"`
+	Start              *eventTime          `json:"start,omitempty"`
+	End                *eventTime          `json:"end,omitempty"`
+	Transparency       string              `json:"transparency,omitempty"`
+	ExtendedProperties *extendedProperties `json:"extendedProperties,omitempty"`
+}
+
+// taskIDProperty adalah extended property privat yang menandai event milik task.
+const taskIDProperty = "taskId"
+
+// newEvent memetakan domain.GoogleCalendarEvent ke body request. Event tenggat tidak membuat
+// pengguna tampil sibuk, sama seperti VEVENT di feed iCalendar.
+func newEvent(e domain.GoogleCalendarEvent) event {
+	return event{
+		Summary:            e.Summary,
+		Description:        e.Description,
+		Start:              &eventTime{DateTime: e.Start.UTC().Format(timeFormat)},
+		End:                &eventTime{DateTime: e.End.UTC().Format(timeFormat)},
+		Transparency:       "transparent",
+		ExtendedProperties: &extendedProperties{Private: map[string]string{taskIDProperty: e.TaskID}},
+	}
+}
+
+// toDomain memetakan event dari API. Event yang dibatalkan hanya berisi ID dan status.
+func (e event) toDomain() (domain.GoogleCalendarEvent, error) {
+	out := domain.GoogleCalendarEvent{
+		ID:          e.ID,
+		Summary:     e.Summary,
+		Description: e.Description,
+		Cancelled:   e.Status == "cancelled",
+	}
+	if e.ExtendedProperties != nil {
+		out.TaskID = e.ExtendedProperties.Private[taskIDProperty]
+	}
+	if out.Cancelled || e.Start == nil {
+		return out, nil
+	}
+	var err error
+	if e.Start.Date != "" {
+		out.AllDay = true
+		out.Start, err = time.Parse(time.DateOnly, e.Start.Date)
+	} else {
+		out.Start, err = time.Parse(timeFormat, e.Start.DateTime)
+	}
+	if err != nil {
+		return out, fmt.Errorf("invalid start of google calendar event %s: %w", e.ID, err)
+	}
+	return out, nil
+}
+
+// VerifyCalendar membaca metadata kalender, yang gagal jika token tidak punya akses ke kalender.
+func (c *Client) VerifyCalendar(ctx context.Context, accessToken, calendarID string) (string, error) {
+	var calendar struct {
+		ID string `json:"id"`
+	}
+	if err := c.call(ctx, accessToken, http.MethodGet, calendarPath(calendarID), nil, &calendar); err != nil {
+		return "", err
+	}
+	return calendar.ID, nil
+}
+
+// InsertEvent membuat event untuk task.
+func (c *Client) InsertEvent(ctx context.Context, accessToken, calendarID string, e domain.GoogleCalendarEvent) (string, error) {
+	var created event
+	if err := c.call(ctx, accessToken, http.MethodPost, calendarPath(calendarID)+"/events", newEvent(e), &created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// UpdateEvent memakai PATCH agar pengingat, tamu, dan warna yang ditambahkan pengguna di Google
+// tidak ikut terhapus.
+func (c *Client) UpdateEvent(ctx context.Context, accessToken, calendarID string, e domain.GoogleCalendarEvent) error {
+	return c.call(ctx, accessToken, http.MethodPatch, eventPath(calendarID, e.ID), newEvent(e), nil)
+}
+
+// DeleteEvent menghapus event.
+func (c *Client) DeleteEvent(ctx context.Context, accessToken, calendarID, eventID string) error {
+	return c.call(ctx, accessToken, http.MethodDelete, eventPath(calendarID, eventID), nil, nil)
+}
+
+// ListChanges membaca semua halaman events.list. Tanpa syncToken, event yang dibatalkan tidak
+// ikut dibaca karena belum ada yang perlu dicocokkan.
+func (c *Client) ListChanges(ctx context.Context, accessToken, calendarID, syncToken string) ([]domain.GoogleCalendarEvent, string, error) {
+	var events []domain.GoogleCalendarEvent
+	pageToken := ""
+	for {
+		query := url.Values{"maxResults": {strconv.Itoa(pageSize)}}
+		if syncToken != "" {
+			query.Set("syncToken", syncToken)
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		var page struct {
+			Items         []event `json:"items"`
+			NextPageToken string  `json:"nextPageToken"`
+			NextSyncToken string  `json:"nextSyncToken"`
+		}
+		err := c.call(ctx, accessToken, http.MethodGet, calendarPath(calendarID)+"/events?"+query.Encode(), nil, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, item := range page.Items {
+			e, err := item.toDomain()
+			if err != nil {
+				return nil, "", err
+			}
+			events = append(events, e)
+		}
+		if page.NextPageToken == "" {
+			return events, page.NextSyncToken, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// RefreshAccessToken memakai grant refresh_token (RFC 6749 bagian 6).
+func (c *Client) RefreshAccessToken(ctx context.Context, refreshToken string) (string, time.Time, error) {
+	if c.clientID == "" || refreshToken == "" {
+		return "", time.Time{}, domain.ErrGoogleCalendarUnauthorized
+	}
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error creating google token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error sending google token request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		return "", time.Time{}, domain.ErrGoogleCalendarUnauthorized // invalid_grant: refresh token dicabut
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("google token endpoint responded with status %d", resp.StatusCode)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return "", time.Time{}, fmt.Errorf("error decoding google token response: %w", err)
+	}
+	return token.AccessToken, time.Now().Add(time.Duration(token.ExpiresIn) * time.Second), nil
+}
+
+func calendarPath(calendarID string) string {
+	return "/calendars/" + url.PathEscape(calendarID)
+}
+
+func eventPath(calendarID, eventID string) string {
+	return calendarPath(calendarID) + "/events/" + url.PathEscape(eventID)
+}
+
+// call mengirim satu request API dan mencoba ulang sekali jika Google menjawab 429 atau 503 dengan
+// jeda yang pendek. Status 401, 404, dan 410 dipetakan ke error domain; 410 pada GET berarti sync
+// token events.list kedaluwarsa.
+func (c *Client) call(ctx context.Context, accessToken, method, path string, body, out any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("error encoding google calendar request: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, apiBaseURL+path, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("error creating google calendar request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("error sending google calendar request: %w", err)
+		}
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 32<<20))
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("error reading google calendar response: %w", err)
+		}
+
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+			if out == nil {
+				return nil
+			}
+			if err := json.Unmarshal(respBody, out); err != nil {
+				return fmt.Errorf("error decoding google calendar response: %w", err)
+			}
+			return nil
+		case resp.StatusCode == http.StatusUnauthorized:
+			return domain.ErrGoogleCalendarUnauthorized
+		case resp.StatusCode == http.StatusGone && method == http.MethodGet:
+			return domain.ErrGoogleCalendarSyncTokenExpired
+		case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+			return domain.ErrGoogleCalendarEventNotFound
+		case (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) && attempt == 0:
+			wait := retryAfter(resp.Header)
+			if wait > maxRetryAfter {
+				return fmt.Errorf("google calendar rate limited for %s", wait)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		default:
+			return fmt.Errorf("google calendar %s %s responded with status %d: %s",
+				method, strings.SplitN(path, "?", 2)[0], resp.StatusCode, bytes.TrimSpace(respBody[:min(len(respBody), 4<<10)]))
+		}
+	}
+}
+
+// retryAfter membaca header Retry-After (detik) dari response 429 atau 503.
+func retryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.ParseFloat(header.Get("Retry-After"), 64)
+	if err != nil || seconds < 0 {
+		return time.Second
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,184 @@
+// file: backend/services/task-service/internal/infrastructure/ical/ical.go
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// ContentType adalah media type dokumen iCalendar.
+const ContentType = "text/calendar; charset=utf-8"
+
+// prodID mengidentifikasi pembuat dokumen (RFC 5545 bagian 3.7.3).
+const prodID = "-//go-vue-todolist//task-service//EN"
+
+// maxLineOctets adalah panjang baris maksimum sebelum dilipat (RFC 5545 bagian 3.1).
+const maxLineOctets = 75
+
+// eventUIDSuffix membedakan UID VEVENT dari VTODO untuk task yang sama, karena beberapa klien
+// menganggap dua komponen dengan UID sama sebagai satu objek.
+const eventUIDSuffix = "-due"
+
+// priorities memetakan prioritas builtin ke PRIORITY iCalendar (1 tertinggi, 9 terendah).
+// Nilai enum milik workspace tidak punya padanan dan tidak ditulis.
+var priorities = map[string]int{
+	"urgent": 1,
+	"high":   3,
+	"medium": 5,
+	"low":    9,
+}
+
+// Priority mengembalikan PRIORITY iCalendar untuk prioritas task, atau 0 (tidak ditentukan) jika
+// task tanpa prioritas atau prioritasnya bukan nilai builtin.
+func Priority(task *domain.Task) int {
+	if task.Priority == nil {
+		return 0
+	}
+	return priorities[*task.Priority]
+}
+
+// WriteTasks menulis tasks sebagai satu VCALENDAR bernama name. Setiap task bertenggat ditulis
+// sebagai VTODO (untuk aplikasi pengingat) dan VEVENT pada waktu tenggatnya (untuk aplikasi
+// kalender yang tidak menampilkan VTODO, misalnya Google Calendar). Task tanpa tenggat dilewati.
+func WriteTasks(w io.Writer, name string, tasks []*domain.Task) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", prodID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	e.text("X-WR-CALNAME", name)
+	e.line("REFRESH-INTERVAL;VALUE=DURATION", "PT1H")
+	e.line("X-PUBLISHED-TTL", "PT1H")
+	for _, task := range tasks {
+		if task.DueAt == nil {
+			continue
+		}
+		writeTodo(e, task)
+		writeEvent(e, task)
+	}
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// WriteTodo menulis satu task sebagai VCALENDAR berisi satu VTODO, yaitu bentuk calendar object
+// resource CalDAV (RFC 4791 bagian 4.1); berbeda dari WriteTasks, task tanpa tenggat tetap ditulis.
+func WriteTodo(w io.Writer, task *domain.Task) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", prodID)
+	writeTodo(e, task)
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+func writeTodo(e *encoder, task *domain.Task) {
+	e.line("BEGIN", "VTODO")
+	e.line("UID", task.ID)
+	writeCommon(e, task)
+	if task.DueAt != nil {
+		e.time("DUE", *task.DueAt)
+	}
+	if task.Completed {
+		e.line("STATUS", "COMPLETED")
+		e.line("PERCENT-COMPLETE", "100")
+		if task.CompletedAt != nil {
+			e.time("COMPLETED", *task.CompletedAt)
+		}
+	} else {
+		e.line("STATUS", "NEEDS-ACTION")
+	}
+	if priority := Priority(task); priority != 0 {
+		e.line("PRIORITY", fmt.Sprint(priority))
+	}
+	e.line("END", "VTODO")
+}
+
+func writeEvent(e *encoder, task *domain.Task) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", task.ID+eventUIDSuffix)
+	writeCommon(e, task)
+	e.time("DTSTART", *task.DueAt)
+	if task.EstimateMinutes != nil && *task.EstimateMinutes > 0 {
+		e.line("DURATION", fmt.Sprintf("PT%dM", *task.EstimateMinutes))
+	}
+	e.line("TRANSP", "TRANSPARENT") // Tenggat tidak membuat pengguna tampil sibuk
+	e.line("RELATED-TO", task.ID)
+	e.line("END", "VEVENT")
+}
+
+// writeCommon menulis properti yang sama untuk VTODO dan VEVENT. DTSTAMP memakai UpdatedAt agar
+// dokumen tidak berubah selama task tidak berubah.
+func writeCommon(e *encoder, task *domain.Task) {
+	e.time("DTSTAMP", task.UpdatedAt)
+	e.time("CREATED", task.CreatedAt)
+	e.time("LAST-MODIFIED", task.UpdatedAt)
+	e.text("SUMMARY", task.Title)
+	if task.Description != "" {
+		e.text("DESCRIPTION", task.Description)
+	}
+}
+
+// encoder menulis content line iCalendar dengan CRLF dan pelipatan baris. Error pertama disimpan
+// dan penulisan berikutnya dilewati.
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// text menulis properti bertipe TEXT setelah di-escape.
+func (e *encoder) text(name, value string) {
+	e.line(name, escapeText(value))
+}
+
+// time menulis properti DATE-TIME dalam UTC.
+func (e *encoder) time(name string, t time.Time) {
+	e.line(name, t.UTC().Format("20060102T150405Z"))
+}
+
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.WriteString(fold(name + ":" + value))
+}
+
+// fold memecah baris menjadi potongan paling panjang maxLineOctets byte tanpa memotong karakter
+// UTF-8; potongan lanjutan diawali satu spasi.
+func fold(line string) string {
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1 // Spasi pembuka ikut dihitung
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// textEscaper meng-escape karakter khusus nilai TEXT (RFC 5545 bagian 3.3.11).
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", "")
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
@@ -0,0 +1,201 @@
+// file: backend/services/task-service/internal/infrastructure/ical/parse.go
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTodo dikembalikan jika dokumen bukan VCALENDAR dengan VTODO yang bisa dibaca.
+var ErrInvalidTodo = errors.New("invalid VTODO")
+
+// maxDocumentSize membatasi ukuran dokumen yang di-parse.
+const maxDocumentSize = 1 << 20
+
+// Todo adalah properti VTODO yang dipetakan ke task. Properti lain (VALARM, RRULE, kategori,
+// dan sebagainya) diabaikan.
+type Todo struct {
+	UID         string
+	Summary     string
+	Description string
+	Completed   bool // STATUS:COMPLETED atau ada properti COMPLETED
+	Priority    int  // 0 berarti tidak ditentukan
+
+	// Due adalah tenggat, nil jika tidak ada. DueLocation nil berarti waktu floating atau tanggal
+	// saja (DueAllDay), yang harus dibaca di zona waktu pengguna.
+	Due         *time.Time
+	DueLocation *time.Location
+	DueAllDay   bool
+}
+
+// ParseTodo membaca VTODO pertama dari dokumen iCalendar. Komponen VTODO berikutnya (misalnya
+// override recurrence dengan UID yang sama) diabaikan.
+func ParseTodo(r io.Reader) (*Todo, error) {
+	raw, err := io.ReadAll(io.LimitReader(r, maxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxDocumentSize {
+		return nil, fmt.Errorf("%w: document too large", ErrInvalidTodo)
+	}
+
+	var todo *Todo
+	var stack []string
+	for _, line := range unfold(string(raw)) {
+		name, params, value, ok := parseLine(line)
+		if !ok {
+			return nil, fmt.Errorf("%w: malformed line %q", ErrInvalidTodo, line)
+		}
+		switch name {
+		case "BEGIN":
+			stack = append(stack, strings.ToUpper(value))
+			if todo == nil && len(stack) == 2 && stack[0] == "VCALENDAR" && stack[1] == "VTODO" {
+				todo = &Todo{}
+			}
+			continue
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(value) {
+				return nil, fmt.Errorf("%w: unbalanced END:%s", ErrInvalidTodo, value)
+			}
+			stack = stack[:len(stack)-1]
+			if todo != nil && len(stack) == 1 {
+				return finishTodo(todo)
+			}
+			continue
+		}
+		// Hanya properti langsung di VTODO pertama; properti VALARM dan VTIMEZONE dilewati.
+		if todo == nil || len(stack) != 2 || stack[1] != "VTODO" {
+			continue
+		}
+		if err := todo.set(name, params, value); err != nil {
+			return nil, err
+		}
+	}
+	if todo == nil {
+		return nil, fmt.Errorf("%w: no VTODO component", ErrInvalidTodo)
+	}
+	return nil, fmt.Errorf("%w: unterminated VTODO", ErrInvalidTodo)
+}
+
+func finishTodo(todo *Todo) (*Todo, error) {
+	if todo.UID == "" {
+		return nil, fmt.Errorf("%w: UID is required", ErrInvalidTodo)
+	}
+	return todo, nil
+}
+
+func (t *Todo) set(name string, params map[string]string, value string) error {
+	switch name {
+	case "UID":
+		t.UID = value
+	case "SUMMARY":
+		t.Summary = unescapeText(value)
+	case "DESCRIPTION":
+		t.Description = unescapeText(value)
+	case "STATUS":
+		t.Completed = t.Completed || strings.EqualFold(value, "COMPLETED")
+	case "COMPLETED":
+		t.Completed = true
+	case "PRIORITY":
+		priority, err := strconv.Atoi(value)
+		if err != nil || priority < 0 || priority > 9 {
+			return fmt.Errorf("%w: PRIORITY must be 0-9", ErrInvalidTodo)
+		}
+		t.Priority = priority
+	case "DUE":
+		due, loc, allDay, err := parseDateTime(params, value)
+		if err != nil {
+			return err
+		}
+		t.Due, t.DueLocation, t.DueAllDay = &due, loc, allDay
+	}
+	return nil
+}
+
+// parseDateTime membaca nilai DATE atau DATE-TIME (RFC 5545 bagian 3.3.4 dan 3.3.5). TZID yang
+// tidak dikenal (misalnya nama zona Windows) diperlakukan sebagai waktu floating.
+func parseDateTime(params map[string]string, value string) (time.Time, *time.Location, bool, error) {
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len("20060102") {
+		t, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, nil, false, fmt.Errorf("%w: invalid date %q", ErrInvalidTodo, value)
+		}
+		return t, nil, true, nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, nil, false, fmt.Errorf("%w: invalid date-time %q", ErrInvalidTodo, value)
+		}
+		return t, time.UTC, false, nil
+	}
+	var loc *time.Location
+	if tzid := params["TZID"]; tzid != "" {
+		loc, _ = time.LoadLocation(strings.TrimPrefix(tzid, "/"))
+	}
+	in := loc
+	if in == nil {
+		in = time.UTC
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, in)
+	if err != nil {
+		return time.Time{}, nil, false, fmt.Errorf("%w: invalid date-time %q", ErrInvalidTodo, value)
+	}
+	return t, loc, false, nil
+}
+
+// unfold menggabungkan baris lanjutan (diawali spasi atau tab) dan membuang baris kosong.
+func unfold(doc string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(doc, "\r\n", "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseLine memecah content line menjadi nama (huruf besar), parameter (nama huruf besar), dan
+// nilai. Titik dua dan titik koma di dalam nilai parameter bertanda kutip tidak dianggap pemisah.
+func parseLine(line string) (string, map[string]string, string, bool) {
+	inQuote := false
+	var parts []string
+	start := 0
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '"':
+			inQuote = !inQuote
+		case c == ';' && !inQuote:
+			parts = append(parts, line[start:i])
+			start = i + 1
+		case c == ':' && !inQuote:
+			parts = append(parts, line[start:i])
+			if parts[0] == "" {
+				return "", nil, "", false
+			}
+			params := make(map[string]string, len(parts)-1)
+			for _, param := range parts[1:] {
+				key, val, _ := strings.Cut(param, "=")
+				params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+			}
+			return strings.ToUpper(parts[0]), params, line[i+1:], true
+		}
+	}
+	return "", nil, "", false
+}
+
+// textUnescaper membalik textEscaper; "\N" juga berarti baris baru.
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+func unescapeText(value string) string {
+	return textUnescaper.Replace(value)
+}
@@ -0,0 +1,75 @@
+// file: backend/services/task-service/internal/infrastructure/idgen/idgen.go
+package idgen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+// Strategi ID yang didukung, dipilih lewat env TASK_ID_STRATEGY.
+const (
+	StrategyUUIDv4 = "uuidv4"
+	StrategyUUIDv7 = "uuidv7"
+	StrategyULID   = "ulid"
+)
+
+// New membuat IDGenerator sesuai nama strategi. String kosong berarti StrategyUUIDv4,
+// yaitu strategi yang dipakai sebelum ID generation bisa dikonfigurasi.
+func New(strategy string) (domain.IDGenerator, error) {
+	switch strings.ToLower(strategy) {
+	case "", StrategyUUIDv4:
+		return UUIDv4Generator{}, nil
+	case StrategyUUIDv7:
+		return UUIDv7Generator{}, nil
+	case StrategyULID:
+		return ULIDGenerator{}, nil
+	default:
+		return nil, fmt.Errorf("unknown id strategy %q (supported: %s, %s, %s)", strategy, StrategyUUIDv4, StrategyUUIDv7, StrategyULID)
+	}
+}
+
+// UUIDv4Generator membuat UUID acak (versi 4). ID tidak bisa diurutkan berdasarkan waktu.
+type UUIDv4Generator struct{}
+
+func (UUIDv4Generator) NewID() string            { return uuid.NewString() }
+func (UUIDv4Generator) Validate(id string) error { return validateUUID(id) }
+func (UUIDv4Generator) Sortable() bool           { return false }
+
+// UUIDv7Generator membuat UUID versi 7 yang diawali timestamp milidetik, sehingga insert
+// bersifat append pada index B-tree dan ID bisa dipakai sebagai cursor pagination.
+type UUIDv7Generator struct{}
+
+func (UUIDv7Generator) NewID() string {
+	// uuid.NewV7 hanya gagal jika crypto/rand gagal, sama seperti uuid.NewString yang panic.
+	return uuid.Must(uuid.NewV7()).String()
+}
+func (UUIDv7Generator) Validate(id string) error { return validateUUID(id) }
+func (UUIDv7Generator) Sortable() bool           { return true }
+
+// ULIDGenerator membuat ULID (26 karakter Crockford base32) yang juga terurut berdasarkan waktu.
+// Validate tetap menerima UUID agar ID lama dan ID dari klien lama tetap valid setelah migrasi.
+type ULIDGenerator struct{}
+
+func (ULIDGenerator) NewID() string { return ulid.Make().String() }
+func (ULIDGenerator) Validate(id string) error {
+	// Hanya bentuk kanonik (huruf besar) yang diterima agar urutan byte ID tetap kronologis.
+	if _, err := ulid.ParseStrict(id); err == nil && id == strings.ToUpper(id) {
+		return nil
+	}
+	return validateUUID(id)
+}
+func (ULIDGenerator) Sortable() bool { return true }
+
+// validateUUID hanya menerima bentuk kanonik xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx (huruf kecil),
+// bukan bentuk urn:uuid: atau {...} yang juga diterima uuid.Parse.
+func validateUUID(id string) error {
+	parsed, err := uuid.Parse(id)
+	if err != nil || parsed.String() != id {
+		return domain.ErrInvalidTaskID
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// file: backend/services/task-service/internal/infrastructure/leader/postgres_elector.go
+package leader
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultKey adalah nama lock untuk job terjadwal task-service.
+const DefaultKey = "task-service:scheduled-jobs"
+
+// ElectorConfig mengatur perilaku PostgresElector.
+type ElectorConfig struct {
+	Key string // Nama lock; replika dengan Key yang sama saling bersaing, default DefaultKey
+
+	// CheckInterval adalah seberapa sering replika standby mencoba mengambil lock dan leader
+	// memeriksa koneksinya. Failover terjadi paling lambat sekitar satu interval setelah leader
+	// berhenti.
+	CheckInterval time.Duration
+}
+
+// DefaultElectorConfig mengembalikan konfigurasi default PostgresElector.
+func DefaultElectorConfig() ElectorConfig {
+	return ElectorConfig{
+		Key:           DefaultKey,
+		CheckInterval: 10 * time.Second,
+	}
+}
+
+// PostgresElector memilih satu replika sebagai leader dengan session advisory lock Postgres, lalu
+// menjalankan job hanya di replika tersebut. Lock dipegang oleh satu koneksi khusus selama
+// replika menjadi leader dan otomatis dilepas Postgres saat koneksi atau proses mati, sehingga
+// replika lain mengambil alih tanpa koordinasi tambahan.
+type PostgresElector struct {
+	dbpool *pgxpool.Pool
+	cfg    ElectorConfig
+	leader atomic.Bool
+}
+
+// NewPostgresElector adalah constructor untuk PostgresElector.
+func NewPostgresElector(dbpool *pgxpool.Pool, cfg ElectorConfig) *PostgresElector {
+	return &PostgresElector{
+		dbpool: dbpool,
+		cfg:    cfg,
+	}
+}
+
+// IsLeader melaporkan apakah replika ini sedang menjadi leader.
+func (e *PostgresElector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run mencoba menjadi leader sampai ctx dibatalkan. Selama menjadi leader, setiap job dijalankan
+// di goroutine sendiri dengan context yang dibatalkan saat kepemimpinan hilang; job harus berhenti
+// saat context-nya selesai. Lock baru dilepas setelah semua job berhenti.
+func (e *PostgresElector) Run(ctx context.Context, jobs ...func(context.Context)) {
+	for {
+		if err := e.lead(ctx, jobs); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "leader election: error, retrying", "key", e.cfg.Key, "retry_in", e.cfg.CheckInterval, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.cfg.CheckInterval):
+		}
+	}
+}
+
+// lead mencoba mengambil lock sekali. Jika berhasil, job dijalankan sampai ctx dibatalkan atau
+// koneksi pemegang lock gagal. Koneksi dilepas dari pool (Hijack, seperti
+// userevents.PostgresConsumer) agar lock sesi tidak ikut terbawa ke pemakai koneksi berikutnya.
+func (e *PostgresElector) lead(ctx context.Context, jobs []func(context.Context)) error {
+	pooled, err := e.dbpool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("error connecting: %w", err)
+	}
+	conn := pooled.Hijack()
+	// Menutup koneksi juga melepas lock, bahkan jika pg_advisory_unlock tidak sempat dijalankan.
+	defer conn.Close(context.Background())
+
+	// Bentuk dua argumen berada di ruang lock terpisah dari lock satu argumen (misalnya
+	// lockUserPositions), sehingga hash yang kebetulan sama tidak saling menghalangi.
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext($1), 0)`, e.cfg.Key).Scan(&locked); err != nil {
+		return fmt.Errorf("error acquiring advisory lock: %w", err)
+	}
+	if !locked {
+		return nil // Replika lain sedang menjadi leader
+	}
+
+	e.leader.Store(true)
+	defer e.leader.Store(false)
+	slog.InfoContext(ctx, "leader election: acquired leadership", "key", e.cfg.Key)
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			job(jobCtx)
+		}()
+	}
+	defer wg.Wait()
+	defer cancel()
+
+	ticker := time.NewTicker(e.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			pingCtx, cancelPing := context.WithTimeout(ctx, e.cfg.CheckInterval)
+			err := conn.Ping(pingCtx)
+			cancelPing()
+			if err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "leader election: lost leadership, stopping jobs", "key", e.cfg.Key, "error", err)
+				return fmt.Errorf("error checking leader connection: %w", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// file: backend/services/task-service/internal/infrastructure/logging/level.go
+package logging
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Level adalah level log minimum yang bisa diubah saat service berjalan, misalnya ke debug
+// selama investigasi insiden. Level mengimplementasikan slog.Leveler.
+type Level struct {
+	current slog.LevelVar
+
+	mu        sync.Mutex
+	base      slog.Level // Level dari konfigurasi (LOG_LEVEL atau CONFIG_FILE)
+	expiresAt time.Time  // Nol berarti perubahan tidak kedaluwarsa
+	timer     *time.Timer
+}
+
+func newLevel(base slog.Level) *Level {
+	l := &Level{base: base}
+	l.current.Set(base)
+	return l
+}
+
+// Level mengimplementasikan slog.Leveler.
+func (l *Level) Level() slog.Level {
+	return l.current.Level()
+}
+
+// Base mengembalikan level dari konfigurasi.
+func (l *Level) Base() slog.Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.base
+}
+
+// SetBase mengganti level dari konfigurasi, misalnya saat konfigurasi dimuat ulang. Level yang
+// berlaku ikut diganti kecuali sedang ada perubahan lewat Set atau ToggleDebug.
+func (l *Level) SetBase(level slog.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.timer == nil && l.current.Level() == l.base {
+		l.current.Set(level)
+	}
+	l.base = level
+}
+
+// ExpiresAt mengembalikan waktu level kembali ke Base; nol jika tidak kedaluwarsa.
+func (l *Level) ExpiresAt() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.expiresAt
+}
+
+// Set mengganti level. Jika duration lebih dari nol, level kembali ke Base setelah duration;
+// perubahan berikutnya membatalkan jadwal tersebut. Pemanggil yang mencatat perubahan di log.
+func (l *Level) Set(level slog.Level, duration time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	l.expiresAt = time.Time{}
+	l.current.Set(level)
+	if duration > 0 {
+		l.expiresAt = time.Now().Add(duration)
+		var timer *time.Timer
+		timer = time.AfterFunc(duration, func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if l.timer != timer {
+				return // Sudah diganti oleh Set berikutnya
+			}
+			l.timer = nil
+			l.expiresAt = time.Time{}
+			l.current.Set(l.base)
+			slog.Info("log level reverted", "level", l.base.String())
+		})
+		l.timer = timer
+	}
+}
+
+// ToggleDebug mengganti level ke debug, atau kembali ke Base jika sedang debug, lalu
+// mengembalikan level baru. Dipakai oleh handler SIGUSR1.
+func (l *Level) ToggleDebug() slog.Level {
+	base := l.Base()
+	level := slog.LevelDebug
+	if l.Level() == slog.LevelDebug && base != slog.LevelDebug {
+		level = base
+	}
+	l.Set(level, 0)
+	return level
+}
@@ -0,0 +1,118 @@
+// file: backend/services/task-service/internal/infrastructure/logging/logging.go
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Format output log.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+type contextKey struct{}
+
+// New membuat logger slog dengan format FormatJSON (default) atau FormatText dan level minimum
+// ("debug", "info", "warn", atau "error"; default "info"). Level yang dikembalikan bisa diubah
+// saat service berjalan. Field yang disimpan di context dengan With ikut ditulis oleh setiap log
+// *Context, misalnya slog.ErrorContext.
+func New(w io.Writer, format, level string) (*slog.Logger, *Level, error) {
+	var minLevel slog.Level
+	if level != "" {
+		parsed, err := ParseLevel(level)
+		if err != nil {
+			return nil, nil, err
+		}
+		minLevel = parsed
+	}
+	leveler := newLevel(minLevel)
+	opts := &slog.HandlerOptions{Level: leveler}
+	var handler slog.Handler
+	switch format {
+	case "", FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, nil, fmt.Errorf("invalid log format %q: must be %s or %s", format, FormatJSON, FormatText)
+	}
+	return slog.New(contextHandler{handler}), leveler, nil
+}
+
+// ParseLevel membaca nama level "debug", "info", "warn", atau "error" (tidak peka huruf besar).
+func ParseLevel(level string) (slog.Level, error) {
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(level)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q", level)
+	}
+	return parsed, nil
+}
+
+// With mengembalikan context yang membawa field log tambahan (pasangan key-value atau slog.Attr,
+// seperti slog.Logger.With), misalnya ID pengguna atau route request.
+func With(ctx context.Context, args ...any) context.Context {
+	var record slog.Record
+	record.Add(args...)
+	attrs := slices.Clip(attrsFromContext(ctx))
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = append(attrs, attr)
+		return true
+	})
+	return context.WithValue(ctx, contextKey{}, attrs)
+}
+
+func attrsFromContext(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(contextKey{}).([]slog.Attr)
+	return attrs
+}
+
+// contextHandler menambahkan field dari context (lihat With), request_id
+// (domain.RequestIDFromContext), serta trace_id dan span_id dari span OpenTelemetry yang aktif ke
+// setiap record.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	var requestID string
+	var spanContext trace.SpanContext
+	if ctx != nil {
+		requestID = domain.RequestIDFromContext(ctx)
+		spanContext = trace.SpanContextFromContext(ctx)
+	}
+	attrs := attrsFromContext(ctx)
+	if requestID == "" && !spanContext.IsValid() && len(attrs) == 0 {
+		return h.Handler.Handle(ctx, record)
+	}
+	record = record.Clone()
+	if requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	if spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	record.AddAttrs(attrs...)
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
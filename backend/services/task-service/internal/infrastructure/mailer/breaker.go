@@ -0,0 +1,44 @@
+// file: backend/services/task-service/internal/infrastructure/mailer/breaker.go
+package mailer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/textproto"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/circuitbreaker"
+)
+
+// breakerSender adalah decorator domain.EmailSender yang menolak pengiriman dengan
+// domain.ErrDependencyUnavailable selama breaker terbuka.
+type breakerSender struct {
+	next    domain.EmailSender
+	breaker *circuitbreaker.Breaker
+}
+
+// NewBreakerSender membungkus next dengan breaker, yang dibuat dengan IsDeliveryFailure.
+func NewBreakerSender(next domain.EmailSender, breaker *circuitbreaker.Breaker) domain.EmailSender {
+	return &breakerSender{next: next, breaker: breaker}
+}
+
+func (s *breakerSender) Send(ctx context.Context, msg domain.EmailMessage) error {
+	return s.breaker.Do(func() error {
+		return s.next.Send(ctx, msg)
+	})
+}
+
+// IsDeliveryFailure melaporkan apakah err menandakan server SMTP tidak sehat: koneksi gagal,
+// timeout, koneksi terputus, atau balasan sementara 4xx. Balasan permanen 5xx (misalnya alamat
+// penerima ditolak) dan error membangun pesan bukan kegagalan server.
+func IsDeliveryFailure(err error) bool {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		return protoErr.Code >= 400 && protoErr.Code < 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
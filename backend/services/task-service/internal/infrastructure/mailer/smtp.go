@@ -0,0 +1,215 @@
+// file: backend/services/task-service/internal/infrastructure/mailer/smtp.go
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Mode keamanan koneksi SMTP, dipilih lewat env SMTP_TLS.
+const (
+	TLSStartTLS = "starttls" // Koneksi biasa yang wajib di-upgrade dengan STARTTLS (port 587)
+	TLSImplicit = "tls"      // TLS sejak awal koneksi (port 465)
+	TLSNone     = "none"     // Tanpa TLS; hanya untuk relay lokal
+)
+
+// sendTimeout membatasi satu pengiriman jika ctx tidak punya deadline.
+const sendTimeout = 30 * time.Second
+
+// Config adalah konfigurasi server SMTP.
+type Config struct {
+	Host     string // Kosong berarti pengiriman email dimatikan
+	Port     int
+	Username string // Kosong berarti tanpa autentikasi
+	Password string
+	From     string // Alamat pengirim, boleh dengan nama, misalnya "Tasks <noreply@example.com>"
+	TLS      string // TLSStartTLS (default), TLSImplicit, atau TLSNone
+
+	// PasswordFunc, jika diisi, dipanggil setiap pengiriman dan menggantikan Password, untuk
+	// password yang dirotasi.
+	PasswordFunc func() string
+}
+
+// New membuat domain.EmailSender dari cfg. Host kosong berarti email dimatikan: Send selalu
+// mengembalikan domain.ErrEmailNotConfigured.
+func New(cfg Config) (domain.EmailSender, error) {
+	if cfg.Host == "" {
+		return disabledSender{}, nil
+	}
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp from address %q: %w", cfg.From, err)
+	}
+	mode := strings.ToLower(cfg.TLS)
+	switch mode {
+	case "":
+		mode = TLSStartTLS
+	case TLSStartTLS, TLSImplicit, TLSNone:
+	default:
+		return nil, fmt.Errorf("unknown smtp tls mode %q (supported: %s, %s, %s)", cfg.TLS, TLSStartTLS, TLSImplicit, TLSNone)
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = 587
+		if mode == TLSImplicit {
+			port = 465
+		}
+	}
+	password := cfg.PasswordFunc
+	if password == nil {
+		password = func() string { return cfg.Password }
+	}
+	return &SMTPSender{
+		addr:     net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		host:     cfg.Host,
+		username: cfg.Username,
+		password: password,
+		from:     from,
+		mode:     mode,
+	}, nil
+}
+
+// SMTPSender adalah implementasi domain.EmailSender dengan satu koneksi SMTP per email.
+type SMTPSender struct {
+	addr     string
+	host     string
+	username string
+	password func() string
+	from     *mail.Address
+	mode     string
+}
+
+// Send mengirim msg. Koneksi tanpa TLS hanya diizinkan untuk TLSNone, sehingga password tidak
+// pernah terkirim tanpa enkripsi karena server tidak menawarkan STARTTLS.
+func (s *SMTPSender) Send(ctx context.Context, msg domain.EmailMessage) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid email recipient: %w", err)
+	}
+	data, err := s.buildMessage(to, msg)
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("error connecting to smtp server: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(sendTimeout)
+	}
+	conn.SetDeadline(deadline)
+	if s.mode == TLSImplicit {
+		conn = tls.Client(conn, &tls.Config{ServerName: s.host})
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error starting smtp session: %w", err)
+	}
+	defer c.Close()
+	if s.mode == TLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("smtp server %s does not support STARTTLS", s.addr)
+		}
+		if err := c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return fmt.Errorf("error starting smtp tls: %w", err)
+		}
+	}
+	if s.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.username, s.password(), s.host)); err != nil {
+			return fmt.Errorf("error authenticating to smtp server: %w", err)
+		}
+	}
+	if err := c.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("error sending smtp MAIL FROM: %w", err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("error sending smtp RCPT TO: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("error sending smtp DATA: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("error writing email: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error writing email: %w", err)
+	}
+	return c.Quit()
+}
+
+// buildMessage menyusun email multipart/alternative dengan bagian teks dan HTML. Subject di-encode
+// dengan RFC 2047, sehingga karakter baris baru dari judul task tidak bisa menyisipkan header.
+func (s *SMTPSender) buildMessage(to *mail.Address, msg domain.EmailMessage) ([]byte, error) {
+	messageID := make([]byte, 16)
+	if _, err := rand.Read(messageID); err != nil {
+		return nil, fmt.Errorf("error generating message id: %w", err)
+	}
+	_, domainPart, _ := strings.Cut(s.from.Address, "@")
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error building email: %w", err)
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("error building email: %w", err)
+		}
+		if err := qp.Close(); err != nil {
+			return nil, fmt.Errorf("error building email: %w", err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("error building email: %w", err)
+	}
+
+	var buf bytes.Buffer
+	header := func(name, value string) { fmt.Fprintf(&buf, "%s: %s\r\n", name, value) }
+	header("From", s.from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", "<"+hex.EncodeToString(messageID)+"@"+domainPart+">")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+// disabledSender dipakai jika SMTP tidak dikonfigurasi.
+type disabledSender struct{}
+
+func (disabledSender) Send(context.Context, domain.EmailMessage) error {
+	return domain.ErrEmailNotConfigured
+}
@@ -0,0 +1,48 @@
+// file: backend/services/task-service/internal/infrastructure/markdown/markdown.go
+package markdown
+
+import (
+	"bytes"
+	stdhtml "html"
+	"log/slog"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// converter merender Markdown dengan ekstensi GFM (tabel, strikethrough, autolink, task list).
+// Raw HTML sengaja diteruskan (WithUnsafe) karena hasilnya selalu melewati policy di bawah,
+// sehingga tag aman seperti <b> tetap tampil sementara <script> dan atribut on* dibuang.
+var converter = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithRendererOptions(html.WithHardWraps(), html.WithUnsafe()),
+)
+
+// policy adalah whitelist HTML untuk konten pengguna, ditambah checkbox read-only dari task list GFM.
+// Semua link diberi rel="nofollow noreferrer" dan link eksternal dibuka di tab baru.
+var policy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	p.RequireNoReferrerOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}()
+
+// Render mengubah Markdown menjadi HTML yang sudah disanitasi dan aman ditampilkan klien
+// apa adanya (misalnya dengan v-html di Vue).
+func Render(source string) string {
+	if source == "" {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := converter.Convert([]byte(source), &buf); err != nil {
+		// Goldmark hanya gagal jika writer gagal; tetap kembalikan teks yang di-escape.
+		slog.Error("error rendering markdown", "error", err)
+		return stdhtml.EscapeString(source)
+	}
+	return policy.SanitizeReader(&buf).String()
+}
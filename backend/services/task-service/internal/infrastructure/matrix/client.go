@@ -0,0 +1,205 @@
+// file: backend/services/task-service/internal/infrastructure/matrix/client.go
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/safehttp"
+)
+
+// Batas laju request ke setiap homeserver. Homeserver self-hosted sering kecil, jadi satu
+// pengguna dengan banyak perubahan task tidak boleh membanjirinya.
+const (
+	requestsPerSecond = 2
+	requestBurst      = 5
+)
+
+// maxRetryAfter adalah batas jeda M_LIMIT_EXCEEDED yang masih ditunggu sebelum mencoba ulang sekali.
+const maxRetryAfter = 5 * time.Second
+
+// Client adalah implementasi domain.MatrixClient untuk Matrix Client-Server API v3.
+type Client struct {
+	client *http.Client
+
+	mu       sync.Mutex
+	limiters map[string]*tokenBucket // Key: host homeserver
+}
+
+// NewClient adalah constructor untuk Client. allowPrivateNetworks mengizinkan homeserver di
+// jaringan privat, yang umum untuk instalasi self-hosted di satu jaringan dengan service ini.
+func NewClient(allowPrivateNetworks bool) *Client {
+	return &Client{
+		client:   safehttp.NewClient(allowPrivateNetworks, 10*time.Second),
+		limiters: make(map[string]*tokenBucket),
+	}
+}
+
+// VerifyRoom memeriksa token lewat whoami, me-resolve alias room, lalu memastikan akun sudah
+// bergabung di room tersebut.
+func (c *Client) VerifyRoom(ctx context.Context, homeserverURL, accessToken, room string) (string, error) {
+	var whoami struct {
+		UserID string `json:"user_id"`
+	}
+	if err := c.call(ctx, homeserverURL, accessToken, http.MethodGet, "/_matrix/client/v3/account/whoami", nil, &whoami); err != nil {
+		return "", err
+	}
+
+	roomID := room
+	if strings.HasPrefix(room, "#") {
+		var resolved struct {
+			RoomID string `json:"room_id"`
+		}
+		if err := c.call(ctx, homeserverURL, accessToken, http.MethodGet,
+			"/_matrix/client/v3/directory/room/"+url.PathEscape(room), nil, &resolved); err != nil {
+			return "", err
+		}
+		roomID = resolved.RoomID
+	}
+
+	var joined struct {
+		JoinedRooms []string `json:"joined_rooms"`
+	}
+	if err := c.call(ctx, homeserverURL, accessToken, http.MethodGet, "/_matrix/client/v3/joined_rooms", nil, &joined); err != nil {
+		return "", err
+	}
+	if !slices.Contains(joined.JoinedRooms, roomID) {
+		return "", fmt.Errorf("%s has not joined room %s", whoami.UserID, roomID)
+	}
+	return roomID, nil
+}
+
+// SendMessage mengirim m.notice agar bot lain tidak membalas pesan notifikasi.
+func (c *Client) SendMessage(ctx context.Context, homeserverURL, accessToken, roomID, txnID string, msg domain.MatrixMessage) error {
+	content := map[string]string{"msgtype": "m.notice", "body": msg.Body}
+	if msg.FormattedBody != "" {
+		content["format"] = "org.matrix.custom.html"
+		content["formatted_body"] = msg.FormattedBody
+	}
+	path := "/_matrix/client/v3/rooms/" + url.PathEscape(roomID) + "/send/m.room.message/" + url.PathEscape(txnID)
+	return c.call(ctx, homeserverURL, accessToken, http.MethodPut, path, content, nil)
+}
+
+// matrixError adalah body error standar Matrix.
+type matrixError struct {
+	ErrCode      string `json:"errcode"`
+	Error        string `json:"error"`
+	RetryAfterMs int64  `json:"retry_after_ms"`
+}
+
+// call mengirim satu request setelah menunggu giliran rate limit homeserver, dan mencoba ulang
+// sekali jika homeserver menjawab 429 dengan jeda yang pendek.
+func (c *Client) call(ctx context.Context, homeserverURL, accessToken, method, path string, body, out any) error {
+	base, err := url.Parse(strings.TrimSuffix(homeserverURL, "/"))
+	if err != nil {
+		return fmt.Errorf("invalid homeserver url: %w", err)
+	}
+	var payload []byte
+	if body != nil {
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("error encoding matrix request: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter(base.Host).wait(ctx); err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, method, base.String()+path, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("error creating matrix request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("error sending matrix request: %w", err)
+		}
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("error reading matrix response: %w", err)
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			if out == nil {
+				return nil
+			}
+			if err := json.Unmarshal(respBody, out); err != nil {
+				return fmt.Errorf("error decoding matrix response: %w", err)
+			}
+			return nil
+		}
+
+		var merr matrixError
+		_ = json.Unmarshal(respBody, &merr)
+		wait := time.Duration(merr.RetryAfterMs) * time.Millisecond
+		if resp.StatusCode == http.StatusTooManyRequests && attempt == 0 && wait <= maxRetryAfter {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(max(wait, time.Second)):
+			}
+			continue
+		}
+		if merr.ErrCode != "" {
+			return fmt.Errorf("matrix %s %s: %s: %s", method, path, merr.ErrCode, merr.Error)
+		}
+		return fmt.Errorf("matrix %s %s responded with status %d", method, path, resp.StatusCode)
+	}
+}
+
+// limiter mengembalikan token bucket untuk host, dibuat saat pertama dipakai.
+func (c *Client) limiter(host string) *tokenBucket {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bucket, ok := c.limiters[host]
+	if !ok {
+		bucket = &tokenBucket{tokens: requestBurst, last: time.Now()}
+		c.limiters[host] = bucket
+	}
+	return bucket
+}
+
+// tokenBucket membatasi request menjadi requestsPerSecond dengan burst requestBurst.
+type tokenBucket struct {
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// wait memblokir sampai satu token tersedia atau ctx dibatalkan.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = min(requestBurst, b.tokens+now.Sub(b.last).Seconds()*requestsPerSecond)
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / requestsPerSecond * float64(time.Second))
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// file: backend/services/task-service/internal/infrastructure/pdf/agenda.go
+package pdf
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Tata letak agenda dalam point.
+const (
+	agendaMargin     = 50
+	agendaFooter     = 30 // Jarak baseline nomor halaman dari tepi bawah
+	agendaCheckbox   = 8
+	agendaTaskIndent = 16 // Jarak judul task dari tepi kiri, setelah checkbox
+)
+
+// WriteAgenda menulis agenda sebagai PDF A4 yang siap dicetak: task dikelompokkan per daftar lalu
+// per prioritas, masing-masing dengan checkbox (tercentang jika selesai), judul, dan tenggat.
+func WriteAgenda(w io.Writer, agenda *domain.Agenda) error {
+	title := "Agenda " + agendaRange(agenda)
+	l := &agendaLayout{
+		doc:      newDocument(title),
+		location: agenda.Location,
+		multiDay: agenda.To.Sub(agenda.From) > 25*time.Hour, // Satu hari bisa 25 jam saat DST berakhir
+	}
+	l.newPage()
+	l.doc.text(agendaMargin, l.y-20, helveticaBold, 20, 0, "Agenda")
+	l.doc.text(agendaMargin, l.y-38, helvetica, 11, 0.4, agendaRange(agenda))
+	l.y -= 56
+
+	if len(agenda.Lists) == 0 {
+		l.doc.text(agendaMargin, l.y-12, helvetica, 11, 0.4, "No tasks are due in this period.")
+	}
+	for _, list := range agenda.Lists {
+		heading := "My tasks"
+		if list.Shared {
+			heading = "Shared by " + string(list.OwnerID)
+		}
+		l.reserve(48) // Judul daftar tidak ditinggal sendirian di bawah halaman
+		l.y -= 14
+		l.doc.text(agendaMargin, l.y-14, helveticaBold, 14, 0, heading)
+		l.doc.line(agendaMargin, l.y-19, pageWidth-agendaMargin, l.y-19, 0.5, 0.7)
+		l.y -= 24
+		for _, priority := range list.Priorities {
+			l.reserve(34)
+			l.y -= 6
+			l.doc.text(agendaMargin, l.y-11, helveticaBold, 11, 0.25, priority.Label)
+			l.y -= 16
+			for _, task := range priority.Tasks {
+				l.task(task)
+			}
+		}
+	}
+	if agenda.Truncated {
+		l.reserve(20)
+		l.doc.text(agendaMargin, l.y-14, helvetica, 9, 0.4, "Some tasks were left out because a list has too many tasks in this period.")
+	}
+	return l.doc.writeTo(w)
+}
+
+// agendaLayout melacak posisi tulis; y adalah batas atas baris berikutnya.
+type agendaLayout struct {
+	doc      *document
+	y        float64
+	page     int
+	location *time.Location
+	multiDay bool // Tenggat ditulis dengan tanggal, bukan hanya jam
+}
+
+// newPage memulai halaman baru dengan nomor halaman di bagian bawah.
+func (l *agendaLayout) newPage() {
+	l.doc.addPage()
+	l.page++
+	l.y = pageHeight - agendaMargin
+	label := fmt.Sprintf("Page %d", l.page)
+	l.doc.text(pageWidth-agendaMargin-textWidth(helvetica, 8, label), agendaFooter, helvetica, 8, 0.5, label)
+}
+
+// reserve pindah ke halaman baru jika sisa halaman kurang dari height.
+func (l *agendaLayout) reserve(height float64) {
+	if l.y-height < agendaMargin {
+		l.newPage()
+	}
+}
+
+// task menulis checkbox, judul (dibungkus ke beberapa baris jika perlu), dan baris detail.
+func (l *agendaLayout) task(task *domain.Task) {
+	width := pageWidth - 2*agendaMargin - agendaTaskIndent
+	lines := wrapText(helvetica, 11, width, strings.Join(strings.Fields(task.Title), " "))
+	if len(lines) == 0 {
+		lines = []string{"(untitled)"}
+	}
+	height := float64(len(lines))*14 + 16
+	l.reserve(height)
+
+	top := l.y
+	l.doc.rect(agendaMargin, top-10, agendaCheckbox, agendaCheckbox, 0.8)
+	gray := 0.0
+	if task.Completed {
+		l.doc.line(agendaMargin+1.5, top-6, agendaMargin+3.5, top-8.5, 1.2, 0)
+		l.doc.line(agendaMargin+3.5, top-8.5, agendaMargin+7, top-3.5, 1.2, 0)
+		gray = 0.45
+	}
+	for i, line := range lines {
+		l.doc.text(agendaMargin+agendaTaskIndent, top-10-float64(i)*14, helvetica, 11, gray, line)
+	}
+	l.doc.text(agendaMargin+agendaTaskIndent, top-10-float64(len(lines)-1)*14-13, helvetica, 8.5, 0.45, l.details(task))
+	l.y -= height
+}
+
+// details menulis tenggat dan, jika ada, estimasi dan status selesai task.
+func (l *agendaLayout) details(task *domain.Task) string {
+	layout := "15:04"
+	if l.multiDay {
+		layout = "Mon 2 Jan, 15:04"
+	}
+	parts := []string{"Due " + task.DueAt.In(l.location).Format(layout)}
+	if task.EstimateMinutes != nil && *task.EstimateMinutes > 0 {
+		parts = append(parts, fmt.Sprintf("Estimate %dh%02dm", *task.EstimateMinutes/60, *task.EstimateMinutes%60))
+	}
+	if task.Completed {
+		parts = append(parts, "Completed")
+	}
+	return strings.Join(parts, " · ")
+}
+
+// agendaRange menulis rentang agenda, misalnya "Wed, 14 Oct 2026" atau "12 Oct – 18 Oct 2026".
+func agendaRange(agenda *domain.Agenda) string {
+	from := agenda.From.In(agenda.Location)
+	last := agenda.To.In(agenda.Location).AddDate(0, 0, -1)
+	if !last.After(from) {
+		return from.Format("Mon, 2 Jan 2006")
+	}
+	return from.Format("2 Jan") + " – " + last.Format("2 Jan 2006")
+}
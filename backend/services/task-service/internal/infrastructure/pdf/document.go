@@ -0,0 +1,223 @@
+// file: backend/services/task-service/internal/infrastructure/pdf/document.go
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// ContentType adalah media type dokumen PDF.
+const ContentType = "application/pdf"
+
+// Ukuran halaman A4 dalam point (1/72 inci).
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+)
+
+// font adalah salah satu font standar PDF yang tidak perlu di-embed.
+type font int
+
+const (
+	helvetica font = iota
+	helveticaBold
+)
+
+// document adalah penulis PDF minimal: halaman A4, teks dengan font standar (encoding WinAnsi),
+// garis, dan kotak. Koordinat memakai sistem PDF, dengan titik (0, 0) di kiri bawah halaman.
+type document struct {
+	title string
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+}
+
+// newDocument membuat dokumen kosong; title ditulis ke metadata dokumen.
+func newDocument(title string) *document {
+	return &document{title: title}
+}
+
+// addPage memulai halaman baru. Semua perintah gambar berikutnya ditulis ke halaman ini.
+func (d *document) addPage() {
+	d.page = &bytes.Buffer{}
+	d.pages = append(d.pages, d.page)
+}
+
+// text menulis s dengan baseline di (x, y). gray 0 berarti hitam, 1 berarti putih.
+func (d *document) text(x, y float64, f font, size, gray float64, s string) {
+	fmt.Fprintf(d.page, "BT %.3f g /F%d %.2f Tf %.2f %.2f Td (%s) Tj ET\n", gray, f+1, size, x, y, encodeText(s))
+}
+
+// rect menggambar garis tepi kotak dengan sudut kiri bawah (x, y).
+func (d *document) rect(x, y, w, h, lineWidth float64) {
+	fmt.Fprintf(d.page, "%.2f w %.2f %.2f %.2f %.2f re S\n", lineWidth, x, y, w, h)
+}
+
+// line menggambar garis dari (x1, y1) ke (x2, y2).
+func (d *document) line(x1, y1, x2, y2, lineWidth, gray float64) {
+	fmt.Fprintf(d.page, "%.2f w %.3f G %.2f %.2f m %.2f %.2f l S 0 G\n", lineWidth, gray, x1, y1, x2, y2)
+}
+
+// writeTo menulis dokumen lengkap: katalog, pohon halaman, dua font, isi halaman (FlateDecode),
+// metadata, dan tabel xref.
+func (d *document) writeTo(w io.Writer) error {
+	if len(d.pages) == 0 {
+		d.addPage()
+	}
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	// Objek 1-4: katalog, pohon halaman, Helvetica, metadata. Halaman ke-i memakai objek 5+2i
+	// (page) dan 6+2i (content stream), lalu Helvetica-Bold di objek terakhir.
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (task-service) >>", encodeText(d.title)))
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << /Font << /F1 3 0 R /F2 %d 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 5+2*len(d.pages), 6+2*i))
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write(page.Bytes())
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("error compressing pdf page: %w", err)
+		}
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.Bytes()))
+	}
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// winAnsiExtra adalah karakter WinAnsi di luar Latin-1 yang sering muncul di judul task.
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// winAnsi mengubah r ke byte WinAnsi, atau '?' jika font standar tidak punya glyph-nya.
+func winAnsi(r rune) byte {
+	switch {
+	case r >= 0x20 && r <= 0x7e, r >= 0xa0 && r <= 0xff:
+		return byte(r)
+	case r == '\t':
+		return ' '
+	}
+	if b, ok := winAnsiExtra[r]; ok {
+		return b
+	}
+	return '?'
+}
+
+// encodeText mengubah s ke string literal PDF dalam encoding WinAnsi.
+func encodeText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch c := winAnsi(r); c {
+		case '(', ')', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// textWidth mengembalikan lebar s dalam point untuk font dan ukuran tersebut.
+func textWidth(f font, size float64, s string) float64 {
+	widths := &helveticaWidths
+	if f == helveticaBold {
+		widths = &helveticaBoldWidths
+	}
+	total := 0
+	for _, r := range s {
+		c := winAnsi(r)
+		switch {
+		case c >= 0x20 && c <= 0x7e:
+			total += widths[c-0x20]
+		case c == 0x97 || c == 0x85:
+			total += 1000
+		default:
+			total += 556 // Perkiraan untuk huruf beraksen dan tanda baca lain
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// wrapText memecah s menjadi baris yang masing-masing tidak lebih lebar dari width. Kata yang
+// lebih panjang dari satu baris dipotong per karakter.
+func wrapText(f font, size, width float64, s string) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(s) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if textWidth(f, size, candidate) <= width {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+			line = ""
+		}
+		for textWidth(f, size, word) > width {
+			cut := len(word)
+			for cut > 0 && textWidth(f, size, word[:cut]) > width {
+				_, n := utf8.DecodeLastRuneInString(word[:cut])
+				cut -= n
+			}
+			if cut == 0 {
+				_, cut = utf8.DecodeRuneInString(word)
+			}
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// helveticaWidths dan helveticaBoldWidths adalah lebar glyph ASCII 0x20-0x7e (per 1000 unit em)
+// dari metrik AFM font standar Adobe.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,59 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/cursor.go
+package persistence
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Cursor pagination di-encode base64url agar opaque bagi klien; formatnya boleh berubah
+// (misalnya saat strategi ID berubah) tanpa dianggap breaking change. Prefix jenis cursor
+// memastikan cursor dari strategi lain ditolak, bukan diartikan secara keliru.
+const (
+	idCursorPrefix     = "i:"
+	timeIDCursorPrefix = "t:"
+)
+
+var errMalformedCursor = errors.New("malformed cursor")
+
+func encodeIDCursor(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(idCursorPrefix + id))
+}
+
+func decodeIDCursor(cursor string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errMalformedCursor
+	}
+	id, found := strings.CutPrefix(string(raw), idCursorPrefix)
+	if !found || id == "" {
+		return "", errMalformedCursor
+	}
+	return id, nil
+}
+
+func encodeTimeIDCursor(t time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(timeIDCursorPrefix + t.UTC().Format(time.RFC3339Nano) + "|" + id))
+}
+
+func decodeTimeIDCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", errMalformedCursor
+	}
+	value, found := strings.CutPrefix(string(raw), timeIDCursorPrefix)
+	if !found {
+		return time.Time{}, "", errMalformedCursor
+	}
+	rawTime, id, found := strings.Cut(value, "|")
+	if !found || id == "" {
+		return time.Time{}, "", errMalformedCursor
+	}
+	t, err := time.Parse(time.RFC3339Nano, rawTime)
+	if err != nil {
+		return time.Time{}, "", errMalformedCursor
+	}
+	return t, id, nil
+}
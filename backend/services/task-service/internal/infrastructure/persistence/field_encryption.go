@@ -0,0 +1,287 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/field_encryption.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// taskDescriptionAAD mengikat ciphertext deskripsi ke task-nya. Deskripsi di task_revisions memakai
+// AAD yang sama karena disalin apa adanya oleh trigger revisi.
+func taskDescriptionAAD(taskID string) string {
+	return "tasks.description:" + taskID
+}
+
+// commentBodyAAD mengikat ciphertext isi komentar ke komentarnya.
+func commentBodyAAD(commentID string) string {
+	return "task_comments.body:" + commentID
+}
+
+// encryptDescription mengembalikan deskripsi task yang siap ditulis ke kolom tasks.description.
+func encryptDescription(cipher domain.FieldCipher, task *domain.Task) (string, error) {
+	description, err := cipher.Encrypt(task.Description, taskDescriptionAAD(task.ID))
+	if err != nil {
+		return "", fmt.Errorf("error encrypting description of task %s: %w", task.ID, err)
+	}
+	return description, nil
+}
+
+// decryptTask mengganti deskripsi hasil scan dengan plaintext-nya.
+func decryptTask(cipher domain.FieldCipher, task *domain.Task) error {
+	description, err := cipher.Decrypt(task.Description, taskDescriptionAAD(task.ID))
+	if err != nil {
+		return fmt.Errorf("error decrypting description of task %s: %w", task.ID, err)
+	}
+	task.Description = description
+	return nil
+}
+
+// encryptCommentBody mengembalikan isi komentar yang siap ditulis ke kolom task_comments.body.
+func encryptCommentBody(cipher domain.FieldCipher, comment *domain.TaskComment) (string, error) {
+	body, err := cipher.Encrypt(comment.Body, commentBodyAAD(comment.ID))
+	if err != nil {
+		return "", fmt.Errorf("error encrypting body of comment %s: %w", comment.ID, err)
+	}
+	return body, nil
+}
+
+// decryptComment mengganti isi komentar hasil scan dengan plaintext-nya.
+func decryptComment(cipher domain.FieldCipher, comment *domain.TaskComment) error {
+	body, err := cipher.Decrypt(comment.Body, commentBodyAAD(comment.ID))
+	if err != nil {
+		return fmt.Errorf("error decrypting body of comment %s: %w", comment.ID, err)
+	}
+	comment.Body = body
+	return nil
+}
+
+// decryptRevision mendekripsi deskripsi di changes dan snapshot revisi.
+func decryptRevision(cipher domain.FieldCipher, revision *domain.TaskRevision) error {
+	aad := taskDescriptionAAD(revision.TaskID)
+	if change, ok := revision.Changes["description"]; ok {
+		for _, value := range []*any{&change.Old, &change.New} {
+			stored, ok := (*value).(string)
+			if !ok {
+				continue
+			}
+			plaintext, err := cipher.Decrypt(stored, aad)
+			if err != nil {
+				return fmt.Errorf("error decrypting description change of task %s: %w", revision.TaskID, err)
+			}
+			*value = plaintext
+		}
+		revision.Changes["description"] = change
+	}
+	description, err := cipher.Decrypt(revision.Snapshot.Description, aad)
+	if err != nil {
+		return fmt.Errorf("error decrypting description snapshot of task %s: %w", revision.TaskID, err)
+	}
+	revision.Snapshot.Description = description
+	return nil
+}
+
+// PostgresFieldEncryptionRepository adalah implementasi domain.FieldEncryptionRepository untuk
+// tasks.description, task_comments.body, dan deskripsi di task_revisions.
+type PostgresFieldEncryptionRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresFieldEncryptionRepository adalah constructor untuk PostgresFieldEncryptionRepository.
+func NewPostgresFieldEncryptionRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.FieldEncryptionRepository {
+	return &PostgresFieldEncryptionRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// EncryptBatch mengenkripsi ulang dalam satu transaksi yang mematikan trigger revisi, agar enkripsi
+// ulang tidak tercatat sebagai perubahan deskripsi. updated_at tidak diubah karena plaintext-nya
+// tetap sama. Baris yang sedang dikunci transaksi lain dilewati dan diambil di batch berikutnya.
+func (r *PostgresFieldEncryptionRepository) EncryptBatch(ctx context.Context, limit int) (int, error) {
+	prefix := r.cipher.CurrentPrefix()
+	if prefix == "" {
+		return 0, nil
+	}
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error starting field encryption transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	if _, err := tx.Exec(ctx, `SELECT set_config('app.skip_revision', 'on', true)`); err != nil {
+		return 0, fmt.Errorf("error disabling revision trigger: %w", err)
+	}
+
+	tasks, err := r.encryptColumn(ctx, tx, "tasks", "description", taskDescriptionAAD, prefix, limit)
+	if err != nil {
+		return 0, err
+	}
+	comments, err := r.encryptColumn(ctx, tx, "task_comments", "body", commentBodyAAD, prefix, limit)
+	if err != nil {
+		return 0, err
+	}
+	revisions, err := r.encryptRevisions(ctx, tx, prefix, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("error committing field encryption transaction: %w", err)
+	}
+	return tasks + comments + revisions, nil
+}
+
+// encryptColumn mengenkripsi ulang nilai kolom teks yang belum memakai key aktif. table dan column
+// selalu konstanta dari EncryptBatch, bukan input pengguna.
+func (r *PostgresFieldEncryptionRepository) encryptColumn(ctx context.Context, tx pgx.Tx, table, column string, aad func(string) string, prefix string, limit int) (int, error) {
+	rows, err := tx.Query(ctx, `SELECT id, `+column+` FROM `+table+`
+	           WHERE `+column+` <> '' AND NOT starts_with(`+column+`, $1)
+	           LIMIT $2 FOR UPDATE SKIP LOCKED`, prefix, limit)
+	if err != nil {
+		return 0, fmt.Errorf("error finding unencrypted %s.%s: %w", table, column, err)
+	}
+	type pending struct{ id, value string }
+	var values []pending
+	for rows.Next() {
+		var value pending
+		if err := rows.Scan(&value.id, &value.value); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("error scanning %s.%s row: %w", table, column, err)
+		}
+		values = append(values, value)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating %s.%s rows: %w", table, column, err)
+	}
+
+	if len(values) == 0 {
+		return 0, nil
+	}
+	batch := &pgx.Batch{}
+	for _, value := range values {
+		encrypted, err := r.reencrypt(value.value, aad(value.id))
+		if err != nil {
+			return 0, fmt.Errorf("error re-encrypting %s.%s of %s: %w", table, column, value.id, err)
+		}
+		batch.Queue(`UPDATE `+table+` SET `+column+` = $2 WHERE id = $1`, value.id, encrypted)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return 0, fmt.Errorf("error updating %s.%s: %w", table, column, err)
+	}
+	return len(values), nil
+}
+
+// storedDescriptionChange adalah entri description di kolom changes task_revisions.
+type storedDescriptionChange struct {
+	Old *string `json:"old"`
+	New *string `json:"new"`
+}
+
+// encryptRevisions mengenkripsi ulang deskripsi di changes dan snapshot task_revisions. Field lain
+// di kedua kolom JSONB disalin apa adanya.
+func (r *PostgresFieldEncryptionRepository) encryptRevisions(ctx context.Context, tx pgx.Tx, prefix string, limit int) (int, error) {
+	rows, err := tx.Query(ctx, `SELECT id, task_id, changes, snapshot FROM task_revisions
+	           WHERE (snapshot->>'description' <> '' AND NOT starts_with(snapshot->>'description', $1))
+	              OR (changes->'description'->>'old' <> '' AND NOT starts_with(changes->'description'->>'old', $1))
+	              OR (changes->'description'->>'new' <> '' AND NOT starts_with(changes->'description'->>'new', $1))
+	           LIMIT $2 FOR UPDATE SKIP LOCKED`, prefix, limit)
+	if err != nil {
+		return 0, fmt.Errorf("error finding unencrypted revisions: %w", err)
+	}
+	type pending struct {
+		id                int64
+		taskID            string
+		changes, snapshot []byte
+	}
+	var revisions []pending
+	for rows.Next() {
+		var revision pending
+		if err := rows.Scan(&revision.id, &revision.taskID, &revision.changes, &revision.snapshot); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("error scanning revision row: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating revision rows: %w", err)
+	}
+
+	if len(revisions) == 0 {
+		return 0, nil
+	}
+	batch := &pgx.Batch{}
+	for _, revision := range revisions {
+		aad := taskDescriptionAAD(revision.taskID)
+		var changes, snapshot map[string]json.RawMessage
+		if err := json.Unmarshal(revision.changes, &changes); err != nil {
+			return 0, fmt.Errorf("error decoding changes of revision %d: %w", revision.id, err)
+		}
+		if err := json.Unmarshal(revision.snapshot, &snapshot); err != nil {
+			return 0, fmt.Errorf("error decoding snapshot of revision %d: %w", revision.id, err)
+		}
+
+		if raw, ok := changes["description"]; ok {
+			var change storedDescriptionChange
+			if err := json.Unmarshal(raw, &change); err != nil {
+				return 0, fmt.Errorf("error decoding description change of revision %d: %w", revision.id, err)
+			}
+			for _, value := range []*string{change.Old, change.New} {
+				if value == nil {
+					continue
+				}
+				if *value, err = r.reencrypt(*value, aad); err != nil {
+					return 0, fmt.Errorf("error re-encrypting description change of revision %d: %w", revision.id, err)
+				}
+			}
+			if changes["description"], err = json.Marshal(change); err != nil {
+				return 0, err
+			}
+		}
+		if raw, ok := snapshot["description"]; ok {
+			var description string
+			if err := json.Unmarshal(raw, &description); err != nil {
+				return 0, fmt.Errorf("error decoding description snapshot of revision %d: %w", revision.id, err)
+			}
+			if description, err = r.reencrypt(description, aad); err != nil {
+				return 0, fmt.Errorf("error re-encrypting description snapshot of revision %d: %w", revision.id, err)
+			}
+			if snapshot["description"], err = json.Marshal(description); err != nil {
+				return 0, err
+			}
+		}
+
+		changesJSON, err := json.Marshal(changes)
+		if err != nil {
+			return 0, err
+		}
+		snapshotJSON, err := json.Marshal(snapshot)
+		if err != nil {
+			return 0, err
+		}
+		batch.Queue(`UPDATE task_revisions SET changes = $2, snapshot = $3 WHERE id = $1`, revision.id, changesJSON, snapshotJSON)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return 0, fmt.Errorf("error updating revisions: %w", err)
+	}
+	return len(revisions), nil
+}
+
+// reencrypt mendekripsi nilai tersimpan (plaintext atau ciphertext key lama) lalu mengenkripsinya
+// dengan key aktif. Nilai dengan key yang tidak lagi dikonfigurasi menghasilkan
+// domain.ErrFieldKeyNotFound.
+func (r *PostgresFieldEncryptionRepository) reencrypt(stored, aad string) (string, error) {
+	plaintext, err := r.cipher.Decrypt(stored, aad)
+	if err != nil {
+		return "", err
+	}
+	return r.cipher.Encrypt(plaintext, aad)
+}
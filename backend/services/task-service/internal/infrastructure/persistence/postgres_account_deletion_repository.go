@@ -0,0 +1,268 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_account_deletion_repository.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const accountDeletionColumns = `id, user_id, status, receipt, error, requested_at, started_at, completed_at`
+
+// accountDeletionReceipt adalah bentuk JSON kolom receipt.
+type accountDeletionReceipt struct {
+	DeletedTasks            int64 `json:"deleted_tasks"`
+	DeletedShares           int   `json:"deleted_shares"`
+	DeletedReminderChannels int   `json:"deleted_reminder_channels"`
+	DeletedWebhooks         int64 `json:"deleted_webhooks"`
+	DeletedFiles            int   `json:"deleted_files"`
+	AnonymizedComments      int64 `json:"anonymized_comments"`
+	AnonymizedActivities    int64 `json:"anonymized_activities"`
+	AnonymizedAuditEntries  int64 `json:"anonymized_audit_entries"`
+	DeletedRecords          int64 `json:"deleted_records"`
+}
+
+func scanAccountDeletion(row pgx.Row) (*domain.AccountDeletion, error) {
+	deletion := &domain.AccountDeletion{}
+	var receipt []byte
+	err := row.Scan(
+		&deletion.ID,
+		&deletion.UserID,
+		&deletion.Status,
+		&receipt,
+		&deletion.Error,
+		&deletion.RequestedAt,
+		&deletion.StartedAt,
+		&deletion.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if receipt != nil {
+		var stored accountDeletionReceipt
+		if err := json.Unmarshal(receipt, &stored); err != nil {
+			return nil, fmt.Errorf("error decoding account deletion receipt: %w", err)
+		}
+		r := domain.AccountDeletionReceipt(stored)
+		deletion.Receipt = &r
+	}
+	return deletion, nil
+}
+
+// PostgresAccountDeletionRepository adalah implementasi domain.AccountDeletionRepository
+// menggunakan tabel account_deletions.
+type PostgresAccountDeletionRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresAccountDeletionRepository adalah constructor untuk PostgresAccountDeletionRepository.
+func NewPostgresAccountDeletionRepository(dbpool *pgxpool.Pool) domain.AccountDeletionRepository {
+	return &PostgresAccountDeletionRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Create menyimpan permintaan baru; penghapusan aktif ganda ditolak oleh idx_account_deletions_active.
+func (r *PostgresAccountDeletionRepository) Create(ctx context.Context, deletion *domain.AccountDeletion) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO account_deletions (id, user_id, status, requested_at)
+	           VALUES ($1, $2, $3, $4)`,
+		deletion.ID, deletion.UserID, deletion.Status, deletion.RequestedAt)
+	if isUniqueViolation(err, "idx_account_deletions_active") {
+		return domain.ErrAccountDeletionInProgress
+	}
+	if err != nil {
+		return fmt.Errorf("error creating account deletion for %s: %w", deletion.UserID, err)
+	}
+	return nil
+}
+
+// FindByID mencari penghapusan milik userID.
+func (r *PostgresAccountDeletionRepository) FindByID(ctx context.Context, userID domain.UserID, id string) (*domain.AccountDeletion, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+accountDeletionColumns+` FROM account_deletions WHERE id = $1 AND user_id = $2`, id, userID)
+	deletion, err := scanAccountDeletion(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrAccountDeletionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding account deletion %s: %w", id, err)
+	}
+	return deletion, nil
+}
+
+// ClaimPending memakai FOR UPDATE SKIP LOCKED sehingga dua klaim bersamaan tidak mengambil
+// penghapusan yang sama.
+func (r *PostgresAccountDeletionRepository) ClaimPending(ctx context.Context, now, staleBefore time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	rows, err := r.dbpool.Query(ctx, `UPDATE account_deletions SET status = 'running', started_at = $1
+	           WHERE id IN (
+	               SELECT id FROM account_deletions
+	               WHERE status = 'pending' OR (status = 'running' AND started_at < $2)
+	               ORDER BY requested_at LIMIT $3
+	               FOR UPDATE SKIP LOCKED
+	           )
+	           RETURNING `+accountDeletionColumns, now, staleBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming pending account deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var deletions []*domain.AccountDeletion
+	for rows.Next() {
+		deletion, err := scanAccountDeletion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning account deletion row: %w", err)
+		}
+		deletions = append(deletions, deletion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating account deletion rows: %w", err)
+	}
+	return deletions, nil
+}
+
+// Update menyimpan kolom yang berubah selama penghapusan diproses.
+func (r *PostgresAccountDeletionRepository) Update(ctx context.Context, deletion *domain.AccountDeletion) error {
+	var receipt []byte
+	if deletion.Receipt != nil {
+		var err error
+		receipt, err = json.Marshal(accountDeletionReceipt(*deletion.Receipt))
+		if err != nil {
+			return fmt.Errorf("error encoding account deletion receipt: %w", err)
+		}
+	}
+	_, err := r.dbpool.Exec(ctx, `UPDATE account_deletions
+	           SET status = $2, receipt = $3, error = $4, started_at = $5, completed_at = $6
+	           WHERE id = $1`,
+		deletion.ID, deletion.Status, receipt, deletion.Error, deletion.StartedAt, deletion.CompletedAt)
+	if err != nil {
+		return fmt.Errorf("error updating account deletion %s: %w", deletion.ID, err)
+	}
+	return nil
+}
+
+// erasureStatement adalah satu langkah EraseUserData. Statement dengan anonymize menerima
+// domain.DeletedUserID sebagai $2; jumlah baris yang diubah ditambahkan ke count.
+type erasureStatement struct {
+	description string
+	query       string
+	anonymize   bool
+	count       *int64
+}
+
+// PostgresAccountErasureRepository adalah implementasi domain.AccountErasureRepository.
+type PostgresAccountErasureRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresAccountErasureRepository adalah constructor untuk PostgresAccountErasureRepository.
+func NewPostgresAccountErasureRepository(dbpool *pgxpool.Pool) domain.AccountErasureRepository {
+	return &PostgresAccountErasureRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindObjectKeys mencari storage_key attachment di task pengguna atau yang diunggahnya di task
+// orang lain, serta blob arsip workspace dan ekspor data.
+func (r *PostgresAccountErasureRepository) FindObjectKeys(ctx context.Context, userID domain.UserID) (*domain.AccountObjectKeys, error) {
+	keys := &domain.AccountObjectKeys{}
+	attachmentKeys, err := r.collectKeys(ctx, `SELECT storage_key FROM task_attachments
+	           WHERE user_id = $1 OR task_id IN (SELECT id FROM tasks WHERE user_id = $1)`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding attachment keys for user_id %s: %w", userID, err)
+	}
+	keys.AttachmentKeys = attachmentKeys
+	blobKeys, err := r.collectKeys(ctx, `SELECT blob_key FROM workspace_archives WHERE user_id = $1 AND blob_key <> ''
+	           UNION ALL
+	           SELECT blob_key FROM data_exports WHERE user_id = $1 AND blob_key <> ''`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding blob keys for user_id %s: %w", userID, err)
+	}
+	keys.BlobKeys = blobKeys
+	return keys, nil
+}
+
+func (r *PostgresAccountErasureRepository) collectKeys(ctx context.Context, query string, userID domain.UserID) ([]string, error) {
+	rows, err := r.dbpool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// EraseUserData menjalankan statement berurutan dalam satu transaksi. Komentar di task orang lain
+// dipertahankan agar utas diskusi tetap utuh, tetapi isinya dikosongkan.
+func (r *PostgresAccountErasureRepository) EraseUserData(ctx context.Context, userID domain.UserID, receipt *domain.AccountDeletionReceipt) error {
+	statements := []erasureStatement{
+		{"comment mentions", `DELETE FROM task_comment_mentions
+		           WHERE user_id = $1 OR comment_id IN (SELECT id FROM task_comments WHERE author_id = $1)`, false, &receipt.DeletedRecords},
+		{"comments", `UPDATE task_comments SET author_id = $2, body = '' WHERE author_id = $1`, true, &receipt.AnonymizedComments},
+		{"activities", `UPDATE activities SET actor_id = $2 WHERE actor_id = $1`, true, &receipt.AnonymizedActivities},
+		{"task revisions", `UPDATE task_revisions SET actor_id = $2 WHERE actor_id = $1`, true, &receipt.AnonymizedActivities},
+		{"attachments", `DELETE FROM task_attachments WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"time entries", `DELETE FROM time_entries WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"webhooks", `DELETE FROM webhooks WHERE user_id = $1`, false, &receipt.DeletedWebhooks},
+		{"task callbacks", `DELETE FROM task_callbacks WHERE user_id = $1`, false, &receipt.DeletedWebhooks},
+		{"task events", `DELETE FROM task_events WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"usage events", `DELETE FROM usage_events WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"undo operations", `DELETE FROM task_undo_operations WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"board columns", `DELETE FROM board_columns WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"enum values", `DELETE FROM enum_values WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"devices", `DELETE FROM devices WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"retrospective settings", `DELETE FROM retrospective_settings WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"retrospectives", `DELETE FROM retrospectives WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"discord channel", `DELETE FROM discord_channels WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"matrix channel", `DELETE FROM matrix_channels WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"calendar feed token", `DELETE FROM calendar_feed_tokens WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"caldav token", `DELETE FROM caldav_tokens WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"google calendar connection", `DELETE FROM google_calendar_connections WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"workspace members", `DELETE FROM workspace_members WHERE workspace_id = $1`, false, &receipt.DeletedRecords},
+		{"workspace groups", `DELETE FROM workspace_groups WHERE workspace_id = $1`, false, &receipt.DeletedRecords},
+		{"workspace role mappings", `DELETE FROM workspace_role_mappings WHERE workspace_id = $1`, false, &receipt.DeletedRecords},
+		{"scim token", `DELETE FROM scim_tokens WHERE workspace_id = $1`, false, &receipt.DeletedRecords},
+		{"workspace archive", `DELETE FROM workspace_archives WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"data exports", `DELETE FROM data_exports WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"role", `DELETE FROM user_roles WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"security audit log", `DELETE FROM security_audit_log WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"last ip", `DELETE FROM user_last_ips WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"sessions", `DELETE FROM user_sessions WHERE user_id = $1`, false, &receipt.DeletedRecords},
+		{"admin audit log", `UPDATE admin_audit_log
+		           SET admin_id = CASE WHEN admin_id = $1 THEN $2 ELSE admin_id END,
+		               details = details
+		                   || CASE WHEN details->>'user_id' = $1 THEN jsonb_build_object('user_id', $2::text) ELSE '{}'::jsonb END
+		                   || CASE WHEN details->>'owner_id' = $1 THEN jsonb_build_object('owner_id', $2::text) ELSE '{}'::jsonb END
+		                   || CASE WHEN details->>'author_id' = $1 THEN jsonb_build_object('author_id', $2::text) ELSE '{}'::jsonb END
+		           WHERE admin_id = $1 OR details->>'user_id' = $1 OR details->>'owner_id' = $1 OR details->>'author_id' = $1`, true, &receipt.AnonymizedAuditEntries},
+	}
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting erasure transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	affected := make([]int64, len(statements))
+	for i, stmt := range statements {
+		args := []any{userID}
+		if stmt.anonymize {
+			args = append(args, domain.DeletedUserID)
+		}
+		tag, err := tx.Exec(ctx, stmt.query, args...)
+		if err != nil {
+			return fmt.Errorf("error erasing %s for user_id %s: %w", stmt.description, userID, err)
+		}
+		affected[i] = tag.RowsAffected()
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing erasure transaction: %w", err)
+	}
+	// Receipt baru diisi setelah commit agar transaksi yang gagal tidak ikut terhitung.
+	for i, stmt := range statements {
+		*stmt.count += affected[i]
+	}
+	return nil
+}
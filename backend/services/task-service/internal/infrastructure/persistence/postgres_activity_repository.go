@@ -0,0 +1,97 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_activity_repository.go
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// activityColumns adalah daftar kolom yang dibaca untuk setiap aktivitas, sesuai urutan Scan di scanActivity.
+const activityColumns = `id, user_id, actor_id, activity_type, task_id, task_title, occurred_at`
+
+// skipActivityStatement mematikan trigger trg_tasks_activities sampai akhir transaksi, untuk
+// operasi massal yang bukan aksi pengguna (pemulihan task, hapus data akun).
+const skipActivityStatement = `SELECT set_config('app.skip_activity', 'on', true)`
+
+func scanActivity(row pgx.Row) (*domain.Activity, error) {
+	activity := &domain.Activity{}
+	err := row.Scan(
+		&activity.ID,
+		&activity.UserID,
+		&activity.ActorID,
+		&activity.Type,
+		&activity.TaskID,
+		&activity.TaskTitle,
+		&activity.OccurredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// PostgresActivityRepository adalah implementasi domain.ActivityRepository menggunakan
+// tabel activities, yang diisi oleh trigger trg_tasks_activities.
+type PostgresActivityRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresActivityRepository adalah constructor untuk PostgresActivityRepository.
+func NewPostgresActivityRepository(dbpool *pgxpool.Pool) domain.ActivityRepository {
+	return &PostgresActivityRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindPageByUserID mengambil satu halaman aktivitas dengan keyset pagination pada id.
+// id BIGSERIAL mengikuti urutan pencatatan, jadi cursor cukup berupa id terakhir.
+func (r *PostgresActivityRepository) FindPageByUserID(ctx context.Context, userID domain.UserID, query domain.ActivityPageQuery) (*domain.ActivityPage, error) {
+	// Ambil satu baris ekstra untuk mengetahui apakah masih ada halaman berikutnya.
+	limit := query.Limit + 1
+
+	var rows pgx.Rows
+	var err error
+	if query.Cursor == "" {
+		rows, err = r.dbpool.Query(ctx, `SELECT `+activityColumns+`
+		           FROM activities WHERE user_id = $1 ORDER BY id DESC LIMIT $2`, userID, limit)
+	} else {
+		rawID, decodeErr := decodeIDCursor(query.Cursor)
+		if decodeErr != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		afterID, parseErr := strconv.ParseInt(rawID, 10, 64)
+		if parseErr != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		rows, err = r.dbpool.Query(ctx, `SELECT `+activityColumns+`
+		           FROM activities WHERE user_id = $1 AND id < $2 ORDER BY id DESC LIMIT $3`, userID, afterID, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding activities for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var activities []domain.Activity
+	for rows.Next() {
+		activity, err := scanActivity(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning activity row: %w", err)
+		}
+		activities = append(activities, *activity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating activity rows: %w", err)
+	}
+
+	page := &domain.ActivityPage{Activities: activities}
+	if len(activities) > query.Limit {
+		page.Activities = activities[:query.Limit]
+		page.NextCursor = encodeIDCursor(strconv.FormatInt(page.Activities[len(page.Activities)-1].ID, 10))
+	}
+	return page, nil
+}
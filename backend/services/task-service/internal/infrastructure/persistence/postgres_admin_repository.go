@@ -0,0 +1,223 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_admin_repository.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// likeEscaper meng-escape karakter wildcard LIKE agar query admin dicari secara literal.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// PostgresAdminSearchRepository adalah implementasi domain.AdminSearchRepository menggunakan PostgreSQL.
+type PostgresAdminSearchRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresAdminSearchRepository adalah constructor untuk PostgresAdminSearchRepository.
+func NewPostgresAdminSearchRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.AdminSearchRepository {
+	return &PostgresAdminSearchRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// SearchTasks mencari task lintas pengguna berdasarkan ID, user ID, atau potongan judul.
+// Pencarian judul memakai index trigram (pg_trgm) pada kolom title.
+func (r *PostgresAdminSearchRepository) SearchTasks(ctx context.Context, query string, limit int) ([]*domain.Task, error) {
+	sql := `SELECT ` + taskColumns + `
+	         FROM tasks
+	         WHERE id = $1 OR user_id = $1 OR title ILIKE $2 ESCAPE '\'
+	         ORDER BY updated_at DESC LIMIT $3`
+	rows, err := r.dbpool.Query(ctx, sql, query, "%"+likeEscaper.Replace(query)+"%", limit)
+	if err != nil {
+		return nil, fmt.Errorf("error searching tasks for admin: %w", err)
+	}
+	return collectTasks(rows, r.cipher)
+}
+
+// SearchUsers mencari pengguna yang memiliki task dan ID-nya diawali query.
+func (r *PostgresAdminSearchRepository) SearchUsers(ctx context.Context, query string, limit int) ([]domain.UserSummary, error) {
+	sql := `SELECT user_id, COUNT(*), MAX(updated_at)
+	         FROM tasks
+	         WHERE user_id LIKE $1 ESCAPE '\'
+	         GROUP BY user_id ORDER BY user_id LIMIT $2`
+	rows, err := r.dbpool.Query(ctx, sql, likeEscaper.Replace(query)+"%", limit)
+	if err != nil {
+		return nil, fmt.Errorf("error searching users for admin: %w", err)
+	}
+	return collectUserSummaries(rows)
+}
+
+// TopUsers mengelompokkan seluruh tabel tasks per pengguna.
+func (r *PostgresAdminSearchRepository) TopUsers(ctx context.Context, limit int) ([]domain.UserSummary, error) {
+	sql := `SELECT user_id, COUNT(*), MAX(updated_at)
+	         FROM tasks
+	         GROUP BY user_id ORDER BY COUNT(*) DESC, user_id LIMIT $1`
+	rows, err := r.dbpool.Query(ctx, sql, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error listing top users for admin: %w", err)
+	}
+	return collectUserSummaries(rows)
+}
+
+func collectUserSummaries(rows pgx.Rows) ([]domain.UserSummary, error) {
+	defer rows.Close()
+
+	var users []domain.UserSummary
+	for rows.Next() {
+		var user domain.UserSummary
+		if err := rows.Scan(&user.UserID, &user.TaskCount, &user.LastActivityAt); err != nil {
+			return nil, fmt.Errorf("error scanning user summary row: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user summary rows: %w", err)
+	}
+	return users, nil
+}
+
+// UserContentCounts menghitung semua jumlah dalam satu query.
+func (r *PostgresAdminSearchRepository) UserContentCounts(ctx context.Context, userID domain.UserID) (*domain.UserContentCounts, error) {
+	sql := `SELECT
+	           (SELECT COUNT(*) FROM tasks WHERE user_id = $1),
+	           (SELECT COUNT(*) FROM tasks WHERE user_id = $1 AND NOT completed),
+	           (SELECT COUNT(*) FROM tasks WHERE user_id = $1 AND archived),
+	           (SELECT COUNT(*) FROM task_comments WHERE author_id = $1),
+	           (SELECT COUNT(*) FROM task_attachments a JOIN tasks t ON t.id = a.task_id WHERE t.user_id = $1),
+	           (SELECT COALESCE(SUM(a.size_bytes), 0) FROM task_attachments a JOIN tasks t ON t.id = a.task_id WHERE t.user_id = $1),
+	           (SELECT COUNT(*) FROM list_shares WHERE owner_id = $1),
+	           (SELECT MAX(updated_at) FROM tasks WHERE user_id = $1)`
+	counts := &domain.UserContentCounts{UserID: userID}
+	err := r.dbpool.QueryRow(ctx, sql, userID).Scan(
+		&counts.Tasks,
+		&counts.OpenTasks,
+		&counts.ArchivedTasks,
+		&counts.Comments,
+		&counts.Attachments,
+		&counts.AttachmentBytes,
+		&counts.Collaborators,
+		&counts.LastActivityAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error counting content of user %s: %w", userID, err)
+	}
+	return counts, nil
+}
+
+// PostgresAdminAuditRepository adalah implementasi domain.AdminAuditRepository menggunakan tabel admin_audit_log.
+type PostgresAdminAuditRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresAdminAuditRepository adalah constructor untuk PostgresAdminAuditRepository.
+func NewPostgresAdminAuditRepository(dbpool *pgxpool.Pool) domain.AdminAuditRepository {
+	return &PostgresAdminAuditRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Record menyimpan satu entri audit log aksi admin.
+func (r *PostgresAdminAuditRepository) Record(ctx context.Context, entry domain.AdminAuditEntry) error {
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return fmt.Errorf("error encoding admin audit details: %w", err)
+	}
+
+	query := `INSERT INTO admin_audit_log (admin_id, action, reason, details, result_count, occurred_at)
+	           VALUES ($1, $2, $3, $4::jsonb, $5, $6)`
+	_, err = r.dbpool.Exec(ctx, query,
+		entry.AdminID,
+		entry.Action,
+		entry.Reason,
+		string(details),
+		entry.ResultCount,
+		entry.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error recording admin audit entry %s: %w", entry.Action, err)
+	}
+	return nil
+}
+
+// FindByUser memindai admin_audit_log tanpa index pada details; hanya dipakai ekspor data pribadi
+// yang jarang dijalankan.
+func (r *PostgresAdminAuditRepository) FindByUser(ctx context.Context, userID domain.UserID, limit int) ([]domain.AdminAuditEntry, error) {
+	query := `SELECT id, admin_id, action, reason, details, result_count, occurred_at
+	           FROM admin_audit_log
+	           WHERE admin_id = $1 OR details->>'user_id' = $1 OR details->>'owner_id' = $1 OR details->>'author_id' = $1
+	           ORDER BY occurred_at DESC LIMIT $2`
+	rows, err := r.dbpool.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding admin audit entries of %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var entries []domain.AdminAuditEntry
+	for rows.Next() {
+		var entry domain.AdminAuditEntry
+		var details []byte
+		if err := rows.Scan(&entry.ID, &entry.AdminID, &entry.Action, &entry.Reason, &details, &entry.ResultCount, &entry.OccurredAt); err != nil {
+			return nil, fmt.Errorf("error scanning admin audit row: %w", err)
+		}
+		if err := json.Unmarshal(details, &entry.Details); err != nil {
+			return nil, fmt.Errorf("error decoding admin audit details %d: %w", entry.ID, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating admin audit rows: %w", err)
+	}
+	return entries, nil
+}
+
+// PostgresSystemStatsRepository adalah implementasi domain.SystemStatsRepository menggunakan PostgreSQL.
+type PostgresSystemStatsRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresSystemStatsRepository adalah constructor untuk PostgresSystemStatsRepository.
+func NewPostgresSystemStatsRepository(dbpool *pgxpool.Pool) domain.SystemStatsRepository {
+	return &PostgresSystemStatsRepository{
+		dbpool: dbpool,
+	}
+}
+
+// SystemStats menghitung statistik dalam satu query. Query ini memindai seluruh tabel tasks, jadi
+// hanya untuk endpoint admin, bukan dashboard yang sering di-refresh.
+func (r *PostgresSystemStatsRepository) SystemStats(ctx context.Context) (*domain.SystemStats, error) {
+	query := `SELECT
+	             (SELECT COUNT(DISTINCT user_id) FROM tasks),
+	             (SELECT COUNT(DISTINCT user_id) FROM tasks WHERE updated_at >= NOW() - INTERVAL '7 days'),
+	             (SELECT COUNT(*) FROM tasks),
+	             (SELECT COUNT(*) FROM tasks WHERE NOT completed),
+	             (SELECT COUNT(*) FROM tasks WHERE created_at >= NOW() - INTERVAL '7 days'),
+	             (SELECT COUNT(*) FROM task_attachments),
+	             (SELECT COALESCE(SUM(size_bytes), 0) FROM task_attachments),
+	             (SELECT COUNT(*) FROM user_roles WHERE role = 'admin'),
+	             NOW()`
+	stats := &domain.SystemStats{}
+	err := r.dbpool.QueryRow(ctx, query).Scan(
+		&stats.Users,
+		&stats.ActiveUsers,
+		&stats.Tasks,
+		&stats.OpenTasks,
+		&stats.TasksCreated7d,
+		&stats.Attachments,
+		&stats.AttachmentBytes,
+		&stats.Admins,
+		&stats.GeneratedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error computing system stats: %w", err)
+	}
+	return stats, nil
+}
@@ -0,0 +1,115 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_archive_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// archiveColumns adalah daftar kolom yang dibaca untuk setiap arsip, sesuai urutan Scan di scanArchive.
+const archiveColumns = `user_id, status, blob_key, task_count, archived_at, purge_after, purged_at, restored_at`
+
+func scanArchive(row pgx.Row) (*domain.WorkspaceArchive, error) {
+	archive := &domain.WorkspaceArchive{}
+	err := row.Scan(
+		&archive.UserID,
+		&archive.Status,
+		&archive.BlobKey,
+		&archive.TaskCount,
+		&archive.ArchivedAt,
+		&archive.PurgeAfter,
+		&archive.PurgedAt,
+		&archive.RestoredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// PostgresArchiveRepository adalah implementasi domain.ArchiveRepository menggunakan tabel workspace_archives.
+type PostgresArchiveRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresArchiveRepository adalah constructor untuk PostgresArchiveRepository.
+func NewPostgresArchiveRepository(dbpool *pgxpool.Pool) domain.ArchiveRepository {
+	return &PostgresArchiveRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Save menyimpan catatan arsip dengan upsert berdasarkan user_id.
+func (r *PostgresArchiveRepository) Save(ctx context.Context, archive *domain.WorkspaceArchive) error {
+	query := `INSERT INTO workspace_archives (` + archiveColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET status = EXCLUDED.status, blob_key = EXCLUDED.blob_key, task_count = EXCLUDED.task_count,
+	               archived_at = EXCLUDED.archived_at, purge_after = EXCLUDED.purge_after,
+	               purged_at = EXCLUDED.purged_at, restored_at = EXCLUDED.restored_at`
+	_, err := r.dbpool.Exec(ctx, query,
+		archive.UserID,
+		archive.Status,
+		archive.BlobKey,
+		archive.TaskCount,
+		archive.ArchivedAt,
+		archive.PurgeAfter,
+		archive.PurgedAt,
+		archive.RestoredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving workspace archive for user_id %s: %w", archive.UserID, err)
+	}
+	return nil
+}
+
+// FindByUserID mencari catatan arsip milik pengguna.
+func (r *PostgresArchiveRepository) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.WorkspaceArchive, error) {
+	archive, err := scanArchive(r.dbpool.QueryRow(ctx, `SELECT `+archiveColumns+`
+	           FROM workspace_archives WHERE user_id = $1`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrArchiveNotFound
+		}
+		return nil, fmt.Errorf("error finding workspace archive for user_id %s: %w", userID, err)
+	}
+	return archive, nil
+}
+
+// FindDueForPurge mencari arsip yang sudah melewati masa retensi data live.
+func (r *PostgresArchiveRepository) FindDueForPurge(ctx context.Context, now time.Time, limit int) ([]*domain.WorkspaceArchive, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+archiveColumns+`
+	           FROM workspace_archives WHERE status = 'archived' AND purge_after <= $1
+	           ORDER BY purge_after LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding workspace archives due for purge: %w", err)
+	}
+	defer rows.Close()
+
+	var archives []*domain.WorkspaceArchive
+	for rows.Next() {
+		archive, err := scanArchive(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning workspace archive row: %w", err)
+		}
+		archives = append(archives, archive)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspace archive rows: %w", err)
+	}
+	return archives, nil
+}
+
+// Delete menghapus catatan arsip milik pengguna.
+func (r *PostgresArchiveRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM workspace_archives WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("error deleting workspace archive for user_id %s: %w", userID, err)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_attachment_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// attachmentColumns adalah daftar kolom yang dibaca untuk setiap attachment, sesuai urutan Scan di scanAttachment.
+const attachmentColumns = `id, task_id, user_id, file_name, content_type, size_bytes, storage_key, created_at`
+
+func scanAttachment(row pgx.Row) (*domain.Attachment, error) {
+	attachment := &domain.Attachment{}
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.TaskID,
+		&attachment.UserID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// PostgresAttachmentRepository adalah implementasi domain.AttachmentRepository menggunakan tabel task_attachments.
+type PostgresAttachmentRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresAttachmentRepository adalah constructor untuk PostgresAttachmentRepository.
+func NewPostgresAttachmentRepository(dbpool *pgxpool.Pool) domain.AttachmentRepository {
+	return &PostgresAttachmentRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Save menyimpan attachment baru. ON CONFLICT DO NOTHING membuat registrasi ulang aman diulang.
+func (r *PostgresAttachmentRepository) Save(ctx context.Context, attachment *domain.Attachment) error {
+	query := `INSERT INTO task_attachments (` + attachmentColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	           ON CONFLICT (id) DO NOTHING`
+	_, err := r.dbpool.Exec(ctx, query,
+		attachment.ID,
+		attachment.TaskID,
+		attachment.UserID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.StorageKey,
+		attachment.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving attachment %s for task %s: %w", attachment.ID, attachment.TaskID, err)
+	}
+	return nil
+}
+
+// FindByID mencari attachment berdasarkan ID.
+func (r *PostgresAttachmentRepository) FindByID(ctx context.Context, id string) (*domain.Attachment, error) {
+	attachment, err := scanAttachment(r.dbpool.QueryRow(ctx, `SELECT `+attachmentColumns+`
+	           FROM task_attachments WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("error finding attachment %s: %w", id, err)
+	}
+	return attachment, nil
+}
+
+// FindByTaskID mengembalikan attachment milik task, yang paling lama lebih dulu.
+func (r *PostgresAttachmentRepository) FindByTaskID(ctx context.Context, taskID string) ([]*domain.Attachment, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+attachmentColumns+`
+	           FROM task_attachments WHERE task_id = $1 ORDER BY created_at, id`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding attachments for task %s: %w", taskID, err)
+	}
+	return collectAttachments(rows)
+}
+
+// FindByTaskIDs mengambil attachment beberapa task dengan satu query.
+func (r *PostgresAttachmentRepository) FindByTaskIDs(ctx context.Context, taskIDs []string) ([]*domain.Attachment, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+attachmentColumns+`
+	           FROM task_attachments WHERE task_id = ANY($1::text[]) ORDER BY created_at, id`, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error finding attachments for tasks: %w", err)
+	}
+	return collectAttachments(rows)
+}
+
+func collectAttachments(rows pgx.Rows) ([]*domain.Attachment, error) {
+	defer rows.Close()
+
+	var attachments []*domain.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning attachment row: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating attachment rows: %w", err)
+	}
+	return attachments, nil
+}
+
+// RestoreAttachments menyisipkan attachment dalam satu transaksi menggunakan pgx.Batch.
+func (r *PostgresAttachmentRepository) RestoreAttachments(ctx context.Context, ownerID domain.UserID, attachments []*domain.Attachment) (int64, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error starting attachment restore transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	batch := &pgx.Batch{}
+	for _, attachment := range attachments {
+		batch.Queue(`INSERT INTO task_attachments (`+attachmentColumns+`)
+		           SELECT $1, $2, $3, $4, $5, $6, $7, $8
+		           WHERE EXISTS (SELECT 1 FROM tasks WHERE id = $2 AND user_id = $9)
+		             AND ($3::text = $9 OR EXISTS (SELECT 1 FROM list_shares WHERE owner_id = $9 AND collaborator_id = $3))
+		           ON CONFLICT DO NOTHING`,
+			attachment.ID, attachment.TaskID, attachment.UserID, attachment.FileName, attachment.ContentType,
+			attachment.Size, attachment.StorageKey, attachment.CreatedAt, ownerID)
+	}
+	results := tx.SendBatch(ctx, batch)
+	var inserted int64
+	for _, attachment := range attachments {
+		cmdTag, err := results.Exec()
+		if err != nil {
+			results.Close()
+			return 0, fmt.Errorf("error restoring attachment %s: %w", attachment.ID, err)
+		}
+		inserted += cmdTag.RowsAffected()
+	}
+	if err := results.Close(); err != nil {
+		return 0, fmt.Errorf("error restoring attachments: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("error committing attachment restore transaction: %w", err)
+	}
+	return inserted, nil
+}
+
+// Delete menghapus metadata attachment.
+func (r *PostgresAttachmentRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM task_attachments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("error deleting attachment %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrAttachmentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_board_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// boardColumnColumns adalah daftar kolom yang ditulis saat menyisipkan kolom board.
+const boardColumnColumns = `id, user_id, name, position, wip_limit, created_at, updated_at`
+
+// boardColumnReadColumns adalah daftar kolom yang dibaca untuk setiap kolom board, termasuk
+// counter cache yang dijaga trigger, sesuai urutan Scan di scanBoardColumn.
+const boardColumnReadColumns = boardColumnColumns + `, total_tasks, open_tasks`
+
+// qualifiedBoardColumnReadColumns sama dengan boardColumnReadColumns dengan prefix tabel, untuk
+// UPDATE ... FROM yang juga memiliki kolom id.
+const qualifiedBoardColumnReadColumns = `board_columns.id, board_columns.user_id, board_columns.name, board_columns.position,
+	           board_columns.wip_limit, board_columns.created_at, board_columns.updated_at,
+	           board_columns.total_tasks, board_columns.open_tasks`
+
+func scanBoardColumn(row pgx.Row) (*domain.BoardColumn, error) {
+	column := &domain.BoardColumn{}
+	err := row.Scan(
+		&column.ID,
+		&column.UserID,
+		&column.Name,
+		&column.Position,
+		&column.WIPLimit,
+		&column.CreatedAt,
+		&column.UpdatedAt,
+		&column.Counters.Total,
+		&column.Counters.Open,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+func collectBoardColumns(rows pgx.Rows) ([]*domain.BoardColumn, error) {
+	defer rows.Close()
+
+	columns := []*domain.BoardColumn{}
+	for rows.Next() {
+		column, err := scanBoardColumn(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning board column: %w", err)
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating board columns: %w", err)
+	}
+	return columns, nil
+}
+
+// PostgresBoardRepository adalah implementasi domain.BoardRepository menggunakan tabel
+// board_columns dan kolom tasks.column_id.
+type PostgresBoardRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresBoardRepository adalah constructor untuk PostgresBoardRepository.
+func NewPostgresBoardRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.BoardRepository {
+	return &PostgresBoardRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// FindColumns mengembalikan kolom milik pengguna dari kiri ke kanan.
+func (r *PostgresBoardRepository) FindColumns(ctx context.Context, userID domain.UserID) ([]*domain.BoardColumn, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+boardColumnReadColumns+` FROM board_columns
+	           WHERE user_id = $1 ORDER BY position, created_at`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding board columns for user_id %s: %w", userID, err)
+	}
+	return collectBoardColumns(rows)
+}
+
+// SaveColumn menyisipkan kolom di posisi paling kanan.
+func (r *PostgresBoardRepository) SaveColumn(ctx context.Context, column *domain.BoardColumn) error {
+	query := `INSERT INTO board_columns (` + boardColumnColumns + `)
+	           VALUES ($1, $2, $3, (SELECT COALESCE(MAX(position) + 1, 0) FROM board_columns WHERE user_id = $2), $4, $5, $5)
+	           RETURNING position`
+	err := r.dbpool.QueryRow(ctx, query, column.ID, column.UserID, column.Name, column.WIPLimit, column.CreatedAt).
+		Scan(&column.Position)
+	if err != nil {
+		return fmt.Errorf("error saving board column: %w", err)
+	}
+	return nil
+}
+
+// RestoreColumns menyisipkan kolom satu per satu dalam satu transaksi, sehingga Position dan batas
+// MaxBoardColumns memperhitungkan kolom yang baru disisipkan sebelumnya.
+func (r *PostgresBoardRepository) RestoreColumns(ctx context.Context, userID domain.UserID, columns []*domain.BoardColumn) (int64, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error starting board column restore transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	var inserted int64
+	for _, column := range columns {
+		tag, err := tx.Exec(ctx, `INSERT INTO board_columns (`+boardColumnColumns+`)
+		           SELECT $1, $2, $3, (SELECT COALESCE(MAX(position) + 1, 0) FROM board_columns WHERE user_id = $2), $4, $5, $6
+		           WHERE (SELECT COUNT(*) FROM board_columns WHERE user_id = $2) < $7
+		           ON CONFLICT (id) DO NOTHING`,
+			column.ID, userID, column.Name, column.WIPLimit, column.CreatedAt, column.UpdatedAt, domain.MaxBoardColumns)
+		if err != nil {
+			return 0, fmt.Errorf("error restoring board column %s: %w", column.ID, err)
+		}
+		inserted += tag.RowsAffected()
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("error committing board column restore transaction: %w", err)
+	}
+	return inserted, nil
+}
+
+// UpdateColumn memperbarui nama dan batas WIP kolom milik pengguna.
+func (r *PostgresBoardRepository) UpdateColumn(ctx context.Context, column *domain.BoardColumn) error {
+	tag, err := r.dbpool.Exec(ctx, `UPDATE board_columns SET name = $1, wip_limit = $2, updated_at = $3
+	           WHERE id = $4 AND user_id = $5`,
+		column.Name, column.WIPLimit, column.UpdatedAt, column.ID, column.UserID)
+	if err != nil {
+		return fmt.Errorf("error updating board column %s: %w", column.ID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrBoardColumnNotFound
+	}
+	return nil
+}
+
+// DeleteColumn mengeluarkan task dari kolom, menghapus kolom, lalu merapatkan Position kolom
+// yang tersisa dalam satu transaksi.
+func (r *PostgresBoardRepository) DeleteColumn(ctx context.Context, userID domain.UserID, columnID string, now time.Time) ([]*domain.Task, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting delete column transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	rows, err := tx.Query(ctx, `UPDATE tasks SET column_id = NULL, updated_at = $1
+	           WHERE user_id = $2 AND column_id = $3
+	           RETURNING `+taskColumns, now, userID, columnID)
+	if err != nil {
+		return nil, fmt.Errorf("error removing tasks from board column %s: %w", columnID, err)
+	}
+	tasks, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM board_columns WHERE id = $1 AND user_id = $2`, columnID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting board column %s: %w", columnID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, domain.ErrBoardColumnNotFound
+	}
+	_, err = tx.Exec(ctx, `UPDATE board_columns SET position = ranked.rn - 1
+	           FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY position, created_at) AS rn
+	                 FROM board_columns WHERE user_id = $1) AS ranked
+	           WHERE board_columns.id = ranked.id AND board_columns.position <> ranked.rn - 1`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error renumbering board columns: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing delete column transaction: %w", err)
+	}
+	return tasks, nil
+}
+
+// ReorderColumns mengunci kolom pengguna dengan FOR UPDATE agar dua reorder yang bersamaan
+// tidak saling menimpa dengan daftar kolom yang berbeda.
+func (r *PostgresBoardRepository) ReorderColumns(ctx context.Context, userID domain.UserID, ids []string, now time.Time) ([]*domain.BoardColumn, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting reorder columns transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	rows, err := tx.Query(ctx, `SELECT id FROM board_columns WHERE user_id = $1 FOR UPDATE`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error locking board columns for user_id %s: %w", userID, err)
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("error reading board columns for user_id %s: %w", userID, err)
+	}
+	requested := slices.Sorted(slices.Values(ids))
+	slices.Sort(existing)
+	if !slices.Equal(slices.Compact(requested), existing) || len(requested) != len(ids) {
+		return nil, fmt.Errorf("%w: ids must list every column exactly once", domain.ErrInvalidBoardColumn)
+	}
+
+	rows, err = tx.Query(ctx, `UPDATE board_columns SET position = o.ord - 1, updated_at = $3
+	           FROM unnest($2::text[]) WITH ORDINALITY AS o(id, ord)
+	           WHERE board_columns.user_id = $1 AND board_columns.id = o.id
+	           RETURNING `+qualifiedBoardColumnReadColumns, userID, ids, now)
+	if err != nil {
+		return nil, fmt.Errorf("error reordering board columns for user_id %s: %w", userID, err)
+	}
+	columns, err := collectBoardColumns(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing reorder columns transaction: %w", err)
+	}
+	slices.SortFunc(columns, func(a, b *domain.BoardColumn) int { return a.Position - b.Position })
+	return columns, nil
+}
+
+// MoveTask mengunci baris kolom tujuan dengan FOR UPDATE, sehingga perpindahan bersamaan ke kolom
+// yang sama diproses satu per satu dan batas WIP tidak bisa terlewati. Isi kolom dibaca dari counter
+// cache total_tasks, dikurangi task itu sendiri jika sudah berada di kolom tersebut.
+func (r *PostgresBoardRepository) MoveTask(ctx context.Context, userID domain.UserID, taskID, columnID string, now time.Time) (*domain.Task, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting move to column transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	if columnID != "" {
+		var wipLimit *int
+		var count int64
+		err := tx.QueryRow(ctx, `SELECT wip_limit, total_tasks - (
+		               SELECT COUNT(*) FROM tasks WHERE id = $3 AND column_id = $1 AND NOT archived
+		           ) FROM board_columns WHERE id = $1 AND user_id = $2 FOR UPDATE`,
+			columnID, userID, taskID).Scan(&wipLimit, &count)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrBoardColumnNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error locking board column %s: %w", columnID, err)
+		}
+		if wipLimit != nil && count >= int64(*wipLimit) {
+			return nil, domain.ErrWIPLimitReached
+		}
+	}
+
+	task, err := scanTask(tx.QueryRow(ctx, `UPDATE tasks SET column_id = NULLIF($1, ''), updated_at = $2
+	           WHERE id = $3 AND user_id = $4
+	           RETURNING `+taskColumns, columnID, now, taskID, userID), r.cipher)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("error moving task %s to board column: %w", taskID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing move to column transaction: %w", err)
+	}
+	return task, nil
+}
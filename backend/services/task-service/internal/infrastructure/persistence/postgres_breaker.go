@@ -0,0 +1,108 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_breaker.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/circuitbreaker"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ProtectPool memasang breaker pada config pool sebelum pgxpool.NewWithConfig. Hasil koneksi
+// baru, query, dan batch dicatat ke breaker lewat tracer pgx; saat breaker terbuka, Acquire (dan
+// semua query lewat pool) langsung gagal dengan domain.ErrDependencyUnavailable tanpa menunggu
+// timeout koneksi atau query. Hook BeforeConnect dan tracer yang sudah dipasang tetap dipanggil.
+func ProtectPool(config *pgxpool.Config, breaker *circuitbreaker.Breaker) {
+	beforeConnect := config.BeforeConnect
+	config.BeforeConnect = func(ctx context.Context, connConfig *pgx.ConnConfig) error {
+		if breaker.Open() {
+			return breaker.Err()
+		}
+		if beforeConnect != nil {
+			return beforeConnect(ctx, connConfig)
+		}
+		return nil
+	}
+	config.PrepareConn = func(context.Context, *pgx.Conn) (bool, error) {
+		if breaker.Open() {
+			return true, breaker.Err() // Koneksi dikembalikan ke pool, bukan ditutup
+		}
+		return true, nil
+	}
+
+	tracer := &breakerTracer{breaker: breaker}
+	if config.ConnConfig.Tracer != nil {
+		config.ConnConfig.Tracer = multitracer.New(config.ConnConfig.Tracer, tracer)
+	} else {
+		config.ConnConfig.Tracer = tracer
+	}
+}
+
+// IsDatabaseFailure melaporkan apakah err menandakan Postgres tidak sehat: koneksi gagal atau
+// terputus, timeout, server sedang shutdown, atau kehabisan sumber daya (SQLSTATE kelas 08, 53,
+// dan 57P0x). Error dari query itu sendiri, seperti pelanggaran constraint, bukan kegagalan.
+func IsDatabaseFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "53") ||
+			pgErr.Code == "57P01" || pgErr.Code == "57P02" || pgErr.Code == "57P03"
+	}
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connectErr) || errors.As(err, &netErr) || pgconn.Timeout(err) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// breakerTracer mencatat hasil koneksi, query, dan batch pgx ke breaker.
+type breakerTracer struct {
+	breaker *circuitbreaker.Breaker
+}
+
+type breakerDoneKey struct{}
+
+func (t *breakerTracer) start(ctx context.Context) context.Context {
+	done, err := t.breaker.Allow()
+	if err != nil {
+		return ctx // Query lewat koneksi yang sudah di-acquire sebelum breaker terbuka
+	}
+	return context.WithValue(ctx, breakerDoneKey{}, done)
+}
+
+func (t *breakerTracer) end(ctx context.Context, err error) {
+	if done, ok := ctx.Value(breakerDoneKey{}).(func(error)); ok {
+		done(err)
+	}
+}
+
+func (t *breakerTracer) TraceConnectStart(ctx context.Context, _ pgx.TraceConnectStartData) context.Context {
+	return t.start(ctx)
+}
+
+func (t *breakerTracer) TraceConnectEnd(ctx context.Context, data pgx.TraceConnectEndData) {
+	t.end(ctx, data.Err)
+}
+
+func (t *breakerTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return t.start(ctx)
+}
+
+func (t *breakerTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	t.end(ctx, data.Err)
+}
+
+func (t *breakerTracer) TraceBatchStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceBatchStartData) context.Context {
+	return t.start(ctx)
+}
+
+func (t *breakerTracer) TraceBatchQuery(context.Context, *pgx.Conn, pgx.TraceBatchQueryData) {}
+
+func (t *breakerTracer) TraceBatchEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchEndData) {
+	t.end(ctx, data.Err)
+}
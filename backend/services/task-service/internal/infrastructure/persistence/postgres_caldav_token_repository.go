@@ -0,0 +1,57 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_caldav_token_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresCalDAVTokenRepository adalah implementasi domain.CalDAVTokenRepository menggunakan tabel caldav_tokens.
+type PostgresCalDAVTokenRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresCalDAVTokenRepository adalah constructor untuk PostgresCalDAVTokenRepository.
+func NewPostgresCalDAVTokenRepository(dbpool *pgxpool.Pool) domain.CalDAVTokenRepository {
+	return &PostgresCalDAVTokenRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindUser mencari pemilik token berdasarkan hash-nya.
+func (r *PostgresCalDAVTokenRepository) FindUser(ctx context.Context, tokenHash string) (domain.UserID, error) {
+	var userID domain.UserID
+	err := r.dbpool.QueryRow(ctx, `SELECT user_id FROM caldav_tokens WHERE token_hash = $1`, tokenHash).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrInvalidCalDAVToken
+	}
+	if err != nil {
+		return "", fmt.Errorf("error finding CalDAV token: %w", err)
+	}
+	return userID, nil
+}
+
+// Replace melakukan upsert token pengguna.
+func (r *PostgresCalDAVTokenRepository) Replace(ctx context.Context, userID domain.UserID, tokenHash string, createdAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO caldav_tokens (user_id, token_hash, created_at) VALUES ($1, $2, $3)
+	           ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at`,
+		userID, tokenHash, createdAt)
+	if err != nil {
+		return fmt.Errorf("error saving CalDAV token for %s: %w", userID, err)
+	}
+	return nil
+}
+
+// Delete menghapus token pengguna.
+func (r *PostgresCalDAVTokenRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM caldav_tokens WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("error deleting CalDAV token for %s: %w", userID, err)
+	}
+	return nil
+}
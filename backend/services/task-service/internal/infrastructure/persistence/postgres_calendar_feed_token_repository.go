@@ -0,0 +1,58 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_calendar_feed_token_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresCalendarFeedTokenRepository adalah implementasi domain.CalendarFeedTokenRepository
+// menggunakan tabel calendar_feed_tokens.
+type PostgresCalendarFeedTokenRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresCalendarFeedTokenRepository adalah constructor untuk PostgresCalendarFeedTokenRepository.
+func NewPostgresCalendarFeedTokenRepository(dbpool *pgxpool.Pool) domain.CalendarFeedTokenRepository {
+	return &PostgresCalendarFeedTokenRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindUser mencari pemilik token berdasarkan hash-nya.
+func (r *PostgresCalendarFeedTokenRepository) FindUser(ctx context.Context, tokenHash string) (domain.UserID, error) {
+	var userID domain.UserID
+	err := r.dbpool.QueryRow(ctx, `SELECT user_id FROM calendar_feed_tokens WHERE token_hash = $1`, tokenHash).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrInvalidCalendarFeedToken
+	}
+	if err != nil {
+		return "", fmt.Errorf("error finding calendar feed token: %w", err)
+	}
+	return userID, nil
+}
+
+// Replace melakukan upsert token pengguna.
+func (r *PostgresCalendarFeedTokenRepository) Replace(ctx context.Context, userID domain.UserID, tokenHash string, createdAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO calendar_feed_tokens (user_id, token_hash, created_at) VALUES ($1, $2, $3)
+	           ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at`,
+		userID, tokenHash, createdAt)
+	if err != nil {
+		return fmt.Errorf("error saving calendar feed token for %s: %w", userID, err)
+	}
+	return nil
+}
+
+// Delete menghapus token pengguna.
+func (r *PostgresCalendarFeedTokenRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM calendar_feed_tokens WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("error deleting calendar feed token for %s: %w", userID, err)
+	}
+	return nil
+}
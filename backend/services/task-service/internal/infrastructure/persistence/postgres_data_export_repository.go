@@ -0,0 +1,141 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_data_export_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const dataExportColumns = `id, user_id, status, blob_key, size_bytes, error, requested_at, started_at, completed_at, expires_at`
+
+func scanDataExport(row pgx.Row) (*domain.DataExport, error) {
+	export := &domain.DataExport{}
+	err := row.Scan(
+		&export.ID,
+		&export.UserID,
+		&export.Status,
+		&export.BlobKey,
+		&export.SizeBytes,
+		&export.Error,
+		&export.RequestedAt,
+		&export.StartedAt,
+		&export.CompletedAt,
+		&export.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+func collectDataExports(rows pgx.Rows) ([]*domain.DataExport, error) {
+	defer rows.Close()
+
+	var exports []*domain.DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning data export row: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating data export rows: %w", err)
+	}
+	return exports, nil
+}
+
+// PostgresDataExportRepository adalah implementasi domain.DataExportRepository menggunakan tabel
+// data_exports.
+type PostgresDataExportRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresDataExportRepository adalah constructor untuk PostgresDataExportRepository.
+func NewPostgresDataExportRepository(dbpool *pgxpool.Pool) domain.DataExportRepository {
+	return &PostgresDataExportRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Create menyimpan permintaan baru; ekspor aktif ganda ditolak oleh idx_data_exports_active.
+func (r *PostgresDataExportRepository) Create(ctx context.Context, export *domain.DataExport) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO data_exports (id, user_id, status, requested_at)
+	           VALUES ($1, $2, $3, $4)`,
+		export.ID, export.UserID, export.Status, export.RequestedAt)
+	if isUniqueViolation(err, "idx_data_exports_active") {
+		return domain.ErrDataExportInProgress
+	}
+	if err != nil {
+		return fmt.Errorf("error creating data export for %s: %w", export.UserID, err)
+	}
+	return nil
+}
+
+// FindByID mencari ekspor milik userID.
+func (r *PostgresDataExportRepository) FindByID(ctx context.Context, userID domain.UserID, id string) (*domain.DataExport, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+dataExportColumns+` FROM data_exports WHERE id = $1 AND user_id = $2`, id, userID)
+	export, err := scanDataExport(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDataExportNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding data export %s: %w", id, err)
+	}
+	return export, nil
+}
+
+// ClaimPending memakai FOR UPDATE SKIP LOCKED sehingga dua klaim bersamaan tidak mengambil ekspor
+// yang sama.
+func (r *PostgresDataExportRepository) ClaimPending(ctx context.Context, now, staleBefore time.Time, limit int) ([]*domain.DataExport, error) {
+	rows, err := r.dbpool.Query(ctx, `UPDATE data_exports SET status = 'running', started_at = $1
+	           WHERE id IN (
+	               SELECT id FROM data_exports
+	               WHERE status = 'pending' OR (status = 'running' AND started_at < $2)
+	               ORDER BY requested_at LIMIT $3
+	               FOR UPDATE SKIP LOCKED
+	           )
+	           RETURNING `+dataExportColumns, now, staleBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming pending data exports: %w", err)
+	}
+	return collectDataExports(rows)
+}
+
+// Update menyimpan kolom yang berubah selama ekspor diproses.
+func (r *PostgresDataExportRepository) Update(ctx context.Context, export *domain.DataExport) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE data_exports
+	           SET status = $2, blob_key = $3, size_bytes = $4, error = $5, started_at = $6, completed_at = $7, expires_at = $8
+	           WHERE id = $1`,
+		export.ID, export.Status, export.BlobKey, export.SizeBytes, export.Error, export.StartedAt, export.CompletedAt, export.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("error updating data export %s: %w", export.ID, err)
+	}
+	return nil
+}
+
+// FindExpired mencari ekspor completed yang arsipnya sudah kedaluwarsa.
+func (r *PostgresDataExportRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*domain.DataExport, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+dataExportColumns+`
+	           FROM data_exports WHERE status = 'completed' AND expires_at <= $1
+	           ORDER BY expires_at LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding expired data exports: %w", err)
+	}
+	return collectDataExports(rows)
+}
+
+// Delete menghapus catatan ekspor; ID yang tidak ada diabaikan.
+func (r *PostgresDataExportRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.dbpool.Exec(ctx, `DELETE FROM data_exports WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("error deleting data export %s: %w", id, err)
+	}
+	return nil
+}
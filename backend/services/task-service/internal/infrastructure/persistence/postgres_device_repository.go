@@ -0,0 +1,238 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_device_repository.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// deviceColumns adalah daftar kolom yang dibaca untuk setiap perangkat, sesuai urutan Scan di scanDevice.
+const deviceColumns = `id, user_id, name, platform, push_subscription, last_pull_at, last_cursor, last_push_at,
+	last_push_items, last_push_failed, last_push_stale, last_push_issues, created_at, updated_at, revoked_at`
+
+func scanDevice(row pgx.Row) (*domain.Device, error) {
+	device := &domain.Device{}
+	var pushSubscription, issues []byte
+	err := row.Scan(
+		&device.ID,
+		&device.UserID,
+		&device.Name,
+		&device.Platform,
+		&pushSubscription,
+		&device.Sync.LastPullAt,
+		&device.Sync.LastCursor,
+		&device.Sync.LastPushAt,
+		&device.Sync.LastPushItems,
+		&device.Sync.LastPushFailed,
+		&device.Sync.LastPushStale,
+		&issues,
+		&device.CreatedAt,
+		&device.UpdatedAt,
+		&device.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if pushSubscription != nil {
+		device.PushSubscription = json.RawMessage(pushSubscription)
+	}
+	if err := json.Unmarshal(issues, &device.Sync.LastPushIssues); err != nil {
+		return nil, fmt.Errorf("error decoding device sync issues: %w", err)
+	}
+	return device, nil
+}
+
+// PostgresDeviceRepository adalah implementasi domain.DeviceRepository menggunakan tabel devices.
+type PostgresDeviceRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresDeviceRepository adalah constructor untuk PostgresDeviceRepository.
+func NewPostgresDeviceRepository(dbpool *pgxpool.Pool) domain.DeviceRepository {
+	return &PostgresDeviceRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByID mencari perangkat milik pengguna.
+func (r *PostgresDeviceRepository) FindByID(ctx context.Context, userID domain.UserID, id string) (*domain.Device, error) {
+	device, err := scanDevice(r.dbpool.QueryRow(ctx, `SELECT `+deviceColumns+`
+	           FROM devices WHERE user_id = $1 AND id = $2`, userID, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDeviceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding device %s: %w", id, err)
+	}
+	return device, nil
+}
+
+// FindByUserID mencari semua perangkat milik pengguna, dari yang terakhir dibuat.
+func (r *PostgresDeviceRepository) FindByUserID(ctx context.Context, userID domain.UserID) ([]*domain.Device, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+deviceColumns+`
+	           FROM devices WHERE user_id = $1 ORDER BY created_at DESC, id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding devices for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var devices []*domain.Device
+	for rows.Next() {
+		device, err := scanDevice(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning device row: %w", err)
+		}
+		devices = append(devices, device)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating device rows: %w", err)
+	}
+	return devices, nil
+}
+
+// CountActive menghitung perangkat pengguna yang belum dicabut.
+func (r *PostgresDeviceRepository) CountActive(ctx context.Context, userID domain.UserID) (int, error) {
+	var count int
+	err := r.dbpool.QueryRow(ctx, `SELECT COUNT(*) FROM devices WHERE user_id = $1 AND revoked_at IS NULL`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error counting devices for user_id %s: %w", userID, err)
+	}
+	return count, nil
+}
+
+// Save melakukan upsert profil perangkat. Klausa WHERE pada DO UPDATE membuat perangkat yang
+// sudah dicabut tidak bisa didaftarkan ulang dengan ID yang sama; diagnostik sync tidak disentuh.
+func (r *PostgresDeviceRepository) Save(ctx context.Context, device *domain.Device) error {
+	var pushSubscription []byte
+	if len(device.PushSubscription) > 0 {
+		pushSubscription = device.PushSubscription
+	}
+	query := `INSERT INTO devices (user_id, id, name, platform, push_subscription, created_at, updated_at)
+	           VALUES ($1, $2, $3, $4, $5, $6, $6)
+	           ON CONFLICT (user_id, id) DO UPDATE
+	           SET name = EXCLUDED.name, platform = EXCLUDED.platform,
+	               push_subscription = EXCLUDED.push_subscription, updated_at = EXCLUDED.updated_at
+	           WHERE devices.revoked_at IS NULL
+	           RETURNING ` + deviceColumns
+	saved, err := scanDevice(r.dbpool.QueryRow(ctx, query,
+		device.UserID, device.ID, device.Name, device.Platform, pushSubscription, device.UpdatedAt))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// DO UPDATE dilewati karena perangkat sudah dicabut.
+		return domain.ErrDeviceRevoked
+	}
+	if err != nil {
+		return fmt.Errorf("error saving device %s: %w", device.ID, err)
+	}
+	*device = *saved
+	return nil
+}
+
+// SaveSyncState menyimpan diagnostik sync perangkat yang belum dicabut.
+func (r *PostgresDeviceRepository) SaveSyncState(ctx context.Context, device *domain.Device) error {
+	issues := device.Sync.LastPushIssues
+	if issues == nil {
+		issues = []domain.SyncIssue{}
+	}
+	encodedIssues, err := json.Marshal(issues)
+	if err != nil {
+		return fmt.Errorf("error encoding device sync issues: %w", err)
+	}
+	_, err = r.dbpool.Exec(ctx, `UPDATE devices
+	           SET last_pull_at = $3, last_cursor = $4, last_push_at = $5, last_push_items = $6,
+	               last_push_failed = $7, last_push_stale = $8, last_push_issues = $9
+	           WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL`,
+		device.UserID, device.ID,
+		device.Sync.LastPullAt, device.Sync.LastCursor, device.Sync.LastPushAt, device.Sync.LastPushItems,
+		device.Sync.LastPushFailed, device.Sync.LastPushStale, encodedIssues)
+	if err != nil {
+		return fmt.Errorf("error saving sync state of device %s: %w", device.ID, err)
+	}
+	return nil
+}
+
+// SavePushSubscription mengganti langganan push perangkat yang belum dicabut.
+func (r *PostgresDeviceRepository) SavePushSubscription(ctx context.Context, userID domain.UserID, id string, subscription json.RawMessage, at time.Time) error {
+	var pushSubscription []byte
+	if len(subscription) > 0 {
+		pushSubscription = subscription
+	}
+	tag, err := r.dbpool.Exec(ctx, `UPDATE devices SET push_subscription = $3, updated_at = $4
+	           WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL`, userID, id, pushSubscription, at)
+	if err != nil {
+		return fmt.Errorf("error saving push subscription of device %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDeviceNotFound
+	}
+	return nil
+}
+
+// RemovePushSubscription membandingkan isi JSONB, sehingga perbedaan spasi atau urutan key tidak
+// berpengaruh.
+func (r *PostgresDeviceRepository) RemovePushSubscription(ctx context.Context, userID domain.UserID, id string, subscription json.RawMessage) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE devices SET push_subscription = NULL
+	           WHERE user_id = $1 AND id = $2 AND push_subscription = $3::jsonb`, userID, id, []byte(subscription))
+	if err != nil {
+		return fmt.Errorf("error removing push subscription of device %s: %w", id, err)
+	}
+	return nil
+}
+
+// FindPushTargets mencari perangkat aktif yang berlangganan push milik userIDs.
+func (r *PostgresDeviceRepository) FindPushTargets(ctx context.Context, userIDs []domain.UserID) ([]domain.PushTarget, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, string(userID))
+	}
+	rows, err := r.dbpool.Query(ctx, `SELECT user_id, id, push_subscription FROM devices
+	           WHERE user_id = ANY($1::text[]) AND push_subscription IS NOT NULL AND revoked_at IS NULL
+	           ORDER BY user_id, id`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("error finding push targets: %w", err)
+	}
+	defer rows.Close()
+
+	var targets []domain.PushTarget
+	for rows.Next() {
+		var (
+			target           domain.PushTarget
+			pushSubscription []byte
+		)
+		if err := rows.Scan(&target.UserID, &target.DeviceID, &pushSubscription); err != nil {
+			return nil, fmt.Errorf("error scanning push target row: %w", err)
+		}
+		sub, err := domain.ParsePushSubscription(pushSubscription)
+		if err != nil { // Langganan opaque dari profil perangkat yang tidak dikenal
+			continue
+		}
+		target.Subscription, target.Stored = *sub, json.RawMessage(pushSubscription)
+		targets = append(targets, target)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating push target rows: %w", err)
+	}
+	return targets, nil
+}
+
+// Revoke menandai perangkat sebagai dicabut dan menghapus langganan push-nya.
+func (r *PostgresDeviceRepository) Revoke(ctx context.Context, userID domain.UserID, id string, at time.Time) error {
+	tag, err := r.dbpool.Exec(ctx, `UPDATE devices SET revoked_at = $3, push_subscription = NULL, updated_at = $3
+	           WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL`, userID, id, at)
+	if err != nil {
+		return fmt.Errorf("error revoking device %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDeviceNotFound
+	}
+	return nil
+}
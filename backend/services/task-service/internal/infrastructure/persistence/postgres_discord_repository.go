@@ -0,0 +1,268 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_discord_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// discordChannelColumns adalah daftar kolom yang dibaca untuk setiap pengaturan Discord,
+// sesuai urutan Scan di scanDiscordChannel.
+const discordChannelColumns = `user_id, webhook_url, channel_id, event_types, COALESCE(discord_user_id, ''), created_at, updated_at`
+
+func scanDiscordChannel(row pgx.Row) (*domain.DiscordChannel, error) {
+	channel := &domain.DiscordChannel{}
+	var eventTypes []string
+	err := row.Scan(
+		&channel.UserID,
+		&channel.WebhookURL,
+		&channel.ChannelID,
+		&eventTypes,
+		&channel.DiscordUserID,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		channel.EventTypes = append(channel.EventTypes, domain.TaskEventType(eventType))
+	}
+	return channel, nil
+}
+
+// PostgresDiscordChannelRepository adalah implementasi domain.DiscordChannelRepository
+// menggunakan tabel discord_channels.
+type PostgresDiscordChannelRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresDiscordChannelRepository adalah constructor untuk PostgresDiscordChannelRepository.
+func NewPostgresDiscordChannelRepository(dbpool *pgxpool.Pool) domain.DiscordChannelRepository {
+	return &PostgresDiscordChannelRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByUserID mencari pengaturan Discord milik pengguna.
+func (r *PostgresDiscordChannelRepository) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.DiscordChannel, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+discordChannelColumns+` FROM discord_channels WHERE user_id = $1`, userID)
+	channel, err := scanDiscordChannel(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDiscordChannelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding discord channel for user_id %s: %w", userID, err)
+	}
+	return channel, nil
+}
+
+// FindByDiscordUserID mencari pengaturan Discord berdasarkan akun Discord yang terhubung.
+func (r *PostgresDiscordChannelRepository) FindByDiscordUserID(ctx context.Context, discordUserID string) (*domain.DiscordChannel, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+discordChannelColumns+` FROM discord_channels WHERE discord_user_id = $1`, discordUserID)
+	channel, err := scanDiscordChannel(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDiscordChannelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding discord channel for discord user %s: %w", discordUserID, err)
+	}
+	return channel, nil
+}
+
+// Save melakukan upsert tujuan notifikasi tanpa mengubah akun dan kode link.
+func (r *PostgresDiscordChannelRepository) Save(ctx context.Context, channel *domain.DiscordChannel) error {
+	eventTypes := make([]string, 0, len(channel.EventTypes))
+	for _, eventType := range channel.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	query := `INSERT INTO discord_channels (user_id, webhook_url, channel_id, event_types, created_at, updated_at)
+	           VALUES ($1, $2, $3, $4, $5, $5)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET webhook_url = EXCLUDED.webhook_url,
+	               channel_id = EXCLUDED.channel_id,
+	               event_types = EXCLUDED.event_types,
+	               updated_at = EXCLUDED.updated_at
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		channel.UserID,
+		channel.WebhookURL,
+		channel.ChannelID,
+		eventTypes,
+		channel.UpdatedAt,
+	).Scan(&channel.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving discord channel for user_id %s: %w", channel.UserID, err)
+	}
+	return nil
+}
+
+// Delete menghapus pengaturan Discord milik pengguna.
+func (r *PostgresDiscordChannelRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM discord_channels WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting discord channel for user_id %s: %w", userID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDiscordChannelNotFound
+	}
+	return nil
+}
+
+// SetLinkCode membuat baris pengaturan jika belum ada, sehingga pengguna bisa menghubungkan akun
+// Discord sebelum mengatur tujuan notifikasi.
+func (r *PostgresDiscordChannelRepository) SetLinkCode(ctx context.Context, userID domain.UserID, codeHash string, expiresAt time.Time) error {
+	query := `INSERT INTO discord_channels (user_id, link_code_hash, link_code_expires_at, created_at, updated_at)
+	           VALUES ($1, $2, $3, NOW(), NOW())
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET link_code_hash = EXCLUDED.link_code_hash,
+	               link_code_expires_at = EXCLUDED.link_code_expires_at`
+	if _, err := r.dbpool.Exec(ctx, query, userID, codeHash, expiresAt); err != nil {
+		return fmt.Errorf("error saving discord link code for user_id %s: %w", userID, err)
+	}
+	return nil
+}
+
+// Link menjalankan pelepasan akun lama dan penautan baru dalam satu transaksi karena
+// discord_user_id bersifat UNIQUE.
+func (r *PostgresDiscordChannelRepository) Link(ctx context.Context, codeHash, discordUserID string, now time.Time) (domain.UserID, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var userID domain.UserID
+	err = tx.QueryRow(ctx, `SELECT user_id FROM discord_channels
+	           WHERE link_code_hash = $1 AND link_code_expires_at > $2
+	           FOR UPDATE`, codeHash, now).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrDiscordLinkCodeInvalid
+	}
+	if err != nil {
+		return "", fmt.Errorf("error finding discord link code: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE discord_channels SET discord_user_id = NULL
+	           WHERE discord_user_id = $1 AND user_id <> $2`, discordUserID, userID); err != nil {
+		return "", fmt.Errorf("error unlinking discord user %s: %w", discordUserID, err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE discord_channels
+	           SET discord_user_id = $1, link_code_hash = NULL, link_code_expires_at = NULL, updated_at = $2
+	           WHERE user_id = $3`, discordUserID, now, userID); err != nil {
+		return "", fmt.Errorf("error linking discord user %s: %w", discordUserID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("error committing transaction: %w", err)
+	}
+	return userID, nil
+}
+
+// discordListChannelColumns adalah daftar kolom pengaturan daftar bersama, sesuai urutan Scan di
+// scanDiscordListChannel.
+const discordListChannelColumns = `owner_id, user_id, webhook_url, channel_id, event_types, created_at, updated_at`
+
+func scanDiscordListChannel(row pgx.Row) (*domain.DiscordChannel, error) {
+	channel := &domain.DiscordChannel{}
+	var eventTypes []string
+	err := row.Scan(
+		&channel.ListOwnerID,
+		&channel.UserID,
+		&channel.WebhookURL,
+		&channel.ChannelID,
+		&eventTypes,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		channel.EventTypes = append(channel.EventTypes, domain.TaskEventType(eventType))
+	}
+	return channel, nil
+}
+
+// FindListChannel mencari pengaturan Discord userID untuk daftar bersama ownerID.
+func (r *PostgresDiscordChannelRepository) FindListChannel(ctx context.Context, ownerID, userID domain.UserID) (*domain.DiscordChannel, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+discordListChannelColumns+` FROM discord_list_channels
+	           WHERE owner_id = $1 AND user_id = $2`, ownerID, userID)
+	channel, err := scanDiscordListChannel(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrDiscordChannelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding discord list channel of user_id %s for owner_id %s: %w", userID, ownerID, err)
+	}
+	return channel, nil
+}
+
+// FindListChannels tidak perlu memeriksa list_shares karena baris ikut terhapus saat akses dicabut.
+func (r *PostgresDiscordChannelRepository) FindListChannels(ctx context.Context, ownerID domain.UserID) ([]*domain.DiscordChannel, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+discordListChannelColumns+` FROM discord_list_channels
+	           WHERE owner_id = $1 ORDER BY created_at, user_id`, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding discord list channels for owner_id %s: %w", ownerID, err)
+	}
+	defer rows.Close()
+
+	var channels []*domain.DiscordChannel
+	for rows.Next() {
+		channel, err := scanDiscordListChannel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning discord list channel: %w", err)
+		}
+		channels = append(channels, channel)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating discord list channels: %w", err)
+	}
+	return channels, nil
+}
+
+// SaveListChannel melakukan upsert pengaturan daftar bersama. Foreign key ke list_shares menolak
+// pengguna yang bukan kolaborator daftar tersebut.
+func (r *PostgresDiscordChannelRepository) SaveListChannel(ctx context.Context, channel *domain.DiscordChannel) error {
+	eventTypes := make([]string, 0, len(channel.EventTypes))
+	for _, eventType := range channel.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	query := `INSERT INTO discord_list_channels (owner_id, user_id, webhook_url, channel_id, event_types, created_at, updated_at)
+	           VALUES ($1, $2, $3, $4, $5, $6, $6)
+	           ON CONFLICT (owner_id, user_id) DO UPDATE
+	           SET webhook_url = EXCLUDED.webhook_url,
+	               channel_id = EXCLUDED.channel_id,
+	               event_types = EXCLUDED.event_types,
+	               updated_at = EXCLUDED.updated_at
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		channel.ListOwnerID,
+		channel.UserID,
+		channel.WebhookURL,
+		channel.ChannelID,
+		eventTypes,
+		channel.UpdatedAt,
+	).Scan(&channel.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving discord list channel of user_id %s for owner_id %s: %w", channel.UserID, channel.ListOwnerID, err)
+	}
+	return nil
+}
+
+// DeleteListChannel menghapus pengaturan Discord userID untuk daftar bersama ownerID.
+func (r *PostgresDiscordChannelRepository) DeleteListChannel(ctx context.Context, ownerID, userID domain.UserID) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM discord_list_channels WHERE owner_id = $1 AND user_id = $2`, ownerID, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting discord list channel of user_id %s for owner_id %s: %w", userID, ownerID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDiscordChannelNotFound
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_email_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// emailChannelColumns adalah daftar kolom yang dibaca untuk setiap pengaturan email,
+// sesuai urutan Scan di scanEmailChannel.
+const emailChannelColumns = `user_id, address, event_types, reminder_lead_seconds, created_at, updated_at`
+
+func scanEmailChannel(row pgx.Row) (*domain.EmailChannel, error) {
+	channel := &domain.EmailChannel{}
+	var (
+		eventTypes  []string
+		leadSeconds int
+	)
+	err := row.Scan(
+		&channel.UserID,
+		&channel.Address,
+		&eventTypes,
+		&leadSeconds,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		channel.EventTypes = append(channel.EventTypes, domain.TaskEventType(eventType))
+	}
+	channel.ReminderLead = time.Duration(leadSeconds) * time.Second
+	return channel, nil
+}
+
+// PostgresEmailChannelRepository adalah implementasi domain.EmailChannelRepository menggunakan
+// tabel email_channels dan task_due_reminders.
+type PostgresEmailChannelRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresEmailChannelRepository adalah constructor untuk PostgresEmailChannelRepository.
+func NewPostgresEmailChannelRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.EmailChannelRepository {
+	return &PostgresEmailChannelRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// FindByUserID mencari pengaturan email milik pengguna.
+func (r *PostgresEmailChannelRepository) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.EmailChannel, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+emailChannelColumns+` FROM email_channels WHERE user_id = $1`, userID)
+	channel, err := scanEmailChannel(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrEmailChannelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding email channel for user_id %s: %w", userID, err)
+	}
+	return channel, nil
+}
+
+// Save melakukan upsert pengaturan email; created_at dipertahankan saat pengaturan diganti.
+func (r *PostgresEmailChannelRepository) Save(ctx context.Context, channel *domain.EmailChannel) error {
+	eventTypes := make([]string, 0, len(channel.EventTypes))
+	for _, eventType := range channel.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	query := `INSERT INTO email_channels (` + emailChannelColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $5)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET address = EXCLUDED.address,
+	               event_types = EXCLUDED.event_types,
+	               reminder_lead_seconds = EXCLUDED.reminder_lead_seconds,
+	               updated_at = EXCLUDED.updated_at
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		channel.UserID,
+		channel.Address,
+		eventTypes,
+		int(channel.ReminderLead/time.Second),
+		channel.UpdatedAt,
+	).Scan(&channel.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving email channel for user_id %s: %w", channel.UserID, err)
+	}
+	return nil
+}
+
+// Delete menghapus pengaturan email milik pengguna.
+func (r *PostgresEmailChannelRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM email_channels WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting email channel for user_id %s: %w", userID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEmailChannelNotFound
+	}
+	return nil
+}
+
+// FindDueReminders membaca task yang jatuh tempo lebih dulu, lalu pengaturan email setiap pemiliknya.
+// Subquery reminder_lead_seconds bernilai NULL untuk pengguna tanpa pengingat, sehingga task-nya
+// tidak ikut.
+func (r *PostgresEmailChannelRepository) FindDueReminders(ctx context.Context, now time.Time, limit int) ([]domain.EmailReminder, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskColumns+` FROM tasks
+	           WHERE NOT completed AND NOT archived AND due_at > $1
+	             AND (snoozed_until IS NULL OR snoozed_until <= $1)
+	             AND due_at <= $1 + (SELECT make_interval(secs => e.reminder_lead_seconds) FROM email_channels e
+	                                 WHERE e.user_id = tasks.user_id AND e.reminder_lead_seconds > 0)
+	             AND NOT EXISTS (SELECT 1 FROM task_due_reminders r
+	                             WHERE r.task_id = tasks.id AND r.due_at = tasks.due_at AND r.channel = 'email')
+	           ORDER BY due_at, id
+	           LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding due reminders: %w", err)
+	}
+	tasks, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make(map[domain.UserID]*domain.EmailChannel)
+	reminders := make([]domain.EmailReminder, 0, len(tasks))
+	for _, task := range tasks {
+		channel, ok := channels[task.UserID]
+		if !ok {
+			channel, err = r.FindByUserID(ctx, task.UserID)
+			if errors.Is(err, domain.ErrEmailChannelNotFound) { // Dihapus setelah query pertama
+				channels[task.UserID] = nil
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			channels[task.UserID] = channel
+		}
+		if channel != nil {
+			reminders = append(reminders, domain.EmailReminder{Channel: channel, Task: task})
+		}
+	}
+	return reminders, nil
+}
+
+// ClaimReminder memakai primary key (task_id, due_at, channel) sebagai kunci klaim.
+func (r *PostgresEmailChannelRepository) ClaimReminder(ctx context.Context, taskID string, dueAt, now time.Time) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx, `INSERT INTO task_due_reminders (task_id, due_at, channel, claimed_at)
+	           VALUES ($1, $2, 'email', $3) ON CONFLICT DO NOTHING`, taskID, dueAt, now)
+	if err != nil {
+		return false, fmt.Errorf("error claiming due reminder for task %s: %w", taskID, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ReleaseReminder menghapus klaim pengingat.
+func (r *PostgresEmailChannelRepository) ReleaseReminder(ctx context.Context, taskID string, dueAt time.Time) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM task_due_reminders WHERE task_id = $1 AND due_at = $2 AND channel = 'email'`, taskID, dueAt); err != nil {
+		return fmt.Errorf("error releasing due reminder for task %s: %w", taskID, err)
+	}
+	return nil
+}
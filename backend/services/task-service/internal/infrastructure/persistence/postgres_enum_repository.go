@@ -0,0 +1,102 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_enum_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// enumValueColumns adalah daftar kolom yang dibaca untuk setiap nilai enum, sesuai urutan Scan
+// di scanEnumValue. user_id kosong menandai nilai builtin.
+const enumValueColumns = `enum_name, value, label, position, user_id = '', deprecated, created_at`
+
+func scanEnumValue(row pgx.Row) (*domain.EnumValue, error) {
+	value := &domain.EnumValue{}
+	err := row.Scan(
+		&value.Enum,
+		&value.Value,
+		&value.Label,
+		&value.Position,
+		&value.Builtin,
+		&value.Deprecated,
+		&value.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// PostgresEnumRepository adalah implementasi domain.EnumRepository menggunakan tabel enum_values.
+type PostgresEnumRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresEnumRepository adalah constructor untuk PostgresEnumRepository.
+func NewPostgresEnumRepository(dbpool *pgxpool.Pool) domain.EnumRepository {
+	return &PostgresEnumRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindValues mencari nilai builtin dan nilai milik pengguna. Jika pengguna punya nilai dengan nama
+// yang sama dengan builtin yang ditambahkan belakangan, nilai builtin yang dipakai.
+func (r *PostgresEnumRepository) FindValues(ctx context.Context, userID domain.UserID, enum domain.EnumName) ([]domain.EnumValue, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+enumValueColumns+` FROM (
+	               SELECT DISTINCT ON (value) * FROM enum_values
+	               WHERE enum_name = $1 AND user_id IN ('', $2)
+	               ORDER BY value, user_id
+	           ) effective ORDER BY position, value`, enum, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding values of enum %s: %w", enum, err)
+	}
+	defer rows.Close()
+
+	var values []domain.EnumValue
+	for rows.Next() {
+		value, err := scanEnumValue(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning enum value row: %w", err)
+		}
+		values = append(values, *value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating enum value rows: %w", err)
+	}
+	return values, nil
+}
+
+// FindValue mencari satu nilai yang berlaku untuk pengguna; nilai builtin didahulukan.
+func (r *PostgresEnumRepository) FindValue(ctx context.Context, userID domain.UserID, enum domain.EnumName, value string) (*domain.EnumValue, error) {
+	found, err := scanEnumValue(r.dbpool.QueryRow(ctx, `SELECT `+enumValueColumns+`
+	           FROM enum_values WHERE enum_name = $1 AND user_id IN ('', $2) AND value = $3
+	           ORDER BY user_id LIMIT 1`, enum, userID, value))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrEnumValueNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding value %s of enum %s: %w", value, enum, err)
+	}
+	return found, nil
+}
+
+// SaveValue melakukan upsert nilai milik pengguna; created_at dipertahankan saat diperbarui.
+func (r *PostgresEnumRepository) SaveValue(ctx context.Context, userID domain.UserID, value *domain.EnumValue) error {
+	query := `INSERT INTO enum_values (enum_name, user_id, value, label, position, deprecated, created_at)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7)
+	           ON CONFLICT (enum_name, user_id, value) DO UPDATE
+	           SET label = EXCLUDED.label, position = EXCLUDED.position, deprecated = EXCLUDED.deprecated
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		value.Enum, userID, value.Value, value.Label, value.Position, value.Deprecated, value.CreatedAt,
+	).Scan(&value.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving value %s of enum %s: %w", value.Value, value.Enum, err)
+	}
+	return nil
+}
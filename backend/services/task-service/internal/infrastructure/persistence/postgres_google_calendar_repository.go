@@ -0,0 +1,259 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_google_calendar_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// googleCalendarConnectionColumns adalah daftar kolom yang dibaca untuk setiap koneksi Google
+// Calendar, sesuai urutan Scan di scanGoogleCalendarConnection.
+const googleCalendarConnectionColumns = `user_id, calendar_id, access_token, refresh_token, token_expires_at,
+	sync_token, last_synced_at, last_error, created_at, updated_at`
+
+func scanGoogleCalendarConnection(row pgx.Row) (*domain.GoogleCalendarConnection, error) {
+	conn := &domain.GoogleCalendarConnection{}
+	err := row.Scan(
+		&conn.UserID,
+		&conn.CalendarID,
+		&conn.AccessToken,
+		&conn.RefreshToken,
+		&conn.TokenExpiresAt,
+		&conn.SyncToken,
+		&conn.LastSyncedAt,
+		&conn.LastError,
+		&conn.CreatedAt,
+		&conn.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// PostgresGoogleCalendarConnectionRepository adalah implementasi
+// domain.GoogleCalendarConnectionRepository menggunakan tabel google_calendar_connections.
+type PostgresGoogleCalendarConnectionRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresGoogleCalendarConnectionRepository adalah constructor untuk
+// PostgresGoogleCalendarConnectionRepository.
+func NewPostgresGoogleCalendarConnectionRepository(dbpool *pgxpool.Pool) domain.GoogleCalendarConnectionRepository {
+	return &PostgresGoogleCalendarConnectionRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByUserID mencari koneksi Google Calendar milik pengguna.
+func (r *PostgresGoogleCalendarConnectionRepository) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.GoogleCalendarConnection, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+googleCalendarConnectionColumns+` FROM google_calendar_connections WHERE user_id = $1`, userID)
+	conn, err := scanGoogleCalendarConnection(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrGoogleCalendarNotConnected
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding google calendar connection for user_id %s: %w", userID, err)
+	}
+	return conn, nil
+}
+
+// FindAll mengembalikan semua koneksi, dari yang paling lama tidak disinkronkan.
+func (r *PostgresGoogleCalendarConnectionRepository) FindAll(ctx context.Context) ([]*domain.GoogleCalendarConnection, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+googleCalendarConnectionColumns+` FROM google_calendar_connections
+	                                   ORDER BY last_synced_at NULLS FIRST, user_id`)
+	if err != nil {
+		return nil, fmt.Errorf("error finding google calendar connections: %w", err)
+	}
+	defer rows.Close()
+
+	var conns []*domain.GoogleCalendarConnection
+	for rows.Next() {
+		conn, err := scanGoogleCalendarConnection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning google calendar connection: %w", err)
+		}
+		conns = append(conns, conn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating google calendar connections: %w", err)
+	}
+	return conns, nil
+}
+
+// Save melakukan upsert koneksi dalam satu transaksi. created_at selalu dipertahankan; sync token,
+// waktu sinkronisasi, dan pemetaan event hanya dipertahankan jika kalendernya sama.
+func (r *PostgresGoogleCalendarConnectionRepository) Save(ctx context.Context, conn *domain.GoogleCalendarConnection) error {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	var oldCalendarID string
+	err = tx.QueryRow(ctx, `SELECT calendar_id FROM google_calendar_connections WHERE user_id = $1 FOR UPDATE`,
+		conn.UserID).Scan(&oldCalendarID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("error locking google calendar connection for user_id %s: %w", conn.UserID, err)
+	}
+	if err == nil && oldCalendarID != conn.CalendarID {
+		if _, err := tx.Exec(ctx, `DELETE FROM google_calendar_events WHERE user_id = $1`, conn.UserID); err != nil {
+			return fmt.Errorf("error deleting google calendar events for user_id %s: %w", conn.UserID, err)
+		}
+	}
+
+	query := `INSERT INTO google_calendar_connections (` + googleCalendarConnectionColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, '', NULL, '', $6, $6)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET calendar_id = EXCLUDED.calendar_id,
+	               access_token = EXCLUDED.access_token,
+	               refresh_token = EXCLUDED.refresh_token,
+	               token_expires_at = EXCLUDED.token_expires_at,
+	               sync_token = CASE WHEN google_calendar_connections.calendar_id = EXCLUDED.calendar_id
+	                                 THEN google_calendar_connections.sync_token ELSE '' END,
+	               last_synced_at = CASE WHEN google_calendar_connections.calendar_id = EXCLUDED.calendar_id
+	                                     THEN google_calendar_connections.last_synced_at END,
+	               last_error = '',
+	               updated_at = EXCLUDED.updated_at
+	           RETURNING sync_token, last_synced_at, created_at`
+	err = tx.QueryRow(ctx, query,
+		conn.UserID,
+		conn.CalendarID,
+		conn.AccessToken,
+		conn.RefreshToken,
+		conn.TokenExpiresAt,
+		conn.UpdatedAt,
+	).Scan(&conn.SyncToken, &conn.LastSyncedAt, &conn.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving google calendar connection for user_id %s: %w", conn.UserID, err)
+	}
+	conn.LastError = ""
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+	return nil
+}
+
+// UpdateToken menyimpan access token hasil refresh.
+func (r *PostgresGoogleCalendarConnectionRepository) UpdateToken(ctx context.Context, userID domain.UserID, accessToken string, expiresAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE google_calendar_connections SET access_token = $2, token_expires_at = $3
+	                               WHERE user_id = $1`, userID, accessToken, expiresAt)
+	if err != nil {
+		return fmt.Errorf("error updating google calendar token for user_id %s: %w", userID, err)
+	}
+	return nil
+}
+
+// UpdateSyncState menyimpan sync token dan hasil sinkronisasi terakhir.
+func (r *PostgresGoogleCalendarConnectionRepository) UpdateSyncState(ctx context.Context, userID domain.UserID, syncToken string, syncedAt time.Time, lastError string) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE google_calendar_connections
+	                               SET sync_token = $2, last_synced_at = $3, last_error = $4
+	                               WHERE user_id = $1`, userID, syncToken, syncedAt, lastError)
+	if err != nil {
+		return fmt.Errorf("error updating google calendar sync state for user_id %s: %w", userID, err)
+	}
+	return nil
+}
+
+// Delete menghapus koneksi; pemetaan event ikut terhapus lewat ON DELETE CASCADE.
+func (r *PostgresGoogleCalendarConnectionRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM google_calendar_connections WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting google calendar connection for user_id %s: %w", userID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrGoogleCalendarNotConnected
+	}
+	return nil
+}
+
+// googleCalendarLinkColumns adalah daftar kolom yang dibaca untuk setiap pemetaan event, sesuai
+// urutan Scan di scanGoogleCalendarLink.
+const googleCalendarLinkColumns = `user_id, task_id, event_id, synced_at`
+
+func scanGoogleCalendarLink(row pgx.Row) (*domain.GoogleCalendarLink, error) {
+	link := &domain.GoogleCalendarLink{}
+	if err := row.Scan(&link.UserID, &link.TaskID, &link.EventID, &link.SyncedAt); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// PostgresGoogleCalendarLinkRepository adalah implementasi domain.GoogleCalendarLinkRepository
+// menggunakan tabel google_calendar_events.
+type PostgresGoogleCalendarLinkRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresGoogleCalendarLinkRepository adalah constructor untuk PostgresGoogleCalendarLinkRepository.
+func NewPostgresGoogleCalendarLinkRepository(dbpool *pgxpool.Pool) domain.GoogleCalendarLinkRepository {
+	return &PostgresGoogleCalendarLinkRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByTaskID mencari event Google untuk task.
+func (r *PostgresGoogleCalendarLinkRepository) FindByTaskID(ctx context.Context, userID domain.UserID, taskID string) (*domain.GoogleCalendarLink, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+googleCalendarLinkColumns+` FROM google_calendar_events
+	                                WHERE user_id = $1 AND task_id = $2`, userID, taskID)
+	link, err := scanGoogleCalendarLink(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrGoogleCalendarLinkNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding google calendar event for task_id %s: %w", taskID, err)
+	}
+	return link, nil
+}
+
+// FindByUserID mengembalikan semua pemetaan event milik pengguna.
+func (r *PostgresGoogleCalendarLinkRepository) FindByUserID(ctx context.Context, userID domain.UserID) ([]*domain.GoogleCalendarLink, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+googleCalendarLinkColumns+` FROM google_calendar_events
+	                                   WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding google calendar events for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var links []*domain.GoogleCalendarLink
+	for rows.Next() {
+		link, err := scanGoogleCalendarLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning google calendar event: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating google calendar events: %w", err)
+	}
+	return links, nil
+}
+
+// Save melakukan upsert pemetaan task. Koneksi yang sudah dihapus membuat insert gagal karena
+// foreign key, sehingga pemetaan tidak tertinggal tanpa koneksi.
+func (r *PostgresGoogleCalendarLinkRepository) Save(ctx context.Context, link *domain.GoogleCalendarLink) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO google_calendar_events (`+googleCalendarLinkColumns+`)
+	                               VALUES ($1, $2, $3, $4)
+	                               ON CONFLICT (user_id, task_id) DO UPDATE
+	                               SET event_id = EXCLUDED.event_id, synced_at = EXCLUDED.synced_at`,
+		link.UserID, link.TaskID, link.EventID, link.SyncedAt)
+	if err != nil {
+		return fmt.Errorf("error saving google calendar event for task_id %s: %w", link.TaskID, err)
+	}
+	return nil
+}
+
+// Delete menghapus pemetaan task; tidak error jika pemetaan tidak ada.
+func (r *PostgresGoogleCalendarLinkRepository) Delete(ctx context.Context, userID domain.UserID, taskID string) error {
+	_, err := r.dbpool.Exec(ctx, `DELETE FROM google_calendar_events WHERE user_id = $1 AND task_id = $2`, userID, taskID)
+	if err != nil {
+		return fmt.Errorf("error deleting google calendar event for task_id %s: %w", taskID, err)
+	}
+	return nil
+}
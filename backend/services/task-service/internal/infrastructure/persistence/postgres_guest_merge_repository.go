@@ -0,0 +1,102 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_guest_merge_repository.go
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// guestMergeStatement adalah satu langkah Merge. Setiap statement menerima ID tamu sebagai $1
+// dan ID pengguna tujuan sebagai $2.
+type guestMergeStatement struct {
+	description string
+	query       string
+}
+
+// guestMergeStatements memindahkan data yang bisa dibuat tamu lewat route yang dibatasi ke
+// daftarnya sendiri (lihat auth.Authenticator). Kolom board dikosongkan karena kolom board
+// tersimpan per pengguna. Counter task dan streak dipindahkan trigger pada tabel tasks.
+var guestMergeStatements = []guestMergeStatement{
+	{"comments", `UPDATE task_comments SET author_id = $2 WHERE author_id = $1`},
+	{"attachments", `UPDATE task_attachments SET user_id = $2 WHERE user_id = $1`},
+	{"activities", `UPDATE activities
+	           SET user_id = CASE WHEN user_id = $1 THEN $2 ELSE user_id END,
+	               actor_id = CASE WHEN actor_id = $1 THEN $2 ELSE actor_id END
+	           WHERE user_id = $1 OR actor_id = $1`},
+	{"task revisions", `UPDATE task_revisions
+	           SET user_id = CASE WHEN user_id = $1 THEN $2 ELSE user_id END,
+	               actor_id = CASE WHEN actor_id = $1 THEN $2 ELSE actor_id END
+	           WHERE user_id = $1 OR actor_id = $1`},
+}
+
+// PostgresGuestMergeRepository adalah implementasi domain.GuestMergeRepository menggunakan tabel
+// guest_merges.
+type PostgresGuestMergeRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresGuestMergeRepository adalah constructor untuk PostgresGuestMergeRepository.
+func NewPostgresGuestMergeRepository(dbpool *pgxpool.Pool) domain.GuestMergeRepository {
+	return &PostgresGuestMergeRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Merge menyisipkan baris guest_merges lebih dulu, sehingga dua penggabungan bersamaan untuk tamu
+// yang sama saling menunggu di primary key dan yang kalah mendapat ErrGuestAlreadyMerged tanpa
+// memindahkan apa pun. updated_at task ikut diubah agar klien /sync pengguna menerima task tersebut.
+func (r *PostgresGuestMergeRepository) Merge(ctx context.Context, merge *domain.GuestMerge) error {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting guest merge transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	tag, err := tx.Exec(ctx, `INSERT INTO guest_merges (guest_id, user_id, merged_at) VALUES ($1, $2, $3)
+	           ON CONFLICT (guest_id) DO NOTHING`,
+		merge.GuestID, merge.UserID, merge.MergedAt)
+	if err != nil {
+		return fmt.Errorf("error recording guest merge for %s: %w", merge.GuestID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrGuestAlreadyMerged
+	}
+
+	tag, err = tx.Exec(ctx, `UPDATE tasks SET user_id = $2, column_id = NULL, updated_at = $3 WHERE user_id = $1`,
+		merge.GuestID, merge.UserID, merge.MergedAt)
+	if err != nil {
+		return fmt.Errorf("error merging tasks of %s into user_id %s: %w", merge.GuestID, merge.UserID, err)
+	}
+	mergedTasks := tag.RowsAffected()
+	for _, stmt := range guestMergeStatements {
+		if _, err := tx.Exec(ctx, stmt.query, merge.GuestID, merge.UserID); err != nil {
+			return fmt.Errorf("error merging %s of %s into user_id %s: %w", stmt.description, merge.GuestID, merge.UserID, err)
+		}
+	}
+	// Semua task sudah dipindahkan, sehingga baris counter tamu (beserta batas dan masa berlakunya)
+	// tidak dibutuhkan lagi.
+	if _, err := tx.Exec(ctx, `DELETE FROM user_task_counters WHERE user_id = $1`, merge.GuestID); err != nil {
+		return fmt.Errorf("error deleting task counters of %s: %w", merge.GuestID, err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE guest_merges SET merged_tasks = $2 WHERE guest_id = $1`, merge.GuestID, mergedTasks); err != nil {
+		return fmt.Errorf("error updating guest merge for %s: %w", merge.GuestID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing guest merge transaction: %w", err)
+	}
+	merge.MergedTasks = mergedTasks
+	return nil
+}
+
+// IsMerged membaca keberadaan baris guest_merges untuk tamu.
+func (r *PostgresGuestMergeRepository) IsMerged(ctx context.Context, guestID domain.UserID) (bool, error) {
+	var merged bool
+	err := r.dbpool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM guest_merges WHERE guest_id = $1)`, guestID).Scan(&merged)
+	if err != nil {
+		return false, fmt.Errorf("error checking guest merge for %s: %w", guestID, err)
+	}
+	return merged, nil
+}
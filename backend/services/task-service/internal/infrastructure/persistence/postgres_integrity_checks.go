@@ -0,0 +1,154 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_integrity_checks.go
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// sqlIntegrityCheck adalah domain.IntegrityCheck yang didefinisikan dengan tiga query:
+// menghitung anomali, mengambil contoh ID ($1 = batas jumlah), dan memperbaikinya.
+type sqlIntegrityCheck struct {
+	dbpool    *pgxpool.Pool
+	name      string
+	countSQL  string
+	sampleSQL string
+	repairSQL string
+}
+
+// Name mengembalikan nama pemeriksaan.
+func (c *sqlIntegrityCheck) Name() string {
+	return c.name
+}
+
+// Find menghitung anomali dan mengambil contoh ID jika ada.
+func (c *sqlIntegrityCheck) Find(ctx context.Context, sampleLimit int) (int64, []string, error) {
+	var count int64
+	if err := c.dbpool.QueryRow(ctx, c.countSQL).Scan(&count); err != nil {
+		return 0, nil, fmt.Errorf("error counting %s: %w", c.name, err)
+	}
+	if count == 0 {
+		return 0, nil, nil
+	}
+
+	rows, err := c.dbpool.Query(ctx, c.sampleSQL, sampleLimit)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error sampling %s: %w", c.name, err)
+	}
+	sample, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return 0, nil, fmt.Errorf("error reading %s sample: %w", c.name, err)
+	}
+	return count, sample, nil
+}
+
+// Repair menjalankan query perbaikan dan mengembalikan jumlah baris yang diubah.
+func (c *sqlIntegrityCheck) Repair(ctx context.Context) (int64, error) {
+	cmdTag, err := c.dbpool.Exec(ctx, c.repairSQL)
+	if err != nil {
+		return 0, fmt.Errorf("error repairing %s: %w", c.name, err)
+	}
+	return cmdTag.RowsAffected(), nil
+}
+
+// counterDriftSQL memilih user_id yang counter cache-nya berbeda dari jumlah task sebenarnya,
+// termasuk pengguna yang punya task tetapi belum punya baris counter.
+const counterDriftSQL = `SELECT COALESCE(c.user_id, t.user_id) AS user_id
+	           FROM user_task_counters c
+	           FULL JOIN (
+	               SELECT user_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE NOT completed) AS open
+	               FROM tasks GROUP BY user_id
+	           ) t ON t.user_id = c.user_id
+	           WHERE COALESCE(c.total_tasks, 0) <> COALESCE(t.total, 0)
+	              OR COALESCE(c.open_tasks, 0) <> COALESCE(t.open, 0)`
+
+// boardColumnCounterDriftSQL memilih ID kolom board yang counter cache-nya berbeda dari jumlah task
+// yang belum diarsipkan di kolom tersebut.
+const boardColumnCounterDriftSQL = `SELECT c.id
+	           FROM board_columns c
+	           LEFT JOIN (
+	               SELECT column_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE NOT completed) AS open
+	               FROM tasks WHERE column_id IS NOT NULL AND NOT archived GROUP BY column_id
+	           ) t ON t.column_id = c.id
+	           WHERE c.total_tasks <> COALESCE(t.total, 0) OR c.open_tasks <> COALESCE(t.open, 0)`
+
+// NewPostgresIntegrityChecks mengembalikan semua pemeriksaan integritas data task-service.
+// Perbaikan mengisi updated_at agar klien menerima baris yang diperbaiki lewat delta sync.
+func NewPostgresIntegrityChecks(dbpool *pgxpool.Pool) []domain.IntegrityCheck {
+	return []domain.IntegrityCheck{
+		// completed dan completed_at harus konsisten: completed_at terisi jika dan hanya jika selesai.
+		&sqlIntegrityCheck{
+			dbpool:    dbpool,
+			name:      "task_completed_at_mismatch",
+			countSQL:  `SELECT COUNT(*) FROM tasks WHERE completed <> (completed_at IS NOT NULL)`,
+			sampleSQL: `SELECT id FROM tasks WHERE completed <> (completed_at IS NOT NULL) ORDER BY id LIMIT $1`,
+			// Untuk task selesai, updated_at (nilai lama) adalah perkiraan terbaik waktu penyelesaian.
+			repairSQL: `UPDATE tasks
+			           SET completed_at = CASE WHEN completed THEN updated_at END,
+			               updated_at = GREATEST(updated_at, now())
+			           WHERE completed <> (completed_at IS NOT NULL)`,
+		},
+		// updated_at tidak boleh lebih awal dari created_at; cursor delta sync bergantung pada updated_at.
+		&sqlIntegrityCheck{
+			dbpool:    dbpool,
+			name:      "task_updated_before_created",
+			countSQL:  `SELECT COUNT(*) FROM tasks WHERE updated_at < created_at`,
+			sampleSQL: `SELECT id FROM tasks WHERE updated_at < created_at ORDER BY id LIMIT $1`,
+			repairSQL: `UPDATE tasks SET updated_at = GREATEST(created_at, now()) WHERE updated_at < created_at`,
+		},
+		// Position ganda dalam satu pengguna membuat urutan manual tidak stabil. Contoh berisi user_id.
+		&sqlIntegrityCheck{
+			dbpool: dbpool,
+			name:   "task_position_duplicates",
+			countSQL: `SELECT COUNT(DISTINCT user_id) FROM (
+			               SELECT user_id FROM tasks GROUP BY user_id, position HAVING COUNT(*) > 1
+			           ) duplicates`,
+			sampleSQL: `SELECT DISTINCT user_id FROM tasks GROUP BY user_id, position HAVING COUNT(*) > 1
+			           ORDER BY user_id LIMIT $1`,
+			repairSQL: `UPDATE tasks t
+			           SET position = ranked.new_position, updated_at = GREATEST(t.updated_at, now())
+			           FROM (
+			               SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY position, id) * 1024 AS new_position
+			               FROM tasks
+			               WHERE user_id IN (SELECT user_id FROM tasks GROUP BY user_id, position HAVING COUNT(*) > 1)
+			           ) ranked
+			           WHERE t.id = ranked.id AND t.position <> ranked.new_position`,
+		},
+		// Counter cache user_task_counters harus sama dengan isi tabel tasks. Contoh berisi user_id.
+		// Write yang commit bersamaan dengan perbaikan bisa menyisakan selisih; run berikutnya akan memperbaikinya.
+		&sqlIntegrityCheck{
+			dbpool:    dbpool,
+			name:      "task_counter_drift",
+			countSQL:  `SELECT COUNT(*) FROM (` + counterDriftSQL + `) drift`,
+			sampleSQL: `SELECT user_id FROM (` + counterDriftSQL + `) drift ORDER BY user_id LIMIT $1`,
+			repairSQL: `INSERT INTO user_task_counters (user_id, total_tasks, open_tasks)
+			           SELECT drift.user_id, COUNT(t.id), COUNT(t.id) FILTER (WHERE NOT t.completed)
+			           FROM (` + counterDriftSQL + `) drift
+			           LEFT JOIN tasks t ON t.user_id = drift.user_id
+			           GROUP BY drift.user_id
+			           ON CONFLICT (user_id) DO UPDATE
+			           SET total_tasks = EXCLUDED.total_tasks, open_tasks = EXCLUDED.open_tasks`,
+		},
+		// Counter cache kolom board harus sama dengan task yang belum diarsipkan di kolom tersebut.
+		// Contoh berisi ID kolom. updated_at kolom tidak diubah karena counter bukan bagian dari sync.
+		&sqlIntegrityCheck{
+			dbpool:    dbpool,
+			name:      "board_column_counter_drift",
+			countSQL:  `SELECT COUNT(*) FROM (` + boardColumnCounterDriftSQL + `) drift`,
+			sampleSQL: `SELECT id FROM (` + boardColumnCounterDriftSQL + `) drift ORDER BY id LIMIT $1`,
+			repairSQL: `UPDATE board_columns c
+			           SET total_tasks = counts.total, open_tasks = counts.open
+			           FROM (
+			               SELECT drift.id, COUNT(t.id) AS total, COUNT(t.id) FILTER (WHERE NOT t.completed) AS open
+			               FROM (` + boardColumnCounterDriftSQL + `) drift
+			               LEFT JOIN tasks t ON t.column_id = drift.id AND NOT t.archived
+			               GROUP BY drift.id
+			           ) counts
+			           WHERE c.id = counts.id`,
+		},
+	}
+}
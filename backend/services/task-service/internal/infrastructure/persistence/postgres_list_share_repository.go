@@ -0,0 +1,132 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_list_share_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// listShareColumns adalah daftar kolom yang dibaca untuk setiap akses, sesuai urutan Scan di scanListShare.
+const listShareColumns = `owner_id, collaborator_id, access, created_at, updated_at`
+
+func scanListShare(row pgx.Row) (*domain.ListShare, error) {
+	share := &domain.ListShare{}
+	err := row.Scan(
+		&share.OwnerID,
+		&share.CollaboratorID,
+		&share.Access,
+		&share.CreatedAt,
+		&share.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+// PostgresListShareRepository adalah implementasi domain.ListShareRepository menggunakan tabel list_shares.
+type PostgresListShareRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresListShareRepository adalah constructor untuk PostgresListShareRepository.
+func NewPostgresListShareRepository(dbpool *pgxpool.Pool) domain.ListShareRepository {
+	return &PostgresListShareRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindAccess mengambil akses seorang kolaborator pada daftar pemilik.
+func (r *PostgresListShareRepository) FindAccess(ctx context.Context, ownerID, collaboratorID domain.UserID) (domain.ListAccess, error) {
+	var access domain.ListAccess
+	err := r.dbpool.QueryRow(ctx, `SELECT access FROM list_shares WHERE owner_id = $1 AND collaborator_id = $2`, ownerID, collaboratorID).
+		Scan(&access)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrListShareNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("error finding list access of %s on %s: %w", collaboratorID, ownerID, err)
+	}
+	return access, nil
+}
+
+// FindByOwner mengambil kolaborator daftar pemilik.
+func (r *PostgresListShareRepository) FindByOwner(ctx context.Context, ownerID domain.UserID) ([]*domain.ListShare, error) {
+	return r.find(ctx, `SELECT `+listShareColumns+` FROM list_shares WHERE owner_id = $1 ORDER BY created_at`, ownerID)
+}
+
+// FindByCollaborator mengambil daftar yang dibagikan kepada kolaborator.
+func (r *PostgresListShareRepository) FindByCollaborator(ctx context.Context, collaboratorID domain.UserID) ([]*domain.ListShare, error) {
+	return r.find(ctx, `SELECT `+listShareColumns+` FROM list_shares WHERE collaborator_id = $1 ORDER BY created_at`, collaboratorID)
+}
+
+func (r *PostgresListShareRepository) find(ctx context.Context, query string, userID domain.UserID) ([]*domain.ListShare, error) {
+	rows, err := r.dbpool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding list shares for %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var shares []*domain.ListShare
+	for rows.Next() {
+		share, err := scanListShare(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning list share row: %w", err)
+		}
+		shares = append(shares, share)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating list share rows: %w", err)
+	}
+	return shares, nil
+}
+
+// Save melakukan upsert akses kolaborator.
+func (r *PostgresListShareRepository) Save(ctx context.Context, share *domain.ListShare) error {
+	query := `INSERT INTO list_shares (` + listShareColumns + `)
+	           VALUES ($1, $2, $3, $4, $5)
+	           ON CONFLICT (owner_id, collaborator_id) DO UPDATE
+	           SET access = EXCLUDED.access, updated_at = EXCLUDED.updated_at
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		share.OwnerID,
+		share.CollaboratorID,
+		share.Access,
+		share.CreatedAt,
+		share.UpdatedAt,
+	).Scan(&share.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving list share of %s for %s: %w", share.OwnerID, share.CollaboratorID, err)
+	}
+	return nil
+}
+
+// Delete mencabut akses kolaborator dan melepas penugasannya dalam satu transaksi.
+func (r *PostgresListShareRepository) Delete(ctx context.Context, ownerID, collaboratorID domain.UserID) error {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting list share transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	cmdTag, err := tx.Exec(ctx, `DELETE FROM list_shares WHERE owner_id = $1 AND collaborator_id = $2`, ownerID, collaboratorID)
+	if err != nil {
+		return fmt.Errorf("error deleting list share of %s for %s: %w", ownerID, collaboratorID, err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrListShareNotFound
+	}
+	if _, err := tx.Exec(ctx, `UPDATE tasks SET assignee_id = NULL, updated_at = NOW() WHERE user_id = $1 AND assignee_id = $2`, ownerID, collaboratorID); err != nil {
+		return fmt.Errorf("error unassigning tasks of %s for %s: %w", ownerID, collaboratorID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing list share transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_local_auth_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresLocalUserRepository adalah implementasi domain.LocalUserRepository menggunakan tabel
+// local_users.
+type PostgresLocalUserRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresLocalUserRepository adalah constructor untuk PostgresLocalUserRepository.
+func NewPostgresLocalUserRepository(dbpool *pgxpool.Pool) domain.LocalUserRepository {
+	return &PostgresLocalUserRepository{
+		dbpool: dbpool,
+	}
+}
+
+func scanLocalUser(row pgx.Row) (*domain.LocalUser, error) {
+	user := &domain.LocalUser{}
+	err := row.Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Role, &user.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// FindByEmail mencari akun berdasarkan email (huruf kecil).
+func (r *PostgresLocalUserRepository) FindByEmail(ctx context.Context, email string) (*domain.LocalUser, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT id, email, password_hash, role, created_at FROM local_users WHERE email = $1`, email)
+	user, err := scanLocalUser(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrLocalUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding local user by email: %w", err)
+	}
+	return user, nil
+}
+
+// FindByID mencari akun berdasarkan ID.
+func (r *PostgresLocalUserRepository) FindByID(ctx context.Context, id domain.UserID) (*domain.LocalUser, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT id, email, password_hash, role, created_at FROM local_users WHERE id = $1`, id)
+	user, err := scanLocalUser(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrLocalUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding local user %s: %w", id, err)
+	}
+	return user, nil
+}
+
+// Create menyimpan akun baru; email ganda ditolak oleh idx_local_users_email.
+func (r *PostgresLocalUserRepository) Create(ctx context.Context, user *domain.LocalUser) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO local_users (id, email, password_hash, role, created_at)
+	           VALUES ($1, $2, $3, $4, $5)`,
+		user.ID, user.Email, user.PasswordHash, user.Role, user.CreatedAt)
+	if isUniqueViolation(err, "idx_local_users_email") {
+		return domain.ErrEmailTaken
+	}
+	if err != nil {
+		return fmt.Errorf("error creating local user: %w", err)
+	}
+	return nil
+}
+
+// PostgresRefreshTokenRepository adalah implementasi domain.RefreshTokenRepository menggunakan
+// tabel refresh_tokens.
+type PostgresRefreshTokenRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresRefreshTokenRepository adalah constructor untuk PostgresRefreshTokenRepository.
+func NewPostgresRefreshTokenRepository(dbpool *pgxpool.Pool) domain.RefreshTokenRepository {
+	return &PostgresRefreshTokenRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByHash mencari token berdasarkan hash-nya, termasuk yang sudah terpakai atau dicabut.
+func (r *PostgresRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	token := &domain.RefreshToken{}
+	err := r.dbpool.QueryRow(ctx, `SELECT id, family_id, user_id, created_at, expires_at, used_at, revoked_at
+	           FROM refresh_tokens WHERE token_hash = $1`, tokenHash).Scan(
+		&token.ID,
+		&token.FamilyID,
+		&token.UserID,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding refresh token: %w", err)
+	}
+	return token, nil
+}
+
+// Create menyimpan token baru.
+func (r *PostgresRefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken, tokenHash string) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO refresh_tokens (id, family_id, user_id, token_hash, created_at, expires_at)
+	           VALUES ($1, $2, $3, $4, $5, $6)`,
+		token.ID, token.FamilyID, token.UserID, tokenHash, token.CreatedAt, token.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("error creating refresh token for %s: %w", token.UserID, err)
+	}
+	return nil
+}
+
+// MarkUsed mengisi used_at hanya jika token belum terpakai dan belum dicabut, sehingga dari dua
+// rotasi bersamaan hanya satu yang berhasil.
+func (r *PostgresRefreshTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx, `UPDATE refresh_tokens SET used_at = $2
+	           WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL`, id, usedAt)
+	if err != nil {
+		return false, fmt.Errorf("error marking refresh token %s as used: %w", id, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// RevokeFamily mengisi revoked_at semua token family yang belum dicabut.
+func (r *PostgresRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string, revokedAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE refresh_tokens SET revoked_at = $2
+	           WHERE family_id = $1 AND revoked_at IS NULL`, familyID, revokedAt)
+	if err != nil {
+		return fmt.Errorf("error revoking refresh token family %s: %w", familyID, err)
+	}
+	return nil
+}
+
+// DeleteExpired menghapus token yang expires_at-nya sebelum before.
+func (r *PostgresRefreshTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM refresh_tokens WHERE expires_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired refresh tokens: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
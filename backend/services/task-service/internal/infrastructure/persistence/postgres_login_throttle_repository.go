@@ -0,0 +1,155 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_login_throttle_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresLoginThrottleRepository adalah implementasi domain.LoginThrottleRepository menggunakan
+// tabel login_throttles. Key berisi email, jadi tidak dicantumkan di pesan error.
+type PostgresLoginThrottleRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresLoginThrottleRepository adalah constructor untuk PostgresLoginThrottleRepository.
+func NewPostgresLoginThrottleRepository(dbpool *pgxpool.Pool) domain.LoginThrottleRepository {
+	return &PostgresLoginThrottleRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Reserve mengunci baris setiap key dengan SELECT ... FOR UPDATE, sehingga percobaan bersamaan dari
+// beberapa replika dihitung satu per satu dan yang datang setelah batas tercapai langsung ditolak.
+// Baris key baru dibuat lebih dulu agar ikut terkunci; key diurutkan agar dua transaksi tidak saling
+// menunggu (deadlock).
+func (r *PostgresLoginThrottleRepository) Reserve(ctx context.Context, keys []string, at, resetBefore time.Time, delay func(key string, failures int) time.Duration) ([]domain.LoginThrottle, error) {
+	keys = slices.Sorted(slices.Values(keys))
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting login throttle transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	if _, err := tx.Exec(ctx, `INSERT INTO login_throttles (key, failures, last_failure_at)
+	           SELECT key, 0, $2 FROM unnest($1::text[]) AS key ORDER BY key
+	           ON CONFLICT (key) DO NOTHING`, keys, at); err != nil {
+		return nil, fmt.Errorf("error creating login throttles: %w", err)
+	}
+	rows, err := tx.Query(ctx, `SELECT key, failures, last_failure_at, blocked_until
+	           FROM login_throttles WHERE key = ANY($1) ORDER BY key FOR UPDATE`, keys)
+	if err != nil {
+		return nil, fmt.Errorf("error locking login throttles: %w", err)
+	}
+	var throttles []domain.LoginThrottle
+	for rows.Next() {
+		var throttle domain.LoginThrottle
+		if err := rows.Scan(&throttle.Key, &throttle.Failures, &throttle.LastFailureAt, &throttle.BlockedUntil); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("error scanning login throttle row: %w", err)
+		}
+		throttles = append(throttles, throttle)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating login throttle rows: %w", err)
+	}
+
+	var retryAfter time.Duration
+	for _, throttle := range throttles {
+		if remaining, blocked := throttle.Blocked(at); blocked {
+			retryAfter = max(retryAfter, remaining)
+		}
+	}
+	if retryAfter > 0 {
+		return nil, &domain.LoginThrottledError{RetryAfter: retryAfter}
+	}
+
+	for i := range throttles {
+		throttle := &throttles[i]
+		throttle.Failures++
+		if throttle.LastFailureAt.Before(resetBefore) {
+			throttle.Failures = 1
+		}
+		if at.After(throttle.LastFailureAt) {
+			throttle.LastFailureAt = at
+		}
+		if d := delay(throttle.Key, throttle.Failures); d > 0 {
+			until := at.Add(d)
+			if throttle.BlockedUntil == nil || until.After(*throttle.BlockedUntil) {
+				throttle.BlockedUntil = &until
+			}
+		}
+		if _, err := tx.Exec(ctx, `UPDATE login_throttles SET failures = $2, last_failure_at = $3, blocked_until = $4
+		           WHERE key = $1`, throttle.Key, throttle.Failures, throttle.LastFailureAt, throttle.BlockedUntil); err != nil {
+			return nil, fmt.Errorf("error recording login attempt: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing login throttle transaction: %w", err)
+	}
+	return throttles, nil
+}
+
+// Release hanya menghapus penolakan yang masih sama dengan yang dipasang Reserve; penolakan yang
+// diperpanjang percobaan lain tetap berlaku.
+func (r *PostgresLoginThrottleRepository) Release(ctx context.Context, reserved domain.LoginThrottle) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE login_throttles
+	           SET failures = GREATEST(failures - 1, 0),
+	               blocked_until = CASE WHEN blocked_until = $2 THEN NULL ELSE blocked_until END
+	           WHERE key = $1`, reserved.Key, reserved.BlockedUntil)
+	if err != nil {
+		return fmt.Errorf("error releasing login attempt: %w", err)
+	}
+	return nil
+}
+
+// SetUnlockToken mengganti token yang sudah kedaluwarsa saja, sehingga hanya satu email buka kunci
+// terkirim selama token masih berlaku.
+func (r *PostgresLoginThrottleRepository) SetUnlockToken(ctx context.Context, key, tokenHash string, expiresAt, now time.Time) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx, `UPDATE login_throttles SET unlock_token_hash = $2, unlock_expires_at = $3
+	           WHERE key = $1 AND (unlock_expires_at IS NULL OR unlock_expires_at <= $4)`, key, tokenHash, expiresAt, now)
+	if err != nil {
+		return false, fmt.Errorf("error saving unlock token: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Unlock menghapus baris dan token-nya sekaligus, sehingga token hanya bisa dipakai sekali.
+func (r *PostgresLoginThrottleRepository) Unlock(ctx context.Context, tokenHash string, now time.Time) (string, error) {
+	var key string
+	err := r.dbpool.QueryRow(ctx, `DELETE FROM login_throttles WHERE unlock_token_hash = $1 AND unlock_expires_at > $2
+	           RETURNING key`, tokenHash, now).Scan(&key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrLoginThrottleNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("error unlocking login: %w", err)
+	}
+	return key, nil
+}
+
+// Delete tidak mengembalikan error jika key tidak ada.
+func (r *PostgresLoginThrottleRepository) Delete(ctx context.Context, key string) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM login_throttles WHERE key = $1`, key); err != nil {
+		return fmt.Errorf("error deleting login throttle: %w", err)
+	}
+	return nil
+}
+
+// DeleteStale memakai index last_failure_at.
+func (r *PostgresLoginThrottleRepository) DeleteStale(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM login_throttles
+	           WHERE last_failure_at < $1 AND (blocked_until IS NULL OR blocked_until < $1)`, before)
+	if err != nil {
+		return 0, fmt.Errorf("error purging login throttles: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
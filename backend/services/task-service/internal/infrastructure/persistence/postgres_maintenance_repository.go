@@ -0,0 +1,59 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_maintenance_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresMaintenanceRepository adalah implementasi domain.MaintenanceRepository menggunakan
+// PostgreSQL (tabel maintenance_mode dengan satu baris).
+type PostgresMaintenanceRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresMaintenanceRepository adalah constructor untuk PostgresMaintenanceRepository.
+func NewPostgresMaintenanceRepository(dbpool *pgxpool.Pool) domain.MaintenanceRepository {
+	return &PostgresMaintenanceRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Get membaca status maintenance; tanpa baris berarti maintenance tidak aktif.
+func (r *PostgresMaintenanceRepository) Get(ctx context.Context) (domain.Maintenance, error) {
+	sql := `SELECT enabled, message, retry_after_seconds, updated_by, updated_at
+	         FROM maintenance_mode WHERE id`
+	var maintenance domain.Maintenance
+	var retryAfterSeconds int
+	err := r.dbpool.QueryRow(ctx, sql).Scan(&maintenance.Enabled, &maintenance.Message, &retryAfterSeconds,
+		&maintenance.UpdatedBy, &maintenance.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Maintenance{}, nil
+	}
+	if err != nil {
+		return domain.Maintenance{}, fmt.Errorf("error getting maintenance mode: %w", err)
+	}
+	maintenance.RetryAfter = time.Duration(retryAfterSeconds) * time.Second
+	return maintenance, nil
+}
+
+// Save menyimpan status maintenance.
+func (r *PostgresMaintenanceRepository) Save(ctx context.Context, maintenance domain.Maintenance) error {
+	sql := `INSERT INTO maintenance_mode (id, enabled, message, retry_after_seconds, updated_by, updated_at)
+	         VALUES (TRUE, $1, $2, $3, $4, $5)
+	         ON CONFLICT (id) DO UPDATE SET enabled = EXCLUDED.enabled, message = EXCLUDED.message,
+	             retry_after_seconds = EXCLUDED.retry_after_seconds, updated_by = EXCLUDED.updated_by,
+	             updated_at = EXCLUDED.updated_at`
+	_, err := r.dbpool.Exec(ctx, sql, maintenance.Enabled, maintenance.Message, int(maintenance.RetryAfter/time.Second),
+		maintenance.UpdatedBy, maintenance.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving maintenance mode: %w", err)
+	}
+	return nil
+}
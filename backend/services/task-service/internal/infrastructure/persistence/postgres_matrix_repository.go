@@ -0,0 +1,107 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_matrix_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// matrixChannelColumns adalah daftar kolom yang dibaca untuk setiap pengaturan Matrix,
+// sesuai urutan Scan di scanMatrixChannel.
+const matrixChannelColumns = `user_id, homeserver_url, access_token, room_id, event_types, verified_at, created_at, updated_at`
+
+func scanMatrixChannel(row pgx.Row) (*domain.MatrixChannel, error) {
+	channel := &domain.MatrixChannel{}
+	var eventTypes []string
+	err := row.Scan(
+		&channel.UserID,
+		&channel.HomeserverURL,
+		&channel.AccessToken,
+		&channel.RoomID,
+		&eventTypes,
+		&channel.VerifiedAt,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		channel.EventTypes = append(channel.EventTypes, domain.TaskEventType(eventType))
+	}
+	return channel, nil
+}
+
+// PostgresMatrixChannelRepository adalah implementasi domain.MatrixChannelRepository
+// menggunakan tabel matrix_channels.
+type PostgresMatrixChannelRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresMatrixChannelRepository adalah constructor untuk PostgresMatrixChannelRepository.
+func NewPostgresMatrixChannelRepository(dbpool *pgxpool.Pool) domain.MatrixChannelRepository {
+	return &PostgresMatrixChannelRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByUserID mencari pengaturan Matrix milik pengguna.
+func (r *PostgresMatrixChannelRepository) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.MatrixChannel, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+matrixChannelColumns+` FROM matrix_channels WHERE user_id = $1`, userID)
+	channel, err := scanMatrixChannel(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrMatrixChannelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding matrix channel for user_id %s: %w", userID, err)
+	}
+	return channel, nil
+}
+
+// Save melakukan upsert pengaturan Matrix; created_at dipertahankan saat pengaturan diganti.
+func (r *PostgresMatrixChannelRepository) Save(ctx context.Context, channel *domain.MatrixChannel) error {
+	eventTypes := make([]string, 0, len(channel.EventTypes))
+	for _, eventType := range channel.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	query := `INSERT INTO matrix_channels (` + matrixChannelColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET homeserver_url = EXCLUDED.homeserver_url,
+	               access_token = EXCLUDED.access_token,
+	               room_id = EXCLUDED.room_id,
+	               event_types = EXCLUDED.event_types,
+	               verified_at = EXCLUDED.verified_at,
+	               updated_at = EXCLUDED.updated_at
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		channel.UserID,
+		channel.HomeserverURL,
+		channel.AccessToken,
+		channel.RoomID,
+		eventTypes,
+		channel.VerifiedAt,
+		channel.UpdatedAt,
+	).Scan(&channel.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving matrix channel for user_id %s: %w", channel.UserID, err)
+	}
+	return nil
+}
+
+// Delete menghapus pengaturan Matrix milik pengguna.
+func (r *PostgresMatrixChannelRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM matrix_channels WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting matrix channel for user_id %s: %w", userID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMatrixChannelNotFound
+	}
+	return nil
+}
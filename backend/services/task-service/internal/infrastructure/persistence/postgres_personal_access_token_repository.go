@@ -0,0 +1,158 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_personal_access_token_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// personalAccessTokenColumns adalah daftar kolom yang dibaca untuk setiap token, sesuai urutan Scan
+// di scanPersonalAccessToken. token_hash tidak pernah dibaca.
+const personalAccessTokenColumns = `id, user_id, name, scopes, lists, allowed_ips, hint, created_at, expires_at, last_used_at`
+
+func scanPersonalAccessToken(row pgx.Row) (*domain.PersonalAccessToken, error) {
+	token := &domain.PersonalAccessToken{}
+	var scopes, lists []string
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&scopes,
+		&lists,
+		&token.AllowedIPs,
+		&token.Hint,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		token.Scopes = append(token.Scopes, domain.TokenScope(scope))
+	}
+	for _, ownerID := range lists {
+		token.Lists = append(token.Lists, domain.UserID(ownerID))
+	}
+	return token, nil
+}
+
+// PostgresPersonalAccessTokenRepository adalah implementasi domain.PersonalAccessTokenRepository
+// menggunakan tabel personal_access_tokens.
+type PostgresPersonalAccessTokenRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresPersonalAccessTokenRepository adalah constructor untuk PostgresPersonalAccessTokenRepository.
+func NewPostgresPersonalAccessTokenRepository(dbpool *pgxpool.Pool) domain.PersonalAccessTokenRepository {
+	return &PostgresPersonalAccessTokenRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByUserID mencari semua token milik pengguna, dari yang terakhir dibuat.
+func (r *PostgresPersonalAccessTokenRepository) FindByUserID(ctx context.Context, userID domain.UserID) ([]*domain.PersonalAccessToken, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+personalAccessTokenColumns+`
+	           FROM personal_access_tokens WHERE user_id = $1 ORDER BY created_at DESC, id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding personal access tokens for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var tokens []*domain.PersonalAccessToken
+	for rows.Next() {
+		token, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning personal access token row: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating personal access token rows: %w", err)
+	}
+	return tokens, nil
+}
+
+// FindByHash mencari token berdasarkan hash-nya.
+func (r *PostgresPersonalAccessTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+personalAccessTokenColumns+` FROM personal_access_tokens WHERE token_hash = $1`, tokenHash)
+	token, err := scanPersonalAccessToken(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPersonalAccessTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding personal access token: %w", err)
+	}
+	return token, nil
+}
+
+// CountByUserID menghitung token milik pengguna.
+func (r *PostgresPersonalAccessTokenRepository) CountByUserID(ctx context.Context, userID domain.UserID) (int, error) {
+	var count int
+	err := r.dbpool.QueryRow(ctx, `SELECT COUNT(*) FROM personal_access_tokens WHERE user_id = $1`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error counting personal access tokens for user_id %s: %w", userID, err)
+	}
+	return count, nil
+}
+
+// Create menyimpan token baru.
+func (r *PostgresPersonalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken, tokenHash string) error {
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	lists := make([]string, 0, len(token.Lists))
+	for _, ownerID := range token.Lists {
+		lists = append(lists, string(ownerID))
+	}
+	allowedIPs := token.AllowedIPs
+	if allowedIPs == nil {
+		allowedIPs = []netip.Prefix{}
+	}
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO personal_access_tokens
+	           (id, user_id, name, token_hash, hint, scopes, lists, allowed_ips, created_at, expires_at)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		token.ID, token.UserID, token.Name, tokenHash, token.Hint, scopes, lists, allowedIPs, token.CreatedAt, token.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("error creating personal access token for %s: %w", token.UserID, err)
+	}
+	return nil
+}
+
+// Delete menghapus token milik pengguna.
+func (r *PostgresPersonalAccessTokenRepository) Delete(ctx context.Context, userID domain.UserID, id string) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM personal_access_tokens WHERE user_id = $1 AND id = $2`, userID, id)
+	if err != nil {
+		return fmt.Errorf("error deleting personal access token %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrPersonalAccessTokenNotFound
+	}
+	return nil
+}
+
+// DeleteByUserID menghapus semua token milik pengguna.
+func (r *PostgresPersonalAccessTokenRepository) DeleteByUserID(ctx context.Context, userID domain.UserID) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM personal_access_tokens WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("error deleting personal access tokens for user_id %s: %w", userID, err)
+	}
+	return nil
+}
+
+// TouchLastUsed memperbarui last_used_at; waktu yang lebih lama dari nilai tersimpan diabaikan.
+func (r *PostgresPersonalAccessTokenRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE personal_access_tokens SET last_used_at = $2
+	           WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $2)`, id, usedAt)
+	if err != nil {
+		return fmt.Errorf("error updating last use of personal access token %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_push_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// pushChannelColumns adalah daftar kolom yang dibaca untuk setiap pengaturan push,
+// sesuai urutan Scan di scanPushChannel.
+const pushChannelColumns = `user_id, event_types, shared_lists, reminder_lead_seconds, created_at, updated_at`
+
+func scanPushChannel(row pgx.Row) (*domain.PushChannel, error) {
+	channel := &domain.PushChannel{}
+	var (
+		eventTypes  []string
+		leadSeconds int
+	)
+	err := row.Scan(
+		&channel.UserID,
+		&eventTypes,
+		&channel.SharedLists,
+		&leadSeconds,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		channel.EventTypes = append(channel.EventTypes, domain.TaskEventType(eventType))
+	}
+	channel.ReminderLead = time.Duration(leadSeconds) * time.Second
+	return channel, nil
+}
+
+// PostgresPushChannelRepository adalah implementasi domain.PushChannelRepository menggunakan
+// tabel push_channels dan task_due_reminders (channel 'push').
+type PostgresPushChannelRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresPushChannelRepository adalah constructor untuk PostgresPushChannelRepository.
+func NewPostgresPushChannelRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.PushChannelRepository {
+	return &PostgresPushChannelRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// FindByUserID mencari pengaturan push milik pengguna.
+func (r *PostgresPushChannelRepository) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.PushChannel, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+pushChannelColumns+` FROM push_channels WHERE user_id = $1`, userID)
+	channel, err := scanPushChannel(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPushChannelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding push channel for user_id %s: %w", userID, err)
+	}
+	return channel, nil
+}
+
+// Save melakukan upsert pengaturan push; created_at dipertahankan saat pengaturan diganti.
+func (r *PostgresPushChannelRepository) Save(ctx context.Context, channel *domain.PushChannel) error {
+	eventTypes := make([]string, 0, len(channel.EventTypes))
+	for _, eventType := range channel.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	query := `INSERT INTO push_channels (` + pushChannelColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $5)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET event_types = EXCLUDED.event_types,
+	               shared_lists = EXCLUDED.shared_lists,
+	               reminder_lead_seconds = EXCLUDED.reminder_lead_seconds,
+	               updated_at = EXCLUDED.updated_at
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		channel.UserID,
+		eventTypes,
+		channel.SharedLists,
+		int(channel.ReminderLead/time.Second),
+		channel.UpdatedAt,
+	).Scan(&channel.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving push channel for user_id %s: %w", channel.UserID, err)
+	}
+	return nil
+}
+
+// Delete menghapus pengaturan push milik pengguna. Langganan push perangkat tidak dihapus.
+func (r *PostgresPushChannelRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM push_channels WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting push channel for user_id %s: %w", userID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrPushChannelNotFound
+	}
+	return nil
+}
+
+// FindDueReminders sama dengan versi email, dengan jarak pengingat dari push_channels.
+func (r *PostgresPushChannelRepository) FindDueReminders(ctx context.Context, now time.Time, limit int) ([]domain.PushReminder, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskColumns+` FROM tasks
+	           WHERE NOT completed AND NOT archived AND due_at > $1
+	             AND (snoozed_until IS NULL OR snoozed_until <= $1)
+	             AND due_at <= $1 + (SELECT make_interval(secs => p.reminder_lead_seconds) FROM push_channels p
+	                                 WHERE p.user_id = tasks.user_id AND p.reminder_lead_seconds > 0)
+	             AND NOT EXISTS (SELECT 1 FROM task_due_reminders r
+	                             WHERE r.task_id = tasks.id AND r.due_at = tasks.due_at AND r.channel = 'push')
+	           ORDER BY due_at, id
+	           LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding due push reminders: %w", err)
+	}
+	tasks, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make(map[domain.UserID]*domain.PushChannel)
+	reminders := make([]domain.PushReminder, 0, len(tasks))
+	for _, task := range tasks {
+		channel, ok := channels[task.UserID]
+		if !ok {
+			channel, err = r.FindByUserID(ctx, task.UserID)
+			if errors.Is(err, domain.ErrPushChannelNotFound) { // Dihapus setelah query pertama
+				channels[task.UserID] = nil
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			channels[task.UserID] = channel
+		}
+		if channel != nil {
+			reminders = append(reminders, domain.PushReminder{Channel: channel, Task: task})
+		}
+	}
+	return reminders, nil
+}
+
+// ClaimReminder memakai primary key (task_id, due_at, channel) sebagai kunci klaim.
+func (r *PostgresPushChannelRepository) ClaimReminder(ctx context.Context, taskID string, dueAt, now time.Time) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx, `INSERT INTO task_due_reminders (task_id, due_at, channel, claimed_at)
+	           VALUES ($1, $2, 'push', $3) ON CONFLICT DO NOTHING`, taskID, dueAt, now)
+	if err != nil {
+		return false, fmt.Errorf("error claiming push reminder for task %s: %w", taskID, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ReleaseReminder menghapus klaim pengingat push.
+func (r *PostgresPushChannelRepository) ReleaseReminder(ctx context.Context, taskID string, dueAt time.Time) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM task_due_reminders WHERE task_id = $1 AND due_at = $2 AND channel = 'push'`, taskID, dueAt); err != nil {
+		return fmt.Errorf("error releasing push reminder for task %s: %w", taskID, err)
+	}
+	return nil
+}
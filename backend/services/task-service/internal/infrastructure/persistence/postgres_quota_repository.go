@@ -0,0 +1,93 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_quota_repository.go
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresQuotaPolicyRepository adalah implementasi domain.QuotaPolicyRepository menggunakan tabel plan_quotas.
+type PostgresQuotaPolicyRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresQuotaPolicyRepository adalah constructor untuk PostgresQuotaPolicyRepository.
+func NewPostgresQuotaPolicyRepository(dbpool *pgxpool.Pool) domain.QuotaPolicyRepository {
+	return &PostgresQuotaPolicyRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindByPlan mengembalikan semua kebijakan kuota untuk plan.
+func (r *PostgresQuotaPolicyRepository) FindByPlan(ctx context.Context, plan domain.Plan) ([]domain.QuotaPolicy, error) {
+	query := `SELECT plan, resource, quota_limit, warning_thresholds
+	           FROM plan_quotas WHERE plan = $1 ORDER BY resource`
+	rows, err := r.dbpool.Query(ctx, query, plan)
+	if err != nil {
+		return nil, fmt.Errorf("error finding quota policies for plan %s: %w", plan, err)
+	}
+	defer rows.Close()
+
+	var policies []domain.QuotaPolicy
+	for rows.Next() {
+		var policy domain.QuotaPolicy
+		if err := rows.Scan(&policy.Plan, &policy.Resource, &policy.Limit, &policy.WarningThresholds); err != nil {
+			return nil, fmt.Errorf("error scanning quota policy row: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating quota policy rows: %w", err)
+	}
+	return policies, nil
+}
+
+// Upsert menyimpan atau mengganti kebijakan kuota untuk (plan, resource).
+func (r *PostgresQuotaPolicyRepository) Upsert(ctx context.Context, policy domain.QuotaPolicy) error {
+	query := `INSERT INTO plan_quotas (plan, resource, quota_limit, warning_thresholds, updated_at)
+	           VALUES ($1, $2, $3, $4, NOW())
+	           ON CONFLICT (plan, resource) DO UPDATE
+	           SET quota_limit = EXCLUDED.quota_limit,
+	               warning_thresholds = EXCLUDED.warning_thresholds,
+	               updated_at = EXCLUDED.updated_at`
+	_, err := r.dbpool.Exec(ctx, query, policy.Plan, policy.Resource, policy.Limit, policy.WarningThresholds)
+	if err != nil {
+		return fmt.Errorf("error saving quota policy %s/%s: %w", policy.Plan, policy.Resource, err)
+	}
+	return nil
+}
+
+// PostgresUsageEventRecorder adalah implementasi domain.UsageEventRecorder menggunakan tabel usage_events.
+type PostgresUsageEventRecorder struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresUsageEventRecorder adalah constructor untuk PostgresUsageEventRecorder.
+func NewPostgresUsageEventRecorder(dbpool *pgxpool.Pool) domain.UsageEventRecorder {
+	return &PostgresUsageEventRecorder{
+		dbpool: dbpool,
+	}
+}
+
+// Record menyimpan UsageEvent ke tabel usage_events.
+func (r *PostgresUsageEventRecorder) Record(ctx context.Context, event domain.UsageEvent) error {
+	query := `INSERT INTO usage_events (event_type, user_id, plan, resource, threshold, used, quota_limit, occurred_at)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := r.dbpool.Exec(ctx, query,
+		event.Type,
+		event.UserID,
+		event.Plan,
+		event.Resource,
+		event.Threshold,
+		event.Used,
+		event.Limit,
+		event.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error recording usage event %s for user_id %s: %w", event.Type, event.UserID, err)
+	}
+	return nil
+}
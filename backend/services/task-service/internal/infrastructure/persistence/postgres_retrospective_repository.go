@@ -0,0 +1,131 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_retrospective_repository.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresRetrospectiveRepository adalah implementasi domain.RetrospectiveRepository
+// menggunakan tabel retrospective_settings dan retrospectives.
+type PostgresRetrospectiveRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresRetrospectiveRepository adalah constructor untuk PostgresRetrospectiveRepository.
+func NewPostgresRetrospectiveRepository(dbpool *pgxpool.Pool) domain.RetrospectiveRepository {
+	return &PostgresRetrospectiveRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindSettings mengembalikan pengaturan nonaktif jika pengguna belum pernah menyimpan pengaturan.
+func (r *PostgresRetrospectiveRepository) FindSettings(ctx context.Context, userID domain.UserID) (*domain.RetrospectiveSettings, error) {
+	settings := &domain.RetrospectiveSettings{UserID: userID}
+	err := r.dbpool.QueryRow(ctx, `SELECT enabled, time_zone, updated_at FROM retrospective_settings WHERE user_id = $1`, userID).
+		Scan(&settings.Enabled, &settings.TimeZone, &settings.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return settings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding retrospective settings for user_id %s: %w", userID, err)
+	}
+	return settings, nil
+}
+
+// SaveSettings melakukan upsert pengaturan retrospektif pengguna.
+func (r *PostgresRetrospectiveRepository) SaveSettings(ctx context.Context, settings *domain.RetrospectiveSettings) error {
+	query := `INSERT INTO retrospective_settings (user_id, enabled, time_zone, updated_at)
+	           VALUES ($1, $2, $3, $4)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET enabled = EXCLUDED.enabled, time_zone = EXCLUDED.time_zone, updated_at = EXCLUDED.updated_at`
+	_, err := r.dbpool.Exec(ctx, query, settings.UserID, settings.Enabled, settings.TimeZone, settings.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving retrospective settings for user_id %s: %w", settings.UserID, err)
+	}
+	return nil
+}
+
+// FindEnabled mengembalikan pengaturan semua pengguna yang mengaktifkan retrospektif otomatis.
+func (r *PostgresRetrospectiveRepository) FindEnabled(ctx context.Context) ([]*domain.RetrospectiveSettings, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT user_id, enabled, time_zone, updated_at FROM retrospective_settings WHERE enabled`)
+	if err != nil {
+		return nil, fmt.Errorf("error finding enabled retrospective settings: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*domain.RetrospectiveSettings
+	for rows.Next() {
+		settings := &domain.RetrospectiveSettings{}
+		if err := rows.Scan(&settings.UserID, &settings.Enabled, &settings.TimeZone, &settings.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning retrospective settings: %w", err)
+		}
+		result = append(result, settings)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating retrospective settings: %w", err)
+	}
+	return result, nil
+}
+
+// Save menyimpan retrospektif sebagai JSON; retrospektif bulan yang sama diganti.
+func (r *PostgresRetrospectiveRepository) Save(ctx context.Context, retro *domain.Retrospective) error {
+	report, err := json.Marshal(retro)
+	if err != nil {
+		return fmt.Errorf("error encoding retrospective: %w", err)
+	}
+	query := `INSERT INTO retrospectives (user_id, month, report, generated_at)
+	           VALUES ($1, $2, $3::jsonb, $4)
+	           ON CONFLICT (user_id, month) DO UPDATE
+	           SET report = EXCLUDED.report, generated_at = EXCLUDED.generated_at`
+	_, err = r.dbpool.Exec(ctx, query, retro.UserID, retro.Month.Format("2006-01"), report, retro.GeneratedAt)
+	if err != nil {
+		return fmt.Errorf("error saving retrospective for user_id %s: %w", retro.UserID, err)
+	}
+	return nil
+}
+
+// Find mencari retrospektif tersimpan untuk bulan month (YYYY-MM).
+func (r *PostgresRetrospectiveRepository) Find(ctx context.Context, userID domain.UserID, month string) (*domain.Retrospective, error) {
+	var report []byte
+	err := r.dbpool.QueryRow(ctx, `SELECT report FROM retrospectives WHERE user_id = $1 AND month = $2`, userID, month).Scan(&report)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrRetrospectiveNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding retrospective %s for user_id %s: %w", month, userID, err)
+	}
+	retro := &domain.Retrospective{}
+	if err := json.Unmarshal(report, retro); err != nil {
+		return nil, fmt.Errorf("error decoding retrospective: %w", err)
+	}
+	return retro, nil
+}
+
+// ListMonths mengembalikan bulan yang retrospektifnya tersimpan, yang terbaru lebih dulu.
+func (r *PostgresRetrospectiveRepository) ListMonths(ctx context.Context, userID domain.UserID) ([]string, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT month FROM retrospectives WHERE user_id = $1 ORDER BY month DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing retrospectives for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	months := []string{}
+	for rows.Next() {
+		var month string
+		if err := rows.Scan(&month); err != nil {
+			return nil, fmt.Errorf("error scanning retrospective month: %w", err)
+		}
+		months = append(months, month)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating retrospective months: %w", err)
+	}
+	return months, nil
+}
@@ -0,0 +1,80 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_role_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresRoleRepository adalah implementasi domain.RoleRepository menggunakan tabel user_roles.
+type PostgresRoleRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresRoleRepository adalah constructor untuk PostgresRoleRepository.
+func NewPostgresRoleRepository(dbpool *pgxpool.Pool) domain.RoleRepository {
+	return &PostgresRoleRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindRole mengembalikan domain.RoleUser jika pengguna tidak memiliki baris di user_roles.
+func (r *PostgresRoleRepository) FindRole(ctx context.Context, userID domain.UserID) (domain.Role, error) {
+	var role domain.Role
+	err := r.dbpool.QueryRow(ctx, `SELECT role FROM user_roles WHERE user_id = $1`, userID).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.RoleUser, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error finding role of user %s: %w", userID, err)
+	}
+	return role, nil
+}
+
+// ListRoles mengembalikan semua baris user_roles.
+func (r *PostgresRoleRepository) ListRoles(ctx context.Context) ([]domain.UserRole, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT user_id, role, granted_by, granted_at FROM user_roles ORDER BY user_id`)
+	if err != nil {
+		return nil, fmt.Errorf("error listing user roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []domain.UserRole
+	for rows.Next() {
+		var role domain.UserRole
+		if err := rows.Scan(&role.UserID, &role.Role, &role.GrantedBy, &role.GrantedAt); err != nil {
+			return nil, fmt.Errorf("error scanning user role row: %w", err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user role rows: %w", err)
+	}
+	return roles, nil
+}
+
+// SetRole menyimpan role dengan upsert.
+func (r *PostgresRoleRepository) SetRole(ctx context.Context, role domain.UserRole) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO user_roles (user_id, role, granted_by, granted_at)
+	           VALUES ($1, $2, $3, $4)
+	           ON CONFLICT (user_id) DO UPDATE SET role = EXCLUDED.role, granted_by = EXCLUDED.granted_by, granted_at = EXCLUDED.granted_at`,
+		role.UserID, role.Role, role.GrantedBy, role.GrantedAt)
+	if err != nil {
+		return fmt.Errorf("error setting role of user %s: %w", role.UserID, err)
+	}
+	return nil
+}
+
+// DeleteRole menghapus baris user_roles; pengguna tanpa baris diabaikan.
+func (r *PostgresRoleRepository) DeleteRole(ctx context.Context, userID domain.UserID) error {
+	_, err := r.dbpool.Exec(ctx, `DELETE FROM user_roles WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting role of user %s: %w", userID, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_security_audit_repository.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresSecurityAuditRepository adalah implementasi domain.SecurityAuditRepository menggunakan
+// tabel security_audit_log dan user_last_ips.
+type PostgresSecurityAuditRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresSecurityAuditRepository adalah constructor untuk PostgresSecurityAuditRepository.
+func NewPostgresSecurityAuditRepository(dbpool *pgxpool.Pool) domain.SecurityAuditRepository {
+	return &PostgresSecurityAuditRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Record menyimpan satu entri audit log keamanan.
+func (r *PostgresSecurityAuditRepository) Record(ctx context.Context, event domain.SecurityEvent) error {
+	details, err := json.Marshal(event.Details)
+	if err != nil {
+		return fmt.Errorf("error encoding security event details: %w", err)
+	}
+	_, err = r.dbpool.Exec(ctx, `INSERT INTO security_audit_log (event_type, user_id, ip, user_agent, details, occurred_at)
+	           VALUES ($1, $2, $3, $4, $5::jsonb, $6)`,
+		event.Type, event.UserID, event.IP, event.UserAgent, string(details), event.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("error recording security event %s: %w", event.Type, err)
+	}
+	return nil
+}
+
+// Find memakai index per user_id atau per ip jika filter tersebut diisi.
+func (r *PostgresSecurityAuditRepository) Find(ctx context.Context, filter domain.SecurityEventFilter) ([]domain.SecurityEvent, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT id, event_type, user_id, ip, user_agent, details, occurred_at
+	           FROM security_audit_log
+	           WHERE ($1 = '' OR user_id = $1) AND ($2 = '' OR event_type = $2) AND ($3 = '' OR ip = $3)
+	             AND ($4::bigint = 0 OR id < $4)
+	           ORDER BY id DESC LIMIT $5`,
+		filter.UserID, filter.Type, filter.IP, filter.BeforeID, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding security events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.SecurityEvent
+	for rows.Next() {
+		var event domain.SecurityEvent
+		var details []byte
+		if err := rows.Scan(&event.ID, &event.Type, &event.UserID, &event.IP, &event.UserAgent, &details, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("error scanning security event row: %w", err)
+		}
+		if err := json.Unmarshal(details, &event.Details); err != nil {
+			return nil, fmt.Errorf("error decoding security event details %d: %w", event.ID, err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating security event rows: %w", err)
+	}
+	return events, nil
+}
+
+// SwapLastIP membaca IP sebelumnya dari snapshot awal statement, sebelum upsert menggantinya.
+func (r *PostgresSecurityAuditRepository) SwapLastIP(ctx context.Context, userID domain.UserID, ip string, seenAt time.Time) (string, error) {
+	var previous string
+	err := r.dbpool.QueryRow(ctx, `WITH previous AS (SELECT ip FROM user_last_ips WHERE user_id = $1)
+	           INSERT INTO user_last_ips (user_id, ip, seen_at) VALUES ($1, $2, $3)
+	           ON CONFLICT (user_id) DO UPDATE SET ip = EXCLUDED.ip, seen_at = EXCLUDED.seen_at
+	           RETURNING COALESCE((SELECT ip FROM previous), '')`,
+		userID, ip, seenAt).Scan(&previous)
+	if err != nil {
+		return "", fmt.Errorf("error storing last ip of user_id %s: %w", userID, err)
+	}
+	return previous, nil
+}
+
+// DeleteBefore memakai index occurred_at, sehingga aman dijalankan berkala pada tabel besar.
+func (r *PostgresSecurityAuditRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM security_audit_log WHERE occurred_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("error purging security events: %w", err)
+	}
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM user_last_ips WHERE seen_at < $1`, before); err != nil {
+		return 0, fmt.Errorf("error purging last ips: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,187 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_slack_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// slackChannelColumns adalah daftar kolom yang dibaca untuk setiap pengaturan Slack,
+// sesuai urutan Scan di scanSlackChannel.
+const slackChannelColumns = `user_id, webhook_url, bot_token, channel_id, event_types, reminder_lead_seconds, created_at, updated_at`
+
+func scanSlackChannel(row pgx.Row) (*domain.SlackChannel, error) {
+	channel := &domain.SlackChannel{}
+	var (
+		eventTypes  []string
+		leadSeconds int
+	)
+	err := row.Scan(
+		&channel.UserID,
+		&channel.WebhookURL,
+		&channel.BotToken,
+		&channel.ChannelID,
+		&eventTypes,
+		&leadSeconds,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		channel.EventTypes = append(channel.EventTypes, domain.SlackEventType(eventType))
+	}
+	channel.ReminderLead = time.Duration(leadSeconds) * time.Second
+	return channel, nil
+}
+
+// PostgresSlackChannelRepository adalah implementasi domain.SlackChannelRepository menggunakan
+// tabel slack_channels, slack_task_completions, dan task_due_reminders (channel 'slack').
+type PostgresSlackChannelRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresSlackChannelRepository adalah constructor untuk PostgresSlackChannelRepository.
+func NewPostgresSlackChannelRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.SlackChannelRepository {
+	return &PostgresSlackChannelRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// FindByUserID mencari pengaturan Slack milik pengguna.
+func (r *PostgresSlackChannelRepository) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.SlackChannel, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+slackChannelColumns+` FROM slack_channels WHERE user_id = $1`, userID)
+	channel, err := scanSlackChannel(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrSlackChannelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding slack channel for user_id %s: %w", userID, err)
+	}
+	return channel, nil
+}
+
+// Save melakukan upsert pengaturan Slack; created_at dipertahankan saat pengaturan diganti.
+func (r *PostgresSlackChannelRepository) Save(ctx context.Context, channel *domain.SlackChannel) error {
+	eventTypes := make([]string, 0, len(channel.EventTypes))
+	for _, eventType := range channel.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	query := `INSERT INTO slack_channels (` + slackChannelColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+	           ON CONFLICT (user_id) DO UPDATE
+	           SET webhook_url = EXCLUDED.webhook_url,
+	               bot_token = EXCLUDED.bot_token,
+	               channel_id = EXCLUDED.channel_id,
+	               event_types = EXCLUDED.event_types,
+	               reminder_lead_seconds = EXCLUDED.reminder_lead_seconds,
+	               updated_at = EXCLUDED.updated_at
+	           RETURNING created_at`
+	err := r.dbpool.QueryRow(ctx, query,
+		channel.UserID,
+		channel.WebhookURL,
+		channel.BotToken,
+		channel.ChannelID,
+		eventTypes,
+		int(channel.ReminderLead/time.Second),
+		channel.UpdatedAt,
+	).Scan(&channel.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving slack channel for user_id %s: %w", channel.UserID, err)
+	}
+	return nil
+}
+
+// Delete menghapus pengaturan Slack milik pengguna.
+func (r *PostgresSlackChannelRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM slack_channels WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting slack channel for user_id %s: %w", userID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrSlackChannelNotFound
+	}
+	return nil
+}
+
+// FindDueReminders sama dengan versi email, dengan jarak pengingat dari slack_channels yang
+// berlangganan 'task.due'.
+func (r *PostgresSlackChannelRepository) FindDueReminders(ctx context.Context, now time.Time, limit int) ([]domain.SlackReminder, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskColumns+` FROM tasks
+	           WHERE NOT completed AND NOT archived AND due_at > $1
+	             AND (snoozed_until IS NULL OR snoozed_until <= $1)
+	             AND due_at <= $1 + (SELECT make_interval(secs => s.reminder_lead_seconds) FROM slack_channels s
+	                                 WHERE s.user_id = tasks.user_id AND s.reminder_lead_seconds > 0
+	                                   AND 'task.due' = ANY(s.event_types))
+	             AND NOT EXISTS (SELECT 1 FROM task_due_reminders r
+	                             WHERE r.task_id = tasks.id AND r.due_at = tasks.due_at AND r.channel = 'slack')
+	           ORDER BY due_at, id
+	           LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding due slack reminders: %w", err)
+	}
+	tasks, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make(map[domain.UserID]*domain.SlackChannel)
+	reminders := make([]domain.SlackReminder, 0, len(tasks))
+	for _, task := range tasks {
+		channel, ok := channels[task.UserID]
+		if !ok {
+			channel, err = r.FindByUserID(ctx, task.UserID)
+			if errors.Is(err, domain.ErrSlackChannelNotFound) { // Dihapus setelah query pertama
+				channels[task.UserID] = nil
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			channels[task.UserID] = channel
+		}
+		if channel != nil {
+			reminders = append(reminders, domain.SlackReminder{Channel: channel, Task: task})
+		}
+	}
+	return reminders, nil
+}
+
+// ClaimReminder memakai primary key (task_id, due_at, channel) sebagai kunci klaim.
+func (r *PostgresSlackChannelRepository) ClaimReminder(ctx context.Context, taskID string, dueAt, now time.Time) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx, `INSERT INTO task_due_reminders (task_id, due_at, channel, claimed_at)
+	           VALUES ($1, $2, 'slack', $3) ON CONFLICT DO NOTHING`, taskID, dueAt, now)
+	if err != nil {
+		return false, fmt.Errorf("error claiming slack reminder for task %s: %w", taskID, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ReleaseReminder menghapus klaim pengingat Slack.
+func (r *PostgresSlackChannelRepository) ReleaseReminder(ctx context.Context, taskID string, dueAt time.Time) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM task_due_reminders WHERE task_id = $1 AND due_at = $2 AND channel = 'slack'`, taskID, dueAt); err != nil {
+		return fmt.Errorf("error releasing slack reminder for task %s: %w", taskID, err)
+	}
+	return nil
+}
+
+// ClaimCompletion mengganti completed_at terakhir yang dikirim. Klausa WHERE membuat upsert
+// dilewati (0 baris) jika penyelesaian yang sama sudah diklaim.
+func (r *PostgresSlackChannelRepository) ClaimCompletion(ctx context.Context, taskID string, completedAt time.Time) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx, `INSERT INTO slack_task_completions (task_id, completed_at) VALUES ($1, $2)
+	           ON CONFLICT (task_id) DO UPDATE SET completed_at = EXCLUDED.completed_at
+	           WHERE slack_task_completions.completed_at <> EXCLUDED.completed_at`, taskID, completedAt)
+	if err != nil {
+		return false, fmt.Errorf("error claiming slack completion for task %s: %w", taskID, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
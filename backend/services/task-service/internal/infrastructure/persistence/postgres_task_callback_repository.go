@@ -0,0 +1,114 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_task_callback_repository.go
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// taskCallbackColumns adalah daftar kolom yang dibaca untuk setiap callback, sesuai urutan Scan di
+// scanTaskCallback.
+const taskCallbackColumns = `id, user_id, task_id, url, secret, created_at`
+
+func scanTaskCallback(row pgx.Row) (*domain.TaskCallback, error) {
+	callback := &domain.TaskCallback{}
+	err := row.Scan(
+		&callback.ID,
+		&callback.UserID,
+		&callback.TaskID,
+		&callback.URL,
+		&callback.Secret,
+		&callback.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return callback, nil
+}
+
+// collectTaskCallbacks membaca seluruh baris hasil query menjadi slice callback dan menutup rows.
+func collectTaskCallbacks(rows pgx.Rows) ([]*domain.TaskCallback, error) {
+	defer rows.Close()
+
+	var callbacks []*domain.TaskCallback
+	for rows.Next() {
+		callback, err := scanTaskCallback(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning task callback row: %w", err)
+		}
+		callbacks = append(callbacks, callback)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task callback rows: %w", err)
+	}
+	return callbacks, nil
+}
+
+// PostgresTaskCallbackRepository adalah implementasi domain.TaskCallbackRepository menggunakan
+// tabel task_callbacks.
+type PostgresTaskCallbackRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresTaskCallbackRepository adalah constructor untuk PostgresTaskCallbackRepository.
+func NewPostgresTaskCallbackRepository(dbpool *pgxpool.Pool) domain.TaskCallbackRepository {
+	return &PostgresTaskCallbackRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Save menyimpan callback baru.
+func (r *PostgresTaskCallbackRepository) Save(ctx context.Context, callback *domain.TaskCallback) error {
+	query := `INSERT INTO task_callbacks (` + taskCallbackColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := r.dbpool.Exec(ctx, query,
+		callback.ID,
+		callback.UserID,
+		callback.TaskID,
+		callback.URL,
+		callback.Secret,
+		callback.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving callback for task %s: %w", callback.TaskID, err)
+	}
+	return nil
+}
+
+// FindByTaskID mengembalikan callback yang menunggu pada task.
+func (r *PostgresTaskCallbackRepository) FindByTaskID(ctx context.Context, taskID string) ([]*domain.TaskCallback, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskCallbackColumns+`
+	           FROM task_callbacks WHERE task_id = $1 ORDER BY created_at, id`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding callbacks for task %s: %w", taskID, err)
+	}
+	return collectTaskCallbacks(rows)
+}
+
+// ClaimByTaskID memakai DELETE ... RETURNING, sehingga dua pemanggil bersamaan tidak pernah
+// mendapat callback yang sama.
+func (r *PostgresTaskCallbackRepository) ClaimByTaskID(ctx context.Context, taskID string) ([]*domain.TaskCallback, error) {
+	rows, err := r.dbpool.Query(ctx, `DELETE FROM task_callbacks WHERE task_id = $1
+	           RETURNING `+taskCallbackColumns, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming callbacks for task %s: %w", taskID, err)
+	}
+	return collectTaskCallbacks(rows)
+}
+
+// Delete menghapus callback milik pengguna pada task.
+func (r *PostgresTaskCallbackRepository) Delete(ctx context.Context, userID domain.UserID, taskID, id string) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM task_callbacks WHERE id = $1 AND task_id = $2 AND user_id = $3`,
+		id, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting task callback %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTaskCallbackNotFound
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_task_comment_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// taskCommentColumns adalah daftar kolom yang dibaca untuk setiap komentar (alias tabel c), sesuai
+// urutan Scan di scanTaskComment. Mention dibaca sebagai array dari task_comment_mentions.
+const taskCommentColumns = `c.id, c.task_id, c.author_id, c.body, c.created_at,
+	ARRAY(SELECT m.user_id FROM task_comment_mentions m WHERE m.comment_id = c.id ORDER BY m.user_id)`
+
+func scanTaskComment(row pgx.Row, cipher domain.FieldCipher) (*domain.TaskComment, error) {
+	comment := &domain.TaskComment{}
+	var mentions []string
+	err := row.Scan(
+		&comment.ID,
+		&comment.TaskID,
+		&comment.AuthorID,
+		&comment.Body,
+		&comment.CreatedAt,
+		&mentions,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, mention := range mentions {
+		comment.Mentions = append(comment.Mentions, domain.UserID(mention))
+	}
+	if err := decryptComment(cipher, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// PostgresTaskCommentRepository adalah implementasi domain.TaskCommentRepository menggunakan tabel
+// task_comments dan task_comment_mentions.
+type PostgresTaskCommentRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresTaskCommentRepository adalah constructor untuk PostgresTaskCommentRepository.
+func NewPostgresTaskCommentRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.TaskCommentRepository {
+	return &PostgresTaskCommentRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// Save menyimpan komentar dan mention-nya dalam satu transaksi menggunakan pgx.Batch.
+func (r *PostgresTaskCommentRepository) Save(ctx context.Context, comment *domain.TaskComment) error {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting comment transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	body, err := encryptCommentBody(r.cipher, comment)
+	if err != nil {
+		return err
+	}
+	batch := &pgx.Batch{}
+	batch.Queue(`INSERT INTO task_comments (id, task_id, author_id, body, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		comment.ID, comment.TaskID, comment.AuthorID, body, comment.CreatedAt)
+	for _, mention := range comment.Mentions {
+		batch.Queue(`INSERT INTO task_comment_mentions (comment_id, user_id, created_at) VALUES ($1, $2, $3)
+		             ON CONFLICT DO NOTHING`,
+			comment.ID, mention, comment.CreatedAt)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("error saving comment %s: %w", comment.ID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing comment transaction: %w", err)
+	}
+	return nil
+}
+
+// FindByID mencari komentar berdasarkan ID.
+func (r *PostgresTaskCommentRepository) FindByID(ctx context.Context, id string) (*domain.TaskComment, error) {
+	comment, err := scanTaskComment(r.dbpool.QueryRow(ctx, `SELECT `+taskCommentColumns+` FROM task_comments c WHERE c.id = $1`, id), r.cipher)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrCommentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding comment %s: %w", id, err)
+	}
+	return comment, nil
+}
+
+// FindByTaskID memakai index idx_task_comments_task_id.
+func (r *PostgresTaskCommentRepository) FindByTaskID(ctx context.Context, taskID string) ([]*domain.TaskComment, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskCommentColumns+`
+	           FROM task_comments c WHERE c.task_id = $1 ORDER BY c.created_at, c.id`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding comments for task %s: %w", taskID, err)
+	}
+	return collectTaskComments(rows, r.cipher)
+}
+
+// FindByTaskIDs mengambil komentar beberapa task dengan satu query.
+func (r *PostgresTaskCommentRepository) FindByTaskIDs(ctx context.Context, taskIDs []string) ([]*domain.TaskComment, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskCommentColumns+`
+	           FROM task_comments c WHERE c.task_id = ANY($1::text[]) ORDER BY c.created_at, c.id`, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error finding comments for tasks: %w", err)
+	}
+	return collectTaskComments(rows, r.cipher)
+}
+
+// FindMentioning memakai index idx_task_comment_mentions_user_id. Akses dibaca ulang dari
+// list_shares agar mention di daftar yang sudah tidak dibagikan tidak ikut.
+func (r *PostgresTaskCommentRepository) FindMentioning(ctx context.Context, userID domain.UserID, limit int) ([]*domain.TaskComment, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskCommentColumns+`
+	           FROM task_comment_mentions mention
+	           JOIN task_comments c ON c.id = mention.comment_id
+	           JOIN tasks t ON t.id = c.task_id
+	           WHERE mention.user_id = $1
+	             AND (t.user_id = $1 OR EXISTS (SELECT 1 FROM list_shares WHERE owner_id = t.user_id AND collaborator_id = $1))
+	           ORDER BY mention.created_at DESC, c.id DESC
+	           LIMIT $2`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding comments mentioning %s: %w", userID, err)
+	}
+	return collectTaskComments(rows, r.cipher)
+}
+
+// FindByAuthor tidak memeriksa akses daftar, karena komentar tetap milik penulisnya.
+func (r *PostgresTaskCommentRepository) FindByAuthor(ctx context.Context, authorID domain.UserID, limit int) ([]*domain.TaskComment, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+taskCommentColumns+`
+	           FROM task_comments c
+	           WHERE c.author_id = $1
+	           ORDER BY c.created_at DESC, c.id DESC
+	           LIMIT $2`, authorID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding comments by %s: %w", authorID, err)
+	}
+	return collectTaskComments(rows, r.cipher)
+}
+
+// RestoreComments menyisipkan komentar dalam satu transaksi menggunakan pgx.Batch. Mention hanya
+// disisipkan untuk komentar yang baru disisipkan, sehingga komentar lain dengan ID yang sama tidak
+// ikut berubah.
+func (r *PostgresTaskCommentRepository) RestoreComments(ctx context.Context, ownerID domain.UserID, comments []*domain.TaskComment) (int64, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error starting comment restore transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	batch := &pgx.Batch{}
+	for _, comment := range comments {
+		mentions := make([]string, len(comment.Mentions))
+		for i, mention := range comment.Mentions {
+			mentions[i] = string(mention)
+		}
+		body, err := encryptCommentBody(r.cipher, comment)
+		if err != nil {
+			return 0, err
+		}
+		batch.Queue(`WITH inserted AS (
+		               INSERT INTO task_comments (id, task_id, author_id, body, created_at)
+		               SELECT $1, $2, $3, $4, $5
+		               WHERE EXISTS (SELECT 1 FROM tasks WHERE id = $2 AND user_id = $7)
+		                 AND ($3::text = $7 OR EXISTS (SELECT 1 FROM list_shares WHERE owner_id = $7 AND collaborator_id = $3))
+		               ON CONFLICT (id) DO NOTHING
+		               RETURNING id, created_at
+		           ), mentions AS (
+		               INSERT INTO task_comment_mentions (comment_id, user_id, created_at)
+		               SELECT inserted.id, mention, inserted.created_at FROM inserted, unnest($6::text[]) AS mention
+		               ON CONFLICT DO NOTHING
+		           )
+		           SELECT COUNT(*) FROM inserted`,
+			comment.ID, comment.TaskID, comment.AuthorID, body, comment.CreatedAt, mentions, ownerID)
+	}
+	results := tx.SendBatch(ctx, batch)
+	var inserted int64
+	for _, comment := range comments {
+		var n int64
+		if err := results.QueryRow().Scan(&n); err != nil {
+			results.Close()
+			return 0, fmt.Errorf("error restoring comment %s: %w", comment.ID, err)
+		}
+		inserted += n
+	}
+	if err := results.Close(); err != nil {
+		return 0, fmt.Errorf("error restoring comments: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("error committing comment restore transaction: %w", err)
+	}
+	return inserted, nil
+}
+
+func collectTaskComments(rows pgx.Rows, cipher domain.FieldCipher) ([]*domain.TaskComment, error) {
+	defer rows.Close()
+
+	var comments []*domain.TaskComment
+	for rows.Next() {
+		comment, err := scanTaskComment(rows, cipher)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning comment row: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating comment rows: %w", err)
+	}
+	return comments, nil
+}
+
+// Delete menghapus komentar; mention ikut terhapus lewat ON DELETE CASCADE.
+func (r *PostgresTaskCommentRepository) Delete(ctx context.Context, id string) error {
+	cmdTag, err := r.dbpool.Exec(ctx, `DELETE FROM task_comments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("error deleting comment %s: %w", id, err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return domain.ErrCommentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_task_history_repository.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// revisionColumns adalah daftar kolom yang dibaca untuk setiap revisi, sesuai urutan Scan di scanRevision.
+const revisionColumns = `task_id, revision, actor_id, changes, snapshot, occurred_at`
+
+func scanRevision(row pgx.Row, cipher domain.FieldCipher) (*domain.TaskRevision, error) {
+	revision := &domain.TaskRevision{}
+	var changes, snapshot []byte
+	if err := row.Scan(&revision.TaskID, &revision.Revision, &revision.ActorID, &changes, &snapshot, &revision.OccurredAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(changes, &revision.Changes); err != nil {
+		return nil, fmt.Errorf("error decoding revision changes: %w", err)
+	}
+	if err := json.Unmarshal(snapshot, &revision.Snapshot); err != nil {
+		return nil, fmt.Errorf("error decoding revision snapshot: %w", err)
+	}
+	if err := decryptRevision(cipher, revision); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// PostgresTaskHistoryRepository adalah implementasi domain.TaskHistoryRepository menggunakan
+// tabel task_revisions, yang diisi oleh trigger trg_tasks_revisions.
+type PostgresTaskHistoryRepository struct {
+	dbpool *pgxpool.Pool
+	cipher domain.FieldCipher
+}
+
+// NewPostgresTaskHistoryRepository adalah constructor untuk PostgresTaskHistoryRepository.
+func NewPostgresTaskHistoryRepository(dbpool *pgxpool.Pool, cipher domain.FieldCipher) domain.TaskHistoryRepository {
+	return &PostgresTaskHistoryRepository{
+		dbpool: dbpool,
+		cipher: cipher,
+	}
+}
+
+// FindByTaskID mencari semua revisi task, dari yang terbaru.
+func (r *PostgresTaskHistoryRepository) FindByTaskID(ctx context.Context, taskID string) ([]domain.TaskRevision, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+revisionColumns+`
+	           FROM task_revisions WHERE task_id = $1 ORDER BY revision DESC`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding revisions for task %s: %w", taskID, err)
+	}
+	defer rows.Close()
+
+	var revisions []domain.TaskRevision
+	for rows.Next() {
+		revision, err := scanRevision(rows, r.cipher)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning revision row: %w", err)
+		}
+		revisions = append(revisions, *revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating revision rows: %w", err)
+	}
+	return revisions, nil
+}
+
+// FindRevision mencari satu revisi task.
+func (r *PostgresTaskHistoryRepository) FindRevision(ctx context.Context, taskID string, revision int) (*domain.TaskRevision, error) {
+	rev, err := scanRevision(r.dbpool.QueryRow(ctx, `SELECT `+revisionColumns+`
+	           FROM task_revisions WHERE task_id = $1 AND revision = $2`, taskID, revision), r.cipher)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("error finding revision %d of task %s: %w", revision, taskID, err)
+	}
+	return rev, nil
+}
@@ -2,26 +2,111 @@
 package persistence
 
 import (
+	"cmp"
 	"context"
 	"errors" // Pastikan ini diimpor
 	"fmt"    // Untuk error wrapping
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain" // Sesuaikan path module Anda
-	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// taskColumns adalah daftar kolom yang dibaca untuk setiap task.
+// Urutannya harus sama dengan urutan Scan di scanTask.
+const taskColumns = `id, user_id, title, description, completed, completed_at, position, created_at, updated_at, estimate_minutes, snoozed_until, archived, column_id, priority, due_at, due_text, assignee_id, color, icon`
+
+// scanTask membaca satu baris hasil query (dengan kolom taskColumns) menjadi domain.Task dan
+// mendekripsi deskripsinya.
+func scanTask(row pgx.Row, cipher domain.FieldCipher) (*domain.Task, error) {
+	task := &domain.Task{}
+	if err := row.Scan(taskScanTargets(task)...); err != nil {
+		return nil, err
+	}
+	if err := decryptTask(cipher, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// taskScanTargets mengembalikan tujuan Scan untuk kolom taskColumns, agar query yang membaca
+// kolom tambahan sebelum taskColumns tetap memakai urutan yang sama dengan scanTask.
+func taskScanTargets(task *domain.Task) []any {
+	return []any{
+		&task.ID,
+		&task.UserID,
+		&task.Title,
+		&task.Description,
+		&task.Completed,
+		&task.CompletedAt,
+		&task.Position,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+		&task.EstimateMinutes,
+		&task.SnoozedUntil,
+		&task.Archived,
+		&task.ColumnID,
+		&task.Priority,
+		&task.DueAt,
+		&task.DueText,
+		&task.AssigneeID,
+		&task.Color,
+		&task.Icon,
+	}
+}
+
+// collectTasks membaca seluruh baris hasil query menjadi slice task dan menutup rows.
+func collectTasks(rows pgx.Rows, cipher domain.FieldCipher) ([]*domain.Task, error) {
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows, cipher)
+		if err != nil {
+			// Sebaiknya log error ini dan mungkin skip task yang error, atau batalkan semua
+			return nil, fmt.Errorf("error scanning task row: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task rows: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// positionStep adalah jarak Position antar task saat urutan ditulis ulang atau task baru ditambahkan.
+const positionStep = 1024
+
+// minPositionGap adalah selisih Position terkecil yang masih dipecah dua saat memindahkan task.
+// Di bawah nilai ini presisi float64 mulai habis, sehingga posisi milik pengguna ditulis ulang dulu.
+const minPositionGap = 1e-6
+
+// topPositionSQL menghitung Position task baru: tepat di atas task paling atas milik pengguna ($2),
+// sehingga task baru tampil paling atas seperti pada urutan default.
+const topPositionSQL = `COALESCE((SELECT MIN(position) FROM tasks WHERE user_id = $2), 1024) - 1024`
+
 // PostgresTaskRepository adalah implementasi dari domain.TaskRepository menggunakan PostgreSQL.
 type PostgresTaskRepository struct {
 	dbpool *pgxpool.Pool
+	idGen  domain.IDGenerator
+	cipher domain.FieldCipher
 }
 
 // NewPostgresTaskRepository adalah constructor untuk PostgresTaskRepository.
-func NewPostgresTaskRepository(dbpool *pgxpool.Pool) domain.TaskRepository {
+// idGen menentukan format ID task baru dan strategi keyset pagination. cipher mengenkripsi
+// deskripsi sebelum ditulis dan mendekripsinya saat dibaca.
+func NewPostgresTaskRepository(dbpool *pgxpool.Pool, idGen domain.IDGenerator, cipher domain.FieldCipher) domain.TaskRepository {
 	return &PostgresTaskRepository{
 		dbpool: dbpool,
+		idGen:  idGen,
+		cipher: cipher,
 	}
 }
 
@@ -30,22 +115,37 @@ func (r *PostgresTaskRepository) Save(ctx context.Context, task *domain.Task) er
 	// Generate ID baru jika belum ada (best practice: biarkan DB generate jika memungkinkan,
 	// atau generate di aplikasi sebelum insert untuk konsistensi)
 	if task.ID == "" {
-		task.ID = uuid.NewString()
+		task.ID = r.idGen.NewID()
+	}
+	description, err := encryptDescription(r.cipher, task)
+	if err != nil {
+		return err
 	}
 
-	query := `INSERT INTO tasks (id, user_id, title, description, completed, created_at, updated_at)
-	           VALUES ($1, $2, $3, $4, $5, $6, $7)`
-	_, err := r.dbpool.Exec(ctx, query,
+	query := `INSERT INTO tasks (id, user_id, title, description, completed, completed_at, position, created_at, updated_at, estimate_minutes, priority, due_at, due_text, color, icon)
+	           VALUES ($1, $2, $3, $4, $5, $6, ` + topPositionSQL + `, $7, $8, $9, $10, $11, $12, $13, $14)
+	           RETURNING position`
+	err = r.dbpool.QueryRow(ctx, query,
 		task.ID,
 		task.UserID,
 		task.Title,
-		task.Description,
+		description,
 		task.Completed,
+		task.CompletedAt,
 		task.CreatedAt,
 		task.UpdatedAt,
-	)
+		task.EstimateMinutes,
+		task.Priority,
+		task.DueAt,
+		task.DueText,
+		task.Color,
+		task.Icon,
+	).Scan(&task.Position)
 
 	if err != nil {
+		if isTaskLimitViolation(err) {
+			return domain.ErrTaskLimitReached
+		}
 		// Cek apakah ada error duplikasi Primary Key (jika ID sudah ada)
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // 23505 adalah kode error unique_violation
@@ -56,21 +156,65 @@ func (r *PostgresTaskRepository) Save(ctx context.Context, task *domain.Task) er
 	return nil
 }
 
+// SaveOrUpdate menyimpan task dengan INSERT ... ON CONFLICT (id) DO UPDATE.
+// Klausa WHERE pada DO UPDATE memastikan task milik pengguna lain tidak bisa ditimpa.
+// completed_at yang tersimpan dipertahankan jika task sudah selesai sebelumnya, agar push
+// yang diulang tidak menggeser waktu penyelesaian. position, estimate_minutes, priority, tenggat, warna, dan ikon hanya
+// diisi saat INSERT, karena klien sync lama tidak mengirimnya dan tidak boleh menghapusnya. Task yang
+// dibuka kembali lewat sync keluar dari arsip, sama seperti lewat UpdateTask.
+func (r *PostgresTaskRepository) SaveOrUpdate(ctx context.Context, task *domain.Task) (bool, error) {
+	description, err := encryptDescription(r.cipher, task)
+	if err != nil {
+		return false, err
+	}
+	query := `INSERT INTO tasks (id, user_id, title, description, completed, completed_at, position, created_at, updated_at, estimate_minutes, priority, due_at, due_text, color, icon)
+	           VALUES ($1, $2, $3, $4, $5, $6, ` + topPositionSQL + `, $7, $8, $9, $10, $11, $12, $13, $14)
+	           ON CONFLICT (id) DO UPDATE
+	           SET title = EXCLUDED.title, description = EXCLUDED.description,
+	               completed = EXCLUDED.completed,
+	               completed_at = CASE WHEN tasks.completed AND EXCLUDED.completed
+	                                   THEN tasks.completed_at ELSE EXCLUDED.completed_at END,
+	               archived = tasks.archived AND EXCLUDED.completed,
+	               updated_at = EXCLUDED.updated_at
+	           WHERE tasks.user_id = EXCLUDED.user_id
+	           RETURNING completed_at, position, created_at, estimate_minutes, snoozed_until, archived, column_id, priority, due_at, due_text, assignee_id, color, icon, (xmax = 0) AS inserted` // xmax = 0 berarti baris hasil INSERT, bukan UPDATE
+	var created bool
+	err = r.dbpool.QueryRow(ctx, query,
+		task.ID,
+		task.UserID,
+		task.Title,
+		description,
+		task.Completed,
+		task.CompletedAt,
+		task.CreatedAt,
+		task.UpdatedAt,
+		task.EstimateMinutes,
+		task.Priority,
+		task.DueAt,
+		task.DueText,
+		task.Color,
+		task.Icon,
+	).Scan(&task.CompletedAt, &task.Position, &task.CreatedAt, &task.EstimateMinutes, &task.SnoozedUntil, &task.Archived, &task.ColumnID, &task.Priority,
+		&task.DueAt, &task.DueText, &task.AssigneeID, &task.Color, &task.Icon, &created)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Konflik ID dengan task milik pengguna lain: DO UPDATE dilewati sehingga tidak ada baris.
+			return false, domain.ErrTaskUpdateConflict
+		}
+		if isTaskLimitViolation(err) {
+			return false, domain.ErrTaskLimitReached
+		}
+		return false, fmt.Errorf("error upserting task %s: %w", task.ID, err)
+	}
+	return created, nil
+}
+
 // FindByID mencari task berdasarkan ID uniknya.
 func (r *PostgresTaskRepository) FindByID(ctx context.Context, id string) (*domain.Task, error) {
-	query := `SELECT id, user_id, title, description, completed, created_at, updated_at
+	query := `SELECT ` + taskColumns + `
 	           FROM tasks WHERE id = $1`
-	task := &domain.Task{}
-	err := r.dbpool.QueryRow(ctx, query, id).Scan(
-		&task.ID,
-		&task.UserID,
-		&task.Title,
-		&task.Description,
-		&task.Completed,
-		&task.CreatedAt,
-		&task.UpdatedAt,
-	)
-
+	task, err := scanTask(r.dbpool.QueryRow(ctx, query, id), r.cipher)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrTaskNotFound
@@ -80,54 +224,520 @@ func (r *PostgresTaskRepository) FindByID(ctx context.Context, id string) (*doma
 	return task, nil
 }
 
+// FindByIDs mengambil task dengan satu query, misalnya untuk dataloader GraphQL.
+func (r *PostgresTaskRepository) FindByIDs(ctx context.Context, ids []string) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + `
+	           FROM tasks WHERE id = ANY($1::text[])`
+	rows, err := r.dbpool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("error finding tasks by ids: %w", err)
+	}
+	return collectTasks(rows, r.cipher)
+}
+
+// titleCollations memetakan subtag bahasa utama ke collation ICU yang dibuat oleh migrasi
+// 000013_create_task_title_collations. Nama collation tidak bisa dikirim sebagai parameter query,
+// sehingga hanya nilai dari map ini yang pernah masuk ke SQL.
+var titleCollations = map[string]string{
+	"de": "task_title_de",
+	"en": "task_title_en",
+	"es": "task_title_es",
+	"fr": "task_title_fr",
+	"id": "task_title_id",
+	"nl": "task_title_nl",
+	"sv": "task_title_sv",
+	"tr": "task_title_tr",
+}
+
+// defaultTitleCollation adalah collation root ICU (bahasa netral) untuk locale yang tidak didukung.
+const defaultTitleCollation = "task_title_und"
+
+// titleCollation memilih collation berdasarkan subtag bahasa utama locale, misalnya "id-ID" -> "id".
+func titleCollation(locale string) string {
+	language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	if collation, ok := titleCollations[strings.ToLower(language)]; ok {
+		return collation
+	}
+	return defaultTitleCollation
+}
+
+// visibleTaskCondition menyaring task untuk daftar task: $2 adalah batas snooze (NULL dari
+// snoozeCutoff dengan waktu nol berarti tidak disaring) dan $3 bernilai true untuk
+// menyembunyikan task yang diarsipkan.
+const visibleTaskCondition = `($2::timestamptz IS NULL OR snoozed_until IS NULL OR snoozed_until <= $2)
+	           AND NOT ($3::boolean AND archived)`
+
+// snoozeCutoff mengembalikan nilai parameter $2 untuk visibleTaskCondition.
+func snoozeCutoff(hideSnoozedAt time.Time) *time.Time {
+	if hideSnoozedAt.IsZero() {
+		return nil
+	}
+	return &hideSnoozedAt
+}
+
+// taskOrderBy mengembalikan klausa ORDER BY untuk order; default terbaru di atas.
+func taskOrderBy(order domain.TaskOrder) string {
+	switch order.Sort {
+	case domain.TaskSortPosition:
+		return `position, id`
+	case domain.TaskSortTitle:
+		return `title COLLATE "` + titleCollation(order.Locale) + `", id`
+	}
+	return `created_at DESC`
+}
+
 // FindByUserID mencari semua task yang dimiliki oleh pengguna tertentu.
-func (r *PostgresTaskRepository) FindByUserID(ctx context.Context, userID domain.UserID) ([]*domain.Task, error) {
-	query := `SELECT id, user_id, title, description, completed, created_at, updated_at
-	           FROM tasks WHERE user_id = $1 ORDER BY created_at DESC` // Urutkan berdasarkan terbaru
-	rows, err := r.dbpool.Query(ctx, query, userID)
+func (r *PostgresTaskRepository) FindByUserID(ctx context.Context, userID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + `
+	           FROM tasks WHERE user_id = $1 AND ` + visibleTaskCondition + ` ORDER BY ` + taskOrderBy(order)
+	rows, err := r.dbpool.Query(ctx, query, userID, snoozeCutoff(order.HideSnoozedAt), order.HideArchived)
 	if err != nil {
 		return nil, fmt.Errorf("error finding tasks by user_id %s: %w", userID, err)
 	}
+	return collectTasks(rows, r.cipher)
+}
+
+// Search memakai kolom search_vector (generated column, konfigurasi text search task_search).
+// Query melewati task_search_emoji_tokens yang sama dengan teks yang diindeks.
+func (r *PostgresTaskRepository) Search(ctx context.Context, userID domain.UserID, query string, limit int) ([]*domain.Task, error) {
+	sql := `SELECT ` + taskColumns + `
+	         FROM tasks, plainto_tsquery('task_search', task_search_emoji_tokens($2)) AS q
+	         WHERE user_id = $1 AND search_vector @@ q
+	         ORDER BY ts_rank(search_vector, q) DESC, updated_at DESC, id
+	         LIMIT $3`
+	rows, err := r.dbpool.Query(ctx, sql, userID, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error searching tasks for user_id %s: %w", userID, err)
+	}
+	return collectTasks(rows, r.cipher)
+}
+
+// CountByUserID menghitung jumlah task milik pengguna dari counter cache user_task_counters.
+func (r *PostgresTaskRepository) CountByUserID(ctx context.Context, userID domain.UserID) (int64, error) {
+	counters, err := r.CountersByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return counters.Total, nil
+}
+
+// taskLimitConstraint adalah nama constraint pada error yang dilempar trigger counter saat batas
+// user_task_counters.max_tasks terlewati.
+const taskLimitConstraint = "user_task_counters_max_tasks"
+
+func isTaskLimitViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.ConstraintName == taskLimitConstraint
+}
+
+// SetTaskLimit menyimpan batas di baris counter pengguna, yang dibuat lebih dulu jika belum ada.
+// Trigger counter memeriksanya setelah mengunci baris tersebut untuk setiap task baru.
+func (r *PostgresTaskRepository) SetTaskLimit(ctx context.Context, userID domain.UserID, maxTasks int64, expiresAt time.Time) error {
+	var expires *time.Time // NULL untuk pengguna permanen
+	if !expiresAt.IsZero() {
+		expires = &expiresAt
+	}
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO user_task_counters (user_id, max_tasks, expires_at) VALUES ($1, $2, $3)
+	           ON CONFLICT (user_id) DO UPDATE SET max_tasks = EXCLUDED.max_tasks, expires_at = EXCLUDED.expires_at`,
+		userID, maxTasks, expires)
+	if err != nil {
+		return fmt.Errorf("error setting task limit for user_id %s: %w", userID, err)
+	}
+	return nil
+}
+
+// FindExpiredTaskLimits memakai index parsial idx_user_task_counters_expires_at.
+func (r *PostgresTaskRepository) FindExpiredTaskLimits(ctx context.Context, now time.Time, limit int) ([]domain.UserID, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT user_id FROM user_task_counters
+	           WHERE expires_at IS NOT NULL AND expires_at <= $1
+	           ORDER BY expires_at LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding expired task limits: %w", err)
+	}
+	userIDs, err := pgx.CollectRows(rows, pgx.RowTo[domain.UserID])
+	if err != nil {
+		return nil, fmt.Errorf("error scanning expired task limits: %w", err)
+	}
+	return userIDs, nil
+}
+
+// CountVisibleByUserID memakai visibleTaskCondition yang sama dengan FindByUserID, sehingga hasilnya
+// selalu sama dengan jumlah task yang dikembalikan FindByUserID.
+func (r *PostgresTaskRepository) CountVisibleByUserID(ctx context.Context, userID domain.UserID, hideSnoozedAt time.Time, hideArchived bool) (int64, error) {
+	var count int64
+	err := r.dbpool.QueryRow(ctx, `SELECT count(*) FROM tasks WHERE user_id = $1 AND `+visibleTaskCondition,
+		userID, snoozeCutoff(hideSnoozedAt), hideArchived).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error counting tasks by user_id %s: %w", userID, err)
+	}
+	return count, nil
+}
+
+// CountersByUserID membaca counter cache user_task_counters yang dijaga oleh trigger pada tabel tasks.
+// Pengguna yang belum pernah punya task tidak memiliki baris, sehingga dianggap nol.
+func (r *PostgresTaskRepository) CountersByUserID(ctx context.Context, userID domain.UserID) (domain.TaskCounters, error) {
+	var counters domain.TaskCounters
+	err := r.dbpool.QueryRow(ctx, `SELECT total_tasks, open_tasks FROM user_task_counters WHERE user_id = $1`,
+		userID).Scan(&counters.Total, &counters.Open)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.TaskCounters{}, fmt.Errorf("error reading task counters for user_id %s: %w", userID, err)
+	}
+	return counters, nil
+}
+
+// FindCompletionStreak membaca tabel completion_streaks yang dijaga oleh trigger pada tabel tasks.
+// Trigger hanya berjalan saat task diselesaikan, sehingga streak yang putus (hari terakhir sebelum
+// kemarin) baru dinolkan di sini, memakai hari ini menurut zona waktu yang sama dengan trigger.
+func (r *PostgresTaskRepository) FindCompletionStreak(ctx context.Context, userID domain.UserID) (domain.CompletionStreak, error) {
+	var streak domain.CompletionStreak
+	err := r.dbpool.QueryRow(ctx, `SELECT CASE WHEN last_completed_on >= completion_streak_day(NOW(), user_id) - 1
+	                                           THEN current_streak ELSE 0 END,
+	                                      longest_streak, last_completed_on
+	                                 FROM completion_streaks WHERE user_id = $1`,
+		userID).Scan(&streak.Current, &streak.Longest, &streak.LastCompletedOn)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.CompletionStreak{}, fmt.Errorf("error reading completion streak for user_id %s: %w", userID, err)
+	}
+	return streak, nil
+}
+
+// FindPageByUserID mengambil satu halaman task milik pengguna, diurutkan dari yang terbaru.
+//
+// Jika ID bersifat sortable (UUIDv7/ULID), cursor cukup berupa ID terakhir dan query memakai
+// index (user_id, id COLLATE "C"). Untuk UUIDv4, cursor berisi (created_at, id) terakhir.
+// COLLATE "C" dipakai agar perbandingan ID berdasarkan byte, tidak terpengaruh locale database.
+func (r *PostgresTaskRepository) FindPageByUserID(ctx context.Context, userID domain.UserID, query domain.TaskPageQuery) (*domain.TaskPage, error) {
+	// Ambil satu baris ekstra untuk mengetahui apakah masih ada halaman berikutnya.
+	limit := query.Limit + 1
+	cutoff := snoozeCutoff(query.HideSnoozedAt)
+
+	var rows pgx.Rows
+	var err error
+	switch {
+	case query.Cursor == "" && r.idGen.Sortable():
+		rows, err = r.dbpool.Query(ctx, `SELECT `+taskColumns+`
+		           FROM tasks WHERE user_id = $1 AND `+visibleTaskCondition+`
+		           ORDER BY id COLLATE "C" DESC LIMIT $4`, userID, cutoff, query.HideArchived, limit)
+	case query.Cursor == "":
+		rows, err = r.dbpool.Query(ctx, `SELECT `+taskColumns+`
+		           FROM tasks WHERE user_id = $1 AND `+visibleTaskCondition+`
+		           ORDER BY created_at DESC, id DESC LIMIT $4`, userID, cutoff, query.HideArchived, limit)
+	case r.idGen.Sortable():
+		afterID, decodeErr := decodeIDCursor(query.Cursor)
+		if decodeErr != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		rows, err = r.dbpool.Query(ctx, `SELECT `+taskColumns+`
+		           FROM tasks WHERE user_id = $1 AND `+visibleTaskCondition+` AND id COLLATE "C" < $4
+		           ORDER BY id COLLATE "C" DESC LIMIT $5`, userID, cutoff, query.HideArchived, afterID, limit)
+	default:
+		afterCreatedAt, afterID, decodeErr := decodeTimeIDCursor(query.Cursor)
+		if decodeErr != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		rows, err = r.dbpool.Query(ctx, `SELECT `+taskColumns+`
+		           FROM tasks WHERE user_id = $1 AND `+visibleTaskCondition+` AND (created_at, id) < ($4, $5)
+		           ORDER BY created_at DESC, id DESC LIMIT $6`, userID, cutoff, query.HideArchived, afterCreatedAt, afterID, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding task page for user_id %s: %w", userID, err)
+	}
+
+	tasks, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.TaskPage{Tasks: tasks}
+	if len(tasks) > query.Limit {
+		page.Tasks = tasks[:query.Limit]
+		last := page.Tasks[len(page.Tasks)-1]
+		if r.idGen.Sortable() {
+			page.NextCursor = encodeIDCursor(last.ID)
+		} else {
+			page.NextCursor = encodeTimeIDCursor(last.CreatedAt, last.ID)
+		}
+	}
+	return page, nil
+}
+
+// taskGroupExpressions berisi ekspresi SQL key grup dan urutan grup untuk setiap domain.TaskGroupBy.
+// Grup kolom board diurutkan sesuai posisi kolom dan grup prioritas dari yang paling penting,
+// dengan task tanpa kolom atau tanpa prioritas di akhir.
+var taskGroupExpressions = map[domain.TaskGroupBy]struct{ key, order string }{
+	domain.TaskGroupByStatus: {
+		key:   `CASE WHEN completed THEN '` + domain.TaskGroupCompleted + `' ELSE '` + domain.TaskGroupOpen + `' END`,
+		order: `CASE WHEN completed THEN 1 ELSE 0 END`,
+	},
+	domain.TaskGroupByColumn: {
+		key:   `COALESCE(column_id, '` + domain.TaskGroupNone + `')`,
+		order: `COALESCE((SELECT c.position FROM board_columns c WHERE c.id = tasks.column_id), 2147483647)`,
+	},
+	domain.TaskGroupByPriority: {
+		key: `COALESCE(priority, '` + domain.TaskGroupNone + `')`,
+		order: `COALESCE(-(SELECT e.position FROM enum_values e
+		           WHERE e.enum_name = '` + string(domain.EnumTaskPriority) + `' AND e.user_id IN ('', tasks.user_id)
+		             AND e.value = tasks.priority ORDER BY e.user_id LIMIT 1), 2147483647)`,
+	},
+}
+
+// FindGroupsByUserID mengelompokkan task dalam satu query: COUNT OVER menghitung seluruh task per
+// grup sebelum cursor diterapkan, lalu ROW_NUMBER OVER membatasi jumlah task per grup.
+// Task di setiap grup diurutkan (created_at, id) dari yang terbaru, sehingga cursor berisi
+// (created_at, id) terakhir apa pun strategi ID-nya.
+func (r *PostgresTaskRepository) FindGroupsByUserID(ctx context.Context, userID domain.UserID, query domain.TaskGroupQuery) ([]domain.TaskGroup, error) {
+	expr, ok := taskGroupExpressions[query.GroupBy]
+	if !ok {
+		return nil, domain.ErrInvalidTaskGroupBy
+	}
+	var afterCreatedAt *time.Time
+	var afterID string
+	if query.Cursor != "" {
+		createdAt, id, err := decodeTimeIDCursor(query.Cursor)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		afterCreatedAt, afterID = &createdAt, id
+	}
+
+	sql := `WITH grouped AS (
+	           SELECT ` + taskColumns + `, ` + expr.key + ` AS group_key, ` + expr.order + ` AS group_order,
+	                  COUNT(*) OVER (PARTITION BY ` + expr.key + `) AS group_count
+	           FROM tasks WHERE user_id = $1 AND ` + visibleTaskCondition + `
+	       ), ranked AS (
+	           SELECT *, ROW_NUMBER() OVER (PARTITION BY group_key ORDER BY created_at DESC, id DESC) AS group_rank
+	           FROM grouped
+	           WHERE ($4::text = '' OR group_key = $4)
+	             AND ($5::timestamptz IS NULL OR (created_at, id) < ($5, $6::text))
+	       )
+	       SELECT group_key, group_count, ` + taskColumns + `
+	       FROM ranked WHERE group_rank <= $7
+	       ORDER BY group_order, group_key, group_rank`
+	// Ambil satu baris ekstra per grup untuk mengetahui apakah grup masih punya halaman berikutnya.
+	rows, err := r.dbpool.Query(ctx, sql, userID, snoozeCutoff(query.HideSnoozedAt), query.HideArchived,
+		query.Group, afterCreatedAt, afterID, query.Limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("error finding task groups for user_id %s: %w", userID, err)
+	}
 	defer rows.Close()
 
-	var tasks []*domain.Task
+	var groups []domain.TaskGroup
 	for rows.Next() {
+		var key string
+		var count int64
 		task := &domain.Task{}
-		err := rows.Scan(
-			&task.ID,
-			&task.UserID,
-			&task.Title,
-			&task.Description,
-			&task.Completed,
-			&task.CreatedAt,
-			&task.UpdatedAt,
-		)
-		if err != nil {
-			// Sebaiknya log error ini dan mungkin skip task yang error, atau batalkan semua
-			return nil, fmt.Errorf("error scanning task row: %w", err)
+		if err := rows.Scan(append([]any{&key, &count}, taskScanTargets(task)...)...); err != nil {
+			return nil, fmt.Errorf("error scanning task group row: %w", err)
 		}
-		tasks = append(tasks, task)
+		if err := decryptTask(r.cipher, task); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || groups[len(groups)-1].Key != key {
+			groups = append(groups, domain.TaskGroup{Key: key, Count: count})
+		}
+		group := &groups[len(groups)-1]
+		if len(group.Tasks) == query.Limit {
+			last := group.Tasks[len(group.Tasks)-1]
+			group.NextCursor = encodeTimeIDCursor(last.CreatedAt, last.ID)
+			continue
+		}
+		group.Tasks = append(group.Tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task group rows: %w", err)
+	}
+	return groups, nil
+}
+
+// FindCompletedBetween mencari task milik pengguna yang diselesaikan dalam rentang [from, to).
+func (r *PostgresTaskRepository) FindCompletedBetween(ctx context.Context, userID domain.UserID, from, to time.Time) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + `
+	           FROM tasks WHERE user_id = $1 AND completed AND completed_at >= $2 AND completed_at < $3
+	           ORDER BY completed_at DESC`
+	rows, err := r.dbpool.Query(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error finding tasks completed between %s and %s for user_id %s: %w",
+			from.Format(time.RFC3339), to.Format(time.RFC3339), userID, err)
 	}
+	return collectTasks(rows, r.cipher)
+}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating task rows: %w", err)
+// FindDueByUserID memakai index parsial idx_tasks_user_due_at.
+func (r *PostgresTaskRepository) FindDueByUserID(ctx context.Context, userID domain.UserID, from time.Time, limit int) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + `
+	           FROM tasks WHERE user_id = $1 AND due_at >= $2 AND NOT archived
+	           ORDER BY due_at, id LIMIT $3`
+	rows, err := r.dbpool.Query(ctx, query, userID, from, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding due tasks for user_id %s: %w", userID, err)
 	}
+	return collectTasks(rows, r.cipher)
+}
 
-	return tasks, nil
+// FindArchivedByUserID memakai index parsial idx_tasks_archived.
+func (r *PostgresTaskRepository) FindArchivedByUserID(ctx context.Context, userID domain.UserID) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + `
+	           FROM tasks WHERE user_id = $1 AND archived
+	           ORDER BY completed_at DESC NULLS LAST, id`
+	rows, err := r.dbpool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding archived tasks for user_id %s: %w", userID, err)
+	}
+	return collectTasks(rows, r.cipher)
+}
+
+// FindByAssignee memakai index parsial idx_tasks_assignee. Task di daftar yang sudah tidak
+// dibagikan kepada assigneeID tidak ikut.
+func (r *PostgresTaskRepository) FindByAssignee(ctx context.Context, assigneeID domain.UserID, order domain.TaskOrder) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + `
+	           FROM tasks
+	           WHERE assignee_id = $1 AND ` + visibleTaskCondition + `
+	             AND (user_id = $1 OR EXISTS (SELECT 1 FROM list_shares WHERE owner_id = tasks.user_id AND collaborator_id = $1))
+	           ORDER BY ` + taskOrderBy(order)
+	rows, err := r.dbpool.Query(ctx, query, assigneeID, snoozeCutoff(order.HideSnoozedAt), order.HideArchived)
+	if err != nil {
+		return nil, fmt.Errorf("error finding tasks assigned to %s: %w", assigneeID, err)
+	}
+	return collectTasks(rows, r.cipher)
+}
+
+// SummarizeEstimates menghitung total usaha tersisa dan usaha yang diselesaikan per hari.
+// Hari dikelompokkan dengan completed_at AT TIME ZONE agar sesuai hari kalender pengguna.
+func (r *PostgresTaskRepository) SummarizeEstimates(ctx context.Context, userID domain.UserID, from, to time.Time, loc *time.Location) (*domain.EstimateSummary, error) {
+	summary := &domain.EstimateSummary{}
+	err := r.dbpool.QueryRow(ctx, `SELECT COUNT(*),
+	                  COUNT(*) FILTER (WHERE estimate_minutes IS NULL),
+	                  COALESCE(SUM(estimate_minutes), 0)
+	           FROM tasks WHERE user_id = $1 AND NOT completed`, userID).
+		Scan(&summary.OpenTasks, &summary.UnestimatedTasks, &summary.RemainingMinutes)
+	if err != nil {
+		return nil, fmt.Errorf("error summarizing open task estimates for user_id %s: %w", userID, err)
+	}
+
+	rows, err := r.dbpool.Query(ctx, `SELECT (completed_at AT TIME ZONE $4)::date AS day,
+	                  COUNT(*), COALESCE(SUM(estimate_minutes), 0)
+	           FROM tasks WHERE user_id = $1 AND completed AND completed_at >= $2 AND completed_at < $3
+	           GROUP BY day ORDER BY day`, userID, from, to, loc.String())
+	if err != nil {
+		return nil, fmt.Errorf("error summarizing completed task estimates for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var day domain.DailyEffort
+		if err := rows.Scan(&day.Date, &day.Tasks, &day.Minutes); err != nil {
+			return nil, fmt.Errorf("error scanning estimate summary row: %w", err)
+		}
+		day.Date = time.Date(day.Date.Year(), day.Date.Month(), day.Date.Day(), 0, 0, 0, 0, loc)
+		summary.CompletedByDay = append(summary.CompletedByDay, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating estimate summary rows: %w", err)
+	}
+	return summary, nil
+}
+
+// SummarizeProductivity menghitung statistik dengan dua query agregat: total dalam rentang dan
+// jumlah per hari. Task yang sudah dihapus tidak ikut karena penghapusan masih berupa hard delete.
+func (r *PostgresTaskRepository) SummarizeProductivity(ctx context.Context, userID domain.UserID, from, to time.Time, loc *time.Location) (*domain.ProductivityStats, error) {
+	stats := &domain.ProductivityStats{From: from, To: to}
+	var avgSeconds *float64
+	err := r.dbpool.QueryRow(ctx, `SELECT
+	                  COUNT(*) FILTER (WHERE created_at >= $2 AND created_at < $3),
+	                  COUNT(*) FILTER (WHERE created_at >= $2 AND created_at < $3 AND completed),
+	                  COUNT(*) FILTER (WHERE completed AND completed_at >= $2 AND completed_at < $3),
+	                  COUNT(*) FILTER (WHERE NOT completed),
+	                  AVG(EXTRACT(EPOCH FROM completed_at - created_at))
+	                      FILTER (WHERE completed AND completed_at >= $2 AND completed_at < $3)
+	           FROM tasks WHERE user_id = $1`, userID, from, to).
+		Scan(&stats.Created, &stats.CreatedStillCompleted, &stats.Completed, &stats.OpenTasks, &avgSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("error summarizing productivity for user_id %s: %w", userID, err)
+	}
+	if avgSeconds != nil {
+		stats.AverageTimeToComplete = time.Duration(*avgSeconds * float64(time.Second))
+	}
+
+	rows, err := r.dbpool.Query(ctx, `SELECT day, SUM(created), SUM(completed) FROM (
+	               SELECT (created_at AT TIME ZONE $4)::date AS day, 1 AS created, 0 AS completed
+	               FROM tasks WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+	               UNION ALL
+	               SELECT (completed_at AT TIME ZONE $4)::date, 0, 1
+	               FROM tasks WHERE user_id = $1 AND completed AND completed_at >= $2 AND completed_at < $3
+	           ) activity GROUP BY day ORDER BY day`, userID, from, to, loc.String())
+	if err != nil {
+		return nil, fmt.Errorf("error summarizing daily productivity for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var day domain.DailyActivity
+		if err := rows.Scan(&day.Date, &day.Created, &day.Completed); err != nil {
+			return nil, fmt.Errorf("error scanning daily productivity row: %w", err)
+		}
+		day.Date = time.Date(day.Date.Year(), day.Date.Month(), day.Date.Day(), 0, 0, 0, 0, loc)
+		stats.ByDay = append(stats.ByDay, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating daily productivity rows: %w", err)
+	}
+	return stats, nil
+}
+
+// FindChangedSince memakai kolom change_xid (ID transaksi yang terakhir menulis task, diisi
+// trigger) dan mengembalikan xmin snapshot sebagai cursor: semua transaksi sebelum xmin sudah
+// selesai dan terlihat di snapshot, sedangkan transaksi yang masih berjalan punya ID >= xmin dan
+// terbaca pada pemanggilan berikutnya. Cursor dan task dibaca dalam satu transaksi REPEATABLE READ
+// agar memakai snapshot yang sama.
+func (r *PostgresTaskRepository) FindChangedSince(ctx context.Context, userID domain.UserID, since int64) (*domain.TaskChanges, error) {
+	tx, err := r.dbpool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("error starting sync transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // Transaksi read-only, tidak perlu di-commit
+
+	changes := &domain.TaskChanges{}
+	if err := tx.QueryRow(ctx, `SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint`).Scan(&changes.Cursor); err != nil {
+		return nil, fmt.Errorf("error reading sync cursor: %w", err)
+	}
+	query := `SELECT ` + taskColumns + `
+	           FROM tasks WHERE user_id = $1 AND change_xid >= $2::text::xid8 ORDER BY change_xid, id`
+	rows, err := tx.Query(ctx, query, userID, strconv.FormatInt(since, 10))
+	if err != nil {
+		return nil, fmt.Errorf("error finding tasks changed since %d for user_id %s: %w", since, userID, err)
+	}
+	if changes.Tasks, err = collectTasks(rows, r.cipher); err != nil {
+		return nil, err
+	}
+	return changes, nil
 }
 
 // Update memperbarui data task yang sudah ada di penyimpanan.
 func (r *PostgresTaskRepository) Update(ctx context.Context, task *domain.Task) error {
+	description, err := encryptDescription(r.cipher, task)
+	if err != nil {
+		return err
+	}
 	query := `UPDATE tasks
-	           SET title = $1, description = $2, completed = $3, updated_at = $4
-	           WHERE id = $5 AND user_id = $6` // Pastikan hanya pemilik yang bisa update
+	           SET title = $1, description = $2, completed = $3, completed_at = $4, updated_at = $5, estimate_minutes = $8,
+	               snoozed_until = $9, archived = $10, priority = $11, due_at = $12, due_text = $13, assignee_id = $14,
+	               color = $15, icon = $16
+	           WHERE id = $6 AND user_id = $7` // Pastikan hanya pemilik yang bisa update
 	cmdTag, err := r.dbpool.Exec(ctx, query,
 		task.Title,
-		task.Description,
+		description,
 		task.Completed,
+		task.CompletedAt,
 		task.UpdatedAt,
 		task.ID,
 		task.UserID, // Penting untuk otorisasi di level DB (tambahan selain di app layer)
+		task.EstimateMinutes,
+		task.SnoozedUntil,
+		task.Archived,
+		task.Priority,
+		task.DueAt,
+		task.DueText,
+		task.AssigneeID,
+		task.Color,
+		task.Icon,
 	)
 
 	if err != nil {
@@ -142,6 +752,303 @@ func (r *PostgresTaskRepository) Update(ctx context.Context, task *domain.Task)
 	return nil
 }
 
+// lockUserPositions mengambil advisory lock transaksi per pengguna, sehingga reorder paralel
+// milik pengguna yang sama tidak membaca tetangga yang sama lalu menulis Position yang bentrok.
+func lockUserPositions(ctx context.Context, tx pgx.Tx, userID domain.UserID) error {
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('task_position:' || $1))`, userID); err != nil {
+		return fmt.Errorf("error locking task positions for user_id %s: %w", userID, err)
+	}
+	return nil
+}
+
+// Reorder menulis ulang Position task dalam ids menjadi 1024, 2048, ... sesuai urutannya dalam
+// satu transaksi. Task lain milik pengguna digeser ke bawah dengan urutan relatif yang sama.
+func (r *PostgresTaskRepository) Reorder(ctx context.Context, userID domain.UserID, ids []string, now time.Time) ([]*domain.Task, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting reorder transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	if err := lockUserPositions(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `UPDATE tasks
+	           SET position = o.ord * 1024,
+	               updated_at = CASE WHEN position = o.ord * 1024 THEN updated_at ELSE $3 END
+	           FROM unnest($2::text[]) WITH ORDINALITY AS o(task_id, ord)
+	           WHERE tasks.user_id = $1 AND tasks.id = o.task_id
+	           RETURNING `+taskColumns, userID, ids, now)
+	if err != nil {
+		return nil, fmt.Errorf("error reordering tasks for user_id %s: %w", userID, err)
+	}
+	tasks, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) != len(ids) {
+		return nil, domain.ErrTaskNotFound
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE tasks t
+	           SET position = rest.new_position, updated_at = $4
+	           FROM (
+	               SELECT id, ($3 + ROW_NUMBER() OVER (ORDER BY position, id)) * 1024 AS new_position
+	               FROM tasks WHERE user_id = $1 AND NOT (id = ANY($2::text[]))
+	           ) rest
+	           WHERE t.id = rest.id AND t.position <> rest.new_position`, userID, ids, len(ids), now)
+	if err != nil {
+		return nil, fmt.Errorf("error shifting unlisted tasks for user_id %s: %w", userID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing reorder transaction: %w", err)
+	}
+
+	// RETURNING pada UPDATE ... FROM tidak menjamin urutan, jadi urutkan sesuai Position baru.
+	slices.SortFunc(tasks, func(a, b *domain.Task) int { return cmp.Compare(a.Position, b.Position) })
+	return tasks, nil
+}
+
+// MoveAfter memindahkan satu task dengan fractional ordering: Position baru adalah titik tengah
+// antara task afterID dan tetangga di bawahnya, sehingga hanya satu baris yang ditulis. Jika
+// selisihnya sudah terlalu kecil, Position semua task milik pengguna ditulis ulang terlebih dahulu.
+func (r *PostgresTaskRepository) MoveAfter(ctx context.Context, userID domain.UserID, taskID, afterID string, now time.Time) (*domain.Task, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting move transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	if err := lockUserPositions(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	position, ok, err := r.positionAfter(ctx, tx, userID, taskID, afterID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := r.renumberPositions(ctx, tx, userID, now); err != nil {
+			return nil, err
+		}
+		if position, _, err = r.positionAfter(ctx, tx, userID, taskID, afterID); err != nil {
+			return nil, err
+		}
+	}
+
+	task, err := scanTask(tx.QueryRow(ctx, `UPDATE tasks SET position = $1, updated_at = $2
+	           WHERE id = $3 AND user_id = $4
+	           RETURNING `+taskColumns, position, now, taskID, userID), r.cipher)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("error moving task %s: %w", taskID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing move transaction: %w", err)
+	}
+	return task, nil
+}
+
+// positionAfter menghitung Position untuk task yang diletakkan tepat setelah afterID
+// (atau paling atas jika afterID kosong). ok bernilai false jika celah antar tetangga
+// sudah lebih kecil dari minPositionGap.
+func (r *PostgresTaskRepository) positionAfter(ctx context.Context, tx pgx.Tx, userID domain.UserID, taskID, afterID string) (position float64, ok bool, err error) {
+	if afterID == "" {
+		var top *float64
+		err := tx.QueryRow(ctx, `SELECT MIN(position) FROM tasks WHERE user_id = $1 AND id <> $2`,
+			userID, taskID).Scan(&top)
+		if err != nil {
+			return 0, false, fmt.Errorf("error finding top position for user_id %s: %w", userID, err)
+		}
+		if top == nil {
+			return positionStep, true, nil // Satu-satunya task milik pengguna
+		}
+		return *top - positionStep, true, nil
+	}
+
+	var after float64
+	err = tx.QueryRow(ctx, `SELECT position FROM tasks WHERE id = $1 AND user_id = $2`,
+		afterID, userID).Scan(&after)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, domain.ErrTaskNotFound
+		}
+		return 0, false, fmt.Errorf("error finding position of task %s: %w", afterID, err)
+	}
+
+	var next *float64
+	err = tx.QueryRow(ctx, `SELECT MIN(position) FROM tasks
+	           WHERE user_id = $1 AND id <> $2 AND position > $3`, userID, taskID, after).Scan(&next)
+	if err != nil {
+		return 0, false, fmt.Errorf("error finding next position after task %s: %w", afterID, err)
+	}
+	if next == nil {
+		return after + positionStep, true, nil // afterID adalah task paling bawah
+	}
+	if *next-after < minPositionGap {
+		return 0, false, nil
+	}
+	return after + (*next-after)/2, true, nil
+}
+
+// renumberPositions menulis ulang Position semua task milik pengguna menjadi 1024, 2048, ...
+// dengan urutan yang sama. UpdatedAt hanya diisi now untuk task yang posisinya berubah.
+func (r *PostgresTaskRepository) renumberPositions(ctx context.Context, tx pgx.Tx, userID domain.UserID, now time.Time) error {
+	_, err := tx.Exec(ctx, `UPDATE tasks t
+	           SET position = ranked.new_position, updated_at = $2
+	           FROM (
+	               SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) * 1024 AS new_position
+	               FROM tasks WHERE user_id = $1
+	           ) ranked
+	           WHERE t.id = ranked.id AND t.position <> ranked.new_position`, userID, now)
+	if err != nil {
+		return fmt.Errorf("error renumbering task positions for user_id %s: %w", userID, err)
+	}
+	return nil
+}
+
+// CompleteMany mengunci task dalam ids (SELECT ... FOR UPDATE) untuk membaca status sebelumnya,
+// lalu menandai yang belum selesai sebagai selesai. previous hanya berisi task yang berubah.
+func (r *PostgresTaskRepository) CompleteMany(ctx context.Context, userID domain.UserID, ids []string, now time.Time) ([]domain.TaskCompletion, []*domain.Task, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error starting bulk complete transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	rows, err := tx.Query(ctx, `SELECT `+taskColumns+` FROM tasks
+	           WHERE user_id = $1 AND id = ANY($2::text[]) FOR UPDATE`, userID, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error locking tasks for bulk complete: %w", err)
+	}
+	current, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(current) != len(ids) {
+		found := make(map[string]struct{}, len(current))
+		for _, task := range current {
+			found[task.ID] = struct{}{}
+		}
+		missing := &domain.TasksNotFoundError{}
+		for _, id := range ids {
+			if _, ok := found[id]; !ok {
+				missing.IDs = append(missing.IDs, id)
+			}
+		}
+		return nil, nil, missing
+	}
+
+	rows, err = tx.Query(ctx, `UPDATE tasks SET completed = TRUE, completed_at = $3, updated_at = $3
+	           WHERE user_id = $1 AND id = ANY($2::text[]) AND NOT (completed AND completed_at IS NOT NULL)
+	           RETURNING `+taskColumns, userID, ids, now)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error completing tasks for user_id %s: %w", userID, err)
+	}
+	updated, err := collectTasks(rows, r.cipher)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("error committing bulk complete transaction: %w", err)
+	}
+
+	byID := make(map[string]*domain.Task, len(updated))
+	for _, task := range updated {
+		byID[task.ID] = task
+	}
+	previous := make([]domain.TaskCompletion, 0, len(updated))
+	for i, task := range current {
+		if next, ok := byID[task.ID]; ok {
+			previous = append(previous, domain.TaskCompletion{
+				TaskID:      task.ID,
+				Completed:   task.Completed,
+				CompletedAt: task.CompletedAt,
+			})
+			current[i] = next
+		}
+	}
+	return previous, current, nil
+}
+
+// RestoreCompletion mengembalikan status selesai banyak task dalam satu statement UPDATE ... FROM unnest.
+func (r *PostgresTaskRepository) RestoreCompletion(ctx context.Context, userID domain.UserID, completions []domain.TaskCompletion, now time.Time) ([]*domain.Task, error) {
+	ids := make([]string, len(completions))
+	completed := make([]bool, len(completions))
+	completedAt := make([]*time.Time, len(completions))
+	for i, c := range completions {
+		ids[i], completed[i], completedAt[i] = c.TaskID, c.Completed, c.CompletedAt
+	}
+
+	rows, err := r.dbpool.Query(ctx, `UPDATE tasks
+	           SET completed = c.was_completed, completed_at = c.was_completed_at, updated_at = $5,
+	               archived = tasks.archived AND c.was_completed
+	           FROM unnest($2::text[], $3::bool[], $4::timestamptz[]) AS c(task_id, was_completed, was_completed_at)
+	           WHERE tasks.user_id = $1 AND tasks.id = c.task_id
+	           RETURNING `+taskColumns, userID, ids, completed, completedAt, now)
+	if err != nil {
+		return nil, fmt.Errorf("error restoring task completion for user_id %s: %w", userID, err)
+	}
+	return collectTasks(rows, r.cipher)
+}
+
+// RestoreTasks menyisipkan task dalam satu transaksi menggunakan pgx.Batch.
+func (r *PostgresTaskRepository) RestoreTasks(ctx context.Context, tasks []*domain.Task) (int64, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error starting restore transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	// Pemulihan bukan pembuatan task baru, jadi tidak dicatat di feed aktivitas.
+	if _, err := tx.Exec(ctx, skipActivityStatement); err != nil {
+		return 0, fmt.Errorf("error disabling activity recording: %w", err)
+	}
+
+	// column_id hanya dipulihkan jika kolom board-nya masih ada, dan assignee_id hanya jika
+	// penerima tugasnya pemilik atau masih kolaborator daftar.
+	batch := &pgx.Batch{}
+	for _, task := range tasks {
+		description, err := encryptDescription(r.cipher, task)
+		if err != nil {
+			return 0, err
+		}
+		batch.Queue(`INSERT INTO tasks (`+taskColumns+`)
+		           VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+		                   (SELECT id FROM board_columns WHERE id = $13 AND user_id = $2), $14, $15, $16,
+		                   CASE WHEN $17::text = $2 OR EXISTS (SELECT 1 FROM list_shares WHERE owner_id = $2 AND collaborator_id = $17)
+		                        THEN $17 END, $18, $19)
+		           ON CONFLICT (id) DO NOTHING`,
+			task.ID, task.UserID, task.Title, description, task.Completed,
+			task.CompletedAt, task.Position, task.CreatedAt, task.UpdatedAt, task.EstimateMinutes, task.SnoozedUntil,
+			task.Archived, task.ColumnID, task.Priority, task.DueAt, task.DueText, task.AssigneeID, task.Color, task.Icon)
+	}
+	results := tx.SendBatch(ctx, batch)
+	var inserted int64
+	for _, task := range tasks {
+		cmdTag, err := results.Exec()
+		if err != nil {
+			results.Close()
+			return 0, fmt.Errorf("error restoring task %s: %w", task.ID, err)
+		}
+		inserted += cmdTag.RowsAffected()
+	}
+	if err := results.Close(); err != nil {
+		return 0, fmt.Errorf("error restoring tasks: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("error committing restore transaction: %w", err)
+	}
+	return inserted, nil
+}
+
 // Delete menghapus task berdasarkan ID uniknya dari penyimpanan.
 func (r *PostgresTaskRepository) Delete(ctx context.Context, id string) error {
 	// Untuk keamanan, idealnya kita juga butuh UserID di sini untuk memastikan
@@ -160,3 +1067,34 @@ func (r *PostgresTaskRepository) Delete(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// DeleteByUserID menghapus semua task milik pengguna beserta baris counter cache, streak, dan feed
+// aktivitasnya dalam satu transaksi.
+func (r *PostgresTaskRepository) DeleteByUserID(ctx context.Context, userID domain.UserID) (int64, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error starting delete transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	if _, err := tx.Exec(ctx, skipActivityStatement); err != nil {
+		return 0, fmt.Errorf("error disabling activity recording: %w", err)
+	}
+	cmdTag, err := tx.Exec(ctx, `DELETE FROM tasks WHERE user_id = $1`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting tasks for user_id %s: %w", userID, err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM activities WHERE user_id = $1`, userID); err != nil {
+		return 0, fmt.Errorf("error deleting activities for user_id %s: %w", userID, err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM user_task_counters WHERE user_id = $1`, userID); err != nil {
+		return 0, fmt.Errorf("error deleting task counters for user_id %s: %w", userID, err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM completion_streaks WHERE user_id = $1`, userID); err != nil {
+		return 0, fmt.Errorf("error deleting completion streak for user_id %s: %w", userID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("error committing delete transaction: %w", err)
+	}
+	return cmdTag.RowsAffected(), nil
+}
@@ -0,0 +1,110 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_time_entry_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// timeEntryColumns adalah daftar kolom yang dibaca untuk setiap sesi timer, sesuai urutan Scan di scanTimeEntry.
+const timeEntryColumns = `id, user_id, task_id, started_at, stopped_at`
+
+func scanTimeEntry(row pgx.Row) (*domain.TimeEntry, error) {
+	entry := &domain.TimeEntry{}
+	err := row.Scan(
+		&entry.ID,
+		&entry.UserID,
+		&entry.TaskID,
+		&entry.StartedAt,
+		&entry.StoppedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// PostgresTimeEntryRepository adalah implementasi domain.TimeEntryRepository menggunakan tabel time_entries.
+type PostgresTimeEntryRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresTimeEntryRepository adalah constructor untuk PostgresTimeEntryRepository.
+func NewPostgresTimeEntryRepository(dbpool *pgxpool.Pool) domain.TimeEntryRepository {
+	return &PostgresTimeEntryRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Start menyimpan timer baru. Pelanggaran index idx_time_entries_running berarti pengguna sudah
+// punya timer yang berjalan, termasuk jika dua request start berjalan bersamaan.
+func (r *PostgresTimeEntryRepository) Start(ctx context.Context, entry *domain.TimeEntry) error {
+	query := `INSERT INTO time_entries (id, user_id, task_id, started_at) VALUES ($1, $2, $3, $4)`
+	_, err := r.dbpool.Exec(ctx, query, entry.ID, entry.UserID, entry.TaskID, entry.StartedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_time_entries_running" {
+			return domain.ErrTimerAlreadyRunning
+		}
+		return fmt.Errorf("error starting timer for task %s: %w", entry.TaskID, err)
+	}
+	return nil
+}
+
+// Stop mengisi stopped_at pada timer yang berjalan.
+func (r *PostgresTimeEntryRepository) Stop(ctx context.Context, userID domain.UserID, taskID string, stoppedAt time.Time) (*domain.TimeEntry, error) {
+	query := `UPDATE time_entries SET stopped_at = GREATEST($3, started_at)
+	           WHERE user_id = $1 AND task_id = $2 AND stopped_at IS NULL
+	           RETURNING ` + timeEntryColumns
+	entry, err := scanTimeEntry(r.dbpool.QueryRow(ctx, query, userID, taskID, stoppedAt))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTimerNotRunning
+		}
+		return nil, fmt.Errorf("error stopping timer for task %s: %w", taskID, err)
+	}
+	return entry, nil
+}
+
+// FindRunning mencari timer pengguna yang berjalan.
+func (r *PostgresTimeEntryRepository) FindRunning(ctx context.Context, userID domain.UserID) (*domain.TimeEntry, error) {
+	entry, err := scanTimeEntry(r.dbpool.QueryRow(ctx, `SELECT `+timeEntryColumns+`
+	           FROM time_entries WHERE user_id = $1 AND stopped_at IS NULL`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTimerNotRunning
+		}
+		return nil, fmt.Errorf("error finding running timer for user_id %s: %w", userID, err)
+	}
+	return entry, nil
+}
+
+// FindByTaskID mengembalikan semua sesi timer task.
+func (r *PostgresTimeEntryRepository) FindByTaskID(ctx context.Context, taskID string) ([]*domain.TimeEntry, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+timeEntryColumns+`
+	           FROM time_entries WHERE task_id = $1 ORDER BY started_at, id`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding time entries for task %s: %w", taskID, err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.TimeEntry
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning time entry row: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating time entry rows: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,71 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_undo_repository.go
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresUndoRepository adalah implementasi domain.UndoRepository menggunakan tabel task_undo_operations.
+type PostgresUndoRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresUndoRepository adalah constructor untuk PostgresUndoRepository.
+func NewPostgresUndoRepository(dbpool *pgxpool.Pool) domain.UndoRepository {
+	return &PostgresUndoRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Save menyimpan operasi undo baru, sekaligus menghapus operasi milik pengguna yang sudah kedaluwarsa.
+func (r *PostgresUndoRepository) Save(ctx context.Context, op domain.UndoOperation) error {
+	payload, err := json.Marshal(op.Completions)
+	if err != nil {
+		return fmt.Errorf("error encoding undo payload: %w", err)
+	}
+
+	_, err = r.dbpool.Exec(ctx, `DELETE FROM task_undo_operations WHERE user_id = $1 AND expires_at < $2`,
+		op.UserID, op.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error pruning expired undo operations for user_id %s: %w", op.UserID, err)
+	}
+
+	query := `INSERT INTO task_undo_operations (token, user_id, kind, payload, created_at, expires_at)
+	           VALUES ($1, $2, $3, $4::jsonb, $5, $6)`
+	_, err = r.dbpool.Exec(ctx, query, op.Token, op.UserID, op.Kind, payload, op.CreatedAt, op.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("error saving undo operation: %w", err)
+	}
+	return nil
+}
+
+// Take menghapus operasi dengan DELETE ... RETURNING, sehingga dua request undo yang bersamaan
+// tidak bisa memakai token yang sama.
+func (r *PostgresUndoRepository) Take(ctx context.Context, userID domain.UserID, token string, now time.Time) (*domain.UndoOperation, error) {
+	op := &domain.UndoOperation{Token: token, UserID: userID}
+	var payload []byte
+	err := r.dbpool.QueryRow(ctx, `DELETE FROM task_undo_operations WHERE token = $1 AND user_id = $2
+	           RETURNING kind, payload, created_at, expires_at`, token, userID).
+		Scan(&op.Kind, &payload, &op.CreatedAt, &op.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUndoUnavailable
+		}
+		return nil, fmt.Errorf("error taking undo operation: %w", err)
+	}
+	if !now.Before(op.ExpiresAt) {
+		return nil, domain.ErrUndoUnavailable
+	}
+	if err := json.Unmarshal(payload, &op.Completions); err != nil {
+		return nil, fmt.Errorf("error decoding undo payload: %w", err)
+	}
+	return op, nil
+}
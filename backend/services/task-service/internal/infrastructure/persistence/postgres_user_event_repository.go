@@ -0,0 +1,74 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_user_event_repository.go
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// maxUserEventErrorLength membatasi panjang pesan error yang disimpan di last_error.
+const maxUserEventErrorLength = 1000
+
+// PostgresUserEventRepository adalah implementasi domain.UserEventRepository menggunakan tabel user_events.
+type PostgresUserEventRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresUserEventRepository adalah constructor untuk PostgresUserEventRepository.
+func NewPostgresUserEventRepository(dbpool *pgxpool.Pool) domain.UserEventRepository {
+	return &PostgresUserEventRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindPending membaca event yang belum diproses dari yang terlama.
+func (r *PostgresUserEventRepository) FindPending(ctx context.Context, limit int) ([]domain.UserEvent, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT id, event_id, event_type, user_id, occurred_at, attempts
+	           FROM user_events WHERE processed_at IS NULL AND attempts < $1
+	           ORDER BY id LIMIT $2`, domain.MaxUserEventAttempts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding pending user events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.UserEvent
+	for rows.Next() {
+		var event domain.UserEvent
+		if err := rows.Scan(&event.ID, &event.EventID, &event.Type, &event.UserID, &event.OccurredAt, &event.Attempts); err != nil {
+			return nil, fmt.Errorf("error scanning user event row: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user event rows: %w", err)
+	}
+	return events, nil
+}
+
+// MarkProcessed mengisi processed_at; event yang sudah diproses replika lain tidak diubah.
+func (r *PostgresUserEventRepository) MarkProcessed(ctx context.Context, id int64, processedAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE user_events SET processed_at = $2, last_error = ''
+	           WHERE id = $1 AND processed_at IS NULL`, id, processedAt)
+	if err != nil {
+		return fmt.Errorf("error marking user event %d processed: %w", id, err)
+	}
+	return nil
+}
+
+// MarkFailed menambah attempts dan menyimpan pesan error terakhir.
+func (r *PostgresUserEventRepository) MarkFailed(ctx context.Context, id int64, message string) error {
+	if len(message) > maxUserEventErrorLength {
+		message = strings.ToValidUTF8(message[:maxUserEventErrorLength], "")
+	}
+	_, err := r.dbpool.Exec(ctx, `UPDATE user_events SET attempts = attempts + 1, last_error = $2
+	           WHERE id = $1 AND processed_at IS NULL`, id, message)
+	if err != nil {
+		return fmt.Errorf("error marking user event %d failed: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_user_session_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresUserSessionRepository adalah implementasi domain.UserSessionRepository menggunakan tabel
+// user_sessions.
+type PostgresUserSessionRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresUserSessionRepository adalah constructor untuk PostgresUserSessionRepository.
+func NewPostgresUserSessionRepository(dbpool *pgxpool.Pool) domain.UserSessionRepository {
+	return &PostgresUserSessionRepository{
+		dbpool: dbpool,
+	}
+}
+
+const userSessionColumns = `id, user_id, kind, user_agent, ip, created_at, last_seen_at, revoked_at`
+
+// Touch memakai upsert sehingga aman dipanggil beberapa replika untuk sesi yang sama.
+func (r *PostgresUserSessionRepository) Touch(ctx context.Context, session *domain.UserSession) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO user_sessions (user_id, id, kind, user_agent, ip, created_at, last_seen_at)
+	           VALUES ($1, $2, $3, $4, $5, $6, $6)
+	           ON CONFLICT (user_id, id) DO UPDATE
+	           SET user_agent = EXCLUDED.user_agent, ip = EXCLUDED.ip,
+	               last_seen_at = GREATEST(user_sessions.last_seen_at, EXCLUDED.last_seen_at)`,
+		session.UserID, session.ID, session.Kind, session.UserAgent, session.IP, session.LastSeenAt)
+	if err != nil {
+		return fmt.Errorf("error touching session %s of user_id %s: %w", session.ID, session.UserID, err)
+	}
+	return nil
+}
+
+// FindActiveByUserID menyaring sesi personal access token dengan tabel personal_access_tokens,
+// karena token yang dicabut lewat /api/v1/me/tokens langsung dihapus.
+func (r *PostgresUserSessionRepository) FindActiveByUserID(ctx context.Context, userID domain.UserID) ([]domain.UserSession, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+userSessionColumns+` FROM user_sessions s
+	           WHERE s.user_id = $1 AND s.revoked_at IS NULL
+	             AND (s.kind <> $2 OR EXISTS (SELECT 1 FROM personal_access_tokens t WHERE t.id = s.id AND t.user_id = s.user_id))
+	           ORDER BY s.last_seen_at DESC`,
+		userID, domain.SessionKindPersonalAccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("error finding sessions of user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var sessions []domain.UserSession
+	for rows.Next() {
+		session, err := scanUserSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning session row: %w", err)
+		}
+		sessions = append(sessions, *session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating session rows: %w", err)
+	}
+	return sessions, nil
+}
+
+// FindByID mengembalikan domain.ErrUserSessionNotFound jika sesi tidak ada.
+func (r *PostgresUserSessionRepository) FindByID(ctx context.Context, userID domain.UserID, id string) (*domain.UserSession, error) {
+	row := r.dbpool.QueryRow(ctx, `SELECT `+userSessionColumns+` FROM user_sessions WHERE user_id = $1 AND id = $2`, userID, id)
+	session, err := scanUserSession(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrUserSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding session %s of user_id %s: %w", id, userID, err)
+	}
+	return session, nil
+}
+
+// IsRevoked memakai primary key, sehingga cukup murah untuk dipanggil saat autentikasi.
+func (r *PostgresUserSessionRepository) IsRevoked(ctx context.Context, userID domain.UserID, id string) (bool, error) {
+	var revoked bool
+	err := r.dbpool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM user_sessions WHERE user_id = $1 AND id = $2 AND revoked_at IS NOT NULL)`,
+		userID, id).Scan(&revoked)
+	if err != nil {
+		return false, fmt.Errorf("error checking session %s of user_id %s: %w", id, userID, err)
+	}
+	return revoked, nil
+}
+
+// Revoke tidak mengubah revoked_at sesi yang sudah dicabut.
+func (r *PostgresUserSessionRepository) Revoke(ctx context.Context, userID domain.UserID, id string, revokedAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE user_sessions SET revoked_at = $3
+	           WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL`, userID, id, revokedAt)
+	if err != nil {
+		return fmt.Errorf("error revoking session %s of user_id %s: %w", id, userID, err)
+	}
+	return nil
+}
+
+// DeleteInactive memakai index last_seen_at. Sesi yang sudah dicabut tidak dihapus: barisnya
+// adalah satu-satunya tanda bahwa token sesi tersebut ditolak, sedangkan masa berlaku token di
+// issuer tidak diketahui.
+func (r *PostgresUserSessionRepository) DeleteInactive(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM user_sessions WHERE last_seen_at < $1 AND revoked_at IS NULL`, before)
+	if err != nil {
+		return 0, fmt.Errorf("error purging inactive sessions: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func scanUserSession(row pgx.Row) (*domain.UserSession, error) {
+	var session domain.UserSession
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.Kind,
+		&session.UserAgent,
+		&session.IP,
+		&session.CreatedAt,
+		&session.LastSeenAt,
+		&session.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
@@ -0,0 +1,107 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_webhook_repository.go
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// webhookColumns adalah daftar kolom yang dibaca untuk setiap webhook, sesuai urutan Scan di scanWebhook.
+const webhookColumns = `id, user_id, url, secret, event_types, payload_template, content_type, created_at`
+
+func scanWebhook(row pgx.Row) (*domain.Webhook, error) {
+	webhook := &domain.Webhook{}
+	var eventTypes []string
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.UserID,
+		&webhook.URL,
+		&webhook.Secret,
+		&eventTypes,
+		&webhook.PayloadTemplate,
+		&webhook.ContentType,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range eventTypes {
+		webhook.EventTypes = append(webhook.EventTypes, domain.TaskEventType(eventType))
+	}
+	return webhook, nil
+}
+
+// PostgresWebhookRepository adalah implementasi domain.WebhookRepository menggunakan tabel webhooks.
+type PostgresWebhookRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresWebhookRepository adalah constructor untuk PostgresWebhookRepository.
+func NewPostgresWebhookRepository(dbpool *pgxpool.Pool) domain.WebhookRepository {
+	return &PostgresWebhookRepository{
+		dbpool: dbpool,
+	}
+}
+
+// Save menyimpan webhook baru.
+func (r *PostgresWebhookRepository) Save(ctx context.Context, webhook *domain.Webhook) error {
+	eventTypes := make([]string, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	query := `INSERT INTO webhooks (` + webhookColumns + `)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := r.dbpool.Exec(ctx, query,
+		webhook.ID,
+		webhook.UserID,
+		webhook.URL,
+		webhook.Secret,
+		eventTypes,
+		webhook.PayloadTemplate,
+		webhook.ContentType,
+		webhook.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving webhook for user_id %s: %w", webhook.UserID, err)
+	}
+	return nil
+}
+
+// FindByUserID mengembalikan webhook milik pengguna.
+func (r *PostgresWebhookRepository) FindByUserID(ctx context.Context, userID domain.UserID) ([]*domain.Webhook, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT `+webhookColumns+`
+	           FROM webhooks WHERE user_id = $1 ORDER BY created_at, id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding webhooks for user_id %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var webhooks []*domain.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook row: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook rows: %w", err)
+	}
+	return webhooks, nil
+}
+
+// Delete menghapus webhook milik pengguna.
+func (r *PostgresWebhookRepository) Delete(ctx context.Context, userID domain.UserID, id string) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM webhooks WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("error deleting webhook %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
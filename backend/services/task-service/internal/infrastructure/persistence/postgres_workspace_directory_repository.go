@@ -0,0 +1,386 @@
+// file: backend/services/task-service/internal/infrastructure/persistence/postgres_workspace_directory_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// workspaceMemberColumns adalah daftar kolom yang dibaca untuk setiap anggota, sesuai urutan Scan di
+// scanWorkspaceMember. Kolom terakhir adalah role tertinggi dari grup yang dipetakan.
+const workspaceMemberColumns = `m.id, m.workspace_id, m.user_name, m.external_id, m.display_name, m.active,
+	COALESCE((SELECT rm.role FROM workspace_group_members gm
+	            JOIN workspace_groups g ON g.id = gm.group_id
+	            JOIN workspace_role_mappings rm ON rm.workspace_id = g.workspace_id AND rm.group_name = lower(g.display_name)
+	           WHERE gm.member_id = m.id
+	           ORDER BY array_position(ARRAY['viewer', 'member', 'admin'], rm.role) DESC LIMIT 1), 'member'),
+	m.created_at, m.updated_at`
+
+// workspaceGroupColumns adalah daftar kolom yang dibaca untuk setiap grup, sesuai urutan Scan di
+// scanWorkspaceGroup.
+const workspaceGroupColumns = `g.id, g.workspace_id, g.display_name, g.external_id,
+	ARRAY(SELECT member_id FROM workspace_group_members WHERE group_id = g.id ORDER BY member_id),
+	g.created_at, g.updated_at`
+
+// workspaceMemberFilters dan workspaceGroupFilters memetakan atribut filter SCIM ke kolom.
+var (
+	workspaceMemberFilters = map[string]string{"userName": "m.user_name", "externalId": "m.external_id", "displayName": "m.display_name"}
+	workspaceGroupFilters  = map[string]string{"displayName": "g.display_name", "externalId": "g.external_id"}
+)
+
+func scanWorkspaceMember(row pgx.Row, extra ...any) (*domain.WorkspaceMember, error) {
+	member := &domain.WorkspaceMember{}
+	err := row.Scan(append([]any{
+		&member.ID,
+		&member.WorkspaceID,
+		&member.UserName,
+		&member.ExternalID,
+		&member.DisplayName,
+		&member.Active,
+		&member.Role,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+	}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func scanWorkspaceGroup(row pgx.Row, extra ...any) (*domain.WorkspaceGroup, error) {
+	group := &domain.WorkspaceGroup{}
+	err := row.Scan(append([]any{
+		&group.ID,
+		&group.WorkspaceID,
+		&group.DisplayName,
+		&group.ExternalID,
+		&group.MemberIDs,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+	}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// isUniqueViolation bernilai true jika err adalah unique_violation pada index constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
+// PostgresWorkspaceDirectoryRepository adalah implementasi domain.WorkspaceDirectoryRepository
+// menggunakan tabel workspace_members, workspace_groups, dan workspace_role_mappings.
+type PostgresWorkspaceDirectoryRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresWorkspaceDirectoryRepository adalah constructor untuk PostgresWorkspaceDirectoryRepository.
+func NewPostgresWorkspaceDirectoryRepository(dbpool *pgxpool.Pool) domain.WorkspaceDirectoryRepository {
+	return &PostgresWorkspaceDirectoryRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindMember mencari anggota di workspace.
+func (r *PostgresWorkspaceDirectoryRepository) FindMember(ctx context.Context, workspaceID domain.UserID, id string) (*domain.WorkspaceMember, error) {
+	member, err := scanWorkspaceMember(r.dbpool.QueryRow(ctx, `SELECT `+workspaceMemberColumns+`
+	           FROM workspace_members m WHERE m.workspace_id = $1 AND m.id = $2`, workspaceID, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrWorkspaceMemberNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding workspace member %s: %w", id, err)
+	}
+	return member, nil
+}
+
+// FindMembers menghitung total dengan COUNT(*) OVER () agar halaman dan total dibaca dalam satu query.
+func (r *PostgresWorkspaceDirectoryRepository) FindMembers(ctx context.Context, workspaceID domain.UserID, query domain.WorkspaceDirectoryQuery) (*domain.WorkspaceMemberPage, error) {
+	condition, err := directoryFilterCondition(workspaceMemberFilters, query.FilterAttribute)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.dbpool.Query(ctx, `SELECT `+workspaceMemberColumns+`, COUNT(*) OVER ()
+	           FROM workspace_members m WHERE m.workspace_id = $1 AND `+condition+`
+	           ORDER BY m.created_at, m.id OFFSET $3 LIMIT $4`,
+		workspaceID, query.FilterValue, query.Offset, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding workspace members for %s: %w", workspaceID, err)
+	}
+	defer rows.Close()
+
+	page := &domain.WorkspaceMemberPage{}
+	for rows.Next() {
+		member, err := scanWorkspaceMember(rows, &page.Total)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning workspace member row: %w", err)
+		}
+		page.Members = append(page.Members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspace member rows: %w", err)
+	}
+	if len(page.Members) == 0 && query.Offset > 0 {
+		// Halaman di luar jangkauan tidak membawa COUNT(*) OVER (), jadi total dihitung terpisah.
+		if err := r.dbpool.QueryRow(ctx, `SELECT COUNT(*) FROM workspace_members m WHERE m.workspace_id = $1 AND `+condition,
+			workspaceID, query.FilterValue).Scan(&page.Total); err != nil {
+			return nil, fmt.Errorf("error counting workspace members for %s: %w", workspaceID, err)
+		}
+	}
+	return page, nil
+}
+
+// SaveMember melakukan upsert berdasarkan ID. ID yang sudah dipakai workspace lain tidak ditimpa.
+func (r *PostgresWorkspaceDirectoryRepository) SaveMember(ctx context.Context, member *domain.WorkspaceMember) error {
+	tag, err := r.dbpool.Exec(ctx, `INSERT INTO workspace_members (id, workspace_id, user_name, external_id, display_name, active, created_at, updated_at)
+	           VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	           ON CONFLICT (id) DO UPDATE
+	           SET user_name = EXCLUDED.user_name, external_id = EXCLUDED.external_id,
+	               display_name = EXCLUDED.display_name, active = EXCLUDED.active, updated_at = EXCLUDED.updated_at
+	           WHERE workspace_members.workspace_id = EXCLUDED.workspace_id`,
+		member.ID, member.WorkspaceID, member.UserName, member.ExternalID, member.DisplayName, member.Active,
+		member.CreatedAt, member.UpdatedAt)
+	if isUniqueViolation(err, "idx_workspace_members_user_name") {
+		return domain.ErrWorkspaceMemberExists
+	}
+	if err != nil {
+		return fmt.Errorf("error saving workspace member %s: %w", member.ID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		// Konflik ID dengan anggota workspace lain: DO UPDATE dilewati.
+		return domain.ErrWorkspaceMemberNotFound
+	}
+	return nil
+}
+
+// DeleteMember menghapus anggota; keanggotaan grupnya terhapus lewat ON DELETE CASCADE.
+func (r *PostgresWorkspaceDirectoryRepository) DeleteMember(ctx context.Context, workspaceID domain.UserID, id string) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM workspace_members WHERE workspace_id = $1 AND id = $2`, workspaceID, id)
+	if err != nil {
+		return fmt.Errorf("error deleting workspace member %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWorkspaceMemberNotFound
+	}
+	return nil
+}
+
+// FindGroup mencari grup di workspace beserta ID anggotanya.
+func (r *PostgresWorkspaceDirectoryRepository) FindGroup(ctx context.Context, workspaceID domain.UserID, id string) (*domain.WorkspaceGroup, error) {
+	group, err := scanWorkspaceGroup(r.dbpool.QueryRow(ctx, `SELECT `+workspaceGroupColumns+`
+	           FROM workspace_groups g WHERE g.workspace_id = $1 AND g.id = $2`, workspaceID, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrWorkspaceGroupNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding workspace group %s: %w", id, err)
+	}
+	return group, nil
+}
+
+// FindGroups mengembalikan satu halaman grup, seperti FindMembers.
+func (r *PostgresWorkspaceDirectoryRepository) FindGroups(ctx context.Context, workspaceID domain.UserID, query domain.WorkspaceDirectoryQuery) (*domain.WorkspaceGroupPage, error) {
+	condition, err := directoryFilterCondition(workspaceGroupFilters, query.FilterAttribute)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.dbpool.Query(ctx, `SELECT `+workspaceGroupColumns+`, COUNT(*) OVER ()
+	           FROM workspace_groups g WHERE g.workspace_id = $1 AND `+condition+`
+	           ORDER BY g.created_at, g.id OFFSET $3 LIMIT $4`,
+		workspaceID, query.FilterValue, query.Offset, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding workspace groups for %s: %w", workspaceID, err)
+	}
+	defer rows.Close()
+
+	page := &domain.WorkspaceGroupPage{}
+	for rows.Next() {
+		group, err := scanWorkspaceGroup(rows, &page.Total)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning workspace group row: %w", err)
+		}
+		page.Groups = append(page.Groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspace group rows: %w", err)
+	}
+	if len(page.Groups) == 0 && query.Offset > 0 {
+		if err := r.dbpool.QueryRow(ctx, `SELECT COUNT(*) FROM workspace_groups g WHERE g.workspace_id = $1 AND `+condition,
+			workspaceID, query.FilterValue).Scan(&page.Total); err != nil {
+			return nil, fmt.Errorf("error counting workspace groups for %s: %w", workspaceID, err)
+		}
+	}
+	return page, nil
+}
+
+// SaveGroup melakukan upsert grup lalu mengganti seluruh anggotanya. Anggota disisipkan dari
+// workspace_members, sehingga ID yang bukan anggota workspace ini terdeteksi dari jumlah baris.
+func (r *PostgresWorkspaceDirectoryRepository) SaveGroup(ctx context.Context, group *domain.WorkspaceGroup) error {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting workspace group transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	tag, err := tx.Exec(ctx, `INSERT INTO workspace_groups (id, workspace_id, display_name, external_id, created_at, updated_at)
+	           VALUES ($1, $2, $3, $4, $5, $6)
+	           ON CONFLICT (id) DO UPDATE
+	           SET display_name = EXCLUDED.display_name, external_id = EXCLUDED.external_id, updated_at = EXCLUDED.updated_at
+	           WHERE workspace_groups.workspace_id = EXCLUDED.workspace_id`,
+		group.ID, group.WorkspaceID, group.DisplayName, group.ExternalID, group.CreatedAt, group.UpdatedAt)
+	if isUniqueViolation(err, "idx_workspace_groups_display_name") {
+		return domain.ErrWorkspaceGroupExists
+	}
+	if err != nil {
+		return fmt.Errorf("error saving workspace group %s: %w", group.ID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWorkspaceGroupNotFound
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM workspace_group_members WHERE group_id = $1`, group.ID); err != nil {
+		return fmt.Errorf("error clearing members of workspace group %s: %w", group.ID, err)
+	}
+	tag, err = tx.Exec(ctx, `INSERT INTO workspace_group_members (group_id, member_id)
+	           SELECT $1, id FROM workspace_members WHERE workspace_id = $2 AND id = ANY($3::text[])`,
+		group.ID, group.WorkspaceID, group.MemberIDs)
+	if err != nil {
+		return fmt.Errorf("error saving members of workspace group %s: %w", group.ID, err)
+	}
+	if int(tag.RowsAffected()) != len(group.MemberIDs) {
+		return fmt.Errorf("%w: members must be existing users of the workspace", domain.ErrInvalidWorkspaceGroup)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing workspace group transaction: %w", err)
+	}
+	return nil
+}
+
+// DeleteGroup menghapus grup; keanggotaannya terhapus lewat ON DELETE CASCADE.
+func (r *PostgresWorkspaceDirectoryRepository) DeleteGroup(ctx context.Context, workspaceID domain.UserID, id string) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM workspace_groups WHERE workspace_id = $1 AND id = $2`, workspaceID, id)
+	if err != nil {
+		return fmt.Errorf("error deleting workspace group %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWorkspaceGroupNotFound
+	}
+	return nil
+}
+
+// FindRoleMappings membaca pemetaan nama grup ke role milik workspace.
+func (r *PostgresWorkspaceDirectoryRepository) FindRoleMappings(ctx context.Context, workspaceID domain.UserID) (map[string]domain.WorkspaceRole, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT group_name, role FROM workspace_role_mappings WHERE workspace_id = $1`, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding role mappings for %s: %w", workspaceID, err)
+	}
+	defer rows.Close()
+
+	mappings := make(map[string]domain.WorkspaceRole)
+	for rows.Next() {
+		var groupName string
+		var role domain.WorkspaceRole
+		if err := rows.Scan(&groupName, &role); err != nil {
+			return nil, fmt.Errorf("error scanning role mapping row: %w", err)
+		}
+		mappings[groupName] = role
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating role mapping rows: %w", err)
+	}
+	return mappings, nil
+}
+
+// ReplaceRoleMappings menghapus pemetaan lama dan menyisipkan yang baru dalam satu transaksi.
+func (r *PostgresWorkspaceDirectoryRepository) ReplaceRoleMappings(ctx context.Context, workspaceID domain.UserID, mappings map[string]domain.WorkspaceRole) error {
+	names := make([]string, 0, len(mappings))
+	roles := make([]string, 0, len(mappings))
+	for name, role := range mappings {
+		names = append(names, name)
+		roles = append(roles, string(role))
+	}
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting role mapping transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op jika transaksi sudah di-commit
+
+	if _, err := tx.Exec(ctx, `DELETE FROM workspace_role_mappings WHERE workspace_id = $1`, workspaceID); err != nil {
+		return fmt.Errorf("error clearing role mappings for %s: %w", workspaceID, err)
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO workspace_role_mappings (workspace_id, group_name, role)
+	           SELECT $1, name, role FROM unnest($2::text[], $3::text[]) AS m(name, role)`,
+		workspaceID, names, roles); err != nil {
+		return fmt.Errorf("error saving role mappings for %s: %w", workspaceID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing role mapping transaction: %w", err)
+	}
+	return nil
+}
+
+// directoryFilterCondition membentuk kondisi WHERE untuk filter SCIM dengan nilai di parameter $2.
+// Kolom diambil dari filters, bukan dari input, sehingga aman disisipkan ke SQL.
+func directoryFilterCondition(filters map[string]string, attribute string) (string, error) {
+	if attribute == "" {
+		return `$2::text IS NOT NULL`, nil // Tetap memakai $2 agar jumlah parameter sama
+	}
+	column, ok := filters[attribute]
+	if !ok {
+		return "", domain.ErrInvalidScimFilter
+	}
+	return `lower(` + column + `) = lower($2)`, nil
+}
+
+// PostgresScimTokenRepository adalah implementasi domain.ScimTokenRepository menggunakan tabel scim_tokens.
+type PostgresScimTokenRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresScimTokenRepository adalah constructor untuk PostgresScimTokenRepository.
+func NewPostgresScimTokenRepository(dbpool *pgxpool.Pool) domain.ScimTokenRepository {
+	return &PostgresScimTokenRepository{
+		dbpool: dbpool,
+	}
+}
+
+// FindWorkspace mencari workspace pemilik hash token.
+func (r *PostgresScimTokenRepository) FindWorkspace(ctx context.Context, tokenHash string) (domain.UserID, error) {
+	var workspaceID domain.UserID
+	err := r.dbpool.QueryRow(ctx, `SELECT workspace_id FROM scim_tokens WHERE token_hash = $1`, tokenHash).Scan(&workspaceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrInvalidScimToken
+	}
+	if err != nil {
+		return "", fmt.Errorf("error finding SCIM token: %w", err)
+	}
+	return workspaceID, nil
+}
+
+// Replace menyimpan token baru; token lama langsung tidak berlaku.
+func (r *PostgresScimTokenRepository) Replace(ctx context.Context, workspaceID domain.UserID, tokenHash string, createdAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `INSERT INTO scim_tokens (workspace_id, token_hash, created_at) VALUES ($1, $2, $3)
+	           ON CONFLICT (workspace_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at`,
+		workspaceID, tokenHash, createdAt)
+	if err != nil {
+		return fmt.Errorf("error saving SCIM token for %s: %w", workspaceID, err)
+	}
+	return nil
+}
+
+// Delete mencabut token workspace.
+func (r *PostgresScimTokenRepository) Delete(ctx context.Context, workspaceID domain.UserID) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM scim_tokens WHERE workspace_id = $1`, workspaceID); err != nil {
+		return fmt.Errorf("error deleting SCIM token for %s: %w", workspaceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// file: backend/services/task-service/internal/infrastructure/push/fcm.go
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Endpoint FCM HTTP v1 dan scope OAuth-nya.
+const (
+	fcmSendURL  = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+	fcmScope    = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmTokenURL = "https://oauth2.googleapis.com/token"
+)
+
+// accessTokenMargin adalah jarak sebelum kedaluwarsa saat access token diperbarui.
+const accessTokenMargin = time.Minute
+
+// serviceAccount adalah bagian file JSON service account Google yang dipakai.
+type serviceAccount struct {
+	ProjectID   string `json:"project_id"`
+	PrivateKey  string `json:"private_key"`
+	ClientEmail string `json:"client_email"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMClient mengirim notifikasi lewat FCM HTTP v1 API dengan service account, tanpa SDK Firebase.
+type FCMClient struct {
+	projectID   string
+	clientEmail string
+	tokenURL    string
+	key         *rsa.PrivateKey
+	client      *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMClient membaca credentials (isi file JSON service account).
+func NewFCMClient(credentials []byte) (*FCMClient, error) {
+	var account serviceAccount
+	if err := json.Unmarshal(credentials, &account); err != nil {
+		return nil, fmt.Errorf("invalid fcm credentials: %w", err)
+	}
+	if account.ProjectID == "" || account.ClientEmail == "" {
+		return nil, errors.New("invalid fcm credentials: project_id and client_email are required")
+	}
+	block, _ := pem.Decode([]byte(account.PrivateKey))
+	if block == nil {
+		return nil, errors.New("invalid fcm credentials: private_key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fcm credentials: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("invalid fcm credentials: private_key is not an RSA key")
+	}
+	tokenURL := account.TokenURI
+	if tokenURL == "" {
+		tokenURL = fcmTokenURL
+	}
+	return &FCMClient{
+		projectID:   account.ProjectID,
+		clientEmail: account.ClientEmail,
+		tokenURL:    tokenURL,
+		key:         key,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// fcmNotification adalah field tampilan notifikasi; tag dan collapse ID dipasang per platform.
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+	Android      *fcmAndroid       `json:"android,omitempty"`
+	APNS         *fcmAPNS          `json:"apns,omitempty"`
+	WebPush      *fcmWebPush       `json:"webpush,omitempty"`
+}
+
+type fcmAndroid struct {
+	Notification struct {
+		Tag string `json:"tag"`
+	} `json:"notification"`
+}
+
+type fcmAPNS struct {
+	Headers map[string]string `json:"headers"`
+}
+
+type fcmWebPush struct {
+	Notification struct {
+		Tag string `json:"tag"`
+	} `json:"notification"`
+}
+
+// fcmError adalah body error FCM; errorCode UNREGISTERED berarti token sudah tidak berlaku.
+type fcmError struct {
+	Error struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// Send mengirim msg ke registration token. Token yang tidak terdaftar lagi (aplikasi di-uninstall
+// atau token diganti) dilaporkan sebagai domain.ErrPushSubscriptionGone.
+func (c *FCMClient) Send(ctx context.Context, token string, msg domain.PushMessage) error {
+	message := fcmMessage{
+		Token:        token,
+		Notification: fcmNotification{Title: msg.Title, Body: msg.Body},
+		Data:         msg.Data,
+	}
+	if msg.Tag != "" {
+		message.Android = &fcmAndroid{}
+		message.Android.Notification.Tag = msg.Tag
+		message.APNS = &fcmAPNS{Headers: map[string]string{"apns-collapse-id": msg.Tag}}
+		message.WebPush = &fcmWebPush{}
+		message.WebPush.Notification.Tag = msg.Tag
+	}
+	body, err := json.Marshal(map[string]fcmMessage{"message": message})
+	if err != nil {
+		return fmt.Errorf("error encoding fcm message: %w", err)
+	}
+	accessToken, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf(fcmSendURL, url.PathEscape(c.projectID)), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating fcm request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending fcm message: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	var apiErr fcmError
+	json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&apiErr)
+	if resp.StatusCode == http.StatusNotFound {
+		return domain.ErrPushSubscriptionGone
+	}
+	for _, detail := range apiErr.Error.Details {
+		if detail.ErrorCode == "UNREGISTERED" {
+			return domain.ErrPushSubscriptionGone
+		}
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		c.mu.Lock()
+		c.accessToken = "" // Diterbitkan ulang pada pengiriman berikutnya
+		c.mu.Unlock()
+	}
+	return fmt.Errorf("fcm returned status %d: %s %s", resp.StatusCode, apiErr.Error.Status, apiErr.Error.Message)
+}
+
+// token mengembalikan access token OAuth dari cache, atau menukar JWT service account yang baru
+// (grant jwt-bearer) jika token hampir kedaluwarsa.
+func (c *FCMClient) token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.accessToken != "" && now.Add(accessTokenMargin).Before(c.expiresAt) {
+		return c.accessToken, nil
+	}
+
+	assertion, err := c.assertion(now)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("error creating fcm token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting fcm access token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("fcm token endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil || token.AccessToken == "" {
+		return "", fmt.Errorf("invalid fcm access token response: %v", err)
+	}
+	c.accessToken = token.AccessToken
+	c.expiresAt = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	return c.accessToken, nil
+}
+
+// assertion membuat JWT RS256 yang ditandatangani kunci service account.
+func (c *FCMClient) assertion(now time.Time) (string, error) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]any{
+		"iss":   c.clientEmail,
+		"scope": fcmScope,
+		"aud":   c.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error encoding fcm assertion: %w", err)
+	}
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("error signing fcm assertion: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
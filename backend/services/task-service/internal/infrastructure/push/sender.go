@@ -0,0 +1,60 @@
+// file: backend/services/task-service/internal/infrastructure/push/sender.go
+package push
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Config adalah konfigurasi provider push. Provider yang tidak diisi dimatikan.
+type Config struct {
+	FCMCredentialsFile string // Path file JSON service account Firebase; kosong mematikan FCM
+
+	VAPIDPublicKey  string // Kunci publik P-256 tanpa kompresi, base64url; kosong mematikan Web Push
+	VAPIDPrivateKey string // Skalar privat P-256 32 byte, base64url
+	VAPIDSubject    string // Kontak untuk push service, "mailto:…" atau "https://…"
+}
+
+// Sender adalah implementasi domain.PushSender yang meneruskan setiap langganan ke provider-nya.
+type Sender struct {
+	fcm     *FCMClient     // nil jika FCM tidak dikonfigurasi
+	webPush *WebPushClient // nil jika Web Push tidak dikonfigurasi
+}
+
+// New membuat Sender dari cfg. Error dikembalikan jika konfigurasi provider yang diisi tidak valid.
+func New(cfg Config) (*Sender, error) {
+	sender := &Sender{}
+	if cfg.FCMCredentialsFile != "" {
+		credentials, err := os.ReadFile(cfg.FCMCredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading fcm credentials: %w", err)
+		}
+		sender.fcm, err = NewFCMClient(credentials)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.VAPIDPublicKey != "" || cfg.VAPIDPrivateKey != "" {
+		var err error
+		sender.webPush, err = NewWebPushClient(cfg.VAPIDPublicKey, cfg.VAPIDPrivateKey, cfg.VAPIDSubject)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sender, nil
+}
+
+// Send mengirim msg lewat provider langganan sub.
+func (s *Sender) Send(ctx context.Context, sub domain.PushSubscription, msg domain.PushMessage) error {
+	switch {
+	case sub.Provider == domain.PushProviderFCM && s.fcm != nil:
+		return s.fcm.Send(ctx, sub.Token, msg)
+	case sub.Provider == domain.PushProviderWebPush && s.webPush != nil:
+		return s.webPush.Send(ctx, sub, msg)
+	default:
+		return domain.ErrPushNotConfigured
+	}
+}
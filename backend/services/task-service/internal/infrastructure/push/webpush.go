@@ -0,0 +1,239 @@
+// file: backend/services/task-service/internal/infrastructure/push/webpush.go
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/safehttp"
+)
+
+const (
+	// webPushRecordSize adalah ukuran record aes128gcm; payload dikirim sebagai satu record.
+	webPushRecordSize = 4096
+
+	// maxWebPushPayload adalah ukuran plaintext terbesar yang masih muat dalam satu record
+	// (dikurangi delimiter padding dan tag GCM).
+	maxWebPushPayload = webPushRecordSize - 1 - 16
+
+	// webPushTTL adalah lama push service menyimpan pesan untuk perangkat yang sedang offline.
+	webPushTTL = 24 * time.Hour
+
+	// vapidTokenLifetime adalah masa berlaku JWT VAPID (maksimum 24 jam menurut RFC 8292).
+	vapidTokenLifetime = 12 * time.Hour
+)
+
+// WebPushClient mengirim notifikasi Web Push (RFC 8030) dengan payload terenkripsi (RFC 8291) dan
+// autentikasi VAPID (RFC 8292).
+type WebPushClient struct {
+	publicKey string // base64url, dikirim di header Authorization dan dipakai browser saat subscribe
+	key       *ecdsa.PrivateKey
+	subject   string
+	client    *http.Client
+}
+
+// NewWebPushClient membuat WebPushClient dari pasangan kunci VAPID.
+func NewWebPushClient(publicKey, privateKey, subject string) (*WebPushClient, error) {
+	if !strings.HasPrefix(subject, "mailto:") && !strings.HasPrefix(subject, "https://") {
+		return nil, errors.New("invalid vapid subject: must start with mailto: or https://")
+	}
+	scalar, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(privateKey, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid vapid private key: %w", err)
+	}
+	ecdhKey, err := ecdh.P256().NewPrivateKey(scalar)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vapid private key: %w", err)
+	}
+	point := ecdhKey.PublicKey().Bytes()
+	if base64.RawURLEncoding.EncodeToString(point) != strings.TrimRight(publicKey, "=") {
+		return nil, errors.New("invalid vapid keys: public key does not match private key")
+	}
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(point[1:33]),
+			Y:     new(big.Int).SetBytes(point[33:]),
+		},
+		D: new(big.Int).SetBytes(scalar),
+	}
+	return &WebPushClient{
+		publicKey: base64.RawURLEncoding.EncodeToString(point),
+		key:       key,
+		subject:   subject,
+		client:    safehttp.NewClient(false, 10*time.Second),
+	}, nil
+}
+
+// webPushPayload adalah isi pesan yang dibaca service worker klien untuk showNotification.
+type webPushPayload struct {
+	Title string            `json:"title"`
+	Body  string            `json:"body"`
+	Tag   string            `json:"tag,omitempty"`
+	Data  map[string]string `json:"data,omitempty"`
+}
+
+// Send mengenkripsi msg untuk langganan sub lalu mengirimnya ke endpoint push service. Endpoint
+// berasal dari klien, jadi dikirim lewat safehttp agar tidak bisa menjangkau jaringan internal.
+func (c *WebPushClient) Send(ctx context.Context, sub domain.PushSubscription, msg domain.PushMessage) error {
+	if sub.Keys == nil {
+		return domain.ErrInvalidPushSubscription
+	}
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil {
+		return domain.ErrInvalidPushSubscription
+	}
+	payload, err := json.Marshal(webPushPayload{Title: msg.Title, Body: msg.Body, Tag: msg.Tag, Data: msg.Data})
+	if err != nil {
+		return fmt.Errorf("error encoding web push payload: %w", err)
+	}
+	if len(payload) > maxWebPushPayload {
+		return fmt.Errorf("web push payload is %d bytes; at most %d fit in one record", len(payload), maxWebPushPayload)
+	}
+	body, err := encryptWebPush(payload, sub.Keys)
+	if err != nil {
+		return err
+	}
+	token, err := c.vapidToken(endpoint.Scheme+"://"+endpoint.Host, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating web push request: %w", err)
+	}
+	req.Header.Set("Authorization", "vapid t="+token+", k="+c.publicKey)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(webPushTTL/time.Second)))
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending web push message: %w", err)
+	}
+	defer resp.Body.Close()
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return domain.ErrPushSubscriptionGone
+	default:
+		return fmt.Errorf("web push service returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+}
+
+// vapidToken membuat JWT ES256 untuk origin push service (claim aud).
+func (c *WebPushClient) vapidToken(audience string, now time.Time) (string, error) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"JWT","alg":"ES256"}`))
+	claims, err := json.Marshal(map[string]any{
+		"aud": audience,
+		"exp": now.Add(vapidTokenLifetime).Unix(),
+		"sub": c.subject,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error encoding vapid claims: %w", err)
+	}
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, c.key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("error signing vapid token: %w", err)
+	}
+	signature := make([]byte, 64) // r || s, masing-masing 32 byte big-endian (JWS ES256)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// encryptWebPush mengenkripsi payload dengan content coding aes128gcm (RFC 8188) memakai kunci
+// yang diturunkan dari ECDH dengan kunci p256dh langganan dan secret auth-nya (RFC 8291).
+func encryptWebPush(payload []byte, keys *domain.PushKeys) ([]byte, error) {
+	uaPublicBytes, err := decodePushKey(keys.P256dh)
+	if err != nil {
+		return nil, domain.ErrInvalidPushSubscription
+	}
+	authSecret, err := decodePushKey(keys.Auth)
+	if err != nil {
+		return nil, domain.ErrInvalidPushSubscription
+	}
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, domain.ErrInvalidPushSubscription
+	}
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating web push key: %w", err)
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+	sharedSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, domain.ErrInvalidPushSubscription
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("error generating web push salt: %w", err)
+	}
+
+	keyInfo := "WebPush: info\x00" + string(uaPublicBytes) + string(asPublic)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving web push key: %w", err)
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving web push key: %w", err)
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving web push key: %w", err)
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving web push nonce: %w", err)
+	}
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, fmt.Errorf("error creating web push cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error creating web push cipher: %w", err)
+	}
+
+	// Header: salt (16) || rs (4) || idlen (1) || keyid (kunci publik server), lalu satu record
+	// terakhir dengan delimiter padding 0x02.
+	header := make([]byte, 0, 16+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, webPushRecordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+// decodePushKey membaca kunci base64url dari browser, dengan atau tanpa padding.
+func decodePushKey(key string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(key, "="))
+}
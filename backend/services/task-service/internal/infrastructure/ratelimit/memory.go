@@ -0,0 +1,68 @@
+// file: backend/services/task-service/internal/infrastructure/ratelimit/memory.go
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// minSweepSize adalah jumlah key paling sedikit sebelum key yang sudah terisi penuh dibersihkan.
+const minSweepSize = 1024
+
+// MemoryLimiter adalah implementasi domain.RateLimiter dengan GCRA (generic cell rate algorithm)
+// di memori proses. Batasnya hanya berlaku per replika; untuk beberapa replika pakai RedisLimiter.
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	tat     map[string]time.Time // Theoretical arrival time: saat kuota key terisi penuh kembali
+	sweepAt int
+}
+
+// NewMemoryLimiter adalah constructor untuk MemoryLimiter.
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		tat:     make(map[string]time.Time),
+		sweepAt: minSweepSize,
+	}
+}
+
+// Allow mengimplementasikan domain.RateLimiter.
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit domain.RateLimit) (domain.RateLimitResult, error) {
+	if !limit.Enabled() {
+		return domain.RateLimitResult{Allowed: true, Remaining: limit.Requests}, nil
+	}
+	emission := limit.Period / time.Duration(limit.Requests)
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tat := l.tat[key]
+	if tat.Before(now) {
+		tat = now
+	}
+	newTAT := tat.Add(emission)
+	if allowAt := newTAT.Add(-limit.Period); allowAt.After(now) {
+		return domain.RateLimitResult{RetryAfter: allowAt.Sub(now), ResetAfter: tat.Sub(now)}, nil
+	}
+	l.tat[key] = newTAT
+	if len(l.tat) >= l.sweepAt {
+		l.sweep(now)
+	}
+	return domain.RateLimitResult{
+		Allowed:    true,
+		Remaining:  int((limit.Period - newTAT.Sub(now)) / emission),
+		ResetAfter: newTAT.Sub(now),
+	}, nil
+}
+
+// sweep menghapus key yang kuotanya sudah terisi penuh, karena hasilnya sama dengan key baru.
+func (l *MemoryLimiter) sweep(now time.Time) {
+	for key, tat := range l.tat {
+		if !tat.After(now) {
+			delete(l.tat, key)
+		}
+	}
+	l.sweepAt = max(2*len(l.tat), minSweepSize)
+}
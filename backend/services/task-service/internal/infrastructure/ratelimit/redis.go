@@ -0,0 +1,69 @@
+// file: backend/services/task-service/internal/infrastructure/ratelimit/redis.go
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix adalah awalan key Redis milik RedisLimiter.
+const keyPrefix = "ratelimit:"
+
+// gcraScript menjalankan GCRA secara atomik dengan jam server Redis, sehingga selisih jam antar
+// replika tidak berpengaruh. Semua waktu dalam mikrodetik. ARGV: jarak antar request dan periode.
+// TAT ditulis dengan string.format karena angka Lua diubah ke string dengan presisi 14 digit.
+// Mengembalikan {allowed, remaining, retry_after, reset_after}.
+var gcraScript = redis.NewScript(`
+local emission = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+  tat = now
+end
+local new_tat = tat + emission
+local allow_at = new_tat - period
+if allow_at > now then
+  return {0, 0, allow_at - now, tat - now}
+end
+redis.call('SET', KEYS[1], string.format('%.0f', new_tat), 'PX', math.ceil((new_tat - now) / 1000))
+return {1, math.floor((period - (new_tat - now)) / emission), 0, new_tat - now}
+`)
+
+// RedisLimiter adalah implementasi domain.RateLimiter dengan GCRA di Redis, sehingga batas
+// berlaku bersama di semua replika. Setiap key hanya menyimpan satu nilai dengan TTL sepanjang
+// sisa pengisian kuota.
+type RedisLimiter struct {
+	client redis.Scripter
+}
+
+// NewRedisLimiter adalah constructor untuk RedisLimiter.
+func NewRedisLimiter(client redis.Scripter) *RedisLimiter {
+	return &RedisLimiter{client: client}
+}
+
+// Allow mengimplementasikan domain.RateLimiter.
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit domain.RateLimit) (domain.RateLimitResult, error) {
+	if !limit.Enabled() {
+		return domain.RateLimitResult{Allowed: true, Remaining: limit.Requests}, nil
+	}
+	emission := limit.Period.Microseconds() / int64(limit.Requests)
+	values, err := gcraScript.Run(ctx, l.client, []string{keyPrefix + key}, max(emission, 1), limit.Period.Microseconds()).Int64Slice()
+	if err != nil {
+		return domain.RateLimitResult{}, fmt.Errorf("error running rate limit script: %w", err)
+	}
+	if len(values) != 4 {
+		return domain.RateLimitResult{}, fmt.Errorf("unexpected rate limit script result %v", values)
+	}
+	return domain.RateLimitResult{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Microsecond,
+		ResetAfter: time.Duration(values[3]) * time.Microsecond,
+	}, nil
+}
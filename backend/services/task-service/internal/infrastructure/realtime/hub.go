@@ -0,0 +1,100 @@
+// file: backend/services/task-service/internal/infrastructure/realtime/hub.go
+package realtime
+
+import (
+	"context"
+	"sync"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Dispatcher menerima event yang sudah diterima dari change feed dan meneruskannya ke subscriber lokal.
+type Dispatcher interface {
+	Dispatch(event domain.TaskEvent)
+}
+
+// Listener menerima event dari change feed semua replika dan meneruskannya ke Dispatcher lokal.
+// Diimplementasikan oleh PostgresListener dan RedisSubscriber.
+type Listener interface {
+	Run(ctx context.Context) error
+	Connected() bool // Untuk pemeriksaan readiness
+}
+
+// defaultSubscriberBuffer adalah kapasitas channel tiap subscriber.
+const defaultSubscriberBuffer = 64
+
+// Hub adalah pub/sub in-process yang meneruskan TaskEvent ke subscriber milik pengguna yang sama,
+// misalnya koneksi WebSocket yang terhubung ke replika ini.
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[domain.UserID]map[*subscription]struct{}
+}
+
+type subscription struct {
+	userID domain.UserID
+	events chan domain.TaskEvent
+}
+
+// NewHub adalah constructor untuk Hub.
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[domain.UserID]map[*subscription]struct{}),
+	}
+}
+
+// Subscribe mendaftarkan subscriber baru untuk event milik userID.
+// Channel yang dikembalikan ditutup saat cancel dipanggil, atau saat subscriber terlalu lambat
+// membaca event (channel penuh). Dalam kasus kedua klien harus melakukan resync lewat /sync.
+func (h *Hub) Subscribe(userID domain.UserID) (<-chan domain.TaskEvent, func()) {
+	sub := &subscription{
+		userID: userID,
+		events: make(chan domain.TaskEvent, defaultSubscriberBuffer),
+	}
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[*subscription]struct{})
+	}
+	h.subscribers[userID][sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub.events, func() { h.remove(sub) }
+}
+
+// Dispatch meneruskan event ke semua subscriber milik event.UserID tanpa pernah memblokir.
+func (h *Hub) Dispatch(event domain.TaskEvent) {
+	var slow []*subscription
+
+	h.mu.RLock()
+	for sub := range h.subscribers[event.UserID] {
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range slow {
+		h.remove(sub)
+	}
+}
+
+// remove menghapus subscriber dan menutup channel-nya. Aman dipanggil lebih dari sekali.
+func (h *Hub) remove(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subscribers[sub.userID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.userID)
+	}
+	close(sub.events)
+}
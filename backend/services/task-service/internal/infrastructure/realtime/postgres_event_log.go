@@ -0,0 +1,47 @@
+// file: backend/services/task-service/internal/infrastructure/realtime/postgres_event_log.go
+package realtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresEventLog adalah implementasi domain.TaskEventLog yang membaca tabel task_events, yaitu
+// tabel yang sama dengan sumber PostgresListener.
+type PostgresEventLog struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresEventLog adalah constructor untuk PostgresEventLog.
+func NewPostgresEventLog(dbpool *pgxpool.Pool) domain.TaskEventLog {
+	return &PostgresEventLog{
+		dbpool: dbpool,
+	}
+}
+
+// FindAfter membaca event milik userID setelah afterID.
+//
+// ID event berurutan secara global, bukan per pengguna, sehingga event yang hilang karena retensi
+// tidak bisa dibedakan dari event milik pengguna lain. Karena itu afterID dianggap kedaluwarsa jika
+// event tertua yang masih ada lebih baru dari afterID+1; akibatnya paling buruk resync yang tidak
+// perlu, bukan event yang terlewat diam-diam.
+func (l *PostgresEventLog) FindAfter(ctx context.Context, userID domain.UserID, afterID int64, limit int) ([]domain.TaskEvent, error) {
+	var oldestID int64
+	if err := l.dbpool.QueryRow(ctx, `SELECT COALESCE(MIN(id), 0) FROM task_events`).Scan(&oldestID); err != nil {
+		return nil, fmt.Errorf("error reading oldest task event id: %w", err)
+	}
+	if afterID > 0 && (oldestID == 0 || oldestID > afterID+1) {
+		return nil, domain.ErrTaskEventsExpired
+	}
+
+	query := `SELECT ` + taskEventColumns + ` FROM task_events
+	           WHERE user_id = $1 AND id > $2 ORDER BY id ASC LIMIT $3`
+	rows, err := l.dbpool.Query(ctx, query, userID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching task events of %s after %d: %w", userID, afterID, err)
+	}
+	return collectTaskEvents(rows)
+}
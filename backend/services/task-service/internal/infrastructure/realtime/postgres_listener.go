@@ -0,0 +1,226 @@
+// file: backend/services/task-service/internal/infrastructure/realtime/postgres_listener.go
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ListenerConfig mengatur perilaku PostgresListener.
+type ListenerConfig struct {
+	Channel       string        // Channel LISTEN/NOTIFY, default DefaultChannel
+	BatchSize     int           // Jumlah event maksimum per query catch-up
+	Retention     time.Duration // Umur event di task_events sebelum dihapus
+	PruneInterval time.Duration // Seberapa sering event lama dihapus
+	MinBackoff    time.Duration // Jeda awal sebelum reconnect
+	MaxBackoff    time.Duration // Jeda maksimum sebelum reconnect
+}
+
+// DefaultListenerConfig mengembalikan konfigurasi default PostgresListener.
+func DefaultListenerConfig() ListenerConfig {
+	return ListenerConfig{
+		Channel:       DefaultChannel,
+		BatchSize:     500,
+		Retention:     24 * time.Hour,
+		PruneInterval: 10 * time.Minute,
+		MinBackoff:    500 * time.Millisecond,
+		MaxBackoff:    30 * time.Second,
+	}
+}
+
+// PostgresListener men-LISTEN channel task_events dan meneruskan event baru ke Dispatcher lokal.
+//
+// Setiap notifikasi hanya dipakai sebagai sinyal; event dibaca dari tabel task_events berdasarkan
+// ID terakhir yang sudah diteruskan. Dengan begitu event yang terlewat selama koneksi putus
+// (backlog) tetap terkirim setelah reconnect, selama belum melewati masa retensi.
+type PostgresListener struct {
+	dbpool     *pgxpool.Pool
+	dispatcher Dispatcher
+	cfg        ListenerConfig
+	lastID     int64
+	connected  atomic.Bool
+}
+
+// NewPostgresListener adalah constructor untuk PostgresListener.
+func NewPostgresListener(dbpool *pgxpool.Pool, dispatcher Dispatcher, cfg ListenerConfig) *PostgresListener {
+	return &PostgresListener{
+		dbpool:     dbpool,
+		dispatcher: dispatcher,
+		cfg:        cfg,
+	}
+}
+
+// Run menjalankan listener sampai ctx dibatalkan, dengan reconnect otomatis (exponential backoff).
+// Event yang sudah ada sebelum Run dipanggil tidak diteruskan.
+func (l *PostgresListener) Run(ctx context.Context) error {
+	backoff := l.cfg.MinBackoff
+	for {
+		err := l.init(ctx)
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slog.ErrorContext(ctx, "task event listener: error reading last event id", "error", err)
+		backoff = l.sleep(ctx, backoff)
+	}
+
+	go pruneTaskEvents(ctx, l.dbpool, l.cfg)
+
+	backoff = l.cfg.MinBackoff
+	for {
+		err := l.listen(ctx, func() { backoff = l.cfg.MinBackoff })
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slog.WarnContext(ctx, "task event listener: connection lost, reconnecting", "backoff", backoff, "error", err)
+		backoff = l.sleep(ctx, backoff)
+	}
+}
+
+// Connected melaporkan apakah koneksi LISTEN sedang terbuka, untuk pemeriksaan readiness.
+func (l *PostgresListener) Connected() bool {
+	return l.connected.Load()
+}
+
+// init menentukan posisi awal listener, yaitu ID event terbaru saat ini.
+func (l *PostgresListener) init(ctx context.Context) error {
+	lastID, err := latestTaskEventID(ctx, l.dbpool)
+	l.lastID = lastID
+	return err
+}
+
+// listen melepas satu koneksi dari pool (Hijack) untuk LISTEN, lalu memproses notifikasi sampai
+// koneksi error atau ctx dibatalkan. Koneksi diambil lewat pool agar dibuat dengan hook pool,
+// termasuk kredensial database yang dirotasi. connected dipanggil setelah LISTEN berhasil.
+func (l *PostgresListener) listen(ctx context.Context, connected func()) error {
+	pooled, err := l.dbpool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("error connecting: %w", err)
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.cfg.Channel}.Sanitize()); err != nil {
+		return fmt.Errorf("error executing LISTEN: %w", err)
+	}
+	l.connected.Store(true)
+	defer l.connected.Store(false)
+	connected()
+
+	// Kejar event yang terlewat selama koneksi sebelumnya putus.
+	if err := l.catchUp(ctx); err != nil {
+		return err
+	}
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("error waiting for notification: %w", err)
+		}
+		if err := l.catchUp(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// catchUp membaca dan meneruskan semua event dengan ID lebih besar dari lastID.
+func (l *PostgresListener) catchUp(ctx context.Context) error {
+	for {
+		events, err := fetchTaskEventsAfter(ctx, l.dbpool, l.lastID, l.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			l.dispatcher.Dispatch(event)
+			l.lastID = event.ID
+		}
+		if len(events) < l.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// latestTaskEventID mengembalikan ID event terbaru di task_events, atau 0 jika tabel kosong.
+func latestTaskEventID(ctx context.Context, dbpool *pgxpool.Pool) (int64, error) {
+	var lastID int64
+	err := dbpool.QueryRow(ctx, `SELECT COALESCE(MAX(id), 0) FROM task_events`).Scan(&lastID)
+	return lastID, err
+}
+
+// fetchTaskEventsAfter membaca paling banyak limit event dengan ID lebih besar dari afterID.
+func fetchTaskEventsAfter(ctx context.Context, dbpool *pgxpool.Pool, afterID int64, limit int) ([]domain.TaskEvent, error) {
+	query := `SELECT ` + taskEventColumns + ` FROM task_events WHERE id > $1 ORDER BY id ASC LIMIT $2`
+	rows, err := dbpool.Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching task events after %d: %w", afterID, err)
+	}
+	return collectTaskEvents(rows)
+}
+
+// taskEventColumns adalah daftar kolom yang dibaca untuk setiap event, sesuai urutan Scan di collectTaskEvents.
+const taskEventColumns = `id, event_type, task_id, user_id, payload, occurred_at, comment`
+
+// collectTaskEvents membaca seluruh baris task_events lalu menutup rows.
+func collectTaskEvents(rows pgx.Rows) ([]domain.TaskEvent, error) {
+	defer rows.Close()
+
+	var events []domain.TaskEvent
+	for rows.Next() {
+		var event domain.TaskEvent
+		var payload, comment []byte
+		if err := rows.Scan(&event.ID, &event.Type, &event.TaskID, &event.UserID, &payload, &event.OccurredAt, &comment); err != nil {
+			return nil, fmt.Errorf("error scanning task event row: %w", err)
+		}
+		if err := json.Unmarshal(payload, &event.Task); err != nil {
+			return nil, fmt.Errorf("error decoding task event %d payload: %w", event.ID, err)
+		}
+		if comment != nil {
+			if err := json.Unmarshal(comment, &event.Comment); err != nil {
+				return nil, fmt.Errorf("error decoding task event %d comment: %w", event.ID, err)
+			}
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task event rows: %w", err)
+	}
+	return events, nil
+}
+
+// pruneTaskEvents menghapus event yang lebih tua dari cfg.Retention setiap cfg.PruneInterval
+// sampai ctx dibatalkan. Dijalankan di semua replika; DELETE bersifat idempotent sehingga tidak
+// perlu koordinasi.
+func pruneTaskEvents(ctx context.Context, dbpool *pgxpool.Pool, cfg ListenerConfig) {
+	ticker := time.NewTicker(cfg.PruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cutoff := time.Now().Add(-cfg.Retention)
+			if _, err := dbpool.Exec(ctx, `DELETE FROM task_events WHERE occurred_at < $1`, cutoff); err != nil && !errors.Is(err, context.Canceled) {
+				slog.ErrorContext(ctx, "task event listener: error pruning events", "error", err)
+			}
+		}
+	}
+}
+
+// sleep menunggu selama backoff (atau sampai ctx dibatalkan) dan mengembalikan backoff berikutnya.
+func (l *PostgresListener) sleep(ctx context.Context, backoff time.Duration) time.Duration {
+	select {
+	case <-ctx.Done():
+	case <-time.After(backoff):
+	}
+	return min(backoff*2, l.cfg.MaxBackoff)
+}
@@ -0,0 +1,77 @@
+// file: backend/services/task-service/internal/infrastructure/realtime/postgres_publisher.go
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultChannel adalah nama channel LISTEN/NOTIFY untuk event task.
+const DefaultChannel = "task_events"
+
+// PostgresEventPublisher adalah implementasi domain.TaskEventPublisher menggunakan tabel task_events
+// dan pg_notify. Event disimpan dulu ke tabel (backlog), lalu NOTIFY hanya membawa ID event sebagai
+// sinyal "ada event baru", sehingga batas 8000 byte payload NOTIFY tidak menjadi masalah.
+type PostgresEventPublisher struct {
+	dbpool  *pgxpool.Pool
+	channel string
+}
+
+// NewPostgresEventPublisher adalah constructor untuk PostgresEventPublisher.
+func NewPostgresEventPublisher(dbpool *pgxpool.Pool, channel string) *PostgresEventPublisher {
+	return &PostgresEventPublisher{
+		dbpool:  dbpool,
+		channel: channel,
+	}
+}
+
+// Publish menyimpan event ke task_events dan memberi tahu semua replika lewat pg_notify
+// dalam satu statement.
+func (p *PostgresEventPublisher) Publish(ctx context.Context, event domain.TaskEvent) error {
+	payload, comment, err := encodeTaskEventColumns(event)
+	if err != nil {
+		return err
+	}
+
+	query := `WITH inserted AS (
+	              INSERT INTO task_events (event_type, task_id, user_id, payload, occurred_at, comment)
+	              VALUES ($1, $2, $3, $4::jsonb, $5, $7::jsonb)
+	              RETURNING id
+	          )
+	          SELECT pg_notify($6, id::text) FROM inserted`
+	_, err = p.dbpool.Exec(ctx, query,
+		event.Type,
+		event.TaskID,
+		event.UserID,
+		payload,
+		event.OccurredAt,
+		p.channel,
+		comment,
+	)
+	if err != nil {
+		return fmt.Errorf("error publishing task event %s for task %s: %w", event.Type, event.TaskID, err)
+	}
+	return nil
+}
+
+// encodeTaskEventColumns meng-encode snapshot task dan komentar event untuk kolom payload dan
+// comment task_events; comment nil (NULL) untuk event tanpa komentar.
+func encodeTaskEventColumns(event domain.TaskEvent) (payload string, comment *string, err error) {
+	raw, err := json.Marshal(event.Task)
+	if err != nil {
+		return "", nil, fmt.Errorf("error encoding task event payload: %w", err)
+	}
+	if event.Comment != nil {
+		rawComment, err := json.Marshal(event.Comment)
+		if err != nil {
+			return "", nil, fmt.Errorf("error encoding task event comment: %w", err)
+		}
+		encoded := string(rawComment)
+		comment = &encoded
+	}
+	return string(raw), comment, nil
+}
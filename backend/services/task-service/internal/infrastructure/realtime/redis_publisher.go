@@ -0,0 +1,67 @@
+// file: backend/services/task-service/internal/infrastructure/realtime/redis_publisher.go
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisEventPublisher adalah implementasi domain.TaskEventPublisher yang menyebarkan event lewat
+// Redis pub/sub, untuk deployment dengan banyak replika di mana satu koneksi LISTEN per replika
+// dan query catch-up per notifikasi (lihat PostgresListener) terlalu mahal.
+//
+// Event tetap disimpan dulu ke task_events, sehingga ID event, resume SSE (PostgresEventLog), dan
+// catch-up setelah RedisSubscriber reconnect tetap bekerja. Pesan Redis membawa event lengkap,
+// sehingga replika penerima tidak perlu membaca database.
+type RedisEventPublisher struct {
+	dbpool  *pgxpool.Pool
+	client  redis.UniversalClient
+	channel string
+}
+
+// NewRedisEventPublisher adalah constructor untuk RedisEventPublisher.
+func NewRedisEventPublisher(dbpool *pgxpool.Pool, client redis.UniversalClient, channel string) *RedisEventPublisher {
+	return &RedisEventPublisher{
+		dbpool:  dbpool,
+		client:  client,
+		channel: channel,
+	}
+}
+
+// Publish menyimpan event ke task_events lalu mem-PUBLISH event lengkap ke channel Redis. Jika
+// PUBLISH gagal, event sudah tersimpan dan dikirim ke replika lain saat subscriber mereka
+// melakukan catch-up berikutnya.
+func (p *RedisEventPublisher) Publish(ctx context.Context, event domain.TaskEvent) error {
+	payload, comment, err := encodeTaskEventColumns(event)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO task_events (event_type, task_id, user_id, payload, occurred_at, comment)
+	          VALUES ($1, $2, $3, $4::jsonb, $5, $6::jsonb)
+	          RETURNING id`
+	err = p.dbpool.QueryRow(ctx, query,
+		event.Type,
+		event.TaskID,
+		event.UserID,
+		payload,
+		event.OccurredAt,
+		comment,
+	).Scan(&event.ID)
+	if err != nil {
+		return fmt.Errorf("error storing task event %s for task %s: %w", event.Type, event.TaskID, err)
+	}
+
+	message, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error encoding task event %d: %w", event.ID, err)
+	}
+	if err := p.client.Publish(ctx, p.channel, message).Err(); err != nil {
+		return fmt.Errorf("error publishing task event %d to redis: %w", event.ID, err)
+	}
+	return nil
+}
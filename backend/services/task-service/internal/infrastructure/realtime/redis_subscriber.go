@@ -0,0 +1,179 @@
+// file: backend/services/task-service/internal/infrastructure/realtime/redis_subscriber.go
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisPingInterval = 30 * time.Second // Ping koneksi pub/sub agar koneksi mati cepat terdeteksi
+	redisSeenEvents   = 1024             // Jumlah ID event terakhir yang diingat untuk membuang duplikat
+)
+
+// RedisSubscriber men-SUBSCRIBE channel Redis tempat RedisEventPublisher mem-PUBLISH event dan
+// meneruskan setiap event ke Dispatcher lokal. Pengganti PostgresListener saat transport realtime
+// memakai Redis.
+//
+// Redis pub/sub tidak menyimpan pesan, jadi setelah (re)subscribe event yang terlewat dikejar dari
+// tabel task_events berdasarkan ID terbesar yang sudah diteruskan, seperti PostgresListener. Event
+// yang datang lewat catch-up dan pesan Redis sekaligus hanya diteruskan sekali.
+type RedisSubscriber struct {
+	dbpool     *pgxpool.Pool
+	client     redis.UniversalClient
+	dispatcher Dispatcher
+	cfg        ListenerConfig
+	lastID     int64
+	seen       map[int64]struct{}
+	seenRing   [redisSeenEvents]int64 // Urutan ID di seen; yang tertua ditimpa lebih dulu
+	seenNext   int
+	connected  atomic.Bool
+}
+
+// NewRedisSubscriber adalah constructor untuk RedisSubscriber. cfg.Channel adalah channel Redis,
+// sama dengan channel RedisEventPublisher.
+func NewRedisSubscriber(dbpool *pgxpool.Pool, client redis.UniversalClient, dispatcher Dispatcher, cfg ListenerConfig) *RedisSubscriber {
+	return &RedisSubscriber{
+		dbpool:     dbpool,
+		client:     client,
+		dispatcher: dispatcher,
+		cfg:        cfg,
+		seen:       make(map[int64]struct{}, redisSeenEvents),
+	}
+}
+
+// Run menjalankan subscriber sampai ctx dibatalkan, dengan reconnect otomatis (exponential
+// backoff). Event yang sudah ada sebelum Run dipanggil tidak diteruskan.
+func (s *RedisSubscriber) Run(ctx context.Context) error {
+	backoff := s.cfg.MinBackoff
+	for {
+		lastID, err := latestTaskEventID(ctx, s.dbpool)
+		if err == nil {
+			s.lastID = lastID
+			break
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slog.ErrorContext(ctx, "task event subscriber: error reading last event id", "error", err)
+		backoff = s.sleep(ctx, backoff)
+	}
+
+	go pruneTaskEvents(ctx, s.dbpool, s.cfg)
+
+	backoff = s.cfg.MinBackoff
+	for {
+		err := s.subscribe(ctx, func() { backoff = s.cfg.MinBackoff })
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slog.WarnContext(ctx, "task event subscriber: connection lost, reconnecting", "backoff", backoff, "error", err)
+		backoff = s.sleep(ctx, backoff)
+	}
+}
+
+// Connected melaporkan apakah subscription Redis sedang aktif, untuk pemeriksaan readiness.
+func (s *RedisSubscriber) Connected() bool {
+	return s.connected.Load()
+}
+
+// subscribe membuka subscription, mengejar event yang terlewat dari task_events, lalu meneruskan
+// pesan sampai koneksi error atau ctx dibatalkan. connected dipanggil setelah SUBSCRIBE berhasil.
+func (s *RedisSubscriber) subscribe(ctx context.Context, connected func()) error {
+	pubsub := s.client.Subscribe(ctx, s.cfg.Channel)
+	defer pubsub.Close()
+	if _, err := pubsub.Receive(ctx); err != nil { // Konfirmasi SUBSCRIBE
+		return fmt.Errorf("error subscribing: %w", err)
+	}
+	s.connected.Store(true)
+	defer s.connected.Store(false)
+	connected()
+
+	// Pembacaan pesan tidak selalu berhenti saat ctx dibatalkan, dan koneksi yang mati diam-diam
+	// baru terdeteksi saat ditulis; menutup pubsub membuat ReceiveMessage langsung gagal.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(redisPingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				pubsub.Close()
+				return
+			case <-ticker.C:
+				if err := pubsub.Ping(ctx); err != nil {
+					pubsub.Close()
+					return
+				}
+			}
+		}
+	}()
+
+	if err := s.catchUp(ctx); err != nil {
+		return err
+	}
+	for {
+		message, err := pubsub.ReceiveMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("error receiving message: %w", err)
+		}
+		var event domain.TaskEvent
+		if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+			slog.ErrorContext(ctx, "task event subscriber: error decoding message", "error", err)
+			continue
+		}
+		s.dispatch(event)
+	}
+}
+
+// catchUp meneruskan semua event di task_events dengan ID lebih besar dari lastID.
+func (s *RedisSubscriber) catchUp(ctx context.Context) error {
+	for {
+		events, err := fetchTaskEventsAfter(ctx, s.dbpool, s.lastID, s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			s.dispatch(event)
+		}
+		if len(events) < s.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// dispatch meneruskan event yang belum pernah diteruskan dan memajukan lastID. Hanya dipanggil
+// dari goroutine Run.
+func (s *RedisSubscriber) dispatch(event domain.TaskEvent) {
+	if _, ok := s.seen[event.ID]; ok {
+		return
+	}
+	if len(s.seen) == redisSeenEvents {
+		delete(s.seen, s.seenRing[s.seenNext])
+	}
+	s.seen[event.ID] = struct{}{}
+	s.seenRing[s.seenNext] = event.ID
+	s.seenNext = (s.seenNext + 1) % redisSeenEvents
+	s.lastID = max(s.lastID, event.ID)
+	s.dispatcher.Dispatch(event)
+}
+
+// sleep menunggu selama backoff (atau sampai ctx dibatalkan) dan mengembalikan backoff berikutnya.
+func (s *RedisSubscriber) sleep(ctx context.Context, backoff time.Duration) time.Duration {
+	select {
+	case <-ctx.Done():
+	case <-time.After(backoff):
+	}
+	return min(backoff*2, s.cfg.MaxBackoff)
+}
@@ -0,0 +1,73 @@
+// file: backend/services/task-service/internal/infrastructure/runtimeconfig/config.go
+package runtimeconfig
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config adalah isi CONFIG_FILE: pengaturan yang tidak kritis dan boleh diganti tanpa restart.
+// Field yang tidak diisi memakai nilai dari environment (misalnya LOG_LEVEL).
+type Config struct {
+	LogLevel  *slog.Level     `yaml:"log_level"`
+	RateLimit RateLimit       `yaml:"rate_limit"`
+	CORS      CORS            `yaml:"cors"`
+	Features  map[string]bool `yaml:"features"`
+}
+
+// RateLimit adalah batas request per menit per pengguna; 0 berarti tanpa batas.
+type RateLimit struct {
+	Read  *int `yaml:"read"`
+	Write *int `yaml:"write"`
+}
+
+// CORS adalah origin browser lain yang boleh memanggil API.
+type CORS struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// Load membaca dan memvalidasi file konfigurasi YAML di path. Field yang tidak dikenal ditolak
+// agar salah ketik tidak diam-diam diabaikan; file kosong berarti semua nilai dari environment.
+func Load(path string) (Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Config{}, err
+	}
+	defer file.Close()
+
+	var config Config
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return Config{}, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := config.validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid %s: %w", path, err)
+	}
+	return config, nil
+}
+
+func (c Config) validate() error {
+	if c.RateLimit.Read != nil && *c.RateLimit.Read < 0 {
+		return errors.New("rate_limit.read must not be negative")
+	}
+	if c.RateLimit.Write != nil && *c.RateLimit.Write < 0 {
+		return errors.New("rate_limit.write must not be negative")
+	}
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			return fmt.Errorf("cors.allowed_origins: %q must be \"*\" or an origin such as https://app.example.com", origin)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// file: backend/services/task-service/internal/infrastructure/runtimeconfig/watcher.go
+package runtimeconfig
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Watcher memuat ulang file konfigurasi saat diminta (misalnya SIGHUP) atau saat file berubah,
+// lalu meneruskan hasilnya ke apply. Konfigurasi yang tidak valid tidak diteruskan, sehingga
+// nilai sebelumnya tetap berlaku.
+type Watcher struct {
+	path  string
+	apply func(Config)
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+// NewWatcher adalah constructor untuk Watcher. Pemanggil memuat konfigurasi awal dengan Load.
+func NewWatcher(path string, apply func(Config)) *Watcher {
+	w := &Watcher{path: path, apply: apply}
+	if info, err := os.Stat(path); err == nil {
+		w.modTime, w.size = info.ModTime(), info.Size()
+	}
+	return w
+}
+
+// Reload membaca ulang file dan meneruskannya ke apply jika valid.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if info, err := os.Stat(w.path); err == nil {
+		w.modTime, w.size = info.ModTime(), info.Size()
+	}
+	config, err := Load(w.path)
+	if err != nil {
+		return err
+	}
+	w.apply(config)
+	return nil
+}
+
+// Run memeriksa file setiap interval dan memuat ulang saat waktu modifikasi atau ukurannya
+// berubah, sampai ctx selesai. os.Stat mengikuti symlink, sehingga penggantian ConfigMap
+// Kubernetes (symlink ..data) ikut terdeteksi.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !w.changed() {
+				continue
+			}
+			if err := w.Reload(); err != nil {
+				slog.Error("error reloading configuration, keeping previous values", "path", w.path, "trigger", "file_change", "error", err)
+				continue
+			}
+			slog.Warn("configuration reloaded", "path", w.path, "trigger", "file_change")
+		}
+	}
+}
+
+func (w *Watcher) changed() bool {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return false // File sedang diganti; diperiksa lagi pada interval berikutnya
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return !info.ModTime().Equal(w.modTime) || info.Size() != w.size
+}
@@ -0,0 +1,49 @@
+// file: backend/services/task-service/internal/infrastructure/safehttp/client.go
+package safehttp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress dikembalikan saat tujuan request mengarah ke alamat jaringan internal.
+var ErrPrivateAddress = errors.New("address is not publicly routable")
+
+// NewClient membuat http.Client untuk URL yang ditentukan pengguna (webhook, homeserver Matrix).
+// Alamat loopback, privat, dan link-local ditolak saat dial (setelah DNS di-resolve) agar URL
+// tersebut tidak bisa dipakai untuk menjangkau jaringan internal; allowPrivateNetworks mematikan
+// pemeriksaan ini, misalnya untuk pengembangan lokal. Redirect tidak diikuti.
+func NewClient(allowPrivateNetworks bool, timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowPrivateNetworks {
+		dialer.Control = rejectPrivateAddress
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// rejectPrivateAddress dipanggil untuk setiap alamat hasil resolve sebelum koneksi dibuat.
+func rejectPrivateAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, address)
+	}
+	addr := addrPort.Addr().Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, addr)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// file: backend/services/task-service/internal/infrastructure/secrets/aws.go
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// AWSBackend adalah implementasi Backend untuk AWS Secrets Manager.
+type AWSBackend struct {
+	client *secretsmanager.Client
+}
+
+// NewAWSBackend adalah constructor untuk AWSBackend. Region dan kredensial dibaca dari rantai
+// default AWS SDK: env AWS_*, shared config, IRSA/web identity, atau role ECS/EC2.
+func NewAWSBackend(ctx context.Context) (*AWSBackend, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading aws config: %w", err)
+	}
+	return &AWSBackend{client: secretsmanager.NewFromConfig(cfg)}, nil
+}
+
+// Fetch mengambil versi AWSCURRENT dari secret dengan nama atau ARN path. SecretString berupa
+// objek JSON dipecah per key; selain itu seluruh SecretString dikembalikan dengan key kosong.
+func (b *AWSBackend) Fetch(ctx context.Context, path string) (map[string]string, error) {
+	out, err := b.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(path)})
+	if err != nil {
+		return nil, err
+	}
+	if out.SecretString == nil {
+		return nil, errors.New("binary secrets are not supported")
+	}
+	value := aws.ToString(out.SecretString)
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &object); err == nil {
+		values := stringValues(object)
+		if _, ok := values[""]; !ok {
+			values[""] = value
+		}
+		return values, nil
+	}
+	return map[string]string{"": value}, nil
+}
@@ -0,0 +1,163 @@
+// file: backend/services/task-service/internal/infrastructure/secrets/secrets.go
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Scheme referensi secret yang dikenal. Referensi ditulis sebagai nilai env, misalnya
+// "vault:secret/data/task-service#database_url" atau "aws-sm:prod/task-service#database_url".
+const (
+	SchemeVault = "vault"  // Path KV Vault (v1 atau v2), key wajib
+	SchemeAWS   = "aws-sm" // Nama atau ARN secret AWS Secrets Manager; tanpa key berarti seluruh SecretString
+)
+
+// Backend mengambil isi satu secret dari secrets backend.
+type Backend interface {
+	// Fetch mengembalikan isi secret di path sebagai pasangan key-value. Secret yang bukan objek
+	// dikembalikan dengan key kosong.
+	Fetch(ctx context.Context, path string) (map[string]string, error)
+}
+
+// Secret adalah nilai konfigurasi yang bisa berubah saat secret dirotasi. Value selalu
+// mengembalikan nilai terbaru yang berhasil diambil.
+type Secret struct {
+	scheme string // Kosong untuk nilai biasa yang tidak pernah berubah
+	path   string
+	key    string
+	value  atomic.Pointer[string]
+}
+
+// Static membuat Secret dengan nilai tetap.
+func Static(value string) *Secret {
+	s := &Secret{}
+	s.value.Store(&value)
+	return s
+}
+
+// Value mengembalikan nilai secret saat ini.
+func (s *Secret) Value() string {
+	return *s.value.Load()
+}
+
+// IsReference melaporkan apakah value berupa referensi secret, bukan nilai biasa.
+func IsReference(value string) bool {
+	scheme, _, ok := strings.Cut(value, ":")
+	return ok && (scheme == SchemeVault || scheme == SchemeAWS)
+}
+
+// Manager menerjemahkan referensi secret menjadi Secret dan mengambil ulang nilainya secara
+// berkala, sehingga rotasi di secrets backend terbaca tanpa restart.
+type Manager struct {
+	backends map[string]Backend
+
+	mu      sync.Mutex
+	secrets []*Secret
+}
+
+// NewManager adalah constructor untuk Manager. backends dipetakan per scheme (SchemeVault atau
+// SchemeAWS); scheme tanpa backend ditolak oleh Resolve.
+func NewManager(backends map[string]Backend) *Manager {
+	return &Manager{
+		backends: backends,
+	}
+}
+
+// Resolve mengembalikan Secret untuk value. Value yang bukan referensi (lihat IsReference)
+// dikembalikan sebagai Static; referensi langsung diambil dari backend dan ikut di-refresh
+// oleh Run.
+func (m *Manager) Resolve(ctx context.Context, value string) (*Secret, error) {
+	if !IsReference(value) {
+		return Static(value), nil
+	}
+	scheme, rest, _ := strings.Cut(value, ":")
+	path, key, _ := strings.Cut(rest, "#")
+	if path == "" {
+		return nil, fmt.Errorf("secret reference %q has no path", value)
+	}
+	if scheme == SchemeVault && key == "" {
+		return nil, fmt.Errorf("vault secret reference %q must include a #key", value)
+	}
+	backend, ok := m.backends[scheme]
+	if !ok {
+		return nil, fmt.Errorf("secret backend %q is not configured", scheme)
+	}
+	data, err := backend.Fetch(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching secret %s:%s: %w", scheme, path, err)
+	}
+	secret := &Secret{scheme: scheme, path: path, key: key}
+	if err := secret.update(data); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	m.secrets = append(m.secrets, secret)
+	m.mu.Unlock()
+	return secret, nil
+}
+
+// update menyimpan nilai key dari isi secret; nilai lama dipertahankan jika key tidak ada.
+func (s *Secret) update(data map[string]string) error {
+	value, ok := data[s.key]
+	if !ok {
+		return fmt.Errorf("secret %s:%s has no key %q", s.scheme, s.path, s.key)
+	}
+	s.value.Store(&value)
+	return nil
+}
+
+// Refresh mengambil ulang semua secret yang sudah di-Resolve, satu kali per path. Secret yang
+// gagal diambil tetap memakai nilai terakhirnya; error pertama dikembalikan.
+func (m *Manager) Refresh(ctx context.Context) error {
+	m.mu.Lock()
+	secrets := append([]*Secret(nil), m.secrets...)
+	m.mu.Unlock()
+
+	type location struct{ scheme, path string }
+	fetched := make(map[location]map[string]string)
+	var errs []error
+	for _, secret := range secrets {
+		loc := location{secret.scheme, secret.path}
+		data, ok := fetched[loc]
+		if !ok {
+			var err error
+			data, err = m.backends[secret.scheme].Fetch(ctx, secret.path)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error fetching secret %s:%s: %w", secret.scheme, secret.path, err))
+				fetched[loc] = nil
+				continue
+			}
+			fetched[loc] = data
+		}
+		if data == nil {
+			continue // Path ini sudah gagal diambil
+		}
+		if err := secret.update(data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run memanggil Refresh setiap interval sampai ctx dibatalkan.
+func (m *Manager) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.Refresh(ctx); err != nil && ctx.Err() == nil {
+				slog.ErrorContext(ctx, "error refreshing secrets", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// file: backend/services/task-service/internal/infrastructure/secrets/vault.go
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// maxVaultResponseSize adalah ukuran response Vault terbesar yang dibaca.
+const maxVaultResponseSize = 1 << 20
+
+// VaultConfig adalah pengaturan koneksi ke HashiCorp Vault.
+type VaultConfig struct {
+	Addr      string // Misalnya https://vault.internal:8200
+	Token     string
+	TokenFile string // Dibaca ulang setiap request, misalnya sink token Vault Agent; menggantikan Token
+	Namespace string // Opsional, untuk Vault Enterprise
+}
+
+// VaultBackend adalah implementasi Backend untuk secrets engine KV Vault versi 1 dan 2.
+type VaultBackend struct {
+	cfg    VaultConfig
+	client *http.Client
+}
+
+// NewVaultBackend adalah constructor untuk VaultBackend.
+func NewVaultBackend(cfg VaultConfig) (*VaultBackend, error) {
+	if cfg.Addr == "" {
+		return nil, errors.New("vault address must be set")
+	}
+	if cfg.Token == "" && cfg.TokenFile == "" {
+		return nil, errors.New("vault token or token file must be set")
+	}
+	cfg.Addr = strings.TrimSuffix(cfg.Addr, "/")
+	return &VaultBackend{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Fetch membaca path lewat GET /v1/<path>. Untuk KV v2, path memakai segmen data, misalnya
+// "secret/data/task-service".
+func (b *VaultBackend) Fetch(ctx context.Context, path string) (map[string]string, error) {
+	token, err := b.token()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.cfg.Addr+"/v1/"+strings.TrimPrefix(path, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if b.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", b.cfg.Namespace)
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxVaultResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("error decoding vault response: %w", err)
+	}
+	data := body.Data
+	// KV v2 membungkus isi secret di data.data, bersama data.metadata.
+	if nested, ok := data["data"]; ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = nil
+			if err := json.Unmarshal(nested, &data); err != nil {
+				return nil, fmt.Errorf("error decoding vault kv v2 data: %w", err)
+			}
+		}
+	}
+	return stringValues(data), nil
+}
+
+func (b *VaultBackend) token() (string, error) {
+	if b.cfg.TokenFile == "" {
+		return b.cfg.Token, nil
+	}
+	raw, err := os.ReadFile(b.cfg.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("error reading vault token file: %w", err)
+	}
+	return strings.TrimSpace(string(raw)), nil
+}
+
+// stringValues mengubah objek JSON menjadi map string. Nilai string dipakai apa adanya; nilai
+// lain (angka, objek) dipakai dalam bentuk JSON-nya.
+func stringValues(data map[string]json.RawMessage) map[string]string {
+	values := make(map[string]string, len(data))
+	for key, raw := range data {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			values[key] = s
+			continue
+		}
+		values[key] = string(raw)
+	}
+	return values
+}
@@ -0,0 +1,169 @@
+// file: backend/services/task-service/internal/infrastructure/slack/client.go
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// postMessageURL adalah endpoint Web API chat.postMessage.
+const postMessageURL = "https://slack.com/api/chat.postMessage"
+
+const (
+	// sendInterval adalah jarak minimum antar pesan ke tujuan yang sama (batas Slack sekitar satu
+	// pesan per detik per channel).
+	sendInterval = time.Second
+
+	// maxQueueWait adalah antrean terlama yang masih ditunggu sebelum pesan ditolak dengan
+	// domain.ErrSlackRateLimited, agar worker notifier tidak tertahan oleh satu channel yang ramai.
+	maxQueueWait = 5 * time.Second
+
+	// maxRetryAfter adalah batas jeda 429 dari Slack yang masih ditunggu sebelum mencoba ulang sekali.
+	maxRetryAfter = 5 * time.Second
+)
+
+// Client adalah implementasi domain.SlackClient dengan incoming webhook dan Slack Web API.
+type Client struct {
+	client *http.Client
+
+	mu   sync.Mutex
+	next map[string]time.Time // Waktu paling awal pesan berikutnya boleh dikirim, per tujuan
+}
+
+// NewClient adalah constructor untuk Client.
+func NewClient() *Client {
+	return &Client{
+		client: &http.Client{Timeout: 10 * time.Second},
+		next:   make(map[string]time.Time),
+	}
+}
+
+// SendWebhook mengirim pesan ke incoming webhook Slack.
+func (c *Client) SendWebhook(ctx context.Context, webhookURL string, msg domain.SlackMessage) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("error encoding slack message: %w", err)
+	}
+	return c.post(ctx, webhookURL, webhookURL, "", body)
+}
+
+// PostMessage mengirim pesan ke channelID sebagai bot pemilik botToken.
+func (c *Client) PostMessage(ctx context.Context, botToken, channelID string, msg domain.SlackMessage) error {
+	body, err := json.Marshal(struct {
+		Channel string `json:"channel"`
+		domain.SlackMessage
+	}{Channel: channelID, SlackMessage: msg})
+	if err != nil {
+		return fmt.Errorf("error encoding slack message: %w", err)
+	}
+	return c.post(ctx, postMessageURL, botToken+"/"+channelID, "Bearer "+botToken, body)
+}
+
+// post menunggu giliran tujuan key lalu mengirim body, dan mencoba ulang sekali jika Slack menjawab
+// 429 dengan jeda yang pendek. Web API menjawab 200 dengan "ok": false untuk error, sedangkan
+// incoming webhook memakai status HTTP.
+func (c *Client) post(ctx context.Context, target, key, authorization string, body []byte) error {
+	for attempt := 0; ; attempt++ {
+		if err := c.wait(ctx, key); err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("error creating slack request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("error sending slack request: %w", err)
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode == http.StatusOK && authorization != "":
+			var result struct {
+				OK    bool   `json:"ok"`
+				Error string `json:"error"`
+			}
+			if err := json.Unmarshal(respBody, &result); err != nil {
+				return fmt.Errorf("invalid slack api response: %w", err)
+			}
+			if !result.OK {
+				return fmt.Errorf("slack api error: %s", result.Error)
+			}
+			return nil
+		case resp.StatusCode == http.StatusOK:
+			return nil
+		case resp.StatusCode == http.StatusTooManyRequests && attempt == 0:
+			wait := retryAfter(resp.Header)
+			if wait > maxRetryAfter {
+				return fmt.Errorf("%w: retry after %s", domain.ErrSlackRateLimited, wait)
+			}
+			c.delay(key, wait)
+		case resp.StatusCode == http.StatusTooManyRequests:
+			return domain.ErrSlackRateLimited
+		default:
+			return fmt.Errorf("slack responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+		}
+	}
+}
+
+// wait memesan slot kirim berikutnya untuk key lalu menunggu sampai slot tersebut. Slot yang lebih
+// jauh dari maxQueueWait tidak dipesan.
+func (c *Client) wait(ctx context.Context, key string) error {
+	now := time.Now()
+	c.mu.Lock()
+	slot := c.next[key]
+	if slot.Before(now) {
+		slot = now
+	}
+	if slot.Sub(now) > maxQueueWait {
+		c.mu.Unlock()
+		return domain.ErrSlackRateLimited
+	}
+	c.next[key] = slot.Add(sendInterval)
+	for other, next := range c.next {
+		if next.Before(now) {
+			delete(c.next, other) // Tujuan yang sudah lama tidak dipakai
+		}
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(slot.Sub(now)):
+		return nil
+	}
+}
+
+// delay menggeser slot berikutnya untuk key sesuai Retry-After dari Slack.
+func (c *Client) delay(key string, wait time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if until := time.Now().Add(wait); c.next[key].Before(until) {
+		c.next[key] = until
+	}
+}
+
+// retryAfter membaca header Retry-After (detik) dari response 429.
+func retryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return time.Second
+	}
+	return time.Duration(seconds) * time.Second
+}
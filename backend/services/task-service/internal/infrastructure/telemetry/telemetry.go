@@ -0,0 +1,60 @@
+// file: backend/services/task-service/internal/infrastructure/telemetry/telemetry.go
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Enabled melaporkan apakah endpoint OTLP dikonfigurasi lewat OTEL_EXPORTER_OTLP_ENDPOINT atau
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT dan tracing tidak dimatikan dengan OTEL_SDK_DISABLED=true.
+func Enabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Setup memasang propagator W3C Trace Context dan Baggage secara global, lalu, jika Enabled,
+// tracer provider yang mengekspor span lewat OTLP/gRPC. Exporter, sampler, dan resource membaca
+// variabel OTEL_* standar (misalnya OTEL_EXPORTER_OTLP_HEADERS, OTEL_TRACES_SAMPLER, dan
+// OTEL_RESOURCE_ATTRIBUTES); serviceName dipakai jika OTEL_SERVICE_NAME kosong, dan serviceVersion
+// menjadi atribut service.version.
+//
+// Fungsi yang dikembalikan mengirim span yang tersisa lalu menghentikan provider, dan harus
+// dipanggil saat shutdown. Jika tracing tidak aktif, provider global tetap no-op.
+func Setup(ctx context.Context, serviceName, serviceVersion string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating otlp trace exporter: %w", err)
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", serviceName), attribute.String("service.version", serviceVersion)),
+		resource.WithFromEnv(), // OTEL_SERVICE_NAME dan OTEL_RESOURCE_ATTRIBUTES menimpa default di atas
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating otel resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,65 @@
+// file: backend/services/task-service/internal/infrastructure/todoist/client.go
+package todoist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// syncURL adalah endpoint Todoist Sync API.
+const syncURL = "https://api.todoist.com/api/v1/sync"
+
+// maxResponseSize membatasi ukuran response sync yang dibaca.
+const maxResponseSize = 32 << 20
+
+// Client adalah implementasi domain.TodoistClient dengan Todoist Sync API.
+type Client struct {
+	client *http.Client
+}
+
+// NewClient adalah constructor untuk Client.
+func NewClient() *Client {
+	return &Client{client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// FetchExport melakukan full sync (sync_token "*") untuk resource yang diimpor.
+func (c *Client) FetchExport(ctx context.Context, apiToken string) (*domain.TodoistExport, error) {
+	form := url.Values{
+		"sync_token":     {"*"},
+		"resource_types": {`["projects","items","labels"]`},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, syncURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating todoist request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending todoist request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, domain.ErrTodoistUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		return nil, fmt.Errorf("todoist sync responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	var export domain.TodoistExport
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&export); err != nil {
+		return nil, fmt.Errorf("error decoding todoist response: %w", err)
+	}
+	return &export, nil
+}
@@ -0,0 +1,137 @@
+// file: backend/services/task-service/internal/infrastructure/userdirectory/cache.go
+package userdirectory
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// CacheConfig mengatur perilaku Cache.
+type CacheConfig struct {
+	TTL        time.Duration // Umur profil di cache sebelum dicari ulang
+	MaxEntries int           // Jumlah pengguna maksimum di cache
+	// Cooldown adalah lama Cache tidak memanggil direktori setelah pencarian gagal, agar request
+	// tidak menunggu timeout satu per satu saat user-service mati.
+	Cooldown time.Duration
+}
+
+// DefaultCacheConfig mengembalikan konfigurasi default Cache.
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		TTL:        5 * time.Minute,
+		MaxEntries: 10000,
+		Cooldown:   30 * time.Second,
+	}
+}
+
+// cacheEntry menyimpan hasil pencarian satu pengguna; found false berarti direktori tidak mengenal
+// ID tersebut, sehingga tidak dicari ulang sampai kedaluwarsa.
+type cacheEntry struct {
+	profile   domain.UserProfile
+	found     bool
+	expiresAt time.Time
+}
+
+// Cache adalah domain.UserDirectory yang menyimpan hasil direktori lain di memori proses. Saat
+// direktori error, Cache tidak mengembalikan error: profil yang sudah kedaluwarsa tetap dipakai
+// (stale) dan pengguna yang belum pernah dicari ditampilkan tanpa profil.
+type Cache struct {
+	next domain.UserDirectory
+	cfg  CacheConfig
+	now  func() time.Time
+
+	mu          sync.Mutex
+	entries     map[domain.UserID]cacheEntry
+	failedUntil time.Time
+}
+
+// NewCache adalah constructor untuk Cache.
+func NewCache(next domain.UserDirectory, cfg CacheConfig) *Cache {
+	return &Cache{
+		next:    next,
+		cfg:     cfg,
+		now:     time.Now,
+		entries: make(map[domain.UserID]cacheEntry),
+	}
+}
+
+// LookupUsers mengembalikan profil dari cache dan hanya mencari ID yang belum ada atau sudah
+// kedaluwarsa ke direktori.
+func (c *Cache) LookupUsers(ctx context.Context, ids []domain.UserID) (map[domain.UserID]domain.UserProfile, error) {
+	now := c.now()
+	profiles := make(map[domain.UserID]domain.UserProfile, len(ids))
+	var missing []domain.UserID
+
+	c.mu.Lock()
+	for _, id := range ids {
+		entry, ok := c.entries[id]
+		if ok && entry.found {
+			profiles[id] = entry.profile // Stale dipakai dulu; diganti jika pencarian berhasil
+		}
+		if !ok || !now.Before(entry.expiresAt) {
+			missing = append(missing, id)
+		}
+	}
+	coolingDown := now.Before(c.failedUntil)
+	c.mu.Unlock()
+	if len(missing) == 0 || coolingDown {
+		return profiles, nil
+	}
+
+	fetched, err := c.next.LookupUsers(ctx, missing)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.WarnContext(ctx, "user directory unavailable, using cached profiles", "cooldown", c.cfg.Cooldown, "error", err)
+			c.mu.Lock()
+			c.failedUntil = now.Add(c.cfg.Cooldown)
+			c.mu.Unlock()
+		}
+		return profiles, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries)+len(missing) > c.cfg.MaxEntries {
+		c.evict(now, len(missing))
+	}
+	expiresAt := now.Add(c.cfg.TTL)
+	for _, id := range missing {
+		profile, found := fetched[id]
+		c.entries[id] = cacheEntry{profile: profile, found: found, expiresAt: expiresAt}
+		if found {
+			profiles[id] = profile
+		} else {
+			delete(profiles, id) // Pengguna yang sudah dihapus tidak lagi ditampilkan dari cache
+		}
+	}
+	return profiles, nil
+}
+
+// evict menghapus entry yang kedaluwarsa, lalu entry sembarang (urutan map) sampai ada ruang untuk
+// n entry baru. Dipanggil dengan c.mu terkunci.
+func (c *Cache) evict(now time.Time, n int) {
+	for id, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+	for id := range c.entries {
+		if len(c.entries)+n <= c.cfg.MaxEntries {
+			return
+		}
+		delete(c.entries, id)
+	}
+}
+
+// Unavailable adalah domain.UserDirectory untuk deployment tanpa user-service: tidak ada profil
+// yang dikenal, sehingga pengguna hanya ditampilkan dengan ID-nya.
+type Unavailable struct{}
+
+// LookupUsers selalu mengembalikan map kosong.
+func (Unavailable) LookupUsers(ctx context.Context, ids []domain.UserID) (map[domain.UserID]domain.UserProfile, error) {
+	return map[domain.UserID]domain.UserProfile{}, nil
+}
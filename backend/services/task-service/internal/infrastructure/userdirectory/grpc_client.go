@@ -0,0 +1,78 @@
+// file: backend/services/task-service/internal/infrastructure/userdirectory/grpc_client.go
+package userdirectory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	userv1 "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/pkg/pb/user/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// GRPCClient adalah implementasi domain.UserDirectory dengan user.v1.UserService di user-service.
+type GRPCClient struct {
+	conn    *grpc.ClientConn
+	client  userv1.UserServiceClient
+	token   string // Dikirim sebagai metadata authorization jika tidak kosong
+	timeout time.Duration
+}
+
+// NewGRPCClient membuat klien untuk user-service di addr (host:port). Koneksi dibuat saat RPC
+// pertama, sehingga user-service yang belum berjalan tidak menggagalkan startup. Koneksi memakai
+// plaintext untuk jaringan internal; token adalah token service-to-service opsional.
+func NewGRPCClient(addr, token string, timeout time.Duration) (*GRPCClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating user-service client: %w", err)
+	}
+	return &GRPCClient{
+		conn:    conn,
+		client:  userv1.NewUserServiceClient(conn),
+		token:   token,
+		timeout: timeout,
+	}, nil
+}
+
+// Close menutup koneksi ke user-service.
+func (c *GRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+// LookupUsers memanggil BatchGetUsers dengan batas waktu c.timeout.
+func (c *GRPCClient) LookupUsers(ctx context.Context, ids []domain.UserID) (map[domain.UserID]domain.UserProfile, error) {
+	profiles := make(map[domain.UserID]domain.UserProfile, len(ids))
+	if len(ids) == 0 {
+		return profiles, nil
+	}
+	req := &userv1.BatchGetUsersRequest{Ids: make([]string, 0, len(ids))}
+	for _, id := range ids {
+		req.Ids = append(req.Ids, string(id))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	if c.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
+	}
+	if id := domain.RequestIDFromContext(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, strings.ToLower(domain.HeaderRequestID), id)
+	}
+	resp, err := c.client.BatchGetUsers(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up users: %w", err)
+	}
+	for _, user := range resp.GetUsers() {
+		id := domain.UserID(user.GetId())
+		profiles[id] = domain.UserProfile{
+			UserID:      id,
+			DisplayName: user.GetDisplayName(),
+			AvatarURL:   user.GetAvatarUrl(),
+		}
+	}
+	return profiles, nil
+}
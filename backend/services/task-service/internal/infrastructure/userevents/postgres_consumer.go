@@ -0,0 +1,147 @@
+// file: backend/services/task-service/internal/infrastructure/userevents/postgres_consumer.go
+package userevents
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultChannel adalah channel LISTEN/NOTIFY yang dipakai producer setelah menulis ke user_events.
+const DefaultChannel = "user_events"
+
+// Processor memproses event akun yang belum diproses. Diimplementasikan oleh
+// application.UserEventApplicationService.
+type Processor interface {
+	ProcessPending(ctx context.Context) (int, error)
+}
+
+// ConsumerConfig mengatur perilaku PostgresConsumer.
+type ConsumerConfig struct {
+	Channel      string        // Channel LISTEN/NOTIFY, default DefaultChannel
+	PollInterval time.Duration // Seberapa sering antrean diperiksa tanpa notifikasi, untuk mencoba ulang event yang gagal
+	MinBackoff   time.Duration // Jeda awal sebelum reconnect
+	MaxBackoff   time.Duration // Jeda maksimum sebelum reconnect
+}
+
+// DefaultConsumerConfig mengembalikan konfigurasi default PostgresConsumer.
+func DefaultConsumerConfig() ConsumerConfig {
+	return ConsumerConfig{
+		Channel:      DefaultChannel,
+		PollInterval: time.Minute,
+		MinBackoff:   500 * time.Millisecond,
+		MaxBackoff:   30 * time.Second,
+	}
+}
+
+// PostgresConsumer men-LISTEN channel user_events dan menjalankan Processor setiap kali ada
+// notifikasi, setelah (re)connect, dan setiap PollInterval.
+//
+// Seperti realtime.PostgresListener, notifikasi hanya dipakai sebagai sinyal: event dibaca dari
+// tabel user_events, sehingga event yang ditulis saat koneksi putus tetap diproses setelah reconnect.
+// Processor hanya dijalankan dari satu goroutine, sehingga tidak pernah berjalan bersamaan di satu
+// replika.
+type PostgresConsumer struct {
+	dbpool    *pgxpool.Pool
+	processor Processor
+	cfg       ConsumerConfig
+	wake      chan struct{}
+	connected atomic.Bool
+}
+
+// NewPostgresConsumer adalah constructor untuk PostgresConsumer.
+func NewPostgresConsumer(dbpool *pgxpool.Pool, processor Processor, cfg ConsumerConfig) *PostgresConsumer {
+	return &PostgresConsumer{
+		dbpool:    dbpool,
+		processor: processor,
+		cfg:       cfg,
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+// Run menjalankan consumer sampai ctx dibatalkan.
+func (c *PostgresConsumer) Run(ctx context.Context) error {
+	go c.listenLoop(ctx)
+
+	var poll <-chan time.Time
+	if c.cfg.PollInterval > 0 {
+		ticker := time.NewTicker(c.cfg.PollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.wake:
+		case <-poll:
+		}
+		if _, err := c.processor.ProcessPending(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "user event consumer: error processing events", "error", err)
+		}
+	}
+}
+
+// Connected melaporkan apakah koneksi LISTEN sedang terbuka, untuk pemeriksaan readiness.
+func (c *PostgresConsumer) Connected() bool {
+	return c.connected.Load()
+}
+
+// signal membangunkan loop pemrosesan; sinyal yang belum diambil digabung menjadi satu.
+func (c *PostgresConsumer) signal() {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// listenLoop menjaga koneksi LISTEN dengan reconnect otomatis (exponential backoff).
+func (c *PostgresConsumer) listenLoop(ctx context.Context) {
+	backoff := c.cfg.MinBackoff
+	for {
+		err := c.listen(ctx, func() { backoff = c.cfg.MinBackoff })
+		if ctx.Err() != nil {
+			return
+		}
+		slog.WarnContext(ctx, "user event consumer: connection lost, reconnecting", "backoff", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, c.cfg.MaxBackoff)
+	}
+}
+
+// listen melepas satu koneksi dari pool (Hijack, seperti realtime.PostgresListener) untuk LISTEN,
+// lalu meneruskan setiap notifikasi sebagai sinyal sampai koneksi error atau ctx dibatalkan.
+// connected dipanggil setelah LISTEN berhasil.
+func (c *PostgresConsumer) listen(ctx context.Context, connected func()) error {
+	pooled, err := c.dbpool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("error connecting: %w", err)
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{c.cfg.Channel}.Sanitize()); err != nil {
+		return fmt.Errorf("error executing LISTEN: %w", err)
+	}
+	c.connected.Store(true)
+	defer c.connected.Store(false)
+	connected()
+
+	// Proses event yang ditulis selama koneksi sebelumnya putus atau sebelum service berjalan.
+	c.signal()
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("error waiting for notification: %w", err)
+		}
+		c.signal()
+	}
+}
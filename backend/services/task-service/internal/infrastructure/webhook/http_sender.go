@@ -0,0 +1,53 @@
+// file: backend/services/task-service/internal/infrastructure/webhook/http_sender.go
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/safehttp"
+)
+
+// HTTPSender adalah implementasi domain.WebhookSender dengan net/http. Karena URL webhook
+// ditentukan pengguna, request dikirim lewat safehttp yang menolak alamat jaringan internal.
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender adalah constructor untuk HTTPSender. allowPrivateNetworks mematikan pemeriksaan
+// alamat, misalnya untuk pengembangan lokal.
+func NewHTTPSender(allowPrivateNetworks bool) *HTTPSender {
+	return &HTTPSender{
+		client: safehttp.NewClient(allowPrivateNetworks, 10*time.Second),
+	}
+}
+
+// Send mengirim delivery dengan POST. Redirect tidak diikuti dan dianggap gagal.
+func (s *HTTPSender) Send(ctx context.Context, delivery domain.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return fmt.Errorf("error creating webhook request: %w", err)
+	}
+	for name, value := range delivery.Headers {
+		req.Header.Set(name, value)
+	}
+	if id := domain.RequestIDFromContext(ctx); id != "" {
+		req.Header.Set(domain.HeaderRequestID, id) // ID request yang memicu event
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
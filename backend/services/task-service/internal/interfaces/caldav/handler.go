@@ -0,0 +1,251 @@
+// file: backend/services/task-service/internal/interfaces/caldav/handler.go
+package caldav
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/ical"
+)
+
+// Path resource CalDAV. Setiap pengguna hanya melihat resource miliknya sendiri, sehingga path
+// tidak memuat ID pengguna.
+const (
+	rootPath      = "/dav/"
+	principalPath = "/dav/principal/"
+	homePath      = "/dav/calendars/"
+	calendarPath  = "/dav/calendars/tasks/" // Satu-satunya koleksi kalender, berisi task pengguna
+	objectSuffix  = ".ics"
+)
+
+// realm ditampilkan klien saat meminta username dan password.
+const realm = "go-vue-todolist CalDAV"
+
+// allowedMethods dikirim di header Allow dan sebagai jawaban OPTIONS.
+const allowedMethods = "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT"
+
+// Handler melayani subset CalDAV (RFC 4791) untuk task pengguna: satu kalender berisi VTODO yang
+// bisa dibaca lewat PROPFIND, calendar-query, dan calendar-multiget, serta diubah lewat PUT dan
+// DELETE. Nama resource adalah ID task.
+type Handler struct {
+	tokens application.CalDAVApplicationService
+	tasks  application.TaskApplicationService
+	idGen  domain.IDGenerator
+}
+
+// NewHandler adalah constructor untuk Handler.
+func NewHandler(tokens application.CalDAVApplicationService, tasks application.TaskApplicationService, idGen domain.IDGenerator) *Handler {
+	return &Handler{
+		tokens: tokens,
+		tasks:  tasks,
+		idGen:  idGen,
+	}
+}
+
+// Authenticate memeriksa HTTP Basic auth dengan token CalDAV sebagai password, karena klien CalDAV
+// tidak bisa mengirim access token. Username diabaikan. Pemilik token disimpan di context seperti
+// middleware autentikasi REST.
+func (h *Handler) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, token, ok := r.BasicAuth()
+		if !ok {
+			unauthorized(w)
+			return
+		}
+		userID, err := h.tokens.Authenticate(r.Context(), token)
+		if errors.Is(err, domain.ErrInvalidCalDAVToken) {
+			unauthorized(w)
+			return
+		}
+		if err != nil {
+			slog.ErrorContext(r.Context(), "caldav: error authenticating token", "error", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithUserID(r.Context(), userID)))
+	})
+}
+
+func unauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="`+realm+`", charset="UTF-8"`)
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+}
+
+// ServeHTTP meneruskan request ke koleksi atau calendar object resource sesuai path.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Header().Set("DAV", "1, 3, calendar-access")
+		w.Header().Set("Allow", allowedMethods)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if name, ok := objectName(r.URL.Path); ok {
+		h.serveObject(w, r, name)
+		return
+	}
+	switch collection := strings.TrimSuffix(r.URL.Path, "/") + "/"; collection {
+	case rootPath, principalPath, homePath, calendarPath:
+		h.serveCollection(w, r, collection)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// objectName mengembalikan nama resource (tanpa .ics) jika path menunjuk calendar object di
+// koleksi task.
+func objectName(path string) (string, bool) {
+	file, ok := strings.CutPrefix(path, calendarPath)
+	if !ok || strings.Contains(file, "/") {
+		return "", false
+	}
+	name, ok := strings.CutSuffix(file, objectSuffix)
+	return name, ok && name != ""
+}
+
+func (h *Handler) serveCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	switch r.Method {
+	case "PROPFIND":
+		h.propfindCollection(w, r, collection)
+	case "REPORT":
+		if collection != calendarPath {
+			http.Error(w, "reports are only supported on the task calendar", http.StatusForbidden)
+			return
+		}
+		h.report(w, r)
+	default:
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// propfindCollection menjawab PROPFIND pada koleksi. Depth selain 0 diperlakukan sebagai 1.
+func (h *Handler) propfindCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	depth := r.Header.Get("Depth")
+
+	ms := newMultistatus()
+	switch collection {
+	case rootPath, principalPath:
+		ms.add(principalResource(collection), req)
+	case homePath, calendarPath:
+		objects, err := h.objects(r.Context())
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		if collection == homePath {
+			ms.add(homeResource(), req)
+			if depth != "0" {
+				ms.add(calendarResource(objects), req)
+			}
+			break
+		}
+		ms.add(calendarResource(objects), req)
+		if depth != "0" {
+			for _, obj := range objects {
+				ms.add(obj.resource(), req)
+			}
+		}
+	}
+	ms.write(w)
+}
+
+// report menjawab calendar-query dan calendar-multiget (RFC 4791 bagian 7.8 dan 7.9). Filter
+// calendar-query hanya dibaca sampai tipe komponen; time-range diabaikan sehingga hasilnya bisa
+// lebih banyak dari yang diminta, yang tetap aman karena klien memfilter ulang.
+func (h *Handler) report(w http.ResponseWriter, r *http.Request) {
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ms := newMultistatus()
+	switch req.root {
+	case reportCalendarQuery:
+		if !matchesTodo(req.comps) {
+			break
+		}
+		objects, err := h.objects(r.Context())
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		for _, obj := range objects {
+			ms.add(obj.resource(), req)
+		}
+	case reportCalendarMultiget:
+		if err := h.multiget(r.Context(), ms, req); err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+	default:
+		http.Error(w, "unsupported report", http.StatusForbidden)
+		return
+	}
+	ms.write(w)
+}
+
+// matchesTodo melaporkan apakah comp-filter calendar-query bisa cocok dengan VTODO.
+func matchesTodo(comps []string) bool {
+	other := false
+	for _, comp := range comps {
+		switch comp {
+		case "VTODO":
+			return true
+		case "VCALENDAR":
+		default:
+			other = true
+		}
+	}
+	return !other
+}
+
+// errorMapping memetakan error domain ke status HTTP, dengan pengelompokan yang sama seperti
+// errorMapping di layer REST. Body error berupa teks biasa karena klien CalDAV tidak membaca JSON.
+var errorMapping = []struct {
+	err    error
+	status int
+}{
+	{domain.ErrTaskNotFound, http.StatusNotFound},
+
+	{ical.ErrInvalidTodo, http.StatusBadRequest},
+	{domain.ErrTaskTitleRequired, http.StatusBadRequest},
+	{domain.ErrInvalidTaskID, http.StatusBadRequest},
+	{domain.ErrInvalidDueText, http.StatusBadRequest},
+	{domain.ErrInvalidTimeZone, http.StatusBadRequest},
+	{domain.ErrInvalidEnumValue, http.StatusBadRequest},
+
+	{domain.ErrTaskUpdateConflict, http.StatusConflict},
+	{domain.ErrTaskAlreadyCompleted, http.StatusConflict},
+	{domain.ErrWIPLimitReached, http.StatusConflict},
+
+	{domain.ErrListReadOnly, http.StatusForbidden},
+	{domain.ErrWorkspaceArchived, http.StatusLocked},
+}
+
+// writeError memetakan err ke status HTTP. Error yang tidak dikenal dicatat dan dikirim sebagai 500
+// tanpa detail.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	for _, m := range errorMapping {
+		if errors.Is(err, m.err) {
+			http.Error(w, err.Error(), m.status)
+			return
+		}
+	}
+	slog.ErrorContext(r.Context(), "caldav: internal error", "error", err)
+	http.Error(w, "internal server error", http.StatusInternalServerError)
+}
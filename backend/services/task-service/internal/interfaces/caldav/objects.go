@@ -0,0 +1,440 @@
+// file: backend/services/task-service/internal/interfaces/caldav/objects.go
+package caldav
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/ical"
+)
+
+// objectContentType adalah getcontenttype calendar object resource.
+const objectContentType = "text/calendar; charset=utf-8; component=VTODO"
+
+// Nilai properti yang sama untuk semua pengguna.
+var (
+	privilegeSetValue = "<d:privilege><d:read/></d:privilege><d:privilege><d:write/></d:privilege>" +
+		"<d:privilege><d:write-content/></d:privilege><d:privilege><d:bind/></d:privilege>" +
+		"<d:privilege><d:unbind/></d:privilege><d:privilege><d:read-current-user-privilege-set/></d:privilege>"
+	supportedReportSetValue = "<d:supported-report><d:report><c:calendar-query/></d:report></d:supported-report>" +
+		"<d:supported-report><d:report><c:calendar-multiget/></d:report></d:supported-report>"
+)
+
+// object adalah task dalam bentuk calendar object resource. ETag adalah hash isi dokumen, sehingga
+// berubah tepat ketika dokumen yang dikirim ke klien berubah.
+type object struct {
+	task *domain.Task
+	data []byte
+	etag string
+}
+
+func newObject(task *domain.Task) (*object, error) {
+	var buf bytes.Buffer
+	if err := ical.WriteTodo(&buf, task); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return &object{
+		task: task,
+		data: buf.Bytes(),
+		etag: `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}, nil
+}
+
+func objectHref(taskID string) string {
+	return calendarPath + url.PathEscape(taskID) + objectSuffix
+}
+
+func (o *object) resource() resource {
+	return resource{
+		href: objectHref(o.task.ID),
+		props: map[xml.Name]string{
+			propResourceType:   "",
+			propGetETag:        escape(o.etag),
+			propGetContentType: objectContentType,
+			propCalendarData:   escape(string(o.data)),
+		},
+	}
+}
+
+// principalResource adalah principal pengguna, juga dipakai untuk /dav/ agar klien yang memulai
+// discovery dari root menemukan current-user-principal.
+func principalResource(href string) resource {
+	resourceType := "<d:collection/>"
+	if href == principalPath {
+		resourceType = "<d:principal/>"
+	}
+	return resource{
+		href: href,
+		props: map[xml.Name]string{
+			propResourceType:         resourceType,
+			propCurrentUserPrincipal: hrefValue(principalPath),
+			propPrincipalURL:         hrefValue(principalPath),
+			propCalendarHomeSet:      hrefValue(homePath),
+		},
+	}
+}
+
+func homeResource() resource {
+	return resource{
+		href: homePath,
+		props: map[xml.Name]string{
+			propResourceType:         "<d:collection/>",
+			propCurrentUserPrincipal: hrefValue(principalPath),
+			propOwner:                hrefValue(principalPath),
+		},
+	}
+}
+
+// calendarResource adalah koleksi task. getctag berubah jika ada task yang ditambah, diubah, atau
+// dihapus, sehingga klien bisa melewati sinkronisasi jika nilainya sama.
+func calendarResource(objects []*object) resource {
+	ctag := sha256.New()
+	for _, obj := range objects {
+		ctag.Write([]byte(obj.task.ID + obj.etag + "\n"))
+	}
+	return resource{
+		href: calendarPath,
+		props: map[xml.Name]string{
+			propResourceType:         "<d:collection/><c:calendar/>",
+			propDisplayName:          "Tasks",
+			propCurrentUserPrincipal: hrefValue(principalPath),
+			propOwner:                hrefValue(principalPath),
+			propPrivilegeSet:         privilegeSetValue,
+			propSupportedReportSet:   supportedReportSetValue,
+			propSupportedComponents:  `<c:comp name="VTODO"/>`,
+			propGetCTag:              escape(`"` + hex.EncodeToString(ctag.Sum(nil)[:16]) + `"`),
+		},
+	}
+}
+
+// objects mengembalikan task aktif milik pengguna. Task di daftar yang dibagikan kepada pengguna
+// dan task yang diarsipkan tidak termasuk.
+func (h *Handler) objects(ctx context.Context) ([]*object, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	tasks, err := h.tasks.GetTasksByUserID(ctx, userID, domain.TaskOrder{Sort: domain.TaskSortPosition, HideArchived: true})
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]*object, 0, len(tasks))
+	for _, task := range tasks {
+		obj, err := newObject(task)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// multiget menulis object untuk setiap href di req, atau 404 jika href bukan task milik pengguna.
+func (h *Handler) multiget(ctx context.Context, ms *multistatus, req *request) error {
+	userID, _ := auth.UserIDFromContext(ctx)
+	ids := make([]string, 0, len(req.hrefs))
+	for _, href := range req.hrefs {
+		if name, ok := objectName(hrefPath(href)); ok {
+			ids = append(ids, h.taskID(name))
+		}
+	}
+	tasks, err := h.tasks.GetTasksByIDs(ctx, userID, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		if visible(task, userID) {
+			byID[task.ID] = task
+		}
+	}
+
+	for _, href := range req.hrefs {
+		name, ok := objectName(hrefPath(href))
+		task := byID[h.taskID(name)]
+		if !ok || task == nil {
+			ms.addStatus(href, http.StatusNotFound)
+			continue
+		}
+		obj, err := newObject(task)
+		if err != nil {
+			return err
+		}
+		ms.add(obj.resource(), req)
+	}
+	return nil
+}
+
+// hrefPath mengembalikan path ter-decode dari href, yang bisa berupa path atau URL absolut.
+func hrefPath(href string) string {
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+// taskID mengubah nama resource menjadi ID task. Klien Apple membuat nama dari UUID huruf besar,
+// yang diterima dalam bentuk huruf kecil jika hanya bentuk itu yang valid untuk generator ID.
+func (h *Handler) taskID(name string) string {
+	if h.idGen.Validate(name) != nil {
+		if lower := strings.ToLower(name); h.idGen.Validate(lower) == nil {
+			return lower
+		}
+	}
+	return name
+}
+
+// visible melaporkan apakah task termasuk koleksi pengguna.
+func visible(task *domain.Task, userID domain.UserID) bool {
+	return task.UserID == userID && !task.Archived
+}
+
+func (h *Handler) serveObject(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		h.getObject(w, r, name)
+	case "PROPFIND":
+		h.propfindObject(w, r, name)
+	case http.MethodPut:
+		h.putObject(w, r, name)
+	case http.MethodDelete:
+		h.deleteObject(w, r, name)
+	default:
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// findObject mengembalikan object untuk nama resource, atau ErrTaskNotFound jika task tidak ada
+// atau bukan bagian koleksi pengguna.
+func (h *Handler) findObject(ctx context.Context, name string) (*object, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	task, err := h.tasks.GetTaskByID(ctx, userID, h.taskID(name))
+	if err != nil {
+		return nil, err
+	}
+	if !visible(task, userID) {
+		return nil, domain.ErrTaskNotFound
+	}
+	return newObject(task)
+}
+
+func (h *Handler) getObject(w http.ResponseWriter, r *http.Request, name string) {
+	obj, err := h.findObject(r.Context(), name)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("ETag", obj.etag)
+	if match := r.Header.Get("If-None-Match"); match != "" && etagMatches(match, obj.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if _, err := w.Write(obj.data); err != nil {
+		slog.ErrorContext(r.Context(), "caldav: error writing object", "error", err)
+	}
+}
+
+func (h *Handler) propfindObject(w http.ResponseWriter, r *http.Request, name string) {
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	obj, err := h.findObject(r.Context(), name)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	ms := newMultistatus()
+	ms.add(obj.resource(), req)
+	ms.write(w)
+}
+
+// putObject membuat atau mengganti task dari VTODO di body. Precondition If-Match dan
+// If-None-Match diperiksa terhadap ETag object. Server menambah dan menormalkan properti, sehingga
+// ETag tidak dikirim dan klien membaca ulang object (RFC 4791 bagian 5.3.4).
+func (h *Handler) putObject(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	userID, _ := auth.UserIDFromContext(ctx)
+	todo, err := ical.ParseTodo(r.Body)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	existing, err := h.findObject(ctx, name)
+	if err != nil && !errors.Is(err, domain.ErrTaskNotFound) {
+		h.writeError(w, r, err)
+		return
+	}
+	if !preconditionsMet(r, existing) {
+		http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+		return
+	}
+
+	if existing != nil {
+		if _, err := h.tasks.UpdateTask(ctx, userID, existing.task.ID, updateInput(existing.task, todo)); err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	task, _, err := h.tasks.CreateTask(ctx, userID, createInput(h.taskID(name), todo))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if todo.Completed {
+		if _, err := h.tasks.CompleteTask(ctx, userID, task.ID); err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+	}
+	w.Header().Set("Location", objectHref(task.ID))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) deleteObject(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	userID, _ := auth.UserIDFromContext(ctx)
+	obj, err := h.findObject(ctx, name)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if !preconditionsMet(r, obj) {
+		http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+		return
+	}
+	if err := h.tasks.DeleteTask(ctx, userID, obj.task.ID); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// preconditionsMet memeriksa If-Match dan If-None-Match untuk request yang mengubah object;
+// existing nil berarti object belum ada.
+func preconditionsMet(r *http.Request, existing *object) bool {
+	if match := r.Header.Get("If-Match"); match != "" {
+		if existing == nil || !etagMatches(match, existing.etag) {
+			return false
+		}
+	}
+	if match := r.Header.Get("If-None-Match"); match != "" && existing != nil {
+		if etagMatches(match, existing.etag) {
+			return false
+		}
+	}
+	return true
+}
+
+// etagMatches melaporkan apakah header berisi daftar ETag cocok dengan etag; "*" cocok dengan semua.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// priorityName memetakan PRIORITY iCalendar ke prioritas builtin, kebalikan rentang dari
+// ical.Priority (1 urgent, 3 high, 5 medium, 9 low).
+func priorityName(priority int) string {
+	switch {
+	case priority == 0:
+		return ""
+	case priority <= 2:
+		return "urgent"
+	case priority <= 4:
+		return "high"
+	case priority == 5:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// dueText mengubah DUE menjadi teks tenggat absolut untuk DueText; string kosong menghapus tenggat.
+// Waktu floating dan tanggal saja dibaca di zona waktu pengguna.
+func dueText(todo *ical.Todo) string {
+	switch {
+	case todo.Due == nil:
+		return ""
+	case todo.DueAllDay:
+		return todo.Due.Format("2006-01-02")
+	case todo.DueLocation != nil:
+		return todo.Due.In(todo.DueLocation).Format("2006-01-02 15:04")
+	default:
+		return todo.Due.Format("2006-01-02 15:04")
+	}
+}
+
+func createInput(id string, todo *ical.Todo) application.CreateTaskInput {
+	input := application.CreateTaskInput{
+		ID:          id,
+		Title:       todo.Summary,
+		Description: todo.Description,
+	}
+	if todo.Due != nil {
+		due := dueText(todo)
+		input.DueText = &due
+		input.DueLocation = todo.DueLocation
+	}
+	if priority := priorityName(todo.Priority); priority != "" {
+		input.Priority = &priority
+	}
+	return input
+}
+
+// updateInput hanya mengubah tenggat dan prioritas jika berbeda dari yang ditulis ke klien, agar
+// task dengan tenggat berdetik atau prioritas enum workspace tidak berubah saat klien menyimpan
+// ulang object tanpa mengubahnya.
+func updateInput(task *domain.Task, todo *ical.Todo) application.UpdateTaskInput {
+	input := application.UpdateTaskInput{
+		Description: &todo.Description,
+		Completed:   &todo.Completed,
+	}
+	if todo.Summary != "" {
+		input.Title = &todo.Summary
+	}
+	if !sameDue(task, todo) {
+		due := dueText(todo)
+		input.DueText = &due
+		input.DueLocation = todo.DueLocation
+	}
+	if todo.Priority != ical.Priority(task) {
+		priority := priorityName(todo.Priority)
+		input.Priority = &priority
+	}
+	return input
+}
+
+// sameDue melaporkan apakah DUE sama dengan tenggat task. Waktu floating dan tanggal saja tidak
+// bisa dibandingkan tanpa zona waktu pengguna, sehingga dianggap berubah.
+func sameDue(task *domain.Task, todo *ical.Todo) bool {
+	if todo.Due == nil || task.DueAt == nil {
+		return todo.Due == nil && task.DueAt == nil
+	}
+	if todo.DueAllDay || todo.DueLocation == nil {
+		return false
+	}
+	return todo.Due.Equal(task.DueAt.Truncate(time.Second))
+}
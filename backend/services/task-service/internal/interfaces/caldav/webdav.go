@@ -0,0 +1,223 @@
+// file: backend/services/task-service/internal/interfaces/caldav/webdav.go
+package caldav
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// Namespace XML yang dipakai WebDAV dan CalDAV.
+const (
+	nsDAV    = "DAV:"
+	nsCalDAV = "urn:ietf:params:xml:ns:caldav"
+	nsCS     = "http://calendarserver.org/ns/" // Ekstensi Apple, misalnya getctag
+)
+
+// prefixes adalah prefix namespace pada response multistatus.
+var prefixes = map[string]string{
+	nsDAV:    "d",
+	nsCalDAV: "c",
+	nsCS:     "cs",
+}
+
+// maxRequestBody membatasi ukuran body PROPFIND dan REPORT.
+const maxRequestBody = 1 << 20
+
+var (
+	propResourceType         = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName          = xml.Name{Space: nsDAV, Local: "displayname"}
+	propCurrentUserPrincipal = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL         = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propOwner                = xml.Name{Space: nsDAV, Local: "owner"}
+	propPrivilegeSet         = xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}
+	propSupportedReportSet   = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propGetETag              = xml.Name{Space: nsDAV, Local: "getetag"}
+	propGetContentType       = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propCalendarHomeSet      = xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}
+	propSupportedComponents  = xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}
+	propCalendarData         = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propGetCTag              = xml.Name{Space: nsCS, Local: "getctag"}
+
+	reportCalendarQuery    = xml.Name{Space: nsCalDAV, Local: "calendar-query"}
+	reportCalendarMultiget = xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}
+)
+
+// notInAllprop adalah properti yang hanya dikirim jika diminta secara eksplisit (RFC 4791 bagian 9.6).
+var notInAllprop = map[xml.Name]bool{propCalendarData: true}
+
+// request adalah isi body PROPFIND atau REPORT yang dipakai handler.
+type request struct {
+	root  xml.Name   // Elemen root, misalnya calendar-multiget
+	all   bool       // allprop, propname, atau body kosong
+	props []xml.Name // Properti yang diminta di <prop>
+	hrefs []string   // <href> pada calendar-multiget
+	comps []string   // Atribut name setiap <comp-filter> pada calendar-query
+}
+
+// parseRequest membaca body PROPFIND atau REPORT. Body kosong berarti allprop.
+func parseRequest(r *http.Request) (*request, error) {
+	decoder := xml.NewDecoder(io.LimitReader(r.Body, maxRequestBody))
+	req := &request{all: true}
+	var stack []xml.Name
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			if len(stack) > 0 {
+				return nil, errors.New("unexpected end of XML body")
+			}
+			return req, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid XML body: %w", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			parent := xml.Name{}
+			if len(stack) > 0 {
+				parent = stack[len(stack)-1]
+			}
+			switch {
+			case len(stack) == 0:
+				req.root = t.Name
+			case parent.Space == nsDAV && parent.Local == "prop" && len(stack) == 2:
+				req.all = false
+				req.props = append(req.props, t.Name)
+			case t.Name.Space == nsCalDAV && t.Name.Local == "comp-filter":
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "name" {
+						req.comps = append(req.comps, strings.ToUpper(attr.Value))
+					}
+				}
+			case t.Name.Space == nsDAV && t.Name.Local == "href" && len(stack) == 1:
+				var href string
+				if err := decoder.DecodeElement(&href, &t); err != nil {
+					return nil, fmt.Errorf("invalid href: %w", err)
+				}
+				req.hrefs = append(req.hrefs, strings.TrimSpace(href))
+				continue
+			}
+			stack = append(stack, t.Name)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// wants melaporkan apakah properti name diminta.
+func (r *request) wants(name xml.Name) bool {
+	if r.all {
+		return !notInAllprop[name]
+	}
+	for _, prop := range r.props {
+		if prop == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resource adalah satu response di multistatus: href dan nilai properti dalam bentuk XML.
+type resource struct {
+	href  string
+	props map[xml.Name]string
+}
+
+// multistatus menyusun body 207 Multi-Status (RFC 4918 bagian 13).
+type multistatus struct {
+	b strings.Builder
+}
+
+func newMultistatus() *multistatus {
+	m := &multistatus{}
+	m.b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	m.b.WriteString(`<d:multistatus xmlns:d="DAV:" xmlns:c="` + nsCalDAV + `" xmlns:cs="` + nsCS + `">`)
+	return m
+}
+
+// add menulis properti res yang diminta req. Properti yang diminta tetapi tidak dimiliki
+// resource dilaporkan dengan status 404.
+func (m *multistatus) add(res resource, req *request) {
+	m.b.WriteString("<d:response><d:href>")
+	xml.EscapeText(&m.b, []byte(res.href))
+	m.b.WriteString("</d:href>")
+
+	var found, missing strings.Builder
+	if req.all {
+		for name, value := range res.props {
+			if !notInAllprop[name] {
+				writeProp(&found, name, value)
+			}
+		}
+	} else {
+		for _, name := range req.props {
+			if value, ok := res.props[name]; ok {
+				writeProp(&found, name, value)
+			} else {
+				writeProp(&missing, name, "")
+			}
+		}
+	}
+	if found.Len() > 0 || missing.Len() == 0 {
+		m.propstat(found.String(), http.StatusOK)
+	}
+	if missing.Len() > 0 {
+		m.propstat(missing.String(), http.StatusNotFound)
+	}
+	m.b.WriteString("</d:response>")
+}
+
+// addStatus menulis response tanpa properti, misalnya 404 untuk href calendar-multiget yang tidak ada.
+func (m *multistatus) addStatus(href string, status int) {
+	m.b.WriteString("<d:response><d:href>")
+	xml.EscapeText(&m.b, []byte(href))
+	m.b.WriteString("</d:href>")
+	fmt.Fprintf(&m.b, "<d:status>HTTP/1.1 %d %s</d:status></d:response>", status, http.StatusText(status))
+}
+
+func (m *multistatus) propstat(props string, status int) {
+	fmt.Fprintf(&m.b, "<d:propstat><d:prop>%s</d:prop><d:status>HTTP/1.1 %d %s</d:status></d:propstat>",
+		props, status, http.StatusText(status))
+}
+
+func (m *multistatus) write(w http.ResponseWriter) {
+	m.b.WriteString("</d:multistatus>")
+	w.Header().Set("Content-Type", `application/xml; charset="utf-8"`)
+	w.WriteHeader(http.StatusMultiStatus)
+	if _, err := io.WriteString(w, m.b.String()); err != nil {
+		slog.Error("error writing response", "error", err)
+	}
+}
+
+// writeProp menulis satu elemen properti; value adalah isi XML yang sudah di-escape.
+func writeProp(b *strings.Builder, name xml.Name, value string) {
+	tag := name.Local
+	attr := ""
+	if prefix, ok := prefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+	} else if name.Space != "" {
+		tag = "x:" + name.Local
+		attr = ` xmlns:x="` + escape(name.Space) + `"`
+	}
+	if value == "" {
+		fmt.Fprintf(b, "<%s%s/>", tag, attr)
+		return
+	}
+	fmt.Fprintf(b, "<%s%s>%s</%s>", tag, attr, value, tag)
+}
+
+// escape meng-escape teks untuk isi elemen atau atribut XML.
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// hrefValue adalah isi properti yang berupa satu <d:href>.
+func hrefValue(href string) string {
+	return "<d:href>" + escape(href) + "</d:href>"
+}
@@ -0,0 +1,59 @@
+// file: backend/services/task-service/internal/interfaces/dto/account_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// AccountDeletionResponse adalah status penghapusan akun untuk DELETE /api/v1/me dan
+// GET /api/v1/me/deletions/{id}.
+type AccountDeletionResponse struct {
+	ID          string                          `json:"id"`
+	Status      string                          `json:"status"`
+	Error       string                          `json:"error,omitempty"`
+	Receipt     *AccountDeletionReceiptResponse `json:"receipt"` // Diisi saat status completed
+	RequestedAt time.Time                       `json:"requested_at"`
+	StartedAt   *time.Time                      `json:"started_at"`
+	CompletedAt *time.Time                      `json:"completed_at"`
+}
+
+// AccountDeletionReceiptResponse adalah jumlah data yang dihapus atau dianonimkan.
+type AccountDeletionReceiptResponse struct {
+	DeletedTasks            int64 `json:"deleted_tasks"`
+	DeletedShares           int   `json:"deleted_shares"`
+	DeletedReminderChannels int   `json:"deleted_reminder_channels"`
+	DeletedWebhooks         int64 `json:"deleted_webhooks"`
+	DeletedFiles            int   `json:"deleted_files"`
+	AnonymizedComments      int64 `json:"anonymized_comments"`
+	AnonymizedActivities    int64 `json:"anonymized_activities"`
+	AnonymizedAuditEntries  int64 `json:"anonymized_audit_entries"`
+	DeletedRecords          int64 `json:"deleted_records"`
+}
+
+// NewAccountDeletionResponse memetakan domain.AccountDeletion ke AccountDeletionResponse.
+func NewAccountDeletionResponse(deletion *domain.AccountDeletion) AccountDeletionResponse {
+	resp := AccountDeletionResponse{
+		ID:          deletion.ID,
+		Status:      string(deletion.Status),
+		Error:       deletion.Error,
+		RequestedAt: deletion.RequestedAt,
+		StartedAt:   deletion.StartedAt,
+		CompletedAt: deletion.CompletedAt,
+	}
+	if r := deletion.Receipt; r != nil {
+		resp.Receipt = &AccountDeletionReceiptResponse{
+			DeletedTasks:            r.DeletedTasks,
+			DeletedShares:           r.DeletedShares,
+			DeletedReminderChannels: r.DeletedReminderChannels,
+			DeletedWebhooks:         r.DeletedWebhooks,
+			DeletedFiles:            r.DeletedFiles,
+			AnonymizedComments:      r.AnonymizedComments,
+			AnonymizedActivities:    r.AnonymizedActivities,
+			AnonymizedAuditEntries:  r.AnonymizedAuditEntries,
+			DeletedRecords:          r.DeletedRecords,
+		}
+	}
+	return resp
+}
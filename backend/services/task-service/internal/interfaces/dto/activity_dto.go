@@ -0,0 +1,34 @@
+// file: backend/services/task-service/internal/interfaces/dto/activity_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// ActivityResponse adalah satu entri pada GET /api/v1/activity.
+type ActivityResponse struct {
+	ID         int64     `json:"id"`
+	Type       string    `json:"type"`
+	ActorID    string    `json:"actor_id"`
+	TaskID     string    `json:"task_id"`
+	TaskTitle  string    `json:"task_title"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewActivityResponses memetakan slice domain.Activity ke slice ActivityResponse.
+func NewActivityResponses(activities []domain.Activity) []ActivityResponse {
+	responses := make([]ActivityResponse, 0, len(activities))
+	for _, activity := range activities {
+		responses = append(responses, ActivityResponse{
+			ID:         activity.ID,
+			Type:       string(activity.Type),
+			ActorID:    string(activity.ActorID),
+			TaskID:     activity.TaskID,
+			TaskTitle:  activity.TaskTitle,
+			OccurredAt: activity.OccurredAt,
+		})
+	}
+	return responses
+}
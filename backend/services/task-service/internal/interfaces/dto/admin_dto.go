@@ -0,0 +1,107 @@
+// file: backend/services/task-service/internal/interfaces/dto/admin_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// UserSummaryResponse adalah ringkasan pengguna pada hasil pencarian admin.
+type UserSummaryResponse struct {
+	UserID         string    `json:"user_id"`
+	TaskCount      int64     `json:"task_count"`
+	LastActivityAt time.Time `json:"last_activity_at"`
+}
+
+// AdminSearchResponse adalah body response untuk GET /api/v1/admin/search.
+type AdminSearchResponse struct {
+	Tasks []TaskResponse        `json:"tasks"`
+	Users []UserSummaryResponse `json:"users"`
+}
+
+// NewAdminSearchResponse memetakan hasil pencarian admin ke AdminSearchResponse.
+func NewAdminSearchResponse(tasks []*domain.Task, users []domain.UserSummary) AdminSearchResponse {
+	return AdminSearchResponse{
+		Tasks: NewTaskResponses(tasks),
+		Users: newUserSummaryResponses(users),
+	}
+}
+
+func newUserSummaryResponses(users []domain.UserSummary) []UserSummaryResponse {
+	responses := make([]UserSummaryResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, UserSummaryResponse{
+			UserID:         string(user.UserID),
+			TaskCount:      user.TaskCount,
+			LastActivityAt: user.LastActivityAt,
+		})
+	}
+	return responses
+}
+
+// AdminUserListResponse adalah body response untuk GET /api/v1/admin/users.
+type AdminUserListResponse struct {
+	Users []UserSummaryResponse `json:"users"`
+}
+
+// NewAdminUserListResponse memetakan daftar pengguna ke AdminUserListResponse.
+func NewAdminUserListResponse(users []domain.UserSummary) AdminUserListResponse {
+	return AdminUserListResponse{Users: newUserSummaryResponses(users)}
+}
+
+// UserContentCountsResponse adalah body response untuk GET /api/v1/admin/users/{userID}/counts.
+type UserContentCountsResponse struct {
+	UserID          string     `json:"user_id"`
+	Tasks           int64      `json:"tasks"`
+	OpenTasks       int64      `json:"open_tasks"`
+	ArchivedTasks   int64      `json:"archived_tasks"`
+	Comments        int64      `json:"comments"`
+	Attachments     int64      `json:"attachments"`
+	AttachmentBytes int64      `json:"attachment_bytes"`
+	Collaborators   int64      `json:"collaborators"`
+	LastActivityAt  *time.Time `json:"last_activity_at,omitempty"`
+}
+
+// NewUserContentCountsResponse memetakan domain.UserContentCounts ke UserContentCountsResponse.
+func NewUserContentCountsResponse(counts *domain.UserContentCounts) UserContentCountsResponse {
+	return UserContentCountsResponse{
+		UserID:          string(counts.UserID),
+		Tasks:           counts.Tasks,
+		OpenTasks:       counts.OpenTasks,
+		ArchivedTasks:   counts.ArchivedTasks,
+		Comments:        counts.Comments,
+		Attachments:     counts.Attachments,
+		AttachmentBytes: counts.AttachmentBytes,
+		Collaborators:   counts.Collaborators,
+		LastActivityAt:  counts.LastActivityAt,
+	}
+}
+
+// SystemStatsResponse adalah body response untuk GET /api/v1/admin/stats.
+type SystemStatsResponse struct {
+	Users           int64     `json:"users"`
+	ActiveUsers     int64     `json:"active_users_7d"`
+	Tasks           int64     `json:"tasks"`
+	OpenTasks       int64     `json:"open_tasks"`
+	TasksCreated7d  int64     `json:"tasks_created_7d"`
+	Attachments     int64     `json:"attachments"`
+	AttachmentBytes int64     `json:"attachment_bytes"`
+	Admins          int64     `json:"admins"`
+	GeneratedAt     time.Time `json:"generated_at"`
+}
+
+// NewSystemStatsResponse memetakan domain.SystemStats ke SystemStatsResponse.
+func NewSystemStatsResponse(stats *domain.SystemStats) SystemStatsResponse {
+	return SystemStatsResponse{
+		Users:           stats.Users,
+		ActiveUsers:     stats.ActiveUsers,
+		Tasks:           stats.Tasks,
+		OpenTasks:       stats.OpenTasks,
+		TasksCreated7d:  stats.TasksCreated7d,
+		Attachments:     stats.Attachments,
+		AttachmentBytes: stats.AttachmentBytes,
+		Admins:          stats.Admins,
+		GeneratedAt:     stats.GeneratedAt,
+	}
+}
@@ -0,0 +1,40 @@
+// file: backend/services/task-service/internal/interfaces/dto/archive_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// ArchiveResponse adalah representasi arsip workspace yang dikembalikan oleh API.
+type ArchiveResponse struct {
+	UserID     string     `json:"user_id"`
+	Status     string     `json:"status"`
+	ReadOnly   bool       `json:"read_only"`
+	TaskCount  int64      `json:"task_count"`
+	ArchivedAt time.Time  `json:"archived_at"`
+	PurgeAfter time.Time  `json:"purge_after"`
+	PurgedAt   *time.Time `json:"purged_at"`
+	RestoredAt *time.Time `json:"restored_at"`
+}
+
+// NewArchiveResponse memetakan domain.WorkspaceArchive ke ArchiveResponse.
+// BlobKey sengaja tidak dikirim karena merupakan detail penyimpanan internal.
+func NewArchiveResponse(archive *domain.WorkspaceArchive) ArchiveResponse {
+	return ArchiveResponse{
+		UserID:     string(archive.UserID),
+		Status:     string(archive.Status),
+		ReadOnly:   archive.ReadOnly(),
+		TaskCount:  archive.TaskCount,
+		ArchivedAt: archive.ArchivedAt,
+		PurgeAfter: archive.PurgeAfter,
+		PurgedAt:   archive.PurgedAt,
+		RestoredAt: archive.RestoredAt,
+	}
+}
+
+// RestoreArchiveRequest adalah body request untuk POST /api/v1/admin/users/{userID}/archive/restore.
+type RestoreArchiveRequest struct {
+	Reason string `json:"reason"`
+}
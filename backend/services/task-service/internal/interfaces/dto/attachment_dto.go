@@ -0,0 +1,76 @@
+// file: backend/services/task-service/internal/interfaces/dto/attachment_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// AttachmentUploadRequest adalah body request untuk POST /api/v1/tasks/{id}/attachments/uploads.
+type AttachmentUploadRequest struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+// PresignedRequestResponse adalah request yang harus dikirim klien langsung ke storage.
+type PresignedRequestResponse struct {
+	Method    string            `json:"method"`
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
+
+// NewPresignedRequestResponse memetakan domain.PresignedRequest ke PresignedRequestResponse.
+func NewPresignedRequestResponse(req *domain.PresignedRequest) PresignedRequestResponse {
+	return PresignedRequestResponse{
+		Method:    req.Method,
+		URL:       req.URL,
+		Headers:   req.Headers,
+		ExpiresAt: req.ExpiresAt,
+	}
+}
+
+// AttachmentUploadResponse adalah body response untuk POST /api/v1/tasks/{id}/attachments/uploads.
+type AttachmentUploadResponse struct {
+	AttachmentID string                   `json:"attachment_id"`
+	Upload       PresignedRequestResponse `json:"upload"`
+}
+
+// RegisterAttachmentRequest adalah body request untuk POST /api/v1/tasks/{id}/attachments.
+type RegisterAttachmentRequest struct {
+	ID          string `json:"id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+}
+
+// AttachmentResponse adalah representasi attachment yang dikembalikan oleh API.
+// StorageKey sengaja tidak dikirim karena merupakan detail penyimpanan internal.
+type AttachmentResponse struct {
+	ID          string                    `json:"id"`
+	TaskID      string                    `json:"task_id"`
+	FileName    string                    `json:"file_name"`
+	ContentType string                    `json:"content_type"`
+	Size        int64                     `json:"size"`
+	CreatedAt   time.Time                 `json:"created_at"`
+	Download    *PresignedRequestResponse `json:"download,omitempty"`
+}
+
+// NewAttachmentResponse memetakan domain.Attachment ke AttachmentResponse.
+// download boleh nil, misalnya untuk response registrasi.
+func NewAttachmentResponse(attachment *domain.Attachment, download *domain.PresignedRequest) AttachmentResponse {
+	resp := AttachmentResponse{
+		ID:          attachment.ID,
+		TaskID:      attachment.TaskID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt,
+	}
+	if download != nil {
+		presigned := NewPresignedRequestResponse(download)
+		resp.Download = &presigned
+	}
+	return resp
+}
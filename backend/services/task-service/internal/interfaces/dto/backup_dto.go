@@ -0,0 +1,16 @@
+// file: backend/services/task-service/internal/interfaces/dto/backup_dto.go
+package dto
+
+// BackupRestoreCountResponse adalah jumlah item di dokumen backup dan yang benar-benar dipulihkan.
+type BackupRestoreCountResponse struct {
+	Total    int   `json:"total"`
+	Restored int64 `json:"restored"`
+}
+
+// BackupRestoreResponse adalah hasil POST /api/v1/me/backup/restore.
+type BackupRestoreResponse struct {
+	BoardColumns BackupRestoreCountResponse `json:"board_columns"`
+	Tasks        BackupRestoreCountResponse `json:"tasks"`
+	Comments     BackupRestoreCountResponse `json:"comments"`
+	Attachments  BackupRestoreCountResponse `json:"attachments"`
+}
@@ -0,0 +1,27 @@
+// file: backend/services/task-service/internal/interfaces/dto/batch_dto.go
+package dto
+
+// BatchError adalah error satu item batch. Code sama dengan field code pada problem+json.
+type BatchError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// BatchItemResponse adalah hasil satu item batch. Status memakai kode HTTP seperti jika item
+// dikirim sebagai request tunggal (201 dibuat, 200 diperbarui, 404, 400, 424 tidak diterapkan, ...).
+type BatchItemResponse struct {
+	Index  int           `json:"index"`
+	ID     string        `json:"id,omitempty"`
+	Status int           `json:"status"`
+	Task   *TaskResponse `json:"task,omitempty"`
+	Error  *BatchError   `json:"error,omitempty"`
+}
+
+// BatchResponse adalah format multi-status yang sama untuk semua operasi batch (bulk create,
+// bulk complete, import, push sync). Response dikirim dengan status 200 jika semua item berhasil,
+// atau 207 Multi-Status jika ada item yang gagal.
+type BatchResponse struct {
+	Results   []BatchItemResponse `json:"results"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+}
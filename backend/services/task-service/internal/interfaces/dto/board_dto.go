@@ -0,0 +1,88 @@
+// file: backend/services/task-service/internal/interfaces/dto/board_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// BoardColumnRequest adalah body request untuk POST /api/v1/board/columns dan
+// PATCH /api/v1/board/columns/{id}. Field null/tidak dikirim berarti tidak diubah.
+type BoardColumnRequest struct {
+	Name     *string `json:"name"`
+	WIPLimit *int    `json:"wip_limit"` // 0 menghapus batas WIP
+}
+
+// ReorderBoardColumnsRequest adalah body request untuk PATCH /api/v1/board/columns/reorder.
+type ReorderBoardColumnsRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// MoveBoardTaskRequest adalah body request untuk POST /api/v1/board/tasks/{id}/move.
+type MoveBoardTaskRequest struct {
+	ColumnID *string `json:"column_id"` // null mengeluarkan task dari board
+	AfterID  *string `json:"after_id"`  // Opsional; "" berarti paling atas
+}
+
+// BoardColumnResponse adalah representasi kolom board yang dikembalikan oleh API.
+type BoardColumnResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Position  int       `json:"position"`
+	WIPLimit  *int      `json:"wip_limit"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// TaskCounts adalah jumlah task yang belum diarsipkan di kolom, dari counter cache.
+	TaskCounts TaskCountersResponse `json:"task_counts"`
+}
+
+// NewBoardColumnResponse memetakan domain.BoardColumn ke BoardColumnResponse.
+func NewBoardColumnResponse(column *domain.BoardColumn) BoardColumnResponse {
+	return BoardColumnResponse{
+		ID:         column.ID,
+		Name:       column.Name,
+		Position:   column.Position,
+		WIPLimit:   column.WIPLimit,
+		CreatedAt:  column.CreatedAt,
+		UpdatedAt:  column.UpdatedAt,
+		TaskCounts: NewTaskCountersResponse(column.Counters),
+	}
+}
+
+// NewBoardColumnResponses memetakan slice domain.BoardColumn ke slice BoardColumnResponse.
+func NewBoardColumnResponses(columns []*domain.BoardColumn) []BoardColumnResponse {
+	responses := make([]BoardColumnResponse, 0, len(columns))
+	for _, column := range columns {
+		responses = append(responses, NewBoardColumnResponse(column))
+	}
+	return responses
+}
+
+// BoardResponse adalah body response untuk GET /api/v1/board.
+type BoardResponse struct {
+	Columns    []BoardColumnTasksResponse `json:"columns"`
+	Unassigned []TaskResponse             `json:"unassigned"`
+}
+
+// BoardColumnTasksResponse adalah satu kolom board beserta task-nya.
+type BoardColumnTasksResponse struct {
+	BoardColumnResponse
+	Tasks []TaskResponse `json:"tasks"`
+}
+
+// NewBoardResponse memetakan domain.Board ke BoardResponse.
+func NewBoardResponse(board *domain.Board) BoardResponse {
+	resp := BoardResponse{
+		Columns:    make([]BoardColumnTasksResponse, 0, len(board.Columns)),
+		Unassigned: NewTaskResponses(board.Unassigned),
+	}
+	for _, column := range board.Columns {
+		resp.Columns = append(resp.Columns, BoardColumnTasksResponse{
+			BoardColumnResponse: NewBoardColumnResponse(column.Column),
+			Tasks:               NewTaskResponses(column.Tasks),
+		})
+	}
+	return resp
+}
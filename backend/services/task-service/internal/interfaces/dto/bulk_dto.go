@@ -0,0 +1,35 @@
+// file: backend/services/task-service/internal/interfaces/dto/bulk_dto.go
+package dto
+
+import "time"
+
+// BulkCreateRequest adalah body request untuk POST /api/v1/tasks/bulk/create.
+type BulkCreateRequest struct {
+	Tasks []CreateTaskRequest `json:"tasks"`
+}
+
+// BulkCreateResponse adalah body response untuk POST /api/v1/tasks/bulk/create.
+type BulkCreateResponse = BatchResponse
+
+// BulkCompleteRequest adalah body request untuk POST /api/v1/tasks/bulk/complete.
+type BulkCompleteRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// BulkCompleteResponse adalah body response untuk POST /api/v1/tasks/bulk/complete.
+// undo_token kosong jika tidak ada task yang berubah (semua sudah selesai atau operasi dibatalkan).
+type BulkCompleteResponse struct {
+	BatchResponse
+	UndoToken     string     `json:"undo_token,omitempty"`
+	UndoExpiresAt *time.Time `json:"undo_expires_at,omitempty"`
+}
+
+// BulkUndoRequest adalah body request untuk POST /api/v1/tasks/bulk/undo.
+type BulkUndoRequest struct {
+	UndoToken string `json:"undo_token"`
+}
+
+// BulkUndoResponse adalah body response untuk POST /api/v1/tasks/bulk/undo, berisi task yang dipulihkan.
+type BulkUndoResponse struct {
+	Tasks []TaskResponse `json:"tasks"`
+}
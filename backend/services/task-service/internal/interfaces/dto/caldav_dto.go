@@ -0,0 +1,11 @@
+// file: backend/services/task-service/internal/interfaces/dto/caldav_dto.go
+package dto
+
+// CalDAVTokenResponse adalah response rotasi password CalDAV. Password hanya dikembalikan sekali;
+// Username boleh diganti apa saja karena hanya password yang diperiksa, dan Path adalah URL server
+// CalDAV relatif terhadap origin service.
+type CalDAVTokenResponse struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Path     string `json:"path"`
+}
@@ -0,0 +1,9 @@
+// file: backend/services/task-service/internal/interfaces/dto/calendar_feed_dto.go
+package dto
+
+// CalendarFeedTokenResponse adalah response rotasi token feed kalender. Token hanya dikembalikan
+// sekali; Path adalah path feed .ics relatif terhadap origin service.
+type CalendarFeedTokenResponse struct {
+	Token string `json:"token"`
+	Path  string `json:"path"`
+}
@@ -0,0 +1,40 @@
+// file: backend/services/task-service/internal/interfaces/dto/data_export_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DataExportResponse adalah status ekspor data pribadi yang dikembalikan oleh API.
+type DataExportResponse struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	SizeBytes   int64      `json:"size_bytes,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	DownloadURL string     `json:"download_url,omitempty"` // Diisi saat status completed
+	RequestedAt time.Time  `json:"requested_at"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+}
+
+// NewDataExportResponse memetakan domain.DataExport ke DataExportResponse. BlobKey sengaja tidak
+// dikirim karena merupakan detail penyimpanan internal.
+func NewDataExportResponse(export *domain.DataExport) DataExportResponse {
+	resp := DataExportResponse{
+		ID:          export.ID,
+		Status:      string(export.Status),
+		SizeBytes:   export.SizeBytes,
+		Error:       export.Error,
+		RequestedAt: export.RequestedAt,
+		StartedAt:   export.StartedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+	}
+	if export.Status == domain.DataExportCompleted {
+		resp.DownloadURL = "/api/v1/me/exports/" + export.ID + "/download"
+	}
+	return resp
+}
@@ -0,0 +1,18 @@
+// file: backend/services/task-service/internal/interfaces/dto/debug_dto.go
+package dto
+
+import "time"
+
+// RuntimeStatsResponse adalah ringkasan runtime Go untuk GET /debug/runtime. Ukuran memori dalam byte.
+type RuntimeStatsResponse struct {
+	GoVersion    string        `json:"go_version"`
+	Goroutines   int           `json:"goroutines"`
+	GOMAXPROCS   int           `json:"gomaxprocs"`
+	HeapAlloc    uint64        `json:"heap_alloc_bytes"`
+	HeapInuse    uint64        `json:"heap_inuse_bytes"`
+	HeapObjects  uint64        `json:"heap_objects"`
+	Sys          uint64        `json:"sys_bytes"`
+	NumGC        uint32        `json:"num_gc"`
+	GCPauseTotal time.Duration `json:"gc_pause_total_ns"`
+	LastGC       *time.Time    `json:"last_gc,omitempty"`
+}
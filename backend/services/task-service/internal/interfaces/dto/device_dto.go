@@ -0,0 +1,85 @@
+// file: backend/services/task-service/internal/interfaces/dto/device_dto.go
+package dto
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DeviceRequest adalah body request untuk PUT /api/v1/me/devices/{id}.
+type DeviceRequest struct {
+	Name             string          `json:"name"`
+	Platform         string          `json:"platform"`
+	PushSubscription json.RawMessage `json:"push_subscription"`
+}
+
+// DeviceSyncResponse adalah diagnostik sync terakhir sebuah perangkat.
+type DeviceSyncResponse struct {
+	LastPullAt     *time.Time         `json:"last_pull_at"`
+	LastCursor     *string            `json:"last_cursor"`
+	LastPushAt     *time.Time         `json:"last_push_at"`
+	LastPushItems  int                `json:"last_push_items"`
+	LastPushFailed int                `json:"last_push_failed"`
+	LastPushStale  int                `json:"last_push_stale"`
+	LastPushIssues []domain.SyncIssue `json:"last_push_issues"`
+}
+
+// DeviceResponse adalah representasi perangkat yang dikembalikan oleh API. Isi langganan push
+// tidak dikirim ulang, hanya apakah perangkat berlangganan.
+type DeviceResponse struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Platform   string             `json:"platform"`
+	PushActive bool               `json:"push_active"`
+	Sync       DeviceSyncResponse `json:"sync"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+	RevokedAt  *time.Time         `json:"revoked_at"`
+}
+
+// NewDeviceResponse memetakan domain.Device ke DeviceResponse.
+func NewDeviceResponse(device *domain.Device) DeviceResponse {
+	issues := device.Sync.LastPushIssues
+	if issues == nil {
+		issues = []domain.SyncIssue{}
+	}
+	return DeviceResponse{
+		ID:         device.ID,
+		Name:       device.Name,
+		Platform:   device.Platform,
+		PushActive: len(device.PushSubscription) > 0,
+		Sync: DeviceSyncResponse{
+			LastPullAt:     device.Sync.LastPullAt,
+			LastCursor:     syncCursor(device.Sync.LastCursor),
+			LastPushAt:     device.Sync.LastPushAt,
+			LastPushItems:  device.Sync.LastPushItems,
+			LastPushFailed: device.Sync.LastPushFailed,
+			LastPushStale:  device.Sync.LastPushStale,
+			LastPushIssues: issues,
+		},
+		CreatedAt: device.CreatedAt,
+		UpdatedAt: device.UpdatedAt,
+		RevokedAt: device.RevokedAt,
+	}
+}
+
+// NewDeviceResponses memetakan slice domain.Device ke slice DeviceResponse.
+func NewDeviceResponses(devices []*domain.Device) []DeviceResponse {
+	responses := make([]DeviceResponse, 0, len(devices))
+	for _, device := range devices {
+		responses = append(responses, NewDeviceResponse(device))
+	}
+	return responses
+}
+
+// syncCursor memformat cursor sync seperti SyncResponse.Cursor.
+func syncCursor(cursor *int64) *string {
+	if cursor == nil {
+		return nil
+	}
+	formatted := strconv.FormatInt(*cursor, 10)
+	return &formatted
+}
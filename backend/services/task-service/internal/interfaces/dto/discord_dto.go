@@ -0,0 +1,121 @@
+// file: backend/services/task-service/internal/interfaces/dto/discord_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// DiscordChannelRequest adalah body request untuk PUT /api/v1/integrations/discord dan
+// PUT /api/v1/shared-lists/{ownerID}/discord.
+type DiscordChannelRequest struct {
+	WebhookURL string                 `json:"webhook_url"`
+	ChannelID  string                 `json:"channel_id"`
+	EventTypes []domain.TaskEventType `json:"event_types"`
+}
+
+// DiscordChannelResponse adalah representasi pengaturan Discord yang dikembalikan oleh API.
+// Token pada webhook URL tidak dikirim ulang; hanya ditandai dengan webhook_configured.
+type DiscordChannelResponse struct {
+	ListOwnerID       domain.UserID          `json:"list_owner_id,omitempty"` // Hanya untuk pengaturan daftar bersama
+	WebhookConfigured bool                   `json:"webhook_configured"`
+	ChannelID         string                 `json:"channel_id,omitempty"`
+	EventTypes        []domain.TaskEventType `json:"event_types"`
+	Linked            bool                   `json:"linked"` // Akun Discord sudah terhubung untuk slash command
+	UpdatedAt         time.Time              `json:"updated_at"`
+}
+
+// NewDiscordChannelResponse memetakan domain.DiscordChannel ke DiscordChannelResponse.
+func NewDiscordChannelResponse(channel *domain.DiscordChannel) DiscordChannelResponse {
+	eventTypes := channel.EventTypes
+	if eventTypes == nil {
+		eventTypes = []domain.TaskEventType{}
+	}
+	return DiscordChannelResponse{
+		ListOwnerID:       channel.ListOwnerID,
+		WebhookConfigured: channel.WebhookURL != "",
+		ChannelID:         channel.ChannelID,
+		EventTypes:        eventTypes,
+		Linked:            channel.DiscordUserID != "",
+		UpdatedAt:         channel.UpdatedAt,
+	}
+}
+
+// DiscordLinkCodeResponse adalah body response untuk POST /api/v1/integrations/discord/link-code.
+type DiscordLinkCodeResponse struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Jenis interaction dan response Discord yang ditangani.
+const (
+	DiscordInteractionPing               = 1
+	DiscordInteractionApplicationCommand = 2
+
+	DiscordResponsePong           = 1
+	DiscordResponseChannelMessage = 4
+
+	// DiscordMessageEphemeral membuat balasan hanya terlihat oleh pengguna yang menjalankan command.
+	DiscordMessageEphemeral = 1 << 6
+)
+
+// DiscordInteraction adalah bagian interaction Discord yang dipakai. Field lain diabaikan.
+type DiscordInteraction struct {
+	Type   int                `json:"type"`
+	Member *DiscordMember     `json:"member"` // Diisi untuk command di server
+	User   *DiscordUser       `json:"user"`   // Diisi untuk command di DM
+	Data   DiscordCommandData `json:"data"`
+}
+
+// DiscordMember adalah anggota server yang menjalankan command.
+type DiscordMember struct {
+	User DiscordUser `json:"user"`
+}
+
+// DiscordUser adalah akun Discord.
+type DiscordUser struct {
+	ID string `json:"id"`
+}
+
+// DiscordCommandData adalah nama command dan opsinya.
+type DiscordCommandData struct {
+	Name    string                 `json:"name"`
+	Options []DiscordCommandOption `json:"options"`
+}
+
+// DiscordCommandOption adalah subcommand (Options terisi) atau opsi bernilai (Value terisi).
+type DiscordCommandOption struct {
+	Name    string                 `json:"name"`
+	Value   any                    `json:"value"`
+	Options []DiscordCommandOption `json:"options"`
+}
+
+// UserID mengembalikan ID akun Discord yang menjalankan interaction.
+func (i DiscordInteraction) UserID() string {
+	if i.Member != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
+
+// DiscordInteractionResponse adalah balasan untuk interaction Discord.
+type DiscordInteractionResponse struct {
+	Type int                     `json:"type"`
+	Data *DiscordInteractionData `json:"data,omitempty"`
+}
+
+// DiscordInteractionData adalah pesan balasan interaction.
+type DiscordInteractionData struct {
+	domain.DiscordMessage
+	Flags           int                    `json:"flags,omitempty"`
+	AllowedMentions DiscordAllowedMentions `json:"allowed_mentions"`
+}
+
+// DiscordAllowedMentions mengatur mention pada balasan; Parse kosong mematikan semua mention.
+type DiscordAllowedMentions struct {
+	Parse []string `json:"parse"`
+}
@@ -0,0 +1,39 @@
+// file: backend/services/task-service/internal/interfaces/dto/email_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// EmailChannelRequest adalah body request untuk PUT /api/v1/integrations/email. Alamat tujuan
+// selalu alamat email akun, jadi tidak ada di body.
+type EmailChannelRequest struct {
+	EventTypes      []domain.TaskEventType `json:"event_types"`
+	ReminderMinutes int                    `json:"reminder_minutes"` // 0 berarti tanpa pengingat tenggat
+}
+
+// EmailChannelResponse adalah representasi pengaturan email yang dikembalikan oleh API.
+type EmailChannelResponse struct {
+	Address         string                 `json:"address"`
+	EventTypes      []domain.TaskEventType `json:"event_types"`
+	ReminderMinutes int                    `json:"reminder_minutes"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
+
+// NewEmailChannelResponse memetakan domain.EmailChannel ke EmailChannelResponse.
+func NewEmailChannelResponse(channel *domain.EmailChannel) EmailChannelResponse {
+	eventTypes := channel.EventTypes
+	if eventTypes == nil {
+		eventTypes = []domain.TaskEventType{}
+	}
+	return EmailChannelResponse{
+		Address:         channel.Address,
+		EventTypes:      eventTypes,
+		ReminderMinutes: int(channel.ReminderLead / time.Minute),
+		CreatedAt:       channel.CreatedAt,
+		UpdatedAt:       channel.UpdatedAt,
+	}
+}
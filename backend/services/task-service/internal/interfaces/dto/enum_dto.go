@@ -0,0 +1,47 @@
+// file: backend/services/task-service/internal/interfaces/dto/enum_dto.go
+package dto
+
+import (
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// EnumValueRequest adalah body request untuk PUT /api/v1/enums/{enum}/values/{value}.
+type EnumValueRequest struct {
+	Label      string `json:"label"`
+	Position   int    `json:"position"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+// EnumValueResponse adalah satu nilai enum pada endpoint discovery.
+type EnumValueResponse struct {
+	Value      string `json:"value"`
+	Label      string `json:"label"`
+	Position   int    `json:"position"`
+	Builtin    bool   `json:"builtin"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+// NewEnumValueResponse memetakan domain.EnumValue ke EnumValueResponse.
+func NewEnumValueResponse(value domain.EnumValue) EnumValueResponse {
+	return EnumValueResponse{
+		Value:      value.Value,
+		Label:      value.Label,
+		Position:   value.Position,
+		Builtin:    value.Builtin,
+		Deprecated: value.Deprecated,
+	}
+}
+
+// NewEnumsResponse memetakan nilai setiap enum ke body response GET /api/v1/enums, dengan key
+// nama enum. Enum tanpa nilai tetap dikirim sebagai array kosong.
+func NewEnumsResponse(enums map[domain.EnumName][]domain.EnumValue) map[domain.EnumName][]EnumValueResponse {
+	resp := make(map[domain.EnumName][]EnumValueResponse, len(enums))
+	for enum, values := range enums {
+		responses := make([]EnumValueResponse, 0, len(values))
+		for _, value := range values {
+			responses = append(responses, NewEnumValueResponse(value))
+		}
+		resp[enum] = responses
+	}
+	return resp
+}
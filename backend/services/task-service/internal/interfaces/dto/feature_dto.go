@@ -0,0 +1,7 @@
+// file: backend/services/task-service/internal/interfaces/dto/feature_dto.go
+package dto
+
+// FeatureFlagsResponse adalah feature flag yang berlaku untuk GET /api/v1/features.
+type FeatureFlagsResponse struct {
+	Features map[string]bool `json:"features"`
+}
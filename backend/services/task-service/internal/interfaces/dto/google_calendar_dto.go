@@ -0,0 +1,38 @@
+// file: backend/services/task-service/internal/interfaces/dto/google_calendar_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// GoogleCalendarConnectionRequest adalah body request untuk PUT /api/v1/integrations/google-calendar.
+type GoogleCalendarConnectionRequest struct {
+	AccessToken  string     `json:"access_token"`
+	RefreshToken string     `json:"refresh_token"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	CalendarID   string     `json:"calendar_id"` // Kosong berarti kalender utama
+}
+
+// GoogleCalendarConnectionResponse adalah representasi koneksi Google Calendar yang dikembalikan
+// oleh API. Token tidak pernah dikirim ulang.
+type GoogleCalendarConnectionResponse struct {
+	CalendarID   string     `json:"calendar_id"`
+	LastSyncedAt *time.Time `json:"last_synced_at"`
+	LastError    string     `json:"last_error,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// NewGoogleCalendarConnectionResponse memetakan domain.GoogleCalendarConnection ke
+// GoogleCalendarConnectionResponse.
+func NewGoogleCalendarConnectionResponse(conn *domain.GoogleCalendarConnection) GoogleCalendarConnectionResponse {
+	return GoogleCalendarConnectionResponse{
+		CalendarID:   conn.CalendarID,
+		LastSyncedAt: conn.LastSyncedAt,
+		LastError:    conn.LastError,
+		CreatedAt:    conn.CreatedAt,
+		UpdatedAt:    conn.UpdatedAt,
+	}
+}
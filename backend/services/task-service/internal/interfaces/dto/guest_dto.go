@@ -0,0 +1,48 @@
+// file: backend/services/task-service/internal/interfaces/dto/guest_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// GuestSessionResponse adalah response POST /api/v1/guests. AccessToken dipakai di header
+// Authorization: Bearer seperti access token biasa.
+type GuestSessionResponse struct {
+	GuestID     string    `json:"guest_id"`
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"` // Selalu "bearer"
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// NewGuestSessionResponse memetakan domain.GuestSession ke GuestSessionResponse.
+func NewGuestSessionResponse(session *domain.GuestSession) GuestSessionResponse {
+	return GuestSessionResponse{
+		GuestID:     string(session.GuestID),
+		AccessToken: session.Token,
+		TokenType:   "bearer",
+		ExpiresAt:   session.ExpiresAt,
+	}
+}
+
+// GuestMergeRequest adalah body request untuk POST /api/v1/me/guest-merges.
+type GuestMergeRequest struct {
+	GuestToken string `json:"guest_token"`
+}
+
+// GuestMergeResponse adalah hasil penggabungan tamu ke akun pengguna.
+type GuestMergeResponse struct {
+	GuestID     string    `json:"guest_id"`
+	MergedTasks int64     `json:"merged_tasks"`
+	MergedAt    time.Time `json:"merged_at"`
+}
+
+// NewGuestMergeResponse memetakan domain.GuestMerge ke GuestMergeResponse.
+func NewGuestMergeResponse(merge *domain.GuestMerge) GuestMergeResponse {
+	return GuestMergeResponse{
+		GuestID:     string(merge.GuestID),
+		MergedTasks: merge.MergedTasks,
+		MergedAt:    merge.MergedAt,
+	}
+}
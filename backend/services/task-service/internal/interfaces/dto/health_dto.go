@@ -0,0 +1,16 @@
+// file: backend/services/task-service/internal/interfaces/dto/health_dto.go
+package dto
+
+// Nilai status pada HealthResponse.
+const (
+	HealthStatusOK           = "ok"
+	HealthStatusFailing      = "failing"
+	HealthStatusShuttingDown = "shutting_down"
+)
+
+// HealthResponse adalah response GET /livez dan GET /readyz. Checks berisi status setiap
+// pemeriksaan readiness.
+type HealthResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
@@ -0,0 +1,59 @@
+// file: backend/services/task-service/internal/interfaces/dto/integrity_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// IntegrityRunRequest adalah body request untuk POST /api/v1/admin/integrity/run.
+type IntegrityRunRequest struct {
+	Repair bool   `json:"repair"`
+	Reason string `json:"reason"`
+}
+
+// IntegrityFindingResponse adalah hasil satu pemeriksaan integritas.
+type IntegrityFindingResponse struct {
+	Check     string   `json:"check"`
+	Count     int64    `json:"count"`
+	Sample    []string `json:"sample"`
+	Repaired  int64    `json:"repaired"`
+	Remaining int64    `json:"remaining"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// IntegrityReportResponse adalah laporan pemeriksaan integritas data.
+type IntegrityReportResponse struct {
+	StartedAt  time.Time                  `json:"started_at"`
+	FinishedAt time.Time                  `json:"finished_at"`
+	Repair     bool                       `json:"repair"`
+	Clean      bool                       `json:"clean"`
+	Findings   []IntegrityFindingResponse `json:"findings"`
+}
+
+// NewIntegrityReportResponse memetakan domain.IntegrityReport ke IntegrityReportResponse.
+func NewIntegrityReportResponse(report *domain.IntegrityReport) IntegrityReportResponse {
+	findings := make([]IntegrityFindingResponse, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		sample := f.Sample
+		if sample == nil {
+			sample = []string{}
+		}
+		findings = append(findings, IntegrityFindingResponse{
+			Check:     f.Check,
+			Count:     f.Count,
+			Sample:    sample,
+			Repaired:  f.Repaired,
+			Remaining: f.Remaining,
+			Error:     f.Error,
+		})
+	}
+	return IntegrityReportResponse{
+		StartedAt:  report.StartedAt,
+		FinishedAt: report.FinishedAt,
+		Repair:     report.Repair,
+		Clean:      report.Clean(),
+		Findings:   findings,
+	}
+}
@@ -0,0 +1,42 @@
+// file: backend/services/task-service/internal/interfaces/dto/list_share_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// ShareListRequest adalah body request untuk PUT /api/v1/me/collaborators/{userID}.
+type ShareListRequest struct {
+	Access string `json:"access"` // read atau write
+}
+
+// ListShareResponse adalah representasi akses kolaborator pada daftar task pemilik.
+type ListShareResponse struct {
+	OwnerID        string    `json:"owner_id"`
+	CollaboratorID string    `json:"collaborator_id"`
+	Access         string    `json:"access"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// NewListShareResponse memetakan domain.ListShare ke ListShareResponse.
+func NewListShareResponse(share *domain.ListShare) ListShareResponse {
+	return ListShareResponse{
+		OwnerID:        string(share.OwnerID),
+		CollaboratorID: string(share.CollaboratorID),
+		Access:         string(share.Access),
+		CreatedAt:      share.CreatedAt,
+		UpdatedAt:      share.UpdatedAt,
+	}
+}
+
+// NewListShareResponses memetakan slice domain.ListShare ke slice ListShareResponse.
+func NewListShareResponses(shares []*domain.ListShare) []ListShareResponse {
+	resp := make([]ListShareResponse, 0, len(shares))
+	for _, share := range shares {
+		resp = append(resp, NewListShareResponse(share))
+	}
+	return resp
+}
@@ -0,0 +1,48 @@
+// file: backend/services/task-service/internal/interfaces/dto/local_auth_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// LocalAuthCredentialsRequest adalah body request untuk POST /api/v1/auth/signup dan
+// POST /api/v1/auth/login.
+type LocalAuthCredentialsRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// RefreshTokenRequest adalah body request untuk POST /api/v1/auth/refresh dan
+// POST /api/v1/auth/logout.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// UnlockAccountRequest adalah body request untuk POST /api/v1/auth/unlock.
+type UnlockAccountRequest struct {
+	Token string `json:"token"` // Token dari email buka kunci
+}
+
+// AuthSessionResponse adalah pasangan token yang dikembalikan saat signup, login, dan refresh.
+type AuthSessionResponse struct {
+	AccessToken           string    `json:"access_token"`
+	TokenType             string    `json:"token_type"` // Selalu "bearer"
+	ExpiresIn             int       `json:"expires_in"` // Sisa masa berlaku access token, dalam detik
+	ExpiresAt             time.Time `json:"expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+// NewAuthSessionResponse memetakan domain.AuthSession ke AuthSessionResponse.
+func NewAuthSessionResponse(session *domain.AuthSession) AuthSessionResponse {
+	return AuthSessionResponse{
+		AccessToken:           session.AccessToken,
+		TokenType:             "bearer",
+		ExpiresIn:             int(time.Until(session.AccessTokenExpiresAt).Round(time.Second).Seconds()),
+		ExpiresAt:             session.AccessTokenExpiresAt,
+		RefreshToken:          session.RefreshToken,
+		RefreshTokenExpiresAt: session.RefreshTokenExpiresAt,
+	}
+}
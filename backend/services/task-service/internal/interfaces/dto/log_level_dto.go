@@ -0,0 +1,17 @@
+// file: backend/services/task-service/internal/interfaces/dto/log_level_dto.go
+package dto
+
+import "time"
+
+// UpdateLogLevelRequest adalah body PUT /api/v1/admin/log-level.
+type UpdateLogLevelRequest struct {
+	Level    string `json:"level"`              // debug, info, warn, atau error
+	Duration string `json:"duration,omitempty"` // Misalnya "15m"; kosong berarti sampai diubah lagi
+}
+
+// LogLevelResponse adalah level log yang sedang berlaku di instance ini.
+type LogLevelResponse struct {
+	Level     string     `json:"level"`
+	Default   string     `json:"default"` // Level dari LOG_LEVEL
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,41 @@
+// file: backend/services/task-service/internal/interfaces/dto/maintenance_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// UpdateMaintenanceRequest adalah body PUT /api/v1/admin/maintenance.
+type UpdateMaintenanceRequest struct {
+	Enabled    bool   `json:"enabled"`
+	Message    string `json:"message,omitempty"`
+	RetryAfter string `json:"retry_after,omitempty"` // Misalnya "10m"; kosong berarti 5 menit
+	Reason     string `json:"reason"`                // Wajib, dicatat di audit log
+}
+
+// MaintenanceResponse adalah status mode maintenance.
+type MaintenanceResponse struct {
+	Enabled    bool          `json:"enabled"`
+	Message    string        `json:"message,omitempty"`
+	RetryAfter string        `json:"retry_after,omitempty"`
+	UpdatedBy  domain.UserID `json:"updated_by,omitempty"`
+	UpdatedAt  *time.Time    `json:"updated_at,omitempty"`
+}
+
+// NewMaintenanceResponse membuat MaintenanceResponse dari domain.Maintenance.
+func NewMaintenanceResponse(maintenance domain.Maintenance) MaintenanceResponse {
+	resp := MaintenanceResponse{
+		Enabled:   maintenance.Enabled,
+		Message:   maintenance.Message,
+		UpdatedBy: maintenance.UpdatedBy,
+	}
+	if maintenance.RetryAfter > 0 {
+		resp.RetryAfter = maintenance.RetryAfter.String()
+	}
+	if !maintenance.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &maintenance.UpdatedAt
+	}
+	return resp
+}
@@ -0,0 +1,41 @@
+// file: backend/services/task-service/internal/interfaces/dto/matrix_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// MatrixChannelRequest adalah body request untuk PUT /api/v1/integrations/matrix.
+type MatrixChannelRequest struct {
+	HomeserverURL string                 `json:"homeserver_url"`
+	AccessToken   string                 `json:"access_token"`
+	Room          string                 `json:"room"` // ID room (!id:server) atau alias (#alias:server)
+	EventTypes    []domain.TaskEventType `json:"event_types"`
+}
+
+// MatrixChannelResponse adalah representasi pengaturan Matrix yang dikembalikan oleh API.
+// Access token tidak pernah dikirim ulang.
+type MatrixChannelResponse struct {
+	HomeserverURL string                 `json:"homeserver_url"`
+	RoomID        string                 `json:"room_id"`
+	EventTypes    []domain.TaskEventType `json:"event_types"`
+	VerifiedAt    time.Time              `json:"verified_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+// NewMatrixChannelResponse memetakan domain.MatrixChannel ke MatrixChannelResponse.
+func NewMatrixChannelResponse(channel *domain.MatrixChannel) MatrixChannelResponse {
+	eventTypes := channel.EventTypes
+	if eventTypes == nil {
+		eventTypes = []domain.TaskEventType{}
+	}
+	return MatrixChannelResponse{
+		HomeserverURL: channel.HomeserverURL,
+		RoomID:        channel.RoomID,
+		EventTypes:    eventTypes,
+		VerifiedAt:    channel.VerifiedAt,
+		UpdatedAt:     channel.UpdatedAt,
+	}
+}
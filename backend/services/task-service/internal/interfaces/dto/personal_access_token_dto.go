@@ -0,0 +1,68 @@
+// file: backend/services/task-service/internal/interfaces/dto/personal_access_token_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// PersonalAccessTokenRequest adalah body request untuk POST /api/v1/me/tokens.
+type PersonalAccessTokenRequest struct {
+	Name          string              `json:"name"`
+	Scopes        []domain.TokenScope `json:"scopes"`
+	Lists         []domain.UserID     `json:"lists"`           // Pemilik daftar; kosong berarti semua daftar
+	AllowedIPs    []string            `json:"allowed_ips"`     // CIDR atau alamat IP; kosong berarti semua IP
+	ExpiresInDays int                 `json:"expires_in_days"` // 0 berarti tidak kedaluwarsa
+}
+
+// PersonalAccessTokenResponse adalah representasi personal access token yang dikembalikan oleh API.
+// Token hanya diisi pada response pembuatan token.
+type PersonalAccessTokenResponse struct {
+	ID         string              `json:"id"`
+	Name       string              `json:"name"`
+	Scopes     []domain.TokenScope `json:"scopes"`
+	Lists      []domain.UserID     `json:"lists"`
+	AllowedIPs []string            `json:"allowed_ips"`
+	Hint       string              `json:"hint"`
+	Token      string              `json:"token,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+	ExpiresAt  *time.Time          `json:"expires_at"`
+	LastUsedAt *time.Time          `json:"last_used_at"`
+}
+
+// NewPersonalAccessTokenResponse memetakan domain.PersonalAccessToken ke PersonalAccessTokenResponse.
+func NewPersonalAccessTokenResponse(token *domain.PersonalAccessToken) PersonalAccessTokenResponse {
+	scopes := token.Scopes
+	if scopes == nil {
+		scopes = []domain.TokenScope{}
+	}
+	lists := token.Lists
+	if lists == nil {
+		lists = []domain.UserID{}
+	}
+	allowedIPs := make([]string, 0, len(token.AllowedIPs))
+	for _, network := range token.AllowedIPs {
+		allowedIPs = append(allowedIPs, network.String())
+	}
+	return PersonalAccessTokenResponse{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     scopes,
+		Lists:      lists,
+		AllowedIPs: allowedIPs,
+		Hint:       token.Hint,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+	}
+}
+
+// NewPersonalAccessTokenResponses memetakan daftar token ke response.
+func NewPersonalAccessTokenResponses(tokens []*domain.PersonalAccessToken) []PersonalAccessTokenResponse {
+	responses := make([]PersonalAccessTokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		responses = append(responses, NewPersonalAccessTokenResponse(token))
+	}
+	return responses
+}
@@ -0,0 +1,49 @@
+// file: backend/services/task-service/internal/interfaces/dto/push_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// PushChannelRequest adalah body request untuk PUT /api/v1/integrations/push.
+type PushChannelRequest struct {
+	EventTypes      []domain.TaskEventType `json:"event_types"`
+	SharedLists     bool                   `json:"shared_lists"`     // Juga kirim event dari daftar yang dibagikan
+	ReminderMinutes int                    `json:"reminder_minutes"` // 0 berarti tanpa pengingat tenggat
+}
+
+// PushChannelResponse adalah representasi pengaturan push yang dikembalikan oleh API.
+type PushChannelResponse struct {
+	EventTypes      []domain.TaskEventType `json:"event_types"`
+	SharedLists     bool                   `json:"shared_lists"`
+	ReminderMinutes int                    `json:"reminder_minutes"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
+
+// NewPushChannelResponse memetakan domain.PushChannel ke PushChannelResponse.
+func NewPushChannelResponse(channel *domain.PushChannel) PushChannelResponse {
+	eventTypes := channel.EventTypes
+	if eventTypes == nil {
+		eventTypes = []domain.TaskEventType{}
+	}
+	return PushChannelResponse{
+		EventTypes:      eventTypes,
+		SharedLists:     channel.SharedLists,
+		ReminderMinutes: int(channel.ReminderLead / time.Minute),
+		CreatedAt:       channel.CreatedAt,
+		UpdatedAt:       channel.UpdatedAt,
+	}
+}
+
+// PushTestResponse adalah hasil POST /api/v1/integrations/push/test.
+type PushTestResponse struct {
+	Devices int `json:"devices"` // Jumlah perangkat yang menerima notifikasi percobaan
+}
+
+// VAPIDKeyResponse berisi kunci publik VAPID untuk PushManager.subscribe (applicationServerKey).
+type VAPIDKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
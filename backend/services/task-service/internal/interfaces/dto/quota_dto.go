@@ -0,0 +1,76 @@
+// file: backend/services/task-service/internal/interfaces/dto/quota_dto.go
+package dto
+
+import (
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// QuotaUsageResponse adalah pemakaian satu kuota pada GET /api/v1/me/limits.
+type QuotaUsageResponse struct {
+	Resource          string  `json:"resource"`
+	Limit             int64   `json:"limit"`
+	Used              int64   `json:"used"`
+	Percent           float64 `json:"percent"`
+	WarningThresholds []int   `json:"warning_thresholds"`
+	WarningLevel      int     `json:"warning_level"` // Ambang tertinggi yang sudah dicapai, 0 jika belum ada
+}
+
+// LimitsResponse adalah body response untuk GET /api/v1/me/limits.
+type LimitsResponse struct {
+	Plan   string               `json:"plan"`
+	Quotas []QuotaUsageResponse `json:"quotas"`
+}
+
+// NewLimitsResponse memetakan pemakaian kuota ke LimitsResponse.
+func NewLimitsResponse(plan domain.Plan, usages []domain.QuotaUsage) LimitsResponse {
+	quotas := make([]QuotaUsageResponse, 0, len(usages))
+	for _, usage := range usages {
+		quotas = append(quotas, QuotaUsageResponse{
+			Resource:          string(usage.Policy.Resource),
+			Limit:             usage.Policy.Limit,
+			Used:              usage.Used,
+			Percent:           usage.Percent(),
+			WarningThresholds: nonNilInts(usage.Policy.WarningThresholds),
+			WarningLevel:      usage.WarningLevel(),
+		})
+	}
+	return LimitsResponse{
+		Plan:   string(plan),
+		Quotas: quotas,
+	}
+}
+
+// QuotaPolicyRequest adalah body request untuk PUT /api/v1/admin/plans/{plan}/quotas/{resource}.
+type QuotaPolicyRequest struct {
+	Limit             int64 `json:"limit"`
+	WarningThresholds []int `json:"warning_thresholds"`
+}
+
+// QuotaPolicyResponse adalah representasi kebijakan kuota untuk endpoint admin.
+type QuotaPolicyResponse struct {
+	Plan              string `json:"plan"`
+	Resource          string `json:"resource"`
+	Limit             int64  `json:"limit"`
+	WarningThresholds []int  `json:"warning_thresholds"`
+}
+
+// NewQuotaPolicyResponses memetakan slice domain.QuotaPolicy ke slice QuotaPolicyResponse.
+func NewQuotaPolicyResponses(policies []domain.QuotaPolicy) []QuotaPolicyResponse {
+	responses := make([]QuotaPolicyResponse, 0, len(policies))
+	for _, policy := range policies {
+		responses = append(responses, QuotaPolicyResponse{
+			Plan:              string(policy.Plan),
+			Resource:          string(policy.Resource),
+			Limit:             policy.Limit,
+			WarningThresholds: nonNilInts(policy.WarningThresholds),
+		})
+	}
+	return responses
+}
+
+func nonNilInts(values []int) []int {
+	if values == nil {
+		return []int{}
+	}
+	return values
+}
@@ -0,0 +1,80 @@
+// file: backend/services/task-service/internal/interfaces/dto/retrospective_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// RetrospectiveSettingsRequest adalah body request untuk PUT /api/v1/me/retrospectives/settings.
+type RetrospectiveSettingsRequest struct {
+	Enabled  bool   `json:"enabled"`
+	TimeZone string `json:"time_zone"`
+}
+
+// RetrospectiveSettingsResponse adalah representasi pengaturan retrospektif pengguna.
+type RetrospectiveSettingsResponse struct {
+	Enabled   bool       `json:"enabled"`
+	TimeZone  string     `json:"time_zone"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"` // Kosong jika pengaturan belum pernah disimpan
+}
+
+// NewRetrospectiveSettingsResponse memetakan domain.RetrospectiveSettings ke RetrospectiveSettingsResponse.
+func NewRetrospectiveSettingsResponse(settings *domain.RetrospectiveSettings) RetrospectiveSettingsResponse {
+	resp := RetrospectiveSettingsResponse{Enabled: settings.Enabled, TimeZone: settings.TimeZone}
+	if !settings.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &settings.UpdatedAt
+	}
+	return resp
+}
+
+// RetrospectiveResponse adalah representasi JSON retrospektif bulanan.
+type RetrospectiveResponse struct {
+	Month           string                      `json:"month"` // YYYY-MM
+	TimeZone        string                      `json:"time_zone"`
+	Completed       int                         `json:"completed"`
+	ActiveDays      int                         `json:"active_days"`
+	LongestStreak   int                         `json:"longest_streak"`
+	Highlights      []TaskResponse              `json:"highlights"`
+	LongestOpen     []RetrospectiveTaskResponse `json:"longest_open"`
+	BusiestWeekdays []WeekdayCountResponse      `json:"busiest_weekdays"`
+	GeneratedAt     time.Time                   `json:"generated_at"`
+}
+
+// RetrospectiveTaskResponse adalah task selesai beserta lama waktunya terbuka.
+type RetrospectiveTaskResponse struct {
+	Task           TaskResponse `json:"task"`
+	OpenForSeconds int64        `json:"open_for_seconds"`
+}
+
+// WeekdayCountResponse adalah jumlah task selesai pada satu hari dalam seminggu.
+type WeekdayCountResponse struct {
+	Weekday string `json:"weekday"` // Nama hari dalam bahasa Inggris, misalnya "Monday"
+	Tasks   int    `json:"tasks"`
+}
+
+// NewRetrospectiveResponse memetakan domain.Retrospective ke RetrospectiveResponse.
+func NewRetrospectiveResponse(retro *domain.Retrospective) RetrospectiveResponse {
+	resp := RetrospectiveResponse{
+		Month:           retro.Month.Format("2006-01"),
+		TimeZone:        retro.TimeZone,
+		Completed:       retro.Completed,
+		ActiveDays:      retro.ActiveDays,
+		LongestStreak:   retro.LongestStreak,
+		Highlights:      NewTaskResponses(retro.Highlights),
+		LongestOpen:     make([]RetrospectiveTaskResponse, 0, len(retro.LongestOpen)),
+		BusiestWeekdays: make([]WeekdayCountResponse, 0, len(retro.BusiestWeekdays)),
+		GeneratedAt:     retro.GeneratedAt,
+	}
+	for _, item := range retro.LongestOpen {
+		resp.LongestOpen = append(resp.LongestOpen, RetrospectiveTaskResponse{
+			Task:           NewTaskResponse(item.Task),
+			OpenForSeconds: int64(item.OpenFor / time.Second),
+		})
+	}
+	for _, item := range retro.BusiestWeekdays {
+		resp.BusiestWeekdays = append(resp.BusiestWeekdays, WeekdayCountResponse{Weekday: item.Weekday.String(), Tasks: item.Tasks})
+	}
+	return resp
+}
@@ -0,0 +1,52 @@
+// file: backend/services/task-service/internal/interfaces/dto/role_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// SetUserRoleRequest adalah body PUT /api/v1/admin/users/{userID}/role.
+type SetUserRoleRequest struct {
+	Role   string `json:"role"`   // user atau admin; user menghapus role tersimpan
+	Reason string `json:"reason"` // Wajib, dicatat di audit log
+}
+
+// UserRoleResponse adalah role yang diberikan admin kepada pengguna.
+type UserRoleResponse struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	GrantedBy string    `json:"granted_by"`
+	GrantedAt time.Time `json:"granted_at"`
+}
+
+// NewUserRoleResponse memetakan domain.UserRole ke UserRoleResponse.
+func NewUserRoleResponse(role domain.UserRole) UserRoleResponse {
+	return UserRoleResponse{
+		UserID:    string(role.UserID),
+		Role:      string(role.Role),
+		GrantedBy: string(role.GrantedBy),
+		GrantedAt: role.GrantedAt,
+	}
+}
+
+// UserRoleListResponse adalah body response untuk GET /api/v1/admin/roles.
+type UserRoleListResponse struct {
+	Roles []UserRoleResponse `json:"roles"`
+}
+
+// NewUserRoleListResponse memetakan daftar role tersimpan ke UserRoleListResponse.
+func NewUserRoleListResponse(roles []domain.UserRole) UserRoleListResponse {
+	responses := make([]UserRoleResponse, 0, len(roles))
+	for _, role := range roles {
+		responses = append(responses, NewUserRoleResponse(role))
+	}
+	return UserRoleListResponse{Roles: responses}
+}
+
+// RoleResponse adalah role dan permission pengguna yang sedang login, untuk GET /api/v1/me/role.
+type RoleResponse struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
@@ -0,0 +1,164 @@
+// file: backend/services/task-service/internal/interfaces/dto/scim_dto.go
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Schema URN SCIM 2.0 (RFC 7643 dan RFC 7644).
+const (
+	ScimUserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	ScimGroupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ScimListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	ScimPatchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ScimErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// ScimMeta adalah atribut meta resource SCIM.
+type ScimMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// ScimRole adalah role anggota workspace yang dihitung dari pemetaan grup.
+type ScimRole struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+// ScimMemberRef adalah referensi anggota di dalam Group.
+type ScimMemberRef struct {
+	Value string `json:"value"`
+}
+
+// ScimUser adalah resource User SCIM, dipakai untuk request dan response. Atribut lain yang
+// dikirim identity provider (misalnya emails atau name) diterima tetapi tidak disimpan.
+type ScimUser struct {
+	Schemas     []string   `json:"schemas"`
+	ID          string     `json:"id,omitempty"`
+	ExternalID  string     `json:"externalId,omitempty"`
+	UserName    string     `json:"userName"`
+	DisplayName string     `json:"displayName,omitempty"`
+	Active      *bool      `json:"active,omitempty"` // Default true saat membuat atau mengganti anggota
+	Roles       []ScimRole `json:"roles,omitempty"`
+	Meta        *ScimMeta  `json:"meta,omitempty"`
+}
+
+// ScimGroup adalah resource Group SCIM, dipakai untuk request dan response.
+type ScimGroup struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	DisplayName string          `json:"displayName"`
+	Members     []ScimMemberRef `json:"members"`
+	Meta        *ScimMeta       `json:"meta,omitempty"`
+}
+
+// ScimListResponse adalah response daftar resource SCIM.
+type ScimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// ScimPatchRequest adalah body request PATCH SCIM.
+type ScimPatchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	} `json:"Operations"`
+}
+
+// ScimError adalah body error SCIM. Status dikirim sebagai string sesuai RFC 7644.
+type ScimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// ScimTokenResponse adalah response rotasi token SCIM. Token hanya dikembalikan sekali.
+type ScimTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// ScimRoleMappingsRequest adalah body request untuk PUT /api/v1/me/scim/role-mappings dan
+// response-nya: nama grup SCIM ke role workspace.
+type ScimRoleMappingsRequest struct {
+	Mappings map[string]domain.WorkspaceRole `json:"mappings"`
+}
+
+// WorkspaceMemberResponse adalah representasi anggota workspace untuk pemilik workspace.
+type WorkspaceMemberResponse struct {
+	ID          string    `json:"id"`
+	UserName    string    `json:"user_name"`
+	ExternalID  string    `json:"external_id,omitempty"`
+	DisplayName string    `json:"display_name,omitempty"`
+	Active      bool      `json:"active"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewScimUser memetakan domain.WorkspaceMember ke resource User SCIM.
+func NewScimUser(member *domain.WorkspaceMember, location string) ScimUser {
+	return ScimUser{
+		Schemas:     []string{ScimUserSchema},
+		ID:          member.ID,
+		ExternalID:  member.ExternalID,
+		UserName:    member.UserName,
+		DisplayName: member.DisplayName,
+		Active:      &member.Active,
+		Roles:       []ScimRole{{Value: string(member.Role), Primary: true}},
+		Meta: &ScimMeta{
+			ResourceType: "User",
+			Created:      member.CreatedAt,
+			LastModified: member.UpdatedAt,
+			Location:     location,
+		},
+	}
+}
+
+// NewScimGroup memetakan domain.WorkspaceGroup ke resource Group SCIM.
+func NewScimGroup(group *domain.WorkspaceGroup, location string) ScimGroup {
+	members := make([]ScimMemberRef, 0, len(group.MemberIDs))
+	for _, id := range group.MemberIDs {
+		members = append(members, ScimMemberRef{Value: id})
+	}
+	return ScimGroup{
+		Schemas:     []string{ScimGroupSchema},
+		ID:          group.ID,
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Members:     members,
+		Meta: &ScimMeta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+			Location:     location,
+		},
+	}
+}
+
+// NewWorkspaceMemberResponse memetakan domain.WorkspaceMember ke WorkspaceMemberResponse.
+func NewWorkspaceMemberResponse(member *domain.WorkspaceMember) WorkspaceMemberResponse {
+	return WorkspaceMemberResponse{
+		ID:          member.ID,
+		UserName:    member.UserName,
+		ExternalID:  member.ExternalID,
+		DisplayName: member.DisplayName,
+		Active:      member.Active,
+		Role:        string(member.Role),
+		CreatedAt:   member.CreatedAt,
+		UpdatedAt:   member.UpdatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+// file: backend/services/task-service/internal/interfaces/dto/security_audit_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// SecurityEventResponse adalah satu entri audit log keamanan.
+type SecurityEventResponse struct {
+	ID         int64          `json:"id"`
+	Type       string         `json:"type"`
+	UserID     string         `json:"user_id,omitempty"`
+	IP         string         `json:"ip,omitempty"`
+	UserAgent  string         `json:"user_agent,omitempty"`
+	Details    map[string]any `json:"details"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+// SecurityEventListResponse adalah body response untuk GET /api/v1/admin/security-events.
+// NextBefore diisi jika mungkin ada halaman berikutnya; kirim sebagai query parameter before.
+type SecurityEventListResponse struct {
+	Events     []SecurityEventResponse `json:"events"`
+	NextBefore int64                   `json:"next_before,omitempty"`
+}
+
+// NewSecurityEventListResponse memetakan satu halaman entri ke SecurityEventListResponse.
+func NewSecurityEventListResponse(events []domain.SecurityEvent, limit int) SecurityEventListResponse {
+	response := SecurityEventListResponse{Events: make([]SecurityEventResponse, 0, len(events))}
+	for _, event := range events {
+		response.Events = append(response.Events, SecurityEventResponse{
+			ID:         event.ID,
+			Type:       string(event.Type),
+			UserID:     string(event.UserID),
+			IP:         event.IP,
+			UserAgent:  event.UserAgent,
+			Details:    event.Details,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+	if len(events) == limit && limit > 0 {
+		response.NextBefore = events[len(events)-1].ID
+	}
+	return response
+}
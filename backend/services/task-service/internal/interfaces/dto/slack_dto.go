@@ -0,0 +1,46 @@
+// file: backend/services/task-service/internal/interfaces/dto/slack_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// SlackChannelRequest adalah body request untuk PUT /api/v1/integrations/slack.
+type SlackChannelRequest struct {
+	WebhookURL      string                  `json:"webhook_url"`
+	BotToken        string                  `json:"bot_token"` // Boleh kosong untuk memakai bot token yang tersimpan
+	ChannelID       string                  `json:"channel_id"`
+	EventTypes      []domain.SlackEventType `json:"event_types"`
+	ReminderMinutes int                     `json:"reminder_minutes"` // Jarak pengingat task.due sebelum tenggat
+}
+
+// SlackChannelResponse adalah representasi pengaturan Slack yang dikembalikan oleh API. Webhook URL
+// dan bot token adalah secret, jadi hanya ditandai dengan webhook_configured dan bot_configured.
+type SlackChannelResponse struct {
+	WebhookConfigured bool                    `json:"webhook_configured"`
+	BotConfigured     bool                    `json:"bot_configured"`
+	ChannelID         string                  `json:"channel_id,omitempty"`
+	EventTypes        []domain.SlackEventType `json:"event_types"`
+	ReminderMinutes   int                     `json:"reminder_minutes"`
+	CreatedAt         time.Time               `json:"created_at"`
+	UpdatedAt         time.Time               `json:"updated_at"`
+}
+
+// NewSlackChannelResponse memetakan domain.SlackChannel ke SlackChannelResponse.
+func NewSlackChannelResponse(channel *domain.SlackChannel) SlackChannelResponse {
+	eventTypes := channel.EventTypes
+	if eventTypes == nil {
+		eventTypes = []domain.SlackEventType{}
+	}
+	return SlackChannelResponse{
+		WebhookConfigured: channel.WebhookURL != "",
+		BotConfigured:     channel.BotToken != "",
+		ChannelID:         channel.ChannelID,
+		EventTypes:        eventTypes,
+		ReminderMinutes:   int(channel.ReminderLead / time.Minute),
+		CreatedAt:         channel.CreatedAt,
+		UpdatedAt:         channel.UpdatedAt,
+	}
+}
@@ -0,0 +1,65 @@
+// file: backend/services/task-service/internal/interfaces/dto/stats_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// StatsResponse adalah body response untuk GET /api/v1/stats.
+type StatsResponse struct {
+	From                         string                  `json:"from"` // YYYY-MM-DD, inklusif
+	To                           string                  `json:"to"`   // YYYY-MM-DD, inklusif
+	Created                      int64                   `json:"created"`
+	Completed                    int64                   `json:"completed"`
+	CompletionRate               float64                 `json:"completion_rate"` // Task yang dibuat dalam rentang dan sudah selesai, 0–1
+	AverageTimeToCompleteSeconds int64                   `json:"average_time_to_complete_seconds"`
+	OpenTasks                    int64                   `json:"open_tasks"`
+	ByDay                        []DailyActivityResponse `json:"by_day"`
+	Streak                       StreakResponse          `json:"streak"`
+}
+
+// StreakResponse adalah streak penyelesaian harian pengguna.
+type StreakResponse struct {
+	Current         int     `json:"current"`
+	Longest         int     `json:"longest"`
+	LastCompletedOn *string `json:"last_completed_on"` // YYYY-MM-DD; null jika belum pernah ada task selesai
+}
+
+// DailyActivityResponse adalah jumlah task yang dibuat dan diselesaikan pada satu hari (YYYY-MM-DD).
+type DailyActivityResponse struct {
+	Date      string `json:"date"`
+	Created   int64  `json:"created"`
+	Completed int64  `json:"completed"`
+}
+
+// NewStatsResponse memetakan domain.ProductivityStats ke StatsResponse.
+func NewStatsResponse(stats *domain.ProductivityStats) StatsResponse {
+	resp := StatsResponse{
+		From:                         stats.From.Format(time.DateOnly),
+		To:                           stats.To.AddDate(0, 0, -1).Format(time.DateOnly),
+		Created:                      stats.Created,
+		Completed:                    stats.Completed,
+		CompletionRate:               stats.CompletionRate(),
+		AverageTimeToCompleteSeconds: int64(stats.AverageTimeToComplete / time.Second),
+		OpenTasks:                    stats.OpenTasks,
+		ByDay:                        make([]DailyActivityResponse, 0, len(stats.ByDay)),
+		Streak: StreakResponse{
+			Current: stats.Streak.Current,
+			Longest: stats.Streak.Longest,
+		},
+	}
+	if day := stats.Streak.LastCompletedOn; day != nil {
+		formatted := day.Format(time.DateOnly)
+		resp.Streak.LastCompletedOn = &formatted
+	}
+	for _, day := range stats.ByDay {
+		resp.ByDay = append(resp.ByDay, DailyActivityResponse{
+			Date:      day.Date.Format(time.DateOnly),
+			Created:   day.Created,
+			Completed: day.Completed,
+		})
+	}
+	return resp
+}
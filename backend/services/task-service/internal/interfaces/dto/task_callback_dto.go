@@ -0,0 +1,33 @@
+// file: backend/services/task-service/internal/interfaces/dto/task_callback_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// CreateTaskCallbackRequest adalah body request untuk POST /api/v1/tasks/{id}/callbacks.
+type CreateTaskCallbackRequest struct {
+	URL string `json:"url"`
+}
+
+// TaskCallbackResponse adalah representasi callback task yang dikembalikan oleh API.
+// Secret hanya diisi pada response pembuatan callback.
+type TaskCallbackResponse struct {
+	ID        string    `json:"id"`
+	TaskID    string    `json:"task_id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewTaskCallbackResponse memetakan domain.TaskCallback ke TaskCallbackResponse tanpa secret.
+func NewTaskCallbackResponse(callback *domain.TaskCallback) TaskCallbackResponse {
+	return TaskCallbackResponse{
+		ID:        callback.ID,
+		TaskID:    callback.TaskID,
+		URL:       callback.URL,
+		CreatedAt: callback.CreatedAt,
+	}
+}
@@ -0,0 +1,55 @@
+// file: backend/services/task-service/internal/interfaces/dto/task_comment_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/markdown"
+)
+
+// CreateTaskCommentRequest adalah body request untuk POST /api/v1/tasks/{id}/comments.
+type CreateTaskCommentRequest struct {
+	Body string `json:"body"` // Markdown; @<user_id> me-mention pengguna
+}
+
+// TaskCommentResponse adalah representasi komentar task. Mentions hanya berisi pengguna yang
+// diberi tahu; RenderedBody adalah HTML hasil render Body yang sudah disanitasi.
+type TaskCommentResponse struct {
+	ID           string    `json:"id"`
+	TaskID       string    `json:"task_id"`
+	AuthorID     string    `json:"author_id"`
+	Body         string    `json:"body"`
+	RenderedBody string    `json:"rendered_body"`
+	Mentions     []string  `json:"mentions"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	// Author adalah profil penulis; nil jika profil tidak dicari.
+	Author *UserProfileResponse `json:"author,omitempty"`
+}
+
+// NewTaskCommentResponse memetakan domain.TaskComment ke TaskCommentResponse.
+func NewTaskCommentResponse(comment *domain.TaskComment) TaskCommentResponse {
+	mentions := make([]string, 0, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		mentions = append(mentions, string(mention))
+	}
+	return TaskCommentResponse{
+		ID:           comment.ID,
+		TaskID:       comment.TaskID,
+		AuthorID:     string(comment.AuthorID),
+		Body:         comment.Body,
+		RenderedBody: markdown.Render(comment.Body),
+		Mentions:     mentions,
+		CreatedAt:    comment.CreatedAt,
+	}
+}
+
+// NewTaskCommentResponses memetakan slice domain.TaskComment ke slice TaskCommentResponse.
+func NewTaskCommentResponses(comments []*domain.TaskComment) []TaskCommentResponse {
+	resp := make([]TaskCommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		resp = append(resp, NewTaskCommentResponse(comment))
+	}
+	return resp
+}
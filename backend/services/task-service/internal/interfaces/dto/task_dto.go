@@ -0,0 +1,226 @@
+// file: backend/services/task-service/internal/interfaces/dto/task_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/markdown"
+)
+
+// CreateTaskRequest adalah body request untuk POST /api/v1/tasks.
+// ID opsional; klien offline-first boleh mengirim UUID sendiri agar request aman diulang.
+type CreateTaskRequest struct {
+	ID              string  `json:"id,omitempty"`
+	Title           string  `json:"title"`
+	Description     string  `json:"description"` // Markdown
+	EstimateMinutes *int    `json:"estimate_minutes,omitempty"`
+	Priority        *string `json:"priority,omitempty"`  // Nilai dari GET /api/v1/enums
+	DueText         *string `json:"due_text,omitempty"`  // Misalnya "tomorrow 5pm" atau "next friday"
+	TimeZone        string  `json:"time_zone,omitempty"` // Zona waktu IANA untuk DueText; kosong berarti zona waktu tersimpan
+	Color           *string `json:"color,omitempty"`     // Nilai dari GET /api/v1/tasks/appearance
+	Icon            *string `json:"icon,omitempty"`      // Nilai dari GET /api/v1/tasks/appearance
+	OwnerID         string  `json:"owner_id,omitempty"`  // Pemilik daftar bersama tujuan; kosong berarti daftar sendiri
+}
+
+// UpdateTaskRequest adalah body request untuk PATCH /api/v1/tasks/{id}.
+// Field bernilai null/tidak dikirim berarti tidak diubah.
+type UpdateTaskRequest struct {
+	Title           *string `json:"title"`
+	Description     *string `json:"description"`
+	Completed       *bool   `json:"completed"`
+	EstimateMinutes *int    `json:"estimate_minutes"` // 0 menghapus estimasi
+	Priority        *string `json:"priority"`         // String kosong menghapus prioritas
+	DueText         *string `json:"due_text"`         // String kosong menghapus tenggat
+	TimeZone        string  `json:"time_zone"`        // Zona waktu IANA untuk DueText; kosong berarti zona waktu tersimpan
+	Color           *string `json:"color"`            // String kosong menghapus warna
+	Icon            *string `json:"icon"`             // String kosong menghapus ikon
+}
+
+// TaskResponse adalah representasi task yang dikembalikan oleh API.
+// Description berisi Markdown mentah untuk diedit; RenderedDescription berisi HTML hasil render
+// yang sudah disanitasi server dan aman ditampilkan klien apa adanya.
+type TaskResponse struct {
+	ID                  string     `json:"id"`
+	UserID              string     `json:"user_id"`
+	Title               string     `json:"title"`
+	Description         string     `json:"description"`
+	RenderedDescription string     `json:"rendered_description"`
+	Completed           bool       `json:"completed"`
+	CompletedAt         *time.Time `json:"completed_at"`
+	Position            float64    `json:"position"`
+	EstimateMinutes     *int       `json:"estimate_minutes"`
+	SnoozedUntil        *time.Time `json:"snoozed_until"`
+	Archived            bool       `json:"archived"`
+	ColumnID            *string    `json:"column_id"`
+	Priority            *string    `json:"priority"`
+	DueAt               *time.Time `json:"due_at"`
+	DueText             *string    `json:"due_text"`
+	AssigneeID          *string    `json:"assignee_id"`
+	Color               *string    `json:"color"`
+	Icon                *string    `json:"icon"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// AssignTaskRequest adalah body request untuk PUT /api/v1/tasks/{id}/assignee.
+type AssignTaskRequest struct {
+	AssigneeID string `json:"assignee_id"` // Pemilik daftar atau salah satu kolaboratornya
+}
+
+// TaskAppearanceResponse adalah body response untuk GET /api/v1/tasks/appearance.
+type TaskAppearanceResponse struct {
+	Colors []string `json:"colors"`
+	Icons  []string `json:"icons"`
+}
+
+// SnoozeTaskRequest adalah body request untuk POST /api/v1/tasks/{id}/snooze.
+// Tepat satu field yang diisi: Duration relatif terhadap sekarang (misalnya "3h" atau "90m"),
+// atau Until sebagai waktu absolut RFC 3339.
+type SnoozeTaskRequest struct {
+	Duration string     `json:"duration,omitempty"`
+	Until    *time.Time `json:"until,omitempty"`
+}
+
+// ReorderTasksRequest adalah body request untuk PATCH /api/v1/tasks/reorder.
+// Tepat satu field yang diisi: IDs untuk mengirim urutan lengkap, atau Move untuk memindahkan satu task.
+type ReorderTasksRequest struct {
+	IDs  []string         `json:"ids,omitempty"`
+	Move *MoveTaskRequest `json:"move,omitempty"`
+}
+
+// MoveTaskRequest memindahkan task ID tepat setelah AfterID. AfterID null berarti ke paling atas.
+type MoveTaskRequest struct {
+	ID      string  `json:"id"`
+	AfterID *string `json:"after_id"`
+}
+
+// NewTaskResponse memetakan domain.Task ke TaskResponse.
+func NewTaskResponse(task *domain.Task) TaskResponse {
+	return TaskResponse{
+		ID:                  task.ID,
+		UserID:              string(task.UserID),
+		Title:               task.Title,
+		Description:         task.Description,
+		RenderedDescription: markdown.Render(task.Description),
+		Completed:           task.Completed,
+		CompletedAt:         task.CompletedAt,
+		Position:            task.Position,
+		EstimateMinutes:     task.EstimateMinutes,
+		SnoozedUntil:        task.SnoozedUntil,
+		Archived:            task.Archived,
+		ColumnID:            task.ColumnID,
+		Priority:            task.Priority,
+		DueAt:               task.DueAt,
+		DueText:             task.DueText,
+		AssigneeID:          (*string)(task.AssigneeID),
+		Color:               task.Color,
+		Icon:                task.Icon,
+		CreatedAt:           task.CreatedAt,
+		UpdatedAt:           task.UpdatedAt,
+	}
+}
+
+// TaskCountersResponse adalah body response untuk GET /api/v1/tasks/counts.
+type TaskCountersResponse struct {
+	Total     int64 `json:"total"`
+	Open      int64 `json:"open"`
+	Completed int64 `json:"completed"`
+}
+
+// NewTaskCountersResponse memetakan domain.TaskCounters ke TaskCountersResponse.
+func NewTaskCountersResponse(counters domain.TaskCounters) TaskCountersResponse {
+	return TaskCountersResponse{
+		Total:     counters.Total,
+		Open:      counters.Open,
+		Completed: counters.Completed(),
+	}
+}
+
+// NewTaskResponses memetakan slice domain.Task ke slice TaskResponse.
+// Selalu mengembalikan slice non-nil agar ter-encode sebagai [] bukan null.
+func NewTaskResponses(tasks []*domain.Task) []TaskResponse {
+	responses := make([]TaskResponse, 0, len(tasks))
+	for _, task := range tasks {
+		responses = append(responses, NewTaskResponse(task))
+	}
+	return responses
+}
+
+// TaskGroupResponse adalah satu grup pada GET /api/v1/tasks?group_by=....
+type TaskGroupResponse struct {
+	Key        string         `json:"key"`
+	Count      int64          `json:"count"` // Jumlah seluruh task di grup, bukan hanya di halaman ini
+	Tasks      []TaskResponse `json:"tasks"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// NewTaskGroupResponses memetakan slice domain.TaskGroup ke slice TaskGroupResponse.
+func NewTaskGroupResponses(groups []domain.TaskGroup) []TaskGroupResponse {
+	responses := make([]TaskGroupResponse, 0, len(groups))
+	for _, group := range groups {
+		responses = append(responses, TaskGroupResponse{
+			Key:        group.Key,
+			Count:      group.Count,
+			Tasks:      NewTaskResponses(group.Tasks),
+			NextCursor: group.NextCursor,
+		})
+	}
+	return responses
+}
+
+// SyncResponse adalah body response untuk GET /api/v1/sync. Cursor adalah string opaque yang
+// dikirim kembali sebagai query parameter since.
+type SyncResponse struct {
+	Cursor string         `json:"cursor"`
+	Tasks  []TaskResponse `json:"tasks"`
+}
+
+// SyncPushTask adalah satu task dalam body request POST /api/v1/sync.
+type SyncPushTask struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Completed   bool   `json:"completed"`
+}
+
+// SyncPushRequest adalah body request untuk POST /api/v1/sync.
+type SyncPushRequest struct {
+	Tasks []SyncPushTask `json:"tasks"`
+}
+
+// SyncPushResponse adalah body response untuk POST /api/v1/sync: satu hasil per task yang dikirim.
+type SyncPushResponse = BatchResponse
+
+// EstimateSummaryResponse adalah body response untuk GET /api/v1/tasks/estimates.
+type EstimateSummaryResponse struct {
+	OpenTasks        int64                 `json:"open_tasks"`
+	UnestimatedTasks int64                 `json:"unestimated_tasks"`
+	RemainingMinutes int64                 `json:"remaining_minutes"`
+	CompletedByDay   []DailyEffortResponse `json:"completed_by_day"`
+}
+
+// DailyEffortResponse adalah usaha yang diselesaikan pada satu hari (YYYY-MM-DD).
+type DailyEffortResponse struct {
+	Date    string `json:"date"`
+	Tasks   int64  `json:"tasks"`
+	Minutes int64  `json:"minutes"`
+}
+
+// NewEstimateSummaryResponse memetakan domain.EstimateSummary ke EstimateSummaryResponse.
+func NewEstimateSummaryResponse(summary *domain.EstimateSummary) EstimateSummaryResponse {
+	resp := EstimateSummaryResponse{
+		OpenTasks:        summary.OpenTasks,
+		UnestimatedTasks: summary.UnestimatedTasks,
+		RemainingMinutes: summary.RemainingMinutes,
+		CompletedByDay:   make([]DailyEffortResponse, 0, len(summary.CompletedByDay)),
+	}
+	for _, day := range summary.CompletedByDay {
+		resp.CompletedByDay = append(resp.CompletedByDay, DailyEffortResponse{
+			Date:    day.Date.Format(time.DateOnly),
+			Tasks:   day.Tasks,
+			Minutes: day.Minutes,
+		})
+	}
+	return resp
+}
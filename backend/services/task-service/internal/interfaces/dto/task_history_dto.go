@@ -0,0 +1,30 @@
+// file: backend/services/task-service/internal/interfaces/dto/task_history_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// TaskRevisionResponse adalah satu revisi pada GET /api/v1/tasks/{id}/history.
+type TaskRevisionResponse struct {
+	Revision   int                           `json:"revision"`
+	ActorID    string                        `json:"actor_id"`
+	OccurredAt time.Time                     `json:"occurred_at"`
+	Changes    map[string]domain.FieldChange `json:"changes"`
+}
+
+// NewTaskRevisionResponses memetakan slice domain.TaskRevision ke slice TaskRevisionResponse.
+func NewTaskRevisionResponses(revisions []domain.TaskRevision) []TaskRevisionResponse {
+	responses := make([]TaskRevisionResponse, 0, len(revisions))
+	for _, rev := range revisions {
+		responses = append(responses, TaskRevisionResponse{
+			Revision:   rev.Revision,
+			ActorID:    string(rev.ActorID),
+			OccurredAt: rev.OccurredAt,
+			Changes:    rev.Changes,
+		})
+	}
+	return responses
+}
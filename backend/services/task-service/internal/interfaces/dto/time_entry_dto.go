@@ -0,0 +1,36 @@
+// file: backend/services/task-service/internal/interfaces/dto/time_entry_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// TimeEntryResponse adalah representasi sesi timer yang dikembalikan oleh API.
+type TimeEntryResponse struct {
+	ID              string     `json:"id"`
+	TaskID          string     `json:"task_id"`
+	StartedAt       time.Time  `json:"started_at"`
+	StoppedAt       *time.Time `json:"stopped_at,omitempty"`
+	Running         bool       `json:"running"`
+	DurationSeconds int64      `json:"duration_seconds"` // Sampai now untuk timer yang masih berjalan
+}
+
+// NewTimeEntryResponse memetakan domain.TimeEntry ke TimeEntryResponse.
+func NewTimeEntryResponse(entry *domain.TimeEntry, now time.Time) TimeEntryResponse {
+	return TimeEntryResponse{
+		ID:              entry.ID,
+		TaskID:          entry.TaskID,
+		StartedAt:       entry.StartedAt,
+		StoppedAt:       entry.StoppedAt,
+		Running:         entry.Running(),
+		DurationSeconds: int64(entry.Duration(now) / time.Second),
+	}
+}
+
+// TaskTimeResponse adalah body response untuk GET /api/v1/tasks/{id}/time-entries.
+type TaskTimeResponse struct {
+	Entries      []TimeEntryResponse `json:"entries"`
+	TotalSeconds int64               `json:"total_seconds"`
+}
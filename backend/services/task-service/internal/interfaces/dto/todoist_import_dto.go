@@ -0,0 +1,39 @@
+// file: backend/services/task-service/internal/interfaces/dto/todoist_import_dto.go
+package dto
+
+import "encoding/json"
+
+// TodoistImportRequest adalah body request untuk POST /api/v1/import/todoist. Export adalah
+// response Todoist Sync API apa adanya, sehingga field yang tidak dipakai di dalamnya diabaikan.
+type TodoistImportRequest struct {
+	Export   json.RawMessage `json:"export"`
+	APIToken string          `json:"api_token"`
+	DryRun   bool            `json:"dry_run"`
+}
+
+// TodoistImportCountResponse adalah jumlah task per project atau label.
+type TodoistImportCountResponse struct {
+	Name  string `json:"name"`
+	Tasks int    `json:"tasks"`
+}
+
+// TodoistImportTaskResponse adalah satu task pada laporan impor.
+type TodoistImportTaskResponse struct {
+	TodoistID string   `json:"todoist_id"`
+	Title     string   `json:"title"`
+	Project   string   `json:"project"`
+	Labels    []string `json:"labels"`
+	Priority  *string  `json:"priority,omitempty"`
+	DueText   *string  `json:"due_text,omitempty"`
+}
+
+// TodoistImportResponse adalah laporan impor Todoist. Import hanya diisi jika task benar-benar
+// dibuat; results di dalamnya memakai index yang sama dengan tasks.
+type TodoistImportResponse struct {
+	DryRun   bool                         `json:"dry_run"`
+	Projects []TodoistImportCountResponse `json:"projects"`
+	Labels   []TodoistImportCountResponse `json:"labels"`
+	Tasks    []TodoistImportTaskResponse  `json:"tasks"`
+	Skipped  int                          `json:"skipped"`
+	Import   *BatchResponse               `json:"import,omitempty"`
+}
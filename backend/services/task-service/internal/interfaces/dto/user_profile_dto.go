@@ -0,0 +1,31 @@
+// file: backend/services/task-service/internal/interfaces/dto/user_profile_dto.go
+package dto
+
+import "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+
+// UserProfileResponse adalah profil publik pengguna. DisplayName dan AvatarURL kosong jika profil
+// tidak tersedia (pengguna tidak berbagi daftar, tidak dikenal, atau user-service tidak tersedia),
+// sehingga klien menampilkan ID-nya.
+type UserProfileResponse struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url"`
+}
+
+// NewUserProfileResponse memetakan domain.UserProfile ke UserProfileResponse.
+func NewUserProfileResponse(profile domain.UserProfile) UserProfileResponse {
+	return UserProfileResponse{
+		ID:          string(profile.UserID),
+		DisplayName: profile.DisplayName,
+		AvatarURL:   profile.AvatarURL,
+	}
+}
+
+// NewUserProfileResponses memetakan slice domain.UserProfile ke slice UserProfileResponse.
+func NewUserProfileResponses(profiles []domain.UserProfile) []UserProfileResponse {
+	resp := make([]UserProfileResponse, 0, len(profiles))
+	for _, profile := range profiles {
+		resp = append(resp, NewUserProfileResponse(profile))
+	}
+	return resp
+}
@@ -0,0 +1,37 @@
+// file: backend/services/task-service/internal/interfaces/dto/user_session_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// UserSessionResponse adalah representasi sesi pengguna yang dikembalikan oleh API.
+type UserSessionResponse struct {
+	ID         string             `json:"id"`
+	Kind       domain.SessionKind `json:"kind"`
+	UserAgent  string             `json:"user_agent"`
+	IP         string             `json:"ip"`
+	CreatedAt  time.Time          `json:"created_at"`
+	LastSeenAt time.Time          `json:"last_seen_at"`
+	Current    bool               `json:"current"` // Sesi yang dipakai request ini
+}
+
+// NewUserSessionResponses memetakan daftar sesi ke response. currentID adalah ID sesi request,
+// kosong jika tidak diketahui.
+func NewUserSessionResponses(sessions []domain.UserSession, currentID string) []UserSessionResponse {
+	responses := make([]UserSessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		responses = append(responses, UserSessionResponse{
+			ID:         session.ID,
+			Kind:       session.Kind,
+			UserAgent:  session.UserAgent,
+			IP:         session.IP,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			Current:    currentID != "" && session.ID == currentID,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,11 @@
+// file: backend/services/task-service/internal/interfaces/dto/version_dto.go
+package dto
+
+// VersionResponse adalah identitas build untuk GET /version.
+type VersionResponse struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	Modified  bool   `json:"modified,omitempty"`
+	GoVersion string `json:"go_version"`
+}
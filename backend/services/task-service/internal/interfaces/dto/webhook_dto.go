@@ -0,0 +1,44 @@
+// file: backend/services/task-service/internal/interfaces/dto/webhook_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// CreateWebhookRequest adalah body request untuk POST /api/v1/webhooks.
+type CreateWebhookRequest struct {
+	URL             string                 `json:"url"`
+	EventTypes      []domain.TaskEventType `json:"event_types"`
+	PayloadTemplate string                 `json:"payload_template"`
+	ContentType     string                 `json:"content_type"`
+}
+
+// WebhookResponse adalah representasi webhook yang dikembalikan oleh API.
+// Secret hanya diisi pada response pembuatan webhook.
+type WebhookResponse struct {
+	ID              string                 `json:"id"`
+	URL             string                 `json:"url"`
+	EventTypes      []domain.TaskEventType `json:"event_types"`
+	PayloadTemplate string                 `json:"payload_template,omitempty"`
+	ContentType     string                 `json:"content_type"`
+	Secret          string                 `json:"secret,omitempty"`
+	CreatedAt       time.Time              `json:"created_at"`
+}
+
+// NewWebhookResponse memetakan domain.Webhook ke WebhookResponse tanpa secret.
+func NewWebhookResponse(webhook *domain.Webhook) WebhookResponse {
+	eventTypes := webhook.EventTypes
+	if eventTypes == nil {
+		eventTypes = []domain.TaskEventType{}
+	}
+	return WebhookResponse{
+		ID:              webhook.ID,
+		URL:             webhook.URL,
+		EventTypes:      eventTypes,
+		PayloadTemplate: webhook.PayloadTemplate,
+		ContentType:     webhook.ContentType,
+		CreatedAt:       webhook.CreatedAt,
+	}
+}
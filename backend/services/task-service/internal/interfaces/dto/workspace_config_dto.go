@@ -0,0 +1,147 @@
+// file: backend/services/task-service/internal/interfaces/dto/workspace_config_dto.go
+package dto
+
+import (
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// WorkspaceConfigDocument adalah dokumen konfigurasi workspace dalam JSON atau YAML. Bagian yang
+// tidak ada atau null tidak dikelola dokumen; bagian kosong ([] atau {}) berarti tanpa item.
+type WorkspaceConfigDocument struct {
+	Version       int                                           `json:"version" yaml:"version"`
+	Board         []WorkspaceConfigColumnDocument               `json:"board" yaml:"board"`
+	Enums         map[string][]WorkspaceConfigEnumValueDocument `json:"enums" yaml:"enums"`
+	RoleMappings  map[string]string                             `json:"role_mappings" yaml:"role_mappings"`
+	Retrospective *WorkspaceConfigRetrospectiveDocument         `json:"retrospective" yaml:"retrospective"`
+}
+
+// WorkspaceConfigColumnDocument adalah satu kolom board; wip_limit null berarti tanpa batas.
+type WorkspaceConfigColumnDocument struct {
+	Name     string `json:"name" yaml:"name"`
+	WIPLimit *int   `json:"wip_limit" yaml:"wip_limit"`
+}
+
+// WorkspaceConfigEnumValueDocument adalah satu nilai enum tambahan.
+type WorkspaceConfigEnumValueDocument struct {
+	Value      string `json:"value" yaml:"value"`
+	Label      string `json:"label" yaml:"label"`
+	Position   int    `json:"position" yaml:"position"`
+	Deprecated bool   `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+}
+
+// WorkspaceConfigRetrospectiveDocument adalah pengaturan retrospektif bulanan.
+type WorkspaceConfigRetrospectiveDocument struct {
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	TimeZone string `json:"time_zone" yaml:"time_zone"`
+}
+
+// WorkspaceConfigChangeResponse adalah satu perubahan dari hasil perbandingan konfigurasi.
+type WorkspaceConfigChangeResponse struct {
+	Section string `json:"section" yaml:"section"`
+	Key     string `json:"key,omitempty" yaml:"key,omitempty"`
+	Action  string `json:"action" yaml:"action"`
+	Before  string `json:"before,omitempty" yaml:"before,omitempty"`
+	After   string `json:"after,omitempty" yaml:"after,omitempty"`
+}
+
+// WorkspaceConfigDiffResponse adalah response perbandingan dan penerapan konfigurasi.
+type WorkspaceConfigDiffResponse struct {
+	Applied bool                            `json:"applied" yaml:"applied"`
+	Changes []WorkspaceConfigChangeResponse `json:"changes" yaml:"changes"`
+}
+
+// NewWorkspaceConfigDocument memetakan domain.WorkspaceConfig ke WorkspaceConfigDocument.
+func NewWorkspaceConfigDocument(config *domain.WorkspaceConfig) WorkspaceConfigDocument {
+	doc := WorkspaceConfigDocument{Version: config.Version}
+	if config.Board != nil {
+		doc.Board = make([]WorkspaceConfigColumnDocument, 0, len(config.Board))
+		for _, column := range config.Board {
+			doc.Board = append(doc.Board, WorkspaceConfigColumnDocument{Name: column.Name, WIPLimit: column.WIPLimit})
+		}
+	}
+	if config.Enums != nil {
+		doc.Enums = make(map[string][]WorkspaceConfigEnumValueDocument, len(config.Enums))
+		for enum, values := range config.Enums {
+			docValues := make([]WorkspaceConfigEnumValueDocument, 0, len(values))
+			for _, value := range values {
+				docValues = append(docValues, WorkspaceConfigEnumValueDocument{
+					Value:      value.Value,
+					Label:      value.Label,
+					Position:   value.Position,
+					Deprecated: value.Deprecated,
+				})
+			}
+			doc.Enums[string(enum)] = docValues
+		}
+	}
+	if config.RoleMappings != nil {
+		doc.RoleMappings = make(map[string]string, len(config.RoleMappings))
+		for name, role := range config.RoleMappings {
+			doc.RoleMappings[name] = string(role)
+		}
+	}
+	if config.Retrospective != nil {
+		doc.Retrospective = &WorkspaceConfigRetrospectiveDocument{
+			Enabled:  config.Retrospective.Enabled,
+			TimeZone: config.Retrospective.TimeZone,
+		}
+	}
+	return doc
+}
+
+// ToDomain memetakan dokumen ke domain.WorkspaceConfig dengan mempertahankan bagian yang nil.
+func (d WorkspaceConfigDocument) ToDomain() *domain.WorkspaceConfig {
+	config := &domain.WorkspaceConfig{Version: d.Version}
+	if d.Board != nil {
+		config.Board = make([]domain.WorkspaceConfigColumn, 0, len(d.Board))
+		for _, column := range d.Board {
+			config.Board = append(config.Board, domain.WorkspaceConfigColumn{Name: column.Name, WIPLimit: column.WIPLimit})
+		}
+	}
+	if d.Enums != nil {
+		config.Enums = make(map[domain.EnumName][]domain.WorkspaceConfigEnumValue, len(d.Enums))
+		for enum, values := range d.Enums {
+			domainValues := make([]domain.WorkspaceConfigEnumValue, 0, len(values))
+			for _, value := range values {
+				domainValues = append(domainValues, domain.WorkspaceConfigEnumValue{
+					Value:      value.Value,
+					Label:      value.Label,
+					Position:   value.Position,
+					Deprecated: value.Deprecated,
+				})
+			}
+			config.Enums[domain.EnumName(enum)] = domainValues
+		}
+	}
+	if d.RoleMappings != nil {
+		config.RoleMappings = make(map[string]domain.WorkspaceRole, len(d.RoleMappings))
+		for name, role := range d.RoleMappings {
+			config.RoleMappings[name] = domain.WorkspaceRole(role)
+		}
+	}
+	if d.Retrospective != nil {
+		config.Retrospective = &domain.WorkspaceConfigRetrospective{
+			Enabled:  d.Retrospective.Enabled,
+			TimeZone: d.Retrospective.TimeZone,
+		}
+	}
+	return config
+}
+
+// NewWorkspaceConfigDiffResponse memetakan daftar perubahan ke WorkspaceConfigDiffResponse.
+func NewWorkspaceConfigDiffResponse(changes []domain.WorkspaceConfigChange, applied bool) WorkspaceConfigDiffResponse {
+	resp := WorkspaceConfigDiffResponse{
+		Applied: applied,
+		Changes: make([]WorkspaceConfigChangeResponse, 0, len(changes)),
+	}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, WorkspaceConfigChangeResponse{
+			Section: change.Section,
+			Key:     change.Key,
+			Action:  string(change.Action),
+			Before:  change.Before,
+			After:   change.After,
+		})
+	}
+	return resp
+}
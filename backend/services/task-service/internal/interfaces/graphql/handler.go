@@ -0,0 +1,74 @@
+// file: backend/services/task-service/internal/interfaces/graphql/handler.go
+package graphql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+// maxComplexity membatasi kompleksitas query (jumlah field) agar query bersarang yang dalam,
+// misalnya tasks { list { tasks { comments { task ... } } } }, tidak membebani database.
+const maxComplexity = 1000
+
+// errorMapping memetakan error domain ke extensions.code pada response GraphQL, dengan kode yang
+// sama seperti field code pada response problem+json REST API.
+var errorMapping = []struct {
+	err  error
+	code string
+}{
+	{domain.ErrTaskNotFound, "task_not_found"},
+	{domain.ErrListShareNotFound, "list_share_not_found"},
+	{domain.ErrInvalidTaskSort, "invalid_sort"},
+}
+
+// NewHandler membuat http.Handler untuk endpoint /graphql (GET dan POST). Handler membutuhkan
+// context yang sudah melewati auth middleware.
+func NewHandler(resolver *Resolver) http.Handler {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
+	srv.SetErrorPresenter(presentError)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := auth.UserIDFromContext(r.Context())
+		ctx := context.WithValue(r.Context(), loadersKey{}, resolver.newLoaders(userID))
+		srv.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// presentError menambahkan extensions.code untuk error domain. Error yang bukan berasal dari
+// resolver (syntax atau validasi query) dikembalikan apa adanya, sedangkan error yang tidak
+// dikenal dicatat dan disembunyikan sebagai internal_error.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := gqlgen.DefaultErrorPresenter(ctx, err)
+	var inner *gqlerror.Error
+	if gqlErr.Err == nil || errors.As(gqlErr.Err, &inner) {
+		return gqlErr
+	}
+	for _, m := range errorMapping {
+		if errors.Is(err, m.err) {
+			gqlErr.Extensions = map[string]any{"code": m.code}
+			return gqlErr
+		}
+	}
+	slog.ErrorContext(ctx, "graphql internal error", "graphql_path", gqlErr.Path.String(), "error", err)
+	gqlErr.Message = "internal server error"
+	gqlErr.Extensions = map[string]any{"code": "internal_error"}
+	return gqlErr
+}
@@ -0,0 +1,83 @@
+// file: backend/services/task-service/internal/interfaces/graphql/loader.go
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// loaderWait adalah jeda pengumpulan key sebelum satu batch dimuat. gqlgen me-resolve elemen list
+// secara konkuren, sehingga field resolver semua elemen sempat mendaftarkan key-nya.
+const loaderWait = 2 * time.Millisecond
+
+// maxLoaderBatch membatasi jumlah key per query batch.
+const maxLoaderBatch = 500
+
+// batchLoader menggabungkan Load untuk banyak key menjadi satu panggilan fetch (pola dataloader)
+// dan menyimpan hasilnya selama satu request, sehingga query bersarang seperti tasks { comments }
+// tidak menghasilkan N+1 query.
+type batchLoader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	batches map[K]*loaderBatch[K, V] // Batch yang memuat setiap key, termasuk yang sudah selesai
+	pending *loaderBatch[K, V]       // Batch yang masih menerima key
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+func newBatchLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *batchLoader[K, V] {
+	return &batchLoader[K, V]{
+		fetch:   fetch,
+		batches: make(map[K]*loaderBatch[K, V]),
+	}
+}
+
+// Load mengembalikan nilai untuk key, atau nilai nol jika fetch tidak mengembalikannya.
+func (l *batchLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	batch, ok := l.batches[key]
+	if !ok {
+		if l.pending == nil {
+			l.pending = &loaderBatch[K, V]{done: make(chan struct{})}
+			go l.run(ctx, l.pending)
+		}
+		batch = l.pending
+		batch.keys = append(batch.keys, key)
+		l.batches[key] = batch
+		if len(batch.keys) >= maxLoaderBatch {
+			l.pending = nil
+		}
+	}
+	l.mu.Unlock()
+
+	var zero V
+	select {
+	case <-batch.done:
+		if batch.err != nil {
+			return zero, batch.err
+		}
+		return batch.results[key], nil
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+func (l *batchLoader[K, V]) run(ctx context.Context, batch *loaderBatch[K, V]) {
+	time.Sleep(loaderWait)
+	l.mu.Lock()
+	if l.pending == batch {
+		l.pending = nil
+	}
+	keys := batch.keys
+	l.mu.Unlock()
+
+	batch.results, batch.err = l.fetch(ctx, keys)
+	close(batch.done)
+}
@@ -0,0 +1,20 @@
+// file: backend/services/task-service/internal/interfaces/graphql/models.go
+package graphql
+
+import (
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// accessOwner adalah nilai TaskList.access untuk daftar milik pengguna sendiri.
+const accessOwner = "owner"
+
+// TaskList adalah daftar task satu pengguna. Task dan kolaboratornya dimuat oleh field resolver.
+type TaskList struct {
+	OwnerID domain.UserID
+	Access  string // accessOwner, atau nilai domain.ListAccess untuk daftar yang dibagikan
+}
+
+// owned melaporkan apakah daftar milik pengguna yang sedang login.
+func (l *TaskList) owned() bool {
+	return l.Access == accessOwner
+}
@@ -0,0 +1,74 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type Query struct {
+}
+
+// Urutan daftar task.
+type TaskSort string
+
+const (
+	// Terbaru di atas.
+	TaskSortCreated TaskSort = "CREATED"
+	// Urutan manual hasil reorder.
+	TaskSortPosition TaskSort = "POSITION"
+	// Judul A-Z sesuai collation locale.
+	TaskSortTitle TaskSort = "TITLE"
+)
+
+var AllTaskSort = []TaskSort{
+	TaskSortCreated,
+	TaskSortPosition,
+	TaskSortTitle,
+}
+
+func (e TaskSort) IsValid() bool {
+	switch e {
+	case TaskSortCreated, TaskSortPosition, TaskSortTitle:
+		return true
+	}
+	return false
+}
+
+func (e TaskSort) String() string {
+	return string(e)
+}
+
+func (e *TaskSort) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskSort", str)
+	}
+	return nil
+}
+
+func (e TaskSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TaskSort) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TaskSort) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,95 @@
+// file: backend/services/task-service/internal/interfaces/graphql/resolver.go
+package graphql
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// Resolver adalah root resolver GraphQL. Semua data dibaca lewat application service yang sama
+// dengan REST API, sehingga aturan akses daftar bersama tetap berlaku.
+type Resolver struct {
+	taskService    application.TaskApplicationService
+	commentService application.TaskCommentApplicationService
+	shareService   application.ListShareApplicationService
+}
+
+// NewResolver adalah constructor untuk Resolver.
+func NewResolver(taskService application.TaskApplicationService, commentService application.TaskCommentApplicationService, shareService application.ListShareApplicationService) *Resolver {
+	return &Resolver{
+		taskService:    taskService,
+		commentService: commentService,
+		shareService:   shareService,
+	}
+}
+
+// maxLimit adalah nilai limit terbesar, sama dengan batas limit pada REST API.
+const maxLimit = 200
+
+// taskOrder memetakan argumen daftar task ke domain.TaskOrder. Seperti GET /api/v1/tasks, task yang
+// diarsipkan tidak ikut dan task yang masih di-snooze disembunyikan kecuali includeSnoozed.
+func taskOrder(sort *TaskSort, locale *string, includeSnoozed *bool) domain.TaskOrder {
+	order := domain.TaskOrder{HideArchived: true}
+	if sort != nil {
+		order.Sort = domain.TaskSort(strings.ToLower(string(*sort)))
+	}
+	if locale != nil {
+		order.Locale = *locale
+	}
+	if includeSnoozed == nil || !*includeSnoozed {
+		order.HideSnoozedAt = time.Now()
+	}
+	return order
+}
+
+// loaders adalah dataloader milik satu request.
+type loaders struct {
+	tasks    *batchLoader[string, *domain.Task]
+	comments *batchLoader[string, []*domain.TaskComment]
+	lists    *batchLoader[domain.UserID, *TaskList]
+}
+
+type loadersKey struct{}
+
+// newLoaders membuat dataloader untuk pengguna userID.
+func (r *Resolver) newLoaders(userID domain.UserID) *loaders {
+	return &loaders{
+		tasks: newBatchLoader(func(ctx context.Context, ids []string) (map[string]*domain.Task, error) {
+			tasks, err := r.taskService.GetTasksByIDs(ctx, userID, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*domain.Task, len(tasks))
+			for _, task := range tasks {
+				byID[task.ID] = task
+			}
+			return byID, nil
+		}),
+		comments: newBatchLoader(func(ctx context.Context, taskIDs []string) (map[string][]*domain.TaskComment, error) {
+			return r.commentService.ListCommentsForTasks(ctx, userID, taskIDs)
+		}),
+		// Semua daftar yang dibagikan dibaca dengan satu query, berapa pun pemilik yang diminta.
+		lists: newBatchLoader(func(ctx context.Context, ownerIDs []domain.UserID) (map[domain.UserID]*TaskList, error) {
+			lists := map[domain.UserID]*TaskList{userID: {OwnerID: userID, Access: accessOwner}}
+			if len(ownerIDs) == 1 && ownerIDs[0] == userID {
+				return lists, nil
+			}
+			shares, err := r.shareService.ListSharedWithMe(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			for _, share := range shares {
+				lists[share.OwnerID] = &TaskList{OwnerID: share.OwnerID, Access: string(share.Access)}
+			}
+			return lists, nil
+		}),
+	}
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,39 @@
+// file: backend/services/task-service/internal/interfaces/graphql/scalars.go
+package graphql
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// MarshalUserID memetakan domain.UserID ke scalar ID. Dipakai gqlgen lewat gqlgen.yml.
+func MarshalUserID(id domain.UserID) gqlgen.Marshaler {
+	return gqlgen.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(string(id)))
+	})
+}
+
+// UnmarshalUserID membaca scalar ID sebagai domain.UserID.
+func UnmarshalUserID(v any) (domain.UserID, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("user id must be a string")
+	}
+	return domain.UserID(s), nil
+}
+
+// MarshalListAccess memetakan domain.ListAccess ke scalar String.
+func MarshalListAccess(access domain.ListAccess) gqlgen.Marshaler {
+	return gqlgen.MarshalString(string(access))
+}
+
+// UnmarshalListAccess membaca scalar String sebagai domain.ListAccess.
+func UnmarshalListAccess(v any) (domain.ListAccess, error) {
+	s, err := gqlgen.UnmarshalString(v)
+	return domain.ListAccess(s), err
+}
@@ -0,0 +1,140 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/markdown"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// RenderedBody is the resolver for the renderedBody field.
+func (r *commentResolver) RenderedBody(ctx context.Context, obj *domain.TaskComment) (string, error) {
+	return markdown.Render(obj.Body), nil
+}
+
+// Task is the resolver for the task field.
+func (r *commentResolver) Task(ctx context.Context, obj *domain.TaskComment) (*domain.Task, error) {
+	return loadersFrom(ctx).tasks.Load(ctx, obj.TaskID)
+}
+
+// Task is the resolver for the task field.
+func (r *queryResolver) Task(ctx context.Context, id string) (*domain.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	task, err := r.taskService.GetTaskByID(ctx, userID, id)
+	if errors.Is(err, domain.ErrTaskNotFound) {
+		return nil, nil
+	}
+	return task, err
+}
+
+// Tasks is the resolver for the tasks field.
+func (r *queryResolver) Tasks(ctx context.Context, sort *TaskSort, locale *string, includeSnoozed *bool) ([]*domain.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	return r.taskService.GetTasksByUserID(ctx, userID, taskOrder(sort, locale, includeSnoozed))
+}
+
+// AssignedTasks is the resolver for the assignedTasks field.
+func (r *queryResolver) AssignedTasks(ctx context.Context, sort *TaskSort, locale *string) ([]*domain.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	return r.taskService.GetAssignedTasks(ctx, userID, taskOrder(sort, locale, nil))
+}
+
+// Lists is the resolver for the lists field.
+func (r *queryResolver) Lists(ctx context.Context) ([]*TaskList, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	shares, err := r.shareService.ListSharedWithMe(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	lists := make([]*TaskList, 0, len(shares)+1)
+	lists = append(lists, &TaskList{OwnerID: userID, Access: accessOwner})
+	for _, share := range shares {
+		lists = append(lists, &TaskList{OwnerID: share.OwnerID, Access: string(share.Access)})
+	}
+	return lists, nil
+}
+
+// List is the resolver for the list field.
+func (r *queryResolver) List(ctx context.Context, ownerID string) (*TaskList, error) {
+	return loadersFrom(ctx).lists.Load(ctx, domain.UserID(ownerID))
+}
+
+// Mentions is the resolver for the mentions field.
+func (r *queryResolver) Mentions(ctx context.Context, limit *int) ([]*domain.TaskComment, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	n := 50
+	if limit != nil {
+		n = *limit
+	}
+	if n < 1 || n > maxLimit {
+		return nil, gqlerror.Errorf("limit must be between 1 and %d", maxLimit)
+	}
+	return r.commentService.ListMentions(ctx, userID, n)
+}
+
+// List is the resolver for the list field.
+func (r *taskResolver) List(ctx context.Context, obj *domain.Task) (*TaskList, error) {
+	list, err := loadersFrom(ctx).lists.Load(ctx, obj.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		return nil, domain.ErrListShareNotFound // Akses dicabut setelah task dimuat
+	}
+	return list, nil
+}
+
+// Comments is the resolver for the comments field.
+func (r *taskResolver) Comments(ctx context.Context, obj *domain.Task) ([]*domain.TaskComment, error) {
+	comments, err := loadersFrom(ctx).comments.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if comments == nil {
+		return []*domain.TaskComment{}, nil
+	}
+	return comments, nil
+}
+
+// Tasks is the resolver for the tasks field.
+func (r *taskListResolver) Tasks(ctx context.Context, obj *TaskList, sort *TaskSort, locale *string, includeSnoozed *bool) ([]*domain.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	order := taskOrder(sort, locale, includeSnoozed)
+	if obj.owned() {
+		return r.taskService.GetTasksByUserID(ctx, userID, order)
+	}
+	return r.taskService.GetSharedTasks(ctx, userID, obj.OwnerID, order)
+}
+
+// Collaborators is the resolver for the collaborators field.
+func (r *taskListResolver) Collaborators(ctx context.Context, obj *TaskList) ([]*domain.ListShare, error) {
+	if !obj.owned() {
+		return []*domain.ListShare{}, nil
+	}
+	return r.shareService.ListCollaborators(ctx, obj.OwnerID)
+}
+
+// Comment returns CommentResolver implementation.
+func (r *Resolver) Comment() CommentResolver { return &commentResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Task returns TaskResolver implementation.
+func (r *Resolver) Task() TaskResolver { return &taskResolver{r} }
+
+// TaskList returns TaskListResolver implementation.
+func (r *Resolver) TaskList() TaskListResolver { return &taskListResolver{r} }
+
+type commentResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type taskResolver struct{ *Resolver }
+type taskListResolver struct{ *Resolver }
@@ -0,0 +1,84 @@
+// file: backend/services/task-service/internal/interfaces/rest/access_log.go
+package rest
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/felixge/httpsnoop"
+)
+
+// AccessLogConfig adalah pengaturan access log, yang ditulis terpisah dari log aplikasi.
+type AccessLogConfig struct {
+	Writer io.Writer // Tujuan access log; nil berarti access log mati
+
+	// SampleRate adalah fraksi request (0 sampai 1) yang dicatat. Request yang gagal dengan status
+	// 5xx atau lebih lama dari SlowThreshold selalu dicatat.
+	SampleRate    float64
+	SlowThreshold time.Duration // 0 berarti request lambat tidak diperlakukan khusus
+}
+
+// accessLogEntry adalah satu baris JSON access log.
+type accessLogEntry struct {
+	Time       time.Time     `json:"time"`
+	Method     string        `json:"method"`
+	Path       string        `json:"path"`
+	Route      string        `json:"route,omitempty"`
+	Status     int           `json:"status"`
+	DurationMS float64       `json:"duration_ms"`
+	Bytes      int64         `json:"bytes"`
+	UserID     domain.UserID `json:"user_id,omitempty"`
+	RequestID  string        `json:"request_id,omitempty"`
+}
+
+// accessLog menulis satu baris JSON per request ke cfg.Writer. Dipasang di dalam requestLogger
+// agar route dan ID pengguna bisa dibaca dari request terdalam (lihat requestLogInfo), dan di luar
+// recoverPanic agar response 500 dari panic ikut tercatat.
+func accessLog(cfg AccessLogConfig, next http.Handler) http.Handler {
+	if cfg.Writer == nil {
+		return next
+	}
+	var mu sync.Mutex
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		metrics := httpsnoop.CaptureMetricsFn(w, func(w http.ResponseWriter) {
+			next.ServeHTTP(w, r)
+		})
+		if metrics.Code < http.StatusInternalServerError &&
+			(cfg.SlowThreshold <= 0 || metrics.Duration < cfg.SlowThreshold) &&
+			rand.Float64() >= cfg.SampleRate {
+			return
+		}
+
+		inner := r
+		if info, ok := r.Context().Value(requestLogInfoKey{}).(*requestLogInfo); ok {
+			inner = info.r
+		}
+		userID, _ := auth.UserIDFromContext(inner.Context())
+		line, err := json.Marshal(accessLogEntry{
+			Time:       start.UTC(),
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			Route:      inner.Pattern,
+			Status:     metrics.Code,
+			DurationMS: float64(metrics.Duration.Microseconds()) / 1000,
+			Bytes:      metrics.Written,
+			UserID:     userID,
+			RequestID:  domain.RequestIDFromContext(r.Context()),
+		})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error encoding access log entry", "error", err)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		cfg.Writer.Write(append(line, '\n'))
+	})
+}
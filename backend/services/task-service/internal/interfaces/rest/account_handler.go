@@ -0,0 +1,55 @@
+// file: backend/services/task-service/internal/interfaces/rest/account_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// AccountHandler menangani endpoint REST untuk data akun pengguna yang sedang login.
+type AccountHandler struct {
+	deletionService application.AccountDeletionApplicationService
+}
+
+// NewAccountHandler adalah constructor untuk AccountHandler.
+func NewAccountHandler(deletionService application.AccountDeletionApplicationService) *AccountHandler {
+	return &AccountHandler{
+		deletionService: deletionService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route akun ke mux. Route ini membutuhkan pengguna terautentikasi.
+// Penghapusan akun tidak bisa dibatalkan, sehingga dibungkus auth.RequireSession: personal access
+// token dan token terbatas tidak bisa memakainya.
+func (h *AccountHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("DELETE /api/v1/me", auth.RequireSession(http.HandlerFunc(h.deleteAccountData)))
+	mux.HandleFunc("GET /api/v1/me/deletions/{id}", h.getDeletion)
+}
+
+// deleteAccountData meminta penghapusan semua data task-service milik pengguna yang sedang login.
+// Request menjawab 202 dengan header Location ke endpoint status; data dihapus di latar belakang.
+func (h *AccountHandler) deleteAccountData(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	deletion, err := h.deletionService.RequestDeletion(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", "/api/v1/me/deletions/"+deletion.ID)
+	writeJSON(w, http.StatusAccepted, dto.NewAccountDeletionResponse(deletion))
+}
+
+// getDeletion mengembalikan status penghapusan beserta receipt-nya setelah selesai.
+func (h *AccountHandler) getDeletion(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	deletion, err := h.deletionService.GetDeletion(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewAccountDeletionResponse(deletion))
+}
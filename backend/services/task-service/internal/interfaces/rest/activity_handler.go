@@ -0,0 +1,58 @@
+// file: backend/services/task-service/internal/interfaces/rest/activity_handler.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// ActivityHandler menangani endpoint feed aktivitas pengguna.
+type ActivityHandler struct {
+	activityService application.ActivityApplicationService
+}
+
+// NewActivityHandler adalah constructor untuk ActivityHandler.
+func NewActivityHandler(activityService application.ActivityApplicationService) *ActivityHandler {
+	return &ActivityHandler{
+		activityService: activityService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route feed aktivitas. Route ini membutuhkan pengguna terautentikasi.
+func (h *ActivityHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/activity", h.list)
+}
+
+// list mengembalikan satu halaman aktivitas dari yang terbaru. Seperti daftar task, body berupa
+// array dan cursor halaman berikutnya dikirim lewat header X-Next-Cursor.
+func (h *ActivityHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+	limit := 50
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeProblem(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	page, err := h.activityService.GetActivityPage(r.Context(), userID, domain.ActivityPageQuery{
+		Limit:  limit,
+		Cursor: query.Get("cursor"),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if page.NextCursor != "" {
+		w.Header().Set(headerNextCursor, page.NextCursor)
+	}
+	writeJSONList(w, http.StatusOK, dto.NewActivityResponses(page.Activities))
+}
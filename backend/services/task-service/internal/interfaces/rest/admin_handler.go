@@ -0,0 +1,151 @@
+// file: backend/services/task-service/internal/interfaces/rest/admin_handler.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// maxAdminSearchLimit adalah jumlah hasil maksimum per jenis pada pencarian admin.
+const maxAdminSearchLimit = 100
+
+// AdminHandler menangani endpoint khusus admin untuk investigasi support.
+type AdminHandler struct {
+	adminService application.AdminApplicationService
+}
+
+// NewAdminHandler adalah constructor untuk AdminHandler.
+func NewAdminHandler(adminService application.AdminApplicationService) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route admin. Semua route dibungkus auth.RequirePermission.
+func (h *AdminHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/admin/search", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.search)))
+	mux.Handle("GET /api/v1/admin/stats", auth.RequirePermission(domain.PermissionViewSystemStats, http.HandlerFunc(h.stats)))
+	mux.Handle("GET /api/v1/admin/users", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.topUsers)))
+	mux.Handle("GET /api/v1/admin/users/{userID}/counts", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.userCounts)))
+	mux.Handle("DELETE /api/v1/admin/tasks/{id}", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.deleteTask)))
+	mux.Handle("DELETE /api/v1/admin/comments/{id}", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.deleteComment)))
+}
+
+// search mencari task dan pengguna lintas tenant.
+// Query parameter: q (wajib, minimal 3 karakter), reason (wajib, dicatat di audit log),
+// type (tasks,users; default keduanya), redact (default true), limit (default 20).
+func (h *AdminHandler) search(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+
+	input := application.AdminSearchInput{
+		Query:  query.Get("q"),
+		Reason: query.Get("reason"),
+		Redact: true,
+	}
+	if raw := query.Get("type"); raw != "" {
+		input.Types = strings.Split(raw, ",")
+	}
+	if raw := query.Get("redact"); raw != "" {
+		redact, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "redact must be a boolean")
+			return
+		}
+		input.Redact = redact
+	}
+	limit, ok := adminLimit(w, r)
+	if !ok {
+		return
+	}
+	input.Limit = limit
+
+	result, err := h.adminService.Search(r.Context(), adminID, input)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewAdminSearchResponse(result.Tasks, result.Users))
+}
+
+// stats mengembalikan statistik penggunaan seluruh instalasi.
+func (h *AdminHandler) stats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.adminService.SystemStats(r.Context())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewSystemStatsResponse(stats))
+}
+
+// topUsers mengembalikan pengguna dengan task terbanyak.
+// Query parameter: reason (wajib, dicatat di audit log), limit (default 20).
+func (h *AdminHandler) topUsers(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	limit, ok := adminLimit(w, r)
+	if !ok {
+		return
+	}
+	users, err := h.adminService.TopUsers(r.Context(), adminID, r.URL.Query().Get("reason"), limit)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewAdminUserListResponse(users))
+}
+
+// userCounts mengembalikan jumlah konten pengguna. Query parameter reason wajib.
+func (h *AdminHandler) userCounts(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	counts, err := h.adminService.UserContentCounts(r.Context(), adminID, domain.UserID(r.PathValue("userID")), r.URL.Query().Get("reason"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewUserContentCountsResponse(counts))
+}
+
+// deleteTask menghapus task pengguna mana pun. Query parameter reason wajib.
+func (h *AdminHandler) deleteTask(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.adminService.DeleteTask(r.Context(), adminID, r.PathValue("id"), r.URL.Query().Get("reason")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteComment menghapus komentar pengguna mana pun. Query parameter reason wajib.
+func (h *AdminHandler) deleteComment(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.adminService.DeleteComment(r.Context(), adminID, r.PathValue("id"), r.URL.Query().Get("reason")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// adminLimit membaca query parameter limit (default 20, paling banyak maxAdminSearchLimit) dan
+// menulis 400 jika tidak valid.
+func adminLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	raw := r.URL.Query().Get("limit")
+	if raw == "" {
+		return 20, true
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 || limit > maxAdminSearchLimit {
+		writeProblem(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxAdminSearchLimit))
+		return 0, false
+	}
+	return limit, true
+}
@@ -0,0 +1,26 @@
+// file: backend/services/task-service/internal/interfaces/rest/admin_policy.go
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+// adminPolicy menolak request (403) ke route di bawah /api/v1/admin/ yang tidak dibungkus
+// auth.RequirePermission, sehingga route admin baru yang lupa memeriksa permission tertutup secara
+// default. Path yang tidak cocok dengan route mana pun tetap dijawab mux (404 atau 405).
+func adminPolicy(mux *http.ServeMux, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/v1/admin/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if h, pattern := mux.Handler(r); pattern != "" && !auth.IsPermissionChecked(h) {
+			writeProblemCode(w, http.StatusForbidden, "permission_required", "admin routes must declare a permission")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,85 @@
+// file: backend/services/task-service/internal/interfaces/rest/agenda_handler.go
+package rest
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/pdf"
+)
+
+// AgendaHandler menangani agenda task bertenggat yang bisa dicetak.
+type AgendaHandler struct {
+	agendaService application.AgendaApplicationService
+}
+
+// NewAgendaHandler adalah constructor untuk AgendaHandler.
+func NewAgendaHandler(agendaService application.AgendaApplicationService) *AgendaHandler {
+	return &AgendaHandler{
+		agendaService: agendaService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route agenda. Route ini membutuhkan pengguna terautentikasi.
+func (h *AgendaHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/agenda.pdf", h.pdf)
+}
+
+// pdf menulis agenda sebagai PDF. Query parameter: date (YYYY-MM-DD, default hari ini), range
+// (day atau week; week dimulai hari Senin minggu tersebut), dan tz (zona waktu IANA, default UTC).
+func (h *AgendaHandler) pdf(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+
+	loc := time.UTC
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "tz must be an IANA time zone name")
+			return
+		}
+		loc = parsed
+	}
+
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if date := query.Get("date"); date != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, date, loc)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "date must be formatted as YYYY-MM-DD")
+			return
+		}
+		from = parsed
+	}
+	days := 1
+	switch query.Get("range") {
+	case "", "day":
+	case "week":
+		days = 7
+		from = from.AddDate(0, 0, -(int(from.Weekday())+6)%7)
+	default:
+		writeProblem(w, http.StatusBadRequest, "range must be day or week")
+		return
+	}
+
+	agenda, err := h.agendaService.GetAgenda(r.Context(), userID, from, days)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var buf bytes.Buffer
+	if err := pdf.WriteAgenda(&buf, agenda); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", pdf.ContentType)
+	w.Header().Set("Content-Disposition", `inline; filename="agenda-`+from.Format(time.DateOnly)+`.pdf"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		slog.ErrorContext(r.Context(), "error writing response", "error", err)
+	}
+}
@@ -0,0 +1,115 @@
+// file: backend/services/task-service/internal/interfaces/rest/archive_handler.go
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// ArchiveHandler menangani endpoint arsip workspace dan penolakan write pada workspace yang diarsipkan.
+type ArchiveHandler struct {
+	archiveService application.ArchiveApplicationService
+}
+
+// NewArchiveHandler adalah constructor untuk ArchiveHandler.
+func NewArchiveHandler(archiveService application.ArchiveApplicationService) *ArchiveHandler {
+	return &ArchiveHandler{
+		archiveService: archiveService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route arsip. Route admin dibungkus auth.RequirePermission.
+func (h *ArchiveHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/archive", h.get)
+	mux.HandleFunc("POST /api/v1/me/archive", h.archive)
+	mux.Handle("GET /api/v1/admin/users/{userID}/archive", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.adminGet)))
+	mux.Handle("POST /api/v1/admin/users/{userID}/archive/restore", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.restore)))
+}
+
+// ReadOnlyMiddleware menolak request write (selain GET/HEAD/OPTIONS) dengan 423 Locked jika workspace
+// pengguna sedang diarsipkan. Route admin, penghapusan akun (DELETE /api/v1/me), dan ekspor data
+// (POST /api/v1/me/export) tetap diizinkan. Harus dipasang setelah middleware autentikasi.
+func (h *ArchiveHandler) ReadOnlyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isWriteRequest(r) || strings.HasPrefix(r.URL.Path, "/api/v1/admin/") ||
+			(r.Method == http.MethodDelete && r.URL.Path == "/api/v1/me") ||
+			(r.Method == http.MethodPost && r.URL.Path == "/api/v1/me/export") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, _ := auth.UserIDFromContext(r.Context())
+		readOnly, err := h.archiveService.IsReadOnly(r.Context(), userID)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		if readOnly {
+			writeError(w, r, domain.ErrWorkspaceArchived)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isWriteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions,
+		"PROPFIND", "REPORT": // Metode baca WebDAV/CalDAV di /dav/
+		return false
+	}
+	return true
+}
+
+func (h *ArchiveHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	archive, err := h.archiveService.GetArchive(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewArchiveResponse(archive))
+}
+
+// archive mengarsipkan workspace pengguna yang sedang login. Setelah ini semua write ditolak
+// dan data live dihapus setelah masa retensi.
+func (h *ArchiveHandler) archive(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	archive, err := h.archiveService.ArchiveWorkspace(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.NewArchiveResponse(archive))
+}
+
+func (h *ArchiveHandler) adminGet(w http.ResponseWriter, r *http.Request) {
+	archive, err := h.archiveService.GetArchive(r.Context(), domain.UserID(r.PathValue("userID")))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewArchiveResponse(archive))
+}
+
+// restore memulihkan workspace pengguna dari ekspor arsipnya. Body wajib berisi reason.
+func (h *ArchiveHandler) restore(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.RestoreArchiveRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	archive, err := h.archiveService.RestoreWorkspace(r.Context(), adminID, domain.UserID(r.PathValue("userID")), req.Reason)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewArchiveResponse(archive))
+}
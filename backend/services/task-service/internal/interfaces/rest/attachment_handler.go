@@ -0,0 +1,100 @@
+// file: backend/services/task-service/internal/interfaces/rest/attachment_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// AttachmentHandler menangani endpoint attachment task.
+type AttachmentHandler struct {
+	attachmentService application.AttachmentApplicationService
+}
+
+// NewAttachmentHandler adalah constructor untuk AttachmentHandler.
+func NewAttachmentHandler(attachmentService application.AttachmentApplicationService) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route attachment. Route ini membutuhkan pengguna terautentikasi.
+func (h *AttachmentHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/tasks/{id}/attachments/uploads", auth.ListScoped(http.HandlerFunc(h.requestUpload)))
+	mux.Handle("POST /api/v1/tasks/{id}/attachments", auth.ListScoped(http.HandlerFunc(h.register)))
+	mux.Handle("GET /api/v1/tasks/{id}/attachments", auth.ListScoped(http.HandlerFunc(h.list)))
+	mux.Handle("DELETE /api/v1/tasks/{id}/attachments/{attachmentID}", auth.ListScoped(http.HandlerFunc(h.delete)))
+}
+
+// requestUpload mengembalikan ID attachment dan request upload langsung ke storage.
+func (h *AttachmentHandler) requestUpload(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.AttachmentUploadRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	upload, err := h.attachmentService.RequestUpload(r.Context(), userID, r.PathValue("id"), application.AttachmentUploadInput{
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.AttachmentUploadResponse{
+		AttachmentID: upload.AttachmentID,
+		Upload:       dto.NewPresignedRequestResponse(upload.Request),
+	})
+}
+
+// register mendaftarkan attachment setelah klien selesai mengunggah file.
+func (h *AttachmentHandler) register(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.RegisterAttachmentRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	attachment, err := h.attachmentService.RegisterAttachment(r.Context(), userID, r.PathValue("id"), application.RegisterAttachmentInput{
+		ID:          req.ID,
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.NewAttachmentResponse(attachment, nil))
+}
+
+// list mengembalikan attachment task beserta URL download sementara.
+func (h *AttachmentHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	links, err := h.attachmentService.ListAttachments(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := make([]dto.AttachmentResponse, 0, len(links))
+	for _, link := range links {
+		resp = append(resp, dto.NewAttachmentResponse(link.Attachment, link.Download))
+	}
+	writeJSONList(w, http.StatusOK, resp)
+}
+
+func (h *AttachmentHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	err := h.attachmentService.DeleteAttachment(r.Context(), userID, r.PathValue("id"), r.PathValue("attachmentID"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
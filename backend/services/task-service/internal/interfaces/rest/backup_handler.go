@@ -0,0 +1,89 @@
+// file: backend/services/task-service/internal/interfaces/rest/backup_handler.go
+package rest
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// maxBackupRestoreSize membatasi ukuran dokumen backup yang dipulihkan.
+const maxBackupRestoreSize = 64 << 20
+
+// BackupHandler menangani ekspor dan pemulihan backup akun.
+type BackupHandler struct {
+	backupService application.BackupApplicationService
+}
+
+// NewBackupHandler adalah constructor untuk BackupHandler.
+func NewBackupHandler(backupService application.BackupApplicationService) *BackupHandler {
+	return &BackupHandler{
+		backupService: backupService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route backup. Route ini membutuhkan pengguna terautentikasi.
+func (h *BackupHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/backup", h.export)
+	mux.HandleFunc("POST /api/v1/me/backup/restore", h.restore)
+}
+
+// export mengirim backup sebagai file unduhan. Error setelah header terkirim hanya bisa di-log;
+// dokumen yang terpotong bukan JSON yang valid sehingga tidak bisa dipulihkan tanpa disadari.
+func (h *BackupHandler) export(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	out := &backupResponseWriter{w: w}
+	if err := h.backupService.ExportBackup(r.Context(), userID, out); err != nil {
+		if !out.started {
+			writeError(w, r, err)
+			return
+		}
+		slog.ErrorContext(r.Context(), "error exporting account backup", "error", err)
+	}
+}
+
+// backupResponseWriter menunda header response sampai byte pertama ditulis, sehingga error sebelum
+// itu masih bisa dikirim sebagai problem+json.
+type backupResponseWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (b *backupResponseWriter) Write(p []byte) (int, error) {
+	if !b.started {
+		b.started = true
+		b.w.Header().Set("Content-Type", "application/json")
+		b.w.Header().Set("Content-Disposition", `attachment; filename="backup.json"`)
+		b.w.WriteHeader(http.StatusOK)
+	}
+	return b.w.Write(p)
+}
+
+// restore memulihkan dokumen backup dari body request.
+func (h *BackupHandler) restore(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	report, err := h.backupService.RestoreBackup(r.Context(), userID, http.MaxBytesReader(w, r.Body, maxBackupRestoreSize))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeProblem(w, http.StatusRequestEntityTooLarge, "backup must be at most "+strconv.Itoa(maxBackupRestoreSize)+" bytes")
+		return
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	count := func(c application.BackupRestoreCount) dto.BackupRestoreCountResponse {
+		return dto.BackupRestoreCountResponse{Total: c.Total, Restored: c.Restored}
+	}
+	writeJSON(w, http.StatusOK, dto.BackupRestoreResponse{
+		BoardColumns: count(report.BoardColumns),
+		Tasks:        count(report.Tasks),
+		Comments:     count(report.Comments),
+		Attachments:  count(report.Attachments),
+	})
+}
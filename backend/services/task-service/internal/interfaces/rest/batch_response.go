@@ -0,0 +1,40 @@
+// file: backend/services/task-service/internal/interfaces/rest/batch_response.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// newBatchResponse mengubah hasil batch dari application layer ke dto.BatchResponse, beserta
+// status HTTP response: 200 jika semua item berhasil, 207 Multi-Status jika ada yang gagal.
+func newBatchResponse(r *http.Request, results []application.BatchItemResult) (int, dto.BatchResponse) {
+	resp := dto.BatchResponse{Results: make([]dto.BatchItemResponse, len(results))}
+	for i, result := range results {
+		item := dto.BatchItemResponse{Index: result.Index, ID: result.ID}
+		switch {
+		case result.Err != nil:
+			status, code, message := errorStatus(r, result.Err)
+			item.Status, item.Error = status, &dto.BatchError{Code: code, Message: message}
+			resp.Failed++
+		case result.Created:
+			item.Status = http.StatusCreated
+			resp.Succeeded++
+		default:
+			item.Status = http.StatusOK
+			resp.Succeeded++
+		}
+		if result.Task != nil {
+			task := dto.NewTaskResponse(result.Task)
+			item.Task = &task
+		}
+		resp.Results[i] = item
+	}
+
+	if resp.Failed > 0 {
+		return http.StatusMultiStatus, resp
+	}
+	return http.StatusOK, resp
+}
@@ -0,0 +1,127 @@
+// file: backend/services/task-service/internal/interfaces/rest/board_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// BoardHandler menangani endpoint board Kanban.
+type BoardHandler struct {
+	boardService application.BoardApplicationService
+}
+
+// NewBoardHandler adalah constructor untuk BoardHandler.
+func NewBoardHandler(boardService application.BoardApplicationService) *BoardHandler {
+	return &BoardHandler{boardService: boardService}
+}
+
+// RegisterRoutes mendaftarkan route board. Route ini membutuhkan pengguna terautentikasi.
+func (h *BoardHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/board", h.get)
+	mux.HandleFunc("POST /api/v1/board/columns", h.createColumn)
+	mux.HandleFunc("PATCH /api/v1/board/columns/reorder", h.reorderColumns)
+	mux.HandleFunc("PATCH /api/v1/board/columns/{id}", h.updateColumn)
+	mux.HandleFunc("DELETE /api/v1/board/columns/{id}", h.deleteColumn)
+	mux.HandleFunc("POST /api/v1/board/tasks/{id}/move", h.moveTask)
+}
+
+func (h *BoardHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	board, err := h.boardService.GetBoard(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewBoardResponse(board))
+}
+
+func (h *BoardHandler) createColumn(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.BoardColumnRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	column, err := h.boardService.CreateColumn(r.Context(), userID, application.BoardColumnInput{
+		Name:     req.Name,
+		WIPLimit: req.WIPLimit,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.NewBoardColumnResponse(column))
+}
+
+func (h *BoardHandler) updateColumn(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.BoardColumnRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	column, err := h.boardService.UpdateColumn(r.Context(), userID, r.PathValue("id"), application.BoardColumnInput{
+		Name:     req.Name,
+		WIPLimit: req.WIPLimit,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewBoardColumnResponse(column))
+}
+
+// deleteColumn menghapus kolom; task di dalamnya tidak dihapus, hanya dikeluarkan dari board.
+func (h *BoardHandler) deleteColumn(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.boardService.DeleteColumn(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reorderColumns menerima urutan lengkap ID kolom dari kiri ke kanan.
+func (h *BoardHandler) reorderColumns(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.ReorderBoardColumnsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	columns, err := h.boardService.ReorderColumns(r.Context(), userID, req.IDs)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewBoardColumnResponses(columns))
+}
+
+// moveTask memindahkan task ke kolom lain. Kolom yang sudah mencapai batas WIP menolak dengan
+// 409 wip_limit_reached.
+func (h *BoardHandler) moveTask(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.MoveBoardTaskRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	input := application.MoveBoardTaskInput{AfterID: req.AfterID}
+	if req.ColumnID != nil {
+		if *req.ColumnID == "" {
+			writeProblem(w, http.StatusBadRequest, "column_id must be a column ID or null")
+			return
+		}
+		input.ColumnID = *req.ColumnID
+	}
+	task, err := h.boardService.MoveTask(r.Context(), userID, r.PathValue("id"), input)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
@@ -0,0 +1,106 @@
+// file: backend/services/task-service/internal/interfaces/rest/bulk_task_handler.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// maxBulkTasks adalah jumlah task maksimum dalam satu operasi bulk.
+const maxBulkTasks = 500
+
+// BulkTaskHandler menangani endpoint operasi banyak task sekaligus.
+type BulkTaskHandler struct {
+	bulkService application.BulkTaskApplicationService
+}
+
+// NewBulkTaskHandler adalah constructor untuk BulkTaskHandler.
+func NewBulkTaskHandler(bulkService application.BulkTaskApplicationService) *BulkTaskHandler {
+	return &BulkTaskHandler{
+		bulkService: bulkService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route bulk. Route ini membutuhkan pengguna terautentikasi.
+func (h *BulkTaskHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/tasks/bulk/create", h.create)
+	mux.HandleFunc("POST /api/v1/tasks/bulk/complete", h.complete)
+	mux.HandleFunc("POST /api/v1/tasks/bulk/undo", h.undo)
+}
+
+// create membuat banyak task sekaligus. Item yang gagal tidak membatalkan item lain.
+func (h *BulkTaskHandler) create(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.BulkCreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if len(req.Tasks) == 0 || len(req.Tasks) > maxBulkTasks {
+		writeProblem(w, http.StatusBadRequest, "tasks must contain between 1 and "+strconv.Itoa(maxBulkTasks)+" tasks")
+		return
+	}
+
+	inputs := make([]application.CreateTaskInput, 0, len(req.Tasks))
+	for _, task := range req.Tasks {
+		inputs = append(inputs, application.CreateTaskInput{
+			ID:          task.ID,
+			Title:       task.Title,
+			Description: task.Description,
+		})
+	}
+	status, resp := newBatchResponse(r, h.bulkService.CreateTasks(r.Context(), userID, inputs))
+	writeJSON(w, status, resp)
+}
+
+// complete menandai banyak task selesai sekaligus dan mengembalikan token undo. Operasi ini atomik:
+// jika ada ID yang tidak ditemukan, response 207 menandai ID tersebut 404 dan sisanya 424.
+func (h *BulkTaskHandler) complete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.BulkCompleteRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if len(req.IDs) > maxBulkTasks {
+		writeProblem(w, http.StatusBadRequest, "ids must contain at most "+strconv.Itoa(maxBulkTasks)+" tasks")
+		return
+	}
+
+	result, err := h.bulkService.CompleteTasks(r.Context(), userID, req.IDs)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	status, batch := newBatchResponse(r, result.Results)
+	resp := dto.BulkCompleteResponse{
+		BatchResponse: batch,
+		UndoToken:     result.UndoToken,
+	}
+	if result.UndoToken != "" {
+		resp.UndoExpiresAt = &result.UndoExpiresAt
+	}
+	writeJSON(w, status, resp)
+}
+
+// undo membatalkan operasi bulk selama token undo masih berlaku. Token yang kedaluwarsa
+// atau sudah dipakai dijawab 410 Gone.
+func (h *BulkTaskHandler) undo(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.BulkUndoRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	tasks, err := h.bulkService.Undo(r.Context(), userID, req.UndoToken)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.BulkUndoResponse{Tasks: dto.NewTaskResponses(tasks)})
+}
@@ -0,0 +1,50 @@
+// file: backend/services/task-service/internal/interfaces/rest/caldav_token_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// CalDAVTokenHandler menangani pengelolaan password aplikasi untuk klien CalDAV di /dav/.
+type CalDAVTokenHandler struct {
+	calDAVService application.CalDAVApplicationService
+}
+
+// NewCalDAVTokenHandler adalah constructor untuk CalDAVTokenHandler.
+func NewCalDAVTokenHandler(calDAVService application.CalDAVApplicationService) *CalDAVTokenHandler {
+	return &CalDAVTokenHandler{
+		calDAVService: calDAVService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route token CalDAV. Route dibungkus auth.RequireSession, sehingga
+// token terbatas tidak bisa dipakai untuk menerbitkan password CalDAV dengan akses penuh.
+func (h *CalDAVTokenHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/me/caldav/token", auth.RequireSession(http.HandlerFunc(h.rotateToken)))
+	mux.Handle("DELETE /api/v1/me/caldav/token", auth.RequireSession(http.HandlerFunc(h.revokeToken)))
+}
+
+// rotateToken membuat password CalDAV baru; klien yang memakai password lama harus login ulang.
+func (h *CalDAVTokenHandler) rotateToken(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	token, err := h.calDAVService.RotateToken(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, dto.CalDAVTokenResponse{Username: string(userID), Password: token, Path: "/dav/"})
+}
+
+func (h *CalDAVTokenHandler) revokeToken(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.calDAVService.RevokeToken(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
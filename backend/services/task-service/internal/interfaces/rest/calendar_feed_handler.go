@@ -0,0 +1,96 @@
+// file: backend/services/task-service/internal/interfaces/rest/calendar_feed_handler.go
+package rest
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/ical"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// calendarFeedName adalah nama kalender yang ditampilkan aplikasi kalender (X-WR-CALNAME).
+const calendarFeedName = "Tasks"
+
+// CalendarFeedHandler menangani feed iCalendar task bertenggat dan pengelolaan token URL-nya.
+type CalendarFeedHandler struct {
+	feedService application.CalendarFeedApplicationService
+}
+
+// NewCalendarFeedHandler adalah constructor untuk CalendarFeedHandler.
+func NewCalendarFeedHandler(feedService application.CalendarFeedApplicationService) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		feedService: feedService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route pengelolaan token feed. Route dibungkus auth.RequireSession,
+// sehingga token terbatas tidak bisa dipakai untuk menerbitkan URL feed berisi semua task.
+func (h *CalendarFeedHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/me/calendar/token", auth.RequireSession(http.HandlerFunc(h.rotateToken)))
+	mux.Handle("DELETE /api/v1/me/calendar/token", auth.RequireSession(http.HandlerFunc(h.revokeToken)))
+}
+
+// RegisterPublicRoutes mendaftarkan feed .ics. Aplikasi kalender tidak bisa mengirim token
+// pengguna, sehingga feed diautentikasi dengan token di URL.
+func (h *CalendarFeedHandler) RegisterPublicRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /calendar/{file}", h.feed)
+}
+
+// rotateToken membuat URL feed baru; URL lama langsung tidak berlaku.
+func (h *CalendarFeedHandler) rotateToken(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	token, err := h.feedService.RotateFeedToken(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, dto.CalendarFeedTokenResponse{Token: token, Path: "/calendar/" + token + ".ics"})
+}
+
+func (h *CalendarFeedHandler) revokeToken(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.feedService.RevokeFeedToken(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// feed menulis task bertenggat pemilik token sebagai dokumen iCalendar. Token yang tidak dikenal
+// dijawab 404 agar tidak bisa dibedakan dari path yang tidak ada.
+func (h *CalendarFeedHandler) feed(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+	if !ok {
+		writeError(w, r, domain.ErrInvalidCalendarFeedToken)
+		return
+	}
+	tasks, err := h.feedService.GetFeed(r.Context(), token)
+	if errors.Is(err, domain.ErrInvalidCalendarFeedToken) {
+		writeError(w, r, err)
+		return
+	}
+	var buf bytes.Buffer
+	if err == nil {
+		err = ical.WriteTasks(&buf, calendarFeedName, tasks)
+	}
+	if err != nil {
+		// Tidak memakai writeError karena log-nya memuat path, yang berisi token.
+		slog.ErrorContext(r.Context(), "error serving calendar feed", "error", err)
+		writeProblemCode(w, http.StatusInternalServerError, "internal_error", "internal server error")
+		return
+	}
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		slog.ErrorContext(r.Context(), "error writing response", "error", err)
+	}
+}
@@ -0,0 +1,97 @@
+// file: backend/services/task-service/internal/interfaces/rest/client_ip.go
+package rest
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// headerForwardedFor adalah header yang ditambahkan reverse proxy dan load balancer berisi rantai
+// alamat klien.
+const headerForwardedFor = "X-Forwarded-For"
+
+// ParseTrustedProxies membaca daftar CIDR atau alamat IP (dipisah koma) proxy yang X-Forwarded-For-nya
+// dipercaya, misalnya "10.0.0.0/8,127.0.0.1".
+func ParseTrustedProxies(raw string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// withClientIP menyimpan alamat IP klien ke context (lihat domain.ClientIPFromContext). Alamat
+// diambil dari RemoteAddr; jika koneksi datang dari proxy tepercaya, X-Forwarded-For dibaca dari
+// kanan dan alamat pertama yang bukan proxy tepercaya dipakai, sehingga klien tidak bisa memalsukan
+// alamatnya dengan mengirim header sendiri.
+func withClientIP(trusted []netip.Prefix, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r, trusted)
+		if !ip.IsValid() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(domain.ContextWithClientIP(r.Context(), ip.String())))
+	})
+}
+
+func clientIP(r *http.Request, trusted []netip.Prefix) netip.Addr {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}
+	}
+	addr = addr.Unmap()
+	if !isTrustedProxy(addr, trusted) {
+		return addr
+	}
+
+	var hops []string
+	for _, header := range r.Header.Values(headerForwardedFor) {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break // Rantai rusak; alamat di kirinya tidak bisa dipercaya
+		}
+		addr = hop.Unmap()
+		if !isTrustedProxy(addr, trusted) {
+			break
+		}
+	}
+	return addr
+}
+
+func isTrustedProxy(addr netip.Addr, trusted []netip.Prefix) bool {
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
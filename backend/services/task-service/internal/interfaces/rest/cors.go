@@ -0,0 +1,96 @@
+// file: backend/services/task-service/internal/interfaces/rest/cors.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+const corsMaxAge = 10 * time.Minute // Lama browser boleh menyimpan hasil preflight
+
+// corsExposedHeaders adalah header response yang boleh dibaca JavaScript dari origin lain.
+var corsExposedHeaders = strings.Join([]string{
+	"ETag", "Location", headerTotalCount, domain.HeaderRequestID, headerCSRFToken,
+	"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After",
+}, ", ")
+
+// CORSConfig adalah daftar origin browser (misalnya "https://app.example.com", atau "*" untuk
+// semua origin) yang boleh memanggil API dari origin lain. Daftar bisa diganti lewat
+// SetAllowedOrigins saat service berjalan.
+type CORSConfig struct {
+	origins atomic.Pointer[map[string]bool]
+
+	// AllowCredentials mengizinkan browser mengirim cookie (lihat SessionCookieConfig), hanya
+	// untuk origin yang disebut eksplisit dan tidak lewat "*".
+	AllowCredentials bool
+}
+
+// NewCORSConfig adalah constructor untuk CORSConfig.
+func NewCORSConfig(allowedOrigins []string) *CORSConfig {
+	cfg := &CORSConfig{}
+	cfg.SetAllowedOrigins(allowedOrigins)
+	return cfg
+}
+
+// SetAllowedOrigins mengganti daftar origin untuk request berikutnya; kosong berarti tidak ada
+// origin lain yang diizinkan.
+func (c *CORSConfig) SetAllowedOrigins(allowedOrigins []string) {
+	origins := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		origins[strings.TrimRight(origin, "/")] = true
+	}
+	c.origins.Store(&origins)
+}
+
+func (c *CORSConfig) allowed(origin string) bool {
+	origins := *c.origins.Load()
+	return origins["*"] || origins[origin]
+}
+
+func (c *CORSConfig) allowedWithCredentials(origin string) bool {
+	return c.AllowCredentials && (*c.origins.Load())[origin]
+}
+
+// cors menambahkan header CORS untuk origin yang diizinkan dan menjawab preflight (OPTIONS dengan
+// Access-Control-Request-Method) sebelum autentikasi, karena browser tidak mengirim token pada
+// preflight. Credential (cookie) hanya diizinkan jika AllowCredentials aktif; selain itu klien
+// mengirim token lewat header Authorization.
+func cors(cfg *CORSConfig, next http.Handler) http.Handler {
+	if cfg == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Origin")
+		if !cfg.allowed(origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if cfg.allowedWithCredentials(origin) {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE")
+			if headers := r.Header.Get("Access-Control-Request-Headers"); headers != "" {
+				w.Header().Set("Access-Control-Allow-Headers", headers)
+			}
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(corsMaxAge.Seconds())))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Access-Control-Expose-Headers", corsExposedHeaders)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,77 @@
+// file: backend/services/task-service/internal/interfaces/rest/data_export_handler.go
+package rest
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// DataExportHandler menangani ekspor seluruh data pribadi pengguna.
+type DataExportHandler struct {
+	exportService application.DataExportApplicationService
+}
+
+// NewDataExportHandler adalah constructor untuk DataExportHandler.
+func NewDataExportHandler(exportService application.DataExportApplicationService) *DataExportHandler {
+	return &DataExportHandler{
+		exportService: exportService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route ekspor data. Kredensial dengan scope membutuhkan scope export
+// (lihat routeScopes).
+func (h *DataExportHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/me/export", h.request)
+	mux.HandleFunc("GET /api/v1/me/exports/{id}", h.get)
+	mux.HandleFunc("GET /api/v1/me/exports/{id}/download", h.download)
+}
+
+// request menjawab 202 dengan header Location ke endpoint status; arsip disusun di latar belakang.
+func (h *DataExportHandler) request(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	export, err := h.exportService.RequestExport(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", "/api/v1/me/exports/"+export.ID)
+	writeJSON(w, http.StatusAccepted, dto.NewDataExportResponse(export))
+}
+
+func (h *DataExportHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	export, err := h.exportService.GetExport(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewDataExportResponse(export))
+}
+
+// download mengirim arsip ZIP. Error setelah header terkirim hanya bisa di-log; arsip yang
+// terpotong tidak bisa dibuka sebagai ZIP.
+func (h *DataExportHandler) download(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	export, archive, err := h.exportService.OpenExport(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer archive.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="data-export-`+export.ID+`.zip"`)
+	w.Header().Set("Content-Length", strconv.FormatInt(export.SizeBytes, 10))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, archive); err != nil {
+		slog.ErrorContext(r.Context(), "error sending data export", "export_id", export.ID, "error", err)
+	}
+}
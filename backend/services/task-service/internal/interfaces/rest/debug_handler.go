@@ -0,0 +1,78 @@
+// file: backend/services/task-service/internal/interfaces/rest/debug_handler.go
+package rest
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// DebugHandler menyajikan profil net/http/pprof, expvar, dan ringkasan runtime untuk mendiagnosis
+// regresi latensi di production. Handler ini dipasang di port internal terpisah (lihat DEBUG_ADDR),
+// bukan di router publik, dan setiap request membutuhkan token debug.
+type DebugHandler struct {
+	tokenHash [sha256.Size]byte
+}
+
+// NewDebugHandler adalah constructor untuk DebugHandler. Token tidak boleh kosong.
+func NewDebugHandler(token string) *DebugHandler {
+	return &DebugHandler{tokenHash: sha256.Sum256([]byte(token))}
+}
+
+// RegisterRoutes mendaftarkan route debug. Semua route membutuhkan header
+// "Authorization: Bearer <token>".
+func (h *DebugHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /debug/pprof/", h.authorize(http.HandlerFunc(pprof.Index)))
+	mux.Handle("GET /debug/pprof/cmdline", h.authorize(http.HandlerFunc(pprof.Cmdline)))
+	mux.Handle("GET /debug/pprof/profile", h.authorize(http.HandlerFunc(pprof.Profile)))
+	mux.Handle("GET /debug/pprof/symbol", h.authorize(http.HandlerFunc(pprof.Symbol)))
+	mux.Handle("POST /debug/pprof/symbol", h.authorize(http.HandlerFunc(pprof.Symbol)))
+	mux.Handle("GET /debug/pprof/trace", h.authorize(http.HandlerFunc(pprof.Trace)))
+	mux.Handle("GET /debug/vars", h.authorize(expvar.Handler()))
+	mux.Handle("GET /debug/runtime", h.authorize(http.HandlerFunc(h.runtimeStats)))
+}
+
+// authorize menolak request tanpa token debug yang benar. Token dibandingkan lewat hash-nya agar
+// perbandingan berjalan dalam waktu konstan tanpa bergantung pada panjang token.
+func (h *DebugHandler) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		hash := sha256.Sum256([]byte(token))
+		if !ok || subtle.ConstantTimeCompare(hash[:], h.tokenHash[:]) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="task-service-debug"`)
+			writeProblem(w, http.StatusUnauthorized, "A valid debug token is required")
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// runtimeStats mengembalikan ringkasan runtime Go: jumlah goroutine, heap, dan GC.
+func (h *DebugHandler) runtimeStats(w http.ResponseWriter, r *http.Request) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	resp := dto.RuntimeStatsResponse{
+		GoVersion:    runtime.Version(),
+		Goroutines:   runtime.NumGoroutine(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		HeapAlloc:    mem.HeapAlloc,
+		HeapInuse:    mem.HeapInuse,
+		HeapObjects:  mem.HeapObjects,
+		Sys:          mem.Sys,
+		NumGC:        mem.NumGC,
+		GCPauseTotal: time.Duration(mem.PauseTotalNs),
+	}
+	if mem.LastGC != 0 {
+		lastGC := time.Unix(0, int64(mem.LastGC)).UTC()
+		resp.LastGC = &lastGC
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,71 @@
+// file: backend/services/task-service/internal/interfaces/rest/device_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// DeviceHandler menangani endpoint pengelolaan perangkat pengguna.
+type DeviceHandler struct {
+	deviceService application.DeviceApplicationService
+}
+
+// NewDeviceHandler adalah constructor untuk DeviceHandler.
+func NewDeviceHandler(deviceService application.DeviceApplicationService) *DeviceHandler {
+	return &DeviceHandler{
+		deviceService: deviceService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route perangkat. Route ini membutuhkan pengguna terautentikasi.
+func (h *DeviceHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/devices", h.list)
+	mux.HandleFunc("PUT /api/v1/me/devices/{id}", h.register)
+	mux.HandleFunc("DELETE /api/v1/me/devices/{id}", h.revoke)
+}
+
+// list mengembalikan semua perangkat pengguna, termasuk yang dicabut, beserta diagnostik sync-nya.
+func (h *DeviceHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	devices, err := h.deviceService.ListDevices(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewDeviceResponses(devices))
+}
+
+// register membuat atau memperbarui profil perangkat; {id} sama dengan header X-Device-ID saat sync.
+func (h *DeviceHandler) register(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.DeviceRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	device, err := h.deviceService.RegisterDevice(r.Context(), userID, application.RegisterDeviceInput{
+		ID:               r.PathValue("id"),
+		Name:             req.Name,
+		Platform:         req.Platform,
+		PushSubscription: req.PushSubscription,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewDeviceResponse(device))
+}
+
+// revoke mencabut perangkat; sync berikutnya dengan ID perangkat tersebut ditolak.
+func (h *DeviceHandler) revoke(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.deviceService.RevokeDevice(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,216 @@
+// file: backend/services/task-service/internal/interfaces/rest/discord_handler.go
+package rest
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// Batas request interaction Discord.
+const (
+	maxDiscordInteractionSize = 64 << 10
+	discordTimestampTolerance = 5 * time.Minute
+)
+
+// DiscordHandler menangani pengaturan integrasi Discord dan endpoint interaction untuk slash command.
+type DiscordHandler struct {
+	discordService application.DiscordApplicationService
+	publicKey      ed25519.PublicKey // Public key aplikasi Discord; nil menonaktifkan slash command
+}
+
+// NewDiscordHandler adalah constructor untuk DiscordHandler.
+func NewDiscordHandler(discordService application.DiscordApplicationService, publicKey ed25519.PublicKey) *DiscordHandler {
+	return &DiscordHandler{
+		discordService: discordService,
+		publicKey:      publicKey,
+	}
+}
+
+// RegisterRoutes mendaftarkan route pengaturan Discord, termasuk pengaturan per daftar bersama.
+// Route ini membutuhkan pengguna terautentikasi.
+func (h *DiscordHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/integrations/discord", h.get)
+	mux.HandleFunc("PUT /api/v1/integrations/discord", h.save)
+	mux.HandleFunc("DELETE /api/v1/integrations/discord", h.delete)
+	mux.HandleFunc("POST /api/v1/integrations/discord/link-code", h.createLinkCode)
+	mux.HandleFunc("GET /api/v1/shared-lists/{ownerID}/discord", h.getList)
+	mux.HandleFunc("PUT /api/v1/shared-lists/{ownerID}/discord", h.saveList)
+	mux.HandleFunc("DELETE /api/v1/shared-lists/{ownerID}/discord", h.deleteList)
+}
+
+// RegisterPublicRoutes mendaftarkan Interactions Endpoint URL Discord. Route ini tidak memakai
+// token pengguna; setiap request diverifikasi dengan signature Ed25519 dari Discord.
+func (h *DiscordHandler) RegisterPublicRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/integrations/discord/interactions", h.interactions)
+}
+
+func (h *DiscordHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	channel, err := h.discordService.GetChannel(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewDiscordChannelResponse(channel))
+}
+
+// save mengganti tujuan notifikasi setelah pesan percobaan terkirim ke Discord.
+func (h *DiscordHandler) save(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.DiscordChannelRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	channel, err := h.discordService.SaveChannel(r.Context(), userID, application.SaveDiscordChannelInput{
+		WebhookURL: req.WebhookURL,
+		ChannelID:  req.ChannelID,
+		EventTypes: req.EventTypes,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewDiscordChannelResponse(channel))
+}
+
+func (h *DiscordHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.discordService.DeleteChannel(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *DiscordHandler) getList(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	channel, err := h.discordService.GetListChannel(r.Context(), userID, domain.UserID(r.PathValue("ownerID")))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewDiscordChannelResponse(channel))
+}
+
+// saveList mengganti tujuan notifikasi event daftar bersama ownerID untuk pengguna ini.
+func (h *DiscordHandler) saveList(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.DiscordChannelRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	channel, err := h.discordService.SaveListChannel(r.Context(), userID, domain.UserID(r.PathValue("ownerID")), application.SaveDiscordChannelInput{
+		WebhookURL: req.WebhookURL,
+		ChannelID:  req.ChannelID,
+		EventTypes: req.EventTypes,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewDiscordChannelResponse(channel))
+}
+
+func (h *DiscordHandler) deleteList(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.discordService.DeleteListChannel(r.Context(), userID, domain.UserID(r.PathValue("ownerID"))); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createLinkCode membuat kode untuk slash command /task link.
+func (h *DiscordHandler) createLinkCode(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	code, err := h.discordService.CreateLinkCode(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.DiscordLinkCodeResponse{Code: code.Code, ExpiresAt: code.ExpiresAt})
+}
+
+// interactions menjawab PING dan slash command /task. Discord mewajibkan balasan dalam tiga detik.
+func (h *DiscordHandler) interactions(w http.ResponseWriter, r *http.Request) {
+	if h.publicKey == nil {
+		writeError(w, r, domain.ErrDiscordIntegrationMissing)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDiscordInteractionSize))
+	if err != nil {
+		writeProblem(w, http.StatusRequestEntityTooLarge, "interaction is too large")
+		return
+	}
+	if err := h.verifySignature(r, body); err != nil {
+		writeProblem(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var interaction dto.DiscordInteraction
+	if err := json.Unmarshal(body, &interaction); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid interaction: "+err.Error())
+		return
+	}
+
+	switch interaction.Type {
+	case dto.DiscordInteractionPing:
+		writeJSON(w, http.StatusOK, dto.DiscordInteractionResponse{Type: dto.DiscordResponsePong})
+	case dto.DiscordInteractionApplicationCommand:
+		cmd := application.DiscordCommand{DiscordUserID: interaction.UserID(), Options: map[string]string{}}
+		if len(interaction.Data.Options) > 0 {
+			sub := interaction.Data.Options[0]
+			cmd.Name = sub.Name
+			for _, option := range sub.Options {
+				cmd.Options[option.Name] = fmt.Sprint(option.Value)
+			}
+		}
+		reply := h.discordService.HandleCommand(r.Context(), cmd)
+		writeJSON(w, http.StatusOK, dto.DiscordInteractionResponse{
+			Type: dto.DiscordResponseChannelMessage,
+			Data: &dto.DiscordInteractionData{
+				DiscordMessage:  reply,
+				Flags:           dto.DiscordMessageEphemeral,
+				AllowedMentions: dto.DiscordAllowedMentions{Parse: []string{}},
+			},
+		})
+	default:
+		writeProblem(w, http.StatusBadRequest, "unsupported interaction type")
+	}
+}
+
+// verifySignature memeriksa X-Signature-Ed25519 atas timestamp + body, dan menolak timestamp
+// yang terlalu jauh dari waktu server agar interaction lama tidak bisa diputar ulang.
+func (h *DiscordHandler) verifySignature(r *http.Request, body []byte) error {
+	signature, err := hex.DecodeString(r.Header.Get("X-Signature-Ed25519"))
+	timestamp := r.Header.Get("X-Signature-Timestamp")
+	if err != nil || len(signature) != ed25519.SignatureSize || timestamp == "" {
+		return fmt.Errorf("missing or malformed interaction signature")
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid interaction timestamp")
+	}
+	if skew := time.Since(time.Unix(seconds, 0)); skew > discordTimestampTolerance || skew < -discordTimestampTolerance {
+		return fmt.Errorf("interaction timestamp is too old")
+	}
+	if !ed25519.Verify(h.publicKey, append([]byte(timestamp), body...), signature) {
+		return fmt.Errorf("invalid interaction signature")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// file: backend/services/task-service/internal/interfaces/rest/email_handler.go
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// EmailHandler menangani pengaturan notifikasi email.
+type EmailHandler struct {
+	emailService application.EmailApplicationService
+}
+
+// NewEmailHandler adalah constructor untuk EmailHandler.
+func NewEmailHandler(emailService application.EmailApplicationService) *EmailHandler {
+	return &EmailHandler{emailService: emailService}
+}
+
+// RegisterRoutes mendaftarkan route pengaturan email. Route ini membutuhkan pengguna terautentikasi.
+func (h *EmailHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/integrations/email", h.get)
+	mux.HandleFunc("PUT /api/v1/integrations/email", h.save)
+	mux.HandleFunc("DELETE /api/v1/integrations/email", h.delete)
+	mux.HandleFunc("POST /api/v1/integrations/email/test", h.test)
+}
+
+func (h *EmailHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	channel, err := h.emailService.GetChannel(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewEmailChannelResponse(channel))
+}
+
+// save mengganti pengaturan. Alamat diambil dari klaim email token, sehingga menyimpan ulang
+// pengaturan juga memperbarui alamat setelah email akun diubah.
+func (h *EmailHandler) save(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.EmailChannelRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	var address string
+	if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
+		address = claims.Email
+	}
+	channel, err := h.emailService.SaveChannel(r.Context(), userID, application.SaveEmailChannelInput{
+		Address:      address,
+		EventTypes:   req.EventTypes,
+		ReminderLead: time.Duration(req.ReminderMinutes) * time.Minute,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewEmailChannelResponse(channel))
+}
+
+func (h *EmailHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.emailService.DeleteChannel(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// test mengirim email percobaan ke alamat yang tersimpan.
+func (h *EmailHandler) test(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.emailService.SendTest(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
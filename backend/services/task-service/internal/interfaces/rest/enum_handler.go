@@ -0,0 +1,62 @@
+// file: backend/services/task-service/internal/interfaces/rest/enum_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// EnumHandler menangani discovery dan perluasan nilai enum.
+type EnumHandler struct {
+	enumService application.EnumApplicationService
+}
+
+// NewEnumHandler adalah constructor untuk EnumHandler.
+func NewEnumHandler(enumService application.EnumApplicationService) *EnumHandler {
+	return &EnumHandler{
+		enumService: enumService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route enum. Route ini membutuhkan pengguna terautentikasi.
+func (h *EnumHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/enums", h.list)
+	mux.HandleFunc("PUT /api/v1/enums/{enum}/values/{value}", h.saveValue)
+}
+
+// list mengembalikan nilai yang berlaku untuk workspace pengguna pada setiap enum. Klien sebaiknya
+// memakai endpoint ini untuk menampilkan label, dan menampilkan nilai yang tidak dikenalnya apa adanya.
+func (h *EnumHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	enums, err := h.enumService.ListEnums(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewEnumsResponse(enums))
+}
+
+// saveValue menambah atau mengubah nilai enum milik workspace, termasuk menandainya deprecated.
+func (h *EnumHandler) saveValue(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.EnumValueRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	value, err := h.enumService.SaveEnumValue(r.Context(), userID, domain.EnumName(r.PathValue("enum")), r.PathValue("value"),
+		application.SaveEnumValueInput{
+			Label:      req.Label,
+			Position:   req.Position,
+			Deprecated: req.Deprecated,
+		})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewEnumValueResponse(*value))
+}
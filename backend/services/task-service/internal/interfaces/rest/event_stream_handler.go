@@ -0,0 +1,191 @@
+// file: backend/services/task-service/internal/interfaces/rest/event_stream_handler.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+const (
+	sseHeartbeatPeriod = 30 * time.Second // Komentar heartbeat agar proxy tidak menutup koneksi idle
+	sseRetry           = 3000             // Jeda reconnect EventSource dalam milidetik
+	sseReplayBatchSize = 500              // Jumlah event per query saat membaca ulang backlog
+)
+
+// EventStreamHandler menangani GET /api/v1/events, yaitu stream Server-Sent Events berisi TaskEvent
+// milik pengguna. Alternatif /ws untuk klien atau proxy yang tidak mendukung WebSocket.
+type EventStreamHandler struct {
+	events    domain.TaskEventSubscriber
+	log       domain.TaskEventLog
+	verifier  auth.TokenVerifier
+	closing   chan struct{} // Ditutup oleh Close
+	closeOnce sync.Once
+}
+
+// NewEventStreamHandler adalah constructor untuk EventStreamHandler. verifier dipakai untuk
+// memeriksa ulang kredensial stream yang terbuka, seperti pada NewRealtimeHandler.
+func NewEventStreamHandler(events domain.TaskEventSubscriber, log domain.TaskEventLog, verifier auth.TokenVerifier) *EventStreamHandler {
+	return &EventStreamHandler{
+		events:   events,
+		log:      log,
+		verifier: verifier,
+		closing:  make(chan struct{}),
+	}
+}
+
+// ServeHTTP mengirim setiap TaskEvent sebagai pesan SSE dengan id berisi ID event. Jika header
+// Last-Event-ID (atau query parameter last_event_id) ada, event setelahnya dibaca ulang dari
+// task_events sebelum event baru. Jika backlog sudah kedaluwarsa, event "resync" dikirim dan klien
+// harus resync lewat /api/v1/sync. Klien yang terlalu lambat diputus; EventSource akan reconnect
+// dengan Last-Event-ID sehingga event yang terlewat dibaca ulang. Saat kredensialnya kedaluwarsa
+// atau dicabut, event "unauthorized" dikirim lalu stream diakhiri; reconnect dengan kredensial
+// yang sama dijawab 401.
+func (h *EventStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	lastID, err := lastEventID(r)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "Last-Event-ID must be a non-negative integer")
+		return
+	}
+
+	// Berlangganan sebelum membaca backlog agar event yang datang di antaranya tidak terlewat;
+	// duplikatnya dibuang berdasarkan ID.
+	events, cancel := h.events.Subscribe(userID)
+	defer cancel()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // Matikan buffering di nginx
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", sseRetry); err != nil {
+		return
+	}
+
+	if lastID > 0 {
+		lastID, err = h.replay(w, r, userID, lastID)
+		if errors.Is(err, domain.ErrTaskEventsExpired) {
+			err = writeSSE(w, "resync", "", struct{}{})
+		}
+		if err != nil {
+			slog.ErrorContext(r.Context(), "event stream: error replaying events", "error", err)
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	credential := newStreamCredential(r, h.verifier)
+	defer credential.Stop()
+	ticker := time.NewTicker(sseHeartbeatPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.ID <= lastID {
+				continue
+			}
+			if err := writeSSE(w, "", strconv.FormatInt(event.ID, 10), event); err != nil {
+				return
+			}
+			lastID = event.ID
+		case <-ticker.C:
+			if !credential.Valid() {
+				writeSSE(w, "unauthorized", "", struct{}{})
+				rc.Flush()
+				return
+			}
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case <-credential.Expired():
+			writeSSE(w, "unauthorized", "", struct{}{})
+			rc.Flush()
+			return
+		case <-r.Context().Done():
+			return
+		case <-h.closing:
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// Close mengakhiri semua stream yang terbuka agar http.Server.Shutdown tidak menunggu sampai
+// batas waktu; EventSource reconnect (ke replika lain) dengan Last-Event-ID sehingga tidak ada
+// event yang hilang. Dipasang lewat http.Server.RegisterOnShutdown.
+func (h *EventStreamHandler) Close() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
+// replay mengirim event milik userID setelah afterID dan mengembalikan ID event terakhir yang dikirim.
+func (h *EventStreamHandler) replay(w http.ResponseWriter, r *http.Request, userID domain.UserID, afterID int64) (int64, error) {
+	for {
+		events, err := h.log.FindAfter(r.Context(), userID, afterID, sseReplayBatchSize)
+		if err != nil {
+			return afterID, err
+		}
+		for _, event := range events {
+			if err := writeSSE(w, "", strconv.FormatInt(event.ID, 10), event); err != nil {
+				return afterID, err
+			}
+			afterID = event.ID
+		}
+		if len(events) < sseReplayBatchSize {
+			return afterID, nil
+		}
+	}
+}
+
+// lastEventID membaca posisi resume dari header Last-Event-ID, atau query parameter last_event_id
+// untuk polyfill EventSource yang tidak bisa mengirim header. Tanpa keduanya hasilnya 0.
+func lastEventID(r *http.Request) (int64, error) {
+	raw := r.Header.Get("Last-Event-ID")
+	if raw == "" {
+		raw = r.URL.Query().Get("last_event_id")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		return 0, errors.New("invalid last event id")
+	}
+	return id, nil
+}
+
+// writeSSE menulis satu pesan SSE. event kosong berarti pesan "message" biasa, dan id kosong
+// tidak mengubah Last-Event-ID di klien. data di-encode sebagai JSON satu baris.
+func writeSSE(w http.ResponseWriter, event, id string, data any) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if event != "" {
+		if _, err := fmt.Fprintf(w, "event: %s\n", event); err != nil {
+			return err
+		}
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", body)
+	return err
+}
@@ -0,0 +1,42 @@
+// file: backend/services/task-service/internal/interfaces/rest/feature_handler.go
+package rest
+
+import (
+	"maps"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// FeatureHandler menangani endpoint feature flag, yang dibaca frontend untuk menyalakan atau
+// mematikan fitur tanpa deploy ulang. Flag bisa diganti lewat SetFlags saat service berjalan.
+type FeatureHandler struct {
+	flags atomic.Pointer[map[string]bool]
+}
+
+// NewFeatureHandler adalah constructor untuk FeatureHandler.
+func NewFeatureHandler(flags map[string]bool) *FeatureHandler {
+	h := &FeatureHandler{}
+	h.SetFlags(flags)
+	return h
+}
+
+// SetFlags mengganti seluruh feature flag; flag yang tidak disebut dianggap mati.
+func (h *FeatureHandler) SetFlags(flags map[string]bool) {
+	flags = maps.Clone(flags)
+	if flags == nil {
+		flags = map[string]bool{}
+	}
+	h.flags.Store(&flags)
+}
+
+// RegisterRoutes mendaftarkan route feature flag.
+func (h *FeatureHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/features", h.list)
+}
+
+func (h *FeatureHandler) list(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.FeatureFlagsResponse{Features: *h.flags.Load()})
+}
@@ -0,0 +1,80 @@
+// file: backend/services/task-service/internal/interfaces/rest/google_calendar_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// GoogleCalendarHandler menangani koneksi sinkronisasi Google Calendar.
+type GoogleCalendarHandler struct {
+	googleCalendarService application.GoogleCalendarApplicationService
+}
+
+// NewGoogleCalendarHandler adalah constructor untuk GoogleCalendarHandler.
+func NewGoogleCalendarHandler(googleCalendarService application.GoogleCalendarApplicationService) *GoogleCalendarHandler {
+	return &GoogleCalendarHandler{googleCalendarService: googleCalendarService}
+}
+
+// RegisterRoutes mendaftarkan route Google Calendar. Route ini membutuhkan pengguna terautentikasi.
+func (h *GoogleCalendarHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/integrations/google-calendar", h.get)
+	mux.HandleFunc("PUT /api/v1/integrations/google-calendar", h.connect)
+	mux.HandleFunc("DELETE /api/v1/integrations/google-calendar", h.disconnect)
+	mux.HandleFunc("POST /api/v1/integrations/google-calendar/sync", h.sync)
+}
+
+func (h *GoogleCalendarHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	conn, err := h.googleCalendarService.GetConnection(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewGoogleCalendarConnectionResponse(conn))
+}
+
+// connect mengganti koneksi setelah token terverifikasi bisa mengakses kalender.
+func (h *GoogleCalendarHandler) connect(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.GoogleCalendarConnectionRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	conn, err := h.googleCalendarService.Connect(r.Context(), userID, application.ConnectGoogleCalendarInput{
+		AccessToken:  req.AccessToken,
+		RefreshToken: req.RefreshToken,
+		ExpiresAt:    req.ExpiresAt,
+		CalendarID:   req.CalendarID,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewGoogleCalendarConnectionResponse(conn))
+}
+
+func (h *GoogleCalendarHandler) disconnect(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.googleCalendarService.Disconnect(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sync menjalankan rekonsiliasi sekarang; hasilnya dilaporkan lewat last_error, bukan status HTTP.
+func (h *GoogleCalendarHandler) sync(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	conn, err := h.googleCalendarService.Sync(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewGoogleCalendarConnectionResponse(conn))
+}
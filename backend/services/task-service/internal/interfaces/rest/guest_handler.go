@@ -0,0 +1,70 @@
+// file: backend/services/task-service/internal/interfaces/rest/guest_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// GuestHandler menangani pembuatan token tamu dan penggabungan task tamu ke akun pengguna.
+type GuestHandler struct {
+	guestService application.GuestApplicationService
+	rateLimit    *RateLimitConfig
+}
+
+// NewGuestHandler adalah constructor untuk GuestHandler. rateLimit membatasi pembuatan tamu per IP
+// klien dengan RateLimits.Guests; nil berarti tanpa batas.
+func NewGuestHandler(guestService application.GuestApplicationService, rateLimit *RateLimitConfig) *GuestHandler {
+	return &GuestHandler{
+		guestService: guestService,
+		rateLimit:    rateLimit,
+	}
+}
+
+// RegisterPublicRoutes mendaftarkan route pembuatan tamu, yang dipanggil sebelum klien punya
+// access token, sehingga dibatasi per IP klien, bukan per pengguna.
+func (h *GuestHandler) RegisterPublicRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/guests", clientRateLimit(h.rateLimit, "guests", guestRateLimit, http.HandlerFunc(h.create)))
+}
+
+func guestRateLimit(limits RateLimits) domain.RateLimit {
+	return limits.Guests
+}
+
+// RegisterRoutes mendaftarkan route penggabungan. Route dibungkus auth.RequireSession, sehingga
+// hanya pengguna yang login langsung (bukan tamu atau personal access token) yang bisa mengambil
+// task tamu.
+func (h *GuestHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/me/guest-merges", auth.RequireSession(http.HandlerFunc(h.merge)))
+}
+
+// create menerbitkan ID dan token tamu baru. Response berisi token, sehingga tidak boleh di-cache.
+func (h *GuestHandler) create(w http.ResponseWriter, r *http.Request) {
+	session, err := h.guestService.CreateGuest(r.Context())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, dto.NewGuestSessionResponse(session))
+}
+
+// merge memindahkan task tamu ke pengguna yang sedang login.
+func (h *GuestHandler) merge(w http.ResponseWriter, r *http.Request) {
+	var req dto.GuestMergeRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	userID, _ := auth.UserIDFromContext(r.Context())
+	merge, err := h.guestService.Merge(r.Context(), userID, req.GuestToken)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewGuestMergeResponse(merge))
+}
@@ -0,0 +1,92 @@
+// file: backend/services/task-service/internal/interfaces/rest/health_handler.go
+package rest
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// readinessTimeout adalah batas waktu semua pemeriksaan readiness dalam satu request /readyz.
+const readinessTimeout = 2 * time.Second
+
+// ReadinessCheck adalah satu dependensi yang harus siap sebelum replika menerima traffic.
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// HealthHandler menangani probe liveness dan readiness.
+type HealthHandler struct {
+	checks       []ReadinessCheck
+	shuttingDown atomic.Bool
+}
+
+// NewHealthHandler adalah constructor untuk HealthHandler.
+func NewHealthHandler(checks ...ReadinessCheck) *HealthHandler {
+	return &HealthHandler{
+		checks: checks,
+	}
+}
+
+// RegisterRoutes mendaftarkan route probe. Route ini tidak membutuhkan autentikasi.
+func (h *HealthHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /livez", h.live)
+	mux.HandleFunc("GET /readyz", h.ready)
+	mux.HandleFunc("GET /health", h.live) // Nama lama, dipertahankan untuk probe yang sudah ada
+}
+
+// SetShuttingDown membuat /readyz gagal agar load balancer berhenti mengirim request baru.
+// Dipanggil di awal shutdown; /livez tetap berhasil sampai proses berhenti.
+func (h *HealthHandler) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// live hanya menandakan proses berjalan dan bisa melayani HTTP; dependensi tidak diperiksa agar
+// gangguan database tidak membuat orchestrator me-restart semua replika.
+func (h *HealthHandler) live(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.HealthResponse{Status: dto.HealthStatusOK})
+}
+
+// ready menjalankan semua pemeriksaan secara paralel. Detail error hanya dicatat di log, karena
+// endpoint ini publik.
+func (h *HealthHandler) ready(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	if h.shuttingDown.Load() {
+		writeJSON(w, http.StatusServiceUnavailable, dto.HealthResponse{Status: dto.HealthStatusShuttingDown})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+	results := make([]error, len(h.checks))
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = check.Check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	resp := dto.HealthResponse{Status: dto.HealthStatusOK, Checks: make(map[string]string, len(h.checks))}
+	status := http.StatusOK
+	for i, check := range h.checks {
+		if err := results[i]; err != nil {
+			slog.WarnContext(r.Context(), "readiness check failed", "check", check.Name, "error", err)
+			resp.Checks[check.Name] = dto.HealthStatusFailing
+			resp.Status = dto.HealthStatusFailing
+			status = http.StatusServiceUnavailable
+			continue
+		}
+		resp.Checks[check.Name] = dto.HealthStatusOK
+	}
+	writeJSON(w, status, resp)
+}
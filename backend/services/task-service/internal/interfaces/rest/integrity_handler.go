@@ -0,0 +1,59 @@
+// file: backend/services/task-service/internal/interfaces/rest/integrity_handler.go
+package rest
+
+import (
+	"expvar"
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// IntegrityHandler menangani endpoint admin untuk pemeriksaan integritas data.
+type IntegrityHandler struct {
+	integrityService application.IntegrityApplicationService
+}
+
+// NewIntegrityHandler adalah constructor untuk IntegrityHandler.
+func NewIntegrityHandler(integrityService application.IntegrityApplicationService) *IntegrityHandler {
+	return &IntegrityHandler{
+		integrityService: integrityService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route integritas. Semua route dibungkus auth.RequirePermission.
+// /api/v1/admin/debug/vars menampilkan metrics expvar, termasuk integrity_findings.
+func (h *IntegrityHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/admin/integrity", auth.RequirePermission(domain.PermissionViewSystemStats, http.HandlerFunc(h.lastReport)))
+	mux.Handle("POST /api/v1/admin/integrity/run", auth.RequirePermission(domain.PermissionManageSystem, http.HandlerFunc(h.run)))
+	mux.Handle("GET /api/v1/admin/debug/vars", auth.RequirePermission(domain.PermissionViewSystemStats, expvar.Handler()))
+}
+
+// lastReport mengembalikan laporan run terakhir (periodik atau manual) pada replika ini.
+func (h *IntegrityHandler) lastReport(w http.ResponseWriter, r *http.Request) {
+	report, ok := h.integrityService.LastReport()
+	if !ok {
+		writeProblem(w, http.StatusNotFound, "integrity checks have not run yet")
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewIntegrityReportResponse(report))
+}
+
+// run menjalankan pemeriksaan saat itu juga; dengan "repair": true anomali langsung diperbaiki.
+func (h *IntegrityHandler) run(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.IntegrityRunRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	report, err := h.integrityService.RunChecksAsAdmin(r.Context(), adminID, req.Reason, req.Repair)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewIntegrityReportResponse(report))
+}
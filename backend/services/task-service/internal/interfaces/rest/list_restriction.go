@@ -0,0 +1,35 @@
+// file: backend/services/task-service/internal/interfaces/rest/list_restriction.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+// listRestriction menolak request (403) dengan personal access token yang dibatasi ke daftar
+// tertentu atau dengan token tamu, kecuali route tujuannya di mux ditandai auth.ListScoped atau
+// auth.RequireList. Route lain, seperti pencarian, statistik, atau pengaturan akun, bisa membaca
+// data di luar daftar tersebut. mux nil berarti semua request dengan token seperti itu ditolak.
+// Harus dipasang setelah middleware autentikasi.
+func listRestriction(mux *http.ServeMux, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := auth.PersonalAccessTokenFromContext(r.Context())
+		guest := auth.IsGuest(r.Context())
+		if !guest && (!ok || len(token.Lists) == 0) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if mux != nil {
+			if h, _ := mux.Handler(r); auth.IsListScoped(h) {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+		if guest {
+			writeProblemCode(w, http.StatusForbidden, "guest_forbidden", "guest tokens cannot use this endpoint")
+			return
+		}
+		writeProblemCode(w, http.StatusForbidden, "token_list_forbidden", "tokens restricted to lists cannot use this endpoint")
+	})
+}
@@ -0,0 +1,112 @@
+// file: backend/services/task-service/internal/interfaces/rest/list_share_handler.go
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// ListShareHandler menangani endpoint berbagi daftar task dengan kolaborator.
+type ListShareHandler struct {
+	shareService application.ListShareApplicationService
+	taskService  application.TaskApplicationService
+}
+
+// NewListShareHandler adalah constructor untuk ListShareHandler.
+func NewListShareHandler(shareService application.ListShareApplicationService, taskService application.TaskApplicationService) *ListShareHandler {
+	return &ListShareHandler{
+		shareService: shareService,
+		taskService:  taskService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route berbagi daftar. Route ini membutuhkan pengguna terautentikasi.
+// Task di daftar bersama diubah lewat endpoint /api/v1/tasks/{id} biasa. Akses kolaborator tetap
+// berlaku setelah token yang memberikannya dicabut, sehingga share dan unshare dibungkus
+// auth.RequireSession agar personal access token tidak bisa membuka daftar ke akun lain.
+func (h *ListShareHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/collaborators", h.listCollaborators)
+	mux.Handle("PUT /api/v1/me/collaborators/{userID}", auth.RequireSession(http.HandlerFunc(h.share)))
+	mux.Handle("DELETE /api/v1/me/collaborators/{userID}", auth.RequireSession(http.HandlerFunc(h.unshare)))
+	mux.HandleFunc("GET /api/v1/shared-lists", h.listShared)
+	mux.HandleFunc("DELETE /api/v1/shared-lists/{ownerID}", h.leave)
+	mux.Handle("GET /api/v1/shared-lists/{ownerID}/tasks", auth.ListScoped(http.HandlerFunc(h.listTasks)))
+}
+
+func (h *ListShareHandler) listCollaborators(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	shares, err := h.shareService.ListCollaborators(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewListShareResponses(shares))
+}
+
+// share memberi atau mengubah akses kolaborator ke daftar pengguna.
+func (h *ListShareHandler) share(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.ShareListRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	share, err := h.shareService.ShareList(r.Context(), userID, domain.UserID(r.PathValue("userID")), domain.ListAccess(req.Access))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewListShareResponse(share))
+}
+
+func (h *ListShareHandler) unshare(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.shareService.UnshareList(r.Context(), userID, domain.UserID(r.PathValue("userID"))); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listShared mengembalikan daftar milik pengguna lain yang dibagikan kepada pengguna.
+func (h *ListShareHandler) listShared(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	shares, err := h.shareService.ListSharedWithMe(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewListShareResponses(shares))
+}
+
+// leave mengeluarkan pengguna dari daftar bersama milik ownerID.
+func (h *ListShareHandler) leave(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.shareService.UnshareList(r.Context(), domain.UserID(r.PathValue("ownerID")), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listTasks mengembalikan task di daftar bersama, dengan parameter sort dan locale yang sama
+// dengan GET /api/v1/tasks. Task yang diarsipkan dan yang masih di-snooze tidak ikut.
+func (h *ListShareHandler) listTasks(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	tasks, err := h.taskService.GetSharedTasks(r.Context(), userID, domain.UserID(r.PathValue("ownerID")), domain.TaskOrder{
+		Sort:          domain.TaskSort(r.URL.Query().Get("sort")),
+		Locale:        requestLocale(r),
+		HideSnoozedAt: time.Now(),
+		HideArchived:  true,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewTaskResponses(tasks))
+}
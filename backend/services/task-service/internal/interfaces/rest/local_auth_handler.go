@@ -0,0 +1,110 @@
+// file: backend/services/task-service/internal/interfaces/rest/local_auth_handler.go
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// LocalAuthHandler menangani signup, login, refresh, logout, dan buka kunci akun pada mode
+// autentikasi lokal.
+type LocalAuthHandler struct {
+	authService application.LocalAuthApplicationService
+}
+
+// NewLocalAuthHandler adalah constructor untuk LocalAuthHandler.
+func NewLocalAuthHandler(authService application.LocalAuthApplicationService) *LocalAuthHandler {
+	return &LocalAuthHandler{
+		authService: authService,
+	}
+}
+
+// RegisterPublicRoutes mendaftarkan route autentikasi lokal, yang dipanggil sebelum klien punya
+// access token.
+func (h *LocalAuthHandler) RegisterPublicRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/auth/signup", h.signUp)
+	mux.HandleFunc("POST /api/v1/auth/login", h.login)
+	mux.HandleFunc("POST /api/v1/auth/refresh", h.refresh)
+	mux.HandleFunc("POST /api/v1/auth/logout", h.logout)
+	mux.HandleFunc("POST /api/v1/auth/unlock", h.unlock)
+}
+
+// signUp membuat akun lalu mengembalikan token seperti login.
+func (h *LocalAuthHandler) signUp(w http.ResponseWriter, r *http.Request) {
+	var req dto.LocalAuthCredentialsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	session, err := h.authService.SignUp(r.Context(), req.Email, req.Password)
+	h.writeSession(w, r, http.StatusCreated, session, err)
+}
+
+// login menukar email dan password dengan access token dan refresh token.
+func (h *LocalAuthHandler) login(w http.ResponseWriter, r *http.Request) {
+	var req dto.LocalAuthCredentialsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	session, err := h.authService.Login(r.Context(), req.Email, req.Password)
+	h.writeSession(w, r, http.StatusOK, session, err)
+}
+
+// refresh menukar refresh token dengan pasangan token baru; refresh token lama tidak berlaku lagi.
+func (h *LocalAuthHandler) refresh(w http.ResponseWriter, r *http.Request) {
+	var req dto.RefreshTokenRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	session, err := h.authService.Refresh(r.Context(), req.RefreshToken)
+	h.writeSession(w, r, http.StatusOK, session, err)
+}
+
+// logout mencabut refresh token; selalu 204 untuk token yang tidak dikenal.
+func (h *LocalAuthHandler) logout(w http.ResponseWriter, r *http.Request) {
+	var req dto.RefreshTokenRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if err := h.authService.Logout(r.Context(), req.RefreshToken); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// unlock membuka kunci akun dengan token dari email buka kunci.
+func (h *LocalAuthHandler) unlock(w http.ResponseWriter, r *http.Request) {
+	var req dto.UnlockAccountRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if err := h.authService.Unlock(r.Context(), req.Token); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeSession menulis session atau err. Response berisi token, sehingga tidak boleh di-cache.
+// Login yang di-throttle mendapat header Retry-After.
+func (h *LocalAuthHandler) writeSession(w http.ResponseWriter, r *http.Request, status int, session *domain.AuthSession, err error) {
+	if err != nil {
+		var throttled *domain.LoginThrottledError
+		if errors.As(err, &throttled) {
+			w.Header().Set("Retry-After", ceilSeconds(throttled.RetryAfter))
+		}
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, status, dto.NewAuthSessionResponse(session))
+}
@@ -0,0 +1,37 @@
+// file: backend/services/task-service/internal/interfaces/rest/locale.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// requestLocale mengembalikan bahasa pilihan klien: query parameter locale jika ada, jika tidak
+// bahasa dengan bobot q tertinggi di header Accept-Language. Kosong jika klien tidak menyebutkan bahasa.
+func requestLocale(r *http.Request) string {
+	if locale := strings.TrimSpace(r.URL.Query().Get("locale")); locale != "" {
+		return locale
+	}
+
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > bestQ {
+			best, bestQ = tag, q
+		}
+	}
+	return best
+}
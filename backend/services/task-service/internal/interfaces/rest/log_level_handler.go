@@ -0,0 +1,86 @@
+// file: backend/services/task-service/internal/interfaces/rest/log_level_handler.go
+package rest
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/logging"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// LogLevelHandler menangani endpoint admin untuk mengubah level log saat service berjalan.
+// Perubahan hanya berlaku di instance yang menerima request.
+type LogLevelHandler struct {
+	level *logging.Level
+}
+
+// NewLogLevelHandler adalah constructor untuk LogLevelHandler.
+func NewLogLevelHandler(level *logging.Level) *LogLevelHandler {
+	return &LogLevelHandler{
+		level: level,
+	}
+}
+
+// RegisterRoutes mendaftarkan route level log. Semua route dibungkus auth.RequirePermission.
+func (h *LogLevelHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/admin/log-level", auth.RequirePermission(domain.PermissionManageSystem, http.HandlerFunc(h.get)))
+	mux.Handle("PUT /api/v1/admin/log-level", auth.RequirePermission(domain.PermissionManageSystem, http.HandlerFunc(h.update)))
+}
+
+func (h *LogLevelHandler) get(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, h.response())
+}
+
+// update mengganti level log; dengan duration, level kembali ke LOG_LEVEL setelah waktu tersebut.
+func (h *LogLevelHandler) update(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateLogLevelRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	var level slog.Level
+	switch strings.ToLower(req.Level) {
+	case "debug":
+		level = slog.LevelDebug
+	case "info":
+		level = slog.LevelInfo
+	case "warn":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	default:
+		writeProblem(w, http.StatusBadRequest, "level must be debug, info, warn, or error")
+		return
+	}
+	var duration time.Duration
+	if req.Duration != "" {
+		var err error
+		duration, err = time.ParseDuration(req.Duration)
+		if err != nil || duration <= 0 {
+			writeProblem(w, http.StatusBadRequest, "duration must be a positive duration such as 15m")
+			return
+		}
+	}
+	h.level.Set(level, duration)
+	// Warn agar perubahan tetap tercatat meskipun level baru lebih tinggi dari info.
+	slog.WarnContext(r.Context(), "log level changed", "level", level.String(), "duration", duration.String())
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, h.response())
+}
+
+func (h *LogLevelHandler) response() dto.LogLevelResponse {
+	resp := dto.LogLevelResponse{
+		Level:   strings.ToLower(h.level.Level().String()),
+		Default: strings.ToLower(h.level.Base().String()),
+	}
+	if expiresAt := h.level.ExpiresAt(); !expiresAt.IsZero() {
+		resp.ExpiresAt = &expiresAt
+	}
+	return resp
+}
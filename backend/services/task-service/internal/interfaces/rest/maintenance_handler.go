@@ -0,0 +1,93 @@
+// file: backend/services/task-service/internal/interfaces/rest/maintenance_handler.go
+package rest
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// MaintenanceHandler menangani endpoint admin mode maintenance dan menolak request write selama
+// maintenance aktif.
+type MaintenanceHandler struct {
+	maintenanceService application.MaintenanceApplicationService
+}
+
+// NewMaintenanceHandler adalah constructor untuk MaintenanceHandler.
+func NewMaintenanceHandler(maintenanceService application.MaintenanceApplicationService) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route maintenance. Semua route dibungkus auth.RequirePermission.
+func (h *MaintenanceHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/admin/maintenance", auth.RequirePermission(domain.PermissionManageSystem, http.HandlerFunc(h.get)))
+	mux.Handle("PUT /api/v1/admin/maintenance", auth.RequirePermission(domain.PermissionManageSystem, http.HandlerFunc(h.update)))
+}
+
+// WriteGuard menolak request write (lihat isWriteRequest) dengan 503 dan Retry-After selama
+// maintenance aktif; request baca tetap dilayani. Route admin tetap diizinkan agar maintenance
+// bisa dimatikan, begitu juga login, refresh, dan logout akun lokal (agar admin bisa login) dan
+// /graphql yang hanya berisi query. Status dibaca dari cache
+// MaintenanceApplicationService, bukan dari database per request.
+func (h *MaintenanceHandler) WriteGuard(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		maintenance := h.maintenanceService.Current()
+		if !maintenance.Enabled || !isWriteRequest(r) ||
+			strings.HasPrefix(r.URL.Path, "/api/v1/admin/") || r.URL.Path == "/graphql" ||
+			(strings.HasPrefix(r.URL.Path, "/api/v1/auth/") && r.URL.Path != "/api/v1/auth/signup") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		err := domain.ErrMaintenance
+		if maintenance.Message != "" {
+			err = fmt.Errorf("%w: %s", domain.ErrMaintenance, maintenance.Message)
+		}
+		w.Header().Set("Retry-After", ceilSeconds(maintenance.RetryAfter))
+		writeError(w, r, err)
+	})
+}
+
+func (h *MaintenanceHandler) get(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewMaintenanceResponse(h.maintenanceService.Current()))
+}
+
+func (h *MaintenanceHandler) update(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.UpdateMaintenanceRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	var retryAfter time.Duration
+	if req.RetryAfter != "" {
+		var err error
+		retryAfter, err = time.ParseDuration(req.RetryAfter)
+		if err != nil || retryAfter <= 0 {
+			writeProblem(w, http.StatusBadRequest, "retry_after must be a positive duration such as 10m")
+			return
+		}
+	}
+	maintenance, err := h.maintenanceService.Set(r.Context(), adminID, application.MaintenanceInput{
+		Enabled:    req.Enabled,
+		Message:    req.Message,
+		RetryAfter: retryAfter,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	slog.WarnContext(r.Context(), "maintenance mode changed", "enabled", maintenance.Enabled, "updated_by", adminID)
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewMaintenanceResponse(maintenance))
+}
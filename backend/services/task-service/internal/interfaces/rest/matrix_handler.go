@@ -0,0 +1,68 @@
+// file: backend/services/task-service/internal/interfaces/rest/matrix_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// MatrixHandler menangani pengaturan integrasi Matrix.
+type MatrixHandler struct {
+	matrixService application.MatrixApplicationService
+}
+
+// NewMatrixHandler adalah constructor untuk MatrixHandler.
+func NewMatrixHandler(matrixService application.MatrixApplicationService) *MatrixHandler {
+	return &MatrixHandler{matrixService: matrixService}
+}
+
+// RegisterRoutes mendaftarkan route pengaturan Matrix. Route ini membutuhkan pengguna terautentikasi.
+func (h *MatrixHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/integrations/matrix", h.get)
+	mux.HandleFunc("PUT /api/v1/integrations/matrix", h.save)
+	mux.HandleFunc("DELETE /api/v1/integrations/matrix", h.delete)
+}
+
+func (h *MatrixHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	channel, err := h.matrixService.GetChannel(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewMatrixChannelResponse(channel))
+}
+
+// save mengganti pengaturan setelah room terverifikasi dan pesan percobaan terkirim.
+func (h *MatrixHandler) save(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.MatrixChannelRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	channel, err := h.matrixService.SaveChannel(r.Context(), userID, application.SaveMatrixChannelInput{
+		HomeserverURL: req.HomeserverURL,
+		AccessToken:   req.AccessToken,
+		Room:          req.Room,
+		EventTypes:    req.EventTypes,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewMatrixChannelResponse(channel))
+}
+
+func (h *MatrixHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.matrixService.DeleteChannel(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,77 @@
+// file: backend/services/task-service/internal/interfaces/rest/personal_access_token_handler.go
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// PersonalAccessTokenHandler menangani pengelolaan personal access token untuk script dan
+// integrasi pihak ketiga.
+type PersonalAccessTokenHandler struct {
+	tokenService application.PersonalAccessTokenApplicationService
+}
+
+// NewPersonalAccessTokenHandler adalah constructor untuk PersonalAccessTokenHandler.
+func NewPersonalAccessTokenHandler(tokenService application.PersonalAccessTokenApplicationService) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{
+		tokenService: tokenService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route personal access token. Semua route dibungkus
+// auth.RequireSession, sehingga token tidak bisa dipakai untuk membuat atau mencabut token lain.
+func (h *PersonalAccessTokenHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/me/tokens", auth.RequireSession(http.HandlerFunc(h.list)))
+	mux.Handle("POST /api/v1/me/tokens", auth.RequireSession(http.HandlerFunc(h.create)))
+	mux.Handle("DELETE /api/v1/me/tokens/{id}", auth.RequireSession(http.HandlerFunc(h.revoke)))
+}
+
+// list mengembalikan semua token pengguna tanpa nilai token-nya.
+func (h *PersonalAccessTokenHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	tokens, err := h.tokenService.ListTokens(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewPersonalAccessTokenResponses(tokens))
+}
+
+// create membuat token baru; nilai token hanya dikembalikan di response ini.
+func (h *PersonalAccessTokenHandler) create(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.PersonalAccessTokenRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	token, value, err := h.tokenService.CreateToken(r.Context(), userID, application.CreatePersonalAccessTokenInput{
+		Name:       req.Name,
+		Scopes:     req.Scopes,
+		Lists:      req.Lists,
+		AllowedIPs: req.AllowedIPs,
+		ExpiresIn:  time.Duration(req.ExpiresInDays) * 24 * time.Hour,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := dto.NewPersonalAccessTokenResponse(token)
+	resp.Token = value
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+func (h *PersonalAccessTokenHandler) revoke(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.tokenService.RevokeToken(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,126 @@
+// file: backend/services/task-service/internal/interfaces/rest/push_handler.go
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// maxPushSubscriptionBodySize membatasi body request langganan push perangkat.
+const maxPushSubscriptionBodySize = 8 << 10
+
+// PushHandler menangani pengaturan notifikasi push dan pendaftaran langganan push perangkat.
+type PushHandler struct {
+	pushService    application.PushApplicationService
+	vapidPublicKey string // Kosong jika Web Push tidak dikonfigurasi
+}
+
+// NewPushHandler adalah constructor untuk PushHandler.
+func NewPushHandler(pushService application.PushApplicationService, vapidPublicKey string) *PushHandler {
+	return &PushHandler{
+		pushService:    pushService,
+		vapidPublicKey: vapidPublicKey,
+	}
+}
+
+// RegisterRoutes mendaftarkan route push. Route ini membutuhkan pengguna terautentikasi.
+func (h *PushHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/integrations/push", h.get)
+	mux.HandleFunc("PUT /api/v1/integrations/push", h.save)
+	mux.HandleFunc("DELETE /api/v1/integrations/push", h.delete)
+	mux.HandleFunc("POST /api/v1/integrations/push/test", h.test)
+	mux.HandleFunc("GET /api/v1/integrations/push/vapid-key", h.vapidKey)
+	mux.HandleFunc("PUT /api/v1/me/devices/{id}/push", h.subscribe)
+	mux.HandleFunc("DELETE /api/v1/me/devices/{id}/push", h.unsubscribe)
+}
+
+func (h *PushHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	channel, err := h.pushService.GetChannel(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewPushChannelResponse(channel))
+}
+
+func (h *PushHandler) save(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.PushChannelRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	channel, err := h.pushService.SaveChannel(r.Context(), userID, application.SavePushChannelInput{
+		EventTypes:   req.EventTypes,
+		SharedLists:  req.SharedLists,
+		ReminderLead: time.Duration(req.ReminderMinutes) * time.Minute,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewPushChannelResponse(channel))
+}
+
+func (h *PushHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.pushService.DeleteChannel(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// test mengirim notifikasi percobaan ke semua perangkat pengguna yang berlangganan push.
+func (h *PushHandler) test(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	devices, err := h.pushService.SendTest(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.PushTestResponse{Devices: devices})
+}
+
+// vapidKey mengembalikan applicationServerKey untuk berlangganan Web Push di browser.
+func (h *PushHandler) vapidKey(w http.ResponseWriter, r *http.Request) {
+	if h.vapidPublicKey == "" {
+		writeError(w, r, domain.ErrPushNotConfigured)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.VAPIDKeyResponse{PublicKey: h.vapidPublicKey})
+}
+
+// subscribe menyimpan langganan push perangkat. Body adalah PushSubscription.toJSON() dari browser,
+// atau {"provider": "fcm", "token": "…"} untuk aplikasi mobile.
+func (h *PushHandler) subscribe(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var subscription json.RawMessage
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPushSubscriptionBodySize)).Decode(&subscription); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	device, err := h.pushService.RegisterSubscription(r.Context(), userID, r.PathValue("id"), subscription)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewDeviceResponse(device))
+}
+
+// unsubscribe menghapus langganan push perangkat tanpa mencabut perangkatnya.
+func (h *PushHandler) unsubscribe(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.pushService.RemoveSubscription(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
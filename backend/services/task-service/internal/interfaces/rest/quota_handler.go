@@ -0,0 +1,71 @@
+// file: backend/services/task-service/internal/interfaces/rest/quota_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// QuotaHandler menangani endpoint batas pemakaian (kuota) dan pengaturannya oleh admin.
+type QuotaHandler struct {
+	quotaService application.QuotaApplicationService
+}
+
+// NewQuotaHandler adalah constructor untuk QuotaHandler.
+func NewQuotaHandler(quotaService application.QuotaApplicationService) *QuotaHandler {
+	return &QuotaHandler{
+		quotaService: quotaService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route kuota. Route admin dibungkus auth.RequirePermission.
+func (h *QuotaHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/limits", h.limits)
+	mux.Handle("GET /api/v1/admin/plans/{plan}/quotas", auth.RequirePermission(domain.PermissionManageSystem, http.HandlerFunc(h.planPolicies)))
+	mux.Handle("PUT /api/v1/admin/plans/{plan}/quotas/{resource}", auth.RequirePermission(domain.PermissionManageSystem, http.HandlerFunc(h.updatePolicy)))
+}
+
+// limits mengembalikan pemakaian pengguna terhadap setiap kuota plan-nya beserta ambang peringatannya.
+func (h *QuotaHandler) limits(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	usages, err := h.quotaService.GetLimits(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewLimitsResponse(domain.PlanFromContext(r.Context()), usages))
+}
+
+func (h *QuotaHandler) planPolicies(w http.ResponseWriter, r *http.Request) {
+	policies, err := h.quotaService.GetPlanPolicies(r.Context(), domain.Plan(r.PathValue("plan")))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewQuotaPolicyResponses(policies))
+}
+
+// updatePolicy mengganti batas dan ambang peringatan kuota sebuah plan.
+func (h *QuotaHandler) updatePolicy(w http.ResponseWriter, r *http.Request) {
+	var req dto.QuotaPolicyRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	policy := domain.QuotaPolicy{
+		Plan:              domain.Plan(r.PathValue("plan")),
+		Resource:          domain.QuotaResource(r.PathValue("resource")),
+		Limit:             req.Limit,
+		WarningThresholds: req.WarningThresholds,
+	}
+	if err := h.quotaService.UpdatePolicy(r.Context(), policy); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewQuotaPolicyResponses([]domain.QuotaPolicy{policy})[0])
+}
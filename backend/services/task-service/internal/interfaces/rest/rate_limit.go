@@ -0,0 +1,146 @@
+// file: backend/services/task-service/internal/interfaces/rest/rate_limit.go
+package rest
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+// RateLimits adalah batas laju request per pengguna untuk route yang diautentikasi, ditambah batas
+// per IP klien untuk route publik yang menerbitkan kredensial.
+type RateLimits struct {
+	Read   domain.RateLimit // Request dengan scope tasks:read (GET dan HEAD)
+	Write  domain.RateLimit // Request dengan scope tasks:write
+	Guests domain.RateLimit // Pembuatan tamu (POST /api/v1/guests) per IP klien
+}
+
+// RateLimitConfig menggabungkan limiter dengan RateLimits yang berlaku. Batas bisa diganti lewat
+// SetLimits saat service berjalan, misalnya saat konfigurasi dimuat ulang.
+type RateLimitConfig struct {
+	limiter domain.RateLimiter
+	limits  atomic.Pointer[RateLimits]
+}
+
+// NewRateLimitConfig adalah constructor untuk RateLimitConfig.
+func NewRateLimitConfig(limiter domain.RateLimiter, limits RateLimits) *RateLimitConfig {
+	cfg := &RateLimitConfig{limiter: limiter}
+	cfg.limits.Store(&limits)
+	return cfg
+}
+
+// Limits mengembalikan batas yang sedang berlaku.
+func (c *RateLimitConfig) Limits() RateLimits {
+	return *c.limits.Load()
+}
+
+// SetLimits mengganti batas untuk request berikutnya.
+func (c *RateLimitConfig) SetLimits(limits RateLimits) {
+	c.limits.Store(&limits)
+}
+
+// rateLimit membatasi request per pengguna dan scope (lihat auth.RequiredScope). Personal access
+// token memakai kuota terpisah dari sesi login, sehingga script yang boros tidak menghabiskan
+// kuota aplikasi web. Harus dipasang setelah middleware autentikasi. Jika limiter gagal
+// (misalnya Redis tidak bisa dihubungi), request tetap dilayani. cfg nil berarti tanpa batas.
+func rateLimit(cfg *RateLimitConfig, next http.Handler) http.Handler {
+	if cfg == nil || cfg.limiter == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := auth.UserIDFromContext(r.Context())
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		scope := auth.RequiredScope(r)
+		limits := cfg.Limits()
+		limit := limits.Write
+		if scope == domain.ScopeTasksRead {
+			limit = limits.Read
+		}
+		if !limit.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		kind := "session"
+		if _, ok := auth.PersonalAccessTokenFromContext(r.Context()); ok {
+			kind = "pat"
+		}
+
+		result, err := cfg.limiter.Allow(r.Context(), string(userID)+":"+kind+":"+string(scope), limit)
+		if err != nil {
+			slog.WarnContext(r.Context(), "rate limiter unavailable, allowing request", "error", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+		w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		w.Header().Set("RateLimit-Reset", ceilSeconds(result.ResetAfter))
+		if !result.Allowed {
+			w.Header().Set("Retry-After", ceilSeconds(result.RetryAfter))
+			writeProblemCode(w, http.StatusTooManyRequests, "rate_limited", "rate limit exceeded for "+string(scope))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientRateLimit membatasi request per IP klien (lihat withClientIP dan TRUSTED_PROXIES) untuk
+// route publik yang belum punya pengguna. name membedakan kuota antar route, dan limit memilih
+// batasnya dari RateLimits yang berlaku. Seperti rateLimit, request tetap dilayani jika limiter
+// gagal, dan cfg nil berarti tanpa batas.
+func clientRateLimit(cfg *RateLimitConfig, name string, limit func(RateLimits) domain.RateLimit, next http.Handler) http.Handler {
+	if cfg == nil || cfg.limiter == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := limit(cfg.Limits())
+		if !current.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		result, err := cfg.limiter.Allow(r.Context(), "ip:"+clientRateLimitKey(domain.ClientIPFromContext(r.Context()))+":"+name, current)
+		if err != nil {
+			slog.WarnContext(r.Context(), "rate limiter unavailable, allowing request", "error", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(current.Requests))
+		w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		w.Header().Set("RateLimit-Reset", ceilSeconds(result.ResetAfter))
+		if !result.Allowed {
+			w.Header().Set("Retry-After", ceilSeconds(result.RetryAfter))
+			writeProblemCode(w, http.StatusTooManyRequests, "rate_limited", "rate limit exceeded for "+name)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientRateLimitKey mengelompokkan alamat IPv6 per prefix /64, karena satu klien biasanya
+// mendapat seluruh /64 dan bisa berganti alamat di dalamnya tanpa batas. Alamat IPv4 (termasuk
+// IPv4-mapped) dan nilai yang bukan IP dipakai apa adanya.
+func clientRateLimitKey(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || addr.Unmap().Is4() {
+		return ip
+	}
+	prefix, err := addr.Prefix(64)
+	if err != nil {
+		return ip
+	}
+	return prefix.String()
+}
+
+// ceilSeconds membulatkan d ke atas menjadi detik bulat, format header Retry-After.
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,249 @@
+// file: backend/services/task-service/internal/interfaces/rest/realtime_handler.go
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+const (
+	wsWriteWait    = 10 * time.Second    // Batas waktu menulis satu pesan
+	wsPongWait     = 60 * time.Second    // Koneksi dianggap putus jika tidak ada pong selama ini
+	wsPingPeriod   = wsPongWait * 9 / 10 // Interval ping, harus lebih pendek dari wsPongWait
+	wsMaxReadBytes = 512                 // Klien tidak mengirim data; hanya frame control yang dibaca
+	wsCloseWait    = 5 * time.Second     // Batas waktu menunggu balasan close frame saat shutdown
+)
+
+// RealtimeHandler menangani koneksi WebSocket /ws yang menerima TaskEvent milik pengguna secara
+// realtime dari change feed (lihat realtime.Hub).
+type RealtimeHandler struct {
+	events   domain.TaskEventSubscriber
+	verifier auth.TokenVerifier
+	upgrader websocket.Upgrader
+
+	mu      sync.Mutex
+	closed  bool
+	closing chan struct{} // Ditutup oleh Shutdown
+	conns   sync.WaitGroup
+}
+
+// NewRealtimeHandler adalah constructor untuk RealtimeHandler. verifier adalah autentikasi yang
+// sama dengan AuthMiddleware, untuk memeriksa ulang kredensial koneksi yang terbuka (lihat
+// streamCredential). allowedOrigins adalah origin (misalnya "https://app.example.com") yang boleh
+// membuka koneksi dari browser; kosong berarti hanya origin yang sama dengan host service.
+func NewRealtimeHandler(events domain.TaskEventSubscriber, verifier auth.TokenVerifier, allowedOrigins []string) *RealtimeHandler {
+	h := &RealtimeHandler{events: events, verifier: verifier, closing: make(chan struct{})}
+	if len(allowedOrigins) > 0 {
+		allowed := make(map[string]bool, len(allowedOrigins))
+		for _, origin := range allowedOrigins {
+			allowed[strings.TrimRight(origin, "/")] = true
+		}
+		h.upgrader.CheckOrigin = func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			if origin == "" || allowed[origin] {
+				return true
+			}
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		}
+	}
+	return h
+}
+
+// ServeHTTP meng-upgrade request ke WebSocket lalu mengirim setiap TaskEvent sebagai satu pesan
+// teks JSON. Jika klien terlalu lambat, koneksi ditutup dengan kode 1013 (try again later) dan
+// klien harus resync lewat /api/v1/sync sebelum terhubung kembali. Koneksi ditutup dengan kode
+// 1008 (policy violation) saat kredensialnya kedaluwarsa atau dicabut. Setelah Shutdown, koneksi
+// baru ditolak dengan 503.
+func (h *RealtimeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		writeProblemCode(w, http.StatusServiceUnavailable, "shutting_down", "server is shutting down")
+		return
+	}
+	h.conns.Add(1)
+	h.mu.Unlock()
+	defer h.conns.Done()
+
+	userID, _ := auth.UserIDFromContext(r.Context())
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // Upgrade sudah menulis response error
+	}
+	defer conn.Close()
+
+	events, cancel := h.events.Subscribe(userID)
+	defer cancel()
+	credential := newStreamCredential(r, h.verifier)
+	defer credential.Stop()
+
+	closed := make(chan struct{})
+	go readWebSocket(conn, closed)
+
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if !ok {
+				conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow, resync required"))
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			valid := credential.Valid()
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if !valid {
+				conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "credential revoked"))
+				return
+			}
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-credential.Expired():
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			conn.WriteMessage(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "credential expired"))
+			return
+		case <-h.closing:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			conn.WriteMessage(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"))
+			select {
+			case <-closed: // Klien membalas close frame
+			case <-time.After(wsCloseWait):
+			}
+			return
+		case <-closed:
+			return
+		}
+	}
+}
+
+// Shutdown mengirim close frame 1001 (going away) ke semua koneksi WebSocket, lalu menunggu klien
+// membalas dan koneksi ditutup, paling lama sampai ctx berakhir. Koneksi WebSocket sudah
+// di-hijack sehingga tidak ditunggu oleh http.Server.Shutdown.
+func (h *RealtimeHandler) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	if !h.closed {
+		h.closed = true
+		close(h.closing)
+	}
+	h.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.conns.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// readWebSocket membaca koneksi agar frame pong dan close diproses, lalu menutup closed saat
+// koneksi putus atau pong tidak datang tepat waktu.
+func readWebSocket(conn *websocket.Conn, closed chan<- struct{}) {
+	defer close(closed)
+	conn.SetReadLimit(wsMaxReadBytes)
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+	for {
+		if _, _, err := conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+// accessTokenQuery memindahkan query parameter access_token ke header Authorization jika header
+// tersebut kosong, karena WebSocket API dan EventSource di browser tidak bisa mengirim header.
+func accessTokenQuery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("access_token"); token != "" && r.Header.Get("Authorization") == "" {
+			r = r.Clone(r.Context())
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// streamCredential memeriksa ulang kredensial koneksi realtime (/ws dan /api/v1/events), yang
+// hanya diautentikasi AuthMiddleware saat koneksi dibuka. Tanpa itu koneksi tetap menerima event
+// setelah token kedaluwarsa, atau setelah sesi maupun personal access token-nya dicabut.
+type streamCredential struct {
+	verifier      auth.TokenVerifier
+	r             *http.Request
+	authorization string
+	userID        domain.UserID
+	expiry        *time.Timer // nil jika kredensial tidak kedaluwarsa
+}
+
+// newStreamCredential membaca kredensial r yang sudah diautentikasi. Timer kedaluwarsa harus
+// dihentikan dengan Stop.
+func newStreamCredential(r *http.Request, verifier auth.TokenVerifier) *streamCredential {
+	c := &streamCredential{
+		verifier:      verifier,
+		r:             r,
+		authorization: r.Header.Get("Authorization"),
+	}
+	c.userID, _ = auth.UserIDFromContext(r.Context())
+	if expiresAt, ok := auth.CredentialExpiresAt(r.Context()); ok {
+		c.expiry = time.NewTimer(time.Until(expiresAt))
+	}
+	return c
+}
+
+// Expired menerima nilai saat kredensial kedaluwarsa. Channel nil (tidak pernah menerima) jika
+// kredensial tidak kedaluwarsa.
+func (c *streamCredential) Expired() <-chan time.Time {
+	if c.expiry == nil {
+		return nil
+	}
+	return c.expiry.C
+}
+
+// Stop menghentikan timer kedaluwarsa.
+func (c *streamCredential) Stop() {
+	if c.expiry != nil {
+		c.expiry.Stop()
+	}
+}
+
+// Valid mengautentikasi ulang kredensial, termasuk pemeriksaan sesi yang dicabut dan personal
+// access token yang dihapus. Jika autentikasi sedang tidak tersedia (ErrAuthUnavailable),
+// koneksi dibiarkan dan diperiksa lagi pada pemanggilan berikutnya, agar gangguan sesaat tidak
+// memutus semua koneksi sekaligus.
+func (c *streamCredential) Valid() bool {
+	ctx, err := c.verifier.Authenticate(c.r.Context(), c.authorization)
+	if errors.Is(err, auth.ErrAuthUnavailable) {
+		slog.WarnContext(c.r.Context(), "realtime: credential check unavailable, keeping connection", "error", err)
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	userID, _ := auth.UserIDFromContext(ctx)
+	return userID == c.userID
+}
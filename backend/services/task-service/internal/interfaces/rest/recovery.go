@@ -0,0 +1,67 @@
+// file: backend/services/task-service/internal/interfaces/rest/recovery.go
+package rest
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/errorreport"
+	"github.com/felixge/httpsnoop"
+)
+
+// recoverPanic mengubah panic di handler menjadi response 500 problem+json, mencatat stack trace
+// dengan request ID, dan mengirimnya ke reporter. Jika response sudah mulai ditulis, koneksi
+// hanya diputus karena status tidak bisa diubah lagi. http.ErrAbortHandler diteruskan apa adanya.
+func recoverPanic(reporter errorreport.Reporter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		written := false
+		// httpsnoop mempertahankan interface seperti http.Flusher dan http.Hijacker milik w,
+		// sehingga SSE dan WebSocket tetap berfungsi.
+		w = httpsnoop.Wrap(w, httpsnoop.Hooks{
+			WriteHeader: func(next httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+				return func(code int) {
+					written = true
+					next(code)
+				}
+			},
+			Write: func(next httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+				return func(b []byte) (int, error) {
+					written = true
+					return next(b)
+				}
+			},
+		})
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+			route := r.Pattern
+			if info, ok := r.Context().Value(requestLogInfoKey{}).(*requestLogInfo); ok {
+				route = info.r.Pattern // Pattern mux bertingkat, lihat requestLogger
+			}
+			report := errorreport.Report{
+				Value:     recovered,
+				Stack:     debug.Stack(),
+				Frames:    errorreport.Frames(),
+				Method:    r.Method,
+				Route:     route,
+				URL:       r.URL.Path,
+				RequestID: domain.RequestIDFromContext(r.Context()),
+			}
+			slog.ErrorContext(r.Context(), "panic recovered", "panic", fmt.Sprint(recovered), "stack", string(report.Stack))
+			reporter.Report(r.Context(), report)
+			if written {
+				panic(http.ErrAbortHandler) // Response sudah sebagian terkirim; putus koneksi tanpa log ulang
+			}
+			writeProblemCode(w, http.StatusInternalServerError, "internal_error", "internal server error")
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
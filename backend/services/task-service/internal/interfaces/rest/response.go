@@ -0,0 +1,228 @@
+// file: backend/services/task-service/internal/interfaces/rest/response.go
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// problem adalah body error dengan format RFC 7807 (application/problem+json).
+type problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Code   string `json:"code,omitempty"` // Kode error stabil, lihat errorMapping
+	Detail string `json:"detail,omitempty"`
+
+	// RequestID adalah ID korelasi request (header X-Request-ID) untuk dilaporkan ke support.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// writeJSON menulis v sebagai JSON dengan status yang diberikan.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("error encoding response", "error", err)
+	}
+}
+
+// writeJSONList menulis daftar items seperti writeJSON dan mengisi X-Total-Count dengan jumlah
+// item, sehingga daftar yang tidak dipaginasi (termasuk lewat HEAD) membawa jumlah item. Daftar
+// dengan jumlah total yang berbeda dari isi body mengisi header sendiri lalu memakai writeJSON.
+func writeJSONList[T any](w http.ResponseWriter, status int, items []T) {
+	w.Header().Set(headerTotalCount, strconv.Itoa(len(items)))
+	writeJSON(w, status, items)
+}
+
+// writeProblem menulis response error dalam format problem+json.
+func writeProblem(w http.ResponseWriter, status int, detail string) {
+	writeProblemCode(w, status, "", detail)
+}
+
+// writeProblemCode sama dengan writeProblem, ditambah kode error stabil.
+func writeProblemCode(w http.ResponseWriter, status int, code, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(status),
+		Status:    status,
+		Code:      code,
+		Detail:    detail,
+		RequestID: w.Header().Get(domain.HeaderRequestID), // Dipasang oleh withRequestID
+	}); err != nil {
+		slog.Error("error encoding problem response", "error", err)
+	}
+}
+
+// errorMapping memetakan error domain ke status HTTP dan kode error stabil. Kode dikirim ke klien
+// di field code (problem+json dan hasil batch), sehingga klien tidak perlu mencocokkan pesan error.
+var errorMapping = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{domain.ErrTaskNotFound, http.StatusNotFound, "task_not_found"},
+	{domain.ErrArchiveNotFound, http.StatusNotFound, "archive_not_found"},
+	{domain.ErrRevisionNotFound, http.StatusNotFound, "revision_not_found"},
+	{domain.ErrAttachmentNotFound, http.StatusNotFound, "attachment_not_found"},
+	{domain.ErrCommentNotFound, http.StatusNotFound, "comment_not_found"},
+	{domain.ErrDataExportNotFound, http.StatusNotFound, "data_export_not_found"},
+	{domain.ErrAccountDeletionNotFound, http.StatusNotFound, "account_deletion_not_found"},
+	{domain.ErrWebhookNotFound, http.StatusNotFound, "webhook_not_found"},
+	{domain.ErrDiscordChannelNotFound, http.StatusNotFound, "discord_channel_not_found"},
+	{domain.ErrMatrixChannelNotFound, http.StatusNotFound, "matrix_channel_not_found"},
+	{domain.ErrGoogleCalendarNotConnected, http.StatusNotFound, "google_calendar_not_connected"},
+	{domain.ErrEmailChannelNotFound, http.StatusNotFound, "email_channel_not_found"},
+	{domain.ErrPushChannelNotFound, http.StatusNotFound, "push_channel_not_found"},
+	{domain.ErrSlackChannelNotFound, http.StatusNotFound, "slack_channel_not_found"},
+	{domain.ErrTimerNotRunning, http.StatusNotFound, "timer_not_running"},
+	{domain.ErrBoardColumnNotFound, http.StatusNotFound, "board_column_not_found"},
+	{domain.ErrDeviceNotFound, http.StatusNotFound, "device_not_found"},
+	{domain.ErrEnumNotFound, http.StatusNotFound, "enum_not_found"},
+	{domain.ErrTaskCallbackNotFound, http.StatusNotFound, "task_callback_not_found"},
+	{domain.ErrWorkspaceMemberNotFound, http.StatusNotFound, "workspace_member_not_found"},
+	{domain.ErrWorkspaceGroupNotFound, http.StatusNotFound, "workspace_group_not_found"},
+	{domain.ErrListShareNotFound, http.StatusNotFound, "list_share_not_found"},
+	{domain.ErrInvalidCalendarFeedToken, http.StatusNotFound, "calendar_feed_not_found"},
+	{domain.ErrPersonalAccessTokenNotFound, http.StatusNotFound, "personal_access_token_not_found"},
+	{domain.ErrUserSessionNotFound, http.StatusNotFound, "session_not_found"},
+	{domain.ErrLocalAuthNotConfigured, http.StatusNotFound, "local_auth_not_configured"},
+	{domain.ErrGuestModeDisabled, http.StatusNotFound, "guest_mode_disabled"},
+	{domain.ErrTaskTitleRequired, http.StatusBadRequest, "title_required"},
+	{domain.ErrInvalidTaskID, http.StatusBadRequest, "invalid_task_id"},
+	{domain.ErrInvalidCursor, http.StatusBadRequest, "invalid_cursor"},
+	{domain.ErrInvalidTaskSort, http.StatusBadRequest, "invalid_sort"},
+	{domain.ErrInvalidTaskGroupBy, http.StatusBadRequest, "invalid_group_by"},
+	{domain.ErrInvalidReorder, http.StatusBadRequest, "invalid_reorder"},
+	{domain.ErrInvalidEstimate, http.StatusBadRequest, "invalid_estimate"},
+	{domain.ErrInvalidSnooze, http.StatusBadRequest, "invalid_snooze"},
+	{domain.ErrInvalidBoardColumn, http.StatusBadRequest, "invalid_board_column"},
+	{domain.ErrInvalidQuotaPolicy, http.StatusBadRequest, "invalid_quota_policy"},
+	{domain.ErrSearchQueryTooShort, http.StatusBadRequest, "search_query_too_short"},
+	{domain.ErrSearchQueryEmpty, http.StatusBadRequest, "search_query_empty"},
+	{domain.ErrAuditReasonRequired, http.StatusBadRequest, "audit_reason_required"},
+	{domain.ErrInvalidSearchType, http.StatusBadRequest, "invalid_search_type"},
+	{domain.ErrInvalidSecurityEventType, http.StatusBadRequest, "invalid_security_event_type"},
+	{domain.ErrInvalidAttachment, http.StatusBadRequest, "invalid_attachment"},
+	{domain.ErrInvalidComment, http.StatusBadRequest, "invalid_comment"},
+	{domain.ErrInvalidWebhook, http.StatusBadRequest, "invalid_webhook"},
+	{domain.ErrInvalidTaskCallback, http.StatusBadRequest, "invalid_task_callback"},
+	{domain.ErrInvalidDiscordChannel, http.StatusBadRequest, "invalid_discord_channel"},
+	{domain.ErrInvalidMatrixChannel, http.StatusBadRequest, "invalid_matrix_channel"},
+	{domain.ErrInvalidGoogleCalendarConnection, http.StatusBadRequest, "invalid_google_calendar_connection"},
+	{domain.ErrInvalidEmailChannel, http.StatusBadRequest, "invalid_email_channel"},
+	{domain.ErrInvalidPushChannel, http.StatusBadRequest, "invalid_push_channel"},
+	{domain.ErrInvalidPushSubscription, http.StatusBadRequest, "invalid_push_subscription"},
+	{domain.ErrInvalidSlackChannel, http.StatusBadRequest, "invalid_slack_channel"},
+	{domain.ErrInvalidTodoistImport, http.StatusBadRequest, "invalid_todoist_import"},
+	{domain.ErrInvalidImportRow, http.StatusBadRequest, "invalid_import_row"},
+	{domain.ErrInvalidBackup, http.StatusBadRequest, "invalid_backup"},
+	{domain.ErrInvalidAgendaRange, http.StatusBadRequest, "invalid_agenda_range"},
+	{domain.ErrInvalidRetrospectiveMonth, http.StatusBadRequest, "invalid_month"},
+	{domain.ErrInvalidTimeZone, http.StatusBadRequest, "invalid_time_zone"},
+	{domain.ErrInvalidDueText, http.StatusBadRequest, "invalid_due_text"},
+	{domain.ErrInvalidAssignee, http.StatusBadRequest, "invalid_assignee"},
+	{domain.ErrInvalidTaskColor, http.StatusBadRequest, "invalid_task_color"},
+	{domain.ErrInvalidTaskIcon, http.StatusBadRequest, "invalid_task_icon"},
+	{domain.ErrInvalidDevice, http.StatusBadRequest, "invalid_device"},
+	{domain.ErrInvalidEnumValue, http.StatusBadRequest, "invalid_enum_value"},
+	{domain.ErrInvalidWorkspaceMember, http.StatusBadRequest, "invalid_workspace_member"},
+	{domain.ErrInvalidWorkspaceGroup, http.StatusBadRequest, "invalid_workspace_group"},
+	{domain.ErrInvalidWorkspaceRole, http.StatusBadRequest, "invalid_workspace_role"},
+	{domain.ErrInvalidRole, http.StatusBadRequest, "invalid_role"},
+	{domain.ErrInvalidScimFilter, http.StatusBadRequest, "invalid_scim_filter"},
+	{domain.ErrInvalidScimPatch, http.StatusBadRequest, "invalid_scim_patch"},
+	{domain.ErrInvalidWorkspaceConfig, http.StatusBadRequest, "invalid_workspace_config"},
+	{domain.ErrInvalidListShare, http.StatusBadRequest, "invalid_list_share"},
+	{domain.ErrInvalidPersonalAccessToken, http.StatusBadRequest, "invalid_personal_access_token"},
+	{domain.ErrInvalidUserLookup, http.StatusBadRequest, "invalid_user_lookup"},
+	{domain.ErrInvalidSignup, http.StatusBadRequest, "invalid_signup"},
+	{domain.ErrInvalidUnlockToken, http.StatusBadRequest, "invalid_unlock_token"},
+	{domain.ErrInvalidGuestToken, http.StatusBadRequest, "invalid_guest_token"},
+	{domain.ErrInvalidCredentials, http.StatusUnauthorized, "invalid_credentials"},
+	{domain.ErrInvalidRefreshToken, http.StatusUnauthorized, "invalid_refresh_token"},
+	{domain.ErrRefreshTokenReused, http.StatusUnauthorized, "refresh_token_reused"},
+	{domain.ErrAttachmentTooLarge, http.StatusRequestEntityTooLarge, "attachment_too_large"},
+	{domain.ErrTaskUpdateConflict, http.StatusConflict, "task_conflict"},
+	{domain.ErrTaskNotCompleted, http.StatusConflict, "task_not_completed"},
+	{domain.ErrTaskLimitReached, http.StatusConflict, "task_limit_reached"},
+	{domain.ErrWIPLimitReached, http.StatusConflict, "wip_limit_reached"},
+	{domain.ErrArchiveNotRestorable, http.StatusConflict, "archive_not_restorable"},
+	{domain.ErrAttachmentNotUploaded, http.StatusConflict, "attachment_not_uploaded"},
+	{domain.ErrWebhookLimitReached, http.StatusConflict, "webhook_limit_reached"},
+	{domain.ErrTaskCallbackLimitReached, http.StatusConflict, "task_callback_limit_reached"},
+	{domain.ErrTaskAlreadyCompleted, http.StatusConflict, "task_already_completed"},
+	{domain.ErrTimerAlreadyRunning, http.StatusConflict, "timer_already_running"},
+	{domain.ErrTooManyDevices, http.StatusConflict, "device_limit_reached"},
+	{domain.ErrBuiltinEnumValue, http.StatusConflict, "builtin_enum_value"},
+	{domain.ErrTooManyEnumValues, http.StatusConflict, "enum_value_limit_reached"},
+	{domain.ErrWorkspaceMemberExists, http.StatusConflict, "workspace_member_exists"},
+	{domain.ErrWorkspaceGroupExists, http.StatusConflict, "workspace_group_exists"},
+	{domain.ErrTooManyCollaborators, http.StatusConflict, "collaborator_limit_reached"},
+	{domain.ErrTooManyPersonalAccessTokens, http.StatusConflict, "personal_access_token_limit_reached"},
+	{domain.ErrEmailTaken, http.StatusConflict, "email_taken"},
+	{domain.ErrDataExportInProgress, http.StatusConflict, "data_export_in_progress"},
+	{domain.ErrDataExportNotReady, http.StatusConflict, "data_export_not_ready"},
+	{domain.ErrAccountDeletionInProgress, http.StatusConflict, "account_deletion_in_progress"},
+	{domain.ErrGuestAlreadyMerged, http.StatusConflict, "guest_already_merged"},
+	{domain.ErrDeviceRevoked, http.StatusForbidden, "device_revoked"},
+	{domain.ErrListReadOnly, http.StatusForbidden, "list_read_only"},
+	{domain.ErrTokenListForbidden, http.StatusForbidden, "token_list_forbidden"},
+	{domain.ErrCommentForbidden, http.StatusForbidden, "comment_forbidden"},
+	{domain.ErrSignupDisabled, http.StatusForbidden, "signup_disabled"},
+	{domain.ErrCannotChangeOwnRole, http.StatusForbidden, "cannot_change_own_role"},
+	{domain.ErrGuestMergeForbidden, http.StatusForbidden, "guest_merge_forbidden"},
+	{domain.ErrWorkspaceArchived, http.StatusLocked, "workspace_archived"},
+	{domain.ErrBatchItemNotApplied, http.StatusFailedDependency, "not_applied"},
+	{domain.ErrUndoUnavailable, http.StatusGone, "undo_unavailable"},
+	{domain.ErrAttachmentStorageDisabled, http.StatusServiceUnavailable, "attachments_disabled"},
+	{domain.ErrDiscordIntegrationMissing, http.StatusServiceUnavailable, "discord_not_configured"},
+	{domain.ErrDependencyUnavailable, http.StatusServiceUnavailable, "dependency_unavailable"},
+	{domain.ErrMaintenance, http.StatusServiceUnavailable, "maintenance"},
+	{domain.ErrEmailNotConfigured, http.StatusServiceUnavailable, "email_not_configured"},
+	{domain.ErrEmailDeliveryFailed, http.StatusBadGateway, "email_delivery_failed"},
+	{domain.ErrPushNotConfigured, http.StatusServiceUnavailable, "push_not_configured"},
+	{domain.ErrPushDeliveryFailed, http.StatusBadGateway, "push_delivery_failed"},
+	{domain.ErrSlackRateLimited, http.StatusTooManyRequests, "slack_rate_limited"},
+	{domain.ErrLoginThrottled, http.StatusTooManyRequests, "login_throttled"},
+}
+
+// errorStatus mengembalikan status HTTP, kode error, dan pesan yang aman dikirim ke klien untuk err.
+// Error yang tidak dikenal dianggap 500 dan detailnya di-log, bukan dikirim ke klien.
+func errorStatus(r *http.Request, err error) (status int, code, message string) {
+	// Error karena deadline withTimeout tidak selalu membungkus context.DeadlineExceeded (misalnya
+	// dari driver), jadi context request yang diperiksa.
+	if errors.Is(r.Context().Err(), context.DeadlineExceeded) {
+		slog.WarnContext(r.Context(), "request timed out", "error", err)
+		return http.StatusServiceUnavailable, "request_timeout", "request timed out"
+	}
+	for _, m := range errorMapping {
+		if errors.Is(err, m.err) {
+			return m.status, m.code, err.Error()
+		}
+	}
+	slog.ErrorContext(r.Context(), "internal error", "error", err)
+	return http.StatusInternalServerError, "internal_error", "internal server error"
+}
+
+// writeError memetakan error dari application/domain layer ke response problem+json.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, code, message := errorStatus(r, err)
+	writeProblemCode(w, status, code, message)
+}
+
+// decodeJSON membaca body request sebagai JSON ke dalam v.
+// Field yang tidak dikenal ditolak agar typo di klien cepat ketahuan.
+func decodeJSON(r *http.Request, v any) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,109 @@
+// file: backend/services/task-service/internal/interfaces/rest/retrospective_handler.go
+package rest
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// RetrospectiveHandler menangani retrospektif bulanan dan pengaturannya.
+type RetrospectiveHandler struct {
+	retroService application.RetrospectiveApplicationService
+}
+
+// NewRetrospectiveHandler adalah constructor untuk RetrospectiveHandler.
+func NewRetrospectiveHandler(retroService application.RetrospectiveApplicationService) *RetrospectiveHandler {
+	return &RetrospectiveHandler{retroService: retroService}
+}
+
+// RegisterRoutes mendaftarkan route retrospektif. Route ini membutuhkan pengguna terautentikasi.
+func (h *RetrospectiveHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/retrospectives", h.list)
+	mux.HandleFunc("GET /api/v1/me/retrospectives/settings", h.getSettings)
+	mux.HandleFunc("PUT /api/v1/me/retrospectives/settings", h.saveSettings)
+	mux.HandleFunc("GET /api/v1/me/retrospectives/{month}", h.get)
+}
+
+// list mengembalikan bulan yang retrospektifnya sudah dibuat oleh job bulanan.
+func (h *RetrospectiveHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	months, err := h.retroService.ListMonths(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string][]string{"months": months})
+}
+
+func (h *RetrospectiveHandler) getSettings(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	settings, err := h.retroService.GetSettings(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewRetrospectiveSettingsResponse(settings))
+}
+
+func (h *RetrospectiveHandler) saveSettings(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.RetrospectiveSettingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	settings, err := h.retroService.SaveSettings(r.Context(), userID, req.Enabled, req.TimeZone)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewRetrospectiveSettingsResponse(settings))
+}
+
+// get mengembalikan retrospektif satu bulan (YYYY-MM) sebagai JSON, atau sebagai body email HTML
+// dengan ?format=html. Query parameter tz (zona waktu IANA) hanya berlaku untuk retrospektif yang
+// belum tersimpan; defaultnya zona waktu di pengaturan pengguna.
+func (h *RetrospectiveHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+
+	var loc *time.Location
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "tz must be an IANA time zone name")
+			return
+		}
+		loc = parsed
+	}
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "html" {
+		writeProblem(w, http.StatusBadRequest, "format must be json or html")
+		return
+	}
+
+	retro, err := h.retroService.GetRetrospective(r.Context(), userID, r.PathValue("month"), loc)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if format != "html" {
+		writeJSON(w, http.StatusOK, dto.NewRetrospectiveResponse(retro))
+		return
+	}
+	body, err := application.RenderRetrospectiveHTML(retro)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		slog.ErrorContext(r.Context(), "error writing response", "error", err)
+	}
+}
@@ -0,0 +1,74 @@
+// file: backend/services/task-service/internal/interfaces/rest/role_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// RoleHandler menangani endpoint role aplikasi.
+type RoleHandler struct {
+	roleService application.RoleApplicationService
+}
+
+// NewRoleHandler adalah constructor untuk RoleHandler.
+func NewRoleHandler(roleService application.RoleApplicationService) *RoleHandler {
+	return &RoleHandler{
+		roleService: roleService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route role. Route admin dibungkus auth.RequirePermission.
+func (h *RoleHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/role", h.me)
+	mux.Handle("GET /api/v1/admin/roles", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.list)))
+	mux.Handle("PUT /api/v1/admin/users/{userID}/role", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.set)))
+}
+
+// me mengembalikan role dan permission pengguna yang sedang login, misalnya agar frontend hanya
+// menampilkan menu admin kepada admin.
+func (h *RoleHandler) me(w http.ResponseWriter, r *http.Request) {
+	role, err := auth.RoleFromContext(r.Context())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	permissions := []string{}
+	for _, p := range role.Permissions() {
+		permissions = append(permissions, string(p))
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.RoleResponse{Role: string(role), Permissions: permissions})
+}
+
+// list mengembalikan role yang diberikan lewat tabel user_roles. Admin dari claim JWT tidak
+// termasuk.
+func (h *RoleHandler) list(w http.ResponseWriter, r *http.Request) {
+	roles, err := h.roleService.ListRoles(r.Context())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewUserRoleListResponse(roles))
+}
+
+// set mengganti role pengguna. Perubahan berlaku di request berikutnya pengguna tersebut.
+func (h *RoleHandler) set(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.SetUserRoleRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	role, err := h.roleService.SetRole(r.Context(), adminID, domain.UserID(r.PathValue("userID")), domain.Role(req.Role), req.Reason)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewUserRoleResponse(*role))
+}
@@ -0,0 +1,60 @@
+// file: backend/services/task-service/internal/interfaces/rest/route_scopes.go
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+// routeScopes memetakan route (pattern mux, persis seperti didaftarkan handler) ke scope yang
+// dibutuhkan kredensial dengan scope, selain scope untuk method-nya yang sudah diperiksa
+// middleware autentikasi (lihat auth.RequiredScope). Route yang tidak ada di sini hanya
+// membutuhkan scope method.
+var routeScopes = map[string]domain.TokenScope{
+	"GET /api/v1/tasks/export.csv":         domain.ScopeExport,
+	"GET /api/v1/lists/{id}/export.md":     domain.ScopeExport,
+	"GET /api/v1/agenda.pdf":               domain.ScopeExport,
+	"GET /api/v1/me/backup":                domain.ScopeExport,
+	"POST /api/v1/me/export":               domain.ScopeExport,
+	"GET /api/v1/me/exports/{id}":          domain.ScopeExport,
+	"GET /api/v1/me/exports/{id}/download": domain.ScopeExport,
+}
+
+// routeScope menolak request (403) ke route di routeScopes dari kredensial yang tidak memiliki
+// scope route tersebut (lihat auth.HasScope). Panic jika ada route di routeScopes yang tidak
+// terdaftar di mux, agar salah ketik pattern tidak diam-diam membuka route. Harus dipasang
+// setelah middleware autentikasi.
+func routeScope(mux *http.ServeMux, next http.Handler) http.Handler {
+	for pattern := range routeScopes {
+		if _, registered := mux.Handler(patternRequest(pattern)); registered != pattern {
+			panic(fmt.Sprintf("routeScopes: route %q is not registered", pattern))
+		}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, pattern := mux.Handler(r); pattern != "" {
+			if scope, ok := routeScopes[pattern]; ok && !auth.HasScope(r.Context(), scope) {
+				writeProblemCode(w, http.StatusForbidden, "insufficient_scope", "token lacks scope "+string(scope))
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+var patternWildcard = regexp.MustCompile(`\{[^}]*\}`)
+
+// patternRequest membuat request contoh yang cocok dengan pattern "<method> <path>", dengan setiap
+// wildcard diganti satu segmen.
+func patternRequest(pattern string) *http.Request {
+	method, path, _ := strings.Cut(pattern, " ")
+	r, err := http.NewRequest(method, patternWildcard.ReplaceAllString(path, "x"), nil)
+	if err != nil {
+		panic(fmt.Sprintf("routeScopes: invalid route %q: %v", pattern, err))
+	}
+	return r
+}
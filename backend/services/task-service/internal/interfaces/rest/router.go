@@ -0,0 +1,325 @@
+// file: backend/services/task-service/internal/interfaces/rest/router.go
+package rest
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/errorreport"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/logging"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headerMethodOverride memungkinkan klien di balik proxy yang hanya meneruskan GET/POST
+// mengirim PUT/PATCH/DELETE sebagai POST.
+const headerMethodOverride = "X-HTTP-Method-Override"
+
+// RouterConfig berisi dependensi yang dibutuhkan untuk menyusun router HTTP.
+type RouterConfig struct {
+	TaskHandler                *TaskHandler
+	BulkTaskHandler            *BulkTaskHandler
+	TaskHistoryHandler         *TaskHistoryHandler
+	AttachmentHandler          *AttachmentHandler
+	TimeTrackingHandler        *TimeTrackingHandler
+	BoardHandler               *BoardHandler
+	WebhookHandler             *WebhookHandler
+	DiscordHandler             *DiscordHandler
+	MatrixHandler              *MatrixHandler
+	GoogleCalendarHandler      *GoogleCalendarHandler
+	EmailHandler               *EmailHandler
+	PushHandler                *PushHandler
+	SlackHandler               *SlackHandler
+	TodoistImportHandler       *TodoistImportHandler
+	TaskCSVHandler             *TaskCSVHandler
+	TaskMarkdownHandler        *TaskMarkdownHandler
+	AgendaHandler              *AgendaHandler
+	BackupHandler              *BackupHandler
+	DataExportHandler          *DataExportHandler
+	SyncHandler                *SyncHandler
+	AccountHandler             *AccountHandler
+	QuotaHandler               *QuotaHandler
+	AdminHandler               *AdminHandler
+	SecurityAuditHandler       *SecurityAuditHandler
+	RoleHandler                *RoleHandler
+	IntegrityHandler           *IntegrityHandler
+	RetrospectiveHandler       *RetrospectiveHandler
+	ArchiveHandler             *ArchiveHandler
+	ActivityHandler            *ActivityHandler
+	DeviceHandler              *DeviceHandler
+	StatsHandler               *StatsHandler
+	EnumHandler                *EnumHandler
+	TaskCallbackHandler        *TaskCallbackHandler
+	ScimHandler                *ScimHandler
+	WorkspaceConfigHandler     *WorkspaceConfigHandler
+	ListShareHandler           *ListShareHandler
+	TaskCommentHandler         *TaskCommentHandler
+	RealtimeHandler            *RealtimeHandler
+	EventStreamHandler         *EventStreamHandler
+	CalendarFeedHandler        *CalendarFeedHandler
+	CalDAVTokenHandler         *CalDAVTokenHandler
+	PersonalAccessTokenHandler *PersonalAccessTokenHandler
+	UserSessionHandler         *UserSessionHandler
+	LocalAuthHandler           *LocalAuthHandler
+	GuestHandler               *GuestHandler
+	UserProfileHandler         *UserProfileHandler
+	HealthHandler              *HealthHandler
+	LogLevelHandler            *LogLevelHandler
+	VersionHandler             *VersionHandler
+	FeatureHandler             *FeatureHandler
+	MaintenanceHandler         *MaintenanceHandler
+
+	// GraphQLHandler melayani /graphql; dilindungi AuthMiddleware seperti route /api/v1/.
+	GraphQLHandler http.Handler
+
+	// CalDAVHandler melayani /dav/ dan dilindungi CalDAVAuth (Basic auth dengan token CalDAV),
+	// bukan AuthMiddleware, karena klien CalDAV tidak bisa mengirim access token.
+	CalDAVHandler http.Handler
+	CalDAVAuth    func(http.Handler) http.Handler
+
+	// AttachmentObjectHandler melayani URL attachment bertanda tangan di
+	// /api/v1/attachment-objects/ (ATTACHMENT_STORAGE=local) tanpa AuthMiddleware, karena tanda
+	// tangan URL-nya sudah menjadi otorisasi. Nil berarti storage attachment dilayani pihak lain.
+	AttachmentObjectHandler http.Handler
+
+	// AuthMiddleware memverifikasi token (JWT Supabase, personal access token, atau token tamu) dan
+	// menyimpan ID pengguna ke context.
+	AuthMiddleware func(http.Handler) http.Handler
+
+	// PanicReporter menerima panic yang ditangkap di handler; nil berarti panic hanya dicatat di log.
+	PanicReporter errorreport.Reporter
+
+	// RequestTimeout adalah batas waktu context setiap request, kecuali stream realtime; 0 berarti
+	// tanpa batas.
+	RequestTimeout time.Duration
+
+	// AccessLog mengatur access log JSON per request; Writer nil berarti access log mati.
+	AccessLog AccessLogConfig
+
+	// RateLimit membatasi request per pengguna di /api/v1/ dan /graphql; nil berarti tanpa batas.
+	RateLimit *RateLimitConfig
+
+	// CORS mengatur origin browser lain yang boleh memanggil API; nil berarti CORS mati.
+	CORS *CORSConfig
+
+	// SessionCookie mengaktifkan autentikasi cookie beserta proteksi CSRF; nil berarti hanya
+	// header Authorization yang diterima.
+	SessionCookie *SessionCookieConfig
+
+	// TrustedProxies adalah proxy yang header X-Forwarded-For-nya dipercaya untuk menentukan IP
+	// klien; kosong berarti IP klien selalu RemoteAddr.
+	TrustedProxies []netip.Prefix
+}
+
+// NewRouter menyusun seluruh route task-service.
+// Route di bawah /api/v1/ dilindungi AuthMiddleware, kecuali yang didaftarkan sebagai route publik.
+//
+// Setiap route GET otomatis juga melayani HEAD (perilaku http.ServeMux); body dibuang oleh
+// net/http sehingga header-nya, termasuk X-Total-Count dari writeJSONList, sama dengan GET.
+// Handler yang mahal bisa memeriksa r.Method untuk melewati pembacaan data setelah validasi,
+// misalnya daftar task yang hanya menghitung task.
+func NewRouter(cfg RouterConfig) http.Handler {
+	protected := http.NewServeMux()
+	cfg.TaskHandler.RegisterRoutes(protected)
+	cfg.BulkTaskHandler.RegisterRoutes(protected)
+	cfg.TaskHistoryHandler.RegisterRoutes(protected)
+	cfg.AttachmentHandler.RegisterRoutes(protected)
+	cfg.TimeTrackingHandler.RegisterRoutes(protected)
+	cfg.BoardHandler.RegisterRoutes(protected)
+	cfg.WebhookHandler.RegisterRoutes(protected)
+	cfg.DiscordHandler.RegisterRoutes(protected)
+	cfg.MatrixHandler.RegisterRoutes(protected)
+	cfg.GoogleCalendarHandler.RegisterRoutes(protected)
+	cfg.EmailHandler.RegisterRoutes(protected)
+	cfg.PushHandler.RegisterRoutes(protected)
+	cfg.SlackHandler.RegisterRoutes(protected)
+	cfg.TodoistImportHandler.RegisterRoutes(protected)
+	cfg.TaskCSVHandler.RegisterRoutes(protected)
+	cfg.TaskMarkdownHandler.RegisterRoutes(protected)
+	cfg.AgendaHandler.RegisterRoutes(protected)
+	cfg.BackupHandler.RegisterRoutes(protected)
+	cfg.DataExportHandler.RegisterRoutes(protected)
+	cfg.SyncHandler.RegisterRoutes(protected)
+	cfg.AccountHandler.RegisterRoutes(protected)
+	cfg.QuotaHandler.RegisterRoutes(protected)
+	cfg.AdminHandler.RegisterRoutes(protected)
+	cfg.SecurityAuditHandler.RegisterRoutes(protected)
+	cfg.RoleHandler.RegisterRoutes(protected)
+	cfg.IntegrityHandler.RegisterRoutes(protected)
+	cfg.RetrospectiveHandler.RegisterRoutes(protected)
+	cfg.ArchiveHandler.RegisterRoutes(protected)
+	cfg.ActivityHandler.RegisterRoutes(protected)
+	cfg.DeviceHandler.RegisterRoutes(protected)
+	cfg.StatsHandler.RegisterRoutes(protected)
+	cfg.EnumHandler.RegisterRoutes(protected)
+	cfg.TaskCallbackHandler.RegisterRoutes(protected)
+	cfg.ScimHandler.RegisterRoutes(protected)
+	cfg.WorkspaceConfigHandler.RegisterRoutes(protected)
+	cfg.ListShareHandler.RegisterRoutes(protected)
+	cfg.TaskCommentHandler.RegisterRoutes(protected)
+	cfg.CalendarFeedHandler.RegisterRoutes(protected)
+	cfg.CalDAVTokenHandler.RegisterRoutes(protected)
+	cfg.PersonalAccessTokenHandler.RegisterRoutes(protected)
+	cfg.UserSessionHandler.RegisterRoutes(protected)
+	cfg.GuestHandler.RegisterRoutes(protected)
+	cfg.UserProfileHandler.RegisterRoutes(protected)
+	cfg.LogLevelHandler.RegisterRoutes(protected)
+	cfg.FeatureHandler.RegisterRoutes(protected)
+	cfg.MaintenanceHandler.RegisterRoutes(protected)
+	sessions := &sessionCookieHandler{cfg: cfg.SessionCookie}
+	if cfg.SessionCookie != nil {
+		sessions.RegisterRoutes(protected)
+	}
+
+	mux := http.NewServeMux()
+	cfg.HealthHandler.RegisterRoutes(mux)
+	cfg.VersionHandler.RegisterRoutes(mux)
+	cfg.SyncHandler.RegisterPublicRoutes(mux)
+	cfg.DiscordHandler.RegisterPublicRoutes(mux)
+	cfg.ScimHandler.RegisterPublicRoutes(mux)
+	cfg.CalendarFeedHandler.RegisterPublicRoutes(mux)
+	cfg.LocalAuthHandler.RegisterPublicRoutes(mux)
+	cfg.GuestHandler.RegisterPublicRoutes(mux)
+	if cfg.AttachmentObjectHandler != nil {
+		mux.Handle("/api/v1/attachment-objects/", requestLogger(cfg.AttachmentObjectHandler))
+	}
+	if cfg.SessionCookie != nil {
+		sessions.RegisterPublicRoutes(mux)
+	}
+	authenticate := func(next http.Handler) http.Handler {
+		return sessionCookie(cfg.SessionCookie, cfg.AuthMiddleware(next))
+	}
+	mux.Handle("/api/v1/", authenticate(listRestriction(protected, routeScope(protected, adminPolicy(protected, rateLimit(cfg.RateLimit, cfg.ArchiveHandler.ReadOnlyMiddleware(requestLogger(protected))))))))
+	// requestLogger dipasang lagi setelah autentikasi agar access log bisa membaca ID pengguna.
+	mux.Handle("/graphql", authenticate(listRestriction(nil, rateLimit(cfg.RateLimit, requestLogger(cfg.GraphQLHandler)))))
+	mux.Handle("GET /ws", accessTokenQuery(authenticate(listRestriction(nil, requestLogger(cfg.RealtimeHandler)))))
+	mux.Handle("GET /api/v1/events", accessTokenQuery(authenticate(listRestriction(nil, requestLogger(cfg.EventStreamHandler)))))
+	mux.Handle("/dav/", cfg.CalDAVAuth(requestLogger(cfg.ArchiveHandler.ReadOnlyMiddleware(cfg.CalDAVHandler))))
+	// Discovery CalDAV (RFC 6764) untuk klien yang hanya diberi nama host.
+	mux.Handle("/.well-known/caldav", http.RedirectHandler("/dav/", http.StatusMovedPermanently))
+
+	panicReporter := cfg.PanicReporter
+	if panicReporter == nil {
+		panicReporter = errorreport.Nop{}
+	}
+	return otelhttp.NewHandler(cors(cfg.CORS, withRequestID(withClientIP(cfg.TrustedProxies, withTimeout(cfg.RequestTimeout, methodOverride(requestLogger(accessLog(cfg.AccessLog, recoverPanic(panicReporter, cfg.MaintenanceHandler.WriteGuard(mux))))))))), "http.request")
+}
+
+// withTimeout memasang deadline timeout pada context request, sehingga query database dan
+// pemanggilan service lain dibatalkan saat request terlalu lama; handler yang mengembalikan error
+// setelah deadline lewat menjawab 503 request_timeout (lihat errorStatus). Stream WebSocket dan
+// SSE tidak dibatasi karena memang berjalan lama.
+func withTimeout(timeout time.Duration, next http.Handler) http.Handler {
+	if timeout <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ws" || r.URL.Path == "/api/v1/events" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// withRequestID memakai header X-Request-ID dari klien jika valid (domain.ValidRequestID), atau
+// membuat ID baru. ID dikirim kembali di header response dan body problem+json, dicatat di setiap
+// log request, dan diteruskan ke pemanggilan service lain lewat context.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(domain.HeaderRequestID)
+		if !domain.ValidRequestID(id) {
+			id = domain.NewRequestID()
+		}
+		w.Header().Set(domain.HeaderRequestID, id)
+		trace.SpanFromContext(r.Context()).SetAttributes(attribute.String("request_id", id))
+		next.ServeHTTP(w, r.WithContext(domain.ContextWithRequestID(r.Context(), id)))
+	})
+}
+
+// requestLogger menyimpan method, path, dan route request ke context log (lihat logging.With),
+// sehingga setiap log dengan context request membawa field "http". Route baru diketahui setelah
+// routing oleh mux, jadi dibaca saat log ditulis. Untuk mux bertingkat, requestLogger dipasang
+// lagi di depan mux dalam agar route yang dicatat adalah pattern paling spesifik. Setelah request
+// selesai, span HTTP (lihat otelhttp di NewRouter) diberi nama dan atribut http.route dari route
+// tersebut.
+func requestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if info, ok := r.Context().Value(requestLogInfoKey{}).(*requestLogInfo); ok {
+			info.r = r
+			next.ServeHTTP(w, r)
+			return
+		}
+		info := &requestLogInfo{}
+		ctx := context.WithValue(r.Context(), requestLogInfoKey{}, info)
+		r = r.WithContext(logging.With(ctx, "http", info))
+		info.r = r
+		next.ServeHTTP(w, r)
+		nameSpan(r.Context(), info.r)
+	})
+}
+
+// nameSpan memberi nama span request "<method> <route>", misalnya "GET /api/v1/tasks/{id}",
+// agar span bisa dikelompokkan per endpoint tanpa ID di path. Request yang tidak cocok dengan
+// route mana pun tetap memakai nama default.
+func nameSpan(ctx context.Context, r *http.Request) {
+	if r.Pattern == "" {
+		return
+	}
+	route := r.Pattern
+	if _, path, ok := strings.Cut(route, " "); ok {
+		route = path
+	}
+	span := trace.SpanFromContext(ctx)
+	span.SetName(r.Method + " " + route)
+	span.SetAttributes(attribute.String("http.route", route))
+}
+
+type requestLogInfoKey struct{}
+
+// requestLogInfo adalah slog.LogValuer untuk request yang sedang ditangani.
+type requestLogInfo struct {
+	r *http.Request
+}
+
+func (i *requestLogInfo) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("method", i.r.Method),
+		slog.String("path", i.r.URL.Path),
+		slog.String("route", i.r.Pattern),
+	)
+}
+
+// methodOverride mengganti method request POST dengan nilai header X-HTTP-Method-Override
+// sebelum routing. Hanya PUT, PATCH, dan DELETE yang diizinkan, sehingga override tidak bisa
+// dipakai untuk mengubah write menjadi GET atau sebaliknya.
+func methodOverride(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		override := r.Header.Get(headerMethodOverride)
+		if override == "" || r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch method := strings.ToUpper(override); method {
+		case http.MethodPut, http.MethodPatch, http.MethodDelete:
+			// Salinan dangkal berbagi map header dengan request asli, sehingga header di-clone
+			// sebelum dihapus.
+			r = r.WithContext(r.Context())
+			r.Method = method
+			r.Header = r.Header.Clone()
+			r.Header.Del(headerMethodOverride)
+			next.ServeHTTP(w, r)
+		default:
+			writeProblem(w, http.StatusBadRequest, headerMethodOverride+" must be PUT, PATCH, or DELETE")
+		}
+	})
+}
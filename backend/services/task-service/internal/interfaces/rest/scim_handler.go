@@ -0,0 +1,442 @@
+// file: backend/services/task-service/internal/interfaces/rest/scim_handler.go
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// maxScimRequestSize membatasi body request SCIM; Group dengan ribuan anggota masih muat.
+const maxScimRequestSize = 1 << 20
+
+// scimWorkspaceKey adalah key context untuk workspace pemilik token SCIM.
+type scimWorkspaceKey struct{}
+
+// ScimHandler menangani endpoint provisioning SCIM 2.0 dan pengaturannya oleh pemilik workspace.
+type ScimHandler struct {
+	scimService application.ScimApplicationService
+}
+
+// NewScimHandler adalah constructor untuk ScimHandler.
+func NewScimHandler(scimService application.ScimApplicationService) *ScimHandler {
+	return &ScimHandler{
+		scimService: scimService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route pengaturan SCIM. Route ini membutuhkan pengguna terautentikasi;
+// workspace adalah milik pengguna tersebut. Route yang menerbitkan token SCIM atau mengubah
+// pemetaan role dibungkus auth.RequireSession, sehingga token terbatas tidak bisa memakainya.
+func (h *ScimHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/me/scim/token", auth.RequireSession(http.HandlerFunc(h.rotateToken)))
+	mux.Handle("DELETE /api/v1/me/scim/token", auth.RequireSession(http.HandlerFunc(h.revokeToken)))
+	mux.HandleFunc("GET /api/v1/me/scim/role-mappings", h.getRoleMappings)
+	mux.Handle("PUT /api/v1/me/scim/role-mappings", auth.RequireSession(http.HandlerFunc(h.saveRoleMappings)))
+	mux.HandleFunc("GET /api/v1/me/members", h.listWorkspaceMembers)
+}
+
+// RegisterPublicRoutes mendaftarkan endpoint SCIM 2.0 untuk identity provider. Route ini tidak
+// memakai token pengguna; setiap request membawa token SCIM workspace.
+func (h *ScimHandler) RegisterPublicRoutes(mux *http.ServeMux) {
+	scim := http.NewServeMux()
+	scim.HandleFunc("GET /scim/v2/Users", h.listUsers)
+	scim.HandleFunc("POST /scim/v2/Users", h.createUser)
+	scim.HandleFunc("GET /scim/v2/Users/{id}", h.getUser)
+	scim.HandleFunc("PUT /scim/v2/Users/{id}", h.replaceUser)
+	scim.HandleFunc("PATCH /scim/v2/Users/{id}", h.patchUser)
+	scim.HandleFunc("DELETE /scim/v2/Users/{id}", h.deleteUser)
+	scim.HandleFunc("GET /scim/v2/Groups", h.listGroups)
+	scim.HandleFunc("POST /scim/v2/Groups", h.createGroup)
+	scim.HandleFunc("GET /scim/v2/Groups/{id}", h.getGroup)
+	scim.HandleFunc("PUT /scim/v2/Groups/{id}", h.replaceGroup)
+	scim.HandleFunc("PATCH /scim/v2/Groups/{id}", h.patchGroup)
+	scim.HandleFunc("DELETE /scim/v2/Groups/{id}", h.deleteGroup)
+	mux.Handle("/scim/v2/", h.tokenMiddleware(scim))
+}
+
+// tokenMiddleware mewajibkan header Authorization: Bearer <token SCIM> dan menyimpan workspace
+// pemilik token ke context.
+func (h *ScimHandler) tokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !strings.EqualFold(scheme, "Bearer") || token == "" {
+			writeScimUnauthorized(w)
+			return
+		}
+		workspaceID, err := h.scimService.Authenticate(r.Context(), strings.TrimSpace(token))
+		if errors.Is(err, domain.ErrInvalidScimToken) {
+			writeScimUnauthorized(w)
+			return
+		}
+		if err != nil {
+			writeScimError(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), scimWorkspaceKey{}, workspaceID)))
+	})
+}
+
+// rotateToken membuat token SCIM baru; token lama langsung tidak berlaku.
+func (h *ScimHandler) rotateToken(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	token, err := h.scimService.RotateToken(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, dto.ScimTokenResponse{Token: token})
+}
+
+func (h *ScimHandler) revokeToken(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.scimService.RevokeToken(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ScimHandler) getRoleMappings(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	mappings, err := h.scimService.GetRoleMappings(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.ScimRoleMappingsRequest{Mappings: mappings})
+}
+
+func (h *ScimHandler) saveRoleMappings(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.ScimRoleMappingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	mappings, err := h.scimService.SaveRoleMappings(r.Context(), userID, req.Mappings)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.ScimRoleMappingsRequest{Mappings: mappings})
+}
+
+// listWorkspaceMembers mengembalikan anggota workspace beserta role-nya. Parameter yang sama
+// dengan SCIM (filter, startIndex, count) didukung; jumlah total dikirim di X-Total-Count.
+func (h *ScimHandler) listWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query, ok := scimListQuery(w, r, writeProblem)
+	if !ok {
+		return
+	}
+	page, err := h.scimService.ListMembers(r.Context(), userID, query)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := make([]dto.WorkspaceMemberResponse, 0, len(page.Members))
+	for _, member := range page.Members {
+		resp = append(resp, dto.NewWorkspaceMemberResponse(member))
+	}
+	w.Header().Set(headerTotalCount, strconv.Itoa(page.Total))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *ScimHandler) listUsers(w http.ResponseWriter, r *http.Request) {
+	query, ok := scimListQuery(w, r, writeScimProblem)
+	if !ok {
+		return
+	}
+	page, err := h.scimService.ListMembers(r.Context(), scimWorkspace(r), query)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	resources := make([]any, 0, len(page.Members))
+	for _, member := range page.Members {
+		resources = append(resources, dto.NewScimUser(member, scimUserLocation(member.ID)))
+	}
+	writeScimJSON(w, http.StatusOK, scimListResponse(query, page.Total, resources))
+}
+
+func (h *ScimHandler) getUser(w http.ResponseWriter, r *http.Request) {
+	member, err := h.scimService.GetMember(r.Context(), scimWorkspace(r), r.PathValue("id"))
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	writeScimJSON(w, http.StatusOK, dto.NewScimUser(member, scimUserLocation(member.ID)))
+}
+
+func (h *ScimHandler) createUser(w http.ResponseWriter, r *http.Request) {
+	input, ok := decodeScimUser(w, r)
+	if !ok {
+		return
+	}
+	member, err := h.scimService.CreateMember(r.Context(), scimWorkspace(r), input)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	location := scimUserLocation(member.ID)
+	w.Header().Set("Location", location)
+	writeScimJSON(w, http.StatusCreated, dto.NewScimUser(member, location))
+}
+
+func (h *ScimHandler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	input, ok := decodeScimUser(w, r)
+	if !ok {
+		return
+	}
+	member, err := h.scimService.ReplaceMember(r.Context(), scimWorkspace(r), r.PathValue("id"), input)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	writeScimJSON(w, http.StatusOK, dto.NewScimUser(member, scimUserLocation(member.ID)))
+}
+
+func (h *ScimHandler) patchUser(w http.ResponseWriter, r *http.Request) {
+	ops, ok := decodeScimPatch(w, r)
+	if !ok {
+		return
+	}
+	member, err := h.scimService.PatchMember(r.Context(), scimWorkspace(r), r.PathValue("id"), ops)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	writeScimJSON(w, http.StatusOK, dto.NewScimUser(member, scimUserLocation(member.ID)))
+}
+
+func (h *ScimHandler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.scimService.DeleteMember(r.Context(), scimWorkspace(r), r.PathValue("id")); err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ScimHandler) listGroups(w http.ResponseWriter, r *http.Request) {
+	query, ok := scimListQuery(w, r, writeScimProblem)
+	if !ok {
+		return
+	}
+	page, err := h.scimService.ListGroups(r.Context(), scimWorkspace(r), query)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	resources := make([]any, 0, len(page.Groups))
+	for _, group := range page.Groups {
+		resources = append(resources, dto.NewScimGroup(group, scimGroupLocation(group.ID)))
+	}
+	writeScimJSON(w, http.StatusOK, scimListResponse(query, page.Total, resources))
+}
+
+func (h *ScimHandler) getGroup(w http.ResponseWriter, r *http.Request) {
+	group, err := h.scimService.GetGroup(r.Context(), scimWorkspace(r), r.PathValue("id"))
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	writeScimJSON(w, http.StatusOK, dto.NewScimGroup(group, scimGroupLocation(group.ID)))
+}
+
+func (h *ScimHandler) createGroup(w http.ResponseWriter, r *http.Request) {
+	input, ok := decodeScimGroup(w, r)
+	if !ok {
+		return
+	}
+	group, err := h.scimService.CreateGroup(r.Context(), scimWorkspace(r), input)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	location := scimGroupLocation(group.ID)
+	w.Header().Set("Location", location)
+	writeScimJSON(w, http.StatusCreated, dto.NewScimGroup(group, location))
+}
+
+func (h *ScimHandler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	input, ok := decodeScimGroup(w, r)
+	if !ok {
+		return
+	}
+	group, err := h.scimService.ReplaceGroup(r.Context(), scimWorkspace(r), r.PathValue("id"), input)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	writeScimJSON(w, http.StatusOK, dto.NewScimGroup(group, scimGroupLocation(group.ID)))
+}
+
+func (h *ScimHandler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	ops, ok := decodeScimPatch(w, r)
+	if !ok {
+		return
+	}
+	group, err := h.scimService.PatchGroup(r.Context(), scimWorkspace(r), r.PathValue("id"), ops)
+	if err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	writeScimJSON(w, http.StatusOK, dto.NewScimGroup(group, scimGroupLocation(group.ID)))
+}
+
+func (h *ScimHandler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	if err := h.scimService.DeleteGroup(r.Context(), scimWorkspace(r), r.PathValue("id")); err != nil {
+		writeScimError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// scimWorkspace mengembalikan workspace yang disimpan tokenMiddleware.
+func scimWorkspace(r *http.Request) domain.UserID {
+	workspaceID, _ := r.Context().Value(scimWorkspaceKey{}).(domain.UserID)
+	return workspaceID
+}
+
+func scimUserLocation(id string) string  { return "/scim/v2/Users/" + id }
+func scimGroupLocation(id string) string { return "/scim/v2/Groups/" + id }
+
+// scimListQuery membaca parameter filter, startIndex, dan count. Error ditulis dengan writeFn agar
+// endpoint SCIM dan endpoint biasa bisa memakai format error masing-masing.
+func scimListQuery(w http.ResponseWriter, r *http.Request, writeFn func(http.ResponseWriter, int, string)) (application.ScimListQuery, bool) {
+	query := application.ScimListQuery{Filter: r.URL.Query().Get("filter")}
+	for name, target := range map[string]*int{"startIndex": &query.StartIndex, "count": &query.Count} {
+		raw := r.URL.Query().Get(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			writeFn(w, http.StatusBadRequest, name+" must be a non-negative integer")
+			return application.ScimListQuery{}, false
+		}
+		*target = parsed
+	}
+	return query, true
+}
+
+// scimListResponse menyusun ListResponse SCIM; startIndex selalu dimulai dari 1.
+func scimListResponse(query application.ScimListQuery, total int, resources []any) dto.ScimListResponse {
+	return dto.ScimListResponse{
+		Schemas:      []string{dto.ScimListResponseSchema},
+		TotalResults: total,
+		StartIndex:   max(query.StartIndex, 1),
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func decodeScimUser(w http.ResponseWriter, r *http.Request) (application.ScimMemberInput, bool) {
+	var req dto.ScimUser
+	if err := decodeScimJSON(w, r, &req); err != nil {
+		writeScimProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return application.ScimMemberInput{}, false
+	}
+	input := application.ScimMemberInput{
+		UserName:    req.UserName,
+		ExternalID:  req.ExternalID,
+		DisplayName: req.DisplayName,
+		Active:      true,
+	}
+	if req.Active != nil {
+		input.Active = *req.Active
+	}
+	return input, true
+}
+
+func decodeScimGroup(w http.ResponseWriter, r *http.Request) (application.ScimGroupInput, bool) {
+	var req dto.ScimGroup
+	if err := decodeScimJSON(w, r, &req); err != nil {
+		writeScimProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return application.ScimGroupInput{}, false
+	}
+	input := application.ScimGroupInput{
+		DisplayName: req.DisplayName,
+		ExternalID:  req.ExternalID,
+	}
+	for _, member := range req.Members {
+		input.MemberIDs = append(input.MemberIDs, member.Value)
+	}
+	return input, true
+}
+
+func decodeScimPatch(w http.ResponseWriter, r *http.Request) ([]application.ScimPatchOperation, bool) {
+	var req dto.ScimPatchRequest
+	if err := decodeScimJSON(w, r, &req); err != nil {
+		writeScimProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return nil, false
+	}
+	ops := make([]application.ScimPatchOperation, 0, len(req.Operations))
+	for _, op := range req.Operations {
+		ops = append(ops, application.ScimPatchOperation{Op: op.Op, Path: op.Path, Value: op.Value})
+	}
+	return ops, true
+}
+
+// decodeScimJSON membaca body request SCIM. Berbeda dengan decodeJSON, field yang tidak dikenal
+// diterima karena identity provider mengirim atribut yang tidak disimpan service ini.
+func decodeScimJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	return json.NewDecoder(http.MaxBytesReader(w, r.Body, maxScimRequestSize)).Decode(v)
+}
+
+// writeScimJSON menulis v sebagai application/scim+json.
+func writeScimJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("error encoding SCIM response", "error", err)
+	}
+}
+
+// writeScimProblem menulis error dengan schema Error SCIM.
+func writeScimProblem(w http.ResponseWriter, status int, detail string) {
+	writeScimErrorType(w, status, "", detail)
+}
+
+func writeScimErrorType(w http.ResponseWriter, status int, scimType, detail string) {
+	writeScimJSON(w, status, dto.ScimError{
+		Schemas:  []string{dto.ScimErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func writeScimUnauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+	writeScimProblem(w, http.StatusUnauthorized, domain.ErrInvalidScimToken.Error())
+}
+
+// writeScimError memetakan error dari application/domain layer ke error SCIM, termasuk scimType
+// yang dipakai identity provider untuk memutuskan langkah berikutnya.
+func writeScimError(w http.ResponseWriter, r *http.Request, err error) {
+	status, _, message := errorStatus(r, err)
+	var scimType string
+	switch {
+	case errors.Is(err, domain.ErrWorkspaceMemberExists), errors.Is(err, domain.ErrWorkspaceGroupExists):
+		scimType = "uniqueness"
+	case errors.Is(err, domain.ErrInvalidScimFilter):
+		scimType = "invalidFilter"
+	case errors.Is(err, domain.ErrInvalidScimPatch):
+		scimType = "invalidSyntax"
+	case status == http.StatusBadRequest:
+		scimType = "invalidValue"
+	}
+	writeScimErrorType(w, status, scimType, message)
+}
@@ -0,0 +1,66 @@
+// file: backend/services/task-service/internal/interfaces/rest/security_audit_handler.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// SecurityAuditHandler menangani endpoint admin untuk audit log keamanan.
+type SecurityAuditHandler struct {
+	securityAuditService application.SecurityAuditApplicationService
+}
+
+// NewSecurityAuditHandler adalah constructor untuk SecurityAuditHandler.
+func NewSecurityAuditHandler(securityAuditService application.SecurityAuditApplicationService) *SecurityAuditHandler {
+	return &SecurityAuditHandler{
+		securityAuditService: securityAuditService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route audit log keamanan. Semua route dibungkus auth.RequirePermission.
+func (h *SecurityAuditHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/admin/security-events", auth.RequirePermission(domain.PermissionManageUsers, http.HandlerFunc(h.list)))
+}
+
+// list mencari entri audit log keamanan dari yang terbaru.
+// Query parameter: reason (wajib, dicatat di audit log admin), user_id, type, ip, before (cursor
+// ID dari next_before), limit (default 20).
+func (h *SecurityAuditHandler) list(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+	limit, ok := adminLimit(w, r)
+	if !ok {
+		return
+	}
+	filter := domain.SecurityEventFilter{
+		UserID: domain.UserID(query.Get("user_id")),
+		Type:   domain.SecurityEventType(query.Get("type")),
+		IP:     query.Get("ip"),
+		Limit:  limit,
+	}
+	if raw := query.Get("before"); raw != "" {
+		before, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || before < 1 {
+			writeProblem(w, http.StatusBadRequest, "before must be a positive integer")
+			return
+		}
+		filter.BeforeID = before
+	}
+
+	events, err := h.securityAuditService.Search(r.Context(), adminID, application.SecurityEventSearchInput{
+		Filter: filter,
+		Reason: query.Get("reason"),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dto.NewSecurityEventListResponse(events, limit))
+}
@@ -0,0 +1,147 @@
+// file: backend/services/task-service/internal/interfaces/rest/session_cookie.go
+package rest
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+const (
+	// headerCSRFToken membawa token CSRF: dikirim server di response request yang diautentikasi
+	// cookie, dan wajib dikirim balik klien pada request yang mengubah data.
+	headerCSRFToken = "X-CSRF-Token"
+
+	// csrfCookieName memakai awalan __Host- agar cookie tidak bisa ditimpa dari subdomain lain.
+	csrfCookieName = "__Host-csrf_token"
+)
+
+// SessionCookieConfig mengaktifkan autentikasi cookie untuk frontend browser. Access token disimpan
+// di cookie HttpOnly lewat POST /api/v1/auth/session, lalu dipakai seperti header Authorization
+// untuk request yang tidak membawa header tersebut. Request dengan header Authorization tidak
+// pernah membaca cookie, sehingga klien bearer token tidak terpengaruh.
+type SessionCookieConfig struct {
+	Name string // Nama cookie access token, misalnya "__Host-session"
+
+	// CSRF mewajibkan header X-CSRF-Token yang sama dengan cookie __Host-csrf_token (double
+	// submit) pada request yang mengubah data dan diautentikasi cookie. Hanya boleh dimatikan jika
+	// CSRF sudah ditangani di depan service, misalnya oleh backend-for-frontend.
+	CSRF bool
+}
+
+// sessionCookie meneruskan access token dari cookie cfg.Name sebagai header Authorization ke
+// middleware autentikasi, setelah memeriksa token CSRF untuk method selain GET, HEAD, dan
+// OPTIONS. Cookie sesi sendiri memakai SameSite=Lax, jadi tidak terkirim pada POST lintas situs;
+// token CSRF melindungi dari situs yang sama, misalnya subdomain lain.
+func sessionCookie(cfg *SessionCookieConfig, next http.Handler) http.Handler {
+	if cfg == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		session, err := r.Cookie(cfg.Name)
+		if err != nil || session.Value == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if cfg.CSRF {
+			token := ensureCSRFToken(w, r)
+			if !safeMethod(r.Method) && !validCSRFToken(r.Header.Get(headerCSRFToken), token) {
+				writeProblemCode(w, http.StatusForbidden, "csrf_token_invalid", "missing or invalid "+headerCSRFToken+" header")
+				return
+			}
+		}
+		r = r.Clone(r.Context())
+		r.Header.Set("Authorization", "Bearer "+session.Value)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ensureCSRFToken mengembalikan token CSRF dari cookie, atau membuat dan memasang cookie baru jika
+// belum ada. Token juga dikirim di header response agar frontend di origin lain (yang tidak bisa
+// membaca cookie milik host API) tetap bisa mengirimnya.
+func ensureCSRFToken(w http.ResponseWriter, r *http.Request) string {
+	var token string
+	if cookie, err := r.Cookie(csrfCookieName); err == nil && cookie.Value != "" {
+		token = cookie.Value
+	} else {
+		token = rand.Text()
+		http.SetCookie(w, &http.Cookie{
+			Name:     csrfCookieName,
+			Value:    token,
+			Path:     "/",
+			Secure:   true,
+			SameSite: http.SameSiteStrictMode,
+		})
+	}
+	w.Header().Set(headerCSRFToken, token)
+	return token
+}
+
+func validCSRFToken(header, token string) bool {
+	return header != "" && subtle.ConstantTimeCompare([]byte(header), []byte(token)) == 1
+}
+
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// sessionCookieHandler menangani pembuatan dan penghapusan cookie sesi.
+type sessionCookieHandler struct {
+	cfg *SessionCookieConfig
+}
+
+// RegisterRoutes mendaftarkan pembuatan cookie sesi, yang membutuhkan JWT; personal access token
+// ditolak agar tidak bisa dipakai sebagai cookie browser.
+func (h *sessionCookieHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/auth/session", auth.RequireSession(http.HandlerFunc(h.create)))
+}
+
+// RegisterPublicRoutes mendaftarkan penghapusan cookie sesi, yang tetap bisa dipanggil setelah
+// access token kedaluwarsa.
+func (h *sessionCookieHandler) RegisterPublicRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("DELETE /api/v1/auth/session", h.delete)
+}
+
+// create menyimpan access token request ke cookie sesi, yang berlaku sampai token kedaluwarsa.
+// Setelah token diperbarui, klien memanggil endpoint ini lagi dengan token baru.
+func (h *sessionCookieHandler) create(w http.ResponseWriter, r *http.Request) {
+	_, token, _ := strings.Cut(r.Header.Get("Authorization"), " ") // Sudah diverifikasi middleware autentikasi
+	cookie := &http.Cookie{
+		Name:     h.cfg.Name,
+		Value:    strings.TrimSpace(token),
+		Path:     "/",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if claims, ok := auth.ClaimsFromContext(r.Context()); ok && claims.ExpiresAt != 0 {
+		cookie.Expires = time.Unix(claims.ExpiresAt, 0)
+	}
+	http.SetCookie(w, cookie)
+	if h.cfg.CSRF {
+		ensureCSRFToken(w, r)
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// delete menghapus cookie sesi dan cookie CSRF.
+func (h *sessionCookieHandler) delete(w http.ResponseWriter, r *http.Request) {
+	for _, name := range []string{h.cfg.Name, csrfCookieName} {
+		http.SetCookie(w, &http.Cookie{Name: name, Path: "/", Secure: true, MaxAge: -1})
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,70 @@
+// file: backend/services/task-service/internal/interfaces/rest/slack_handler.go
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// SlackHandler menangani pengaturan notifikasi Slack.
+type SlackHandler struct {
+	slackService application.SlackApplicationService
+}
+
+// NewSlackHandler adalah constructor untuk SlackHandler.
+func NewSlackHandler(slackService application.SlackApplicationService) *SlackHandler {
+	return &SlackHandler{
+		slackService: slackService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route Slack. Route ini membutuhkan pengguna terautentikasi.
+func (h *SlackHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/integrations/slack", h.get)
+	mux.HandleFunc("PUT /api/v1/integrations/slack", h.save)
+	mux.HandleFunc("DELETE /api/v1/integrations/slack", h.delete)
+}
+
+func (h *SlackHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	channel, err := h.slackService.GetChannel(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewSlackChannelResponse(channel))
+}
+
+func (h *SlackHandler) save(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.SlackChannelRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	channel, err := h.slackService.SaveChannel(r.Context(), userID, application.SaveSlackChannelInput{
+		WebhookURL:   req.WebhookURL,
+		BotToken:     req.BotToken,
+		ChannelID:    req.ChannelID,
+		EventTypes:   req.EventTypes,
+		ReminderLead: time.Duration(req.ReminderMinutes) * time.Minute,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewSlackChannelResponse(channel))
+}
+
+func (h *SlackHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.slackService.DeleteChannel(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,61 @@
+// file: backend/services/task-service/internal/interfaces/rest/stats_handler.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// StatsHandler menangani endpoint statistik produktivitas.
+type StatsHandler struct {
+	statsService application.StatsApplicationService
+}
+
+// NewStatsHandler adalah constructor untuk StatsHandler.
+func NewStatsHandler(statsService application.StatsApplicationService) *StatsHandler {
+	return &StatsHandler{
+		statsService: statsService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route statistik. Route ini membutuhkan pengguna terautentikasi.
+func (h *StatsHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/stats", h.get)
+}
+
+// get mengembalikan statistik untuk ?days=N hari terakhir (default 30, maksimum 90) di zona waktu ?tz=.
+func (h *StatsHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+
+	loc := time.UTC
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "tz must be an IANA time zone name")
+			return
+		}
+		loc = parsed
+	}
+	days := 30
+	if raw := query.Get("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			writeProblem(w, http.StatusBadRequest, "days must be a positive integer")
+			return
+		}
+		days = parsed
+	}
+
+	stats, err := h.statsService.GetStats(r.Context(), userID, days, loc)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewStatsResponse(stats))
+}
@@ -0,0 +1,122 @@
+// file: backend/services/task-service/internal/interfaces/rest/sync_handler.go
+package rest
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/pkg/syncdict"
+)
+
+// headerDeviceID berisi ID perangkat klien (lihat DeviceHandler). Opsional pada request sync.
+const headerDeviceID = "X-Device-ID"
+
+// SyncHandler menangani endpoint delta sync untuk klien offline-first.
+type SyncHandler struct {
+	syncService application.SyncApplicationService
+	compressor  *zstdCompressor
+}
+
+// NewSyncHandler adalah constructor untuk SyncHandler.
+func NewSyncHandler(syncService application.SyncApplicationService) (*SyncHandler, error) {
+	compressor, err := newZstdCompressor()
+	if err != nil {
+		return nil, err
+	}
+	return &SyncHandler{
+		syncService: syncService,
+		compressor:  compressor,
+	}, nil
+}
+
+// RegisterRoutes mendaftarkan route sync yang membutuhkan pengguna terautentikasi.
+func (h *SyncHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/sync", h.pull)
+	mux.HandleFunc("POST /api/v1/sync", h.push)
+}
+
+// RegisterPublicRoutes mendaftarkan route sync yang tidak membutuhkan autentikasi.
+func (h *SyncHandler) RegisterPublicRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/sync/dictionary", h.dictionary)
+}
+
+// pull mengembalikan task yang berubah sejak query parameter since, yaitu cursor dari response
+// sebelumnya. Cursor lama berupa timestamp RFC 3339 masih diterima tetapi diperlakukan sebagai full
+// sync, karena timestamp tidak bisa dipetakan ke cursor baru tanpa risiko melewatkan perubahan.
+func (h *SyncHandler) pull(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+
+	var since int64
+	if raw := r.URL.Query().Get("since"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || parsed < 0 {
+			if _, timeErr := time.Parse(time.RFC3339Nano, raw); timeErr != nil {
+				writeProblem(w, http.StatusBadRequest, "since must be a cursor from a previous sync response")
+				return
+			}
+			parsed = 0
+		}
+		since = parsed
+	}
+
+	changes, err := h.syncService.PullChanges(r.Context(), userID, r.Header.Get(headerDeviceID), since)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	h.compressor.writeJSON(w, r, http.StatusOK, dto.SyncResponse{
+		Cursor: strconv.FormatInt(changes.Cursor, 10),
+		Tasks:  dto.NewTaskResponses(changes.Tasks),
+	})
+}
+
+// maxPushBatch adalah jumlah task maksimum dalam satu push sync.
+const maxPushBatch = 500
+
+// push menyimpan perubahan task dari klien. Aman diulang karena memakai upsert berdasarkan ID.
+// Response memakai format batch; 207 berarti sebagian task gagal dan perlu dikirim ulang.
+func (h *SyncHandler) push(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.SyncPushRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if len(req.Tasks) > maxPushBatch {
+		writeProblem(w, http.StatusBadRequest, "too many tasks in one push, maximum is "+strconv.Itoa(maxPushBatch))
+		return
+	}
+
+	inputs := make([]application.PushTaskInput, 0, len(req.Tasks))
+	for _, task := range req.Tasks {
+		inputs = append(inputs, application.PushTaskInput{
+			ID:          task.ID,
+			Title:       task.Title,
+			Description: task.Description,
+			Completed:   task.Completed,
+		})
+	}
+
+	results, err := h.syncService.PushChanges(r.Context(), userID, r.Header.Get(headerDeviceID), inputs)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	status, resp := newBatchResponse(r, results)
+	h.compressor.writeJSON(w, r, status, resp)
+}
+
+// dictionary mengirim dictionary zstd yang dipakai untuk payload sync.
+// Klien menyimpannya lalu mengirim X-Zstd-Dictionary-ID pada request sync berikutnya.
+func (h *SyncHandler) dictionary(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	w.Header().Set("ETag", strconv.Quote(syncdict.Checksum()))
+	w.Header().Set(headerZstdDictionaryID, strconv.FormatUint(uint64(syncdict.ID), 10))
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(syncdict.Content()))
+}
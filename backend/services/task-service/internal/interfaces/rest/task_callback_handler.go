@@ -0,0 +1,71 @@
+// file: backend/services/task-service/internal/interfaces/rest/task_callback_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// TaskCallbackHandler menangani endpoint callback sekali pakai per task.
+type TaskCallbackHandler struct {
+	callbackService application.TaskCallbackApplicationService
+}
+
+// NewTaskCallbackHandler adalah constructor untuk TaskCallbackHandler.
+func NewTaskCallbackHandler(callbackService application.TaskCallbackApplicationService) *TaskCallbackHandler {
+	return &TaskCallbackHandler{
+		callbackService: callbackService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route callback task. Route ini membutuhkan pengguna terautentikasi.
+func (h *TaskCallbackHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/tasks/{id}/callbacks", h.create)
+	mux.HandleFunc("GET /api/v1/tasks/{id}/callbacks", h.list)
+	mux.HandleFunc("DELETE /api/v1/tasks/{id}/callbacks/{callbackID}", h.delete)
+}
+
+// create mendaftarkan callback dan mengembalikan secret untuk verifikasi signature.
+func (h *TaskCallbackHandler) create(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.CreateTaskCallbackRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	callback, err := h.callbackService.CreateCallback(r.Context(), userID, r.PathValue("id"), req.URL)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := dto.NewTaskCallbackResponse(callback)
+	resp.Secret = callback.Secret
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+func (h *TaskCallbackHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	callbacks, err := h.callbackService.ListCallbacks(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := make([]dto.TaskCallbackResponse, 0, len(callbacks))
+	for _, callback := range callbacks {
+		resp = append(resp, dto.NewTaskCallbackResponse(callback))
+	}
+	writeJSONList(w, http.StatusOK, resp)
+}
+
+func (h *TaskCallbackHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.callbackService.DeleteCallback(r.Context(), userID, r.PathValue("id"), r.PathValue("callbackID")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
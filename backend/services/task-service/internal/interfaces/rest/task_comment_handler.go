@@ -0,0 +1,134 @@
+// file: backend/services/task-service/internal/interfaces/rest/task_comment_handler.go
+package rest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// TaskCommentHandler menangani endpoint komentar task dan daftar mention pengguna.
+type TaskCommentHandler struct {
+	commentService application.TaskCommentApplicationService
+	profileService application.UserProfileApplicationService
+}
+
+// NewTaskCommentHandler adalah constructor untuk TaskCommentHandler.
+func NewTaskCommentHandler(commentService application.TaskCommentApplicationService, profileService application.UserProfileApplicationService) *TaskCommentHandler {
+	return &TaskCommentHandler{
+		commentService: commentService,
+		profileService: profileService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route komentar. Route ini membutuhkan pengguna terautentikasi.
+func (h *TaskCommentHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/tasks/{id}/comments", auth.ListScoped(http.HandlerFunc(h.list)))
+	mux.Handle("POST /api/v1/tasks/{id}/comments", auth.ListScoped(http.HandlerFunc(h.create)))
+	mux.Handle("DELETE /api/v1/tasks/{id}/comments/{commentID}", auth.ListScoped(http.HandlerFunc(h.delete)))
+	mux.HandleFunc("GET /api/v1/me/mentions", h.listMentions)
+}
+
+func (h *TaskCommentHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	comments, err := h.commentService.ListComments(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	h.writeComments(w, r, http.StatusOK, comments)
+}
+
+// create menambahkan komentar dan memberi tahu pengguna yang di-mention.
+func (h *TaskCommentHandler) create(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.CreateTaskCommentRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	comment, err := h.commentService.AddComment(r.Context(), userID, r.PathValue("id"), req.Body)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp, err := h.withAuthors(r, []*domain.TaskComment{comment})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, resp[0])
+}
+
+func (h *TaskCommentHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.commentService.DeleteComment(r.Context(), userID, r.PathValue("id"), r.PathValue("commentID")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listMentions mengembalikan komentar terbaru yang me-mention pengguna (default 50).
+func (h *TaskCommentHandler) listMentions(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	limit := 50
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeProblem(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	comments, err := h.commentService.ListMentions(r.Context(), userID, limit)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	h.writeComments(w, r, http.StatusOK, comments)
+}
+
+func (h *TaskCommentHandler) writeComments(w http.ResponseWriter, r *http.Request, status int, comments []*domain.TaskComment) {
+	resp, err := h.withAuthors(r, comments)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, status, resp)
+}
+
+// withAuthors memetakan komentar ke response beserta profil penulisnya, dicari per
+// domain.MaxUserProfileLookup penulis.
+func (h *TaskCommentHandler) withAuthors(r *http.Request, comments []*domain.TaskComment) ([]dto.TaskCommentResponse, error) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var authors []domain.UserID
+	for _, comment := range comments {
+		if !slices.Contains(authors, comment.AuthorID) {
+			authors = append(authors, comment.AuthorID)
+		}
+	}
+	profiles := make(map[domain.UserID]dto.UserProfileResponse, len(authors))
+	for chunk := range slices.Chunk(authors, domain.MaxUserProfileLookup) {
+		found, err := h.profileService.GetProfiles(r.Context(), userID, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range found {
+			profiles[profile.UserID] = dto.NewUserProfileResponse(profile)
+		}
+	}
+
+	resp := dto.NewTaskCommentResponses(comments)
+	for i, comment := range comments {
+		if profile, ok := profiles[comment.AuthorID]; ok {
+			resp[i].Author = &profile
+		}
+	}
+	return resp, nil
+}
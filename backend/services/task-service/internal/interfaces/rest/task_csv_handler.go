@@ -0,0 +1,314 @@
+// file: backend/services/task-service/internal/interfaces/rest/task_csv_handler.go
+package rest
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+const (
+	// maxTaskCSVImportSize membatasi ukuran file CSV yang diimpor.
+	maxTaskCSVImportSize = 8 << 20
+
+	// maxTaskCSVImportRows adalah jumlah baris data terbanyak dalam satu impor.
+	maxTaskCSVImportRows = 2000
+)
+
+// taskCSVColumns adalah kolom file ekspor, sesuai urutan. Kolom yang juga dibaca saat impor ada di
+// taskCSVImportColumns.
+var taskCSVColumns = []string{
+	"id", "title", "description", "completed", "completed_at", "priority", "due_at", "due_text",
+	"estimate_minutes", "color", "icon", "archived", "created_at", "updated_at",
+}
+
+// taskCSVImportColumns adalah field yang bisa diisi dari file impor.
+var taskCSVImportColumns = map[string]bool{
+	"id": true, "title": true, "description": true, "completed": true, "priority": true,
+	"due_at": true, "due_text": true, "estimate_minutes": true, "color": true, "icon": true,
+}
+
+// taskCSVDelimiters adalah nilai query parameter delimiter. Titik koma dipakai spreadsheet di
+// locale yang memakai koma sebagai pemisah desimal.
+var taskCSVDelimiters = map[string]rune{"": ',', "comma": ',', "semicolon": ';', "tab": '\t'}
+
+// TaskCSVHandler menangani ekspor dan impor task sebagai CSV untuk spreadsheet.
+type TaskCSVHandler struct {
+	taskService application.TaskApplicationService
+	bulkService application.BulkTaskApplicationService
+}
+
+// NewTaskCSVHandler adalah constructor untuk TaskCSVHandler.
+func NewTaskCSVHandler(taskService application.TaskApplicationService, bulkService application.BulkTaskApplicationService) *TaskCSVHandler {
+	return &TaskCSVHandler{
+		taskService: taskService,
+		bulkService: bulkService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route CSV. Route ini membutuhkan pengguna terautentikasi.
+func (h *TaskCSVHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/tasks/export.csv", h.export)
+	mux.HandleFunc("POST /api/v1/tasks/import", h.importCSV)
+}
+
+// export menulis semua task milik pengguna, termasuk yang di-snooze dan diarsipkan, halaman demi
+// halaman sehingga daftar besar tidak ditampung di memori. Error setelah header terkirim hanya bisa
+// di-log; file yang terpotong tidak diakhiri baris kosong.
+func (h *TaskCSVHandler) export(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	page, err := h.taskService.GetTasksPage(r.Context(), userID, domain.TaskPageQuery{Limit: maxPageLimit})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="tasks.csv"`)
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+	cw := csv.NewWriter(w)
+	if err := cw.Write(taskCSVColumns); err != nil {
+		return
+	}
+	for {
+		for _, task := range page.Tasks {
+			if err := cw.Write(taskCSVRecord(task)); err != nil {
+				return
+			}
+		}
+		if cw.Flush(); cw.Error() != nil {
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+		if page.NextCursor == "" {
+			return
+		}
+		page, err = h.taskService.GetTasksPage(r.Context(), userID, domain.TaskPageQuery{Limit: maxPageLimit, Cursor: page.NextCursor})
+		if err != nil {
+			slog.ErrorContext(r.Context(), "error exporting tasks as csv", "error", err)
+			return
+		}
+	}
+}
+
+// taskCSVRecord memetakan task ke satu baris sesuai taskCSVColumns. Waktu ditulis dalam RFC 3339 UTC.
+func taskCSVRecord(task *domain.Task) []string {
+	optional := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	timestamp := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	estimate := ""
+	if task.EstimateMinutes != nil {
+		estimate = strconv.Itoa(*task.EstimateMinutes)
+	}
+	return []string{
+		task.ID,
+		escapeCSVFormula(task.Title),
+		escapeCSVFormula(task.Description),
+		strconv.FormatBool(task.Completed),
+		timestamp(task.CompletedAt),
+		optional(task.Priority),
+		timestamp(task.DueAt),
+		escapeCSVFormula(optional(task.DueText)),
+		estimate,
+		optional(task.Color),
+		optional(task.Icon),
+		strconv.FormatBool(task.Archived),
+		timestamp(&task.CreatedAt),
+		timestamp(&task.UpdatedAt),
+	}
+}
+
+// escapeCSVFormula mencegah teks pengguna dibaca spreadsheet sebagai formula (CSV injection)
+// dengan awalan apostrof. unescapeCSVFormula membuangnya lagi saat impor.
+func escapeCSVFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func unescapeCSVFormula(s string) string {
+	if len(s) > 1 && s[0] == '\'' && strings.ContainsRune("=+-@\t\r", rune(s[1])) {
+		return s[1:]
+	}
+	return s
+}
+
+// importCSV membuat task dari file CSV dengan baris header. Kolom dicocokkan dengan nama field
+// (seperti file ekspor), atau dipetakan dengan map=field:Header, misalnya map=title:Task%20Name.
+// Kolom lain diabaikan. Hasilnya memakai format batch: index 0 adalah baris data pertama, dan
+// baris yang gagal tidak membatalkan baris lain.
+func (h *TaskCSVHandler) importCSV(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+	mapping := make(map[string]string) // Header huruf kecil -> field
+	for _, entry := range query["map"] {
+		field, header, ok := strings.Cut(entry, ":")
+		if !ok || !taskCSVImportColumns[field] || strings.TrimSpace(header) == "" {
+			writeProblem(w, http.StatusBadRequest, "map must be field:Header with one of the importable fields: "+strings.Join(taskCSVImportFields(), ", "))
+			return
+		}
+		mapping[strings.ToLower(strings.TrimSpace(header))] = field
+	}
+	delimiter, ok := taskCSVDelimiters[query.Get("delimiter")]
+	if !ok {
+		writeProblem(w, http.StatusBadRequest, "delimiter must be comma, semicolon, or tab")
+		return
+	}
+
+	cr := csv.NewReader(http.MaxBytesReader(w, r.Body, maxTaskCSVImportSize))
+	cr.Comma = delimiter
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil { // Termasuk file kosong dan file yang melebihi batas ukuran
+		writeProblem(w, http.StatusBadRequest, "invalid csv: a header row is required")
+		return
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\uFEFF") // BOM dari Excel
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		field, ok := mapping[name]
+		if !ok && taskCSVImportColumns[name] {
+			field = name
+		}
+		if _, dup := columns[field]; field != "" && !dup {
+			columns[field] = i
+		}
+	}
+	if _, ok := columns["title"]; !ok {
+		writeProblem(w, http.StatusBadRequest, "invalid csv: no title column; map one with map=title:Header")
+		return
+	}
+
+	var (
+		results []application.BatchItemResult
+		inputs  []application.ImportTaskInput
+		rows    []int // Index baris untuk setiap inputs
+	)
+	for index := 0; ; index++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeProblem(w, http.StatusRequestEntityTooLarge, "csv must be at most "+strconv.Itoa(maxTaskCSVImportSize)+" bytes")
+			return
+		}
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "invalid csv: "+err.Error())
+			return
+		}
+		if index == maxTaskCSVImportRows {
+			writeProblem(w, http.StatusBadRequest, "csv must contain at most "+strconv.Itoa(maxTaskCSVImportRows)+" rows")
+			return
+		}
+		input, err := taskCSVImportInput(record, columns)
+		if err != nil {
+			results = append(results, application.BatchItemResult{Index: index, ID: input.ID, Err: err})
+			continue
+		}
+		inputs = append(inputs, input)
+		rows = append(rows, index)
+	}
+	if len(inputs)+len(results) == 0 {
+		writeProblem(w, http.StatusBadRequest, "invalid csv: no rows to import")
+		return
+	}
+
+	for i, result := range h.bulkService.ImportTasks(r.Context(), userID, inputs) {
+		result.Index = rows[i]
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	status, resp := newBatchResponse(r, results)
+	writeJSON(w, status, resp)
+}
+
+// taskCSVImportInput membaca satu baris CSV. due_at (RFC 3339, seperti file ekspor) diutamakan atas
+// due_text, karena due_text hasil ekspor bisa relatif ("tomorrow") terhadap waktu task dibuat.
+func taskCSVImportInput(record []string, columns map[string]int) (application.ImportTaskInput, error) {
+	value := func(field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return unescapeCSVFormula(strings.TrimSpace(record[i]))
+	}
+	optional := func(field string) *string {
+		if v := value(field); v != "" {
+			return &v
+		}
+		return nil
+	}
+
+	var input application.ImportTaskInput
+	input.ID = value("id")
+	input.Title = value("title")
+	input.Description = value("description")
+	input.Priority = optional("priority")
+	input.Color = optional("color")
+	input.Icon = optional("icon")
+	if raw := value("completed"); raw != "" {
+		completed, err := strconv.ParseBool(strings.ToLower(raw))
+		if err != nil {
+			return input, fmt.Errorf("%w: completed must be true or false", domain.ErrInvalidImportRow)
+		}
+		input.Completed = completed
+	}
+	if raw := value("estimate_minutes"); raw != "" {
+		estimate, err := strconv.Atoi(raw)
+		if err != nil {
+			return input, fmt.Errorf("%w: estimate_minutes must be a whole number", domain.ErrInvalidImportRow)
+		}
+		input.EstimateMinutes = &estimate
+	}
+	if raw := value("due_at"); raw != "" {
+		dueAt, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return input, fmt.Errorf("%w: due_at must be an RFC 3339 timestamp", domain.ErrInvalidImportRow)
+		}
+		dueText := dueAt.UTC().Format("2006-01-02 15:04")
+		input.DueText, input.DueLocation = &dueText, time.UTC
+	} else {
+		input.DueText = optional("due_text")
+	}
+	return input, nil
+}
+
+// taskCSVImportFields mengembalikan nama field impor secara urut untuk pesan error.
+func taskCSVImportFields() []string {
+	fields := make([]string, 0, len(taskCSVImportColumns))
+	for _, column := range taskCSVColumns {
+		if taskCSVImportColumns[column] {
+			fields = append(fields, column)
+		}
+	}
+	return fields
+}
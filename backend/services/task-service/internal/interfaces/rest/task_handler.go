@@ -0,0 +1,611 @@
+// file: backend/services/task-service/internal/interfaces/rest/task_handler.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// TaskHandler menangani endpoint REST untuk resource task.
+type TaskHandler struct {
+	taskService application.TaskApplicationService
+}
+
+// NewTaskHandler adalah constructor untuk TaskHandler.
+func NewTaskHandler(taskService application.TaskApplicationService) *TaskHandler {
+	return &TaskHandler{
+		taskService: taskService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route task ke mux. Route ini membutuhkan pengguna terautentikasi.
+func (h *TaskHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/tasks", auth.RequireList("", http.HandlerFunc(h.list)))
+	mux.Handle("POST /api/v1/tasks", auth.ListScoped(http.HandlerFunc(h.create)))
+	mux.HandleFunc("GET /api/v1/tasks/completed", h.listCompleted)
+	mux.HandleFunc("GET /api/v1/tasks/archived", h.listArchived)
+	mux.HandleFunc("GET /api/v1/tasks/assigned", h.listAssigned)
+	mux.HandleFunc("GET /api/v1/tasks/appearance", h.appearance)
+	mux.HandleFunc("GET /api/v1/tasks/counts", h.counts)
+	mux.HandleFunc("GET /api/v1/tasks/estimates", h.estimates)
+	mux.HandleFunc("GET /api/v1/tasks/search", h.search)
+	mux.HandleFunc("PATCH /api/v1/tasks/reorder", h.reorder)
+	mux.Handle("GET /api/v1/tasks/{id}", auth.ListScoped(http.HandlerFunc(h.get)))
+	mux.Handle("PATCH /api/v1/tasks/{id}", auth.ListScoped(http.HandlerFunc(h.update)))
+	mux.Handle("DELETE /api/v1/tasks/{id}", auth.ListScoped(http.HandlerFunc(h.delete)))
+	mux.Handle("POST /api/v1/tasks/{id}/complete", auth.ListScoped(http.HandlerFunc(h.complete)))
+	mux.Handle("POST /api/v1/tasks/{id}/uncomplete", auth.ListScoped(http.HandlerFunc(h.uncomplete)))
+	mux.Handle("POST /api/v1/tasks/{id}/snooze", auth.ListScoped(http.HandlerFunc(h.snooze)))
+	mux.Handle("DELETE /api/v1/tasks/{id}/snooze", auth.ListScoped(http.HandlerFunc(h.unsnooze)))
+	mux.Handle("POST /api/v1/tasks/{id}/archive", auth.ListScoped(http.HandlerFunc(h.archive)))
+	mux.Handle("POST /api/v1/tasks/{id}/unarchive", auth.ListScoped(http.HandlerFunc(h.unarchive)))
+	mux.Handle("PUT /api/v1/tasks/{id}/assignee", auth.ListScoped(http.HandlerFunc(h.assign)))
+	mux.Handle("DELETE /api/v1/tasks/{id}/assignee", auth.ListScoped(http.HandlerFunc(h.unassign)))
+}
+
+// maxPageLimit adalah nilai maksimum query parameter limit pada daftar task.
+const maxPageLimit = 200
+
+// maxReorderIDs adalah jumlah ID maksimum dalam satu request reorder.
+const maxReorderIDs = 1000
+
+// headerTotalCount berisi jumlah item sebuah daftar. Pada GET /api/v1/tasks nilainya adalah jumlah
+// seluruh task yang cocok dengan filter, juga saat hasilnya dipaginasi atau dikelompokkan; pada
+// daftar lain nilainya diisi writeJSONList dari jumlah elemen body.
+const headerTotalCount = "X-Total-Count"
+
+// headerNextCursor berisi cursor halaman berikutnya saat daftar task dipaginasi.
+const headerNextCursor = "X-Next-Cursor"
+
+// list mengembalikan task milik pengguna. Tanpa limit/cursor semua task dikembalikan
+// (perilaku lama). Dengan limit/cursor hasilnya dipaginasi; body tetap berupa array
+// dan cursor halaman berikutnya dikirim lewat header X-Next-Cursor.
+// Query parameter sort=position mengurutkan sesuai urutan manual, dan sort=title mengurutkan judul
+// sesuai bahasa dari query parameter locale atau header Accept-Language (hanya tanpa pagination).
+// Task yang diarsipkan tidak pernah ikut (lihat GET /api/v1/tasks/archived), dan task yang masih
+// di-snooze disembunyikan kecuali dengan include_snoozed=true.
+// Dengan group_by, body berupa array grup (lihat listGroups).
+// Setiap bentuk daftar mengirim X-Total-Count dari filter yang sama. Request HEAD divalidasi seperti
+// GET, lalu hanya mengirim header tanpa memuat task.
+func (h *TaskHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+	includeSnoozed := false
+	if raw := query.Get("include_snoozed"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "include_snoozed must be a boolean")
+			return
+		}
+		includeSnoozed = parsed
+	}
+	var hideSnoozedAt time.Time
+	if !includeSnoozed {
+		hideSnoozedAt = time.Now()
+	}
+
+	sort := domain.TaskSort(query.Get("sort"))
+	if query.Has("group_by") {
+		if sort != "" && sort != domain.TaskSortCreated {
+			writeProblem(w, http.StatusBadRequest, "sort="+string(sort)+" cannot be combined with group_by")
+			return
+		}
+		h.listGroups(w, r, hideSnoozedAt)
+		return
+	}
+	if query.Has("limit") || query.Has("cursor") {
+		if sort != "" && sort != domain.TaskSortCreated {
+			writeProblem(w, http.StatusBadRequest, "sort="+string(sort)+" cannot be combined with limit or cursor")
+			return
+		}
+		h.listPage(w, r, query.Get("limit"), query.Get("cursor"), hideSnoozedAt)
+		return
+	}
+
+	if err := sort.Validate(); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if r.Method == http.MethodHead {
+		// Tanpa pagination, GET memakai jumlah task yang dimuat; HEAD cukup menghitungnya.
+		h.writeTotalCount(w, r, hideSnoozedAt)
+		return
+	}
+	tasks, err := h.taskService.GetTasksByUserID(r.Context(), userID, domain.TaskOrder{
+		Sort:          sort,
+		Locale:        requestLocale(r),
+		HideSnoozedAt: hideSnoozedAt,
+		HideArchived:  true,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewTaskResponses(tasks))
+}
+
+// writeTotalCount mengisi header X-Total-Count daftar task dari query count, untuk response yang
+// body-nya tidak memuat semua task (halaman, grup, atau HEAD). Mengembalikan false jika response
+// sudah ditulis: error, atau request HEAD yang cukup dijawab dengan header saja.
+func (h *TaskHandler) writeTotalCount(w http.ResponseWriter, r *http.Request, hideSnoozedAt time.Time) bool {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	total, err := h.taskService.CountTasks(r.Context(), userID, hideSnoozedAt)
+	if err != nil {
+		writeError(w, r, err)
+		return false
+	}
+	w.Header().Set(headerTotalCount, strconv.FormatInt(total, 10))
+	if r.Method == http.MethodHead {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		return false
+	}
+	return true
+}
+
+func (h *TaskHandler) listPage(w http.ResponseWriter, r *http.Request, rawLimit, cursor string, hideSnoozedAt time.Time) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	limit := 50
+	if rawLimit != "" {
+		parsed, err := strconv.Atoi(rawLimit)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeProblem(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	if !h.writeTotalCount(w, r, hideSnoozedAt) {
+		return
+	}
+
+	page, err := h.taskService.GetTasksPage(r.Context(), userID, domain.TaskPageQuery{
+		Limit:         limit,
+		Cursor:        cursor,
+		HideSnoozedAt: hideSnoozedAt,
+		HideArchived:  true,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if page.NextCursor != "" {
+		w.Header().Set(headerNextCursor, page.NextCursor)
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponses(page.Tasks))
+}
+
+// listGroups mengembalikan task yang dikelompokkan menurut group_by (status, column, atau priority), masing-masing
+// dengan count dan paling banyak limit task. Halaman berikutnya sebuah grup diminta dengan
+// group=<key>&cursor=<next_cursor>.
+func (h *TaskHandler) listGroups(w http.ResponseWriter, r *http.Request, hideSnoozedAt time.Time) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+	limit := 50
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeProblem(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	groupBy := domain.TaskGroupBy(query.Get("group_by"))
+	if err := groupBy.Validate(); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if !h.writeTotalCount(w, r, hideSnoozedAt) {
+		return
+	}
+
+	groups, err := h.taskService.GetTaskGroups(r.Context(), userID, domain.TaskGroupQuery{
+		GroupBy:       groupBy,
+		Limit:         limit,
+		Group:         query.Get("group"),
+		Cursor:        query.Get("cursor"),
+		HideSnoozedAt: hideSnoozedAt,
+		HideArchived:  true,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskGroupResponses(groups))
+}
+
+func (h *TaskHandler) create(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.CreateTaskRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	loc, err := dueLocation(req.TimeZone)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	task, created, err := h.taskService.CreateTask(r.Context(), userID, application.CreateTaskInput{
+		ID:              req.ID,
+		Title:           req.Title,
+		Description:     req.Description,
+		EstimateMinutes: req.EstimateMinutes,
+		Priority:        req.Priority,
+		DueText:         req.DueText,
+		DueLocation:     loc,
+		Color:           req.Color,
+		Icon:            req.Icon,
+		OwnerID:         domain.UserID(req.OwnerID),
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if !created {
+		// ID dari klien sudah ada, sehingga task tersebut ditimpa, bukan dibuat.
+		writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+		return
+	}
+	w.Header().Set("Location", "/api/v1/tasks/"+task.ID)
+	writeJSON(w, http.StatusCreated, dto.NewTaskResponse(task))
+}
+
+// dueLocation memuat zona waktu time_zone dari body request. Kosong berarti nil, sehingga service
+// memakai zona waktu tersimpan pengguna.
+func dueLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, domain.ErrInvalidTimeZone
+	}
+	return loc, nil
+}
+
+func (h *TaskHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	task, err := h.taskService.GetTaskByID(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+func (h *TaskHandler) update(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.UpdateTaskRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	loc, err := dueLocation(req.TimeZone)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	task, err := h.taskService.UpdateTask(r.Context(), userID, r.PathValue("id"), application.UpdateTaskInput{
+		Title:           req.Title,
+		Description:     req.Description,
+		Completed:       req.Completed,
+		EstimateMinutes: req.EstimateMinutes,
+		Priority:        req.Priority,
+		DueText:         req.DueText,
+		DueLocation:     loc,
+		Color:           req.Color,
+		Icon:            req.Icon,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+func (h *TaskHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.taskService.DeleteTask(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *TaskHandler) complete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	task, err := h.taskService.CompleteTask(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+func (h *TaskHandler) uncomplete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	task, err := h.taskService.UncompleteTask(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+// counts mengembalikan jumlah task pengguna (total, belum selesai, selesai) dari counter cache,
+// sehingga sidebar tidak perlu memuat seluruh daftar task.
+func (h *TaskHandler) counts(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	counters, err := h.taskService.GetTaskCounters(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskCountersResponse(counters))
+}
+
+// search mencari task berdasarkan query parameter q, diurutkan dari yang paling relevan.
+func (h *TaskHandler) search(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+	limit := 50
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeProblem(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	tasks, err := h.taskService.SearchTasks(r.Context(), userID, query.Get("q"), limit)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewTaskResponses(tasks))
+}
+
+// reorder menyimpan urutan manual task dari drag-and-drop di UI. Body berisi salah satu dari:
+//
+//	{"ids": ["id-1", "id-2", ...]}              // urutan lengkap dari atas ke bawah
+//	{"move": {"id": "id-3", "after_id": "id-1"}} // pindahkan satu task; after_id null = paling atas
+//
+// Response berisi task yang disebut dalam request dengan Position barunya, terurut dari atas.
+func (h *TaskHandler) reorder(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.ReorderTasksRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	switch {
+	case (len(req.IDs) > 0) == (req.Move != nil):
+		writeProblem(w, http.StatusBadRequest, "exactly one of ids or move must be set")
+	case len(req.IDs) > maxReorderIDs:
+		writeProblem(w, http.StatusBadRequest, "ids must contain at most "+strconv.Itoa(maxReorderIDs)+" tasks")
+	case req.Move != nil:
+		afterID := ""
+		if req.Move.AfterID != nil {
+			afterID = *req.Move.AfterID
+		}
+		task, err := h.taskService.MoveTask(r.Context(), userID, req.Move.ID, afterID)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, dto.NewTaskResponses([]*domain.Task{task}))
+	default:
+		tasks, err := h.taskService.ReorderTasks(r.Context(), userID, req.IDs)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, dto.NewTaskResponses(tasks))
+	}
+}
+
+// snooze menyembunyikan task dari daftar default sampai waktu yang diminta, baik relatif
+// ({"duration": "3h"}) maupun absolut ({"until": "2026-01-02T09:00:00+07:00"}).
+func (h *TaskHandler) snooze(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.SnoozeTaskRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	var until time.Time
+	switch {
+	case req.Duration != "" && req.Until != nil, req.Duration == "" && req.Until == nil:
+		writeProblem(w, http.StatusBadRequest, "exactly one of duration and until is required")
+		return
+	case req.Duration != "":
+		duration, err := time.ParseDuration(req.Duration)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "duration must be a Go duration such as 90m or 3h")
+			return
+		}
+		until = time.Now().Add(duration)
+	default:
+		until = *req.Until
+	}
+
+	task, err := h.taskService.SnoozeTask(r.Context(), userID, r.PathValue("id"), until)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+func (h *TaskHandler) unsnooze(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	task, err := h.taskService.UnsnoozeTask(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+func (h *TaskHandler) archive(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	task, err := h.taskService.ArchiveTask(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+func (h *TaskHandler) unarchive(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	task, err := h.taskService.UnarchiveTask(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+// listArchived mengembalikan task yang diarsipkan, dari yang terakhir diselesaikan.
+func (h *TaskHandler) listArchived(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	tasks, err := h.taskService.GetArchivedTasks(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewTaskResponses(tasks))
+}
+
+// appearance mengembalikan warna dan ikon yang boleh dipakai task.
+func (h *TaskHandler) appearance(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, dto.TaskAppearanceResponse{
+		Colors: domain.TaskColors,
+		Icons:  domain.TaskIcons,
+	})
+}
+
+// listAssigned mengembalikan task yang ditugaskan kepada pengguna, baik di daftar sendiri maupun
+// daftar bersama, dengan parameter sort dan locale yang sama dengan GET /api/v1/tasks.
+// Task yang diarsipkan dan yang masih di-snooze tidak ikut.
+func (h *TaskHandler) listAssigned(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	tasks, err := h.taskService.GetAssignedTasks(r.Context(), userID, domain.TaskOrder{
+		Sort:          domain.TaskSort(r.URL.Query().Get("sort")),
+		Locale:        requestLocale(r),
+		HideSnoozedAt: time.Now(),
+		HideArchived:  true,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewTaskResponses(tasks))
+}
+
+// assign menugaskan task kepada pemilik daftar atau salah satu kolaboratornya.
+func (h *TaskHandler) assign(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.AssignTaskRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	task, err := h.taskService.AssignTask(r.Context(), userID, r.PathValue("id"), domain.UserID(req.AssigneeID))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+func (h *TaskHandler) unassign(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	task, err := h.taskService.UnassignTask(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
+
+// listCompleted mengembalikan task yang diselesaikan pada satu hari kalender.
+// Query parameter: date (YYYY-MM-DD, default hari ini) dan tz (zona waktu IANA, default UTC),
+// misalnya ?tz=Asia/Jakarta untuk tampilan "selesai hari ini" sesuai waktu lokal pengguna.
+func (h *TaskHandler) listCompleted(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+
+	loc := time.UTC
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "tz must be an IANA time zone name")
+			return
+		}
+		loc = parsed
+	}
+
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if date := query.Get("date"); date != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, date, loc)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "date must be formatted as YYYY-MM-DD")
+			return
+		}
+		from = parsed
+	}
+
+	tasks, err := h.taskService.GetCompletedTasks(r.Context(), userID, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewTaskResponses(tasks))
+}
+
+// estimates mengembalikan sisa usaha task yang belum selesai dan usaha yang diselesaikan per hari.
+// Query parameter: days (default 7, maksimum 31) dan tz (zona waktu IANA, default UTC).
+func (h *TaskHandler) estimates(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+
+	loc := time.UTC
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "tz must be an IANA time zone name")
+			return
+		}
+		loc = parsed
+	}
+	days := 7
+	if raw := query.Get("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			writeProblem(w, http.StatusBadRequest, "days must be a positive integer")
+			return
+		}
+		days = parsed
+	}
+
+	summary, err := h.taskService.GetEstimateSummary(r.Context(), userID, days, loc)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewEstimateSummaryResponse(summary))
+}
@@ -0,0 +1,57 @@
+// file: backend/services/task-service/internal/interfaces/rest/task_history_handler.go
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// TaskHistoryHandler menangani endpoint riwayat perubahan task.
+type TaskHistoryHandler struct {
+	historyService application.TaskHistoryApplicationService
+}
+
+// NewTaskHistoryHandler adalah constructor untuk TaskHistoryHandler.
+func NewTaskHistoryHandler(historyService application.TaskHistoryApplicationService) *TaskHistoryHandler {
+	return &TaskHistoryHandler{
+		historyService: historyService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route riwayat task. Route ini membutuhkan pengguna terautentikasi.
+func (h *TaskHistoryHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/tasks/{id}/history", auth.ListScoped(http.HandlerFunc(h.list)))
+	mux.Handle("POST /api/v1/tasks/{id}/history/{revision}/revert", auth.ListScoped(http.HandlerFunc(h.revert)))
+}
+
+// list mengembalikan revisi task dari yang terbaru, masing-masing dengan field yang berubah.
+func (h *TaskHistoryHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	revisions, err := h.historyService.GetHistory(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewTaskRevisionResponses(revisions))
+}
+
+// revert mengembalikan task ke revisi tertentu dan mengembalikan task yang sudah diperbarui.
+func (h *TaskHistoryHandler) revert(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	revision, err := strconv.Atoi(r.PathValue("revision"))
+	if err != nil || revision < 1 {
+		writeProblem(w, http.StatusBadRequest, "revision must be a positive integer")
+		return
+	}
+
+	task, err := h.historyService.RevertToRevision(r.Context(), userID, r.PathValue("id"), revision)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTaskResponse(task))
+}
@@ -0,0 +1,160 @@
+// file: backend/services/task-service/internal/interfaces/rest/task_markdown_handler.go
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+)
+
+// markdownEscaper meng-escape karakter yang bisa mengubah format inline Markdown (GFM), sehingga
+// judul seperti "*penting*" atau "[x]" tampil apa adanya.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`, "~", `\~`,
+)
+
+// TaskMarkdownHandler menangani ekspor daftar task sebagai checklist Markdown.
+type TaskMarkdownHandler struct {
+	taskService application.TaskApplicationService
+}
+
+// NewTaskMarkdownHandler adalah constructor untuk TaskMarkdownHandler.
+func NewTaskMarkdownHandler(taskService application.TaskApplicationService) *TaskMarkdownHandler {
+	return &TaskMarkdownHandler{
+		taskService: taskService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route ekspor Markdown. Route ini membutuhkan pengguna terautentikasi.
+func (h *TaskMarkdownHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/lists/{id}/export.md", auth.RequireList("id", http.HandlerFunc(h.export)))
+}
+
+// export menulis task di daftar {id} sebagai checklist GFM. Daftar task adalah seluruh task milik
+// satu pengguna, jadi {id} adalah ID pemilik daftar; "me" berarti daftar pengguna sendiri, dan
+// daftar lain membutuhkan akses baca sebagai kolaborator. Query parameter: tz (zona waktu IANA
+// untuk tenggat, default UTC), sort (seperti daftar task), dan completed=false untuk melewatkan
+// task yang sudah selesai. Task yang diarsipkan tidak diekspor.
+func (h *TaskMarkdownHandler) export(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	query := r.URL.Query()
+
+	loc := time.UTC
+	if tz := query.Get("tz"); tz != "" {
+		parsed, err := time.LoadLocation(tz)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "tz must be an IANA time zone name")
+			return
+		}
+		loc = parsed
+	}
+	includeCompleted := true
+	switch query.Get("completed") {
+	case "", "true":
+	case "false":
+		includeCompleted = false
+	default:
+		writeProblem(w, http.StatusBadRequest, "completed must be true or false")
+		return
+	}
+
+	ownerID := domain.UserID(r.PathValue("id"))
+	if ownerID == "me" {
+		ownerID = userID
+	}
+	order := domain.TaskOrder{
+		Sort:         domain.TaskSort(query.Get("sort")),
+		Locale:       requestLocale(r),
+		HideArchived: true,
+	}
+	var (
+		tasks []*domain.Task
+		err   error
+	)
+	if ownerID == userID {
+		tasks, err = h.taskService.GetTasksByUserID(r.Context(), userID, order)
+	} else {
+		tasks, err = h.taskService.GetSharedTasks(r.Context(), userID, ownerID, order)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("# Tasks\n\n")
+	open, completed := 0, 0
+	for _, task := range tasks {
+		if task.Completed {
+			completed++
+		} else {
+			open++
+		}
+	}
+	fmt.Fprintf(&b, "_%d open, %d completed · exported %s_\n\n", open, completed, time.Now().In(loc).Format("2 Jan 2006 15:04 MST"))
+	for _, task := range tasks {
+		if task.Completed && !includeCompleted {
+			continue
+		}
+		writeMarkdownTask(&b, task, loc)
+	}
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="tasks.md"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(b.String()))
+}
+
+// writeMarkdownTask menulis satu item checklist: judul, tenggat, lalu prioritas, warna, dan ikon
+// sebagai tag `nama:nilai` (task belum punya tag bebas). Deskripsi sudah berupa Markdown, jadi ditulis apa
+// adanya sebagai paragraf lanjutan item.
+func writeMarkdownTask(b *strings.Builder, task *domain.Task, loc *time.Location) {
+	check := " "
+	if task.Completed {
+		check = "x"
+	}
+	title := escapeMarkdown(strings.Join(strings.Fields(task.Title), " "))
+	if task.Completed {
+		title = "~~" + title + "~~"
+	}
+	fmt.Fprintf(b, "- [%s] %s", check, title)
+
+	if task.DueAt != nil {
+		fmt.Fprintf(b, " — due %s", task.DueAt.In(loc).Format("Mon, 2 Jan 2006 15:04 MST"))
+	}
+	var tags []string
+	for _, tag := range []struct {
+		name  string
+		value *string
+	}{{"priority", task.Priority}, {"color", task.Color}, {"icon", task.Icon}} {
+		if tag.value != nil && *tag.value != "" {
+			tags = append(tags, "`"+tag.name+":"+strings.ReplaceAll(*tag.value, "`", "")+"`")
+		}
+	}
+	if len(tags) > 0 {
+		b.WriteString(" " + strings.Join(tags, " "))
+	}
+	b.WriteString("\n")
+
+	if description := strings.TrimSpace(task.Description); description != "" {
+		b.WriteString("\n")
+		for _, line := range strings.Split(description, "\n") {
+			if line = strings.TrimRight(line, " \t\r"); line != "" {
+				b.WriteString("  " + line)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+}
+
+// escapeMarkdown meng-escape teks pengguna untuk satu baris Markdown.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
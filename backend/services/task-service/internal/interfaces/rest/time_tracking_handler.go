@@ -0,0 +1,80 @@
+// file: backend/services/task-service/internal/interfaces/rest/time_tracking_handler.go
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// TimeTrackingHandler menangani endpoint timer task.
+type TimeTrackingHandler struct {
+	timeService application.TimeTrackingApplicationService
+}
+
+// NewTimeTrackingHandler adalah constructor untuk TimeTrackingHandler.
+func NewTimeTrackingHandler(timeService application.TimeTrackingApplicationService) *TimeTrackingHandler {
+	return &TimeTrackingHandler{
+		timeService: timeService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route timer. Route ini membutuhkan pengguna terautentikasi.
+func (h *TimeTrackingHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/tasks/{id}/timer/start", h.start)
+	mux.HandleFunc("POST /api/v1/tasks/{id}/timer/stop", h.stop)
+	mux.HandleFunc("GET /api/v1/tasks/{id}/time-entries", h.taskTime)
+	mux.HandleFunc("GET /api/v1/timer", h.running)
+}
+
+func (h *TimeTrackingHandler) start(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	entry, err := h.timeService.StartTimer(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.NewTimeEntryResponse(entry, time.Now()))
+}
+
+func (h *TimeTrackingHandler) stop(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	entry, err := h.timeService.StopTimer(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTimeEntryResponse(entry, time.Now()))
+}
+
+// taskTime mengembalikan semua sesi timer task dan total waktunya.
+func (h *TimeTrackingHandler) taskTime(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	report, err := h.timeService.GetTaskTime(r.Context(), userID, r.PathValue("id"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := dto.TaskTimeResponse{
+		Entries:      make([]dto.TimeEntryResponse, 0, len(report.Entries)),
+		TotalSeconds: int64(report.Total / time.Second),
+	}
+	for _, entry := range report.Entries {
+		resp.Entries = append(resp.Entries, dto.NewTimeEntryResponse(entry, report.GeneratedAt))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// running mengembalikan timer pengguna yang sedang berjalan, atau 404 jika tidak ada.
+func (h *TimeTrackingHandler) running(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	entry, err := h.timeService.GetRunningTimer(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewTimeEntryResponse(entry, time.Now()))
+}
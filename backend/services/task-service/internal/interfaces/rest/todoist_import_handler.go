@@ -0,0 +1,98 @@
+// file: backend/services/task-service/internal/interfaces/rest/todoist_import_handler.go
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// maxTodoistImportSize membatasi ukuran body impor Todoist, termasuk dokumen ekspornya.
+const maxTodoistImportSize = 16 << 20
+
+// TodoistImportHandler menangani impor dari Todoist.
+type TodoistImportHandler struct {
+	importService application.TodoistImportApplicationService
+}
+
+// NewTodoistImportHandler adalah constructor untuk TodoistImportHandler.
+func NewTodoistImportHandler(importService application.TodoistImportApplicationService) *TodoistImportHandler {
+	return &TodoistImportHandler{importService: importService}
+}
+
+// RegisterRoutes mendaftarkan route impor Todoist. Route ini membutuhkan pengguna terautentikasi.
+func (h *TodoistImportHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/import/todoist", h.importTodoist)
+}
+
+// importTodoist membuat task dari ekspor Todoist, atau dengan dry_run hanya melaporkan task yang
+// akan dibuat. Seperti bulk create, response 207 jika ada task yang gagal dibuat.
+func (h *TodoistImportHandler) importTodoist(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	r.Body = http.MaxBytesReader(w, r.Body, maxTodoistImportSize)
+	var req dto.TodoistImportRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	input := application.TodoistImportInput{APIToken: req.APIToken, DryRun: req.DryRun}
+	if len(req.Export) > 0 && string(req.Export) != "null" {
+		input.Export = &domain.TodoistExport{}
+		if err := json.Unmarshal(req.Export, input.Export); err != nil {
+			writeProblem(w, http.StatusBadRequest, "invalid todoist export: "+err.Error())
+			return
+		}
+	}
+
+	report, err := h.importService.Import(r.Context(), userID, input)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := newTodoistImportResponse(report, req.DryRun)
+	status := http.StatusOK
+	if report.Results != nil {
+		var batch dto.BatchResponse
+		status, batch = newBatchResponse(r, report.Results)
+		resp.Import = &batch
+	}
+	writeJSON(w, status, resp)
+}
+
+// newTodoistImportResponse memetakan application.TodoistImportReport ke dto.TodoistImportResponse.
+func newTodoistImportResponse(report *application.TodoistImportReport, dryRun bool) dto.TodoistImportResponse {
+	resp := dto.TodoistImportResponse{
+		DryRun:   dryRun,
+		Projects: newTodoistImportCounts(report.Projects),
+		Labels:   newTodoistImportCounts(report.Labels),
+		Tasks:    make([]dto.TodoistImportTaskResponse, len(report.Tasks)),
+		Skipped:  report.Skipped,
+	}
+	for i, task := range report.Tasks {
+		labels := task.Labels
+		if labels == nil {
+			labels = []string{}
+		}
+		resp.Tasks[i] = dto.TodoistImportTaskResponse{
+			TodoistID: task.TodoistID,
+			Title:     task.Input.Title,
+			Project:   task.Project,
+			Labels:    labels,
+			Priority:  task.Input.Priority,
+			DueText:   task.Input.DueText,
+		}
+	}
+	return resp
+}
+
+func newTodoistImportCounts(counts []application.TodoistImportCount) []dto.TodoistImportCountResponse {
+	resp := make([]dto.TodoistImportCountResponse, len(counts))
+	for i, count := range counts {
+		resp[i] = dto.TodoistImportCountResponse{Name: count.Name, Tasks: count.Tasks}
+	}
+	return resp
+}
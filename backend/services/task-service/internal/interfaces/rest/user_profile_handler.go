@@ -0,0 +1,46 @@
+// file: backend/services/task-service/internal/interfaces/rest/user_profile_handler.go
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// UserProfileHandler menangani pencarian profil pengguna untuk assignee dan kolaborator.
+type UserProfileHandler struct {
+	profileService application.UserProfileApplicationService
+}
+
+// NewUserProfileHandler adalah constructor untuk UserProfileHandler.
+func NewUserProfileHandler(profileService application.UserProfileApplicationService) *UserProfileHandler {
+	return &UserProfileHandler{
+		profileService: profileService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route profil. Route ini membutuhkan pengguna terautentikasi.
+func (h *UserProfileHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/users/profiles", h.list)
+}
+
+// list mengembalikan profil untuk ?ids=<id>,<id>, misalnya semua assignee_id di satu halaman task.
+func (h *UserProfileHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var ids []domain.UserID
+	for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, domain.UserID(id))
+		}
+	}
+	profiles, err := h.profileService.GetProfiles(r.Context(), userID, ids)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSONList(w, http.StatusOK, dto.NewUserProfileResponses(profiles))
+}
@@ -0,0 +1,55 @@
+// file: backend/services/task-service/internal/interfaces/rest/user_session_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// UserSessionHandler menangani daftar sesi login dan personal access token pengguna beserta
+// pencabutannya.
+type UserSessionHandler struct {
+	sessionService application.UserSessionApplicationService
+}
+
+// NewUserSessionHandler adalah constructor untuk UserSessionHandler.
+func NewUserSessionHandler(sessionService application.UserSessionApplicationService) *UserSessionHandler {
+	return &UserSessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route sesi. Semua route dibungkus auth.RequireSession, sehingga
+// personal access token tidak bisa mencabut sesi lain.
+func (h *UserSessionHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/me/sessions", auth.RequireSession(http.HandlerFunc(h.list)))
+	mux.Handle("DELETE /api/v1/me/sessions/{id}", auth.RequireSession(http.HandlerFunc(h.revoke)))
+}
+
+// list mengembalikan sesi aktif pengguna dan menandai sesi yang dipakai request ini.
+func (h *UserSessionHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	sessions, err := h.sessionService.ListSessions(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var currentID string
+	if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
+		currentID = claims.SessionID
+	}
+	writeJSONList(w, http.StatusOK, dto.NewUserSessionResponses(sessions, currentID))
+}
+
+// revoke mencabut sesi, termasuk sesi request ini (setara logout).
+func (h *UserSessionHandler) revoke(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.sessionService.RevokeSession(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
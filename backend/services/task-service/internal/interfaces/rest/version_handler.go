@@ -0,0 +1,36 @@
+// file: backend/services/task-service/internal/interfaces/rest/version_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/buildinfo"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// VersionHandler menangani endpoint identitas build.
+type VersionHandler struct {
+	info buildinfo.Info
+}
+
+// NewVersionHandler adalah constructor untuk VersionHandler.
+func NewVersionHandler(info buildinfo.Info) *VersionHandler {
+	return &VersionHandler{
+		info: info,
+	}
+}
+
+// RegisterRoutes mendaftarkan route versi. Route ini tidak membutuhkan autentikasi.
+func (h *VersionHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /version", h.get)
+}
+
+func (h *VersionHandler) get(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, dto.VersionResponse{
+		Version:   h.info.Version,
+		Commit:    h.info.Commit,
+		BuildTime: h.info.BuildTime,
+		Modified:  h.info.Modified,
+		GoVersion: h.info.GoVersion,
+	})
+}
@@ -0,0 +1,78 @@
+// file: backend/services/task-service/internal/interfaces/rest/webhook_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// WebhookHandler menangani endpoint registrasi webhook pengguna.
+type WebhookHandler struct {
+	webhookService application.WebhookApplicationService
+}
+
+// NewWebhookHandler adalah constructor untuk WebhookHandler.
+func NewWebhookHandler(webhookService application.WebhookApplicationService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route webhook. Route ini membutuhkan pengguna terautentikasi.
+// Webhook terus mengirim event task setelah token yang membuatnya dicabut, sehingga pembuatannya
+// dibungkus auth.RequireSession.
+func (h *WebhookHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.Handle("POST /api/v1/webhooks", auth.RequireSession(http.HandlerFunc(h.create)))
+	mux.HandleFunc("GET /api/v1/webhooks", h.list)
+	mux.HandleFunc("DELETE /api/v1/webhooks/{id}", h.delete)
+}
+
+// create mendaftarkan webhook dan mengembalikan secret untuk verifikasi signature.
+func (h *WebhookHandler) create(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	var req dto.CreateWebhookRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(r.Context(), userID, application.CreateWebhookInput{
+		URL:             req.URL,
+		EventTypes:      req.EventTypes,
+		PayloadTemplate: req.PayloadTemplate,
+		ContentType:     req.ContentType,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := dto.NewWebhookResponse(webhook)
+	resp.Secret = webhook.Secret
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+func (h *WebhookHandler) list(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	webhooks, err := h.webhookService.ListWebhooks(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	resp := make([]dto.WebhookResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		resp = append(resp, dto.NewWebhookResponse(webhook))
+	}
+	writeJSONList(w, http.StatusOK, resp)
+}
+
+func (h *WebhookHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.webhookService.DeleteWebhook(r.Context(), userID, r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
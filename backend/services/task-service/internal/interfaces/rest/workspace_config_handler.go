@@ -0,0 +1,130 @@
+// file: backend/services/task-service/internal/interfaces/rest/workspace_config_handler.go
+package rest
+
+import (
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/interfaces/dto"
+)
+
+// maxWorkspaceConfigSize membatasi ukuran dokumen konfigurasi yang diterima.
+const maxWorkspaceConfigSize = 1 << 20
+
+// WorkspaceConfigHandler menangani endpoint ekspor dan penerapan konfigurasi workspace.
+type WorkspaceConfigHandler struct {
+	configService application.WorkspaceConfigApplicationService
+}
+
+// NewWorkspaceConfigHandler adalah constructor untuk WorkspaceConfigHandler.
+func NewWorkspaceConfigHandler(configService application.WorkspaceConfigApplicationService) *WorkspaceConfigHandler {
+	return &WorkspaceConfigHandler{
+		configService: configService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route konfigurasi workspace. Route ini membutuhkan pengguna terautentikasi.
+func (h *WorkspaceConfigHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/config", h.export)
+	mux.HandleFunc("POST /api/v1/me/config/diff", h.diff)
+	mux.HandleFunc("PUT /api/v1/me/config", h.apply)
+}
+
+// export mengembalikan konfigurasi workspace sebagai JSON, atau YAML jika format=yaml.
+func (h *WorkspaceConfigHandler) export(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "yaml" {
+		writeProblem(w, http.StatusBadRequest, "format must be json or yaml")
+		return
+	}
+
+	config, err := h.configService.ExportConfig(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	doc := dto.NewWorkspaceConfigDocument(config)
+	if format != "yaml" {
+		writeJSON(w, http.StatusOK, doc)
+		return
+	}
+	body, err := yaml.Marshal(doc)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		slog.ErrorContext(r.Context(), "error writing response", "error", err)
+	}
+}
+
+// diff menampilkan perubahan yang akan dilakukan PUT /api/v1/me/config tanpa menerapkannya.
+func (h *WorkspaceConfigHandler) diff(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	doc, prune, ok := decodeWorkspaceConfig(w, r)
+	if !ok {
+		return
+	}
+	changes, err := h.configService.DiffConfig(r.Context(), userID, doc.ToDomain(), prune)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewWorkspaceConfigDiffResponse(changes, false))
+}
+
+// apply menerapkan dokumen konfigurasi dan mengembalikan perubahan yang dilakukan.
+func (h *WorkspaceConfigHandler) apply(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	doc, prune, ok := decodeWorkspaceConfig(w, r)
+	if !ok {
+		return
+	}
+	changes, err := h.configService.ApplyConfig(r.Context(), userID, doc.ToDomain(), prune)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewWorkspaceConfigDiffResponse(changes, true))
+}
+
+// decodeWorkspaceConfig membaca dokumen dari body (YAML jika Content-Type-nya YAML, selain itu
+// JSON) dan parameter prune. Sama dengan decodeJSON, field yang tidak dikenal ditolak.
+func decodeWorkspaceConfig(w http.ResponseWriter, r *http.Request) (dto.WorkspaceConfigDocument, bool, bool) {
+	var prune bool
+	if raw := r.URL.Query().Get("prune"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "prune must be a boolean")
+			return dto.WorkspaceConfigDocument{}, false, false
+		}
+		prune = parsed
+	}
+
+	var doc dto.WorkspaceConfigDocument
+	r.Body = http.MaxBytesReader(w, r.Body, maxWorkspaceConfigSize)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var err error
+	switch mediaType {
+	case "application/yaml", "application/x-yaml", "text/yaml":
+		decoder := yaml.NewDecoder(r.Body)
+		decoder.KnownFields(true)
+		err = decoder.Decode(&doc)
+	default:
+		err = decodeJSON(r, &doc)
+	}
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid configuration document: "+err.Error())
+		return dto.WorkspaceConfigDocument{}, false, false
+	}
+	return doc, prune, true
+}
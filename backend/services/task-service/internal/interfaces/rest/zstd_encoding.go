@@ -0,0 +1,104 @@
+// file: backend/services/task-service/internal/interfaces/rest/zstd_encoding.go
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/pkg/syncdict"
+	"github.com/klauspost/compress/zstd"
+)
+
+// headerZstdDictionaryID dikirim klien untuk memberi tahu dictionary yang sudah dimilikinya,
+// dan dikirim balik server jika response dikompresi dengan dictionary tersebut.
+const headerZstdDictionaryID = "X-Zstd-Dictionary-ID"
+
+// minZstdSize adalah ukuran payload minimum yang layak dikompresi.
+// Di bawah ini overhead header frame lebih besar dari penghematannya.
+const minZstdSize = 256
+
+// zstdCompressor mengompresi response JSON dengan zstd, memakai dictionary sync jika klien memilikinya.
+// Encoder zstd aman dipakai bersamaan lewat EncodeAll, jadi satu instance cukup untuk semua request.
+type zstdCompressor struct {
+	plain    *zstd.Encoder
+	withDict *zstd.Encoder
+	dictID   uint32
+}
+
+func newZstdCompressor() (*zstdCompressor, error) {
+	plain, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd encoder: %w", err)
+	}
+	withDict, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.SpeedDefault),
+		zstd.WithEncoderDictRaw(syncdict.ID, syncdict.Content()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd dictionary encoder: %w", err)
+	}
+	return &zstdCompressor{
+		plain:    plain,
+		withDict: withDict,
+		dictID:   syncdict.ID,
+	}, nil
+}
+
+// writeJSON menulis v sebagai JSON, dikompresi zstd jika klien mengirim Accept-Encoding: zstd.
+// Jika klien juga mengirim X-Zstd-Dictionary-ID yang cocok, dictionary sync dipakai.
+func (c *zstdCompressor) writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, r, fmt.Errorf("error encoding response: %w", err))
+		return
+	}
+
+	w.Header().Add("Vary", "Accept-Encoding")
+	w.Header().Add("Vary", headerZstdDictionaryID)
+	w.Header().Set("Content-Type", "application/json")
+
+	if len(body) >= minZstdSize && acceptsEncoding(r, "zstd") {
+		encoder := c.plain
+		if c.clientHasDictionary(r) {
+			encoder = c.withDict
+			w.Header().Set(headerZstdDictionaryID, strconv.FormatUint(uint64(c.dictID), 10))
+		}
+		body = encoder.EncodeAll(body, make([]byte, 0, len(body)/2))
+		w.Header().Set("Content-Encoding", "zstd")
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		slog.ErrorContext(r.Context(), "error writing response", "error", err)
+	}
+}
+
+func (c *zstdCompressor) clientHasDictionary(r *http.Request) bool {
+	id, err := strconv.ParseUint(r.Header.Get(headerZstdDictionaryID), 10, 32)
+	return err == nil && uint32(id) == c.dictID
+}
+
+// acceptsEncoding memeriksa apakah header Accept-Encoding memuat coding tertentu dengan q > 0.
+func acceptsEncoding(r *http.Request, coding string) bool {
+	for _, header := range r.Header.Values("Accept-Encoding") {
+		for _, part := range strings.Split(header, ",") {
+			name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+			if !strings.EqualFold(strings.TrimSpace(name), coding) {
+				continue
+			}
+			params = strings.ReplaceAll(params, " ", "")
+			if q, ok := strings.CutPrefix(params, "q="); ok {
+				if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
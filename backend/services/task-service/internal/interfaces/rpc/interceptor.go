@@ -0,0 +1,132 @@
+// file: backend/services/task-service/internal/interfaces/rpc/interceptor.go
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/errorreport"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/logging"
+	taskv1 "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/pkg/pb/task/v1"
+)
+
+// requestIDMetadata adalah key metadata gRPC untuk domain.HeaderRequestID.
+var requestIDMetadata = strings.ToLower(domain.HeaderRequestID)
+
+// readMethods adalah RPC yang hanya membaca data, sehingga JWT dengan claim scope cukup memiliki
+// domain.ScopeTasksRead; RPC lain membutuhkan domain.ScopeTasksWrite (lihat auth.RequiredScope).
+var readMethods = map[string]bool{
+	taskv1.TaskService_GetTask_FullMethodName:            true,
+	taskv1.TaskService_ListTasks_FullMethodName:          true,
+	taskv1.TaskService_SearchTasks_FullMethodName:        true,
+	taskv1.TaskService_GetTaskCounters_FullMethodName:    true,
+	taskv1.TaskService_ListArchivedTasks_FullMethodName:  true,
+	taskv1.TaskService_ListCompletedTasks_FullMethodName: true,
+	taskv1.TaskService_ListAssignedTasks_FullMethodName:  true,
+}
+
+// AuthInterceptor mewajibkan metadata "authorization: Bearer <token>" yang valid pada setiap RPC,
+// sama dengan auth middleware pada REST API, lalu menyimpan ID pengguna ke context. Token dengan
+// claim scope ditolak (PermissionDenied) jika tidak memiliki scope RPC-nya (lihat readMethods).
+// Error dari handler dipetakan ke status gRPC di sini sehingga handler cukup mengembalikan error
+// domain.
+func AuthInterceptor(verifier auth.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = logging.With(ctx, "rpc", info.FullMethod)
+		var authorization, requestID string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				authorization = values[0]
+			}
+			if values := md.Get(requestIDMetadata); len(values) > 0 {
+				requestID = values[0]
+			}
+		}
+		if !domain.ValidRequestID(requestID) {
+			requestID = domain.NewRequestID()
+		}
+		ctx = domain.ContextWithRequestID(ctx, requestID)
+		grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, requestID))
+		authCtx, err := verifier.Authenticate(ctx, authorization)
+		if errors.Is(err, auth.ErrAuthUnavailable) {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		scope := domain.ScopeTasksWrite
+		if readMethods[info.FullMethod] {
+			scope = domain.ScopeTasksRead
+		}
+		if !auth.HasScope(authCtx, scope) {
+			return nil, status.Error(codes.PermissionDenied, "token lacks scope "+string(scope))
+		}
+
+		resp, err := handler(authCtx, req)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err // Sudah berupa status gRPC, misalnya validasi request di handler
+			}
+			return nil, toStatus(authCtx, err)
+		}
+		return resp, nil
+	}
+}
+
+// RecoveryInterceptor mengubah panic di handler RPC menjadi status Internal, mencatat stack
+// trace dengan request ID, dan mengirimnya ke reporter. Tanpa interceptor ini panic di handler
+// gRPC menghentikan seluruh proses. Dipasang setelah AuthInterceptor agar context sudah membawa
+// request ID dan field log rpc.
+func RecoveryInterceptor(reporter errorreport.Reporter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			report := errorreport.Report{
+				Value:     recovered,
+				Stack:     debug.Stack(),
+				Frames:    errorreport.Frames(),
+				Method:    info.FullMethod,
+				RequestID: domain.RequestIDFromContext(ctx),
+			}
+			slog.ErrorContext(ctx, "panic recovered", "panic", fmt.Sprint(recovered), "stack", string(report.Stack))
+			reporter.Report(ctx, report)
+			resp, err = nil, status.Error(codes.Internal, "internal server error")
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// MaintenanceInterceptor menolak RPC write dengan UNAVAILABLE selama mode maintenance aktif,
+// sama seperti MaintenanceHandler.WriteGuard di REST; RPC baca (Get*, List*, dan Search*) tetap
+// dilayani. Dipasang setelah AuthInterceptor yang memetakan errornya ke status gRPC.
+func MaintenanceInterceptor(maintenanceService application.MaintenanceApplicationService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		maintenance := maintenanceService.Current()
+		if !maintenance.Enabled {
+			return handler(ctx, req)
+		}
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		if strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List") || strings.HasPrefix(method, "Search") {
+			return handler(ctx, req)
+		}
+		if maintenance.Message != "" {
+			return nil, fmt.Errorf("%w: %s", domain.ErrMaintenance, maintenance.Message)
+		}
+		return nil, domain.ErrMaintenance
+	}
+}
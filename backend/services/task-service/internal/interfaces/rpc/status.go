@@ -0,0 +1,71 @@
+// file: backend/services/task-service/internal/interfaces/rpc/status.go
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+)
+
+// errorMapping memetakan error domain yang bisa dikembalikan TaskApplicationService ke status
+// code gRPC, dengan pengelompokan yang sama seperti errorMapping di layer REST.
+var errorMapping = []struct {
+	err  error
+	code codes.Code
+}{
+	{domain.ErrTaskNotFound, codes.NotFound},
+	{domain.ErrListShareNotFound, codes.NotFound},
+	{domain.ErrBoardColumnNotFound, codes.NotFound},
+
+	{domain.ErrTaskTitleRequired, codes.InvalidArgument},
+	{domain.ErrInvalidTaskID, codes.InvalidArgument},
+	{domain.ErrInvalidCursor, codes.InvalidArgument},
+	{domain.ErrInvalidTaskSort, codes.InvalidArgument},
+	{domain.ErrInvalidReorder, codes.InvalidArgument},
+	{domain.ErrInvalidEstimate, codes.InvalidArgument},
+	{domain.ErrInvalidSnooze, codes.InvalidArgument},
+	{domain.ErrSearchQueryTooShort, codes.InvalidArgument},
+	{domain.ErrSearchQueryEmpty, codes.InvalidArgument},
+	{domain.ErrInvalidTimeZone, codes.InvalidArgument},
+	{domain.ErrInvalidDueText, codes.InvalidArgument},
+	{domain.ErrInvalidAssignee, codes.InvalidArgument},
+	{domain.ErrInvalidTaskColor, codes.InvalidArgument},
+	{domain.ErrInvalidTaskIcon, codes.InvalidArgument},
+	{domain.ErrInvalidEnumValue, codes.InvalidArgument},
+
+	{domain.ErrTaskUpdateConflict, codes.Aborted},
+	{domain.ErrTaskNotCompleted, codes.FailedPrecondition},
+	{domain.ErrTaskLimitReached, codes.ResourceExhausted},
+	{domain.ErrTaskAlreadyCompleted, codes.FailedPrecondition},
+	{domain.ErrWIPLimitReached, codes.FailedPrecondition},
+	{domain.ErrWorkspaceArchived, codes.FailedPrecondition},
+
+	{domain.ErrListReadOnly, codes.PermissionDenied},
+	{domain.ErrTokenListForbidden, codes.PermissionDenied},
+
+	{domain.ErrDependencyUnavailable, codes.Unavailable},
+	{domain.ErrMaintenance, codes.Unavailable},
+}
+
+// toStatus memetakan error dari application/domain layer ke status gRPC. Error yang tidak dikenal
+// dicatat dan dikembalikan sebagai Internal tanpa detail.
+func toStatus(ctx context.Context, err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	for _, m := range errorMapping {
+		if errors.Is(err, m.err) {
+			return status.Error(m.code, err.Error())
+		}
+	}
+	slog.ErrorContext(ctx, "internal error", "error", err)
+	return status.Error(codes.Internal, "internal server error")
+}
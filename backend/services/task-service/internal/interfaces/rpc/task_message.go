@@ -0,0 +1,60 @@
+// file: backend/services/task-service/internal/interfaces/rpc/task_message.go
+package rpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	taskv1 "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/pkg/pb/task/v1"
+)
+
+// newTask memetakan domain.Task ke message taskv1.Task, setara dengan dto.NewTaskResponse.
+func newTask(task *domain.Task) *taskv1.Task {
+	msg := &taskv1.Task{
+		Id:           task.ID,
+		UserId:       string(task.UserID),
+		Title:        task.Title,
+		Description:  task.Description,
+		Completed:    task.Completed,
+		CompletedAt:  timestamp(task.CompletedAt),
+		Position:     task.Position,
+		SnoozedUntil: timestamp(task.SnoozedUntil),
+		Archived:     task.Archived,
+		ColumnId:     task.ColumnID,
+		Priority:     task.Priority,
+		DueAt:        timestamp(task.DueAt),
+		DueText:      task.DueText,
+		Color:        task.Color,
+		Icon:         task.Icon,
+		CreatedAt:    timestamppb.New(task.CreatedAt),
+		UpdatedAt:    timestamppb.New(task.UpdatedAt),
+	}
+	if task.EstimateMinutes != nil {
+		minutes := int32(*task.EstimateMinutes)
+		msg.EstimateMinutes = &minutes
+	}
+	if task.AssigneeID != nil {
+		assigneeID := string(*task.AssigneeID)
+		msg.AssigneeId = &assigneeID
+	}
+	return msg
+}
+
+// newTasks memetakan daftar domain.Task ke message taskv1.Task.
+func newTasks(tasks []*domain.Task) []*taskv1.Task {
+	msgs := make([]*taskv1.Task, 0, len(tasks))
+	for _, task := range tasks {
+		msgs = append(msgs, newTask(task))
+	}
+	return msgs
+}
+
+// timestamp mengembalikan nil untuk waktu nil.
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,349 @@
+// file: backend/services/task-service/internal/interfaces/rpc/task_server.go
+package rpc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/internal/infrastructure/auth"
+	taskv1 "github.com/TubagusAldiMY/go-vue-todolist/backend/services/task-service/pkg/pb/task/v1"
+)
+
+const (
+	defaultPageSize = 50  // Ukuran halaman ListTasks dan limit SearchTasks jika tidak diisi
+	maxPageSize     = 200 // Sama dengan batas limit pada REST API
+	maxReorderIDs   = 1000
+)
+
+// TaskServer mengimplementasikan taskv1.TaskServiceServer di atas TaskApplicationService, sehingga
+// aturan bisnis gRPC dan REST selalu sama. Autentikasi dan pemetaan error dilakukan AuthInterceptor.
+type TaskServer struct {
+	taskv1.UnimplementedTaskServiceServer
+	taskService application.TaskApplicationService
+}
+
+// NewTaskServer adalah constructor untuk TaskServer.
+func NewTaskServer(taskService application.TaskApplicationService) *TaskServer {
+	return &TaskServer{
+		taskService: taskService,
+	}
+}
+
+func (s *TaskServer) CreateTask(ctx context.Context, req *taskv1.CreateTaskRequest) (*taskv1.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	loc, err := dueLocation(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+	task, _, err := s.taskService.CreateTask(ctx, userID, application.CreateTaskInput{
+		ID:              req.GetId(),
+		Title:           req.GetTitle(),
+		Description:     req.GetDescription(),
+		EstimateMinutes: intPtr(req.EstimateMinutes),
+		Priority:        req.Priority,
+		DueText:         req.DueText,
+		DueLocation:     loc,
+		Color:           req.Color,
+		Icon:            req.Icon,
+		OwnerID:         domain.UserID(req.GetOwnerId()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newTask(task), nil
+}
+
+func (s *TaskServer) GetTask(ctx context.Context, req *taskv1.GetTaskRequest) (*taskv1.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	task, err := s.taskService.GetTaskByID(ctx, userID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return newTask(task), nil
+}
+
+// ListTasks mengikuti GET /api/v1/tasks: task yang diarsipkan tidak ikut, dan task yang masih
+// di-snooze disembunyikan kecuali include_snoozed.
+func (s *TaskServer) ListTasks(ctx context.Context, req *taskv1.ListTasksRequest) (*taskv1.ListTasksResponse, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	sort, err := taskSort(req.GetSort())
+	if err != nil {
+		return nil, err
+	}
+	var hideSnoozedAt time.Time
+	if !req.GetIncludeSnoozed() {
+		hideSnoozedAt = time.Now()
+	}
+
+	if req.GetPageSize() != 0 || req.GetPageToken() != "" {
+		if sort != domain.TaskSortCreated {
+			return nil, status.Error(codes.InvalidArgument, "sort cannot be combined with page_size or page_token")
+		}
+		if req.GetOwnerId() != "" {
+			return nil, status.Error(codes.InvalidArgument, "owner_id cannot be combined with page_size or page_token")
+		}
+		limit, err := pageSize(req.GetPageSize())
+		if err != nil {
+			return nil, err
+		}
+		page, err := s.taskService.GetTasksPage(ctx, userID, domain.TaskPageQuery{
+			Limit:         limit,
+			Cursor:        req.GetPageToken(),
+			HideSnoozedAt: hideSnoozedAt,
+			HideArchived:  true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &taskv1.ListTasksResponse{Tasks: newTasks(page.Tasks), NextPageToken: page.NextCursor}, nil
+	}
+
+	order := domain.TaskOrder{
+		Sort:          sort,
+		Locale:        req.GetLocale(),
+		HideSnoozedAt: hideSnoozedAt,
+		HideArchived:  true,
+	}
+	var tasks []*domain.Task
+	if ownerID := domain.UserID(req.GetOwnerId()); ownerID != "" && ownerID != userID {
+		tasks, err = s.taskService.GetSharedTasks(ctx, userID, ownerID, order)
+	} else {
+		tasks, err = s.taskService.GetTasksByUserID(ctx, userID, order)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.ListTasksResponse{Tasks: newTasks(tasks)}, nil
+}
+
+func (s *TaskServer) SearchTasks(ctx context.Context, req *taskv1.SearchTasksRequest) (*taskv1.ListTasksResponse, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	limit, err := pageSize(req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.taskService.SearchTasks(ctx, userID, req.GetQuery(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.ListTasksResponse{Tasks: newTasks(tasks)}, nil
+}
+
+func (s *TaskServer) GetTaskCounters(ctx context.Context, _ *taskv1.GetTaskCountersRequest) (*taskv1.TaskCounters, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	counters, err := s.taskService.GetTaskCounters(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.TaskCounters{
+		Total:     counters.Total,
+		Open:      counters.Open,
+		Completed: counters.Completed(),
+	}, nil
+}
+
+func (s *TaskServer) UpdateTask(ctx context.Context, req *taskv1.UpdateTaskRequest) (*taskv1.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	loc, err := dueLocation(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+	input := application.UpdateTaskInput{
+		Title:           req.Title,
+		Description:     req.Description,
+		Completed:       req.Completed,
+		EstimateMinutes: intPtr(req.EstimateMinutes),
+		Priority:        req.Priority,
+		DueText:         req.DueText,
+		DueLocation:     loc,
+		Color:           req.Color,
+		Icon:            req.Icon,
+	}
+	if req.AssigneeId != nil {
+		assigneeID := domain.UserID(*req.AssigneeId)
+		input.AssigneeID = &assigneeID
+	}
+	task, err := s.taskService.UpdateTask(ctx, userID, req.GetId(), input)
+	if err != nil {
+		return nil, err
+	}
+	return newTask(task), nil
+}
+
+func (s *TaskServer) CompleteTask(ctx context.Context, req *taskv1.TaskIDRequest) (*taskv1.Task, error) {
+	return s.taskAction(ctx, req, s.taskService.CompleteTask)
+}
+
+func (s *TaskServer) UncompleteTask(ctx context.Context, req *taskv1.TaskIDRequest) (*taskv1.Task, error) {
+	return s.taskAction(ctx, req, s.taskService.UncompleteTask)
+}
+
+func (s *TaskServer) SnoozeTask(ctx context.Context, req *taskv1.SnoozeTaskRequest) (*taskv1.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	if req.GetUntil() == nil {
+		return nil, status.Error(codes.InvalidArgument, "until is required")
+	}
+	task, err := s.taskService.SnoozeTask(ctx, userID, req.GetId(), req.GetUntil().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return newTask(task), nil
+}
+
+func (s *TaskServer) UnsnoozeTask(ctx context.Context, req *taskv1.TaskIDRequest) (*taskv1.Task, error) {
+	return s.taskAction(ctx, req, s.taskService.UnsnoozeTask)
+}
+
+func (s *TaskServer) ArchiveTask(ctx context.Context, req *taskv1.TaskIDRequest) (*taskv1.Task, error) {
+	return s.taskAction(ctx, req, s.taskService.ArchiveTask)
+}
+
+func (s *TaskServer) UnarchiveTask(ctx context.Context, req *taskv1.TaskIDRequest) (*taskv1.Task, error) {
+	return s.taskAction(ctx, req, s.taskService.UnarchiveTask)
+}
+
+func (s *TaskServer) ListArchivedTasks(ctx context.Context, _ *taskv1.ListArchivedTasksRequest) (*taskv1.ListTasksResponse, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	tasks, err := s.taskService.GetArchivedTasks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.ListTasksResponse{Tasks: newTasks(tasks)}, nil
+}
+
+func (s *TaskServer) ListCompletedTasks(ctx context.Context, req *taskv1.ListCompletedTasksRequest) (*taskv1.ListTasksResponse, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	if req.GetFrom() == nil || req.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	from, to := req.GetFrom().AsTime(), req.GetTo().AsTime()
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	tasks, err := s.taskService.GetCompletedTasks(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.ListTasksResponse{Tasks: newTasks(tasks)}, nil
+}
+
+func (s *TaskServer) AssignTask(ctx context.Context, req *taskv1.AssignTaskRequest) (*taskv1.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	task, err := s.taskService.AssignTask(ctx, userID, req.GetId(), domain.UserID(req.GetAssigneeId()))
+	if err != nil {
+		return nil, err
+	}
+	return newTask(task), nil
+}
+
+func (s *TaskServer) UnassignTask(ctx context.Context, req *taskv1.TaskIDRequest) (*taskv1.Task, error) {
+	return s.taskAction(ctx, req, s.taskService.UnassignTask)
+}
+
+func (s *TaskServer) ListAssignedTasks(ctx context.Context, req *taskv1.ListAssignedTasksRequest) (*taskv1.ListTasksResponse, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	sort, err := taskSort(req.GetSort())
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.taskService.GetAssignedTasks(ctx, userID, domain.TaskOrder{
+		Sort:          sort,
+		Locale:        req.GetLocale(),
+		HideSnoozedAt: time.Now(),
+		HideArchived:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.ListTasksResponse{Tasks: newTasks(tasks)}, nil
+}
+
+func (s *TaskServer) ReorderTasks(ctx context.Context, req *taskv1.ReorderTasksRequest) (*taskv1.ListTasksResponse, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	if len(req.GetIds()) > maxReorderIDs {
+		return nil, status.Error(codes.InvalidArgument, "ids must contain at most "+strconv.Itoa(maxReorderIDs)+" tasks")
+	}
+	tasks, err := s.taskService.ReorderTasks(ctx, userID, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.ListTasksResponse{Tasks: newTasks(tasks)}, nil
+}
+
+func (s *TaskServer) MoveTask(ctx context.Context, req *taskv1.MoveTaskRequest) (*taskv1.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	task, err := s.taskService.MoveTask(ctx, userID, req.GetId(), req.GetAfterId())
+	if err != nil {
+		return nil, err
+	}
+	return newTask(task), nil
+}
+
+func (s *TaskServer) DeleteTask(ctx context.Context, req *taskv1.TaskIDRequest) (*taskv1.DeleteTaskResponse, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	if err := s.taskService.DeleteTask(ctx, userID, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &taskv1.DeleteTaskResponse{}, nil
+}
+
+// taskAction menjalankan use case yang hanya membutuhkan ID task, seperti CompleteTask.
+func (s *TaskServer) taskAction(ctx context.Context, req *taskv1.TaskIDRequest, action func(context.Context, domain.UserID, string) (*domain.Task, error)) (*taskv1.Task, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+	task, err := action(ctx, userID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return newTask(task), nil
+}
+
+// taskSort memetakan enum TaskSort ke domain.TaskSort.
+func taskSort(sort taskv1.TaskSort) (domain.TaskSort, error) {
+	switch sort {
+	case taskv1.TaskSort_TASK_SORT_UNSPECIFIED, taskv1.TaskSort_TASK_SORT_CREATED:
+		return domain.TaskSortCreated, nil
+	case taskv1.TaskSort_TASK_SORT_POSITION:
+		return domain.TaskSortPosition, nil
+	case taskv1.TaskSort_TASK_SORT_TITLE:
+		return domain.TaskSortTitle, nil
+	}
+	return "", domain.ErrInvalidTaskSort
+}
+
+// pageSize memvalidasi ukuran halaman; 0 berarti defaultPageSize.
+func pageSize(size int32) (int, error) {
+	if size == 0 {
+		return defaultPageSize, nil
+	}
+	if size < 1 || size > maxPageSize {
+		return 0, status.Error(codes.InvalidArgument, "page size must be between 1 and "+strconv.Itoa(maxPageSize))
+	}
+	return int(size), nil
+}
+
+// dueLocation memuat zona waktu time_zone. Kosong berarti nil, sehingga service memakai zona waktu
+// tersimpan pengguna.
+func dueLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, domain.ErrInvalidTimeZone
+	}
+	return loc, nil
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
@@ -0,0 +1,35 @@
+// Package syncdict berisi dictionary zstd yang dipakai bersama oleh task-service dan klien
+// untuk mengompresi payload delta sync (/api/v1/sync).
+//
+// Dictionary ini berupa "raw content dictionary": contoh payload JSON sync yang memuat nama-nama
+// field task dan pola timestamp yang sering muncul. Karena payload sync sebagian besar terdiri dari
+// string yang sama berulang-ulang, dictionary memperkecil ukuran payload secara signifikan,
+// terutama untuk payload kecil-menengah yang tidak sempat "belajar" polanya sendiri.
+//
+// Setiap perubahan isi dictionary WAJIB disertai ID baru, karena klien lama hanya bisa
+// mendekompresi frame dengan dictionary yang ID-nya mereka miliki.
+package syncdict
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+)
+
+// ID adalah dictionary ID yang ditulis ke header frame zstd.
+// Nilai di atas 32767 dipakai karena rentang di bawahnya dicadangkan oleh spesifikasi zstd.
+const ID uint32 = 0x544B0001
+
+//go:embed dictionary_v1.json
+var content []byte
+
+// Content mengembalikan salinan isi dictionary.
+func Content() []byte {
+	return append([]byte(nil), content...)
+}
+
+// Checksum mengembalikan SHA-256 (hex) dari isi dictionary, dipakai sebagai ETag.
+func Checksum() string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
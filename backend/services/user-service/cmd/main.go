@@ -0,0 +1,148 @@
+// file: backend/services/user-service/cmd/main.go
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/infrastructure/persistence"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/interfaces/rest"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/interfaces/rpc"
+	userv1 "github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/pkg/pb/user/v1"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	var level slog.Level
+	if raw := os.Getenv("LOG_LEVEL"); raw != "" {
+		if err := level.UnmarshalText([]byte(raw)); err != nil {
+			fatal("Invalid LOG_LEVEL: must be debug, info, warn, or error")
+		}
+	}
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8082" // Port default untuk user-service
+	}
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9082" // Port default untuk API gRPC
+	}
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		fatal("DATABASE_URL must be set")
+	}
+	jwtSecret := os.Getenv("SUPABASE_JWT_SECRET")
+	if jwtSecret == "" {
+		fatal("SUPABASE_JWT_SECRET must be set")
+	}
+
+	// SERVICE_TOKENS adalah daftar token (dipisah koma) yang boleh memanggil API gRPC, misalnya
+	// USER_SERVICE_TOKEN task-service; lebih dari satu token dipakai selama rotasi.
+	var serviceTokens []string
+	for _, token := range strings.Split(os.Getenv("SERVICE_TOKENS"), ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			serviceTokens = append(serviceTokens, token)
+		}
+	}
+	if len(serviceTokens) == 0 {
+		slog.Warn("SERVICE_TOKENS is empty, gRPC API accepts unauthenticated calls")
+	}
+
+	shutdownTimeout := 20 * time.Second
+	if raw := os.Getenv("SHUTDOWN_TIMEOUT"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			fatal("Invalid SHUTDOWN_TIMEOUT: must be a positive duration")
+		}
+		shutdownTimeout = parsed
+	}
+
+	dbpool, err := pgxpool.New(context.Background(), databaseURL)
+	if err != nil {
+		fatal("Could not create database pool", "error", err)
+	}
+	defer dbpool.Close()
+
+	profileService := application.NewProfileService(persistence.NewPostgresProfileRepository(dbpool))
+
+	// /readyz gagal selama database atau migrasi belum siap.
+	healthHandler := rest.NewHealthHandler(
+		rest.ReadinessCheck{Name: "database", Check: dbpool.Ping},
+		rest.ReadinessCheck{Name: "migrations", Check: persistence.NewPostgresSchemaChecker(dbpool).Check},
+	)
+	router := rest.NewRouter(rest.RouterConfig{
+		ProfileHandler: rest.NewProfileHandler(profileService),
+		HealthHandler:  healthHandler,
+		AuthMiddleware: auth.NewSupabaseVerifier(jwtSecret).Middleware,
+	})
+
+	// API gRPC internal berjalan di port terpisah dan dipakai task-service untuk lookup profil.
+	grpcListener, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		fatal("Could not listen on gRPC port", "error", err)
+	}
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(rpc.ServiceAuthInterceptor(auth.NewServiceTokens(serviceTokens))))
+	userv1.RegisterUserServiceServer(grpcServer, rpc.NewUserServer(profileService))
+	go func() {
+		slog.Info("User Service gRPC listening", "port", grpcPort)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			fatal("Could not start gRPC server", "error", err)
+		}
+	}()
+
+	server := &http.Server{Addr: ":" + port, Handler: router, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		slog.Info("User Service listening", "port", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("Could not start server", "error", err)
+		}
+	}()
+
+	// SIGINT/SIGTERM menghentikan penerimaan request baru lalu menunggu request HTTP dan RPC yang
+	// sedang berjalan selesai, paling lama shutdownTimeout.
+	signals, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	<-signals.Done()
+	stopSignals() // Sinyal berikutnya langsung menghentikan proses
+	slog.Info("Shutting down User Service...")
+	healthHandler.SetShuttingDown()
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("HTTP server did not shut down cleanly", "error", err)
+		server.Close()
+	}
+	select {
+	case <-grpcStopped:
+	case <-shutdownCtx.Done():
+		grpcServer.Stop()
+	}
+	slog.Info("User Service stopped")
+}
+
+// fatal mencatat error startup lalu menghentikan proses dengan status 1, pengganti log.Fatalf
+// yang menulis lewat slog.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
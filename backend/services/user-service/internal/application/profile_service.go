@@ -0,0 +1,140 @@
+// file: backend/services/user-service/internal/application/profile_service.go
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+)
+
+// UpdateProfileInput berisi field profil yang diubah; field nil tidak diubah.
+type UpdateProfileInput struct {
+	DisplayName *string
+	AvatarURL   *string
+	TimeZone    *string
+	Preferences map[string]any // nil berarti tidak diubah; objek kosong menghapus semua preferensi
+}
+
+// ProfileApplicationService mendefinisikan use case profil pengguna.
+type ProfileApplicationService interface {
+	// GetProfile mengembalikan profil pengguna, atau profil kosong jika belum pernah disimpan.
+	GetProfile(ctx context.Context, userID domain.UserID) (*domain.Profile, error)
+	// UpdateProfile mengubah field yang diisi di input dan membuat profil jika belum ada.
+	UpdateProfile(ctx context.Context, userID domain.UserID, input UpdateProfileInput) (*domain.Profile, error)
+	// DeleteProfile menghapus profil pengguna, misalnya saat akun dihapus.
+	DeleteProfile(ctx context.Context, userID domain.UserID) error
+	// BatchGetProfiles mengembalikan profil yang tersimpan untuk ids. Mengembalikan
+	// ErrInvalidUserLookup jika ids kosong atau lebih dari MaxBatchGetUsers.
+	BatchGetProfiles(ctx context.Context, ids []domain.UserID) ([]*domain.Profile, error)
+}
+
+// profileService adalah implementasi dari ProfileApplicationService.
+type profileService struct {
+	profileRepo domain.ProfileRepository
+	now         func() time.Time
+}
+
+// NewProfileService adalah constructor untuk profileService.
+func NewProfileService(profileRepo domain.ProfileRepository) ProfileApplicationService {
+	return &profileService{
+		profileRepo: profileRepo,
+		now:         time.Now,
+	}
+}
+
+func (s *profileService) GetProfile(ctx context.Context, userID domain.UserID) (*domain.Profile, error) {
+	profile, err := s.profileRepo.FindByID(ctx, userID)
+	if errors.Is(err, domain.ErrProfileNotFound) {
+		return &domain.Profile{UserID: userID, Preferences: map[string]any{}}, nil
+	}
+	return profile, err
+}
+
+func (s *profileService) UpdateProfile(ctx context.Context, userID domain.UserID, input UpdateProfileInput) (*domain.Profile, error) {
+	profile, err := s.profileRepo.FindByID(ctx, userID)
+	if errors.Is(err, domain.ErrProfileNotFound) {
+		profile = &domain.Profile{UserID: userID, Preferences: map[string]any{}, CreatedAt: s.now()}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if input.DisplayName != nil {
+		name := strings.TrimSpace(*input.DisplayName)
+		if !utf8.ValidString(name) || utf8.RuneCountInString(name) > domain.MaxDisplayNameLength {
+			return nil, domain.ErrInvalidDisplayName
+		}
+		profile.DisplayName = name
+	}
+	if input.AvatarURL != nil {
+		avatarURL := strings.TrimSpace(*input.AvatarURL)
+		if err := validateAvatarURL(avatarURL); err != nil {
+			return nil, err
+		}
+		profile.AvatarURL = avatarURL
+	}
+	if input.TimeZone != nil {
+		timeZone := strings.TrimSpace(*input.TimeZone)
+		if timeZone != "" {
+			if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "Local" {
+				return nil, domain.ErrInvalidTimeZone
+			}
+		}
+		profile.TimeZone = timeZone
+	}
+	if input.Preferences != nil {
+		encoded, err := json.Marshal(input.Preferences)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding preferences: %w", err)
+		}
+		if len(encoded) > domain.MaxPreferencesBytes {
+			return nil, domain.ErrPreferencesTooLarge
+		}
+		profile.Preferences = input.Preferences
+	}
+
+	profile.UpdatedAt = s.now()
+	if err := s.profileRepo.Save(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (s *profileService) DeleteProfile(ctx context.Context, userID domain.UserID) error {
+	return s.profileRepo.Delete(ctx, userID)
+}
+
+func (s *profileService) BatchGetProfiles(ctx context.Context, ids []domain.UserID) ([]*domain.Profile, error) {
+	unique := make([]domain.UserID, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 || len(unique) > domain.MaxBatchGetUsers {
+		return nil, fmt.Errorf("%w: between 1 and %d user IDs are required", domain.ErrInvalidUserLookup, domain.MaxBatchGetUsers)
+	}
+	return s.profileRepo.FindByIDs(ctx, unique)
+}
+
+// validateAvatarURL menerima string kosong (tanpa avatar) atau URL http(s) absolut.
+func validateAvatarURL(raw string) error {
+	if raw == "" {
+		return nil
+	}
+	if len(raw) > domain.MaxAvatarURLLength {
+		return domain.ErrInvalidAvatarURL
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return domain.ErrInvalidAvatarURL
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// UserID adalah ID pengguna Supabase (claim sub pada access token).
+type UserID string
+
+// Batas profil pengguna.
+const (
+	MaxDisplayNameLength = 100       // Dalam karakter
+	MaxAvatarURLLength   = 2048      // Dalam byte
+	MaxPreferencesBytes  = 16 * 1024 // Ukuran preferences setelah di-encode sebagai JSON
+	MaxBatchGetUsers     = 100       // Jumlah ID per BatchGetUsers
+)
+
+// Definisikan error domain yang umum
+var (
+	ErrProfileNotFound     = errors.New("profile not found")
+	ErrInvalidDisplayName  = errors.New("display name must be at most 100 characters")
+	ErrInvalidAvatarURL    = errors.New("avatar url must be an absolute http or https url")
+	ErrInvalidTimeZone     = errors.New("time zone must be an IANA time zone name")
+	ErrPreferencesTooLarge = errors.New("preferences must be at most 16 KiB")
+	ErrInvalidUserLookup   = errors.New("invalid user lookup")
+)
+
+// Profile adalah profil pengguna. Akun dan autentikasi dikelola Supabase Auth; user-service hanya
+// menyimpan data tampilan dan preferensi yang dipakai frontend dan service lain.
+type Profile struct {
+	UserID      UserID
+	DisplayName string
+	AvatarURL   string         // URL absolut; kosong jika pengguna belum memasang avatar
+	TimeZone    string         // Zona waktu IANA; kosong jika belum diatur
+	Preferences map[string]any // Objek JSON bebas milik frontend, misalnya tema dan bahasa
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ProfileRepository mendefinisikan kontrak penyimpanan profil pengguna
+type ProfileRepository interface {
+	// FindByID mengembalikan ErrProfileNotFound jika pengguna belum menyimpan profil.
+	FindByID(ctx context.Context, userID UserID) (*Profile, error)
+	// FindByIDs mengembalikan profil yang ada untuk ids; ID tanpa profil dilewati.
+	FindByIDs(ctx context.Context, ids []UserID) ([]*Profile, error)
+	// Save membuat atau memperbarui profil.
+	Save(ctx context.Context, profile *Profile) error
+	// Delete menghapus profil; tidak error jika profil tidak ada.
+	Delete(ctx context.Context, userID UserID) error
+}
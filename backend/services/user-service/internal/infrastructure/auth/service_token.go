@@ -0,0 +1,42 @@
+// file: backend/services/user-service/internal/infrastructure/auth/service_token.go
+package auth
+
+import "crypto/subtle"
+
+// ServiceTokens adalah token service-to-service yang boleh memanggil API gRPC internal, misalnya
+// USER_SERVICE_TOKEN milik task-service. Beberapa token bisa aktif sekaligus selama rotasi.
+type ServiceTokens struct {
+	tokens [][]byte
+}
+
+// NewServiceTokens adalah constructor untuk ServiceTokens. Token kosong diabaikan; tanpa token
+// sama sekali semua pemanggil diizinkan (hanya untuk jaringan internal yang sudah dibatasi).
+func NewServiceTokens(tokens []string) *ServiceTokens {
+	s := &ServiceTokens{}
+	for _, token := range tokens {
+		if token != "" {
+			s.tokens = append(s.tokens, []byte(token))
+		}
+	}
+	return s
+}
+
+// Required melaporkan apakah pemanggil wajib mengirim token.
+func (s *ServiceTokens) Required() bool {
+	return len(s.tokens) > 0
+}
+
+// Valid melaporkan apakah token cocok dengan salah satu token yang dikonfigurasi, dengan
+// perbandingan waktu konstan.
+func (s *ServiceTokens) Valid(token string) bool {
+	if !s.Required() {
+		return true
+	}
+	valid := false
+	for _, expected := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), expected) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
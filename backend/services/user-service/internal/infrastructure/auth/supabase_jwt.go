@@ -0,0 +1,142 @@
+// file: backend/services/user-service/internal/infrastructure/auth/supabase_jwt.go
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+)
+
+// Definisikan error autentikasi yang umum
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// Claims adalah subset claim JWT Supabase Auth yang dipakai oleh user-service.
+type Claims struct {
+	Subject   string `json:"sub"` // ID pengguna Supabase
+	ExpiresAt int64  `json:"exp"`
+}
+
+type contextKey int
+
+const userIDKey contextKey = iota
+
+// WithUserID menyimpan ID pengguna yang sudah terautentikasi ke dalam context.
+func WithUserID(ctx context.Context, userID domain.UserID) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext mengambil ID pengguna yang disimpan oleh middleware autentikasi.
+func UserIDFromContext(ctx context.Context) (domain.UserID, bool) {
+	userID, ok := ctx.Value(userIDKey).(domain.UserID)
+	return userID, ok && userID != ""
+}
+
+// SupabaseVerifier memverifikasi access token Supabase yang ditandatangani dengan HS256
+// menggunakan JWT secret project, sama dengan task-service.
+type SupabaseVerifier struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// NewSupabaseVerifier adalah constructor untuk SupabaseVerifier.
+func NewSupabaseVerifier(jwtSecret string) *SupabaseVerifier {
+	return &SupabaseVerifier{
+		secret: []byte(jwtSecret),
+		now:    time.Now,
+	}
+}
+
+// Verify memeriksa signature dan masa berlaku token, lalu mengembalikan claim-nya.
+func (v *SupabaseVerifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrInvalidToken
+	}
+
+	claims := &Claims{}
+	if err := decodeSegment(parts[1], claims); err != nil || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	if claims.ExpiresAt != 0 && v.now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return claims, nil
+}
+
+// Middleware mewajibkan header Authorization: Bearer <token> yang valid,
+// dan menyimpan ID pengguna (claim sub) ke context request.
+func (v *SupabaseVerifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := BearerToken(r.Header.Get("Authorization"))
+		if !ok {
+			unauthorized(w, ErrMissingToken)
+			return
+		}
+		claims, err := v.Verify(token)
+		if err != nil {
+			unauthorized(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithUserID(r.Context(), domain.UserID(claims.Subject))))
+	})
+}
+
+// BearerToken mengambil token dari nilai header Authorization ("Bearer <token>").
+func BearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func decodeSegment(segment string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// unauthorized menulis response 401 dengan header WWW-Authenticate dalam format problem+json
+// yang sama dengan layer REST.
+func unauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="user-service"`)
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(map[string]any{
+		"type":   "about:blank",
+		"title":  http.StatusText(http.StatusUnauthorized),
+		"status": http.StatusUnauthorized,
+		"detail": err.Error(),
+	})
+}
@@ -0,0 +1,105 @@
+// file: backend/services/user-service/internal/infrastructure/persistence/postgres_profile_repository.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresProfileRepository adalah implementasi domain.ProfileRepository menggunakan PostgreSQL
+// (tabel user_profiles).
+type PostgresProfileRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+// NewPostgresProfileRepository adalah constructor untuk PostgresProfileRepository.
+func NewPostgresProfileRepository(dbpool *pgxpool.Pool) domain.ProfileRepository {
+	return &PostgresProfileRepository{
+		dbpool: dbpool,
+	}
+}
+
+const profileColumns = `user_id, display_name, avatar_url, time_zone, preferences, created_at, updated_at`
+
+func scanProfile(row pgx.Row) (*domain.Profile, error) {
+	var profile domain.Profile
+	var userID string
+	err := row.Scan(&userID, &profile.DisplayName, &profile.AvatarURL, &profile.TimeZone, &profile.Preferences,
+		&profile.CreatedAt, &profile.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	profile.UserID = domain.UserID(userID)
+	if profile.Preferences == nil {
+		profile.Preferences = map[string]any{}
+	}
+	return &profile, nil
+}
+
+// FindByID mencari profil berdasarkan ID pengguna.
+func (r *PostgresProfileRepository) FindByID(ctx context.Context, userID domain.UserID) (*domain.Profile, error) {
+	sql := `SELECT ` + profileColumns + ` FROM user_profiles WHERE user_id = $1`
+	profile, err := scanProfile(r.dbpool.QueryRow(ctx, sql, string(userID)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrProfileNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding profile: %w", err)
+	}
+	return profile, nil
+}
+
+// FindByIDs mencari profil untuk beberapa ID pengguna sekaligus.
+func (r *PostgresProfileRepository) FindByIDs(ctx context.Context, ids []domain.UserID) ([]*domain.Profile, error) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, string(id))
+	}
+	sql := `SELECT ` + profileColumns + ` FROM user_profiles WHERE user_id = ANY($1)`
+	rows, err := r.dbpool.Query(ctx, sql, keys)
+	if err != nil {
+		return nil, fmt.Errorf("error finding profiles: %w", err)
+	}
+	defer rows.Close()
+
+	var profiles []*domain.Profile
+	for rows.Next() {
+		profile, err := scanProfile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning profile: %w", err)
+		}
+		profiles = append(profiles, profile)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating profiles: %w", err)
+	}
+	return profiles, nil
+}
+
+// Save membuat profil baru atau menimpa profil yang ada. created_at tidak berubah saat update.
+func (r *PostgresProfileRepository) Save(ctx context.Context, profile *domain.Profile) error {
+	sql := `INSERT INTO user_profiles (` + profileColumns + `)
+	         VALUES ($1, $2, $3, $4, $5, $6, $7)
+	         ON CONFLICT (user_id) DO UPDATE SET display_name = EXCLUDED.display_name,
+	             avatar_url = EXCLUDED.avatar_url, time_zone = EXCLUDED.time_zone,
+	             preferences = EXCLUDED.preferences, updated_at = EXCLUDED.updated_at`
+	_, err := r.dbpool.Exec(ctx, sql, string(profile.UserID), profile.DisplayName, profile.AvatarURL,
+		profile.TimeZone, profile.Preferences, profile.CreatedAt, profile.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error saving profile: %w", err)
+	}
+	return nil
+}
+
+// Delete menghapus profil pengguna.
+func (r *PostgresProfileRepository) Delete(ctx context.Context, userID domain.UserID) error {
+	if _, err := r.dbpool.Exec(ctx, `DELETE FROM user_profiles WHERE user_id = $1`, string(userID)); err != nil {
+		return fmt.Errorf("error deleting profile: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// file: backend/services/user-service/internal/infrastructure/persistence/postgres_schema.go
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SchemaVersion adalah versi migrasi terbaru di migrations yang dibutuhkan kode ini.
+// Naikkan setiap kali menambah migrasi.
+const SchemaVersion = 1
+
+// SchemaMigrationsTable adalah tabel versi golang-migrate milik user-service. Berbeda dari tabel
+// default schema_migrations task-service sehingga kedua service bisa memakai database yang sama.
+const SchemaMigrationsTable = "user_service_schema_migrations"
+
+// PostgresSchemaChecker memeriksa bahwa migrasi user-service sudah diterapkan sampai SchemaVersion.
+type PostgresSchemaChecker struct {
+	dbpool *pgxpool.Pool
+	// ok diset setelah pemeriksaan pertama berhasil; versi schema tidak turun selama service berjalan.
+	ok atomic.Bool
+}
+
+// NewPostgresSchemaChecker adalah constructor untuk PostgresSchemaChecker.
+func NewPostgresSchemaChecker(dbpool *pgxpool.Pool) *PostgresSchemaChecker {
+	return &PostgresSchemaChecker{
+		dbpool: dbpool,
+	}
+}
+
+// Check mengembalikan error jika migrasi belum diterapkan sampai SchemaVersion atau migrasi
+// terakhir gagal di tengah jalan (dirty).
+func (c *PostgresSchemaChecker) Check(ctx context.Context) error {
+	if c.ok.Load() {
+		return nil
+	}
+	var version int64
+	var dirty bool
+	err := c.dbpool.QueryRow(ctx, `SELECT version, dirty FROM `+SchemaMigrationsTable+` LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errors.New("no migrations applied")
+	}
+	if err != nil {
+		return fmt.Errorf("error reading schema version: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version < SchemaVersion {
+		return fmt.Errorf("schema version %d is older than required version %d", version, SchemaVersion)
+	}
+	c.ok.Store(true)
+	return nil
+}
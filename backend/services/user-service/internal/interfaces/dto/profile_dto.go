@@ -0,0 +1,45 @@
+// file: backend/services/user-service/internal/interfaces/dto/profile_dto.go
+package dto
+
+import (
+	"time"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+)
+
+// UpdateProfileRequest adalah body PATCH /api/v1/me/profile. Field yang tidak dikirim tidak
+// diubah; string kosong menghapus nilainya.
+type UpdateProfileRequest struct {
+	DisplayName *string        `json:"display_name"`
+	AvatarURL   *string        `json:"avatar_url"`
+	TimeZone    *string        `json:"time_zone"`
+	Preferences map[string]any `json:"preferences"` // Menggantikan seluruh objek preferences
+}
+
+// ProfileResponse adalah profil pengguna. UpdatedAt nil jika profil belum pernah disimpan.
+type ProfileResponse struct {
+	ID          string         `json:"id"`
+	DisplayName string         `json:"display_name"`
+	AvatarURL   string         `json:"avatar_url"`
+	TimeZone    string         `json:"time_zone"`
+	Preferences map[string]any `json:"preferences"`
+	UpdatedAt   *time.Time     `json:"updated_at"`
+}
+
+// NewProfileResponse memetakan domain.Profile ke ProfileResponse.
+func NewProfileResponse(profile *domain.Profile) ProfileResponse {
+	resp := ProfileResponse{
+		ID:          string(profile.UserID),
+		DisplayName: profile.DisplayName,
+		AvatarURL:   profile.AvatarURL,
+		TimeZone:    profile.TimeZone,
+		Preferences: profile.Preferences,
+	}
+	if resp.Preferences == nil {
+		resp.Preferences = map[string]any{}
+	}
+	if !profile.UpdatedAt.IsZero() {
+		resp.UpdatedAt = &profile.UpdatedAt
+	}
+	return resp
+}
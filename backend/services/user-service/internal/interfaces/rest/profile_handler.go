@@ -0,0 +1,73 @@
+// file: backend/services/user-service/internal/interfaces/rest/profile_handler.go
+package rest
+
+import (
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/infrastructure/auth"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/interfaces/dto"
+)
+
+// maxProfileBodySize membatasi body PATCH profil; preferences dibatasi lagi oleh domain.
+const maxProfileBodySize = 2 * domain.MaxPreferencesBytes
+
+// ProfileHandler menangani profil milik pengguna yang sedang login.
+type ProfileHandler struct {
+	profileService application.ProfileApplicationService
+}
+
+// NewProfileHandler adalah constructor untuk ProfileHandler.
+func NewProfileHandler(profileService application.ProfileApplicationService) *ProfileHandler {
+	return &ProfileHandler{
+		profileService: profileService,
+	}
+}
+
+// RegisterRoutes mendaftarkan route profil. Route ini membutuhkan pengguna terautentikasi.
+func (h *ProfileHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/me/profile", h.get)
+	mux.HandleFunc("PATCH /api/v1/me/profile", h.update)
+	mux.HandleFunc("DELETE /api/v1/me/profile", h.delete)
+}
+
+func (h *ProfileHandler) get(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	profile, err := h.profileService.GetProfile(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewProfileResponse(profile))
+}
+
+func (h *ProfileHandler) update(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	r.Body = http.MaxBytesReader(w, r.Body, maxProfileBodySize)
+	var req dto.UpdateProfileRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	profile, err := h.profileService.UpdateProfile(r.Context(), userID, application.UpdateProfileInput{
+		DisplayName: req.DisplayName,
+		AvatarURL:   req.AvatarURL,
+		TimeZone:    req.TimeZone,
+		Preferences: req.Preferences,
+	})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewProfileResponse(profile))
+}
+
+func (h *ProfileHandler) delete(w http.ResponseWriter, r *http.Request) {
+	userID, _ := auth.UserIDFromContext(r.Context())
+	if err := h.profileService.DeleteProfile(r.Context(), userID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,85 @@
+// file: backend/services/user-service/internal/interfaces/rest/response.go
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+)
+
+// problem adalah body error dengan format RFC 7807 (application/problem+json).
+type problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Code   string `json:"code,omitempty"` // Kode error stabil, lihat errorMapping
+	Detail string `json:"detail,omitempty"`
+}
+
+// writeJSON menulis v sebagai JSON dengan status yang diberikan.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("error encoding response", "error", err)
+	}
+}
+
+// writeProblem menulis response error dalam format problem+json.
+func writeProblem(w http.ResponseWriter, status int, detail string) {
+	writeProblemCode(w, status, "", detail)
+}
+
+// writeProblemCode sama dengan writeProblem, ditambah kode error stabil.
+func writeProblemCode(w http.ResponseWriter, status int, code, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+		Detail: detail,
+	}); err != nil {
+		slog.Error("error encoding problem response", "error", err)
+	}
+}
+
+// errorMapping memetakan error domain ke status HTTP dan kode error stabil, dengan kode yang sama
+// seperti task-service untuk error sejenis.
+var errorMapping = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{domain.ErrProfileNotFound, http.StatusNotFound, "profile_not_found"},
+	{domain.ErrInvalidDisplayName, http.StatusBadRequest, "invalid_display_name"},
+	{domain.ErrInvalidAvatarURL, http.StatusBadRequest, "invalid_avatar_url"},
+	{domain.ErrInvalidTimeZone, http.StatusBadRequest, "invalid_time_zone"},
+	{domain.ErrPreferencesTooLarge, http.StatusRequestEntityTooLarge, "preferences_too_large"},
+	{domain.ErrInvalidUserLookup, http.StatusBadRequest, "invalid_user_lookup"},
+}
+
+// writeError memetakan error dari application/domain layer ke response problem+json. Error yang
+// tidak dikenal dianggap 500 dan detailnya di-log, bukan dikirim ke klien.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	for _, m := range errorMapping {
+		if errors.Is(err, m.err) {
+			writeProblemCode(w, m.status, m.code, err.Error())
+			return
+		}
+	}
+	slog.ErrorContext(r.Context(), "internal error", "error", err)
+	writeProblemCode(w, http.StatusInternalServerError, "internal_error", "internal server error")
+}
+
+// decodeJSON membaca body request sebagai JSON ke dalam v.
+// Field yang tidak dikenal ditolak agar typo di klien cepat ketahuan.
+func decodeJSON(r *http.Request, v any) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,44 @@
+// file: backend/services/user-service/internal/interfaces/rest/router.go
+package rest
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// RouterConfig berisi handler dan middleware yang dipasang oleh NewRouter.
+type RouterConfig struct {
+	ProfileHandler *ProfileHandler
+	HealthHandler  *HealthHandler
+	AuthMiddleware func(http.Handler) http.Handler
+}
+
+// NewRouter membuat http.Handler untuk seluruh REST API. Route /api/v1/ membutuhkan access token
+// Supabase; probe kesehatan tidak.
+func NewRouter(cfg RouterConfig) http.Handler {
+	protected := http.NewServeMux()
+	cfg.ProfileHandler.RegisterRoutes(protected)
+
+	mux := http.NewServeMux()
+	cfg.HealthHandler.RegisterRoutes(mux)
+	mux.Handle("/api/v1/", cfg.AuthMiddleware(protected))
+	return recoverPanic(mux)
+}
+
+// recoverPanic mengubah panic di handler menjadi response 500 agar satu request yang gagal tidak
+// menghentikan proses.
+func recoverPanic(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+				slog.ErrorContext(r.Context(), "panic while handling request", "panic", rec, "stack", string(debug.Stack()))
+				writeProblemCode(w, http.StatusInternalServerError, "internal_error", "internal server error")
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,75 @@
+// file: backend/services/user-service/internal/interfaces/rpc/interceptor.go
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/infrastructure/auth"
+)
+
+// errorMapping memetakan error domain ke status code gRPC, dengan pengelompokan yang sama seperti
+// errorMapping di layer REST.
+var errorMapping = []struct {
+	err  error
+	code codes.Code
+}{
+	{domain.ErrProfileNotFound, codes.NotFound},
+	{domain.ErrInvalidUserLookup, codes.InvalidArgument},
+}
+
+// ServiceAuthInterceptor mewajibkan metadata "authorization: Bearer <token>" berisi salah satu
+// token service jika tokens dikonfigurasi. API gRPC hanya untuk service internal, jadi access
+// token pengguna tidak diterima. Error dari handler dipetakan ke status gRPC di sini, dan panic
+// diubah menjadi codes.Internal.
+func ServiceAuthInterceptor(tokens *auth.ServiceTokens) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if tokens.Required() {
+			var authorization string
+			if md, ok := metadata.FromIncomingContext(ctx); ok {
+				if values := md.Get("authorization"); len(values) > 0 {
+					authorization = values[0]
+				}
+			}
+			token, ok := auth.BearerToken(authorization)
+			if !ok || !tokens.Valid(token) {
+				return nil, status.Error(codes.Unauthenticated, "invalid service token")
+			}
+		}
+
+		defer func() {
+			if rec := recover(); rec != nil {
+				slog.ErrorContext(ctx, "panic while handling rpc", "rpc", info.FullMethod, "panic", rec, "stack", string(debug.Stack()))
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		resp, err = handler(ctx, req)
+		if err != nil {
+			return nil, toStatus(ctx, info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+// toStatus memetakan err ke status gRPC. Error yang tidak dikenal dianggap codes.Internal dan
+// detailnya di-log, bukan dikirim ke klien.
+func toStatus(ctx context.Context, method string, err error) error {
+	for _, m := range errorMapping {
+		if errors.Is(err, m.err) {
+			return status.Error(m.code, err.Error())
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+	slog.ErrorContext(ctx, "internal error", "rpc", method, "error", err)
+	return status.Error(codes.Internal, "internal server error")
+}
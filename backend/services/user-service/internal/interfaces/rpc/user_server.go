@@ -0,0 +1,46 @@
+// file: backend/services/user-service/internal/interfaces/rpc/user_server.go
+package rpc
+
+import (
+	"context"
+
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/application"
+	"github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/internal/domain"
+	userv1 "github.com/TubagusAldiMY/go-vue-todolist/backend/services/user-service/pkg/pb/user/v1"
+)
+
+// UserServer mengimplementasikan userv1.UserServiceServer di atas ProfileApplicationService.
+// Autentikasi service-to-service dan pemetaan error dilakukan ServiceAuthInterceptor.
+type UserServer struct {
+	userv1.UnimplementedUserServiceServer
+	profileService application.ProfileApplicationService
+}
+
+// NewUserServer adalah constructor untuk UserServer.
+func NewUserServer(profileService application.ProfileApplicationService) *UserServer {
+	return &UserServer{
+		profileService: profileService,
+	}
+}
+
+// BatchGetUsers hanya mengembalikan pengguna yang sudah menyimpan profil; task-service
+// menampilkan ID untuk pengguna lain.
+func (s *UserServer) BatchGetUsers(ctx context.Context, req *userv1.BatchGetUsersRequest) (*userv1.BatchGetUsersResponse, error) {
+	ids := make([]domain.UserID, 0, len(req.GetIds()))
+	for _, id := range req.GetIds() {
+		ids = append(ids, domain.UserID(id))
+	}
+	profiles, err := s.profileService.BatchGetProfiles(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	resp := &userv1.BatchGetUsersResponse{Users: make([]*userv1.User, 0, len(profiles))}
+	for _, profile := range profiles {
+		resp.Users = append(resp.Users, &userv1.User{
+			Id:          string(profile.UserID),
+			DisplayName: profile.DisplayName,
+			AvatarUrl:   profile.AvatarURL,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,11 @@
+-- Profil pengguna, satu baris per ID pengguna Supabase. Baris dibuat saat pengguna pertama kali
+-- menyimpan profil; pengguna tanpa baris memakai profil kosong.
+CREATE TABLE IF NOT EXISTS user_profiles (
+    user_id      TEXT        PRIMARY KEY,
+    display_name TEXT        NOT NULL DEFAULT '',
+    avatar_url   TEXT        NOT NULL DEFAULT '',
+    time_zone    TEXT        NOT NULL DEFAULT '',
+    preferences  JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
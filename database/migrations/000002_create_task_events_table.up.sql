@@ -0,0 +1,13 @@
+-- Backlog event perubahan task untuk fan-out realtime lewat LISTEN/NOTIFY.
+-- NOTIFY hanya membawa ID event; isi event dibaca dari tabel ini, sehingga replika yang
+-- sempat terputus bisa mengejar event yang terlewat. Event lama dihapus berkala oleh listener.
+CREATE TABLE IF NOT EXISTS task_events (
+    id          BIGSERIAL PRIMARY KEY,
+    event_type  TEXT        NOT NULL,
+    task_id     TEXT        NOT NULL,
+    user_id     TEXT        NOT NULL,
+    payload     JSONB,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_events_occurred_at ON task_events (occurred_at);
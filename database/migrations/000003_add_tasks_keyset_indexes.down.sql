@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_tasks_user_created_at ON tasks (user_id, created_at DESC);
+DROP INDEX IF EXISTS idx_tasks_user_created_at_id;
+DROP INDEX IF EXISTS idx_tasks_user_id_keyset;
@@ -0,0 +1,7 @@
+-- Index untuk keyset pagination daftar task.
+-- Strategi ID sortable (UUIDv7/ULID): cursor berupa ID, dibandingkan dengan COLLATE "C" (urutan byte).
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_keyset ON tasks (user_id, id COLLATE "C");
+
+-- Strategi UUIDv4: cursor berupa (created_at, id). Menggantikan idx_tasks_user_created_at.
+CREATE INDEX IF NOT EXISTS idx_tasks_user_created_at_id ON tasks (user_id, created_at DESC, id DESC);
+DROP INDEX IF EXISTS idx_tasks_user_created_at;
@@ -0,0 +1,7 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+-- Task yang sudah selesai sebelum kolom ini ada: updated_at adalah perkiraan terbaik.
+UPDATE tasks SET completed_at = updated_at WHERE completed AND completed_at IS NULL;
+
+-- Dipakai untuk tampilan "selesai hari ini" dan statistik penyelesaian.
+CREATE INDEX IF NOT EXISTS idx_tasks_user_completed_at ON tasks (user_id, completed_at DESC) WHERE completed;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS usage_events;
+DROP TABLE IF EXISTS plan_quotas;
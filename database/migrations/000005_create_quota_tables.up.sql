@@ -0,0 +1,29 @@
+-- Kebijakan soft-quota per plan. Melewati batas tidak memblokir operasi, hanya memicu peringatan.
+CREATE TABLE IF NOT EXISTS plan_quotas (
+    plan               TEXT        NOT NULL,
+    resource           TEXT        NOT NULL,
+    quota_limit        BIGINT      NOT NULL CHECK (quota_limit > 0),
+    warning_thresholds INT[]       NOT NULL DEFAULT '{80,95}',
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (plan, resource)
+);
+
+INSERT INTO plan_quotas (plan, resource, quota_limit, warning_thresholds) VALUES
+    ('free', 'tasks', 500, '{80,95}'),
+    ('pro', 'tasks', 10000, '{80,95}')
+ON CONFLICT (plan, resource) DO NOTHING;
+
+-- Event pemakaian kuota (misalnya peringatan 80%/95%) untuk diteruskan sebagai notifikasi.
+CREATE TABLE IF NOT EXISTS usage_events (
+    id          BIGSERIAL PRIMARY KEY,
+    event_type  TEXT        NOT NULL,
+    user_id     TEXT        NOT NULL,
+    plan        TEXT        NOT NULL,
+    resource    TEXT        NOT NULL,
+    threshold   INT         NOT NULL,
+    used        BIGINT      NOT NULL,
+    quota_limit BIGINT      NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_usage_events_user_occurred_at ON usage_events (user_id, occurred_at DESC);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS admin_audit_log;
+DROP INDEX IF EXISTS idx_tasks_user_id_pattern;
+DROP INDEX IF EXISTS idx_tasks_title_trgm;
@@ -0,0 +1,19 @@
+-- Pencarian judul oleh admin (ILIKE '%...%') membutuhkan index trigram.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS idx_tasks_title_trgm ON tasks USING gin (title gin_trgm_ops);
+
+-- Pencarian prefix user_id (LIKE 'abc%') tanpa bergantung pada locale database.
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_pattern ON tasks (user_id text_pattern_ops);
+
+-- Audit log aksi admin. Hanya di-INSERT oleh aplikasi, tidak pernah di-UPDATE/DELETE.
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id           BIGSERIAL PRIMARY KEY,
+    admin_id     TEXT        NOT NULL,
+    action       TEXT        NOT NULL,
+    reason       TEXT        NOT NULL,
+    details      JSONB       NOT NULL DEFAULT '{}',
+    result_count INT         NOT NULL DEFAULT 0,
+    occurred_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_admin_occurred_at ON admin_audit_log (admin_id, occurred_at DESC);
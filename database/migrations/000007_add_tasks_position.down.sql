@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_tasks_user_position;
+ALTER TABLE tasks DROP COLUMN IF EXISTS position;
@@ -0,0 +1,13 @@
+-- Urutan manual task (drag-and-drop). Posisi lebih kecil tampil lebih atas.
+-- Posisi bersifat fractional: memindahkan satu task cukup mengisi nilai di tengah dua tetangganya.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS position DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- Task lama: urutan awal sama dengan urutan default (terbaru di atas), berjarak 1024.
+UPDATE tasks t SET position = ranked.rn * 1024
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) AS rn
+    FROM tasks
+) ranked
+WHERE t.id = ranked.id;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_user_position ON tasks (user_id, position, id);
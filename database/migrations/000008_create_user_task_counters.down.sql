@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_tasks_counters_update ON tasks;
+DROP TRIGGER IF EXISTS trg_tasks_counters_insert_delete ON tasks;
+DROP FUNCTION IF EXISTS maintain_user_task_counters();
+DROP TABLE IF EXISTS user_task_counters;
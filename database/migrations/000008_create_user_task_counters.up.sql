@@ -0,0 +1,45 @@
+-- Counter cache jumlah task per pengguna, menggantikan COUNT(*) pada setiap render sidebar dan
+-- pemantauan kuota. Diperbarui oleh trigger di transaksi yang sama dengan write ke tabel tasks,
+-- dan direkonsiliasi oleh pemeriksaan integritas task_counter_drift.
+CREATE TABLE IF NOT EXISTS user_task_counters (
+    user_id     TEXT   PRIMARY KEY,
+    total_tasks BIGINT NOT NULL DEFAULT 0,
+    open_tasks  BIGINT NOT NULL DEFAULT 0 -- Task yang belum selesai
+);
+
+INSERT INTO user_task_counters (user_id, total_tasks, open_tasks)
+SELECT user_id, COUNT(*), COUNT(*) FILTER (WHERE NOT completed)
+FROM tasks
+GROUP BY user_id
+ON CONFLICT (user_id) DO UPDATE
+SET total_tasks = EXCLUDED.total_tasks, open_tasks = EXCLUDED.open_tasks;
+
+CREATE OR REPLACE FUNCTION maintain_user_task_counters() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE user_task_counters
+        SET total_tasks = total_tasks - 1,
+            open_tasks = open_tasks - CASE WHEN OLD.completed THEN 0 ELSE 1 END
+        WHERE user_id = OLD.user_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO user_task_counters (user_id, total_tasks, open_tasks)
+        VALUES (NEW.user_id, 1, CASE WHEN NEW.completed THEN 0 ELSE 1 END)
+        ON CONFLICT (user_id) DO UPDATE
+        SET total_tasks = user_task_counters.total_tasks + 1,
+            open_tasks = user_task_counters.open_tasks + EXCLUDED.open_tasks;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_tasks_counters_insert_delete
+AFTER INSERT OR DELETE ON tasks
+FOR EACH ROW EXECUTE FUNCTION maintain_user_task_counters();
+
+-- UPDATE hanya memengaruhi counter jika status selesai atau pemilik berubah.
+CREATE TRIGGER trg_tasks_counters_update
+AFTER UPDATE OF completed, user_id ON tasks
+FOR EACH ROW
+WHEN (OLD.completed IS DISTINCT FROM NEW.completed OR OLD.user_id IS DISTINCT FROM NEW.user_id)
+EXECUTE FUNCTION maintain_user_task_counters();
@@ -0,0 +1,11 @@
+-- Operasi bulk yang masih bisa dibatalkan dalam jendela undo. Token hanya bisa dipakai sekali.
+CREATE TABLE IF NOT EXISTS task_undo_operations (
+    token      TEXT        PRIMARY KEY,
+    user_id    TEXT        NOT NULL,
+    kind       TEXT        NOT NULL,         -- Misalnya "bulk.complete"
+    payload    JSONB       NOT NULL,         -- State task sebelum operasi
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_undo_operations_user_expires_at ON task_undo_operations (user_id, expires_at);
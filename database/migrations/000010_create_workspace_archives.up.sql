@@ -0,0 +1,14 @@
+-- Catatan arsip workspace. Ekspor datanya disimpan di BlobStore (blob_key); baris live
+-- di tabel tasks dihapus setelah purge_after.
+CREATE TABLE IF NOT EXISTS workspace_archives (
+    user_id     TEXT        PRIMARY KEY,
+    status      TEXT        NOT NULL CHECK (status IN ('archived', 'purged', 'restored')),
+    blob_key    TEXT        NOT NULL,
+    task_count  BIGINT      NOT NULL DEFAULT 0,
+    archived_at TIMESTAMPTZ NOT NULL,
+    purge_after TIMESTAMPTZ NOT NULL,
+    purged_at   TIMESTAMPTZ,
+    restored_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_archives_purge_after ON workspace_archives (purge_after) WHERE status = 'archived';
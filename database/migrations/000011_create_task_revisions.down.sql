@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_tasks_revisions ON tasks;
+DROP FUNCTION IF EXISTS record_task_revision();
+DROP TABLE IF EXISTS task_revisions;
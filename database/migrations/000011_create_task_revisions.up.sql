@@ -0,0 +1,63 @@
+-- Riwayat perubahan task per revisi. Diisi oleh trigger sehingga semua jalur write (REST, sync,
+-- bulk, restore arsip) tercatat. Position tidak dilacak karena berubah setiap drag-and-drop.
+CREATE TABLE IF NOT EXISTS task_revisions (
+    id          BIGSERIAL   PRIMARY KEY,
+    task_id     TEXT        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id     TEXT        NOT NULL,
+    actor_id    TEXT        NOT NULL,  -- Pelaku perubahan; default pemilik task
+    revision    INT         NOT NULL,
+    changes     JSONB       NOT NULL,  -- {"field": {"old": ..., "new": ...}}
+    snapshot    JSONB       NOT NULL,  -- Nilai field yang dilacak setelah perubahan
+    occurred_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (task_id, revision)
+);
+
+-- Pelaku bisa diatur per transaksi dengan SET LOCAL app.actor_id = '<id>' (misalnya admin);
+-- tanpa itu, pelaku dianggap pemilik task.
+CREATE OR REPLACE FUNCTION record_task_revision() RETURNS trigger AS $$
+DECLARE
+    diff JSONB := '{}'::jsonb;
+    next_revision INT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        diff := jsonb_build_object(
+            'title', jsonb_build_object('old', NULL, 'new', NEW.title),
+            'description', jsonb_build_object('old', NULL, 'new', NEW.description),
+            'completed', jsonb_build_object('old', NULL, 'new', NEW.completed),
+            'completed_at', jsonb_build_object('old', NULL, 'new', NEW.completed_at));
+    ELSE
+        IF NEW.title IS DISTINCT FROM OLD.title THEN
+            diff := diff || jsonb_build_object('title', jsonb_build_object('old', OLD.title, 'new', NEW.title));
+        END IF;
+        IF NEW.description IS DISTINCT FROM OLD.description THEN
+            diff := diff || jsonb_build_object('description', jsonb_build_object('old', OLD.description, 'new', NEW.description));
+        END IF;
+        IF NEW.completed IS DISTINCT FROM OLD.completed THEN
+            diff := diff || jsonb_build_object('completed', jsonb_build_object('old', OLD.completed, 'new', NEW.completed));
+        END IF;
+        IF NEW.completed_at IS DISTINCT FROM OLD.completed_at THEN
+            diff := diff || jsonb_build_object('completed_at', jsonb_build_object('old', OLD.completed_at, 'new', NEW.completed_at));
+        END IF;
+        IF diff = '{}'::jsonb THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    -- Aman dari race: UPDATE memegang row lock task sampai commit.
+    SELECT COALESCE(MAX(revision), 0) + 1 INTO next_revision FROM task_revisions WHERE task_id = NEW.id;
+
+    INSERT INTO task_revisions (task_id, user_id, actor_id, revision, changes, snapshot, occurred_at)
+    VALUES (
+        NEW.id, NEW.user_id,
+        COALESCE(NULLIF(current_setting('app.actor_id', true), ''), NEW.user_id),
+        next_revision, diff,
+        jsonb_build_object('title', NEW.title, 'description', NEW.description,
+                           'completed', NEW.completed, 'completed_at', NEW.completed_at),
+        NEW.updated_at);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_tasks_revisions
+AFTER INSERT OR UPDATE OF title, description, completed, completed_at ON tasks
+FOR EACH ROW EXECUTE FUNCTION record_task_revision();
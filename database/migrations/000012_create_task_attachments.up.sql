@@ -0,0 +1,14 @@
+-- Metadata attachment task. Isi file disimpan di object storage (Supabase Storage atau S3)
+-- dengan key storage_key; baris di sini dihapus otomatis saat task dihapus.
+CREATE TABLE IF NOT EXISTS task_attachments (
+    id           TEXT        PRIMARY KEY,
+    task_id      TEXT        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id      TEXT        NOT NULL,
+    file_name    TEXT        NOT NULL,
+    content_type TEXT        NOT NULL,
+    size_bytes   BIGINT      NOT NULL CHECK (size_bytes >= 0),
+    storage_key  TEXT        NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_attachments_task_id ON task_attachments (task_id, created_at);
@@ -0,0 +1,9 @@
+DROP COLLATION IF EXISTS task_title_tr;
+DROP COLLATION IF EXISTS task_title_sv;
+DROP COLLATION IF EXISTS task_title_nl;
+DROP COLLATION IF EXISTS task_title_id;
+DROP COLLATION IF EXISTS task_title_fr;
+DROP COLLATION IF EXISTS task_title_es;
+DROP COLLATION IF EXISTS task_title_en;
+DROP COLLATION IF EXISTS task_title_de;
+DROP COLLATION IF EXISTS task_title_und;
@@ -0,0 +1,12 @@
+-- Collation ICU untuk sort=title. Membutuhkan Postgres yang dibangun dengan ICU (misalnya Supabase
+-- dan image resmi). Opsi kn-true membandingkan angka secara numerik, sehingga "Task 2" tampil
+-- sebelum "Task 10". Daftar ini harus sama dengan titleCollations di postgres_task_repository.go.
+CREATE COLLATION IF NOT EXISTS task_title_und (provider = icu, locale = 'und-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_de (provider = icu, locale = 'de-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_en (provider = icu, locale = 'en-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_es (provider = icu, locale = 'es-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_fr (provider = icu, locale = 'fr-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_id (provider = icu, locale = 'id-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_nl (provider = icu, locale = 'nl-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_sv (provider = icu, locale = 'sv-u-kn-true');
+CREATE COLLATION IF NOT EXISTS task_title_tr (provider = icu, locale = 'tr-u-kn-true');
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_search_vector;
+ALTER TABLE tasks DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS task_search_emoji_tokens(TEXT);
+DROP TEXT SEARCH CONFIGURATION IF EXISTS task_search;
@@ -0,0 +1,50 @@
+-- Full-text search task untuk pengguna (GET /api/v1/tasks/search).
+--
+-- Normalisasi dilakukan di konfigurasi text search task_search: kamus unaccent membuang
+-- diakritik ("café" -> "cafe") lalu kamus simple melakukan case folding tanpa stemming, sehingga
+-- cocok untuk teks campuran bahasa Indonesia dan Inggris. Parser bawaan Postgres membuang emoji,
+-- jadi teks dilewatkan dulu ke task_search_emoji_tokens yang mengubah setiap emoji menjadi token
+-- kata (misalnya "🔥" -> "emoji1f525"). Query pencarian melewati fungsi yang sama.
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+CREATE TEXT SEARCH CONFIGURATION task_search (COPY = simple);
+ALTER TEXT SEARCH CONFIGURATION task_search
+    ALTER MAPPING FOR asciiword, asciihword, hword_asciipart, word, hword, hword_part, numword, numhword
+    WITH unaccent, simple;
+
+-- Variation selector (FE0F), zero-width joiner (200D), dan modifier warna kulit (1F3FB-1F3FF)
+-- dibuang agar varian emoji yang sama menghasilkan token yang sama.
+CREATE OR REPLACE FUNCTION task_search_emoji_tokens(input TEXT) RETURNS TEXT
+LANGUAGE plpgsql IMMUTABLE PARALLEL SAFE AS $$
+DECLARE
+    result TEXT := '';
+    ch TEXT;
+    cp INT;
+BEGIN
+    IF input IS NULL OR input !~ '[\u2300-\u23FF\u2600-\u27BF\u2B00-\u2BFF\U0001F000-\U0001FAFF]' THEN
+        RETURN COALESCE(input, '');
+    END IF;
+
+    FOREACH ch IN ARRAY regexp_split_to_array(input, '') LOOP
+        cp := ascii(ch);
+        IF cp IN (x'FE0F'::int, x'200D'::int) OR cp BETWEEN x'1F3FB'::int AND x'1F3FF'::int THEN
+            CONTINUE;
+        ELSIF cp BETWEEN x'2300'::int AND x'23FF'::int OR cp BETWEEN x'2600'::int AND x'27BF'::int
+           OR cp BETWEEN x'2B00'::int AND x'2BFF'::int OR cp BETWEEN x'1F000'::int AND x'1FAFF'::int THEN
+            result := result || ' emoji' || to_hex(cp) || ' ';
+        ELSE
+            result := result || ch;
+        END IF;
+    END LOOP;
+    RETURN result;
+END;
+$$;
+
+-- Judul diberi bobot lebih tinggi daripada deskripsi untuk ranking. Menambah generated column
+-- menulis ulang tabel tasks; jalankan di luar jam sibuk untuk tabel besar.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('task_search', task_search_emoji_tokens(title)), 'A') ||
+    setweight(to_tsvector('task_search', task_search_emoji_tokens(description)), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING gin (search_vector);
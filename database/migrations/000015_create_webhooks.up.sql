@@ -0,0 +1,13 @@
+-- Registrasi webhook per pengguna. event_types kosong berarti semua jenis event task.
+CREATE TABLE IF NOT EXISTS webhooks (
+    id               TEXT        PRIMARY KEY,
+    user_id          TEXT        NOT NULL,
+    url              TEXT        NOT NULL,
+    secret           TEXT        NOT NULL,
+    event_types      TEXT[]      NOT NULL DEFAULT '{}',
+    payload_template TEXT        NOT NULL DEFAULT '',
+    content_type     TEXT        NOT NULL DEFAULT 'application/json',
+    created_at       TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_user_id ON webhooks (user_id, created_at);
@@ -0,0 +1,13 @@
+-- Pengaturan notifikasi Discord per pengguna. webhook_url dan channel_id boleh kosong selama
+-- pengguna hanya menghubungkan akun Discord untuk slash command.
+CREATE TABLE IF NOT EXISTS discord_channels (
+    user_id              TEXT        PRIMARY KEY,
+    webhook_url          TEXT        NOT NULL DEFAULT '',
+    channel_id           TEXT        NOT NULL DEFAULT '',
+    event_types          TEXT[]      NOT NULL DEFAULT '{}',
+    discord_user_id      TEXT        UNIQUE,
+    link_code_hash       TEXT        UNIQUE,
+    link_code_expires_at TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ NOT NULL,
+    updated_at           TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,12 @@
+-- Sesi timer per task. stopped_at NULL berarti timer masih berjalan; index unik parsial
+-- menjamin setiap pengguna punya paling banyak satu timer yang berjalan.
+CREATE TABLE IF NOT EXISTS time_entries (
+    id         TEXT        PRIMARY KEY,
+    task_id    TEXT        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id    TEXT        NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    stopped_at TIMESTAMPTZ CHECK (stopped_at IS NULL OR stopped_at >= started_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_time_entries_task_id ON time_entries (task_id, started_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries (user_id) WHERE stopped_at IS NULL;
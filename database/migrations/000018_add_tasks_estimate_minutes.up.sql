@@ -0,0 +1,3 @@
+-- Perkiraan usaha task dalam menit; NULL berarti belum diestimasi.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS estimate_minutes INTEGER
+    CHECK (estimate_minutes IS NULL OR estimate_minutes BETWEEN 0 AND 43200);
@@ -0,0 +1,12 @@
+-- Pengaturan notifikasi Matrix per pengguna. access_token adalah token akun pengirim di
+-- homeserver pengguna.
+CREATE TABLE IF NOT EXISTS matrix_channels (
+    user_id        TEXT        PRIMARY KEY,
+    homeserver_url TEXT        NOT NULL,
+    access_token   TEXT        NOT NULL,
+    room_id        TEXT        NOT NULL,
+    event_types    TEXT[]      NOT NULL DEFAULT '{}',
+    verified_at    TIMESTAMPTZ NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL,
+    updated_at     TIMESTAMPTZ NOT NULL
+);
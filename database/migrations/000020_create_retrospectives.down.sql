@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS retrospectives;
+DROP TABLE IF EXISTS retrospective_settings;
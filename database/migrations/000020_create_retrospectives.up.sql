@@ -0,0 +1,18 @@
+-- Pengaturan retrospektif bulanan (opt-in) dan hasil retrospektif yang sudah dibuat.
+CREATE TABLE IF NOT EXISTS retrospective_settings (
+    user_id    TEXT        PRIMARY KEY,
+    enabled    BOOLEAN     NOT NULL DEFAULT FALSE,
+    time_zone  TEXT        NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_retrospective_settings_enabled ON retrospective_settings (user_id) WHERE enabled;
+
+-- month berformat YYYY-MM; report berisi rangkuman lengkap sebagai JSON.
+CREATE TABLE IF NOT EXISTS retrospectives (
+    user_id      TEXT        NOT NULL,
+    month        TEXT        NOT NULL,
+    report       JSONB       NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, month)
+);
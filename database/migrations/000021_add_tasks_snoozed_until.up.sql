@@ -0,0 +1,2 @@
+-- Task yang di-snooze disembunyikan dari daftar default sampai snoozed_until; NULL berarti tidak di-snooze.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS snoozed_until TIMESTAMPTZ;
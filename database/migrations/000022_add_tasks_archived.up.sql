@@ -0,0 +1,4 @@
+-- Task selesai yang diarsipkan tetap tersimpan tetapi tidak tampil di daftar default.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_archived ON tasks (user_id, completed_at DESC) WHERE archived;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_column_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS column_id;
+DROP TABLE IF EXISTS board_columns;
@@ -0,0 +1,17 @@
+-- Kolom board Kanban per pengguna. wip_limit NULL berarti tanpa batas.
+CREATE TABLE IF NOT EXISTS board_columns (
+    id         TEXT        PRIMARY KEY,
+    user_id    TEXT        NOT NULL,
+    name       TEXT        NOT NULL,
+    position   INTEGER     NOT NULL,
+    wip_limit  INTEGER     CHECK (wip_limit IS NULL OR wip_limit > 0),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_columns_user_id ON board_columns (user_id, position);
+
+-- Menghapus kolom mengeluarkan task-nya dari board, bukan menghapus task.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS column_id TEXT REFERENCES board_columns (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_column_id ON tasks (column_id) WHERE column_id IS NOT NULL;
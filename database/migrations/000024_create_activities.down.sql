@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_tasks_activities ON tasks;
+DROP FUNCTION IF EXISTS record_task_activity();
+DROP TABLE IF EXISTS activities;
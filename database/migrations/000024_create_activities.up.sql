@@ -0,0 +1,57 @@
+-- Feed aktivitas per pengguna ("aktivitas terbaru"). Seperti task_revisions, diisi oleh trigger
+-- sehingga semua jalur write tercatat. task_id sengaja tanpa foreign key agar aktivitas
+-- penghapusan tetap ada setelah task-nya dihapus; judul disalin saat aktivitas terjadi.
+CREATE TABLE IF NOT EXISTS activities (
+    id            BIGSERIAL   PRIMARY KEY,
+    user_id       TEXT        NOT NULL,
+    actor_id      TEXT        NOT NULL,  -- Pelaku; default pemilik task
+    activity_type TEXT        NOT NULL,  -- task.created, task.completed, task.reopened, task.archived, task.deleted
+    task_id       TEXT        NOT NULL,
+    task_title    TEXT        NOT NULL,
+    occurred_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_activities_user_id ON activities (user_id, id DESC);
+
+-- Operasi massal yang bukan aksi pengguna (restore arsip/undo, hapus akun) mematikan pencatatan
+-- dengan SELECT set_config('app.skip_activity', 'on', true) di dalam transaksinya.
+CREATE OR REPLACE FUNCTION record_task_activity() RETURNS trigger AS $$
+DECLARE
+    actor TEXT;
+BEGIN
+    IF current_setting('app.skip_activity', true) = 'on' THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO activities (user_id, actor_id, activity_type, task_id, task_title, occurred_at)
+        VALUES (OLD.user_id, COALESCE(NULLIF(current_setting('app.actor_id', true), ''), OLD.user_id),
+                'task.deleted', OLD.id, OLD.title, NOW());
+        RETURN NULL;
+    END IF;
+
+    actor := COALESCE(NULLIF(current_setting('app.actor_id', true), ''), NEW.user_id);
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO activities (user_id, actor_id, activity_type, task_id, task_title, occurred_at)
+        VALUES (NEW.user_id, actor, 'task.created', NEW.id, NEW.title, NEW.created_at);
+        RETURN NULL;
+    END IF;
+
+    IF NEW.completed AND NOT OLD.completed THEN
+        INSERT INTO activities (user_id, actor_id, activity_type, task_id, task_title, occurred_at)
+        VALUES (NEW.user_id, actor, 'task.completed', NEW.id, NEW.title, COALESCE(NEW.completed_at, NEW.updated_at));
+    ELSIF OLD.completed AND NOT NEW.completed THEN
+        INSERT INTO activities (user_id, actor_id, activity_type, task_id, task_title, occurred_at)
+        VALUES (NEW.user_id, actor, 'task.reopened', NEW.id, NEW.title, NEW.updated_at);
+    END IF;
+    IF NEW.archived AND NOT OLD.archived THEN
+        INSERT INTO activities (user_id, actor_id, activity_type, task_id, task_title, occurred_at)
+        VALUES (NEW.user_id, actor, 'task.archived', NEW.id, NEW.title, NEW.updated_at);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_tasks_activities
+AFTER INSERT OR DELETE OR UPDATE OF completed, archived ON tasks
+FOR EACH ROW EXECUTE FUNCTION record_task_activity();
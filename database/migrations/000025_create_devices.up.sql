@@ -0,0 +1,20 @@
+-- Perangkat klien milik pengguna beserta diagnostik sinkronisasinya. ID dibuat oleh klien
+-- (header X-Device-ID), sehingga unik per pengguna, bukan global.
+CREATE TABLE IF NOT EXISTS devices (
+    user_id           TEXT        NOT NULL,
+    id                TEXT        NOT NULL,
+    name              TEXT        NOT NULL DEFAULT '',
+    platform          TEXT        NOT NULL DEFAULT '',
+    push_subscription JSONB,
+    last_pull_at      TIMESTAMPTZ,
+    last_cursor       TIMESTAMPTZ,
+    last_push_at      TIMESTAMPTZ,
+    last_push_items   INT         NOT NULL DEFAULT 0,
+    last_push_failed  INT         NOT NULL DEFAULT 0,
+    last_push_stale   INT         NOT NULL DEFAULT 0,
+    last_push_issues  JSONB       NOT NULL DEFAULT '[]',
+    created_at        TIMESTAMPTZ NOT NULL,
+    updated_at        TIMESTAMPTZ NOT NULL,
+    revoked_at        TIMESTAMPTZ,
+    PRIMARY KEY (user_id, id)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
+DROP TABLE IF EXISTS enum_values;
@@ -0,0 +1,25 @@
+-- Nilai enum disimpan sebagai data, bukan CHECK constraint atau tipe ENUM Postgres, sehingga nilai
+-- baru bisa ditambahkan saat runtime (per workspace, atau builtin dengan INSERT biasa) tanpa
+-- migrasi skema. Nilai tidak dihapus, hanya ditandai deprecated, agar data lama tetap valid.
+-- user_id '' berarti nilai builtin yang berlaku untuk semua workspace.
+CREATE TABLE IF NOT EXISTS enum_values (
+    enum_name  TEXT        NOT NULL,
+    user_id    TEXT        NOT NULL DEFAULT '',
+    value      TEXT        NOT NULL,
+    label      TEXT        NOT NULL,
+    position   INT         NOT NULL DEFAULT 0,
+    deprecated BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (enum_name, user_id, value)
+);
+
+INSERT INTO enum_values (enum_name, value, label, position) VALUES
+    ('task_priority', 'low', 'Low', 10),
+    ('task_priority', 'medium', 'Medium', 20),
+    ('task_priority', 'high', 'High', 30),
+    ('task_priority', 'urgent', 'Urgent', 40)
+ON CONFLICT DO NOTHING;
+
+-- Validasi nilai dilakukan aplikasi terhadap enum_values (nilai workspace tidak bisa dirujuk
+-- dengan foreign key biasa). NULL berarti task tanpa prioritas.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS priority TEXT;
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trg_tasks_streak_update ON tasks;
+DROP TRIGGER IF EXISTS trg_tasks_streak_insert ON tasks;
+DROP FUNCTION IF EXISTS maintain_completion_streak();
+DROP FUNCTION IF EXISTS completion_streak_day(TIMESTAMPTZ, TEXT);
+DROP TABLE IF EXISTS completion_streaks;
@@ -0,0 +1,80 @@
+-- Streak penyelesaian harian per pengguna: jumlah hari kalender berturut-turut dengan minimal satu
+-- task yang diselesaikan. Seperti user_task_counters, diperbarui oleh trigger di transaksi yang sama
+-- dengan write yang menyelesaikan task, sehingga semua jalur (PATCH, bulk, sync) ikut terhitung.
+CREATE TABLE IF NOT EXISTS completion_streaks (
+    user_id           TEXT        PRIMARY KEY,
+    current_streak    INTEGER     NOT NULL,  -- Panjang streak yang berakhir di last_completed_on
+    longest_streak    INTEGER     NOT NULL,
+    last_completed_on DATE        NOT NULL,  -- Hari terakhir dengan task selesai
+    updated_at        TIMESTAMPTZ NOT NULL
+);
+
+-- Hari kalender ts di zona waktu pengguna (time_zone pengaturan retrospektif, default UTC).
+-- Zona waktu yang tidak dikenal Postgres jatuh ke UTC agar write ke tasks tidak pernah gagal.
+CREATE OR REPLACE FUNCTION completion_streak_day(ts TIMESTAMPTZ, owner TEXT) RETURNS DATE AS $$
+DECLARE
+    tz TEXT;
+BEGIN
+    SELECT NULLIF(time_zone, '') INTO tz FROM retrospective_settings WHERE user_id = owner;
+    RETURN (ts AT TIME ZONE COALESCE(tz, 'UTC'))::date;
+EXCEPTION WHEN invalid_parameter_value THEN
+    RETURN (ts AT TIME ZONE 'UTC')::date;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+-- Isi awal dari task yang sudah selesai: setiap rangkaian hari berturut-turut punya
+-- day - ROW_NUMBER() yang sama, sehingga panjang rangkaian cukup dihitung per grup.
+WITH days AS (
+    SELECT DISTINCT user_id, completion_streak_day(completed_at, user_id) AS day
+    FROM tasks
+    WHERE completed AND completed_at IS NOT NULL
+), runs AS (
+    SELECT user_id, MAX(day) AS last_day, COUNT(*)::int AS length
+    FROM (
+        SELECT user_id, day, day - (ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY day))::int AS run
+        FROM days
+    ) numbered
+    GROUP BY user_id, run
+)
+INSERT INTO completion_streaks (user_id, current_streak, longest_streak, last_completed_on, updated_at)
+SELECT user_id, (array_agg(length ORDER BY last_day DESC))[1], MAX(length), MAX(last_day), NOW()
+FROM runs
+GROUP BY user_id
+ON CONFLICT (user_id) DO NOTHING;
+
+-- Penyelesaian pada hari yang sama tidak mengubah streak, hari berikutnya menambah streak,
+-- dan jeda lebih dari satu hari memulai streak baru. Penyelesaian dengan completed_at sebelum
+-- last_completed_on (misalnya sync offline yang terlambat) diabaikan. Membuka kembali task tidak
+-- mengurangi streak.
+CREATE OR REPLACE FUNCTION maintain_completion_streak() RETURNS trigger AS $$
+DECLARE
+    day DATE;
+BEGIN
+    day := completion_streak_day(COALESCE(NEW.completed_at, NOW()), NEW.user_id);
+    INSERT INTO completion_streaks (user_id, current_streak, longest_streak, last_completed_on, updated_at)
+    VALUES (NEW.user_id, 1, 1, day, NOW())
+    ON CONFLICT (user_id) DO UPDATE
+    SET current_streak = CASE
+            WHEN completion_streaks.last_completed_on >= EXCLUDED.last_completed_on THEN completion_streaks.current_streak
+            WHEN completion_streaks.last_completed_on = EXCLUDED.last_completed_on - 1 THEN completion_streaks.current_streak + 1
+            ELSE 1
+        END,
+        last_completed_on = GREATEST(completion_streaks.last_completed_on, EXCLUDED.last_completed_on),
+        updated_at = NOW();
+    UPDATE completion_streaks SET longest_streak = current_streak
+    WHERE user_id = NEW.user_id AND current_streak > longest_streak;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_tasks_streak_insert
+AFTER INSERT ON tasks
+FOR EACH ROW
+WHEN (NEW.completed)
+EXECUTE FUNCTION maintain_completion_streak();
+
+CREATE TRIGGER trg_tasks_streak_update
+AFTER UPDATE OF completed ON tasks
+FOR EACH ROW
+WHEN (NEW.completed AND NOT OLD.completed)
+EXECUTE FUNCTION maintain_completion_streak();
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS due_text;
+ALTER TABLE tasks DROP COLUMN IF EXISTS due_at;
@@ -0,0 +1,4 @@
+-- Tenggat task. due_text menyimpan teks asli yang diketik pengguna (misalnya "tomorrow 5pm")
+-- apa adanya; due_at adalah hasil parse-nya di zona waktu pengguna saat task disimpan.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS due_at TIMESTAMPTZ;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS due_text TEXT;
@@ -0,0 +1,12 @@
+-- Callback sekali pakai per task: URL yang dipanggil sekali saat task selesai, lalu barisnya
+-- dihapus. Callback ikut terhapus bersama task-nya.
+CREATE TABLE IF NOT EXISTS task_callbacks (
+    id         TEXT        PRIMARY KEY,
+    user_id    TEXT        NOT NULL,
+    task_id    TEXT        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    url        TEXT        NOT NULL,
+    secret     TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_callbacks_task_id ON task_callbacks (task_id, created_at);
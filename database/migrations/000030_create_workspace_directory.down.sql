@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS scim_tokens;
+DROP TABLE IF EXISTS workspace_role_mappings;
+DROP TABLE IF EXISTS workspace_group_members;
+DROP TABLE IF EXISTS workspace_groups;
+DROP TABLE IF EXISTS workspace_members;
@@ -0,0 +1,49 @@
+-- Direktori anggota workspace yang diprovisikan identity provider lewat SCIM 2.0. Workspace
+-- diidentifikasi dengan ID pemiliknya (workspace_id = user_id pemilik).
+CREATE TABLE IF NOT EXISTS workspace_members (
+    id           TEXT        PRIMARY KEY,
+    workspace_id TEXT        NOT NULL,
+    user_name    TEXT        NOT NULL,  -- userName SCIM, biasanya email
+    external_id  TEXT        NOT NULL DEFAULT '',
+    display_name TEXT        NOT NULL DEFAULT '',
+    active       BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_members_user_name ON workspace_members (workspace_id, lower(user_name));
+
+CREATE TABLE IF NOT EXISTS workspace_groups (
+    id           TEXT        PRIMARY KEY,
+    workspace_id TEXT        NOT NULL,
+    display_name TEXT        NOT NULL,
+    external_id  TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_groups_display_name ON workspace_groups (workspace_id, lower(display_name));
+
+CREATE TABLE IF NOT EXISTS workspace_group_members (
+    group_id  TEXT NOT NULL REFERENCES workspace_groups (id) ON DELETE CASCADE,
+    member_id TEXT NOT NULL REFERENCES workspace_members (id) ON DELETE CASCADE,
+    PRIMARY KEY (group_id, member_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_group_members_member_id ON workspace_group_members (member_id);
+
+-- Pemetaan nama grup (huruf kecil) ke role. Dipetakan per nama, bukan per ID grup, agar pemilik
+-- bisa menyiapkannya sebelum identity provider membuat grupnya.
+CREATE TABLE IF NOT EXISTS workspace_role_mappings (
+    workspace_id TEXT NOT NULL,
+    group_name   TEXT NOT NULL,
+    role         TEXT NOT NULL CHECK (role IN ('viewer', 'member', 'admin')),
+    PRIMARY KEY (workspace_id, group_name)
+);
+
+-- Token bearer SCIM per workspace; hanya hash SHA-256-nya yang disimpan.
+CREATE TABLE IF NOT EXISTS scim_tokens (
+    workspace_id TEXT        PRIMARY KEY,
+    token_hash   TEXT        NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,14 @@
+-- Akses kolaborator pada daftar task pemilik. Daftar task adalah seluruh task milik owner_id,
+-- sehingga satu baris memberi akses ke semua task pemilik.
+CREATE TABLE IF NOT EXISTS list_shares (
+    owner_id        TEXT        NOT NULL,
+    collaborator_id TEXT        NOT NULL,
+    access          TEXT        NOT NULL CHECK (access IN ('read', 'write')),
+    created_at      TIMESTAMPTZ NOT NULL,
+    updated_at      TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (owner_id, collaborator_id),
+    CHECK (owner_id <> collaborator_id)
+);
+
+-- Daftar yang dibagikan kepada seorang kolaborator.
+CREATE INDEX IF NOT EXISTS idx_list_shares_collaborator_id ON list_shares (collaborator_id);
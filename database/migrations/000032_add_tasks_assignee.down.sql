@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_tasks_assignee;
+ALTER TABLE tasks DROP COLUMN IF EXISTS assignee_id;
@@ -0,0 +1,4 @@
+-- Penerima tugas task di daftar bersama, terpisah dari pemilik (user_id). Index parsial dipakai
+-- oleh daftar "ditugaskan kepada saya".
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS assignee_id TEXT;
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee ON tasks (assignee_id) WHERE assignee_id IS NOT NULL;
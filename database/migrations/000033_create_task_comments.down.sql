@@ -0,0 +1,3 @@
+ALTER TABLE task_events DROP COLUMN IF EXISTS comment;
+DROP TABLE IF EXISTS task_comment_mentions;
+DROP TABLE IF EXISTS task_comments;
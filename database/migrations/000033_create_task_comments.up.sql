@@ -0,0 +1,23 @@
+-- Komentar task. Baris dihapus otomatis saat task dihapus.
+CREATE TABLE IF NOT EXISTS task_comments (
+    id         TEXT        PRIMARY KEY,
+    task_id    TEXT        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    author_id  TEXT        NOT NULL,
+    body       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_comments_task_id ON task_comments (task_id, created_at);
+
+-- Pengguna yang di-mention di komentar dan sudah diberi tahu.
+CREATE TABLE IF NOT EXISTS task_comment_mentions (
+    comment_id TEXT        NOT NULL REFERENCES task_comments (id) ON DELETE CASCADE,
+    user_id    TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (comment_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_comment_mentions_user_id ON task_comment_mentions (user_id, created_at DESC);
+
+-- Komentar untuk event task.mentioned, terpisah dari payload (snapshot task).
+ALTER TABLE task_events ADD COLUMN IF NOT EXISTS comment JSONB;
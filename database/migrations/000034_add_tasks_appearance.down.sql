@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS icon;
+ALTER TABLE tasks DROP COLUMN IF EXISTS color;
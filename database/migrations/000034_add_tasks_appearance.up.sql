@@ -0,0 +1,4 @@
+-- Warna dan ikon task untuk tampilan UI. Nilai yang diizinkan divalidasi di aplikasi
+-- (domain.TaskColors dan domain.TaskIcons), sehingga palet bisa bertambah tanpa migrasi.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS color TEXT;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS icon TEXT;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_task_events_user_id;
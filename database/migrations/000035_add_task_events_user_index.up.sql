@@ -0,0 +1,2 @@
+-- Index untuk membaca ulang event milik satu pengguna setelah Last-Event-ID (stream SSE).
+CREATE INDEX IF NOT EXISTS idx_task_events_user_id ON task_events (user_id, id);
@@ -0,0 +1,10 @@
+-- Token URL feed kalender (.ics) per pengguna. Seperti scim_tokens, hanya hash SHA-256 token yang
+-- disimpan sehingga URL tidak bisa direkonstruksi dari database.
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+    user_id    TEXT        PRIMARY KEY,
+    token_hash TEXT        NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+-- Feed hanya berisi task yang punya tenggat.
+CREATE INDEX IF NOT EXISTS idx_tasks_user_due_at ON tasks (user_id, due_at) WHERE due_at IS NOT NULL;
@@ -0,0 +1,6 @@
+-- Password aplikasi CalDAV per pengguna. Seperti calendar_feed_tokens, hanya hash SHA-256 yang disimpan.
+CREATE TABLE IF NOT EXISTS caldav_tokens (
+    user_id    TEXT        PRIMARY KEY,
+    token_hash TEXT        NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS google_calendar_events;
+DROP TABLE IF EXISTS google_calendar_connections;
@@ -0,0 +1,25 @@
+-- Sinkronisasi dua arah task bertenggat dengan Google Calendar. access_token dan refresh_token
+-- adalah token OAuth pengguna dengan scope calendar.events.
+CREATE TABLE IF NOT EXISTS google_calendar_connections (
+    user_id          TEXT        PRIMARY KEY,
+    calendar_id      TEXT        NOT NULL,
+    access_token     TEXT        NOT NULL,
+    refresh_token    TEXT        NOT NULL DEFAULT '',
+    token_expires_at TIMESTAMPTZ,
+    sync_token       TEXT        NOT NULL DEFAULT '',
+    last_synced_at   TIMESTAMPTZ,
+    last_error       TEXT        NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ NOT NULL,
+    updated_at       TIMESTAMPTZ NOT NULL
+);
+
+-- Pemetaan task ke event Google. Tidak memakai foreign key ke tasks agar event task yang dihapus
+-- masih bisa ditemukan dan dihapus dari Google.
+CREATE TABLE IF NOT EXISTS google_calendar_events (
+    user_id   TEXT        NOT NULL REFERENCES google_calendar_connections (user_id) ON DELETE CASCADE,
+    task_id   TEXT        NOT NULL,
+    event_id  TEXT        NOT NULL,
+    synced_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, task_id),
+    UNIQUE (user_id, event_id)
+);
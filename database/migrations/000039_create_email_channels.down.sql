@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_due_reminders;
+DROP TABLE IF EXISTS email_channels;
@@ -0,0 +1,17 @@
+-- Pengaturan notifikasi email per pengguna. address diambil dari klaim email token Supabase Auth.
+CREATE TABLE IF NOT EXISTS email_channels (
+    user_id               TEXT        PRIMARY KEY,
+    address               TEXT        NOT NULL,
+    event_types           TEXT[]      NOT NULL DEFAULT '{}',
+    reminder_lead_seconds INTEGER     NOT NULL DEFAULT 0 CHECK (reminder_lead_seconds >= 0),
+    created_at            TIMESTAMPTZ NOT NULL,
+    updated_at            TIMESTAMPTZ NOT NULL
+);
+
+-- Pengingat tenggat yang sudah diklaim, per tenggat sehingga tenggat yang diubah diingatkan lagi.
+CREATE TABLE IF NOT EXISTS task_due_reminders (
+    task_id    TEXT        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    due_at     TIMESTAMPTZ NOT NULL,
+    claimed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (task_id, due_at)
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_devices_push;
+DELETE FROM task_due_reminders WHERE channel <> 'email';
+ALTER TABLE task_due_reminders DROP CONSTRAINT IF EXISTS task_due_reminders_pkey;
+ALTER TABLE task_due_reminders ADD PRIMARY KEY (task_id, due_at);
+ALTER TABLE task_due_reminders DROP COLUMN IF EXISTS channel;
+DROP TABLE IF EXISTS push_channels;
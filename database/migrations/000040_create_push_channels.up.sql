@@ -0,0 +1,19 @@
+-- Pengaturan notifikasi push per pengguna. Langganan push (token FCM atau Web Push) disimpan per
+-- perangkat di devices.push_subscription.
+CREATE TABLE IF NOT EXISTS push_channels (
+    user_id               TEXT        PRIMARY KEY,
+    event_types           TEXT[]      NOT NULL DEFAULT '{}',
+    shared_lists          BOOLEAN     NOT NULL DEFAULT FALSE,
+    reminder_lead_seconds INTEGER     NOT NULL DEFAULT 0 CHECK (reminder_lead_seconds >= 0),
+    created_at            TIMESTAMPTZ NOT NULL,
+    updated_at            TIMESTAMPTZ NOT NULL
+);
+
+-- Klaim pengingat tenggat dibedakan per channel, sehingga email dan push masing-masing
+-- mengingatkan sekali untuk tenggat yang sama.
+ALTER TABLE task_due_reminders ADD COLUMN IF NOT EXISTS channel TEXT NOT NULL DEFAULT 'email';
+ALTER TABLE task_due_reminders DROP CONSTRAINT IF EXISTS task_due_reminders_pkey;
+ALTER TABLE task_due_reminders ADD PRIMARY KEY (task_id, due_at, channel);
+
+-- Perangkat tujuan push dicari per pengguna.
+CREATE INDEX IF NOT EXISTS idx_devices_push ON devices (user_id) WHERE push_subscription IS NOT NULL AND revoked_at IS NULL;
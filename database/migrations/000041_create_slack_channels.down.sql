@@ -0,0 +1,3 @@
+DELETE FROM task_due_reminders WHERE channel = 'slack';
+DROP TABLE IF EXISTS slack_task_completions;
+DROP TABLE IF EXISTS slack_channels;
@@ -0,0 +1,18 @@
+-- Pengaturan notifikasi Slack per pengguna: incoming webhook, atau bot token dengan channel_id.
+CREATE TABLE IF NOT EXISTS slack_channels (
+    user_id               TEXT        PRIMARY KEY,
+    webhook_url           TEXT        NOT NULL DEFAULT '',
+    bot_token             TEXT        NOT NULL DEFAULT '',
+    channel_id            TEXT        NOT NULL DEFAULT '',
+    event_types           TEXT[]      NOT NULL DEFAULT '{}',
+    reminder_lead_seconds INTEGER     NOT NULL DEFAULT 0 CHECK (reminder_lead_seconds >= 0),
+    created_at            TIMESTAMPTZ NOT NULL,
+    updated_at            TIMESTAMPTZ NOT NULL
+);
+
+-- Penyelesaian task terakhir yang sudah dikirim ke Slack; completed_at baru berarti task diselesaikan
+-- lagi setelah dibuka kembali. Pengingat tenggat memakai task_due_reminders dengan channel 'slack'.
+CREATE TABLE IF NOT EXISTS slack_task_completions (
+    task_id      TEXT        PRIMARY KEY REFERENCES tasks (id) ON DELETE CASCADE,
+    completed_at TIMESTAMPTZ NOT NULL
+);
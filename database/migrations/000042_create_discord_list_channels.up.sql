@@ -0,0 +1,13 @@
+-- Pengaturan notifikasi Discord kolaborator untuk daftar bersama. Baris dihapus bersama aksesnya,
+-- sehingga kolaborator yang dicabut atau keluar tidak menerima event daftar tersebut lagi.
+CREATE TABLE IF NOT EXISTS discord_list_channels (
+    owner_id    TEXT        NOT NULL,
+    user_id     TEXT        NOT NULL,
+    webhook_url TEXT        NOT NULL DEFAULT '',
+    channel_id  TEXT        NOT NULL DEFAULT '',
+    event_types TEXT[]      NOT NULL DEFAULT '{}',
+    created_at  TIMESTAMPTZ NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (owner_id, user_id),
+    FOREIGN KEY (owner_id, user_id) REFERENCES list_shares (owner_id, collaborator_id) ON DELETE CASCADE
+);
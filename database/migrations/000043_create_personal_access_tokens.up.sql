@@ -0,0 +1,15 @@
+-- Personal access token untuk script dan integrasi pihak ketiga. Seperti caldav_tokens, hanya hash
+-- SHA-256 yang disimpan; hint adalah beberapa karakter terakhir token untuk ditampilkan di daftar.
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id           TEXT        PRIMARY KEY,
+    user_id      TEXT        NOT NULL,
+    name         TEXT        NOT NULL,
+    token_hash   TEXT        NOT NULL UNIQUE,
+    hint         TEXT        NOT NULL,
+    scopes       TEXT[]      NOT NULL DEFAULT '{}',
+    created_at   TIMESTAMPTZ NOT NULL,
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_created_at ON personal_access_tokens (user_id, created_at DESC);
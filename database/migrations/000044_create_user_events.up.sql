@@ -0,0 +1,16 @@
+-- Antrean event akun dari luar task-service (user-service atau trigger pada auth.users Supabase).
+-- Producer menulis baris lalu memanggil pg_notify('user_events', ''); event_id unik sehingga publish
+-- ulang bisa memakai ON CONFLICT DO NOTHING. processed_at diisi setelah event diproses.
+CREATE TABLE IF NOT EXISTS user_events (
+    id           BIGSERIAL   PRIMARY KEY,
+    event_id     TEXT        NOT NULL UNIQUE,
+    event_type   TEXT        NOT NULL,
+    user_id      TEXT        NOT NULL,
+    occurred_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    processed_at TIMESTAMPTZ,
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    last_error   TEXT        NOT NULL DEFAULT ''
+);
+
+-- Event yang belum diproses.
+CREATE INDEX IF NOT EXISTS idx_user_events_pending ON user_events (id) WHERE processed_at IS NULL;
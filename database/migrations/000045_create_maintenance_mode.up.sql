@@ -0,0 +1,13 @@
+-- Mode maintenance global, selalu satu baris. Selama enabled, request write ke task-service dijawab
+-- 503 dengan Retry-After sebesar retry_after_seconds; setiap replika membaca ulang baris ini
+-- secara berkala.
+CREATE TABLE IF NOT EXISTS maintenance_mode (
+    id                  BOOLEAN     PRIMARY KEY DEFAULT TRUE CHECK (id),
+    enabled             BOOLEAN     NOT NULL DEFAULT FALSE,
+    message             TEXT        NOT NULL DEFAULT '',
+    retry_after_seconds INTEGER     NOT NULL DEFAULT 0,
+    updated_by          TEXT        NOT NULL DEFAULT '',
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO maintenance_mode (id) VALUES (TRUE) ON CONFLICT DO NOTHING;
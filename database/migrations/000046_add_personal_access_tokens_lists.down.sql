@@ -0,0 +1,2 @@
+ALTER TABLE personal_access_tokens DROP COLUMN IF EXISTS lists;
+UPDATE personal_access_tokens SET scopes = array_remove(scopes, 'export');
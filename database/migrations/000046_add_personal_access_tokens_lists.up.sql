@@ -0,0 +1,8 @@
+-- Personal access token bisa dibatasi ke daftar tertentu (user_id pemilik daftar); kosong berarti
+-- semua daftar yang bisa diakses pengguna. Scope export baru diberikan ke token lama yang sudah
+-- bisa membaca tugas, agar script ekspor yang ada tetap berjalan.
+ALTER TABLE personal_access_tokens ADD COLUMN IF NOT EXISTS lists TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE personal_access_tokens
+SET scopes = array_append(scopes, 'export')
+WHERE scopes && ARRAY['tasks:read', 'tasks:write'] AND NOT 'export' = ANY(scopes);
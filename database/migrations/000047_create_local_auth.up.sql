@@ -0,0 +1,28 @@
+-- Akun dan refresh token untuk mode autentikasi lokal (LOCAL_AUTH_SECRET), untuk instalasi
+-- self-hosted tanpa Supabase. Email disimpan dalam huruf kecil. Seperti personal_access_tokens,
+-- hanya hash SHA-256 refresh token yang disimpan.
+CREATE TABLE IF NOT EXISTS local_users (
+    id            TEXT        PRIMARY KEY,
+    email         TEXT        NOT NULL,
+    password_hash TEXT        NOT NULL,
+    role          TEXT        NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_local_users_email ON local_users (email);
+
+-- Token hasil rotasi dari satu login memakai family_id yang sama. used_at diisi saat token
+-- dirotasi; token terpakai yang dikirim lagi membuat seluruh family dicabut (revoked_at).
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id         TEXT        PRIMARY KEY,
+    family_id  TEXT        NOT NULL,
+    user_id    TEXT        NOT NULL REFERENCES local_users (id) ON DELETE CASCADE,
+    token_hash TEXT        NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
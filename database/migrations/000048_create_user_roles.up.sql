@@ -0,0 +1,10 @@
+-- Role aplikasi yang diberikan admin lewat PUT /api/v1/admin/users/{userID}/role. Pengguna tanpa
+-- baris memiliki role "user"; claim app_metadata.role admin di JWT tetap berlaku tanpa baris di sini.
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id    TEXT        PRIMARY KEY,
+    role       TEXT        NOT NULL CHECK (role IN ('user', 'admin')),
+    granted_by TEXT        NOT NULL,
+    granted_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles (role);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_task_comments_author_id;
+DROP TABLE IF EXISTS data_exports;
@@ -0,0 +1,22 @@
+-- Permintaan ekspor data pribadi (POST /api/v1/me/export). Arsip ZIP disusun job terjadwal dan
+-- disimpan di BlobStore (blob_key) sampai expires_at.
+CREATE TABLE IF NOT EXISTS data_exports (
+    id           TEXT        PRIMARY KEY,
+    user_id      TEXT        NOT NULL,
+    status       TEXT        NOT NULL CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    blob_key     TEXT        NOT NULL DEFAULT '',
+    size_bytes   BIGINT      NOT NULL DEFAULT 0,
+    error        TEXT        NOT NULL DEFAULT '',
+    requested_at TIMESTAMPTZ NOT NULL,
+    started_at   TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ
+);
+
+-- Paling banyak satu ekspor yang sedang berjalan per pengguna.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_data_exports_active ON data_exports (user_id) WHERE status IN ('pending', 'running');
+CREATE INDEX IF NOT EXISTS idx_data_exports_pending ON data_exports (requested_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_data_exports_expires_at ON data_exports (expires_at) WHERE status = 'completed';
+
+-- Komentar yang ditulis pengguna di task milik siapa pun ikut diekspor.
+CREATE INDEX IF NOT EXISTS idx_task_comments_author_id ON task_comments (author_id, created_at DESC);
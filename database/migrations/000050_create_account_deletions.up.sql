@@ -0,0 +1,16 @@
+-- Permintaan penghapusan data akun (DELETE /api/v1/me). Data dihapus job terjadwal; baris ini dan
+-- receipt-nya tetap disimpan sebagai bukti penghapusan.
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id           TEXT        PRIMARY KEY,
+    user_id      TEXT        NOT NULL,
+    status       TEXT        NOT NULL CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    receipt      JSONB,
+    error        TEXT        NOT NULL DEFAULT '',
+    requested_at TIMESTAMPTZ NOT NULL,
+    started_at   TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ
+);
+
+-- Paling banyak satu penghapusan yang sedang berjalan per pengguna.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_account_deletions_active ON account_deletions (user_id) WHERE status IN ('pending', 'running');
+CREATE INDEX IF NOT EXISTS idx_account_deletions_pending ON account_deletions (requested_at) WHERE status = 'pending';
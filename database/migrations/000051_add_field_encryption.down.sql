@@ -0,0 +1,52 @@
+-- Kembalikan definisi dari 000011 dan 000014. Deskripsi yang sudah terenkripsi tetap terenkripsi.
+CREATE OR REPLACE FUNCTION record_task_revision() RETURNS trigger AS $$
+DECLARE
+    diff JSONB := '{}'::jsonb;
+    next_revision INT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        diff := jsonb_build_object(
+            'title', jsonb_build_object('old', NULL, 'new', NEW.title),
+            'description', jsonb_build_object('old', NULL, 'new', NEW.description),
+            'completed', jsonb_build_object('old', NULL, 'new', NEW.completed),
+            'completed_at', jsonb_build_object('old', NULL, 'new', NEW.completed_at));
+    ELSE
+        IF NEW.title IS DISTINCT FROM OLD.title THEN
+            diff := diff || jsonb_build_object('title', jsonb_build_object('old', OLD.title, 'new', NEW.title));
+        END IF;
+        IF NEW.description IS DISTINCT FROM OLD.description THEN
+            diff := diff || jsonb_build_object('description', jsonb_build_object('old', OLD.description, 'new', NEW.description));
+        END IF;
+        IF NEW.completed IS DISTINCT FROM OLD.completed THEN
+            diff := diff || jsonb_build_object('completed', jsonb_build_object('old', OLD.completed, 'new', NEW.completed));
+        END IF;
+        IF NEW.completed_at IS DISTINCT FROM OLD.completed_at THEN
+            diff := diff || jsonb_build_object('completed_at', jsonb_build_object('old', OLD.completed_at, 'new', NEW.completed_at));
+        END IF;
+        IF diff = '{}'::jsonb THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    -- Aman dari race: UPDATE memegang row lock task sampai commit.
+    SELECT COALESCE(MAX(revision), 0) + 1 INTO next_revision FROM task_revisions WHERE task_id = NEW.id;
+
+    INSERT INTO task_revisions (task_id, user_id, actor_id, revision, changes, snapshot, occurred_at)
+    VALUES (
+        NEW.id, NEW.user_id,
+        COALESCE(NULLIF(current_setting('app.actor_id', true), ''), NEW.user_id),
+        next_revision, diff,
+        jsonb_build_object('title', NEW.title, 'description', NEW.description,
+                           'completed', NEW.completed, 'completed_at', NEW.completed_at),
+        NEW.updated_at);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE tasks ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('task_search', task_search_emoji_tokens(title)), 'A') ||
+    setweight(to_tsvector('task_search', task_search_emoji_tokens(description)), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING gin (search_vector);
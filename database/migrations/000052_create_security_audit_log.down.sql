@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_last_ips;
+DROP TABLE IF EXISTS security_audit_log;
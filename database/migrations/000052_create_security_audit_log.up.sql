@@ -0,0 +1,23 @@
+-- Audit log keamanan: token yang ditolak, perpindahan jaringan IP, dan pemakaian personal access
+-- token. Terpisah dari admin_audit_log, yang mencatat aksi admin. Entri dihapus job terjadwal
+-- setelah SECURITY_AUDIT_RETENTION.
+CREATE TABLE IF NOT EXISTS security_audit_log (
+    id          BIGSERIAL   PRIMARY KEY,
+    event_type  TEXT        NOT NULL,
+    user_id     TEXT        NOT NULL DEFAULT '',  -- Kosong jika pengguna tidak diketahui
+    ip          TEXT        NOT NULL DEFAULT '',
+    user_agent  TEXT        NOT NULL DEFAULT '',
+    details     JSONB       NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_audit_log_user_id ON security_audit_log (user_id, id DESC) WHERE user_id <> '';
+CREATE INDEX IF NOT EXISTS idx_security_audit_log_ip ON security_audit_log (ip, id DESC);
+CREATE INDEX IF NOT EXISTS idx_security_audit_log_occurred_at ON security_audit_log (occurred_at);
+
+-- IP terakhir setiap pengguna, untuk mendeteksi perpindahan jaringan.
+CREATE TABLE IF NOT EXISTS user_last_ips (
+    user_id TEXT        PRIMARY KEY,
+    ip      TEXT        NOT NULL,
+    seen_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,17 @@
+-- Sesi aktif setiap pengguna: sesi login (claim session_id atau sid JWT; untuk akun lokal sama
+-- dengan family_id refresh token) dan personal access token. Baris yang dicabut (revoked_at)
+-- disimpan agar access token sesi tersebut tetap ditolak. Baris yang tidak terlihat lagi selama
+-- 90 hari dihapus job terjadwal.
+CREATE TABLE IF NOT EXISTS user_sessions (
+    user_id      TEXT        NOT NULL,
+    id           TEXT        NOT NULL,
+    kind         TEXT        NOT NULL,
+    user_agent   TEXT        NOT NULL DEFAULT '',
+    ip           TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    revoked_at   TIMESTAMPTZ,
+    PRIMARY KEY (user_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_sessions_last_seen_at ON user_sessions (last_seen_at);
@@ -0,0 +1 @@
+ALTER TABLE personal_access_tokens DROP COLUMN IF EXISTS allowed_ips;
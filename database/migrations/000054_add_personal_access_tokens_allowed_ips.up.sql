@@ -0,0 +1,2 @@
+-- Personal access token bisa dibatasi ke jaringan klien tertentu; kosong berarti semua IP.
+ALTER TABLE personal_access_tokens ADD COLUMN IF NOT EXISTS allowed_ips CIDR[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,14 @@
+-- Penghitung login akun lokal yang gagal, per akun (key "account:<email>") dan per IP (key
+-- "ip:<alamat>"). failures dihitung ulang dari 1 jika kegagalan terakhir sudah lewat dari jendela
+-- penghitungan. unlock_token_hash adalah hash SHA-256 token buka kunci yang dikirim lewat email
+-- saat akun terkunci.
+CREATE TABLE IF NOT EXISTS login_throttles (
+    key               TEXT        PRIMARY KEY,
+    failures          INTEGER     NOT NULL,
+    last_failure_at   TIMESTAMPTZ NOT NULL,
+    blocked_until     TIMESTAMPTZ,
+    unlock_token_hash TEXT        UNIQUE,
+    unlock_expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_throttles_last_failure_at ON login_throttles (last_failure_at);
@@ -0,0 +1,10 @@
+-- Tamu (klien anonim dengan token tamu) yang sudah digabung ke akun pengguna. Baris ini sekaligus
+-- mencegah penggabungan ganda dan membuat token tamu tersebut ditolak setelahnya.
+CREATE TABLE IF NOT EXISTS guest_merges (
+    guest_id     TEXT        PRIMARY KEY,
+    user_id      TEXT        NOT NULL,
+    merged_tasks BIGINT      NOT NULL DEFAULT 0,
+    merged_at    TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_guest_merges_user_id ON guest_merges (user_id);
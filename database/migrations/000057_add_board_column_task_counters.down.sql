@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_tasks_board_column_counters_update ON tasks;
+DROP TRIGGER IF EXISTS trg_tasks_board_column_counters_insert_delete ON tasks;
+DROP FUNCTION IF EXISTS maintain_board_column_task_counters();
+ALTER TABLE board_columns DROP COLUMN IF EXISTS open_tasks, DROP COLUMN IF EXISTS total_tasks;
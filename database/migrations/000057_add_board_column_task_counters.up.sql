@@ -0,0 +1,48 @@
+-- Counter cache jumlah task per kolom board, seperti user_task_counters untuk pengguna. Hanya task
+-- yang belum diarsipkan yang dihitung, sama dengan batas WIP. Diperbarui oleh trigger di transaksi
+-- yang sama dengan write ke tabel tasks, dan direkonsiliasi oleh pemeriksaan integritas
+-- board_column_counter_drift.
+ALTER TABLE board_columns
+    ADD COLUMN IF NOT EXISTS total_tasks BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS open_tasks  BIGINT NOT NULL DEFAULT 0; -- Task yang belum selesai
+
+UPDATE board_columns c
+SET total_tasks = t.total, open_tasks = t.open
+FROM (
+    SELECT column_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE NOT completed) AS open
+    FROM tasks
+    WHERE column_id IS NOT NULL AND NOT archived
+    GROUP BY column_id
+) t
+WHERE c.id = t.column_id;
+
+CREATE OR REPLACE FUNCTION maintain_board_column_task_counters() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND OLD.column_id IS NOT NULL AND NOT OLD.archived THEN
+        UPDATE board_columns
+        SET total_tasks = total_tasks - 1,
+            open_tasks = open_tasks - CASE WHEN OLD.completed THEN 0 ELSE 1 END
+        WHERE id = OLD.column_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.column_id IS NOT NULL AND NOT NEW.archived THEN
+        UPDATE board_columns
+        SET total_tasks = total_tasks + 1,
+            open_tasks = open_tasks + CASE WHEN NEW.completed THEN 0 ELSE 1 END
+        WHERE id = NEW.column_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_tasks_board_column_counters_insert_delete
+AFTER INSERT OR DELETE ON tasks
+FOR EACH ROW EXECUTE FUNCTION maintain_board_column_task_counters();
+
+-- UPDATE hanya memengaruhi counter jika kolom, status selesai, atau status arsip berubah.
+CREATE TRIGGER trg_tasks_board_column_counters_update
+AFTER UPDATE OF column_id, completed, archived ON tasks
+FOR EACH ROW
+WHEN (OLD.column_id IS DISTINCT FROM NEW.column_id
+      OR OLD.completed IS DISTINCT FROM NEW.completed
+      OR OLD.archived IS DISTINCT FROM NEW.archived)
+EXECUTE FUNCTION maintain_board_column_task_counters();
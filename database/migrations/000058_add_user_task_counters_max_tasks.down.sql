@@ -0,0 +1,20 @@
+CREATE OR REPLACE FUNCTION maintain_user_task_counters() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE user_task_counters
+        SET total_tasks = total_tasks - 1,
+            open_tasks = open_tasks - CASE WHEN OLD.completed THEN 0 ELSE 1 END
+        WHERE user_id = OLD.user_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO user_task_counters (user_id, total_tasks, open_tasks)
+        VALUES (NEW.user_id, 1, CASE WHEN NEW.completed THEN 0 ELSE 1 END)
+        ON CONFLICT (user_id) DO UPDATE
+        SET total_tasks = user_task_counters.total_tasks + 1,
+            open_tasks = user_task_counters.open_tasks + EXCLUDED.open_tasks;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE user_task_counters DROP COLUMN IF EXISTS max_tasks;
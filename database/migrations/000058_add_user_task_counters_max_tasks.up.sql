@@ -0,0 +1,33 @@
+-- Batas jumlah task per pengguna, saat ini hanya diisi untuk tamu (lihat mode tamu). NULL berarti
+-- tanpa batas. Batas diperiksa oleh trigger counter setelah baris counter dikunci, sehingga insert
+-- bersamaan tidak bisa melewatinya. Pelanggaran dilaporkan sebagai check_violation dengan nama
+-- constraint user_task_counters_max_tasks.
+ALTER TABLE user_task_counters ADD COLUMN IF NOT EXISTS max_tasks BIGINT;
+
+CREATE OR REPLACE FUNCTION maintain_user_task_counters() RETURNS trigger AS $$
+DECLARE
+    total BIGINT;
+    max_total BIGINT;
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE user_task_counters
+        SET total_tasks = total_tasks - 1,
+            open_tasks = open_tasks - CASE WHEN OLD.completed THEN 0 ELSE 1 END
+        WHERE user_id = OLD.user_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO user_task_counters (user_id, total_tasks, open_tasks)
+        VALUES (NEW.user_id, 1, CASE WHEN NEW.completed THEN 0 ELSE 1 END)
+        ON CONFLICT (user_id) DO UPDATE
+        SET total_tasks = user_task_counters.total_tasks + 1,
+            open_tasks = user_task_counters.open_tasks + EXCLUDED.open_tasks
+        RETURNING total_tasks, max_tasks INTO total, max_total;
+        -- Hanya task baru yang ditolak; task yang dipindahkan ke pengguna (penggabungan tamu) tidak.
+        IF TG_OP = 'INSERT' AND total > max_total THEN
+            RAISE EXCEPTION 'task limit of % reached', max_total
+                USING ERRCODE = 'check_violation', CONSTRAINT = 'user_task_counters_max_tasks';
+        END IF;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,5 @@
+ALTER TABLE devices ALTER COLUMN last_cursor TYPE TIMESTAMPTZ USING NULL;
+DROP TRIGGER IF EXISTS trg_tasks_change_xid ON tasks;
+DROP FUNCTION IF EXISTS set_task_change_xid();
+DROP INDEX IF EXISTS idx_tasks_user_change_xid;
+ALTER TABLE tasks DROP COLUMN IF EXISTS change_xid;
@@ -0,0 +1,27 @@
+-- Posisi perubahan task untuk delta sync (GET /api/v1/sync). updated_at diisi jam replika yang
+-- menulis, sehingga transaksi yang commit setelah pull dengan updated_at yang lebih lama (atau sama
+-- dengan cursor) terlewat selamanya. change_xid berisi ID transaksi yang terakhir menulis baris,
+-- dan cursor sync adalah xmin snapshot pull (pg_snapshot_xmin): semua transaksi di bawahnya sudah
+-- selesai dan terlihat, sehingga transaksi yang belum commit saat pull ikut terbaca pada pull
+-- berikutnya. Baris lama diisi 0 agar ikut terbaca pada full sync.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS change_xid xid8 NOT NULL DEFAULT '0';
+
+CREATE INDEX IF NOT EXISTS idx_tasks_user_change_xid ON tasks (user_id, change_xid);
+
+CREATE OR REPLACE FUNCTION set_task_change_xid() RETURNS trigger AS $$
+BEGIN
+    -- Enkripsi ulang field (app.skip_revision) tidak mengubah isi task, jadi tidak perlu disinkron.
+    IF TG_OP = 'UPDATE' AND current_setting('app.skip_revision', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+    NEW.change_xid := pg_current_xact_id();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_tasks_change_xid
+BEFORE INSERT OR UPDATE ON tasks
+FOR EACH ROW EXECUTE FUNCTION set_task_change_xid();
+
+-- Cursor perangkat kini berupa xid, bukan waktu; cursor lama tidak bisa dikonversi.
+ALTER TABLE devices ALTER COLUMN last_cursor TYPE BIGINT USING NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_user_task_counters_expires_at;
+ALTER TABLE user_task_counters DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,17 @@
+-- Waktu kedaluwarsa token tamu. Setelah lewat, data tamu yang belum digabung tidak bisa diakses
+-- lagi dan dihapus oleh purge berkala task-service. NULL berarti bukan tamu.
+ALTER TABLE user_task_counters ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_user_task_counters_expires_at
+    ON user_task_counters (expires_at) WHERE expires_at IS NOT NULL;
+
+-- Tamu yang sudah digabung tidak lagi memiliki task, sehingga baris counternya dibuang.
+DELETE FROM user_task_counters c
+USING guest_merges m
+WHERE c.user_id = m.guest_id;
+
+-- Waktu pembuatan tamu lama tidak tersimpan, jadi tamu tersebut diberi masa berlaku default
+-- GUEST_TOKEN_TTL (30 hari) sejak migrasi.
+UPDATE user_task_counters
+SET expires_at = now() + interval '30 days'
+WHERE user_id LIKE 'guest\_%' AND expires_at IS NULL;